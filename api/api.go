@@ -3,7 +3,9 @@ package api
 import (
 	"context"
 
+	"github.com/brimdata/super"
 	"github.com/brimdata/super/compiler/srcfiles"
+	"github.com/brimdata/super/lake/pools"
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/pkg/field"
 	"github.com/brimdata/super/pkg/nano"
@@ -20,8 +22,39 @@ func RequestIDFromContext(ctx context.Context) string {
 	return ""
 }
 
+// An ErrorCode classifies an Error so that clients can act on the kind of
+// failure without parsing its message.  Codes are stable across releases.
+type ErrorCode string
+
+const (
+	// ErrorCodeCanceled means the request was canceled or timed out.
+	ErrorCodeCanceled ErrorCode = "canceled"
+	// ErrorCodeCompile means a query failed to parse or compile.  The
+	// errors are in Error.CompilationErrors.
+	ErrorCodeCompile ErrorCode = "compile_error"
+	// ErrorCodeConflict means the request conflicts with the current state
+	// of the lake, e.g., an item already exists or a concurrent commit won.
+	ErrorCodeConflict ErrorCode = "conflict"
+	// ErrorCodeForbidden means the caller lacks permission for the request.
+	ErrorCodeForbidden ErrorCode = "forbidden"
+	// ErrorCodeInternal means the service failed for an unclassified reason.
+	ErrorCodeInternal ErrorCode = "internal"
+	// ErrorCodeInvalid means the request is malformed.
+	ErrorCodeInvalid ErrorCode = "invalid"
+	// ErrorCodeNotFound means an item named by the request does not exist.
+	ErrorCodeNotFound ErrorCode = "not_found"
+	// ErrorCodeQuota means the request exceeds a resource limit.
+	ErrorCodeQuota ErrorCode = "quota_exceeded"
+	// ErrorCodeStorageUnavailable means the service could not reach its
+	// storage.  The request may succeed if retried.
+	ErrorCodeStorageUnavailable ErrorCode = "storage_unavailable"
+	// ErrorCodeUnauthenticated means the request lacks valid credentials.
+	ErrorCodeUnauthenticated ErrorCode = "unauthenticated"
+)
+
 type Error struct {
 	Type              string             `json:"type"`
+	Code              ErrorCode          `json:"code"`
 	Kind              string             `json:"kind"`
 	Message           string             `json:"error"`
 	CompilationErrors srcfiles.ErrorList `json:"compilation_errors,omitempty"`
@@ -36,10 +69,23 @@ type VersionResponse struct {
 }
 
 type PoolPostRequest struct {
-	Name       string   `json:"name"`
-	SortKeys   SortKeys `json:"layout"`
-	SeekStride int      `json:"seek_stride"`
-	Thresh     int64    `json:"thresh"`
+	Name       string          `json:"name"`
+	SortKeys   SortKeys        `json:"layout"`
+	SeekStride int             `json:"seek_stride"`
+	Thresh     int64           `json:"thresh"`
+	Summaries  []string        `json:"summaries,omitempty"`
+	Defaults   pools.Defaults  `json:"defaults"`
+	Transform  string          `json:"transform,omitempty"`
+	Masks      []pools.Mask    `json:"masks,omitempty"`
+	Partition  pools.Partition `json:"partition"`
+}
+
+type ExternalPostRequest struct {
+	Name string `json:"name"`
+	// Pattern is the URI of the files of the external pool, whose last
+	// element may contain wildcards, e.g., "s3://bucket/logs/*.parquet".
+	Pattern string `json:"pattern"`
+	Format  string `json:"format,omitempty"`
 }
 
 type SortKeys struct {
@@ -77,6 +123,22 @@ type CommitMessage struct {
 	Author string `super:"author"`
 	Body   string `super:"body"`
 	Meta   string `super:"meta"`
+	// Sources, Loader, and Labels are recorded as the provenance of
+	// the commit for load requests.
+	Sources []string          `super:"sources" json:",omitempty"`
+	Loader  string            `super:"loader" json:",omitempty"`
+	Labels  map[string]string `super:"labels" json:",omitempty"`
+	// Key, if nonempty, identifies a load request so that a retry of the
+	// request with the same key returns the original commit instead of
+	// committing the data again.
+	Key string `super:"key" json:",omitempty"`
+	// Dedup, if true, causes a load request whose values have the same
+	// content hash as those of a previous deduplicated load to commit
+	// none of them.
+	Dedup bool `super:"dedup" json:",omitempty"`
+	// Transform, if nonempty, is a query applied to the values of a load
+	// request in place of the pool's transform.
+	Transform string `super:"transform" json:",omitempty"`
 }
 
 type CommitResponse struct {
@@ -102,6 +164,141 @@ type EventBranch struct {
 
 type QueryRequest struct {
 	Query string `json:"query"`
+	// Labels attribute the query to a workload, e.g., {"team":"search",
+	// "job":"nightly"}, in the service's query status, logs, and metrics.
+	Labels map[string]string `json:"labels,omitempty"`
+	// Export, if set, directs the service to write the query's results
+	// to storage instead of the response.
+	Export *QueryExport `json:"export,omitempty"`
+}
+
+type QueryExport struct {
+	// URI is the file or S3 URI to which results are written.  It must
+	// be under an export root of the service.
+	URI string `json:"uri"`
+	// Format is the format of the results (default "bsup").
+	Format string `json:"format,omitempty"`
+}
+
+// QueryExportManifest describes the results of an exported query.  Once
+// the results are complete, it is written in JSON to the export URI with
+// the suffix ExportManifestSuffix and returned in the query's response.
+type QueryExportManifest struct {
+	URI       string  `json:"uri" super:"uri"`
+	Format    string  `json:"format" super:"format"`
+	Query     string  `json:"query" super:"query"`
+	Records   int64   `json:"records" super:"records"`
+	Bytes     int64   `json:"bytes" super:"bytes"`
+	Start     nano.Ts `json:"start" super:"start"`
+	End       nano.Ts `json:"end" super:"end"`
+	RequestID string  `json:"request_id" super:"request_id"`
+}
+
+const ExportManifestSuffix = ".manifest.json"
+
+type SessionPostRequest struct {
+	// Pool and Branch name the pool scanned by queries in the session
+	// that have no data source.
+	Pool   string `json:"pool"`
+	Branch string `json:"branch"`
+	// Decls are declarations (e.g., of constants and functions) placed
+	// in scope of every query in the session.
+	Decls string `json:"decls"`
+}
+
+type Session struct {
+	ID     ksuid.KSUID `json:"id" super:"id"`
+	Pool   string      `json:"pool" super:"pool"`
+	Branch string      `json:"branch" super:"branch"`
+	Decls  string      `json:"decls" super:"decls"`
+}
+
+type SchedulePostRequest struct {
+	// Name identifies the schedule.
+	Name string `json:"name"`
+	// Query is run at the times given by Cron, a cron expression
+	// (e.g., "0 * * * *") or descriptor (e.g., "@hourly" or "@every 5m"),
+	// and its results are committed to Branch (default "main") of Pool.
+	Query  string `json:"query"`
+	Cron   string `json:"cron"`
+	Pool   string `json:"pool"`
+	Branch string `json:"branch"`
+	// Author is the author of the commits.  Defaults to "scheduler".
+	Author string `json:"author"`
+}
+
+type Schedule struct {
+	Name   string `json:"name" super:"name"`
+	Query  string `json:"query" super:"query"`
+	Cron   string `json:"cron" super:"cron"`
+	Pool   string `json:"pool" super:"pool"`
+	Branch string `json:"branch" super:"branch"`
+	Author string `json:"author" super:"author"`
+	// Next is the time of the next scheduled run.
+	Next nano.Ts `json:"next" super:"next"`
+	// Runs are the most recent runs, oldest first.
+	Runs []ScheduleRun `json:"runs" super:"runs"`
+}
+
+type ScheduleRun struct {
+	Start nano.Ts `json:"start" super:"start"`
+	End   nano.Ts `json:"end" super:"end"`
+	// Commit is the commit of the run's results.  It is nil if the run
+	// failed or produced no results.
+	Commit ksuid.KSUID `json:"commit" super:"commit"`
+	Error  string      `json:"error,omitempty" super:"error"`
+	// Skipped is true if the run was skipped because the previous run of
+	// the schedule had not finished.
+	Skipped bool `json:"skipped,omitempty" super:"skipped"`
+}
+
+type EventSchedule struct {
+	Name   string      `super:"name"`
+	PoolID ksuid.KSUID `super:"pool_id"`
+	Branch string      `super:"branch"`
+	Commit ksuid.KSUID `super:"commit_id"`
+	Error  string      `super:"error"`
+}
+
+type AlertPostRequest struct {
+	// Name identifies the alert rule.
+	Name string `json:"name"`
+	// Query is run over the data newly committed to Branch (default
+	// "main") of Pool, and the rule fires if any of its results satisfy
+	// Condition, a Boolean expression (e.g., "count > 0").  If Condition
+	// is empty, the rule fires if there are any results.
+	Query     string `json:"query"`
+	Condition string `json:"condition"`
+	Pool      string `json:"pool"`
+	Branch    string `json:"branch"`
+	// Webhook is an optional URL to which each alert is posted as JSON.
+	Webhook string `json:"webhook"`
+}
+
+type AlertRule struct {
+	Name      string `json:"name" super:"name"`
+	Query     string `json:"query" super:"query"`
+	Condition string `json:"condition" super:"condition"`
+	Pool      string `json:"pool" super:"pool"`
+	Branch    string `json:"branch" super:"branch"`
+	Webhook   string `json:"webhook" super:"webhook"`
+	// Commit is the last commit of the branch whose data has been
+	// evaluated by the rule.
+	Commit ksuid.KSUID `json:"commit" super:"commit"`
+	// Alerts is the number of times the rule has fired.
+	Alerts uint64 `json:"alerts" super:"alerts"`
+	// Error is the error of the most recent evaluation, if it failed.
+	Error string `json:"error,omitempty" super:"error"`
+}
+
+type EventAlert struct {
+	Name   string      `super:"name"`
+	PoolID ksuid.KSUID `super:"pool_id"`
+	Branch string      `super:"branch"`
+	Commit ksuid.KSUID `super:"commit_id"`
+	// Values are the results that satisfied the rule's condition.
+	Values []super.Value `super:"values"`
+	Error  string        `super:"error"`
 }
 
 type QueryChannelSet struct {
@@ -113,7 +310,26 @@ type QueryChannelEnd struct {
 }
 
 type QueryError struct {
-	Error string `json:"error" super:"error"`
+	Error  string            `json:"error" super:"error"`
+	Labels map[string]string `json:"labels,omitempty" super:"labels"`
+}
+
+type QueryStatus struct {
+	RequestID string            `json:"request_id" super:"request_id"`
+	TenantID  string            `json:"tenant_id" super:"tenant_id"`
+	UserID    string            `json:"user_id" super:"user_id"`
+	Query     string            `json:"query" super:"query"`
+	Labels    map[string]string `json:"labels,omitempty" super:"labels"`
+	Start     nano.Ts           `json:"start" super:"start"`
+	// End is zero if the query is running.
+	End      nano.Ts       `json:"end" super:"end"`
+	Elapsed  nano.Duration `json:"elapsed" super:"elapsed"`
+	Progress zbuf.Progress `json:"progress" super:"progress"`
+	// Spill is the statistics of the values spilled to temporary storage
+	// by the query's operators, e.g., aggregations that exceeded their
+	// memory budget.
+	Spill zbuf.SpillStats `json:"spill" super:"spill"`
+	Error string          `json:"error,omitempty" super:"error"`
 }
 
 type QueryStats struct {
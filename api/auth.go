@@ -1,8 +1,9 @@
 package api
 
 type AuthIdentityResponse struct {
-	TenantID string `json:"tenant_id" super:"tenant_id"`
-	UserID   string `json:"user_id" super:"user_id"`
+	TenantID string   `json:"tenant_id" super:"tenant_id"`
+	UserID   string   `json:"user_id" super:"user_id"`
+	Roles    []string `json:"roles,omitempty" super:"roles"`
 }
 
 type AuthMethod string
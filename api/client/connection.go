@@ -19,6 +19,7 @@ import (
 	"github.com/brimdata/super/compiler/srcfiles"
 	"github.com/brimdata/super/lake"
 	"github.com/brimdata/super/lake/branches"
+	"github.com/brimdata/super/lake/externals"
 	"github.com/brimdata/super/runtime/exec"
 	"github.com/brimdata/super/sup"
 	"github.com/brimdata/super/zio/bsupio"
@@ -210,7 +211,7 @@ func (c *Connection) PoolStats(ctx context.Context, id ksuid.KSUID) (exec.PoolSt
 	var stats exec.PoolStats
 	err := c.doAndUnmarshal(req, &stats)
 	if errIsStatus(err, http.StatusNotFound) {
-		err = ErrPoolNotFound
+		err = &sentinelError{ErrPoolNotFound, err}
 	}
 	return stats, err
 }
@@ -221,7 +222,7 @@ func (c *Connection) BranchGet(ctx context.Context, poolID ksuid.KSUID, branchNa
 	var commit api.CommitResponse
 	err := c.doAndUnmarshal(req, &commit)
 	if errIsStatus(err, http.StatusNotFound) {
-		err = ErrBranchNotFound
+		err = &sentinelError{ErrBranchNotFound, err}
 	}
 	return commit, err
 }
@@ -231,7 +232,7 @@ func (c *Connection) CreatePool(ctx context.Context, payload api.PoolPostRequest
 	var meta lake.BranchMeta
 	err := c.doAndUnmarshal(req, &meta)
 	if errIsStatus(err, http.StatusConflict) {
-		err = ErrPoolExists
+		err = &sentinelError{ErrPoolExists, err}
 	}
 	return meta, err
 }
@@ -259,12 +260,29 @@ func (c *Connection) RemovePool(ctx context.Context, id ksuid.KSUID) error {
 	return nil
 }
 
+func (c *Connection) CreateExternal(ctx context.Context, payload api.ExternalPostRequest) (externals.Config, error) {
+	req := c.NewRequest(ctx, http.MethodPost, "/external", payload)
+	var config externals.Config
+	err := c.doAndUnmarshal(req, &config)
+	return config, err
+}
+
+func (c *Connection) RemoveExternal(ctx context.Context, name string) error {
+	req := c.NewRequest(ctx, http.MethodDelete, urlPath("external", name), nil)
+	res, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+	return nil
+}
+
 func (c *Connection) CreateBranch(ctx context.Context, poolID ksuid.KSUID, payload api.BranchPostRequest) (branches.Config, error) {
 	req := c.NewRequest(ctx, http.MethodPost, path.Join("/pool", poolID.String()), payload)
 	var branch branches.Config
 	err := c.doAndUnmarshal(req, &branch)
 	if errIsStatus(err, http.StatusConflict) {
-		err = ErrBranchExists
+		err = &sentinelError{ErrBranchExists, err}
 	}
 	return branch, err
 }
@@ -296,12 +314,25 @@ func (c *Connection) Revert(ctx context.Context, poolID ksuid.KSUID, branchName
 // As for Connection.Do, if the returned error is nil, the user is expected to
 // call Response.Body.Close.
 func (c *Connection) Query(ctx context.Context, src string, filenames ...string) (*Response, error) {
+	return c.query(ctx, "/query?ctrl=T", src, filenames)
+}
+
+// QueryInSession is like Query but runs the query in a session created by
+// CreateSession.
+func (c *Connection) QueryInSession(ctx context.Context, session ksuid.KSUID, src string, filenames ...string) (*Response, error) {
+	return c.query(ctx, "/query?ctrl=T&session="+session.String(), src, filenames)
+}
+
+func (c *Connection) query(ctx context.Context, target, src string, filenames []string) (*Response, error) {
 	files, err := srcfiles.Concat(filenames, src)
 	if err != nil {
 		return nil, err
 	}
-	body := api.QueryRequest{Query: string(files.Text)}
-	req := c.NewRequest(ctx, http.MethodPost, "/query?ctrl=T", body)
+	body := api.QueryRequest{
+		Query:  string(files.Text),
+		Labels: api.QueryLabelsFromContext(ctx),
+	}
+	req := c.NewRequest(ctx, http.MethodPost, target, body)
 	res, err := c.Do(req)
 	if ae := (*api.Error)(nil); errors.As(err, &ae) && len(ae.CompilationErrors) > 0 {
 		ae.CompilationErrors.Bind(files)
@@ -310,6 +341,115 @@ func (c *Connection) Query(ctx context.Context, src string, filenames ...string)
 	return res, err
 }
 
+// QueryStatusList returns the status of the queries running on the service
+// and of those that finished recently.
+func (c *Connection) QueryStatusList(ctx context.Context) ([]api.QueryStatus, error) {
+	req := c.NewRequest(ctx, http.MethodGet, "/query/status", nil)
+	var list []api.QueryStatus
+	err := c.doAndUnmarshal(req, &list)
+	return list, err
+}
+
+// CancelQuery cancels the query whose request ID is requestID.  It requires
+// the service's admin role.
+func (c *Connection) CancelQuery(ctx context.Context, requestID string) error {
+	req := c.NewRequest(ctx, http.MethodDelete, urlPath("query", "status", requestID), nil)
+	res, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+	return nil
+}
+
+func (c *Connection) CreateSession(ctx context.Context, payload api.SessionPostRequest) (api.Session, error) {
+	req := c.NewRequest(ctx, http.MethodPost, "/session", payload)
+	var session api.Session
+	err := c.doAndUnmarshal(req, &session)
+	return session, err
+}
+
+func (c *Connection) DeleteSession(ctx context.Context, id ksuid.KSUID) error {
+	req := c.NewRequest(ctx, http.MethodDelete, path.Join("/session", id.String()), nil)
+	res, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+	return nil
+}
+
+func (c *Connection) CreateSchedule(ctx context.Context, payload api.SchedulePostRequest) (api.Schedule, error) {
+	req := c.NewRequest(ctx, http.MethodPost, "/schedule", payload)
+	var schedule api.Schedule
+	err := c.doAndUnmarshal(req, &schedule)
+	return schedule, err
+}
+
+func (c *Connection) ScheduleList(ctx context.Context) ([]api.Schedule, error) {
+	req := c.NewRequest(ctx, http.MethodGet, "/schedule", nil)
+	var schedules []api.Schedule
+	err := c.doAndUnmarshal(req, &schedules)
+	return schedules, err
+}
+
+func (c *Connection) ScheduleGet(ctx context.Context, name string) (api.Schedule, error) {
+	req := c.NewRequest(ctx, http.MethodGet, urlPath("schedule", name), nil)
+	var schedule api.Schedule
+	err := c.doAndUnmarshal(req, &schedule)
+	return schedule, err
+}
+
+// RunSchedule runs the query of a schedule now rather than at its next
+// scheduled time.
+func (c *Connection) RunSchedule(ctx context.Context, name string) (api.ScheduleRun, error) {
+	req := c.NewRequest(ctx, http.MethodPost, urlPath("schedule", name, "run"), nil)
+	var run api.ScheduleRun
+	err := c.doAndUnmarshal(req, &run)
+	return run, err
+}
+
+func (c *Connection) DeleteSchedule(ctx context.Context, name string) error {
+	req := c.NewRequest(ctx, http.MethodDelete, urlPath("schedule", name), nil)
+	res, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+	return nil
+}
+
+func (c *Connection) CreateAlertRule(ctx context.Context, payload api.AlertPostRequest) (api.AlertRule, error) {
+	req := c.NewRequest(ctx, http.MethodPost, "/alert", payload)
+	var rule api.AlertRule
+	err := c.doAndUnmarshal(req, &rule)
+	return rule, err
+}
+
+func (c *Connection) AlertRuleList(ctx context.Context) ([]api.AlertRule, error) {
+	req := c.NewRequest(ctx, http.MethodGet, "/alert", nil)
+	var rules []api.AlertRule
+	err := c.doAndUnmarshal(req, &rules)
+	return rules, err
+}
+
+func (c *Connection) AlertRuleGet(ctx context.Context, name string) (api.AlertRule, error) {
+	req := c.NewRequest(ctx, http.MethodGet, urlPath("alert", name), nil)
+	var rule api.AlertRule
+	err := c.doAndUnmarshal(req, &rule)
+	return rule, err
+}
+
+func (c *Connection) DeleteAlertRule(ctx context.Context, name string) error {
+	req := c.NewRequest(ctx, http.MethodDelete, urlPath("alert", name), nil)
+	res, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+	return nil
+}
+
 func (c *Connection) Compact(ctx context.Context, poolID ksuid.KSUID, branchName string, objects []ksuid.KSUID, writeVectors bool, message api.CommitMessage) (api.CommitResponse, error) {
 	path := urlPath("pool", poolID.String(), "branch", branchName, "compact")
 	if writeVectors {
@@ -470,6 +610,45 @@ func (e *ErrorResponse) Error() string {
 	return fmt.Sprintf("status code %d: %v", e.StatusCode, e.Err)
 }
 
+// Code returns the code of the error in the response body or
+// api.ErrorCodeInternal if the body did not contain an api.Error.
+func (e *ErrorResponse) Code() api.ErrorCode {
+	var apierr *api.Error
+	if errors.As(e.Err, &apierr) && apierr.Code != "" {
+		return apierr.Code
+	}
+	return api.ErrorCodeInternal
+}
+
+// ErrorCode returns the code of err if it is or wraps an *ErrorResponse
+// or is a compilation error returned by Query and the empty string
+// otherwise, e.g., if the service could not be reached.
+func ErrorCode(err error) api.ErrorCode {
+	if list := (srcfiles.ErrorList)(nil); errors.As(err, &list) {
+		return api.ErrorCodeCompile
+	}
+	var errRes *ErrorResponse
+	if errors.As(err, &errRes) {
+		return errRes.Code()
+	}
+	return ""
+}
+
+// sentinelError is a sentinel error, e.g., ErrPoolNotFound, that also wraps
+// the *ErrorResponse from which it was derived.
+type sentinelError struct {
+	sentinel error
+	res      error
+}
+
+func (e *sentinelError) Error() string {
+	return e.sentinel.Error()
+}
+
+func (e *sentinelError) Unwrap() []error {
+	return []error{e.sentinel, e.res}
+}
+
 func urlPath(elem ...string) string {
 	var s string
 	for _, e := range elem {
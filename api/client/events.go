@@ -1,8 +1,10 @@
 package client
 
 import (
+	"bufio"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/brimdata/super/api"
 	"github.com/brimdata/super/sup"
@@ -10,6 +12,7 @@ import (
 
 type EventsClient struct {
 	rc          io.ReadCloser
+	r           *bufio.Reader
 	unmarshaler *sup.UnmarshalContext
 }
 
@@ -19,16 +22,24 @@ func newEventsClient(resp *Response) *EventsClient {
 		api.EventPool{},
 		api.EventBranch{},
 		api.EventBranchCommit{},
+		api.EventSchedule{},
+		api.EventAlert{},
 	)
 	return &EventsClient{
 		rc:          resp.Body,
+		r:           bufio.NewReader(resp.Body),
 		unmarshaler: unmarshaler,
 	}
 }
 
 func (l *EventsClient) Recv() (string, any, error) {
-	var kind, data string
-	_, err := fmt.Fscanf(l.rc, "event: %s\ndata: %s\n\n\n", &kind, &data)
+	// Read lines rather than scan fields since data may contain spaces
+	// (e.g., in the error of a schedule-failure event).
+	kind, err := l.readField("event")
+	if err != nil {
+		return "", nil, err
+	}
+	data, err := l.readField("data")
 	if err != nil {
 		return "", nil, err
 	}
@@ -39,6 +50,24 @@ func (l *EventsClient) Recv() (string, any, error) {
 	return kind, v, err
 }
 
+// readField reads the value of the named field, skipping the blank lines
+// that separate events.
+func (l *EventsClient) readField(name string) (string, error) {
+	var line string
+	for line == "" {
+		var err error
+		if line, err = l.r.ReadString('\n'); err != nil {
+			return "", err
+		}
+		line = strings.TrimSuffix(line, "\n")
+	}
+	value, ok := strings.CutPrefix(line, name+": ")
+	if !ok {
+		return "", fmt.Errorf("malformed event stream: expected %q field but found %q", name, line)
+	}
+	return value, nil
+}
+
 func (l *EventsClient) Close() error {
 	return l.rc.Close()
 }
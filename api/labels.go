@@ -0,0 +1,50 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// MaxQueryLabelLen is the maximum length of the value of a query label.
+const MaxQueryLabelLen = 256
+
+var queryLabelNameRE = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// CheckQueryLabels returns an error if the name of any of labels is not an
+// identifier or any value is longer than MaxQueryLabelLen.
+func CheckQueryLabels(labels map[string]string) error {
+	for name, value := range labels {
+		if !queryLabelNameRE.MatchString(name) {
+			return fmt.Errorf("query label name %q is not an identifier", name)
+		}
+		if len(value) > MaxQueryLabelLen {
+			return fmt.Errorf("value of query label %q is longer than %d bytes", name, MaxQueryLabelLen)
+		}
+	}
+	return nil
+}
+
+// ParseQueryLabel parses a query label of the form name=value.
+func ParseQueryLabel(s string) (string, string, error) {
+	name, value, ok := strings.Cut(s, "=")
+	if !ok {
+		return "", "", fmt.Errorf("query label %q must be of the form name=value", s)
+	}
+	return name, value, CheckQueryLabels(map[string]string{name: value})
+}
+
+type queryLabelsKey struct{}
+
+// ContextWithQueryLabels returns a copy of ctx carrying labels, which a
+// client attaches to the queries it sends with the copy.
+func ContextWithQueryLabels(ctx context.Context, labels map[string]string) context.Context {
+	return context.WithValue(ctx, queryLabelsKey{}, labels)
+}
+
+// QueryLabelsFromContext returns the query labels carried by ctx, if any.
+func QueryLabelsFromContext(ctx context.Context) map[string]string {
+	labels, _ := ctx.Value(queryLabelsKey{}).(map[string]string)
+	return labels
+}
@@ -17,6 +17,7 @@ const (
 	MediaTypeLine        = "application/x-line"
 	MediaTypeNDJSON      = "application/x-ndjson"
 	MediaTypeParquet     = "application/x-parquet"
+	MediaTypePCAP        = "application/vnd.tcpdump.pcap"
 	MediaTypeSUP         = "application/x-sup"
 	MediaTypeTSV         = "text/tab-separated-values"
 	MediaTypeZeek        = "application/x-zeek"
@@ -60,6 +61,8 @@ func MediaTypeToFormat(s string, dflt string) (string, error) {
 		return "ndjson", nil
 	case MediaTypeParquet:
 		return "parquet", nil
+	case MediaTypePCAP:
+		return "pcap", nil
 	case MediaTypeSUP:
 		return "sup", nil
 	case MediaTypeTSV:
@@ -90,6 +93,8 @@ func FormatToMediaType(format string) (string, error) {
 		return MediaTypeNDJSON, nil
 	case "parquet":
 		return MediaTypeParquet, nil
+	case "pcap":
+		return MediaTypePCAP, nil
 	case "sup":
 		return MediaTypeSUP, nil
 	case "tsv":
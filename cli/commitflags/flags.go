@@ -1,9 +1,11 @@
 package commitflags
 
 import (
+	"errors"
 	"flag"
 	"os"
 	"os/user"
+	"strings"
 
 	"github.com/brimdata/super/api"
 )
@@ -35,12 +37,18 @@ type Flags struct {
 	User    string
 	Message string
 	Meta    string
+	Labels  Labels
+	Key     string
+	Dedup   bool
 }
 
 func (c *Flags) SetFlags(f *flag.FlagSet) {
 	f.StringVar(&c.User, "user", username(), "user name for commit message")
 	f.StringVar(&c.Message, "message", "", "commit message")
 	f.StringVar(&c.Meta, "meta", "", "application metadata")
+	f.Var(&c.Labels, "label", "provenance label of the form key=value (may be repeated)")
+	f.StringVar(&c.Key, "key", "", "idempotency key for load (a load with the key of a previous load returns the previous commit)")
+	f.BoolVar(&c.Dedup, "dedup", false, "skip committing a load whose values were already loaded with -dedup")
 }
 
 func (c *Flags) CommitMessage() api.CommitMessage {
@@ -48,5 +56,31 @@ func (c *Flags) CommitMessage() api.CommitMessage {
 		Author: c.User,
 		Body:   c.Message,
 		Meta:   c.Meta,
+		Labels: c.Labels,
+		Key:    c.Key,
+		Dedup:  c.Dedup,
 	}
 }
+
+// Labels implements flag.Value for a repeatable key=value flag.
+type Labels map[string]string
+
+func (l Labels) String() string {
+	var pairs []string
+	for k, v := range l {
+		pairs = append(pairs, k+"="+v)
+	}
+	return strings.Join(pairs, ",")
+}
+
+func (l *Labels) Set(s string) error {
+	key, val, ok := strings.Cut(s, "=")
+	if !ok || key == "" {
+		return errors.New("must be of the form key=value")
+	}
+	if *l == nil {
+		*l = make(Labels)
+	}
+	(*l)[key] = val
+	return nil
+}
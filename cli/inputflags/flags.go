@@ -27,7 +27,7 @@ func (f *Flags) Options() anyio.ReaderOpts {
 }
 
 func (f *Flags) SetFlags(fs *flag.FlagSet, validate bool) {
-	fs.StringVar(&f.Format, "i", "auto", "format of input data [auto,arrows,bsup,csup,csv,json,line,parquet,sup,tsv,zeek,zjson]")
+	fs.StringVar(&f.Format, "i", "auto", "format of input data [auto,arrows,bsup,csup,csv,json,line,parquet,pcap,sup,tsv,zeek,zjson]")
 	f.CSV.Delim = ','
 	fs.Func("csv.delim", `CSV field delimiter (default ",")`, func(s string) error {
 		if len(s) != 1 {
@@ -37,6 +37,8 @@ func (f *Flags) SetFlags(fs *flag.FlagSet, validate bool) {
 		return nil
 
 	})
+	fs.StringVar(&f.PCAP.Filter, "pcap.filter", "", "BPF-style filter selecting the packets read from pcap input, e.g., \"tcp port 80\"")
+	fs.StringVar(&f.Preset, "preset", "", "shape the values of well-known logs read [suricata,zeek]")
 	fs.IntVar(&f.BSUP.Threads, "bsup.threads", 0, "number of Super Binary read threads (0=GOMAXPROCS)")
 	fs.BoolVar(&f.BSUP.Validate, "bsup.validate", validate, "validate format when reading Super Binary")
 	f.ReadMax = auto.NewBytes(bsupio.MaxSize)
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"strconv"
 
 	"github.com/brimdata/super/cli/auto"
 	"github.com/brimdata/super/pkg/storage"
@@ -22,7 +23,7 @@ import (
 type Flags struct {
 	anyio.WriterOpts
 	DefaultFormat string
-	color         bool
+	color         colorFlag
 	forceBinary   bool
 	jsonPretty    bool
 	jsonShortcut  bool
@@ -45,12 +46,15 @@ func (f *Flags) setFlags(fs *flag.FlagSet) {
 	fs.BoolVar(&f.BSUP.Compress, "bsup.compress", true, "compress Super Binary frames")
 	fs.IntVar(&f.BSUP.FrameThresh, "bsup.framethresh", bsupio.DefaultFrameThresh,
 		"minimum Super Binary frame size in uncompressed bytes")
-	fs.BoolVar(&f.color, "color", true, "enable/disable color formatting for -S and lake text output")
+	f.color = colorAuto
+	fs.Var(&f.color, "color", "enable/disable color formatting for SUP, table, and lake text output on a terminal (\"always\" to enable for any output)")
 	fs.StringVar(&f.supPersist, "persist", "",
 		"regular expression to persist type definitions across the stream")
 	fs.IntVar(&f.pretty, "pretty", 4,
 		"tab size to pretty print JSON and Super JSON output (0 for newline-delimited output")
 	fs.StringVar(&f.outputFile, "o", "", "write data to output file")
+	fs.BoolVar(&f.Table.Align, "table.align", false, "right-align columns of numbers in table output")
+	fs.IntVar(&f.Table.MaxWidth, "table.maxwidth", 0, "truncate table output values wider than this (0 for no limit)")
 	fs.StringVar(&f.split, "split", "",
 		"split output into one file per data type in this directory (but see -splitsize)")
 	fs.Var(&f.splitSize, "splitsize",
@@ -139,7 +143,7 @@ func (f *Flags) Open(ctx context.Context, engine storage.Engine) (zio.WriteClose
 		}
 		return d, nil
 	}
-	if f.outputFile == "" && f.color && terminal.IsTerminalFile(os.Stdout) {
+	if f.color == colorAlways || f.color == colorAuto && f.outputFile == "" && terminal.IsTerminalFile(os.Stdout) {
 		color.Enabled = true
 	}
 	w, err := emitter.NewFileFromPath(ctx, engine, f.outputFile, f.unbuffered, f.WriterOpts)
@@ -148,3 +152,37 @@ func (f *Flags) Open(ctx context.Context, engine storage.Engine) (zio.WriteClose
 	}
 	return w, nil
 }
+
+// colorFlag is a boolean flag that also accepts "always" to enable color
+// formatting when output is not a terminal, e.g., when piping to a pager.
+type colorFlag string
+
+const (
+	colorAlways colorFlag = "always"
+	colorAuto   colorFlag = "true"
+	colorNever  colorFlag = "false"
+)
+
+func (c colorFlag) String() string {
+	return string(c)
+}
+
+func (c *colorFlag) Set(s string) error {
+	if s == string(colorAlways) {
+		*c = colorAlways
+		return nil
+	}
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return errors.New(`must be true, false, or "always"`)
+	}
+	*c = colorNever
+	if b {
+		*c = colorAuto
+	}
+	return nil
+}
+
+func (c *colorFlag) IsBoolFlag() bool {
+	return true
+}
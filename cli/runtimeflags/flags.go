@@ -3,11 +3,21 @@ package runtimeflags
 import (
 	"errors"
 	"flag"
+	"fmt"
 
 	"github.com/brimdata/super/cli/auto"
+	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/sam/expr"
 	"github.com/brimdata/super/runtime/sam/expr/agg"
+	"github.com/brimdata/super/runtime/sam/expr/function"
+	"github.com/brimdata/super/runtime/sam/op/aggregate"
+	"github.com/brimdata/super/runtime/sam/op/distinct"
 	"github.com/brimdata/super/runtime/sam/op/fuse"
+	"github.com/brimdata/super/runtime/sam/op/join"
 	"github.com/brimdata/super/runtime/sam/op/sort"
+	"github.com/brimdata/super/runtime/sam/op/spill"
+	"github.com/brimdata/super/zbuf"
 	"github.com/pbnjay/memory"
 )
 
@@ -30,19 +40,53 @@ func defaultMemMaxBytes() uint64 {
 
 type Flags struct {
 	// these memory limits should be based on a shared resource model
-	aggMemMax  auto.Bytes
-	sortMemMax auto.Bytes
-	fuseMemMax auto.Bytes
+	aggMemMax      auto.Bytes
+	aggQueryMemMax auto.Bytes
+	aggProcMemMax  auto.Bytes
+	aggThreads     int
+	combinerGroups int
+	sortMemMax     auto.Bytes
+	fuseMemMax     auto.Bytes
+	distinctMemMax auto.Bytes
+	joinMemMax     auto.Bytes
+	spillEncrypt   bool
+	spillFormat    string
+	spillCompress  string
+	spillFanIn     int
+	nulls          string
+	missing        string
+	nan            string
+	geoipDBs       []string
 }
 
 func (f *Flags) SetFlags(fs *flag.FlagSet) {
 	f.aggMemMax = auto.NewBytes(uint64(agg.MaxValueSize))
 	fs.Var(&f.aggMemMax, "aggmem", "maximum memory used per aggregate function value in MiB, MB, etc")
 	def := defaultMemMaxBytes()
+	f.aggQueryMemMax = auto.NewBytes(def)
+	fs.Var(&f.aggQueryMemMax, "aggquerymem", "maximum memory used by the aggregations of each query in MiB, MB, etc")
+	fs.Var(&f.aggProcMemMax, "aggprocmem", "maximum memory used by the aggregations of all queries in MiB, MB, etc (0 for no limit)")
+	fs.IntVar(&f.aggThreads, "aggthreads", 1, "number of goroutines among which each grouped aggregation over unsorted input is partitioned")
+	fs.IntVar(&f.combinerGroups, "combinergroups", aggregate.CombinerMaxGroups, "maximum number of groups held by each pre-aggregation of a parallel path")
 	f.sortMemMax = auto.NewBytes(def)
 	fs.Var(&f.sortMemMax, "sortmem", "maximum memory used by sort in MiB, MB, etc")
 	f.fuseMemMax = auto.NewBytes(def)
 	fs.Var(&f.fuseMemMax, "fusemem", "maximum memory used by fuse in MiB, MB, etc")
+	f.distinctMemMax = auto.NewBytes(def)
+	fs.Var(&f.distinctMemMax, "distinctmem", "maximum memory used by distinct in MiB, MB, etc")
+	f.joinMemMax = auto.NewBytes(def)
+	fs.Var(&f.joinMemMax, "joinmem", "maximum memory used by the hash table of join in MiB, MB, etc")
+	fs.StringVar(&f.nulls, "nulls", "last", "position of nulls in a sort that does not specify it [first,last]")
+	fs.StringVar(&f.missing, "missing", "", "treat missing as null or as distinct from null when sorting, grouping, and joining [null,distinct] (default sorts missing as null but groups and joins it as distinct)")
+	fs.StringVar(&f.nan, "nan", "low", "whether NaN sorts below or above all other numbers [low,high]")
+	fs.BoolVar(&f.spillEncrypt, "spillencrypt", false, "encrypt values spilled to temporary files with an ephemeral key")
+	fs.StringVar(&f.spillFormat, "spillformat", "bsup", "format of the temporary files of sort, aggregate, distinct, and join spills [bsup,csup]")
+	fs.Func("geoipdb", "path of a MaxMind DB database for the geoip function (may be repeated)", func(s string) error {
+		f.geoipDBs = append(f.geoipDBs, s)
+		return nil
+	})
+	fs.IntVar(&f.spillFanIn, "spillfanin", spill.MaxFanIn, "maximum number of spilled runs of sort, aggregate, and distinct merged at once")
+	fs.StringVar(&f.spillCompress, "spillcompress", "none", "compression of the temporary files of bsup spills [none,lz4,zstd]")
 }
 
 func (f *Flags) Init() error {
@@ -50,6 +94,19 @@ func (f *Flags) Init() error {
 		return errors.New("aggmem value must be greater than zero")
 	}
 	agg.MaxValueSize = int(f.aggMemMax.Bytes)
+	if f.aggQueryMemMax.Bytes <= 0 {
+		return errors.New("aggquerymem value must be greater than zero")
+	}
+	runtime.QueryMemMaxBytes = int(f.aggQueryMemMax.Bytes)
+	runtime.ProcessMemory.SetLimit(int64(f.aggProcMemMax.Bytes))
+	if f.aggThreads <= 0 {
+		return errors.New("aggthreads value must be greater than zero")
+	}
+	aggregate.Concurrency = f.aggThreads
+	if f.combinerGroups <= 0 {
+		return errors.New("combinergroups value must be greater than zero")
+	}
+	aggregate.CombinerMaxGroups = f.combinerGroups
 	if f.sortMemMax.Bytes <= 0 {
 		return errors.New("sortmem value must be greater than zero")
 	}
@@ -58,5 +115,50 @@ func (f *Flags) Init() error {
 		return errors.New("fusemem value must be greater than zero")
 	}
 	fuse.MemMaxBytes = int(f.fuseMemMax.Bytes)
+	if f.distinctMemMax.Bytes <= 0 {
+		return errors.New("distinctmem value must be greater than zero")
+	}
+	distinct.MemMaxBytes = int(f.distinctMemMax.Bytes)
+	if f.joinMemMax.Bytes <= 0 {
+		return errors.New("joinmem value must be greater than zero")
+	}
+	join.MemMaxBytes = int(f.joinMemMax.Bytes)
+	spill.Encrypt = f.spillEncrypt
+	spillOpts := zbuf.SpillOptions{Format: f.spillFormat, Compression: f.spillCompress}
+	if err := spillOpts.Validate(); err != nil {
+		return err
+	}
+	if spillOpts.Format == "csup" && f.spillEncrypt {
+		return errors.New("spillencrypt is not supported by the csup spill format")
+	}
+	runtime.DefaultSpillOptions = spillOpts
+	if f.spillFanIn < 2 {
+		return errors.New("spillfanin value must be at least two")
+	}
+	spill.MaxFanIn = f.spillFanIn
+	if err := function.SetGeoIPDatabases(f.geoipDBs); err != nil {
+		return err
+	}
+	if err := order.DefaultNulls.UnmarshalText([]byte(f.nulls)); err != nil {
+		return err
+	}
+	switch f.missing {
+	case "":
+		expr.QueryCollation.Missing = expr.MissingDefault
+	case "null":
+		expr.QueryCollation.Missing = expr.MissingNull
+	case "distinct":
+		expr.QueryCollation.Missing = expr.MissingDistinct
+	default:
+		return fmt.Errorf("missing value must be null or distinct: %q", f.missing)
+	}
+	switch f.nan {
+	case "low":
+		expr.QueryCollation.NaNsMax = false
+	case "high":
+		expr.QueryCollation.NaNsMax = true
+	default:
+		return fmt.Errorf("nan value must be low or high: %q", f.nan)
+	}
 	return nil
 }
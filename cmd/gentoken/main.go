@@ -5,6 +5,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/brimdata/super/pkg/charm"
@@ -28,6 +29,7 @@ type Command struct {
 	keyID          string
 	tenantID       string
 	userID         string
+	roles          string
 }
 
 func New(_ charm.Command, fs *flag.FlagSet) (charm.Command, error) {
@@ -39,6 +41,7 @@ func New(_ charm.Command, fs *flag.FlagSet) (charm.Command, error) {
 	fs.StringVar(&c.keyID, "keyid", "", "key identifier")
 	fs.StringVar(&c.tenantID, "tenantid", "", "tenant ID claim in generated token")
 	fs.StringVar(&c.userID, "userid", "", "user ID claim in generated token")
+	fs.StringVar(&c.roles, "roles", "", "comma-separated roles claim in generated token")
 	return c, nil
 }
 
@@ -49,8 +52,12 @@ func (c *Command) Run(args []string) error {
 	if c.privateKeyFile == "" {
 		return errors.New("must specify a keyfile")
 	}
+	var roles []string
+	if c.roles != "" {
+		roles = strings.Split(c.roles, ",")
+	}
 	token, err := auth.GenerateAccessToken(
-		c.keyID, c.privateKeyFile, c.expiration, c.audience, c.domain, auth.TenantID(c.tenantID), auth.UserID(c.userID))
+		c.keyID, c.privateKeyFile, c.expiration, c.audience, c.domain, auth.TenantID(c.tenantID), auth.UserID(c.userID), roles)
 	if err != nil {
 		return fmt.Errorf("GenerateAccessToken failed: %w", err)
 	}
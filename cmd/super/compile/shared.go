@@ -12,7 +12,9 @@ import (
 	"github.com/brimdata/super/cli/queryflags"
 	"github.com/brimdata/super/compiler"
 	"github.com/brimdata/super/compiler/describe"
+	"github.com/brimdata/super/compiler/srcfiles"
 	"github.com/brimdata/super/lake"
+	lakeapi "github.com/brimdata/super/lake/api"
 	"github.com/brimdata/super/pkg/storage"
 	"github.com/brimdata/super/runtime"
 	"github.com/brimdata/super/runtime/exec"
@@ -24,6 +26,7 @@ import (
 
 type Shared struct {
 	dag         bool
+	errors      bool
 	includes    queryflags.Includes
 	optimize    bool
 	parallel    int
@@ -34,6 +37,7 @@ type Shared struct {
 
 func (s *Shared) SetFlags(fs *flag.FlagSet) {
 	fs.BoolVar(&s.dag, "dag", false, "display output as DAG (implied by -O or -P)")
+	fs.BoolVar(&s.errors, "errors", false, "display compilation errors as values instead of compiling")
 	fs.Var(&s.includes, "I", "source file containing query text (may be repeated)")
 	fs.BoolVar(&s.optimize, "O", false, "display optimized DAG")
 	fs.IntVar(&s.parallel, "P", 0, "display parallelized DAG")
@@ -49,9 +53,11 @@ func (s *Shared) Run(ctx context.Context, args []string, lakeFlags *lakeflags.Fl
 	if len(args) > 1 {
 		return errors.New("too many arguments")
 	}
+	var lakeAPI lakeapi.Interface
 	var lk *lake.Root
 	if lakeFlags != nil {
-		lakeAPI, err := lakeFlags.Open(ctx)
+		var err error
+		lakeAPI, err = lakeFlags.Open(ctx)
 		if err != nil {
 			return err
 		}
@@ -61,6 +67,9 @@ func (s *Shared) Run(ctx context.Context, args []string, lakeFlags *lakeflags.Fl
 	if len(args) == 1 {
 		query = args[0]
 	}
+	if s.errors {
+		return s.check(ctx, query, lakeAPI, extInput)
+	}
 	ast, err := compiler.Parse(query, s.includes...)
 	if err != nil {
 		return err
@@ -104,6 +113,31 @@ func (s *Shared) Run(ctx context.Context, args []string, lakeFlags *lakeflags.Fl
 	return s.writeValue(ctx, dag)
 }
 
+// check compiles query and writes the resulting compilation errors, if any,
+// as a list of values.  Nothing is written if there are no errors.  If lakeAPI is not nil, field references are also
+// checked against a sample of the values of the pool read by the query.
+func (s *Shared) check(ctx context.Context, query string, lakeAPI lakeapi.Interface, extInput bool) error {
+	var lk *lake.Root
+	if lakeAPI != nil {
+		lk = lakeAPI.Root()
+	}
+	ast, err := compiler.Parse(query, s.includes...)
+	if err == nil {
+		_, err = compiler.Analyze(runtime.DefaultContext(), ast, exec.NewEnvironment(nil, lk), extInput)
+	}
+	if err == nil && lakeAPI != nil {
+		err = lakeapi.CheckFields(ctx, lakeAPI, ast)
+	}
+	if err == nil {
+		return nil
+	}
+	var list srcfiles.ErrorList
+	if !errors.As(err, &list) {
+		return err
+	}
+	return s.writeValue(ctx, list)
+}
+
 func (s *Shared) writeValue(ctx context.Context, v any) error {
 	val, err := sup.MarshalBSUP(v)
 	if err != nil {
@@ -4,10 +4,12 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"strings"
 
 	"github.com/brimdata/super/cli/poolflags"
 	"github.com/brimdata/super/cmd/super/db"
 	"github.com/brimdata/super/lake/data"
+	"github.com/brimdata/super/lake/pools"
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/pkg/charm"
 	"github.com/brimdata/super/pkg/units"
@@ -30,6 +32,37 @@ range scans with respect to the pool key using the
 "range" parameter to the Zed "from" operator as the data is laid out
 naturally for such scans.
 
+Query defaults may be declared for the pool with the -time, -sort, and
+-alias flags.  The -time flag names the field to which a time range in a
+"from" operator applies, e.g., "from logs range 2024-01-01T00:00:00Z to
+2024-01-02T00:00:00Z".  The -sort flag gives the order, as key[:asc|:desc],
+in which a query that only filters the values of the pool displays them.
+Each -alias flag, of the form name=field, declares an alternative name by
+which queries of the pool may refer to a field.
+
+The -transform flag gives a query, e.g., "rename host:=hostname | drop debug",
+that is applied to the values of each load into the pool before they are
+written.  A load may override it with its own -transform flag.
+
+Each -mask flag, of the form field:method[:role,role,...], declares a policy
+that hides the values of a field from queries of the pool by requesters
+holding none of the listed roles.  The method "hash" replaces each value with
+the SHA-256 digest of its string form, "partial" replaces all but its last
+four characters with "****", and "null" replaces it with null.  Masks are
+applied as the pool is scanned, so no query, e.g., "cut *", can see the
+values they hide.  Roles are taken from the access token of a request to a
+lake service, so queries of a local lake see every masked field masked.
+
+The -partition flag, of the form field[:bin], places the values of the pool
+in data objects by partition, e.g., "tenant" places the values of each
+tenant in their own objects and "ts:1d" places the values of each day,
+i.e., of each value of "bucket(ts, 1d)", in their own objects.  Each data
+object records its partition so that queries filtering on the partition
+field, e.g., "tenant=='acme'" or "ts >= 2024-01-01T00:00:00Z", or on the
+partition expression, e.g., "bucket(ts, 1d) == 2024-01-01T00:00:00Z", skip
+the objects of partitions that cannot match, regardless of the pool key.
+The partition of a pool cannot be changed.
+
 By default, a branch called "main" is initialized in the newly created pool.
 `,
 	HiddenFlags: "seekstride",
@@ -42,6 +75,63 @@ type Command struct {
 	thresh     units.Bytes
 	seekStride units.Bytes
 	use        bool
+	summaries  summaries
+	defaults   pools.Defaults
+	transform  string
+	masks      masks
+	partition  string
+}
+
+type summaries []string
+
+func (s summaries) String() string {
+	return strings.Join(s, ";")
+}
+
+func (s *summaries) Set(text string) error {
+	*s = append(*s, text)
+	return nil
+}
+
+type masks []pools.Mask
+
+func (m masks) String() string {
+	var s []string
+	for _, mask := range m {
+		s = append(s, mask.Field+":"+mask.Method+":"+strings.Join(mask.Roles, ","))
+	}
+	return strings.Join(s, ";")
+}
+
+func (m *masks) Set(s string) error {
+	mask, err := pools.ParseMask(s)
+	if err != nil {
+		return err
+	}
+	*m = append(*m, mask)
+	return nil
+}
+
+type aliases map[string]string
+
+func (a aliases) String() string {
+	var pairs []string
+	for name, path := range a {
+		pairs = append(pairs, name+"="+path)
+	}
+	return strings.Join(pairs, ",")
+}
+
+func (a *aliases) Set(s string) error {
+	name, path, ok := strings.Cut(s, "=")
+	if !ok || name == "" || path == "" {
+		return errors.New("must be of the form name=field")
+	}
+	if *a == nil {
+		*a = make(aliases)
+	}
+	(*a)[name] = path
+	return nil
 }
 
 func init() {
@@ -57,6 +147,13 @@ func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 	c.thresh = data.DefaultThreshold
 	f.Var(&c.thresh, "S", "target size of pool data objects, as '10MB' or '4GiB', etc.")
 	f.BoolVar(&c.use, "use", false, "set created pool as the current pool")
+	f.Var(&c.summaries, "summary", "aggregation maintained by compaction for each data object, e.g., 'count() by key' (may be repeated)")
+	f.StringVar(&c.defaults.Time, "time", "", "default time field for time ranges in queries of pool")
+	f.StringVar(&c.defaults.Order, "sort", "", "default sort key with optional :asc or :desc suffix for display of pool values")
+	f.StringVar(&c.transform, "transform", "", "query applied to values loaded into pool before they are written")
+	f.Var(&c.masks, "mask", "policy masking a field in queries of pool, as field:hash|partial|null[:role,...] (may be repeated)")
+	f.StringVar(&c.partition, "partition", "", "field with optional :bin suffix, as 'tenant' or 'ts:1d', by which to place values in data objects (cannot be changed)")
+	f.Var((*aliases)(&c.defaults.Aliases), "alias", "alternative name for a field in queries of pool, as name=field (may be repeated)")
 	f.StringVar(&c.sortKey, "orderby", "ts:desc", "pool key with optional :asc or :desc suffix to organize data in pool (cannot be changed)")
 	return c, nil
 }
@@ -78,8 +175,12 @@ func (c *Command) Run(args []string) error {
 	if err != nil {
 		return err
 	}
+	partition, err := pools.ParsePartition(c.partition)
+	if err != nil {
+		return err
+	}
 	poolName := args[0]
-	id, err := lake.CreatePool(ctx, poolName, sortKey, int(c.seekStride), int64(c.thresh))
+	id, err := lake.CreatePool(ctx, poolName, sortKey, int(c.seekStride), int64(c.thresh), c.summaries, c.defaults, c.transform, c.masks, partition)
 	if err != nil {
 		return err
 	}
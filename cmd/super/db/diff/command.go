@@ -0,0 +1,113 @@
+package diff
+
+import (
+	"errors"
+	"flag"
+	"strings"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/cli/outputflags"
+	"github.com/brimdata/super/cli/poolflags"
+	"github.com/brimdata/super/cmd/super/db"
+	lakeapi "github.com/brimdata/super/lake/api"
+	"github.com/brimdata/super/lakeparse"
+	"github.com/brimdata/super/pkg/charm"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/brimdata/super/sup"
+)
+
+var spec = &charm.Spec{
+	Name:  "diff",
+	Usage: "diff [options] from [to]",
+	Short: "compare the shapes of the data at two commits",
+	Long: `
+The diff command compares the types of the values present at two commits
+and outputs a record for each field whose type differs, indicating whether
+the field was added, removed, widened (e.g., from int32 to int64), or
+otherwise changed.
+
+Each of from and to is a branch name or commit ID of the pool specified
+with -use or a reference of the form pool@branch or pool@commit.
+When to is omitted, from is compared with the commit specified with -use.
+`,
+	New: New,
+}
+
+func init() {
+	db.Spec.Add(spec)
+}
+
+type Command struct {
+	*db.Command
+	outputFlags outputflags.Flags
+	poolFlags   poolflags.Flags
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &Command{Command: parent.(*db.Command)}
+	c.outputFlags.SetFlags(f)
+	c.poolFlags.SetFlags(f)
+	return c, nil
+}
+
+func (c *Command) Run(args []string) error {
+	ctx, cleanup, err := c.Init(&c.outputFlags)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if len(args) == 0 || len(args) > 2 {
+		return errors.New("one or two commits must be specified")
+	}
+	head, err := c.poolFlags.HEAD()
+	if err != nil {
+		return err
+	}
+	from, err := parseCommitish(head, args[0])
+	if err != nil {
+		return err
+	}
+	to := head
+	if len(args) == 2 {
+		if to, err = parseCommitish(head, args[1]); err != nil {
+			return err
+		}
+	}
+	lake, err := c.LakeFlags.Open(ctx)
+	if err != nil {
+		return err
+	}
+	sctx := super.NewContext()
+	fromTypes, err := lakeapi.Shapes(ctx, lake, sctx, from)
+	if err != nil {
+		return err
+	}
+	toTypes, err := lakeapi.Shapes(ctx, lake, sctx, to)
+	if err != nil {
+		return err
+	}
+	w, err := c.outputFlags.Open(ctx, storage.NewLocalEngine())
+	if err != nil {
+		return err
+	}
+	m := sup.NewBSUPMarshalerWithContext(sctx)
+	for _, change := range lakeapi.DiffShapes(sctx, fromTypes, toTypes) {
+		val, err := m.Marshal(change)
+		if err != nil {
+			w.Close()
+			return err
+		}
+		if err := w.Write(val); err != nil {
+			w.Close()
+			return err
+		}
+	}
+	return w.Close()
+}
+
+func parseCommitish(head *lakeparse.Commitish, s string) (*lakeparse.Commitish, error) {
+	if !strings.Contains(s, "@") {
+		return &lakeparse.Commitish{Pool: head.Pool, Branch: s}, nil
+	}
+	return lakeparse.ParseCommitish(s)
+}
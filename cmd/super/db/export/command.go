@@ -0,0 +1,283 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/cli/auto"
+	"github.com/brimdata/super/cli/poolflags"
+	"github.com/brimdata/super/cmd/super/db"
+	"github.com/brimdata/super/compiler"
+	"github.com/brimdata/super/compiler/ast"
+	"github.com/brimdata/super/compiler/parser"
+	lakeapi "github.com/brimdata/super/lake/api"
+	"github.com/brimdata/super/lakeparse"
+	"github.com/brimdata/super/pkg/charm"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/zbuf"
+	"github.com/brimdata/super/zio"
+	"github.com/brimdata/super/zio/anyio"
+	"github.com/brimdata/super/zio/bsupio"
+	"github.com/segmentio/ksuid"
+)
+
+var spec = &charm.Spec{
+	Name:  "export",
+	Usage: "export [options] -o dir [query]",
+	Short: "export data to a partitioned directory tree",
+	Long: `
+The export command writes the data of the commit specified with -use, or the
+results of query if given, to files in the directory (or S3 prefix) given by
+-o for handoff to other tools.
+
+The -by flag gives an expression whose value for each input value selects
+the partition, i.e., the subdirectory, to which the value is written.
+If the value is a record, each of its fields names a level of the directory
+tree in the Hive style, e.g., -by '{date:strftime("%Y-%m-%d",ts)}' writes
+to directories like date=2024-01-02.  Otherwise, the value itself names the
+subdirectory.  A partition whose value is null, missing, or an error is named
+__HIVE_DEFAULT_PARTITION__.
+
+Each partition holds files named part-NNNNN with the extension of the
+format given by -f.  When the values written to a file exceed the size
+given by -target, the file is closed and the next values of its partition
+are written to a new file.
+
+Once all files are written, a manifest, manifest.json, listing the files and
+their partitions, record counts, and sizes is written to the directory.
+If the export fails, the files it wrote are removed.
+
+Exporting a pool to a directory holding the manifest of an earlier export
+of the same pool and branch is incremental: only the data committed since
+that export is written, to new files that are added to the manifest.
+Commits that rewrite existing data, i.e., compactions and deletes, are not
+exported.  Incremental exports require a local lake.
+`,
+	New: New,
+}
+
+func init() {
+	db.Spec.Add(spec)
+}
+
+type Command struct {
+	*db.Command
+	by        string
+	dir       string
+	format    string
+	target    auto.Bytes
+	poolFlags poolflags.Flags
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &Command{Command: parent.(*db.Command)}
+	f.StringVar(&c.by, "by", "", "expression whose value selects the partition of each value")
+	f.StringVar(&c.dir, "o", "", "directory or S3 prefix to write")
+	f.StringVar(&c.format, "f", "parquet", "format of the files written")
+	c.target = auto.NewBytes(DefaultTarget)
+	f.Var(&c.target, "target", "size of the values written to a file before a new one is started in MiB, MB, etc")
+	c.poolFlags.SetFlags(f)
+	return c, nil
+}
+
+func (c *Command) Run(args []string) error {
+	ctx, cleanup, err := c.Init()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if len(args) > 1 {
+		return charm.NeedHelp
+	}
+	if c.dir == "" {
+		return errors.New("output directory must be specified with -o")
+	}
+	if _, err := anyio.NewWriter(zio.NopCloser(io.Discard), anyio.WriterOpts{Format: c.format}); err != nil {
+		return err
+	}
+	partitioner, err := parsePartitioner(c.by)
+	if err != nil {
+		return err
+	}
+	dir, err := storage.ParseURI(c.dir)
+	if err != nil {
+		return err
+	}
+	engine := storage.NewLocalEngine()
+	prev, err := readManifest(ctx, engine, dir)
+	if err != nil {
+		return err
+	}
+	lake, err := c.LakeFlags.Open(ctx)
+	if err != nil {
+		return err
+	}
+	m := &manifest{By: c.by, Format: c.format}
+	var puller zbuf.Puller
+	if len(args) == 1 {
+		if prev != nil {
+			return fmt.Errorf("%s: directory holds an earlier export", c.dir)
+		}
+		m.Query = args[0]
+		q, err := lake.Query(ctx, args[0]+"\n| "+partitioner)
+		if err != nil {
+			return err
+		}
+		defer q.Pull(true)
+		puller = q
+	} else {
+		head, err := c.poolFlags.HEAD()
+		if err != nil {
+			return err
+		}
+		commit, err := tip(ctx, lake, head)
+		if err != nil {
+			return err
+		}
+		m.Pool, m.Branch, m.Commit = head.Pool, head.Branch, commit.String()
+		var since ksuid.KSUID
+		if prev != nil {
+			if prev.Pool != m.Pool || prev.Branch != m.Branch || prev.By != m.By || prev.Format != m.Format {
+				return fmt.Errorf("%s: directory holds an export of different data", c.dir)
+			}
+			if prev.Commit == m.Commit {
+				// Nothing has been committed since the last export.
+				return nil
+			}
+			if since, err = lakeparse.ParseID(prev.Commit); err != nil {
+				return err
+			}
+			m.Files = prev.Files
+		}
+		switch {
+		case commit == ksuid.Nil:
+			// The branch is empty.
+		case since == ksuid.Nil:
+			src, err := (&lakeparse.Commitish{Pool: head.Pool, Branch: commit.String()}).FromSpec("")
+			if err != nil {
+				return err
+			}
+			q, err := lake.Query(ctx, src+"\n| "+partitioner)
+			if err != nil {
+				return err
+			}
+			defer q.Pull(true)
+			puller = q
+		default:
+			q, err := openAdded(ctx, lake, head.Pool, since, commit, partitioner)
+			if err != nil {
+				return err
+			}
+			defer q.Close()
+			puller = q
+		}
+	}
+	e := newExporter(ctx, engine, dir, c.format, int64(c.target.Bytes), c.by != "", len(m.Files))
+	if puller != nil {
+		if err := e.export(puller); err != nil {
+			e.abort()
+			return err
+		}
+	}
+	if err := e.close(); err != nil {
+		e.abort()
+		return err
+	}
+	m.Files = append(m.Files, e.files...)
+	if err := writeManifest(ctx, engine, dir, m); err != nil {
+		e.abort()
+		return err
+	}
+	return nil
+}
+
+// parsePartitioner returns the query that turns each value into a record
+// whose field p is the value of by, if any, and field v is the value.
+func parsePartitioner(by string) (string, error) {
+	if by == "" {
+		return "values {v:this}", nil
+	}
+	// Make sure by is an expression before wrapping it in the query.
+	values, err := parser.ParseQuery("values " + by)
+	if err != nil {
+		return "", err
+	}
+	if seq := values.Parsed(); len(seq) != 1 {
+		return "", fmt.Errorf("partition must be an expression: %s", by)
+	} else if v, ok := seq[0].(*ast.Values); !ok || len(v.Exprs) != 1 {
+		return "", fmt.Errorf("partition must be an expression: %s", by)
+	}
+	return fmt.Sprintf("values {p:(%s),v:this}", by), nil
+}
+
+// tip returns the commit of head, which names a branch or a commit.
+func tip(ctx context.Context, lake lakeapi.Interface, head *lakeparse.Commitish) (ksuid.KSUID, error) {
+	if id, err := lakeparse.ParseID(head.Branch); err == nil {
+		return id, nil
+	}
+	poolID, err := lake.PoolID(ctx, head.Pool)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	return lake.CommitObject(ctx, poolID, head.Branch)
+}
+
+// openAdded returns the partitioned values of the data objects added to
+// pool after since in the history ending at commit.
+func openAdded(ctx context.Context, lake lakeapi.Interface, poolName string, since, commit ksuid.KSUID, partitioner string) (runtime.Query, error) {
+	root := lake.Root()
+	if root == nil {
+		return nil, errors.New("incremental export requires a local lake")
+	}
+	poolID, err := lake.PoolID(ctx, poolName)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := root.OpenPool(ctx, poolID)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := pool.AddedObjects(ctx, since, commit)
+	if err != nil {
+		return nil, err
+	}
+	sctx := super.NewContext()
+	var readers []zio.Reader
+	for _, o := range objects {
+		rc, err := o.NewReader(ctx, pool.Storage(), pool.DataPath, nil)
+		if err != nil {
+			return nil, err
+		}
+		readers = append(readers, &closingReader{bsupio.NewReader(sctx, rc), rc})
+	}
+	ast, err := parser.ParseQuery(partitioner)
+	if err != nil {
+		return nil, err
+	}
+	// The query reads the new data rather than the lake, and the remote
+	// engine keeps it from reading the local file system.
+	comp := compiler.NewCompiler(storage.NewRemoteEngine())
+	return runtime.CompileQuery(ctx, sctx, comp, ast, []zio.Reader{zio.ConcatReader(readers...)})
+}
+
+// closingReader closes the data object of its reader once the reader is
+// done.
+type closingReader struct {
+	*bsupio.Reader
+	closer io.Closer
+}
+
+func (c *closingReader) Read() (*super.Value, error) {
+	val, err := c.Reader.Read()
+	if (val == nil || err != nil) && c.closer != nil {
+		c.Reader.Close()
+		c.closer.Close()
+		c.closer = nil
+	}
+	return val, err
+}
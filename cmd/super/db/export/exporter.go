@@ -0,0 +1,279 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/brimdata/super/sup"
+	"github.com/brimdata/super/zbuf"
+	"github.com/brimdata/super/zio"
+	"github.com/brimdata/super/zio/anyio"
+)
+
+// DefaultTarget is the default size of the values written to a file before
+// a new file is started.
+const DefaultTarget = 128 * 1024 * 1024
+
+// ManifestName is the name of the manifest in the directory of an export.
+const ManifestName = "manifest.json"
+
+// DefaultPartition names the partition of a null, missing, or error value
+// as Hive names that of a null.
+const DefaultPartition = "__HIVE_DEFAULT_PARTITION__"
+
+// manifest describes the files of an export.  Pool, Branch, and Commit
+// identify the data of a pool export, and Commit is the commit up to which
+// its data has been exported.  Query is the query of a query export.
+type manifest struct {
+	Pool   string          `json:"pool,omitempty"`
+	Branch string          `json:"branch,omitempty"`
+	Commit string          `json:"commit,omitempty"`
+	Query  string          `json:"query,omitempty"`
+	By     string          `json:"by,omitempty"`
+	Format string          `json:"format"`
+	Files  []*manifestFile `json:"files"`
+}
+
+type manifestFile struct {
+	Path      string `json:"path"`
+	Partition string `json:"partition"`
+	Records   int64  `json:"records"`
+	Bytes     int64  `json:"bytes"`
+}
+
+// readManifest returns the manifest in dir or nil if there is none.
+func readManifest(ctx context.Context, engine storage.Engine, dir *storage.URI) (*manifest, error) {
+	u := dir.JoinPath(ManifestName)
+	if ok, err := engine.Exists(ctx, u); err != nil || !ok {
+		return nil, err
+	}
+	b, err := storage.Get(ctx, engine, u)
+	if err != nil {
+		return nil, err
+	}
+	var m manifest
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("%s: %w", u, err)
+	}
+	return &m, nil
+}
+
+func writeManifest(ctx context.Context, engine storage.Engine, dir *storage.URI, m *manifest) error {
+	if m.Files == nil {
+		m.Files = []*manifestFile{}
+	}
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return storage.Put(ctx, engine, dir.JoinPath(ManifestName), bytes.NewReader(append(b, '\n')))
+}
+
+// exporter writes values to the files of their partitions.
+type exporter struct {
+	ctx    context.Context
+	engine storage.Engine
+	dir    *storage.URI
+	format string
+	target int64
+	// partitioned is true if values select their partitions.
+	partitioned bool
+	seq         int
+	parts       map[string]*partition
+	// files are the files written in the order they were started.
+	files   []*manifestFile
+	written []*storage.URI
+}
+
+// partition is the file being written for a partition.
+type partition struct {
+	file    *manifestFile
+	counter *countingWriter
+	writer  zio.WriteCloser
+	// size is the size of the values written to file.
+	size int64
+}
+
+// newExporter returns an exporter whose files are numbered from seq.
+func newExporter(ctx context.Context, engine storage.Engine, dir *storage.URI, format string, target int64, partitioned bool, seq int) *exporter {
+	return &exporter{
+		ctx:         ctx,
+		engine:      engine,
+		dir:         dir,
+		format:      format,
+		target:      target,
+		partitioned: partitioned,
+		seq:         seq,
+		parts:       make(map[string]*partition),
+	}
+}
+
+// export writes the values pulled from puller, which are records whose field
+// v is the value to be written and whose field p, if e is partitioned, is the
+// value selecting its partition.
+func (e *exporter) export(puller zbuf.Puller) error {
+	for {
+		batch, err := puller.Pull(false)
+		if batch == nil || err != nil {
+			return err
+		}
+		for _, val := range batch.Values() {
+			v := val.Deref("v")
+			if v == nil {
+				continue
+			}
+			var dir string
+			if e.partitioned {
+				dir = partitionPath(val.Deref("p"))
+			}
+			if err := e.write(dir, *v); err != nil {
+				batch.Unref()
+				return err
+			}
+		}
+		batch.Unref()
+	}
+}
+
+func (e *exporter) write(dir string, val super.Value) error {
+	p := e.parts[dir]
+	if p == nil {
+		p = &partition{}
+		e.parts[dir] = p
+	}
+	if p.writer == nil {
+		if err := e.start(dir, p); err != nil {
+			return err
+		}
+	}
+	if err := p.writer.Write(val); err != nil {
+		return err
+	}
+	p.file.Records++
+	p.size += int64(len(val.Bytes()))
+	if p.size >= e.target {
+		return e.finish(p)
+	}
+	return nil
+}
+
+// start opens the next file of the partition in dir.
+func (e *exporter) start(dir string, p *partition) error {
+	name := path.Join(dir, fmt.Sprintf("part-%05d.%s", e.seq, e.format))
+	e.seq++
+	u := e.dir.JoinPath(name)
+	out, err := e.engine.Put(e.ctx, u)
+	if err != nil {
+		return err
+	}
+	e.written = append(e.written, u)
+	counter := &countingWriter{WriteCloser: out}
+	writer, err := anyio.NewWriter(counter, anyio.WriterOpts{Format: e.format})
+	if err != nil {
+		out.Close()
+		return err
+	}
+	file := &manifestFile{Path: name, Partition: dir}
+	e.files = append(e.files, file)
+	*p = partition{file: file, counter: counter, writer: writer}
+	return nil
+}
+
+// finish closes the file of p.
+func (e *exporter) finish(p *partition) error {
+	err := p.writer.Close()
+	p.file.Bytes = p.counter.n
+	p.writer = nil
+	return err
+}
+
+// close closes the open file of each partition.
+func (e *exporter) close() error {
+	var err error
+	for _, p := range e.parts {
+		if p.writer != nil {
+			if closeErr := e.finish(p); err == nil {
+				err = closeErr
+			}
+		}
+	}
+	return err
+}
+
+// abort closes and removes the files written by e.
+func (e *exporter) abort() {
+	for _, p := range e.parts {
+		if p.writer != nil {
+			p.writer.Close()
+		}
+	}
+	for _, u := range slices.Backward(e.written) {
+		e.engine.Delete(context.Background(), u)
+	}
+}
+
+// partitionPath returns the directory, relative to that of the export, of
+// the partition selected by val.
+func partitionPath(val *super.Value) string {
+	if val == nil {
+		return DefaultPartition
+	}
+	if rec := super.TypeRecordOf(val.Type()); rec != nil && !val.IsNull() {
+		var elems []string
+		for k, f := range rec.Fields {
+			elems = append(elems, escape(f.Name)+"="+partitionName(val.DerefByColumn(k)))
+		}
+		return path.Join(elems...)
+	}
+	return partitionName(val)
+}
+
+func partitionName(val *super.Value) string {
+	if val == nil || val.IsNull() || val.IsError() {
+		return DefaultPartition
+	}
+	if val.IsString() {
+		return escape(val.AsString())
+	}
+	return escape(sup.FormatValue(*val))
+}
+
+// escape percent-encodes the characters of s that may not appear in a
+// path element, as Hive does.
+func escape(s string) string {
+	switch s {
+	case "":
+		return DefaultPartition
+	case ".", "..":
+		return strings.Repeat("%2E", len(s))
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c < 0x20 || c >= 0x7f || strings.IndexByte(`"#%'*/:=?\{}[]^`, c) >= 0 {
+			fmt.Fprintf(&b, "%%%02X", c)
+		} else {
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+type countingWriter struct {
+	io.WriteCloser
+	n int64
+}
+
+func (c *countingWriter) Write(b []byte) (int, error) {
+	n, err := c.WriteCloser.Write(b)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,117 @@
+package external
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/brimdata/super/cli/outputflags"
+	"github.com/brimdata/super/cmd/super/db"
+	"github.com/brimdata/super/lake/api"
+	"github.com/brimdata/super/pkg/charm"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/brimdata/super/zbuf"
+)
+
+var spec = &charm.Spec{
+	Name:  "external",
+	Usage: "external [-format format] [-d] [name [pattern]]",
+	Short: "create, delete, or list external pools",
+	Long: `
+The external command creates an external pool with the indicated name over
+the files whose URIs match pattern, e.g., "s3://bucket/logs/*.parquet".
+Only the last element of pattern may contain wildcards.  Queries read the
+files of an external pool in place with "from name", so data may be
+explored before it is loaded into a pool.
+
+The files are listed when a query of the external pool is compiled and the
+listing is reused for a minute.  If the -format flag is given, the files are
+read in that format.  Otherwise, the format of each file is inferred as it is
+for a file named in a "from" operator.
+
+If the -d flag is specified, the external pool is deleted.  Its files are
+not deleted.
+
+With no arguments, the external pools are listed along with the number and
+total size of their files.
+`,
+	New: New,
+}
+
+type Command struct {
+	*db.Command
+	delete      bool
+	format      string
+	outputFlags outputflags.Flags
+}
+
+func init() {
+	db.Spec.Add(spec)
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &Command{Command: parent.(*db.Command)}
+	f.BoolVar(&c.delete, "d", false, "delete the external pool instead of creating it")
+	f.StringVar(&c.format, "format", "", "format of the files of the external pool")
+	c.outputFlags.DefaultFormat = "lake"
+	c.outputFlags.SetFlags(f)
+	return c, nil
+}
+
+func (c *Command) Run(args []string) error {
+	ctx, cleanup, err := c.Init(&c.outputFlags)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	lake, err := c.LakeFlags.Open(ctx)
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return c.list(ctx, lake)
+	}
+	name := args[0]
+	if c.delete {
+		if len(args) > 1 {
+			return errors.New("too many arguments")
+		}
+		if err := lake.RemoveExternal(ctx, name); err != nil {
+			return err
+		}
+		if !c.LakeFlags.Quiet {
+			fmt.Printf("external pool deleted: %s\n", name)
+		}
+		return nil
+	}
+	if len(args) != 2 {
+		return errors.New("external requires a name and a pattern")
+	}
+	id, err := lake.CreateExternal(ctx, name, args[1], c.format)
+	if err != nil {
+		return err
+	}
+	if !c.LakeFlags.Quiet {
+		fmt.Printf("external pool created: %s %s\n", name, id)
+	}
+	return nil
+}
+
+func (c *Command) list(ctx context.Context, lake api.Interface) error {
+	w, err := c.outputFlags.Open(ctx, storage.NewLocalEngine())
+	if err != nil {
+		return err
+	}
+	q, err := lake.Query(ctx, "from :externals")
+	if err != nil {
+		w.Close()
+		return err
+	}
+	defer q.Pull(true)
+	err = zbuf.CopyPuller(w, q)
+	if closeErr := w.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
@@ -0,0 +1,121 @@
+package ingest
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/brimdata/super/cli"
+	"github.com/brimdata/super/cli/commitflags"
+	"github.com/brimdata/super/cli/inputflags"
+	"github.com/brimdata/super/cli/lakeflags"
+	"github.com/brimdata/super/cli/logflags"
+	"github.com/brimdata/super/cli/poolflags"
+	"github.com/brimdata/super/cmd/super/db"
+	"github.com/brimdata/super/cmd/super/internal/lakeingest"
+	"github.com/brimdata/super/pkg/charm"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/brimdata/super/sup"
+)
+
+var spec = &charm.Spec{
+	Name:  "ingest",
+	Usage: "ingest [options] queue-url",
+	Short: "continuously load new S3 objects announced by event notifications",
+	Long: `
+The ingest command runs until interrupted, receiving the S3 event
+notifications delivered to the Amazon SQS queue at queue-url, either
+directly or through an SNS topic, and loading each newly created object
+they reference into the working branch.
+
+Each object is loaded with an idempotency key formed from its URI and
+version, so an object announced more than once is loaded only once,
+while an object overwritten with new content is loaded again.  A
+notification is deleted from the queue once all of its objects are loaded.
+Otherwise, the queue redelivers it and the failed loads are retried.
+
+The status of each object is written to standard output as a record with
+fields ts, uri, status ("loaded", "empty", or "failed"), commit, and error.
+The -q option suppresses this output.
+`,
+	New: New,
+}
+
+func init() {
+	db.Spec.Add(spec)
+}
+
+type Command struct {
+	*db.Command
+	commitFlags commitflags.Flags
+	inputFlags  inputflags.Flags
+	logFlags    logflags.Flags
+	poolFlags   poolflags.Flags
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &Command{Command: parent.(*db.Command)}
+	c.commitFlags.SetFlags(f)
+	c.inputFlags.SetFlags(f, true)
+	c.logFlags.SetFlags(f)
+	c.poolFlags.SetFlags(f)
+	return c, nil
+}
+
+func (c *Command) Run(args []string) error {
+	ctx, cleanup, err := c.Init(&c.inputFlags)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if len(args) != 1 {
+		return errors.New("a single queue URL is required")
+	}
+	head, err := c.poolFlags.HEAD()
+	if err != nil {
+		return err
+	}
+	if head.Pool == "" {
+		return lakeflags.ErrNoHEAD
+	}
+	lake, err := c.LakeFlags.Open(ctx)
+	if err != nil {
+		return err
+	}
+	poolID, err := lake.PoolID(ctx, head.Pool)
+	if err != nil {
+		return err
+	}
+	queue, err := lakeingest.NewSQSQueue(args[0])
+	if err != nil {
+		return err
+	}
+	logger, err := c.logFlags.Open()
+	if err != nil {
+		return err
+	}
+	defer logger.Sync()
+	message := c.commitFlags.CommitMessage()
+	message.Loader = "super " + cli.Version()
+	conf := lakeingest.Config{
+		Pool:       poolID,
+		Branch:     head.Branch,
+		Message:    message,
+		ReaderOpts: c.inputFlags.Options(),
+	}
+	if !c.LakeFlags.Quiet {
+		conf.Status = func(status lakeingest.FileStatus) {
+			s, err := sup.Marshal(status)
+			if err != nil {
+				s = err.Error()
+			}
+			fmt.Println(s)
+		}
+	}
+	err = lakeingest.Run(ctx, lake, storage.NewRemoteEngine(), queue, conf, logger)
+	if ctx.Err() != nil {
+		// Interrupted.
+		return nil
+	}
+	return err
+}
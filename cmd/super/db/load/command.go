@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/brimdata/super"
+	"github.com/brimdata/super/cli"
 	"github.com/brimdata/super/cli/commitflags"
 	"github.com/brimdata/super/cli/inputflags"
 	"github.com/brimdata/super/cli/lakeflags"
@@ -32,6 +33,10 @@ var spec = &charm.Spec{
 	Short: "add and commit data to a branch",
 	Long: `
 The load command adds data to a pool and commits it to a branch.
+
+The -transform flag gives a query, e.g., "rename host:=hostname | drop debug",
+that is applied to the loaded values before they are written in place of
+the pool's transform, if any.
 `,
 	New: New,
 }
@@ -46,6 +51,7 @@ type Command struct {
 	inputFlags   inputflags.Flags
 	poolFlags    poolflags.Flags
 	runtimeFlags runtimeflags.Flags
+	transform    string
 
 	// status output
 	ctx       context.Context
@@ -60,6 +66,7 @@ func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 	c.inputFlags.SetFlags(f, true)
 	c.poolFlags.SetFlags(f)
 	c.runtimeFlags.SetFlags(f)
+	f.StringVar(&c.transform, "transform", "", "query applied to loaded values in place of pool's transform")
 	return c, nil
 }
 
@@ -103,6 +110,9 @@ func (c *Command) Run(args []string) error {
 		go d.Run()
 	}
 	message := c.commitFlags.CommitMessage()
+	message.Sources = paths
+	message.Loader = "super " + cli.Version()
+	message.Transform = c.transform
 	commitID, err := lake.Load(ctx, sctx, poolID, head.Branch, zio.ConcatReader(readers...), message)
 	if d != nil {
 		d.Close()
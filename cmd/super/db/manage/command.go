@@ -26,7 +26,9 @@ The manage command performs maintenance tasks on a lake.
 
 Currently the only supported task is compaction, which reduces
 fragmentation by reading data objects in a pool and writing their
-contents back to large, non-overlapping objects.
+contents back to large, non-overlapping objects.  Partitions with
+overlapping objects are compacted first, starting with the most deeply
+overlapping, after which the remaining objects are combined by size.
 
 If the -monitor option is specified and the lake is located via network
 connection, zed manage will run continuously and perform updates as
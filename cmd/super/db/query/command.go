@@ -4,6 +4,7 @@ import (
 	"flag"
 	"os"
 
+	"github.com/brimdata/super/api"
 	"github.com/brimdata/super/cli/outputflags"
 	"github.com/brimdata/super/cli/queryflags"
 	"github.com/brimdata/super/cli/runtimeflags"
@@ -21,6 +22,10 @@ var spec = &charm.Spec{
 	Short: "run a Zed query on a Zed data lake",
 	Long: `
 "zed query" runs a Zed query on a Zed data lake.
+
+Each -label flag, of the form name=value, attaches a label to a query sent to
+a lake service, e.g., "-label team=search -label job=nightly", by which the
+service attributes the query in its query status, logs, and metrics.
 `,
 	New: New,
 }
@@ -31,6 +36,7 @@ func init() {
 
 type Command struct {
 	*db.Command
+	labels       map[string]string
 	outputFlags  outputflags.Flags
 	queryFlags   queryflags.Flags
 	runtimeFlags runtimeflags.Flags
@@ -38,6 +44,17 @@ type Command struct {
 
 func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 	c := &Command{Command: parent.(*db.Command)}
+	f.Func("label", "label attributing query to a workload, as name=value (may be repeated)", func(s string) error {
+		name, value, err := api.ParseQueryLabel(s)
+		if err != nil {
+			return err
+		}
+		if c.labels == nil {
+			c.labels = make(map[string]string)
+		}
+		c.labels[name] = value
+		return nil
+	})
 	c.outputFlags.SetFlags(f)
 	c.queryFlags.SetFlags(f)
 	c.runtimeFlags.SetFlags(f)
@@ -57,6 +74,9 @@ func (c *Command) Run(args []string) error {
 	if len(args) == 1 {
 		src = args[0]
 	}
+	if len(c.labels) > 0 {
+		ctx = api.ContextWithQueryLabels(ctx, c.labels)
+	}
 	lake, err := c.LakeFlags.Open(ctx)
 	if err != nil {
 		return err
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"flag"
+	"fmt"
 	"io"
 	"net"
 	"os"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/brimdata/super/api/client"
 	"github.com/brimdata/super/cli"
+	"github.com/brimdata/super/cli/auto"
 	"github.com/brimdata/super/cli/logflags"
 	"github.com/brimdata/super/cmd/super/db"
 	"github.com/brimdata/super/cmd/super/internal/lakemanage"
@@ -20,6 +22,10 @@ import (
 	"github.com/brimdata/super/pkg/charm"
 	"github.com/brimdata/super/pkg/fs"
 	"github.com/brimdata/super/pkg/httpd"
+	"github.com/brimdata/super/runtime/sam/expr/function"
+	"github.com/brimdata/super/runtime/sam/op/join"
+	"github.com/brimdata/super/runtime/sam/op/meta"
+	"github.com/brimdata/super/runtime/vcache"
 	"github.com/brimdata/super/service"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
@@ -42,7 +48,53 @@ output at the default info level seems too excessive for
 production use, warn level is recommended.
 
 The -manage option enables the running of the same maintenance tasks
-normally performed via the separate "zed manage" command.
+normally performed via the separate "zed manage" command.  When several
+servers share a lake, a lease stored in the lake elects one of them to
+run these tasks.
+
+The -readonly option runs a read-only server, or replica, that serves
+queries but rejects requests that modify the lake, so that query capacity
+can be added by running replicas that share the lake of a server that
+accepts writes.  A replica refreshes its view of the lake's pools and
+branches at the interval given by -readonly.refresh.
+
+The -snapshotcache option caches the tip of each queried branch and the
+snapshot at that tip across queries.  Cached entries are invalidated only
+by commits made through or observed by this server, so use it only on the
+lake's sole writer or on a read-only server.
+
+The -geoipdb option gives a MaxMind DB database, e.g., GeoLite2-City or
+GeoLite2-ASN, in which the geoip function looks up addresses and may be
+repeated.  A database whose file changes is reloaded.
+
+The -export.root option enables queries to write their results to a file
+or S3 object instead of the response and gives a URI under which those
+results may be written.  It may be repeated.
+
+The -external.root option enables the creation of external pools and gives
+a URI under which the files of an external pool may be.  It may be
+repeated.  Creating or deleting an external pool requires the admin role.
+
+The -session.idlettl option gives the time after which a session in which
+no query has run is deleted along with its temporary tables.
+
+The -lookupcache option gives the number of indexes built by lookup joins
+that are cached across queries.  An index is rebuilt once a commit is made
+to a pool that it reads or a file that it reads changes.
+
+The -otlp.logs and -otlp.traces options enable an OTLP/HTTP receiver at
+the paths /v1/logs and /v1/traces, respectively, to which OpenTelemetry
+collectors and SDKs may export in the protobuf or JSON encoding.  Each
+option gives the branch, as pool[@branch], to which the log records or spans
+of each export request are loaded in a single commit.
+
+The -esbulk.index option enables an Elasticsearch-compatible bulk endpoint
+at the paths /_bulk and /{index}/_bulk so that log shippers configured for
+Elasticsearch may load into the lake.  Each option has the form
+pattern=pool[@branch] and maps the index names matching the glob pattern,
+e.g., filebeat-*=logs, to a branch.  The first matching option applies, and
+documents for an index that matches none are rejected.  The option may be
+repeated.
 `,
 	HiddenFlags: "brimfd,portfile",
 	New:         New,
@@ -60,8 +112,10 @@ type Command struct {
 	// brimfd is a file descriptor passed through by Zui desktop. If set the
 	// command will exit if the fd is closed.
 	brimfd          int
+	geoipDBs        []string
 	listenAddr      string
 	manage          time.Duration
+	metaCacheSize   auto.Bytes
 	portFile        string
 	rootContentFile string
 }
@@ -76,9 +130,40 @@ func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 		c.conf.CORSAllowedOrigins = append(c.conf.CORSAllowedOrigins, s)
 		return nil
 	})
+	f.Func("geoipdb", "path of a MaxMind DB database for the geoip function (may be repeated)", func(s string) error {
+		c.geoipDBs = append(c.geoipDBs, s)
+		return nil
+	})
+	f.Func("esbulk.index", "pattern=pool[@branch] mapping Elasticsearch index names matching pattern to a branch for the bulk endpoint (may be repeated)", func(s string) error {
+		c.conf.ESBulkIndexes = append(c.conf.ESBulkIndexes, s)
+		return nil
+	})
+	f.Func("export.root", "file or S3 URI under which queries may export their results (may be repeated)", func(s string) error {
+		c.conf.ExportRoots = append(c.conf.ExportRoots, s)
+		return nil
+	})
+	f.Func("external.root", "file or S3 URI under which the files of external pools may be (may be repeated)", func(s string) error {
+		c.conf.ExternalRoots = append(c.conf.ExternalRoots, s)
+		return nil
+	})
 	f.StringVar(&c.conf.DefaultResponseFormat, "defaultfmt", service.DefaultFormat, "default response format")
 	f.StringVar(&c.listenAddr, "l", ":9867", "[addr]:port to listen on")
 	f.DurationVar(&c.manage, "manage", 0, "when positive, run lake maintenance tasks at this interval")
+	c.metaCacheSize = auto.NewBytes(vcache.DefaultMetaCacheSize)
+	f.Var(&c.metaCacheSize, "metacache", "maximum size of the CSUP object metadata cached across queries in MiB, MB, etc")
+	f.StringVar(&c.conf.OTLPLogs, "otlp.logs", "", "pool[@branch] to which the OTLP/HTTP receiver loads logs (disabled if empty)")
+	f.StringVar(&c.conf.OTLPTraces, "otlp.traces", "", "pool[@branch] to which the OTLP/HTTP receiver loads traces (disabled if empty)")
+	f.IntVar(&c.conf.LookupCacheSize, "lookupcache", join.DefaultLookupCacheSize, "number of lookup join indexes cached across queries")
+	f.IntVar(&c.conf.PartitionCacheSize, "partitioncache", meta.DefaultPartitionCacheSize, "number of commits whose pool partitions are cached across queries")
+	f.BoolVar(&c.conf.SnapshotCache, "snapshotcache", false, "cache branch tips and snapshots across queries (use only when this server observes every commit to the lake)")
+	f.Func("query.metriclabel", "name of query label whose values label the query metrics (may be repeated)", func(s string) error {
+		c.conf.QueryMetricLabels = append(c.conf.QueryMetricLabels, s)
+		return nil
+	})
+	f.DurationVar(&c.conf.SlowQueryThreshold, "query.slowthreshold", 0, "when positive, log the plan and operator statistics of queries running at least this long")
+	f.BoolVar(&c.conf.ReadOnly, "readonly", false, "serve queries only, rejecting requests that modify the lake")
+	f.DurationVar(&c.conf.SessionIdleTTL, "session.idlettl", service.DefaultSessionIdleTTL, "time after which a session in which no query has run is deleted")
+	f.DurationVar(&c.conf.RefreshInterval, "readonly.refresh", service.DefaultRefreshInterval, "interval at which a read-only server refreshes its view of the lake")
 	f.StringVar(&c.portFile, "portfile", "", "write listen port to file")
 	f.StringVar(&c.rootContentFile, "rootcontentfile", "", "file to serve for GET /")
 	return c, nil
@@ -98,6 +183,13 @@ func (c *Command) Run(args []string) error {
 	if api.IsLakeService(c.conf.Root.String()) {
 		return errors.New("serve command available for local lakes only")
 	}
+	c.conf.MetaCacheSize = uint64(c.metaCacheSize.Bytes)
+	if c.conf.ReadOnly && c.manage > 0 {
+		return errors.New("-manage cannot be used with -readonly")
+	}
+	if err := function.SetGeoIPDatabases(c.geoipDBs); err != nil {
+		return err
+	}
 	if c.rootContentFile != "" {
 		f, err := fs.Open(c.rootContentFile)
 		if err != nil {
@@ -129,8 +221,16 @@ func (c *Command) Run(args []string) error {
 	group, ctx := errgroup.WithContext(ctx)
 	if c.manage > 0 {
 		conn := client.NewConnectionTo("http://" + srv.Addr())
+		// Elect a single server among those sharing the lake to run
+		// maintenance.  The lease outlives a few intervals so that the
+		// elected server keeps it across updates.
+		host, _ := os.Hostname()
+		owner := fmt.Sprintf("%s:%d:%s", host, os.Getpid(), srv.Addr())
+		elector := core.Root().NewElector("manage", owner, 3*c.manage)
+		conf := lakemanage.Config{Interval: &c.manage, Elect: elector.Elect}
 		group.Go(func() error {
-			return lakemanage.Monitor(ctx, conn, lakemanage.Config{Interval: &c.manage}, logger.Named("manage"))
+			defer elector.Release(context.Background())
+			return lakemanage.Monitor(ctx, conn, conf, logger.Named("manage"))
 		})
 	}
 	if c.portFile != "" {
@@ -0,0 +1,39 @@
+package lakeingest
+
+import (
+	"time"
+
+	"github.com/brimdata/super/api"
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/zio/anyio"
+	"github.com/segmentio/ksuid"
+)
+
+// RetryInterval is the time Run waits before retrying after it fails to
+// receive messages from its queue.
+const RetryInterval = 5 * time.Second
+
+type Config struct {
+	// Pool and Branch name the branch into which files are loaded.
+	Pool   ksuid.KSUID
+	Branch string
+	// Message is the commit message of each load.  Its sources and
+	// idempotency key are set to those of the loaded file.
+	Message    api.CommitMessage
+	ReaderOpts anyio.ReaderOpts
+	// If Status is not nil, Run calls it with the status of each file
+	// referenced by a notification.
+	Status func(FileStatus)
+}
+
+// FileStatus is the outcome of loading a file referenced by a notification.
+type FileStatus struct {
+	Time nano.Ts `super:"ts"`
+	URI  string  `super:"uri"`
+	// Status is "loaded", "empty" if the file had no values, or "failed".
+	Status string `super:"status"`
+	// Commit is the commit that loaded the file.  If the file was loaded
+	// previously, it is the commit of that load.
+	Commit ksuid.KSUID `super:"commit"`
+	Error  string      `super:"error"`
+}
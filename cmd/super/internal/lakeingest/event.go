@@ -0,0 +1,75 @@
+package lakeingest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// file is a new object referenced by an S3 event notification.
+type file struct {
+	uri string
+	// key is the idempotency key of the load of the object, which
+	// identifies its content so that a redelivered notification does not
+	// load the object again but an overwritten object is loaded anew.
+	key string
+}
+
+// notification is the subset of an S3 event notification, possibly
+// wrapped in an SNS envelope, used by the ingester.  See
+// https://docs.aws.amazon.com/AmazonS3/latest/userguide/notification-content-structure.html.
+type notification struct {
+	// Type and Message are set when the notification was published to
+	// an SNS topic to which the queue subscribes.
+	Type    string `json:"Type"`
+	Message string `json:"Message"`
+	Records []struct {
+		EventName string `json:"eventName"`
+		S3        struct {
+			Bucket struct {
+				Name string `json:"name"`
+			} `json:"bucket"`
+			Object struct {
+				Key       string `json:"key"`
+				ETag      string `json:"eTag"`
+				VersionID string `json:"versionId"`
+				Sequencer string `json:"sequencer"`
+			} `json:"object"`
+		} `json:"s3"`
+	} `json:"Records"`
+}
+
+// parseMessage returns the objects created according to the S3 event
+// notification in body.  Other events, e.g., removals and the test event
+// sent when notifications are configured, are ignored.
+func parseMessage(body string) ([]file, error) {
+	var n notification
+	if err := json.Unmarshal([]byte(body), &n); err != nil {
+		return nil, fmt.Errorf("malformed S3 event notification: %w", err)
+	}
+	if n.Type == "Notification" {
+		return parseMessage(n.Message)
+	}
+	var files []file
+	for _, r := range n.Records {
+		if !strings.HasPrefix(r.EventName, "ObjectCreated:") {
+			continue
+		}
+		// Object keys are URL encoded as in an HTML form.
+		key, err := url.QueryUnescape(r.S3.Object.Key)
+		if err != nil {
+			return nil, fmt.Errorf("malformed S3 object key %q: %w", r.S3.Object.Key, err)
+		}
+		uri := (&url.URL{Scheme: "s3", Host: r.S3.Bucket.Name, Path: "/" + key}).String()
+		version := r.S3.Object.VersionID
+		if version == "" {
+			version = r.S3.Object.ETag
+		}
+		if version == "" {
+			version = r.S3.Object.Sequencer
+		}
+		files = append(files, file{uri: uri, key: uri + "@" + version})
+	}
+	return files, nil
+}
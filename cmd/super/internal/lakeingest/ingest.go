@@ -0,0 +1,114 @@
+// Package lakeingest loads the objects referenced by S3 event notifications
+// received from a queue into a lake.
+package lakeingest
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/brimdata/super"
+	lakeapi "github.com/brimdata/super/lake/api"
+	"github.com/brimdata/super/lake/commits"
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/brimdata/super/zio/anyio"
+	"github.com/segmentio/ksuid"
+	"go.uber.org/zap"
+)
+
+// Run receives S3 event notifications from queue and loads each newly
+// created object they reference into the branch of conf, using engine to
+// read the object, until ctx is canceled.  Each object is loaded with an
+// idempotency key derived from its URI and version so that a notification
+// delivered more than once loads its objects only once.  A message is
+// deleted from queue after all of its objects are loaded; otherwise, it is
+// redelivered by the queue and the objects that failed are retried.
+func Run(ctx context.Context, lk lakeapi.Interface, engine storage.Engine, queue Queue, conf Config, logger *zap.Logger) error {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	logger.Info("ingesting", zap.Stringer("pool", conf.Pool), zap.String("branch", conf.Branch))
+	for {
+		messages, err := queue.Receive(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			logger.Error("cannot receive messages, retrying", zap.Duration("interval", RetryInterval), zap.Error(err))
+			select {
+			case <-time.After(RetryInterval):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			continue
+		}
+		for _, m := range messages {
+			if !ingestMessage(ctx, lk, engine, m, conf, logger) {
+				continue
+			}
+			if err := queue.Delete(ctx, m); err != nil {
+				logger.Error("cannot delete message", zap.String("message", m.ID), zap.Error(err))
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+}
+
+// ingestMessage loads the objects referenced by m and returns true if m
+// should be deleted from the queue.
+func ingestMessage(ctx context.Context, lk lakeapi.Interface, engine storage.Engine, m Message, conf Config, logger *zap.Logger) bool {
+	logger = logger.With(zap.String("message", m.ID))
+	files, err := parseMessage(m.Body)
+	if err != nil {
+		// Redelivery cannot fix a malformed message.
+		logger.Error("discarding message", zap.Error(err))
+		return true
+	}
+	ok := true
+	for _, f := range files {
+		status := ingestFile(ctx, lk, engine, f, conf)
+		if status.Status == "failed" {
+			logger.Error("load failed", zap.String("uri", f.uri), zap.String("error", status.Error))
+			ok = false
+		} else {
+			logger.Info("file "+status.Status, zap.String("uri", f.uri), zap.Stringer("commit", status.Commit))
+		}
+		if conf.Status != nil {
+			conf.Status(status)
+		}
+	}
+	return ok
+}
+
+func ingestFile(ctx context.Context, lk lakeapi.Interface, engine storage.Engine, f file, conf Config) FileStatus {
+	commit, err := load(ctx, lk, engine, f, conf)
+	status := FileStatus{
+		Time:   nano.Now(),
+		URI:    f.uri,
+		Status: "loaded",
+		Commit: commit,
+	}
+	if errors.Is(err, commits.ErrEmptyTransaction) {
+		status.Status = "empty"
+	} else if err != nil {
+		status.Status = "failed"
+		status.Error = err.Error()
+	}
+	return status
+}
+
+func load(ctx context.Context, lk lakeapi.Interface, engine storage.Engine, f file, conf Config) (ksuid.KSUID, error) {
+	sctx := super.NewContext()
+	r, err := anyio.Open(ctx, sctx, engine, f.uri, conf.ReaderOpts)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	defer r.Close()
+	message := conf.Message
+	message.Sources = []string{f.uri}
+	message.Key = f.key
+	return lk.Load(ctx, sctx, conf.Pool, conf.Branch, r, message)
+}
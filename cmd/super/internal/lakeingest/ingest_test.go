@@ -0,0 +1,136 @@
+package lakeingest
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"strings"
+	"testing"
+
+	lakeapi "github.com/brimdata/super/lake/api"
+	"github.com/brimdata/super/lake/data"
+	"github.com/brimdata/super/lake/pools"
+	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/brimdata/super/zbuf"
+	"github.com/brimdata/super/zio"
+	"github.com/brimdata/super/zio/supio"
+	"github.com/segmentio/ksuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestParseMessage(t *testing.T) {
+	body := `{"Records":[
+		{"eventName":"ObjectCreated:Put","s3":{"bucket":{"name":"b"},"object":{"key":"logs/a+b%2B.sup","eTag":"e1"}}},
+		{"eventName":"ObjectRemoved:Delete","s3":{"bucket":{"name":"b"},"object":{"key":"logs/c.sup"}}},
+		{"eventName":"ObjectCreated:Copy","s3":{"bucket":{"name":"b"},"object":{"key":"d.sup","versionId":"v1","eTag":"e2"}}}
+	]}`
+	files, err := parseMessage(body)
+	require.NoError(t, err)
+	expected := []file{
+		{uri: "s3://b/logs/a%20b+.sup", key: "s3://b/logs/a%20b+.sup@e1"},
+		{uri: "s3://b/d.sup", key: "s3://b/d.sup@v1"},
+	}
+	assert.Equal(t, expected, files)
+	// An SNS envelope wraps the notification in a string.
+	sns := fmt.Sprintf(`{"Type":"Notification","Message":%q}`, body)
+	files, err = parseMessage(sns)
+	require.NoError(t, err)
+	assert.Equal(t, expected, files)
+	files, err = parseMessage(`{"Service":"Amazon S3","Event":"s3:TestEvent"}`)
+	require.NoError(t, err)
+	assert.Len(t, files, 0)
+	_, err = parseMessage("not json")
+	assert.Error(t, err)
+}
+
+func TestRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	lk, err := lakeapi.CreateLocalLake(ctx, zap.NewNop(), t.TempDir())
+	require.NoError(t, err)
+	sortKeys := order.SortKeys{order.NewSortKey(order.Asc, field.Path{"x"})}
+	poolID, err := lk.CreatePool(ctx, "test", sortKeys, data.DefaultSeekStride, data.DefaultThreshold, nil, pools.Defaults{}, "", nil, pools.Partition{})
+	require.NoError(t, err)
+	engine := &testEngine{objects: map[string]string{
+		"s3://bucket/a.sup": "{x:1}",
+		"s3://bucket/b.sup": "{x:2}",
+	}}
+	created := func(keys ...string) string {
+		var records []string
+		for _, key := range keys {
+			records = append(records, fmt.Sprintf(`{"eventName":"ObjectCreated:Put","s3":{"bucket":{"name":"bucket"},"object":{"key":%q,"eTag":"etag"}}}`, key))
+		}
+		return `{"Records":[` + strings.Join(records, ",") + `]}`
+	}
+	queue := &testQueue{
+		cancel: cancel,
+		messages: []Message{
+			{ID: "first", Body: created("a.sup", "b.sup")},
+			{ID: "redelivered", Body: created("a.sup")},
+			{ID: "test", Body: `{"Event":"s3:TestEvent"}`},
+			{ID: "missing", Body: created("missing.sup")},
+			{ID: "malformed", Body: "not json"},
+		},
+	}
+	var statuses []FileStatus
+	conf := Config{
+		Pool:   poolID,
+		Branch: "main",
+		Status: func(s FileStatus) { statuses = append(statuses, s) },
+	}
+	err = Run(ctx, lk, engine, queue, conf, nil)
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []string{"first", "redelivered", "test", "malformed"}, queue.deleted)
+	require.Len(t, statuses, 4)
+	assert.Equal(t, "loaded", statuses[0].Status)
+	assert.Equal(t, "loaded", statuses[1].Status)
+	assert.Equal(t, statuses[0].Commit, statuses[2].Commit, "redelivered object was loaded again")
+	assert.NotEqual(t, statuses[0].Commit, statuses[1].Commit)
+	assert.Equal(t, "failed", statuses[3].Status)
+	assert.Equal(t, ksuid.Nil, statuses[3].Commit)
+	q, err := lk.Query(context.Background(), "from test | sort x")
+	require.NoError(t, err)
+	var b strings.Builder
+	require.NoError(t, zbuf.CopyPuller(supio.NewWriter(zio.NopCloser(&b), supio.WriterOpts{}), q))
+	assert.Equal(t, "{x:1}\n{x:2}\n", b.String())
+}
+
+type testEngine struct {
+	storage.Engine
+	objects map[string]string
+}
+
+func (e *testEngine) Get(_ context.Context, u *storage.URI) (storage.Reader, error) {
+	s, ok := e.objects[u.String()]
+	if !ok {
+		return nil, fs.ErrNotExist
+	}
+	return storage.NewBytesReader([]byte(s)), nil
+}
+
+// testQueue delivers its messages one at a time and cancels the context of
+// Run once all of them have been received.
+type testQueue struct {
+	cancel   context.CancelFunc
+	messages []Message
+	deleted  []string
+}
+
+func (q *testQueue) Receive(ctx context.Context) ([]Message, error) {
+	if len(q.messages) == 0 {
+		q.cancel()
+		return nil, ctx.Err()
+	}
+	m := q.messages[0]
+	q.messages = q.messages[1:]
+	return []Message{m}, nil
+}
+
+func (q *testQueue) Delete(_ context.Context, m Message) error {
+	q.deleted = append(q.deleted, m.ID)
+	return nil
+}
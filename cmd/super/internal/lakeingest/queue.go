@@ -0,0 +1,84 @@
+package lakeingest
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sqs"
+)
+
+// A Queue is a queue of S3 event notifications.
+type Queue interface {
+	// Receive waits for and returns the next messages of the queue.  A
+	// received message is redelivered unless it is deleted.
+	Receive(ctx context.Context) ([]Message, error)
+	Delete(ctx context.Context, m Message) error
+}
+
+type Message struct {
+	ID string
+	// Handle identifies the receipt of the message for Delete.
+	Handle string
+	Body   string
+}
+
+type sqsQueue struct {
+	client *sqs.SQS
+	url    string
+}
+
+// NewSQSQueue returns a Queue that receives messages from the Amazon SQS
+// queue at queueURL.  The region and endpoint of the queue are taken from
+// queueURL and the credentials from the environment as for S3.
+func NewSQSQueue(queueURL string) (Queue, error) {
+	u, err := url.Parse(queueURL)
+	if err != nil {
+		return nil, err
+	}
+	cfg := aws.Config{Endpoint: aws.String(u.Scheme + "://" + u.Host)}
+	// AWS queue URLs have the form https://sqs.REGION.amazonaws.com/...
+	if region, ok := strings.CutPrefix(u.Hostname(), "sqs."); ok {
+		if region, ok := strings.CutSuffix(region, ".amazonaws.com"); ok {
+			cfg.Region = aws.String(region)
+		}
+	}
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config:            cfg,
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &sqsQueue{client: sqs.New(sess), url: queueURL}, nil
+}
+
+func (q *sqsQueue) Receive(ctx context.Context) ([]Message, error) {
+	out, err := q.client.ReceiveMessageWithContext(ctx, &sqs.ReceiveMessageInput{
+		QueueUrl:            aws.String(q.url),
+		MaxNumberOfMessages: aws.Int64(10),
+		WaitTimeSeconds:     aws.Int64(20),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var messages []Message
+	for _, m := range out.Messages {
+		messages = append(messages, Message{
+			ID:     aws.StringValue(m.MessageId),
+			Handle: aws.StringValue(m.ReceiptHandle),
+			Body:   aws.StringValue(m.Body),
+		})
+	}
+	return messages, nil
+}
+
+func (q *sqsQueue) Delete(ctx context.Context, m Message) error {
+	_, err := q.client.DeleteMessageWithContext(ctx, &sqs.DeleteMessageInput{
+		QueueUrl:      aws.String(q.url),
+		ReceiptHandle: aws.String(m.Handle),
+	})
+	return err
+}
@@ -38,6 +38,23 @@ func newBranch(c Config, pool *pools.Config, lake lakeapi.Interface, logger *zap
 func (b *branch) run(ctx context.Context) error {
 	b.logger.Debug("compaction started")
 	head := lakeparse.Commitish{Pool: b.pool.Name, Branch: b.config.Branch}
+	// First compact the partitions with overlapping objects, worst first,
+	// then coalesce what remains by size.
+	runs, err := planOverlaps(ctx, b.lake, &head)
+	if err != nil {
+		return err
+	}
+	var found int
+	var compacted int
+	for _, run := range runs {
+		commit, err := b.lake.Compact(ctx, b.pool.ID, b.config.Branch, run, b.config.Vectors, api.CommitMessage{})
+		if err != nil {
+			return err
+		}
+		found++
+		compacted += len(run)
+		b.logger.Debug("compacted overlapping partition", zap.Stringer("commit", commit), zap.Int("objects_compacted", len(run)))
+	}
 	it, err := newObjectIterator(ctx, b.lake, &head)
 	if err != nil {
 		return err
@@ -52,8 +69,6 @@ func (b *branch) run(ctx context.Context) error {
 		close(vecCh)
 		return err
 	})
-	var found int
-	var compacted int
 	var vectors int
 	group.Go(func() error {
 		for run := range runCh {
@@ -1,6 +1,7 @@
 package lakemanage
 
 import (
+	"context"
 	"time"
 
 	"github.com/brimdata/super/lake/pools"
@@ -12,6 +13,10 @@ type Config struct {
 	Interval *time.Duration `yaml:"interval"`
 	Vectors  bool           `yaml:"vectors"`
 	Pools    []PoolConfig   `yaml:"pools"`
+	// If Elect is not nil, Monitor runs maintenance at each interval only
+	// when Elect returns true, e.g., to run it on a single process of
+	// several monitoring the same lake.
+	Elect func(context.Context) (bool, error) `yaml:"-"`
 }
 
 func (c *Config) poolConfig(p *pools.Config) PoolConfig {
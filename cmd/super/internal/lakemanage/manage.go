@@ -62,6 +62,17 @@ func monitor(ctx context.Context, lk lakeapi.Interface, conf Config, logger *zap
 		case <-ctx.Done():
 			return ctx.Err()
 		}
+		if conf.Elect != nil {
+			ok, err := conf.Elect(ctx)
+			if err != nil {
+				logger.Error("election error", zap.Error(err))
+				continue
+			}
+			if !ok {
+				logger.Debug("skipping update held by another process")
+				continue
+			}
+		}
 		err := Update(ctx, lk, conf, logger)
 		if err != nil {
 			return err
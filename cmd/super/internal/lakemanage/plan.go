@@ -0,0 +1,49 @@
+package lakemanage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/brimdata/super/lake/api"
+	"github.com/brimdata/super/lakeparse"
+	"github.com/brimdata/super/sup"
+	"github.com/brimdata/super/zbuf"
+	"github.com/segmentio/ksuid"
+)
+
+// overlapsQuery lists the partitions of a branch that have overlapping data
+// objects ordered from the worst (i.e., the most deeply overlapping) to the
+// least.
+const overlapsQuery = `
+from %q@%q:overlaps
+| objects > 1
+| sort -r depth, objects, size
+| yield ids
+`
+
+// planOverlaps returns the data objects of each partition of the branch head
+// that has overlapping objects, worst partition first, so that compacting
+// each run removes the merge-on-read work of its partition.  Since
+// partitions do not overlap, the runs may be compacted independently.
+func planOverlaps(ctx context.Context, lake api.Interface, head *lakeparse.Commitish) ([][]ksuid.KSUID, error) {
+	query := fmt.Sprintf(overlapsQuery, head.Pool, head.Branch)
+	q, err := lake.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer q.Pull(true)
+	reader := zbuf.PullerReader(q)
+	unmarshaler := sup.NewBSUPUnmarshaler()
+	var runs [][]ksuid.KSUID
+	for {
+		val, err := reader.Read()
+		if val == nil || err != nil {
+			return runs, err
+		}
+		var run []ksuid.KSUID
+		if err := unmarshaler.Unmarshal(*val, &run); err != nil {
+			return nil, err
+		}
+		runs = append(runs, run)
+	}
+}
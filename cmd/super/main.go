@@ -11,7 +11,11 @@ import (
 	_ "github.com/brimdata/super/cmd/super/db/compile"
 	_ "github.com/brimdata/super/cmd/super/db/create"
 	_ "github.com/brimdata/super/cmd/super/db/delete"
+	_ "github.com/brimdata/super/cmd/super/db/diff"
 	_ "github.com/brimdata/super/cmd/super/db/drop"
+	_ "github.com/brimdata/super/cmd/super/db/export"
+	_ "github.com/brimdata/super/cmd/super/db/external"
+	_ "github.com/brimdata/super/cmd/super/db/ingest"
 	_ "github.com/brimdata/super/cmd/super/db/init"
 	_ "github.com/brimdata/super/cmd/super/db/load"
 	_ "github.com/brimdata/super/cmd/super/db/log"
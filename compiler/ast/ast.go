@@ -197,6 +197,20 @@ type FromEntity interface {
 	fromEntity()
 }
 
+// Temp is a temporary table written by an Into operator.
+type Temp struct {
+	Kind string `json:"kind" unpack:""`
+	Name string `json:"name"`
+	Loc  `json:"loc"`
+}
+
+// Generate is a source of synthetic values specified by a record expression.
+type Generate struct {
+	Kind string `json:"kind" unpack:""`
+	Spec Expr   `json:"spec"`
+	Loc  `json:"loc"`
+}
+
 type ExprEntity struct {
 	Kind string `json:"kind" unpack:""`
 	Expr Expr   `json:"expr"`
@@ -206,11 +220,13 @@ type ExprEntity struct {
 func (*Glob) fromEntity()       {}
 func (*Regexp) fromEntity()     {}
 func (*ExprEntity) fromEntity() {}
+func (*Generate) fromEntity()   {}
 func (*LakeMeta) fromEntity()   {}
 func (*Name) fromEntity()       {}
 func (*CrossJoin) fromEntity()  {}
 func (*SQLJoin) fromEntity()    {}
 func (*SQLPipe) fromEntity()    {}
+func (*Temp) fromEntity()       {}
 
 type FromElem struct {
 	Kind       string      `json:"kind" unpack:""`
@@ -512,7 +528,21 @@ type (
 		Limit int         `json:"limit"`
 		Keys  Assignments `json:"keys"`
 		Aggs  Assignments `json:"aggs"`
-		Loc   `json:"loc"`
+		// Lateness, if not nil, is the duration by which the time of the
+		// first grouping key may lag the latest seen before the groups
+		// of earlier times are complete.
+		Lateness *Primitive `json:"lateness"`
+		Loc      `json:"loc"`
+	}
+	// Sequence matches its steps in order among the values with the same
+	// grouping keys whose times fall within a window.
+	Sequence struct {
+		Kind   string      `json:"kind" unpack:""`
+		Steps  Assignments `json:"steps"`
+		Keys   Assignments `json:"keys"`
+		Window *Primitive  `json:"window"`
+		Time   Expr        `json:"time"`
+		Loc    `json:"loc"`
 	}
 	Top struct {
 		Kind    string     `json:"kind" unpack:""`
@@ -583,6 +613,7 @@ type (
 		Style      string      `json:"style"`
 		RightInput Seq         `json:"right_input"`
 		Cond       JoinExpr    `json:"cond"`
+		Tolerance  Expr        `json:"tolerance"`
 		Args       Assignments `json:"args"`
 		Loc        `json:"loc"`
 	}
@@ -591,6 +622,14 @@ type (
 		Expr Expr   `json:"expr"`
 		Loc  `json:"loc"`
 	}
+	// Graph summarizes the edges from Src to Dst as the adjacency of
+	// each node.
+	Graph struct {
+		Kind string `json:"kind" unpack:""`
+		Src  Expr   `json:"src"`
+		Dst  Expr   `json:"dst"`
+		Loc  `json:"loc"`
+	}
 	Shape struct {
 		Kind string `json:"kind" unpack:""`
 		Loc  `json:"loc"`
@@ -615,6 +654,11 @@ type (
 		Name *ID    `json:"name"`
 		Loc  `json:"loc"`
 	}
+	Into struct {
+		Kind string `json:"kind" unpack:""`
+		Temp *Temp  `json:"temp"`
+		Loc  `json:"loc"`
+	}
 	Debug struct {
 		Kind string `json:"kind" unpack:""`
 		Expr Expr   `json:"expr"`
@@ -649,13 +693,24 @@ type (
 )
 
 type PoolArgs struct {
-	Kind   string `json:"kind" unpack:""`
-	Commit *Name  `json:"commit"`
-	Meta   *Name  `json:"meta"`
-	Tap    bool   `json:"tap"`
+	Kind   string     `json:"kind" unpack:""`
+	Commit *Name      `json:"commit"`
+	AsOf   *Primitive `json:"as_of"`
+	Meta   *Name      `json:"meta"`
+	Range  *TimeRange `json:"range"`
+	Tap    bool       `json:"tap"`
 	Loc    `json:"loc"`
 }
 
+// TimeRange is the half-open interval [Lower, Upper) of a pool's default
+// time field.
+type TimeRange struct {
+	Kind  string     `json:"kind" unpack:""`
+	Lower *Primitive `json:"lower"`
+	Upper *Primitive `json:"upper"`
+	Loc   `json:"loc"`
+}
+
 type FormatArg struct {
 	Kind   string `json:"kind" unpack:""`
 	Format *Name  `json:"format"`
@@ -681,11 +736,12 @@ func (*FormatArg) fromArgs() {}
 func (*HTTPArgs) fromArgs()  {}
 
 type SortExpr struct {
-	Kind  string `json:"kind" unpack:""`
-	Expr  Expr   `json:"expr"`
-	Order *ID    `json:"order"`
-	Nulls *ID    `json:"nulls"`
-	Loc   `json:"loc"`
+	Kind    string `json:"kind" unpack:""`
+	Expr    Expr   `json:"expr"`
+	Collate *ID    `json:"collate"`
+	Order   *ID    `json:"order"`
+	Nulls   *ID    `json:"nulls"`
+	Loc     `json:"loc"`
 }
 
 type Case struct {
@@ -742,9 +798,12 @@ func (*Search) OpAST()       {}
 func (*Where) OpAST()        {}
 func (*Yield) OpAST()        {}
 func (*Sample) OpAST()       {}
+func (*Graph) OpAST()        {}
+func (*Sequence) OpAST()     {}
 func (*Load) OpAST()         {}
 func (*Assert) OpAST()       {}
 func (*Output) OpAST()       {}
+func (*Into) OpAST()         {}
 func (*Debug) OpAST()        {}
 func (*Distinct) OpAST()     {}
 func (*Delete) OpAST()       {}
@@ -760,6 +819,7 @@ type Agg struct {
 	Name     string `json:"name"`
 	Distinct bool   `json:"distinct"`
 	Expr     Expr   `json:"expr"`
+	Params   []Expr `json:"params"`
 	Where    Expr   `json:"where"`
 	Loc      `json:"loc"`
 }
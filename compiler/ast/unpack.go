@@ -48,6 +48,7 @@ var unpacker = unpack.New(
 	ImpliedValue{},
 	IndexExpr{},
 	IsNullExpr{},
+	Into{},
 	Join{},
 	Load{},
 	Merge{},
@@ -81,6 +82,7 @@ var unpacker = unpack.New(
 	Switch{},
 	Tail{},
 	Term{},
+	TimeRange{},
 	Top{},
 	TypeArray{},
 	TypeDef{},
@@ -88,6 +90,8 @@ var unpacker = unpack.New(
 	TypeEnum{},
 	TypeError{},
 	TypeMap{},
+	Temp{},
+	Generate{},
 	TypeName{},
 	TypeNull{},
 	TypePrimitive{},
@@ -101,6 +105,8 @@ var unpacker = unpack.New(
 	Where{},
 	Yield{},
 	Sample{},
+	Graph{},
+	Sequence{},
 	Delete{},
 	LakeMeta{},
 	// SuperSQL
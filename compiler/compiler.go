@@ -11,6 +11,7 @@ import (
 	"github.com/brimdata/super/pkg/storage"
 	"github.com/brimdata/super/runtime"
 	"github.com/brimdata/super/runtime/exec"
+	"github.com/brimdata/super/runtime/sam/op/meta"
 	"github.com/brimdata/super/zio"
 )
 
@@ -31,6 +32,20 @@ func NewLakeCompiler(lk *lake.Root) runtime.Compiler {
 	return &compiler{env: exec.NewEnvironment(storage.NewRemoteEngine(), lk)}
 }
 
+// NewLakeCompilerWithPartitionCache is like NewLakeCompiler but shares
+// cache among the queries it compiles.
+func NewLakeCompilerWithPartitionCache(lk *lake.Root, cache *meta.PartitionCache) runtime.Compiler {
+	env := exec.NewEnvironment(storage.NewRemoteEngine(), lk)
+	env.SetPartitionCache(cache)
+	return &compiler{env: env}
+}
+
+// NewCompilerWithEnvironment returns a compiler that compiles queries
+// with env.
+func NewCompilerWithEnvironment(env *exec.Environment) runtime.Compiler {
+	return &compiler{env: env}
+}
+
 func (c *compiler) NewQuery(rctx *runtime.Context, ast *parser.AST, readers []zio.Reader, parallelism int) (runtime.Query, error) {
 	if parallelism == 0 {
 		parallelism = Parallelism
@@ -22,6 +22,7 @@ type (
 		Name     string `json:"name"`
 		Distinct bool   `json:"distinct"`
 		Expr     Expr   `json:"expr"`
+		Params   []Expr `json:"params,omitempty"`
 		Where    Expr   `json:"where"`
 	}
 	ArrayExpr struct {
@@ -120,9 +121,10 @@ type (
 		To   Expr   `json:"to"`
 	}
 	SortExpr struct {
-		Key   Expr        `json:"key"`
-		Order order.Which `json:"order"`
-		Nulls order.Nulls `json:"nulls"`
+		Key       Expr        `json:"key"`
+		Order     order.Which `json:"order"`
+		Nulls     order.Nulls `json:"nulls"`
+		Collation string      `json:"collation,omitempty"`
 	}
 	This struct {
 		Kind string   `json:"kind" unpack:""`
@@ -13,6 +13,7 @@ import (
 
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/pkg/nano"
 	"github.com/segmentio/ksuid"
 )
 
@@ -35,6 +36,29 @@ type (
 		InputSortDir int          `json:"input_sort_dir,omitempty"`
 		PartialsIn   bool         `json:"partials_in,omitempty"`
 		PartialsOut  bool         `json:"partials_out,omitempty"`
+		// TopLimit, if nonzero, limits the output to the first TopLimit
+		// values that would be produced by sorting it by TopExprs.
+		TopLimit int        `json:"top_limit,omitempty"`
+		TopExprs []SortExpr `json:"top_exprs,omitempty"`
+		// OutputSort, if not empty, orders the output by Keys, with
+		// OutputSort[i] giving the order of the values of Keys[i].
+		OutputSort []SortExpr `json:"output_sort,omitempty"`
+		// Lateness, if nonzero, is the duration by which the time of the
+		// first key of the input may lag the latest seen.  Groups whose
+		// first key is earlier than the latest less Lateness are output
+		// before EOS and later values in them are dropped.
+		Lateness nano.Duration `json:"lateness,omitempty"`
+		// Combiner, which requires PartialsOut, bounds the table of
+		// groups and outputs its partial results whenever it is full
+		// rather than spilling it, so that the aggregation reduces the
+		// data passed to a downstream aggregation without holding all of
+		// the groups.
+		Combiner bool `json:"combiner,omitempty"`
+		// Emit, if not empty, replaces Aggs in the output, which then
+		// holds Keys followed by Emit.  Emit is evaluated over a record
+		// holding Keys followed by Aggs when each group is output (but
+		// not when PartialsOut is set).
+		Emit []Assignment `json:"emit,omitempty"`
 	}
 	// A BadOp node is a placeholder for an expression containing semantic
 	// errors.
@@ -84,7 +108,17 @@ type (
 		LeftDir  order.Direction `json:"left_dir"`
 		RightKey Expr            `json:"right_key"`
 		RightDir order.Direction `json:"right_dir"`
-		Args     []Assignment    `json:"args"`
+		// Tolerance bounds the distance between the keys matched by an
+		// asof join.
+		Tolerance Expr         `json:"tolerance"`
+		Args      []Assignment `json:"args"`
+		// KeySet names the set of left keys that a semi join publishes
+		// to the Lister of its right input for pruning.
+		KeySet string `json:"key_set"`
+	}
+	Into struct {
+		Kind string `json:"kind" unpack:""`
+		Temp string `json:"temp"`
 	}
 	Load struct {
 		Kind    string      `json:"kind" unpack:""`
@@ -156,6 +190,18 @@ type (
 		Kind  string `json:"kind" unpack:""`
 		Count int    `json:"count"`
 	}
+	// Sequence outputs a record for each match of Steps in order among
+	// the values with the same Keys, where the value of Time for the
+	// match of the last step is no more than Window later than that for
+	// the first.  The record holds Keys followed by the value of Time for
+	// the match of each step.
+	Sequence struct {
+		Kind   string        `json:"kind" unpack:""`
+		Steps  []Assignment  `json:"steps"`
+		Keys   []Assignment  `json:"keys"`
+		Window nano.Duration `json:"window"`
+		Time   Expr          `json:"time"`
+	}
 	Top struct {
 		Kind    string     `json:"kind" unpack:""`
 		Limit   int        `json:"limit"`
@@ -185,6 +231,12 @@ type (
 		Pool      ksuid.KSUID `json:"pool"`
 		Commit    ksuid.KSUID `json:"commit"`
 		KeyPruner Expr        `json:"key_pruner"`
+		Unordered bool        `json:"unordered"`
+		Reverse   bool        `json:"reverse"`
+		// KeySet names the set of keys published by a semi join whose
+		// right input is this scan.  Objects whose key ranges contain
+		// none of the keys are pruned.
+		KeySet string `json:"key_set"`
 	}
 	Slicer struct {
 		Kind string `json:"kind" unpack:""`
@@ -196,6 +248,7 @@ type (
 		Fields    []field.Path `json:"fields"`
 		Filter    Expr         `json:"filter"`
 		KeyPruner Expr         `json:"key_pruner"`
+		Reverse   bool         `json:"reverse"`
 	}
 	Deleter struct {
 		Kind      string      `json:"kind" unpack:""`
@@ -241,6 +294,14 @@ type (
 		ID     ksuid.KSUID `json:"id"`
 		Commit ksuid.KSUID `json:"commit"`
 	}
+	// SummaryScan reads the partial results of a pool's Summary'th summary
+	// aggregation for each data object in Commit.
+	SummaryScan struct {
+		Kind    string      `json:"kind" unpack:""`
+		Pool    ksuid.KSUID `json:"pool"`
+		Commit  ksuid.KSUID `json:"commit"`
+		Summary int         `json:"summary"`
+	}
 	RobotScan struct {
 		Kind   string `json:"kind" unpack:""`
 		Expr   Expr   `json:"expr"`
@@ -265,6 +326,10 @@ type (
 		Tap       bool        `json:"tap"`
 		KeyPruner Expr        `json:"key_pruner"`
 	}
+	GenerateScan struct {
+		Kind string `json:"kind" unpack:""`
+		Spec Expr   `json:"spec"`
+	}
 	LakeMetaScan struct {
 		Kind string `json:"kind" unpack:""`
 		Meta string `json:"meta"`
@@ -272,11 +337,16 @@ type (
 	NullScan struct {
 		Kind string `json:"kind" unpack:""`
 	}
+	TempScan struct {
+		Kind string `json:"kind" unpack:""`
+		Name string `json:"name"`
+	}
 )
 
 var LakeMetas = map[string]struct{}{
-	"branches": {},
-	"pools":    {},
+	"branches":  {},
+	"externals": {},
+	"pools":     {},
 }
 
 var PoolMetas = map[string]struct{}{
@@ -286,6 +356,7 @@ var PoolMetas = map[string]struct{}{
 var CommitMetas = map[string]struct{}{
 	"log":        {},
 	"objects":    {},
+	"overlaps":   {},
 	"partitions": {},
 	"rawlog":     {},
 	"vectors":    {},
@@ -295,12 +366,15 @@ func (*DefaultScan) OpNode()    {}
 func (*FileScan) OpNode()       {}
 func (*HTTPScan) OpNode()       {}
 func (*PoolScan) OpNode()       {}
+func (*SummaryScan) OpNode()    {}
 func (*RobotScan) OpNode()      {}
 func (*DeleteScan) OpNode()     {}
+func (*GenerateScan) OpNode()   {}
 func (*LakeMetaScan) OpNode()   {}
 func (*PoolMetaScan) OpNode()   {}
 func (*CommitMetaScan) OpNode() {}
 func (*NullScan) OpNode()       {}
+func (*TempScan) OpNode()       {}
 
 func (*Lister) OpNode()  {}
 func (*Slicer) OpNode()  {}
@@ -340,6 +414,7 @@ func (*Skip) OpNode()      {}
 func (*Pass) OpNode()      {}
 func (*Filter) OpNode()    {}
 func (*Uniq) OpNode()      {}
+func (*Sequence) OpNode()  {}
 func (*Top) OpNode()       {}
 func (*Put) OpNode()       {}
 func (*Rename) OpNode()    {}
@@ -354,6 +429,7 @@ func (*Merge) OpNode()     {}
 func (*Mirror) OpNode()    {}
 func (*Combine) OpNode()   {}
 func (*Scope) OpNode()     {}
+func (*Into) OpNode()      {}
 func (*Load) OpNode()      {}
 func (*Output) OpNode()    {}
 
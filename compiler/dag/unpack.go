@@ -31,10 +31,12 @@ var unpacker = unpack.New(
 	Fork{},
 	Func{},
 	Fuse{},
+	GenerateScan{},
 	Head{},
 	HTTPScan{},
 	IndexExpr{},
 	IsNullExpr{},
+	Into{},
 	Join{},
 	LakeMetaScan{},
 	Lister{},
@@ -60,6 +62,7 @@ var unpacker = unpack.New(
 	Scope{},
 	Search{},
 	SeqScan{},
+	Sequence{},
 	SetExpr{},
 	Shape{},
 	Skip{},
@@ -67,8 +70,10 @@ var unpacker = unpack.New(
 	Slicer{},
 	Sort{},
 	Spread{},
+	SummaryScan{},
 	Switch{},
 	Tail{},
+	TempScan{},
 	This{},
 	Top{},
 	UnaryExpr{},
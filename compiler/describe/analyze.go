@@ -52,6 +52,7 @@ type Channel struct {
 	Name            string         `json:"name"`
 	AggregationKeys field.List     `json:"aggregation_keys"`
 	Sort            order.SortKeys `json:"sort"`
+	Types           []Type         `json:"types"`
 }
 
 func Analyze(ctx context.Context, query string, src *exec.Environment) (*Info, error) {
@@ -78,6 +79,7 @@ func AnalyzeDAG(ctx context.Context, entry dag.Seq, src *exec.Environment) (*Inf
 	}
 	aggKeys := describeAggs(entry, []field.List{nil})
 	outputs := collectOutputs(entry)
+	types := inferTypes(entry)
 	m := make(map[string]int)
 	for i, s := range sortKeys {
 		name := outputs[i].Name
@@ -93,8 +95,9 @@ func AnalyzeDAG(ctx context.Context, entry dag.Seq, src *exec.Environment) (*Inf
 			Name:            name,
 			Sort:            s,
 			AggregationKeys: aggKeys[i],
+			Types:           exportTypes(types[name]),
 		})
-		m[name] = i
+		m[name] = len(info.Channels) - 1
 	}
 	return &info, nil
 }
@@ -129,6 +132,8 @@ func describeSources(ctx context.Context, lk *lake.Root, o dag.Op) ([]Source, er
 		return sourceOfPool(ctx, lk, o.Pool)
 	case *dag.CommitMetaScan:
 		return sourceOfPool(ctx, lk, o.Pool)
+	case *dag.SummaryScan:
+		return sourceOfPool(ctx, lk, o.Pool)
 	case *dag.LakeMetaScan:
 		return []Source{&LakeMeta{Kind: "LakeMeta", Meta: o.Meta}}, nil
 	default:
@@ -0,0 +1,583 @@
+package describe
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/compiler/dag"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/sup"
+)
+
+// A Type is the type of the values sent to a channel, inferred from a query
+// without running it.  Type is the SUP text of the type or "unknown" if the
+// type depends on the data read by the query.  If the type is a record,
+// Fields lists its leaf fields, which may be known even when the record
+// type as a whole is not.
+type Type struct {
+	Type   string  `json:"type"`
+	Fields []Field `json:"fields"`
+}
+
+type Field struct {
+	Name field.Path `json:"name"`
+	Type string     `json:"type"`
+}
+
+// vtype is the inferred type of a value.  Kind is "record", "array", "set",
+// or "union" for the complex types described by Fields, Elem, or Types;
+// "unknown" for a type that depends on the data read by the query; and
+// otherwise the SUP text of a primitive type.
+type vtype struct {
+	Kind   string
+	Fields []vfield
+	Elem   *vtype
+	Types  []*vtype
+}
+
+type vfield struct {
+	Name string
+	Type *vtype
+}
+
+var unknown = &vtype{Kind: "unknown"}
+
+func (t *vtype) isUnknown() bool {
+	return t.Kind == "unknown"
+}
+
+func (t *vtype) isKnown() bool {
+	switch t.Kind {
+	case "unknown":
+		return false
+	case "record":
+		for _, f := range t.Fields {
+			if !f.Type.isKnown() {
+				return false
+			}
+		}
+	case "array", "set":
+		return t.Elem.isKnown()
+	case "union":
+		for _, u := range t.Types {
+			if !u.isKnown() {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (t *vtype) field(name string) *vtype {
+	for _, f := range t.Fields {
+		if f.Name == name {
+			return f.Type
+		}
+	}
+	return nil
+}
+
+// key returns a string that uniquely identifies t.  It is the SUP text of t
+// if t is known.
+func (t *vtype) key() string {
+	var b strings.Builder
+	t.build(&b)
+	return b.String()
+}
+
+func (t *vtype) build(b *strings.Builder) {
+	switch t.Kind {
+	case "record":
+		b.WriteByte('{')
+		for i, f := range t.Fields {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(sup.QuotedName(f.Name))
+			b.WriteByte(':')
+			f.Type.build(b)
+		}
+		b.WriteByte('}')
+	case "array":
+		b.WriteByte('[')
+		t.Elem.build(b)
+		b.WriteByte(']')
+	case "set":
+		b.WriteString("|[")
+		t.Elem.build(b)
+		b.WriteString("]|")
+	case "union":
+		b.WriteByte('(')
+		for i, u := range t.Types {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			u.build(b)
+		}
+		b.WriteByte(')')
+	default:
+		b.WriteString(t.Kind)
+	}
+}
+
+func (t *vtype) format() string {
+	if !t.isKnown() {
+		return "unknown"
+	}
+	return t.key()
+}
+
+// export converts t to a Type.
+func (t *vtype) export() Type {
+	out := Type{Type: t.format()}
+	if t.Kind == "record" {
+		out.Fields = t.leaves(nil, nil)
+	}
+	return out
+}
+
+func (t *vtype) leaves(path field.Path, fields []Field) []Field {
+	for _, f := range t.Fields {
+		p := append(slices.Clone(path), f.Name)
+		if f.Type.Kind == "record" && len(f.Type.Fields) > 0 {
+			fields = f.Type.leaves(p, fields)
+		} else {
+			fields = append(fields, Field{Name: p, Type: f.Type.format()})
+		}
+	}
+	return fields
+}
+
+func exportTypes(types []*vtype) []Type {
+	var out []Type
+	for _, t := range types {
+		out = append(out, t.export())
+	}
+	return out
+}
+
+func typeOf(typ super.Type) *vtype {
+	switch typ := typ.(type) {
+	case *super.TypeNamed:
+		return typeOf(typ.Type)
+	case *super.TypeRecord:
+		rec := &vtype{Kind: "record"}
+		for _, f := range typ.Fields {
+			rec.Fields = append(rec.Fields, vfield{f.Name, typeOf(f.Type)})
+		}
+		return rec
+	case *super.TypeArray:
+		return &vtype{Kind: "array", Elem: typeOf(typ.Type)}
+	case *super.TypeSet:
+		return &vtype{Kind: "set", Elem: typeOf(typ.Type)}
+	case *super.TypeUnion:
+		u := &vtype{Kind: "union"}
+		for _, t := range typ.Types {
+			u.Types = append(u.Types, typeOf(t))
+		}
+		return u
+	default:
+		return &vtype{Kind: sup.FormatType(typ)}
+	}
+}
+
+var (
+	typeBool    = typeOf(super.TypeBool)
+	typeFloat64 = typeOf(super.TypeFloat64)
+	typeInt64   = typeOf(super.TypeInt64)
+	typeString  = typeOf(super.TypeString)
+	typeTime    = typeOf(super.TypeTime)
+	typeType    = typeOf(super.TypeType)
+	typeUint64  = typeOf(super.TypeUint64)
+	typeMissing = typeOf(super.NewContext().LookupTypeError(super.TypeString))
+)
+
+// unionOf returns the type of a value that may be of any type in types.
+func unionOf(types ...*vtype) *vtype {
+	types = dedupe(types)
+	if len(types) == 1 {
+		return types[0]
+	}
+	if slices.ContainsFunc(types, (*vtype).isUnknown) {
+		return unknown
+	}
+	return &vtype{Kind: "union", Types: types}
+}
+
+func dedupe(types []*vtype) []*vtype {
+	var out []*vtype
+	seen := make(map[string]struct{})
+	for _, t := range types {
+		k := t.key()
+		if _, ok := seen[k]; !ok {
+			seen[k] = struct{}{}
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+// withField returns a copy of the record type rec with the field at path
+// set to typ.
+func withField(rec *vtype, path []string, typ *vtype) *vtype {
+	if len(path) == 0 {
+		return typ
+	}
+	out := &vtype{Kind: "record", Fields: slices.Clone(rec.Fields)}
+	for i, f := range out.Fields {
+		if f.Name == path[0] {
+			inner := f.Type
+			if inner.Kind != "record" {
+				inner = &vtype{Kind: "record"}
+			}
+			out.Fields[i].Type = withField(inner, path[1:], typ)
+			return out
+		}
+	}
+	out.Fields = append(out.Fields, vfield{path[0], withField(&vtype{Kind: "record"}, path[1:], typ)})
+	return out
+}
+
+func withoutField(rec *vtype, path []string) *vtype {
+	out := &vtype{Kind: "record"}
+	for _, f := range rec.Fields {
+		if f.Name != path[0] {
+			out.Fields = append(out.Fields, f)
+		} else if len(path) > 1 && f.Type.Kind == "record" {
+			out.Fields = append(out.Fields, vfield{f.Name, withoutField(f.Type, path[1:])})
+		}
+	}
+	return out
+}
+
+func lookupPath(typ *vtype, path []string) *vtype {
+	for _, name := range path {
+		if typ.Kind != "record" {
+			return unknown
+		}
+		if typ = typ.field(name); typ == nil {
+			// Missing fields evaluate to error("missing").
+			return typeMissing
+		}
+	}
+	return typ
+}
+
+// typer infers the types of the values sent to each output of a DAG.
+type typer struct {
+	sctx    *super.Context
+	outputs map[string][]*vtype
+}
+
+// inferTypes returns the possible types of the values sent to each named
+// output of seq.
+func inferTypes(seq dag.Seq) map[string][]*vtype {
+	t := &typer{
+		sctx:    super.NewContext(),
+		outputs: make(map[string][]*vtype),
+	}
+	t.seq(seq, nil)
+	return t.outputs
+}
+
+// seq returns the possible types of the output of seq given the possible
+// types in of its input.
+func (t *typer) seq(seq dag.Seq, in []*vtype) []*vtype {
+	for _, op := range seq {
+		in = t.op(op, in)
+	}
+	return in
+}
+
+func (t *typer) paths(paths []dag.Seq, in []*vtype) []*vtype {
+	var out []*vtype
+	for _, p := range paths {
+		out = append(out, t.seq(p, in)...)
+	}
+	return dedupe(out)
+}
+
+func (t *typer) op(op dag.Op, in []*vtype) []*vtype {
+	switch op := op.(type) {
+	case *dag.NullScan:
+		return []*vtype{typeOf(super.TypeNull)}
+	case *dag.CommitMetaScan, *dag.DefaultScan, *dag.DeleteScan, *dag.FileScan, *dag.GenerateScan, *dag.HTTPScan, *dag.LakeMetaScan, *dag.Lister, *dag.PoolMetaScan, *dag.PoolScan, *dag.RobotScan, *dag.SeqScan, *dag.SummaryScan, *dag.TempScan:
+		return []*vtype{unknown}
+	case *dag.Filter, *dag.Head, *dag.Merge, *dag.Pass, *dag.Skip, *dag.Slicer, *dag.Sort, *dag.Tail, *dag.Top, *dag.Uniq:
+		return in
+	case *dag.Output:
+		t.outputs[op.Name] = dedupe(append(t.outputs[op.Name], in...))
+		return in
+	case *dag.Into:
+		return nil
+	case *dag.Scope:
+		return t.seq(op.Body, in)
+	case *dag.Vectorize:
+		return t.seq(op.Body, in)
+	case *dag.Fork:
+		return t.paths(op.Paths, in)
+	case *dag.Scatter:
+		return t.paths(op.Paths, in)
+	case *dag.Mirror:
+		return t.paths([]dag.Seq{op.Main, op.Mirror}, in)
+	case *dag.Switch:
+		var paths []dag.Seq
+		for _, c := range op.Cases {
+			paths = append(paths, c.Path)
+		}
+		return t.paths(paths, in)
+	case *dag.Aggregate:
+		rec := &vtype{Kind: "record"}
+		for _, a := range op.Keys {
+			rec = t.assign(rec, a, in)
+		}
+		for _, a := range op.Aggs {
+			rec = t.assign(rec, a, in)
+		}
+		if len(op.Emit) != 0 {
+			groups := []*vtype{rec}
+			rec = &vtype{Kind: "record"}
+			for _, a := range op.Keys {
+				rec = t.assign(rec, dag.Assignment{Kind: "Assignment", LHS: a.LHS, RHS: a.LHS}, groups)
+			}
+			for _, a := range op.Emit {
+				rec = t.assign(rec, a, groups)
+			}
+		}
+		return []*vtype{rec}
+	case *dag.Cut:
+		return t.mapRecords(in, func(this *vtype) *vtype {
+			rec := &vtype{Kind: "record"}
+			for _, a := range op.Args {
+				rec = t.assign(rec, a, []*vtype{this})
+			}
+			return rec
+		})
+	case *dag.Put:
+		return t.mapRecords(in, func(this *vtype) *vtype {
+			if this.Kind != "record" {
+				return unknown
+			}
+			rec := this
+			for _, a := range op.Args {
+				rec = t.assign(rec, a, []*vtype{this})
+			}
+			return rec
+		})
+	case *dag.Drop:
+		return t.mapRecords(in, func(this *vtype) *vtype {
+			if this.Kind != "record" {
+				return unknown
+			}
+			for _, e := range op.Args {
+				this2, ok := e.(*dag.This)
+				if !ok || len(this2.Path) == 0 {
+					return unknown
+				}
+				this = withoutField(this, this2.Path)
+			}
+			return this
+		})
+	case *dag.Rename:
+		return t.mapRecords(in, func(this *vtype) *vtype {
+			if this.Kind != "record" {
+				return unknown
+			}
+			for _, a := range op.Args {
+				lhs, ok1 := a.LHS.(*dag.This)
+				rhs, ok2 := a.RHS.(*dag.This)
+				if !ok1 || !ok2 || len(lhs.Path) == 0 || len(rhs.Path) == 0 {
+					return unknown
+				}
+				typ := lookupPath(this, rhs.Path)
+				this = withField(withoutField(this, rhs.Path), lhs.Path, typ)
+			}
+			return this
+		})
+	case *dag.Yield:
+		var out []*vtype
+		for _, this := range in {
+			for _, e := range op.Exprs {
+				out = append(out, t.expr(e, this))
+			}
+		}
+		return dedupe(out)
+	default:
+		return []*vtype{unknown}
+	}
+}
+
+func (t *typer) mapRecords(in []*vtype, f func(*vtype) *vtype) []*vtype {
+	var out []*vtype
+	for _, this := range in {
+		out = append(out, f(this))
+	}
+	return dedupe(out)
+}
+
+// assign returns rec with the field assigned by a, whose right-hand side is
+// evaluated over values of the types in.
+func (t *typer) assign(rec *vtype, a dag.Assignment, in []*vtype) *vtype {
+	lhs, ok := a.LHS.(*dag.This)
+	if !ok {
+		return unknown
+	}
+	var types []*vtype
+	for _, this := range in {
+		types = append(types, t.expr(a.RHS, this))
+	}
+	if len(types) == 0 {
+		types = append(types, unknown)
+	}
+	return withField(rec, lhs.Path, unionOf(types...))
+}
+
+// expr returns the type of e evaluated over a value of type this.
+func (t *typer) expr(e dag.Expr, this *vtype) *vtype {
+	switch e := e.(type) {
+	case *dag.Literal:
+		val, err := sup.ParseValue(t.sctx, e.Value)
+		if err != nil {
+			return unknown
+		}
+		return typeOf(val.Type())
+	case *dag.This:
+		if this.isUnknown() {
+			return unknown
+		}
+		return lookupPath(this, e.Path)
+	case *dag.Dot:
+		return lookupPath(t.expr(e.LHS, this), []string{e.RHS})
+	case *dag.RecordExpr:
+		rec := &vtype{Kind: "record"}
+		for _, elem := range e.Elems {
+			switch elem := elem.(type) {
+			case *dag.Field:
+				rec = withField(rec, []string{elem.Name}, t.expr(elem.Value, this))
+			case *dag.Spread:
+				spread := t.expr(elem.Expr, this)
+				if spread.Kind != "record" {
+					return unknown
+				}
+				for _, f := range spread.Fields {
+					rec = withField(rec, []string{f.Name}, f.Type)
+				}
+			default:
+				return unknown
+			}
+		}
+		return rec
+	case *dag.ArrayExpr:
+		return t.vector("array", e.Elems, this)
+	case *dag.SetExpr:
+		return t.vector("set", e.Elems, this)
+	case *dag.BinaryExpr:
+		switch e.Op {
+		case "==", "!=", "<", "<=", ">", ">=", "and", "or", "in", "like":
+			return typeBool
+		case "+", "-", "*", "/", "%":
+			lhs, rhs := t.expr(e.LHS, this), t.expr(e.RHS, this)
+			if !lhs.isUnknown() && lhs.key() == rhs.key() {
+				return lhs
+			}
+		}
+		return unknown
+	case *dag.UnaryExpr:
+		if e.Op == "!" {
+			return typeBool
+		}
+		return t.expr(e.Operand, this)
+	case *dag.IsNullExpr, *dag.RegexpMatch, *dag.RegexpSearch, *dag.Search:
+		return typeBool
+	case *dag.Conditional:
+		return unionOf(t.expr(e.Then, this), t.expr(e.Else, this))
+	case *dag.Agg:
+		return t.agg(e, this)
+	case *dag.Call:
+		return t.call(e, this)
+	default:
+		return unknown
+	}
+}
+
+func (t *typer) vector(kind string, elems []dag.VectorElem, this *vtype) *vtype {
+	var types []*vtype
+	for _, elem := range elems {
+		v, ok := elem.(*dag.VectorValue)
+		if !ok {
+			return unknown
+		}
+		types = append(types, t.expr(v.Expr, this))
+	}
+	if len(types) == 0 {
+		types = append(types, typeOf(super.TypeNull))
+	}
+	return &vtype{Kind: kind, Elem: unionOf(types...)}
+}
+
+func (t *typer) agg(a *dag.Agg, this *vtype) *vtype {
+	switch a.Name {
+	case "approx_count_distinct", "count", "dcount":
+		return typeUint64
+	case "avg", "increase", "mad", "median", "percentile", "rate", "time_weighted_avg", "unique_rate":
+		return typeFloat64
+	case "and", "or":
+		return typeBool
+	case "any", "first", "last", "max", "min", "sum":
+		return t.expr(a.Expr, this)
+	case "collect":
+		return &vtype{Kind: "array", Elem: t.expr(a.Expr, this)}
+	case "union":
+		return &vtype{Kind: "set", Elem: t.expr(a.Expr, this)}
+	case "approx_histogram":
+		return &vtype{Kind: "array", Elem: &vtype{Kind: "record", Fields: []vfield{
+			{Name: "value", Type: typeFloat64},
+			{Name: "count", Type: typeUint64},
+		}}}
+	case "histogram":
+		return &vtype{Kind: "array", Elem: &vtype{Kind: "record", Fields: []vfield{
+			{Name: "lo", Type: typeFloat64},
+			{Name: "hi", Type: typeFloat64},
+			{Name: "count", Type: typeUint64},
+		}}}
+	case "approx_top":
+		return &vtype{Kind: "array", Elem: &vtype{Kind: "record", Fields: []vfield{
+			{Name: "value", Type: t.expr(a.Expr, this)},
+			{Name: "count", Type: typeUint64},
+		}}}
+	default:
+		return unknown
+	}
+}
+
+func (t *typer) call(c *dag.Call, this *vtype) *vtype {
+	switch c.Name {
+	case "cast":
+		if len(c.Args) == 2 {
+			if lit, ok := c.Args[1].(*dag.Literal); ok {
+				val, err := sup.ParseValue(t.sctx, lit.Value)
+				if err == nil && val.Type() == super.TypeType {
+					if typ, err := t.sctx.LookupByValue(val.Bytes()); err == nil {
+						return typeOf(typ)
+					}
+				}
+			}
+		}
+		return unknown
+	case "has", "is", "is_error", "missing":
+		return typeBool
+	case "len":
+		return typeInt64
+	case "join", "lower", "nameof", "regexp_replace", "replace", "strftime", "trim", "upper":
+		return typeString
+	case "log", "pow", "sqrt":
+		return typeFloat64
+	case "now":
+		return typeTime
+	case "typeof":
+		return typeType
+	default:
+		return unknown
+	}
+}
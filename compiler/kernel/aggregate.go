@@ -4,15 +4,18 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/brimdata/super"
 	"github.com/brimdata/super/compiler/dag"
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/pkg/field"
 	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
 	"github.com/brimdata/super/runtime/sam/op/aggregate"
+	"github.com/brimdata/super/sup"
 	"github.com/brimdata/super/zbuf"
 )
 
-func (b *Builder) compileAggregate(parent zbuf.Puller, a *dag.Aggregate) (*aggregate.Op, error) {
+func (b *Builder) compileAggregate(parent zbuf.Puller, a *dag.Aggregate) (zbuf.Puller, error) {
 	b.resetResetters()
 	keys, err := b.compileAssignments(a.Keys)
 	if err != nil {
@@ -22,8 +25,58 @@ func (b *Builder) compileAggregate(parent zbuf.Puller, a *dag.Aggregate) (*aggre
 	if err != nil {
 		return nil, err
 	}
+	var emit []expr.Assignment
+	if len(a.Emit) != 0 && !a.PartialsOut {
+		emit, err = b.compileAssignments(a.Emit)
+		if err != nil {
+			return nil, err
+		}
+	}
 	dir := order.Direction(a.InputSortDir)
-	return aggregate.New(b.rctx, parent, keys, names, reducers, a.Limit, dir, a.PartialsIn, a.PartialsOut, b.resetters)
+	if a.Combiner && a.PartialsOut && dir == 0 && a.Lateness == 0 {
+		return aggregate.NewCombiner(b.rctx, parent, keys, names, reducers, b.resetters)
+	}
+	var top *aggregate.Top
+	if a.TopLimit > 0 && !a.PartialsOut {
+		exprs, err := b.compileSortExprs(a.TopExprs)
+		if err != nil {
+			return nil, err
+		}
+		top = &aggregate.Top{Limit: a.TopLimit, Exprs: exprs}
+	}
+	var sortOut []aggregate.KeyOrder
+	for _, e := range a.OutputSort {
+		sortOut = append(sortOut, aggregate.KeyOrder{Order: e.Order, Nulls: e.Nulls})
+	}
+	if n := aggregate.Concurrency; n > 1 && dir == 0 && a.Lateness == 0 && len(keys) > 0 {
+		// Evaluators aren't safe for concurrent use so compile the
+		// keys and aggregations anew for each shard.
+		shards := []aggregate.Shard{{Keys: keys, Aggs: reducers, Emit: emit}}
+		for len(shards) < n {
+			keys, err := b.compileAssignments(a.Keys)
+			if err != nil {
+				return nil, err
+			}
+			_, reducers, err := b.compileAggAssignments(a.Aggs)
+			if err != nil {
+				return nil, err
+			}
+			var emit []expr.Assignment
+			if len(a.Emit) != 0 && !a.PartialsOut {
+				emit, err = b.compileAssignments(a.Emit)
+				if err != nil {
+					return nil, err
+				}
+			}
+			shards = append(shards, aggregate.Shard{Keys: keys, Aggs: reducers, Emit: emit})
+		}
+		router, err := b.compileAssignments(a.Keys)
+		if err != nil {
+			return nil, err
+		}
+		return aggregate.NewSharded(b.rctx, parent, router, shards, names, a.Limit, a.PartialsIn, a.PartialsOut, top, sortOut, b.resetters)
+	}
+	return aggregate.NewWithLateness(b.rctx, parent, keys, names, reducers, emit, a.Limit, dir, a.Lateness, a.PartialsIn, a.PartialsOut, top, sortOut, b.resetters)
 }
 
 func (b *Builder) compileAggAssignments(assignments []dag.Assignment) (field.List, []*expr.Aggregator, error) {
@@ -70,5 +123,27 @@ func (b *Builder) compileAgg(agg *dag.Agg) (*expr.Aggregator, error) {
 			return nil, err
 		}
 	}
-	return expr.NewAggregator(name, agg.Distinct, arg, where)
+	params, err := b.evalAggParams(agg)
+	if err != nil {
+		return nil, err
+	}
+	return expr.NewAggregator(name, agg.Distinct, arg, where, params...)
+}
+
+// evalAggParams evaluates the constant parameters of agg, e.g., the
+// percentage of percentile.
+func (b *Builder) evalAggParams(agg *dag.Agg) ([]float64, error) {
+	var params []float64
+	for _, e := range agg.Params {
+		val, err := EvalAtCompileTime(b.sctx(), e)
+		if err != nil {
+			return nil, err
+		}
+		f, ok := coerce.ToFloat(val, super.TypeFloat64)
+		if !ok {
+			return nil, fmt.Errorf("%s: parameter is not a number: %s", agg.Name, sup.FormatValue(val))
+		}
+		params = append(params, f)
+	}
+	return params, nil
 }
@@ -551,7 +551,11 @@ func (b *Builder) compileSortExprs(sortExprs []dag.SortExpr) ([]expr.SortExpr, e
 		if err != nil {
 			return nil, err
 		}
-		out = append(out, expr.NewSortExpr(e, se.Order, se.Nulls))
+		s := expr.NewSortExpr(e, se.Order, se.Nulls)
+		if s.Collator, err = expr.NewCollator(se.Collation); err != nil {
+			return nil, err
+		}
+		out = append(out, s)
 	}
 	return out, nil
 }
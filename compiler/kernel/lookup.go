@@ -0,0 +1,125 @@
+package kernel
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/brimdata/super/compiler/dag"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/brimdata/super/runtime/sam/op/join"
+	"github.com/brimdata/super/zbuf"
+	"github.com/segmentio/ksuid"
+)
+
+// compileLookup compiles a lookup join whose right input is right.  If
+// right is nil, the join's index is not cached.
+func (b *Builder) compileLookup(o *dag.Join, right dag.Seq, parents []zbuf.Puller) ([]zbuf.Puller, error) {
+	if len(parents) != 2 {
+		return nil, ErrJoinParents
+	}
+	b.resetResetters()
+	assignments, err := b.compileAssignments(o.Args)
+	if err != nil {
+		return nil, err
+	}
+	lhs, rhs := splitAssignments(assignments)
+	leftKey, err := b.compileExpr(o.LeftKey)
+	if err != nil {
+		return nil, err
+	}
+	rightKey, err := b.compileExpr(o.RightKey)
+	if err != nil {
+		return nil, err
+	}
+	key, pools := lookupCacheKey(right)
+	lookup := join.NewLookup(b.rctx, b.env.LookupCache(), key, pools, parents[0], parents[1], leftKey, rightKey, lhs, rhs, b.resetters)
+	return []zbuf.Puller{b.opStats.Wrap(opName(o), lookup)}, nil
+}
+
+// lookupCacheKey returns the key under which the index of a lookup join
+// with right input seq is cached along with the IDs of the pools that seq
+// reads.  Since the DAG of a pool scan gives the commit scanned, the key is
+// the DAG of seq followed by the modification time and size of each local
+// file it reads.  The key is empty if seq reads another kind of source,
+// whose content cannot be identified.
+func lookupCacheKey(seq dag.Seq) (string, []ksuid.KSUID) {
+	if seq == nil {
+		return "", nil
+	}
+	b, err := json.Marshal(seq)
+	if err != nil {
+		return "", nil
+	}
+	key := string(b)
+	var pools []ksuid.KSUID
+	ok := walkSources(seq, func(o dag.Op) bool {
+		switch o := o.(type) {
+		case *dag.PoolScan:
+			pools = append(pools, o.ID)
+		case *dag.Lister:
+			pools = append(pools, o.Pool)
+		case *dag.FileScan:
+			uri, err := storage.ParseURI(o.Path)
+			if err != nil || storage.Scheme(uri.Scheme) != storage.FileScheme {
+				return false
+			}
+			info, err := os.Stat(uri.Filepath())
+			if err != nil {
+				return false
+			}
+			key += fmt.Sprintf("\n%s %d %d", o.Path, info.ModTime().UnixNano(), info.Size())
+		case *dag.NullScan:
+		default:
+			return false
+		}
+		return true
+	})
+	if !ok {
+		return "", nil
+	}
+	return key, pools
+}
+
+// walkSources calls visit for each data source in seq, including those in
+// nested sequences, and returns false as soon as visit does.
+func walkSources(seq dag.Seq, visit func(dag.Op) bool) bool {
+	for _, o := range seq {
+		var ok bool
+		switch o := o.(type) {
+		case *dag.Fork:
+			ok = walkSourcePaths(o.Paths, visit)
+		case *dag.Scatter:
+			ok = walkSourcePaths(o.Paths, visit)
+		case *dag.Mirror:
+			ok = walkSources(o.Main, visit) && walkSources(o.Mirror, visit)
+		case *dag.Switch:
+			ok = true
+			for _, c := range o.Cases {
+				ok = ok && walkSources(c.Path, visit)
+			}
+		case *dag.Scope:
+			ok = walkSources(o.Body, visit)
+		case *dag.Over:
+			ok = walkSources(o.Body, visit)
+		case *dag.RobotScan:
+			// A robot reads the files named by its input.
+			ok = visit(o)
+		default:
+			ok = !isEntry(dag.Seq{o}) || visit(o)
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func walkSourcePaths(paths []dag.Seq, visit func(dag.Op) bool) bool {
+	for _, seq := range paths {
+		if !walkSources(seq, visit) {
+			return false
+		}
+	}
+	return true
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"reflect"
 	"slices"
 	"strings"
 	"sync"
@@ -13,6 +14,7 @@ import (
 	"github.com/brimdata/super/compiler/dag"
 	"github.com/brimdata/super/compiler/optimizer"
 	"github.com/brimdata/super/lake"
+	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/pkg/field"
 	"github.com/brimdata/super/runtime"
 	"github.com/brimdata/super/runtime/exec"
@@ -24,6 +26,7 @@ import (
 	"github.com/brimdata/super/runtime/sam/op/exprswitch"
 	"github.com/brimdata/super/runtime/sam/op/fork"
 	"github.com/brimdata/super/runtime/sam/op/fuse"
+	"github.com/brimdata/super/runtime/sam/op/generate"
 	"github.com/brimdata/super/runtime/sam/op/head"
 	"github.com/brimdata/super/runtime/sam/op/join"
 	"github.com/brimdata/super/runtime/sam/op/load"
@@ -31,11 +34,14 @@ import (
 	"github.com/brimdata/super/runtime/sam/op/meta"
 	"github.com/brimdata/super/runtime/sam/op/mirror"
 	"github.com/brimdata/super/runtime/sam/op/robot"
+	"github.com/brimdata/super/runtime/sam/op/scatter"
+	"github.com/brimdata/super/runtime/sam/op/sequence"
 	"github.com/brimdata/super/runtime/sam/op/shape"
 	"github.com/brimdata/super/runtime/sam/op/skip"
 	"github.com/brimdata/super/runtime/sam/op/sort"
 	"github.com/brimdata/super/runtime/sam/op/switcher"
 	"github.com/brimdata/super/runtime/sam/op/tail"
+	"github.com/brimdata/super/runtime/sam/op/temp"
 	"github.com/brimdata/super/runtime/sam/op/top"
 	"github.com/brimdata/super/runtime/sam/op/traverse"
 	"github.com/brimdata/super/runtime/sam/op/uniq"
@@ -62,6 +68,9 @@ type Builder struct {
 	udfs         map[string]dag.Expr
 	compiledUDFs map[string]*expr.UDF
 	resetters    expr.Resetters
+	temps        *temp.Tables
+	keySets      map[string]*join.KeySet
+	opStats      exec.OpStats
 }
 
 func NewBuilder(rctx *runtime.Context, env *exec.Environment) *Builder {
@@ -142,6 +151,12 @@ func (b *Builder) Meter() zbuf.Meter {
 	return b.progress
 }
 
+// OpStats returns the statistics of the operators of the sequential
+// flowgraph built by b.
+func (b *Builder) OpStats() *exec.OpStats {
+	return &b.opStats
+}
+
 func (b *Builder) Deletes() *sync.Map {
 	return b.deletes
 }
@@ -176,16 +191,12 @@ func (b *Builder) compileLeaf(o dag.Op, parent zbuf.Puller) (zbuf.Puller, error)
 		if err != nil {
 			return nil, err
 		}
-		return distinct.New(parent, e), nil
+		return distinct.New(b.rctx, parent, e), nil
 	case *dag.Sort:
 		b.resetResetters()
-		var sortExprs []expr.SortExpr
-		for _, e := range v.Exprs {
-			k, err := b.compileExpr(e.Key)
-			if err != nil {
-				return nil, err
-			}
-			sortExprs = append(sortExprs, expr.NewSortExpr(k, e.Order, e.Nulls))
+		sortExprs, err := b.compileSortExprs(v.Exprs)
+		if err != nil {
+			return nil, err
 		}
 		return sort.New(b.rctx, parent, sortExprs, v.Reverse, b.resetters), nil
 	case *dag.Head:
@@ -204,6 +215,21 @@ func (b *Builder) compileLeaf(o dag.Op, parent zbuf.Puller) (zbuf.Puller, error)
 		return skip.New(parent, v.Count), nil
 	case *dag.Uniq:
 		return uniq.New(b.rctx, parent, v.Cflag), nil
+	case *dag.Sequence:
+		b.resetResetters()
+		keys, err := b.compileAssignments(v.Keys)
+		if err != nil {
+			return nil, err
+		}
+		steps, err := b.compileAssignments(v.Steps)
+		if err != nil {
+			return nil, err
+		}
+		time, err := b.compileExpr(v.Time)
+		if err != nil {
+			return nil, err
+		}
+		return sequence.New(b.rctx, parent, keys, steps, time, v.Window, b.resetters)
 	case *dag.Pass:
 		return parent, nil
 	case *dag.Filter:
@@ -215,13 +241,9 @@ func (b *Builder) compileLeaf(o dag.Op, parent zbuf.Puller) (zbuf.Puller, error)
 		return op.NewApplier(b.rctx, parent, expr.NewFilterApplier(b.sctx(), f), b.resetters), nil
 	case *dag.Top:
 		b.resetResetters()
-		var sortExprs []expr.SortExpr
-		for _, dagSortExpr := range v.Exprs {
-			e, err := b.compileExpr(dagSortExpr.Key)
-			if err != nil {
-				return nil, err
-			}
-			sortExprs = append(sortExprs, expr.NewSortExpr(e, dagSortExpr.Order, dagSortExpr.Nulls))
+		sortExprs, err := b.compileSortExprs(v.Exprs)
+		if err != nil {
+			return nil, err
 		}
 		return top.New(b.sctx(), parent, v.Limit, sortExprs, v.Reverse, b.resetters), nil
 	case *dag.Put:
@@ -286,6 +308,8 @@ func (b *Builder) compileLeaf(o dag.Op, parent zbuf.Puller) (zbuf.Puller, error)
 			}
 		}
 		return meta.NewCommitMetaScanner(b.rctx.Context, b.sctx(), b.env.Lake(), v.Pool, v.Commit, v.Meta, pruner)
+	case *dag.SummaryScan:
+		return meta.NewSummaryScanner(b.rctx.Context, b.sctx(), b.env.Lake(), v.Pool, v.Commit, v.Summary)
 	case *dag.LakeMetaScan:
 		return meta.NewLakeMetaScanner(b.rctx.Context, b.sctx(), b.env.Lake(), v.Meta)
 	case *dag.HTTPScan:
@@ -320,6 +344,10 @@ func (b *Builder) compileLeaf(o dag.Op, parent zbuf.Puller) (zbuf.Puller, error)
 	case *dag.NullScan:
 		//XXX we need something that implements the done protocol and restarst
 		return zbuf.NewPuller(zbuf.NewArray([]super.Value{super.Null})), nil
+	case *dag.TempScan:
+		return temp.NewScanner(b.rctx, b.tempTables(), v.Name), nil
+	case *dag.GenerateScan:
+		return b.compileGenerate(v.Spec)
 	case *dag.Lister:
 		if parent != nil {
 			return nil, errors.New("internal error: data source cannot have a parent operator")
@@ -335,9 +363,23 @@ func (b *Builder) compileLeaf(o dag.Op, parent zbuf.Puller) (zbuf.Puller, error)
 				return nil, err
 			}
 		}
+		if v.KeySet != "" {
+			keySetPruner := b.keySet(v.KeySet).Pruner(b.rctx.Context)
+			if pruner == nil {
+				pruner = keySetPruner
+			} else {
+				pruner = expr.NewLogicalOr(b.sctx(), pruner, keySetPruner)
+			}
+		}
+		if v.Unordered {
+			return meta.NewUnorderedLister(b.rctx.Context, b.mctx, pool, v.Commit, pruner)
+		}
+		if v.Reverse {
+			return meta.NewReverseLister(b.rctx.Context, b.mctx, pool, v.Commit, pruner)
+		}
 		return meta.NewSortedLister(b.rctx.Context, b.mctx, pool, v.Commit, pruner)
 	case *dag.Slicer:
-		return meta.NewSlicer(parent, b.mctx), nil
+		return meta.NewCachedSlicer(parent, b.mctx, b.env.PartitionCache()), nil
 	case *dag.SeqScan:
 		pool, err := b.lookupPool(v.Pool)
 		if err != nil {
@@ -350,6 +392,9 @@ func (b *Builder) compileLeaf(o dag.Op, parent zbuf.Puller) (zbuf.Puller, error)
 				return nil, err
 			}
 		}
+		if v.Reverse {
+			return meta.NewReverseSequenceScanner(b.rctx, parent, pool, b.newPushdown(v.Filter, nil), pruner, b.progress), nil
+		}
 		return meta.NewSequenceScanner(b.rctx, parent, pool, b.newPushdown(v.Filter, nil), pruner, b.progress), nil
 	case *dag.Deleter:
 		pool, err := b.lookupPool(v.Pool)
@@ -371,8 +416,13 @@ func (b *Builder) compileLeaf(o dag.Op, parent zbuf.Puller) (zbuf.Puller, error)
 			pushdown = &deleter{pushdown, b, v.Where}
 		}
 		return meta.NewDeleter(b.rctx, parent, pool, pushdown, pruner, b.progress, b.deletes), nil
+	case *dag.Into:
+		return temp.NewWriter(parent, b.tempTables().Declare(v.Temp)), nil
 	case *dag.Load:
-		return load.New(b.rctx, b.env.Lake(), parent, v.Pool, v.Branch, v.Author, v.Message, v.Meta), nil
+		if b.env.ReadOnly() {
+			return nil, errors.New("load: lake is read-only")
+		}
+		return load.New(b.rctx, b.env.Lake(), parent, v.Pool, v.Branch, v.Author, v.Message, v.Meta, false), nil
 	case *dag.Vectorize:
 		// If the first op is SeqScan, then pull it out so we can
 		// give the scanner a zio.Puller parent (i.e., the lister).
@@ -489,9 +539,19 @@ func splitAssignments(assignments []expr.Assignment) ([]*expr.Lval, []expr.Evalu
 }
 
 func (b *Builder) compileSeq(seq dag.Seq, parents []zbuf.Puller) ([]zbuf.Puller, error) {
-	for _, o := range seq {
+	for k, o := range seq {
 		var err error
-		parents, err = b.compile(o, parents)
+		if j, ok := o.(*dag.Join); ok && j.Style == "lookup" && k > 0 {
+			// The index of a lookup join is cached by its right
+			// input, which is the second path of the preceding fork.
+			var right dag.Seq
+			if fork, ok := seq[k-1].(*dag.Fork); ok && len(fork.Paths) == 2 {
+				right = fork.Paths[1]
+			}
+			parents, err = b.compileLookup(j, right, parents)
+		} else {
+			parents, err = b.compile(o, parents)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -543,9 +603,12 @@ func (b *Builder) compileScatter(par *dag.Scatter, parents []zbuf.Puller) ([]zbu
 	if len(parents) != 1 {
 		return nil, errors.New("internal error: scatter operator requires a single parent")
 	}
+	// Each path pulls distinct batches from the parent so they can scan,
+	// e.g., distinct partitions of a pool concurrently.
+	s := scatter.New(b.rctx, parents[0])
 	var ops []zbuf.Puller
 	for _, o := range par.Paths {
-		op, err := b.compileSeq(o, parents[:1])
+		op, err := b.compileSeq(o, []zbuf.Puller{s.AddExit()})
 		if err != nil {
 			return nil, err
 		}
@@ -657,6 +720,13 @@ func (b *Builder) compile(o dag.Op, parents []zbuf.Puller) ([]zbuf.Puller, error
 			return nil, err
 		}
 		lhs, rhs := splitAssignments(assignments)
+		if o.Style == "lookup" {
+			return b.compileLookup(o, nil, parents)
+		}
+		if o.Style == "cross" {
+			cross := join.NewCross(b.rctx, parents[0], parents[1], lhs, rhs, b.resetters)
+			return []zbuf.Puller{b.opStats.Wrap(opName(o), cross)}, nil
+		}
 		leftKey, err := b.compileExpr(o.LeftKey)
 		if err != nil {
 			return nil, err
@@ -666,13 +736,27 @@ func (b *Builder) compile(o dag.Op, parents []zbuf.Puller) ([]zbuf.Puller, error
 			return nil, err
 		}
 		leftParent, rightParent := parents[0], parents[1]
+		if o.Style == "asof" {
+			var tolerance *super.Value
+			if o.Tolerance != nil {
+				val, err := b.evalAtCompileTime(o.Tolerance)
+				if err != nil {
+					return nil, err
+				}
+				tolerance = &val
+			}
+			asof := join.NewAsOf(b.rctx, leftParent, rightParent, leftKey, rightKey, tolerance, lhs, rhs, b.resetters)
+			return []zbuf.Puller{b.opStats.Wrap(opName(o), asof)}, nil
+		}
 		leftDir, rightDir := o.LeftDir, o.RightDir
-		var anti, inner bool
+		var anti, inner, semi bool
 		switch o.Style {
 		case "anti":
 			anti = true
 		case "inner":
 			inner = true
+		case "semi":
+			inner, semi = true, true
 		case "left":
 		case "right":
 			leftKey, rightKey = rightKey, leftKey
@@ -681,15 +765,23 @@ func (b *Builder) compile(o dag.Op, parents []zbuf.Puller) ([]zbuf.Puller, error
 		default:
 			return nil, fmt.Errorf("unknown kind of join: '%s'", o.Style)
 		}
-		join := join.New(b.rctx, anti, inner, leftParent, rightParent, leftKey, rightKey, leftDir, rightDir, lhs, rhs, b.resetters)
-		return []zbuf.Puller{join}, nil
+		if rightDir == order.Unknown || o.KeySet != "" {
+			// Rather than sorting an unordered right input (and
+			// possibly the left input too) for a merge join, build
+			// a hash table of it.  A join publishing its left keys
+			// must read its left input first and so must hash.
+			hash := join.NewHash(b.rctx, anti, inner, semi, b.keySet(o.KeySet), leftParent, rightParent, leftKey, rightKey, lhs, rhs, b.resetters)
+			return []zbuf.Puller{b.opStats.Wrap(opName(o), hash)}, nil
+		}
+		join := join.New(b.rctx, anti, inner, semi, leftParent, rightParent, leftKey, rightKey, leftDir, rightDir, lhs, rhs, b.resetters)
+		return []zbuf.Puller{b.opStats.Wrap(opName(o), join)}, nil
 	case *dag.Merge:
 		b.resetResetters()
 		exprs, err := b.compileSortExprs(o.Exprs)
 		if err != nil {
 			return nil, err
 		}
-		cmp := expr.NewComparator(exprs...).WithMissingAsNull()
+		cmp := expr.NewComparator(exprs...).WithCollation(expr.QueryCollation)
 		return []zbuf.Puller{merge.New(b.rctx, parents, cmp.Compare, b.resetters)}, nil
 	case *dag.Combine:
 		return []zbuf.Puller{combine.New(b.rctx, parents)}, nil
@@ -704,8 +796,51 @@ func (b *Builder) compile(o dag.Op, parents []zbuf.Puller) ([]zbuf.Puller, error
 		if err != nil {
 			return nil, err
 		}
-		return []zbuf.Puller{p}, nil
+		return []zbuf.Puller{b.opStats.Wrap(opName(o), p)}, nil
+	}
+}
+
+// opName returns the name of the type of o for use in operator statistics.
+func opName(o dag.Op) string {
+	return reflect.TypeOf(o).Elem().Name()
+}
+
+// keySet returns the join.KeySet named name, creating it if needed, or nil
+// if name is empty.
+func (b *Builder) keySet(name string) *join.KeySet {
+	if name == "" {
+		return nil
 	}
+	if b.keySets == nil {
+		b.keySets = make(map[string]*join.KeySet)
+	}
+	k, ok := b.keySets[name]
+	if !ok {
+		k = join.NewKeySet()
+		b.keySets[name] = k
+	}
+	return k
+}
+
+// tempTables returns the temporary tables of b's environment or, if the
+// environment has none, a set of tables scoped to the query.
+func (b *Builder) tempTables() *temp.Tables {
+	if b.temps != nil {
+		return b.temps
+	}
+	if b.env != nil && b.env.TempTables() != nil {
+		b.temps = b.env.TempTables()
+		return b.temps
+	}
+	b.temps = temp.NewTables()
+	// Block b.rctx.Cancel until any spill files are removed.
+	b.rctx.WaitGroup.Add(1)
+	go func() {
+		defer b.rctx.WaitGroup.Done()
+		<-b.rctx.Done()
+		b.temps.Close()
+	}()
+	return b.temps
 }
 
 func (b *Builder) compilePoolScan(scan *dag.PoolScan) (zbuf.Puller, error) {
@@ -720,7 +855,7 @@ func (b *Builder) compilePoolScan(scan *dag.PoolScan) (zbuf.Puller, error) {
 	if err != nil {
 		return nil, err
 	}
-	slicer := meta.NewSlicer(l, b.mctx)
+	slicer := meta.NewCachedSlicer(l, b.mctx, b.env.PartitionCache())
 	return meta.NewSequenceScanner(b.rctx, slicer, pool, nil, nil, b.progress), nil
 }
 
@@ -780,6 +915,18 @@ func compileExpr(in dag.Expr) (expr.Evaluator, error) {
 	return b.compileExpr(in)
 }
 
+func (b *Builder) compileGenerate(spec dag.Expr) (zbuf.Puller, error) {
+	val, err := b.evalAtCompileTime(spec)
+	if err != nil {
+		return nil, err
+	}
+	r, err := generate.NewReader(b.sctx(), val)
+	if err != nil {
+		return nil, err
+	}
+	return zbuf.NewPuller(r), nil
+}
+
 func EvalAtCompileTime(sctx *super.Context, in dag.Expr) (val super.Value, err error) {
 	// We pass in a nil adaptor, which causes a panic for anything adaptor
 	// related, which is not currently allowed in an expression sub-query.
@@ -792,7 +939,7 @@ func isEntry(seq dag.Seq) bool {
 		return false
 	}
 	switch op := seq[0].(type) {
-	case *dag.Lister, *dag.DefaultScan, *dag.FileScan, *dag.HTTPScan, *dag.PoolScan, *dag.LakeMetaScan, *dag.PoolMetaScan, *dag.CommitMetaScan, *dag.NullScan:
+	case *dag.Lister, *dag.DefaultScan, *dag.FileScan, *dag.HTTPScan, *dag.PoolScan, *dag.LakeMetaScan, *dag.PoolMetaScan, *dag.CommitMetaScan, *dag.SummaryScan, *dag.NullScan, *dag.TempScan, *dag.GenerateScan:
 		return true
 	case *dag.Scope:
 		return isEntry(op.Body)
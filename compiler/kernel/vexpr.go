@@ -229,6 +229,18 @@ func (b *Builder) compileVamCast(args []dag.Expr) (vamexpr.Evaluator, error) {
 }
 
 func (b *Builder) compileVamShaper(args []dag.Expr, tf expr.ShaperTransform) (vamexpr.Evaluator, error) {
+	if err := function.CheckArgCount(len(args), 2, 2); err != nil {
+		return nil, err
+	}
+	exprs, err := b.compileVamExprs(args)
+	if err != nil {
+		return nil, err
+	}
+	if literal, ok := exprs[1].(*vamexpr.Literal); ok {
+		if shaper, err := vamexpr.NewLiteralShaper(b.sctx(), exprs[0], literal, tf); err == nil {
+			return shaper, nil
+		}
+	}
 	shaper, err := b.compileShaper(args, tf)
 	if err != nil {
 		return nil, err
@@ -9,6 +9,9 @@ import (
 	"github.com/brimdata/super/compiler/dag"
 	"github.com/brimdata/super/pkg/field"
 	"github.com/brimdata/super/runtime/sam/expr"
+	samaggregate "github.com/brimdata/super/runtime/sam/op/aggregate"
+	"github.com/brimdata/super/runtime/sam/op/load"
+	"github.com/brimdata/super/runtime/sam/op/temp"
 	"github.com/brimdata/super/runtime/vam"
 	vamexpr "github.com/brimdata/super/runtime/vam/expr"
 	vamop "github.com/brimdata/super/runtime/vam/op"
@@ -34,6 +37,9 @@ func (b *Builder) compileVam(o dag.Op, parents []vector.Puller) ([]vector.Puller
 			return nil, err
 		}
 		lhs, rhs := splitAssignments(assignments)
+		if o.Style == "cross" {
+			return []vector.Puller{vamop.NewCross(b.rctx, parents[0], parents[1], lhs, rhs)}, nil
+		}
 		leftKey, err := b.compileVamExpr(o.LeftKey)
 		if err != nil {
 			return nil, err
@@ -43,20 +49,35 @@ func (b *Builder) compileVam(o dag.Op, parents []vector.Puller) ([]vector.Puller
 			return nil, err
 		}
 		leftParent, rightParent := parents[0], parents[1]
-		var anti, inner bool
+		if o.Style == "asof" {
+			var tolerance *super.Value
+			if o.Tolerance != nil {
+				val, err := b.evalAtCompileTime(o.Tolerance)
+				if err != nil {
+					return nil, err
+				}
+				tolerance = &val
+			}
+			return []vector.Puller{vamop.NewAsOf(b.rctx, leftParent, rightParent, leftKey, rightKey, tolerance, lhs, rhs)}, nil
+		}
+		var anti, inner, semi bool
 		switch o.Style {
 		case "anti":
 			anti = true
 		case "inner":
 			inner = true
-		case "left":
+		case "semi":
+			inner, semi = true, true
+		case "left", "lookup":
+			// The vector runtime runs a lookup join as a left join
+			// without caching its right input.
 		case "right":
 			leftKey, rightKey = rightKey, leftKey
 			leftParent, rightParent = rightParent, leftParent
 		default:
 			return nil, fmt.Errorf("unknown kind of join: '%s'", o.Style)
 		}
-		join := vamop.NewJoin(b.rctx.Sctx, anti, inner, leftParent, rightParent, leftKey, rightKey, lhs, rhs)
+		join := vamop.NewJoin(b.rctx.Sctx, anti, inner, semi, b.keySet(o.KeySet), leftParent, rightParent, leftKey, rightKey, lhs, rhs)
 		return []vector.Puller{join}, nil
 	case *dag.Merge:
 		b.resetResetters()
@@ -64,7 +85,7 @@ func (b *Builder) compileVam(o dag.Op, parents []vector.Puller) ([]vector.Puller
 		if err != nil {
 			return nil, err
 		}
-		cmp := expr.NewComparator(exprs...).WithMissingAsNull()
+		cmp := expr.NewComparator(exprs...).WithCollation(expr.QueryCollation)
 		return []vector.Puller{vamop.NewMerge(b.rctx, parents, cmp.Compare)}, nil
 	case *dag.Scatter:
 		return b.compileVamScatter(o, parents)
@@ -251,6 +272,8 @@ func (b *Builder) compileVamLeaf(o dag.Op, parent vector.Puller) (vector.Puller,
 		}
 		pushdown := b.newMetaPushdown(metaFilter, o.Pushdown.Projection, metaProjection, o.Pushdown.Unordered)
 		return b.env.VectorOpen(b.rctx, b.sctx(), o.Path, o.Format, pushdown)
+	case *dag.Fuse:
+		return vamop.NewFuse(b.rctx, parent), nil
 	case *dag.Filter:
 		e, err := b.compileVamExpr(o.Expr)
 		if err != nil {
@@ -261,6 +284,23 @@ func (b *Builder) compileVamLeaf(o dag.Op, parent vector.Puller) (vector.Puller,
 		return vamop.NewHead(parent, o.Count), nil
 	case *dag.NullScan:
 		return vam.NewDematerializer(zbuf.NewPuller(zbuf.NewArray([]super.Value{super.Null}))), nil
+	case *dag.TempScan:
+		return vam.NewDematerializer(temp.NewScanner(b.rctx, b.tempTables(), o.Name)), nil
+	case *dag.GenerateScan:
+		puller, err := b.compileGenerate(o.Spec)
+		if err != nil {
+			return nil, err
+		}
+		return vam.NewDematerializer(puller), nil
+	case *dag.Into:
+		return vam.NewDematerializer(temp.NewWriter(vam.NewMaterializer(parent), b.tempTables().Declare(o.Temp))), nil
+	case *dag.Load:
+		if b.env.ReadOnly() {
+			return nil, errors.New("load: lake is read-only")
+		}
+		// Since the input is already vectorized, write the vector form
+		// of each new data object too so it can be scanned as vectors.
+		return vam.NewDematerializer(load.New(b.rctx, b.env.Lake(), vam.NewMaterializer(parent), o.Pool, o.Branch, o.Author, o.Message, o.Meta, true)), nil
 	case *dag.Output:
 		b.channels[o.Name] = append(b.channels[o.Name], vam.NewMaterializer(parent))
 		return parent, nil
@@ -286,6 +326,12 @@ func (b *Builder) compileVamLeaf(o dag.Op, parent vector.Puller) (vector.Puller,
 		}
 		renamer := vamexpr.NewRenamer(b.sctx(), srcs, dsts)
 		return vamop.NewYield(b.sctx(), parent, []vamexpr.Evaluator{renamer}), nil
+	case *dag.Shape:
+		zbufPuller, err := b.compileLeaf(o, vam.NewMaterializer(parent))
+		if err != nil {
+			return nil, err
+		}
+		return vam.NewDematerializer(zbufPuller), nil
 	case *dag.Skip:
 		return vamop.NewSkip(parent, o.Count), nil
 	case *dag.Top:
@@ -296,18 +342,14 @@ func (b *Builder) compileVamLeaf(o dag.Op, parent vector.Puller) (vector.Puller,
 		return vam.NewDematerializer(zbufPuller), nil
 	case *dag.Sort:
 		b.resetResetters()
-		var sortExprs []expr.SortExpr
-		for _, e := range o.Exprs {
-			k, err := b.compileExpr(e.Key)
-			if err != nil {
-				return nil, err
-			}
-			sortExprs = append(sortExprs, expr.NewSortExpr(k, e.Order, e.Nulls))
+		sortExprs, err := b.compileSortExprs(o.Exprs)
+		if err != nil {
+			return nil, err
 		}
 		return vamop.NewSort(b.rctx, parent, sortExprs, o.Reverse, b.resetters), nil
 	case *dag.Tail:
 		return vamop.NewTail(parent, o.Count), nil
-	case *dag.Uniq:
+	case *dag.Sequence, *dag.Uniq:
 		zbufPuller, err := b.compileLeaf(o, vam.NewMaterializer(parent))
 		if err != nil {
 			return nil, err
@@ -405,6 +447,9 @@ func (b *Builder) compileVamSeq(seq dag.Seq, parents []vector.Puller) ([]vector.
 }
 
 func (b *Builder) compileVamAggregate(s *dag.Aggregate, parent vector.Puller) (vector.Puller, error) {
+	if s.Lateness != 0 {
+		return nil, errors.New("aggregate lateness not supported in vector runtime")
+	}
 	// compile aggs
 	var aggNames []field.Path
 	var aggExprs []vamexpr.Evaluator
@@ -437,7 +482,38 @@ func (b *Builder) compileVamAggregate(s *dag.Aggregate, parent vector.Puller) (v
 		keyNames = append(keyNames, lhs.Path)
 		keyExprs = append(keyExprs, rhs)
 	}
-	return aggregate.New(parent, b.sctx(), aggNames, aggExprs, aggs, keyNames, keyExprs, s.PartialsIn, s.PartialsOut)
+	if s.Combiner && s.PartialsOut && s.InputSortDir == 0 {
+		return aggregate.NewCombiner(parent, b.sctx(), aggNames, aggExprs, aggs, keyNames, keyExprs, samaggregate.CombinerMaxGroups)
+	}
+	var emit vamexpr.Evaluator
+	if len(s.Emit) != 0 && !s.PartialsOut {
+		// The output holds the keys followed by the values of s.Emit.
+		var assignments []dag.Assignment
+		for _, k := range s.Keys {
+			assignments = append(assignments, dag.Assignment{Kind: "Assignment", LHS: k.LHS, RHS: k.LHS})
+		}
+		rec, err := vamNewRecordExprFromAssignments(append(assignments, s.Emit...))
+		if err != nil {
+			return nil, err
+		}
+		if emit, err = b.compileVamRecordExpr(rec); err != nil {
+			return nil, err
+		}
+	}
+	agg, err := aggregate.New(parent, b.sctx(), aggNames, aggExprs, aggs, keyNames, keyExprs, emit, s.PartialsIn, s.PartialsOut)
+	if err != nil {
+		return nil, err
+	}
+	if len(s.OutputSort) == 0 {
+		return agg, nil
+	}
+	// The vector aggregation doesn't order its output so sort it.
+	b.resetResetters()
+	sortExprs, err := b.compileSortExprs(s.OutputSort)
+	if err != nil {
+		return nil, err
+	}
+	return vamop.NewSort(b.rctx, agg, sortExprs, false, b.resetters), nil
 }
 
 func (b *Builder) compileVamAgg(agg *dag.Agg) (*vamexpr.Aggregator, error) {
@@ -457,5 +533,9 @@ func (b *Builder) compileVamAgg(agg *dag.Agg) (*vamexpr.Aggregator, error) {
 			return nil, err
 		}
 	}
-	return vamexpr.NewAggregator(name, agg.Distinct, arg, where)
+	params, err := b.evalAggParams(agg)
+	if err != nil {
+		return nil, err
+	}
+	return vamexpr.NewAggregator(name, agg.Distinct, arg, where, params...)
 }
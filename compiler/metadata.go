@@ -0,0 +1,285 @@
+package compiler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/compiler/dag"
+	"github.com/brimdata/super/compiler/optimizer"
+	"github.com/brimdata/super/lake"
+	"github.com/brimdata/super/lake/data"
+	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/runtime/exec"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/sup"
+)
+
+// useObjectMetadata rewrites each pool scan followed by optional filters on
+// the pool key and an aggregation without keys that can be computed from the
+// metadata of the pool's data objects alone (i.e., count() and the min() or
+// max() of the pool key) into a constant computed from that metadata so no
+// data objects are read.  The rewrite is
+// skipped whenever some object only partially matches the filters.
+func useObjectMetadata(ctx context.Context, seq dag.Seq, env *exec.Environment) (dag.Seq, error) {
+	if env == nil || env.Lake() == nil {
+		return seq, nil
+	}
+	lk := env.Lake()
+	var err error
+	seq = optimizer.Walk(seq, func(seq dag.Seq) dag.Seq {
+		if err != nil || len(seq) < 2 {
+			return seq
+		}
+		scan, ok := seq[0].(*dag.PoolScan)
+		if !ok {
+			return seq
+		}
+		var filters []dag.Expr
+		n := 1
+		for ; n < len(seq); n++ {
+			f, ok := seq[n].(*dag.Filter)
+			if !ok {
+				break
+			}
+			filters = append(filters, f.Expr)
+		}
+		if n == len(seq) {
+			return seq
+		}
+		agg, ok := seq[n].(*dag.Aggregate)
+		if !ok || !isMetadataAggregate(agg) {
+			return seq
+		}
+		var val string
+		val, err = metadataValue(ctx, lk, scan, filters, agg)
+		if val == "" {
+			return seq
+		}
+		yield := &dag.Yield{
+			Kind:  "Yield",
+			Exprs: []dag.Expr{&dag.Literal{Kind: "Literal", Value: val}},
+		}
+		return append(dag.Seq{&dag.NullScan{Kind: "NullScan"}, yield}, seq[n+1:]...)
+	})
+	return seq, err
+}
+
+func isMetadataAggregate(agg *dag.Aggregate) bool {
+	if len(agg.Keys) != 0 || len(agg.Aggs) == 0 || len(agg.Emit) != 0 || agg.PartialsIn || agg.PartialsOut {
+		return false
+	}
+	for _, a := range agg.Aggs {
+		if this, ok := a.LHS.(*dag.This); !ok || len(this.Path) != 1 {
+			return false
+		}
+		call, ok := a.RHS.(*dag.Agg)
+		if !ok || call.Distinct || call.Where != nil {
+			return false
+		}
+		switch call.Name {
+		case "count":
+			if call.Expr != nil {
+				return false
+			}
+		case "min", "max":
+			if _, ok := call.Expr.(*dag.This); !ok {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// metadataValue returns the SUP text of the result of agg over the values
+// of scan that match filters or the empty string if the result cannot be
+// determined from the pool's object metadata.
+func metadataValue(ctx context.Context, lk *lake.Root, scan *dag.PoolScan, filters []dag.Expr, agg *dag.Aggregate) (string, error) {
+	pool, err := lk.OpenPool(ctx, scan.ID)
+	if err != nil {
+		return "", err
+	}
+	sortKey := pool.SortKeys.Primary()
+	countOnly := true
+	for _, a := range agg.Aggs {
+		if e := a.RHS.(*dag.Agg).Expr; e != nil {
+			if !sortKey.Key.Equal(e.(*dag.This).Path) {
+				return "", nil
+			}
+			countOnly = false
+		}
+	}
+	var preds []keyPredicate
+	for _, f := range filters {
+		var ok bool
+		if preds, ok = appendKeyPredicates(preds, f, sortKey.Key); !ok {
+			return "", nil
+		}
+	}
+	snap, err := pool.Snapshot(ctx, scan.Commit)
+	if err != nil {
+		return "", err
+	}
+	var count uint64
+	var min, max super.Value
+	if len(preds) == 0 && countOnly {
+		count = snap.Totals().Count
+	} else {
+		sctx := super.NewContext()
+		if preds, err = parseKeyPredicates(sctx, preds); err != nil {
+			return "", err
+		}
+		cmp := expr.NewValueCompareFn(order.Asc, order.NullsLast)
+		for _, o := range snap.SelectAll() {
+			in, ok := matchObject(o, preds, cmp)
+			if !ok {
+				return "", nil
+			}
+			if !in {
+				continue
+			}
+			if count != 0 && o.Min.Type() != min.Type() {
+				// Comparing keys of different types would not match
+				// the ordering used by min() and max().
+				return "", nil
+			}
+			if count == 0 || cmp(o.Min, min) < 0 {
+				min = o.Min
+			}
+			if count == 0 || cmp(o.Max, max) > 0 {
+				max = o.Max
+			}
+			count += o.Count
+		}
+	}
+	if count == 0 {
+		// An aggregation without keys produces no value for empty input.
+		return "", nil
+	}
+	if !countOnly && !isMinMaxType(min.Type()) {
+		return "", nil
+	}
+	var fields []string
+	for _, a := range agg.Aggs {
+		val := fmt.Sprintf("%d(uint64)", count)
+		switch a.RHS.(*dag.Agg).Name {
+		case "min":
+			val = sup.FormatValue(min)
+		case "max":
+			val = sup.FormatValue(max)
+		}
+		name := a.LHS.(*dag.This).Path[0]
+		fields = append(fields, sup.QuotedName(name)+":"+val)
+	}
+	return "{" + strings.Join(fields, ",") + "}", nil
+}
+
+// isMinMaxType returns true if min() and max() over values of typ produce
+// values of typ.
+func isMinMaxType(typ super.Type) bool {
+	switch typ.ID() {
+	case super.IDInt64, super.IDUint64, super.IDFloat64, super.IDDuration, super.IDTime, super.IDString:
+		return true
+	}
+	return false
+}
+
+// A keyPredicate is a comparison of the pool key with a literal value.
+type keyPredicate struct {
+	op      string
+	literal string
+	val     super.Value
+}
+
+// appendKeyPredicates appends the conjunction of pool key comparisons in e
+// to preds and returns false if e is not such a conjunction.
+func appendKeyPredicates(preds []keyPredicate, e dag.Expr, key field.Path) ([]keyPredicate, bool) {
+	b, ok := e.(*dag.BinaryExpr)
+	if !ok {
+		return nil, false
+	}
+	if b.Op == "and" {
+		if preds, ok = appendKeyPredicates(preds, b.LHS, key); !ok {
+			return nil, false
+		}
+		return appendKeyPredicates(preds, b.RHS, key)
+	}
+	op := b.Op
+	lhs, rhs := b.LHS, b.RHS
+	if _, ok := lhs.(*dag.Literal); ok {
+		lhs, rhs = rhs, lhs
+		switch op {
+		case "<":
+			op = ">"
+		case "<=":
+			op = ">="
+		case ">":
+			op = "<"
+		case ">=":
+			op = "<="
+		}
+	}
+	switch op {
+	case "==", "<", "<=", ">", ">=":
+	default:
+		return nil, false
+	}
+	this, ok := lhs.(*dag.This)
+	if !ok || !key.Equal(this.Path) {
+		return nil, false
+	}
+	literal, ok := rhs.(*dag.Literal)
+	if !ok {
+		return nil, false
+	}
+	return append(preds, keyPredicate{op: op, literal: literal.Value}), true
+}
+
+func parseKeyPredicates(sctx *super.Context, preds []keyPredicate) ([]keyPredicate, error) {
+	for k := range preds {
+		val, err := sup.ParseValue(sctx, preds[k].literal)
+		if err != nil {
+			return nil, err
+		}
+		preds[k].val = val
+	}
+	return preds, nil
+}
+
+// matchObject returns whether all of the values of o satisfy preds (true) or
+// none of them do (false).  If neither can be determined from the object's
+// key range, matchObject returns false for ok.
+func matchObject(o *data.Object, preds []keyPredicate, cmp expr.CompareFn) (in bool, ok bool) {
+	if o.Min.IsNull() || o.Max.IsNull() || o.Min.Type() != o.Max.Type() {
+		return false, false
+	}
+	all := true
+	for _, p := range preds {
+		if p.val.Type() != o.Min.Type() {
+			return false, false
+		}
+		min, max := cmp(o.Min, p.val), cmp(o.Max, p.val)
+		var every, none bool
+		switch p.op {
+		case "==":
+			every, none = min == 0 && max == 0, min > 0 || max < 0
+		case "<":
+			every, none = max < 0, min >= 0
+		case "<=":
+			every, none = max <= 0, min > 0
+		case ">":
+			every, none = min > 0, max <= 0
+		case ">=":
+			every, none = min >= 0, max < 0
+		}
+		if none {
+			return false, true
+		}
+		all = all && every
+	}
+	return all, all
+}
@@ -3,8 +3,11 @@ package optimizer
 import (
 	"slices"
 
+	"github.com/brimdata/super"
 	"github.com/brimdata/super/compiler/dag"
 	"github.com/brimdata/super/compiler/optimizer/demand"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/sup"
 )
 
 func DemandForSeq(seq dag.Seq, downstreams ...demand.Demand) []demand.Demand {
@@ -76,6 +79,15 @@ func demandForSimpleOp(op dag.Op, downstream demand.Demand) demand.Demand {
 		return d
 	case *dag.Combine:
 		return downstream
+	case *dag.Sequence:
+		d := demandForExpr(op.Time)
+		for _, assignment := range op.Keys {
+			d = demand.Union(d, demandForExpr(assignment.RHS))
+		}
+		for _, assignment := range op.Steps {
+			d = demand.Union(d, demandForExpr(assignment.RHS))
+		}
+		return d
 	case *dag.Cut:
 		return demandForAssignments(op.Args, demand.None())
 	case *dag.Distinct:
@@ -94,6 +106,8 @@ func demandForSimpleOp(op dag.Op, downstream demand.Demand) demand.Demand {
 		return demand.All()
 	case *dag.Head:
 		return downstream
+	case *dag.Into:
+		return demand.All()
 	case *dag.Load:
 		return demand.All()
 	case *dag.Merge:
@@ -146,7 +160,7 @@ func demandForSimpleOp(op dag.Op, downstream demand.Demand) demand.Demand {
 		}
 		op.Pushdown.Projection = demand.Fields(d)
 		return demand.None()
-	case *dag.HTTPScan, *dag.Lister, *dag.NullScan, *dag.PoolMetaScan, *dag.PoolScan:
+	case *dag.GenerateScan, *dag.HTTPScan, *dag.Lister, *dag.NullScan, *dag.PoolMetaScan, *dag.PoolScan, *dag.SummaryScan, *dag.TempScan:
 		return demand.None()
 	case *dag.RobotScan:
 		return demandForExpr(op.Expr)
@@ -184,11 +198,11 @@ func demandForExpr(expr dag.Expr) demand.Demand {
 		return demand.Union(demandForExpr(expr.Cond),
 			demand.Union(demandForExpr(expr.Then), demandForExpr(expr.Else)))
 	case *dag.Dot:
-		return demandForExpr(expr.LHS)
+		return demandForPath(expr, demand.All())
 	case *dag.Func:
 		// return demand.All()
 	case *dag.IndexExpr:
-		return demand.Union(demandForExpr(expr.Expr), demandForExpr(expr.Index))
+		return demandForPath(expr, demand.All())
 	case *dag.IsNullExpr:
 		return demandForExpr(expr.Expr)
 	case *dag.Literal:
@@ -236,11 +250,7 @@ func demandForExpr(expr dag.Expr) demand.Demand {
 		return demand.Union(demandForExpr(expr.Expr),
 			demand.Union(demandForExpr(expr.From), demandForExpr(expr.To)))
 	case *dag.This:
-		d := demand.All()
-		for i := len(expr.Path) - 1; i >= 0; i-- {
-			d = demand.Key(expr.Path[i], d)
-		}
-		return d
+		return demandForPath(expr, demand.All())
 	case *dag.UnaryExpr:
 		return demandForExpr(expr.Operand)
 	case *dag.Var:
@@ -249,6 +259,60 @@ func demandForExpr(expr dag.Expr) demand.Demand {
 	panic(expr)
 }
 
+// demandForPath returns the demand for expr, which is a chain of field
+// references and index operations, when d is the demand for the value it
+// yields.  Indexing by a string literal refers to a field and indexing by an
+// integer literal refers to an element (field.Elem) so that a projection
+// computed from the demand includes only the leaf values needed, e.g., the
+// demand for a.b[1].c is a.b.[].c.
+func demandForPath(expr dag.Expr, d demand.Demand) demand.Demand {
+	switch expr := expr.(type) {
+	case *dag.Dot:
+		return demandForPath(expr.LHS, demand.Key(expr.RHS, d))
+	case *dag.IndexExpr:
+		key, ok := indexKey(expr.Index)
+		if ok && key == field.Elem {
+			// An element demanded in its entirety is left to demand the
+			// whole container since values other than arrays, sets, and
+			// maps (e.g., strings) may be indexed by position, as is an
+			// element of this, which is always a record.
+			if this, isThis := expr.Expr.(*dag.This); demand.IsAll(d) || isThis && len(this.Path) == 0 {
+				ok = false
+			}
+		}
+		if ok {
+			return demandForPath(expr.Expr, demand.Key(key, d))
+		}
+		return demand.Union(demandForExpr(expr.Expr), demandForExpr(expr.Index))
+	case *dag.This:
+		for i := len(expr.Path) - 1; i >= 0; i-- {
+			d = demand.Key(expr.Path[i], d)
+		}
+		return d
+	}
+	return demandForExpr(expr)
+}
+
+// indexKey returns the demand key for an index expression that is a string
+// or integer literal.
+func indexKey(e dag.Expr) (string, bool) {
+	literal, ok := e.(*dag.Literal)
+	if !ok {
+		return "", false
+	}
+	val, err := sup.ParseValue(super.NewContext(), literal.Value)
+	if err != nil {
+		return "", false
+	}
+	switch id := val.Type().ID(); {
+	case id == super.IDString && !val.IsNull():
+		return super.DecodeString(val.Bytes()), true
+	case super.IsInteger(id) && !val.IsNull():
+		return field.Elem, true
+	}
+	return "", false
+}
+
 func demandForArrayOrSetExpr(elems []dag.VectorElem) demand.Demand {
 	d := demand.None()
 	for _, e := range elems {
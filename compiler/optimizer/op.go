@@ -29,6 +29,10 @@ func (o *Optimizer) analyzeSortKeys(op dag.Op, in order.SortKeys) (order.SortKey
 		return sortKeysOfSortExprs(op.Exprs), nil
 	case *dag.Top:
 		return sortKeysOfSortExprs(op.Exprs), nil
+	case *dag.Aggregate:
+		if len(op.OutputSort) != 0 {
+			return sortKeysOfSortExprs(op.OutputSort[:1]), nil
+		}
 	}
 	// We should handle secondary keys at some point.
 	// See issue #2657.
@@ -79,7 +83,8 @@ func (o *Optimizer) analyzeSortKeys(op dag.Op, in order.SortKeys) (order.SortKey
 
 func sortKeysOfSortExprs(exprs []dag.SortExpr) order.SortKeys {
 	// XXX Only single sort keys.  See issue #2657.
-	if len(exprs) != 1 {
+	if len(exprs) != 1 || exprs[0].Collation != "" {
+		// A collation orders strings in an order unknown to the lake.
 		return nil
 	}
 	key, ok := sortKeyOfExpr(exprs[0].Key, exprs[0].Order)
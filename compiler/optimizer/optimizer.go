@@ -20,6 +20,8 @@ type Optimizer struct {
 	env  *exec.Environment
 	lake *lake.Root
 	nent int
+	// nkeySets counts the key sets of semi joins.
+	nkeySets int
 }
 
 func New(ctx context.Context, env *exec.Environment) *Optimizer {
@@ -153,11 +155,16 @@ func (o *Optimizer) Optimize(seq dag.Seq) (dag.Seq, error) {
 	seq = removePassOps(seq)
 	seq = replaceSortAndHeadOrTailWithTop(seq)
 	o.optimizeParallels(seq)
+	pushTopIntoAggregate(seq)
+	seq = pushSortIntoAggregate(seq)
 	seq = mergeFilters(seq)
 	seq, err := o.optimizeSourcePaths(seq)
 	if err != nil {
 		return nil, err
 	}
+	if err := o.pushSemiJoinKeys(seq); err != nil {
+		return nil, err
+	}
 	seq = removePassOps(seq)
 	DemandForSeq(seq, demand.All())
 	setPushdownUnordered(seq, false)
@@ -195,7 +202,11 @@ func (o *Optimizer) OptimizeDeleter(seq dag.Seq, replicas int) (dag.Seq, error)
 		Where: filter.Expr,
 		//XXX KeyPruner?
 	}
-	lister.KeyPruner = maybeNewRangePruner(filter.Expr, sortKeys)
+	partitionPruner, err := o.partitionPruner(filter.Expr, scan.ID)
+	if err != nil {
+		return nil, err
+	}
+	lister.KeyPruner = orPruners(maybeNewRangePruner(filter.Expr, sortKeys), partitionPruner)
 	scatter := &dag.Scatter{Kind: "Scatter"}
 	for range replicas {
 		scatter.Paths = append(scatter.Paths, dag.CopySeq(dag.Seq{deleter}))
@@ -249,21 +260,43 @@ func (o *Optimizer) optimizeSourcePaths(seq dag.Seq) (dag.Seq, error) {
 			if err != nil {
 				return nil, err
 			}
-			lister.KeyPruner = maybeNewRangePruner(filter, sortKeys)
+			partitionPruner, err := o.partitionPruner(filter, op.ID)
+			if err != nil {
+				return nil, err
+			}
+			keyPruner := maybeNewRangePruner(filter, sortKeys)
+			lister.KeyPruner = newObjectPruner(filter, orPruners(keyPruner, partitionPruner))
 			seq = dag.Seq{lister}
+			// If the chain begins with a top on the pool key, replace it
+			// with a head on a scan in the key's order so the scan can stop
+			// as soon as the head is satisfied.
+			reverse, err := o.scanOrderForTop(op, chain, sortKeys)
+			if err != nil {
+				return nil, err
+			}
+			if reverse != nil {
+				chain[0] = &dag.Head{Kind: "Head", Count: chain[0].(*dag.Top).Limit}
+				lister.Reverse = *reverse
+			}
 			_, _, orderRequired, err := o.concurrentPath(chain, sortKeys)
 			if err != nil {
 				return nil, err
 			}
 			if orderRequired {
 				seq = append(seq, &dag.Slicer{Kind: "Slicer"})
+			} else {
+				// The downstream flowgraph is insensitive to the order
+				// of its input so the lister need not sort the objects
+				// and the sequence scanners can read them in any order.
+				lister.Unordered = true
 			}
 			seq = append(seq, &dag.SeqScan{
 				Kind:      "SeqScan",
 				Pool:      op.ID,
 				Commit:    op.Commit,
 				Filter:    filter,
-				KeyPruner: lister.KeyPruner,
+				KeyPruner: keyPruner,
+				Reverse:   lister.Reverse,
 			})
 			seq = append(seq, chain...)
 		case *dag.FileScan:
@@ -292,7 +325,11 @@ func (o *Optimizer) optimizeSourcePaths(seq dag.Seq) (dag.Seq, error) {
 				}
 				// Check to see if we can add a range pruner when the pool key is used
 				// in a normal filtering operation.
-				op.KeyPruner = maybeNewRangePruner(filter, sortKeys)
+				partitionPruner, err := o.partitionPruner(filter, op.Pool)
+				if err != nil {
+					return nil, err
+				}
+				op.KeyPruner = newObjectPruner(filter, orPruners(maybeNewRangePruner(filter, sortKeys), partitionPruner))
 				// Delete the downstream operators when we are tapping the object list.
 				o, ok := seq[len(seq)-1].(*dag.Output)
 				if !ok {
@@ -309,6 +346,117 @@ func (o *Optimizer) optimizeSourcePaths(seq dag.Seq) (dag.Seq, error) {
 	})
 }
 
+// pushSemiJoinKeys links each semi join whose right input is a scan of a
+// pool keyed by the right join key to the scan's lister with a key set so
+// that the lister prunes the objects that cannot contain a key of the join's
+// left input.  Since the join reads its left input before its right input
+// and tests only whether a key is present, the scan need not be ordered.
+func (o *Optimizer) pushSemiJoinKeys(seq dag.Seq) error {
+	var err error
+	walk(seq, true, func(seq dag.Seq) dag.Seq {
+		for i := 0; i+1 < len(seq) && err == nil; i++ {
+			fork, ok := seq[i].(*dag.Fork)
+			if !ok || len(fork.Paths) != 2 {
+				continue
+			}
+			join, ok := seq[i+1].(*dag.Join)
+			if !ok || join.Style != "semi" {
+				continue
+			}
+			var right dag.Seq
+			if right, err = o.pushKeySet(join, fork.Paths[1]); right != nil {
+				fork.Paths[1] = right
+			}
+		}
+		return seq
+	})
+	return err
+}
+
+// pushKeySet returns nil if right is not exactly a scan of a pool whose
+// primary key is the right join key.  Otherwise, it returns an unordered
+// scan whose lister is linked to join by a key set.
+func (o *Optimizer) pushKeySet(join *dag.Join, right dag.Seq) (dag.Seq, error) {
+	var lister *dag.Lister
+	var scan *dag.SeqScan
+	switch op := right[0].(type) {
+	case *dag.PoolScan:
+		// A scan with nothing downstream is left as is by
+		// optimizeSourcePaths.
+		if len(right) != 1 {
+			return nil, nil
+		}
+		lister = &dag.Lister{Kind: "Lister", Pool: op.ID, Commit: op.Commit}
+		scan = &dag.SeqScan{Kind: "SeqScan", Pool: op.ID, Commit: op.Commit}
+	case *dag.Lister:
+		lister = op
+		for _, op := range right[1:] {
+			switch op := op.(type) {
+			case *dag.Slicer:
+			case *dag.SeqScan:
+				scan = op
+			default:
+				return nil, nil
+			}
+		}
+		if scan == nil || scan.Reverse {
+			return nil, nil
+		}
+	default:
+		return nil, nil
+	}
+	sortKeys, err := o.sortKeysOfSource(lister)
+	if err != nil || sortKeys.IsNil() {
+		return nil, err
+	}
+	if key := fieldOf(join.RightKey); key == nil || !key.Equal(sortKeys.Primary().Key) {
+		return nil, nil
+	}
+	o.nkeySets++
+	join.KeySet = fmt.Sprintf("keyset%d", o.nkeySets)
+	join.RightDir = order.Unknown
+	lister.KeySet = join.KeySet
+	lister.Unordered = true
+	return dag.Seq{lister, scan}, nil
+}
+
+// scanOrderForTop returns nil if chain does not begin with a top on the
+// primary key of scan's pool that can be computed by a head on a scan in
+// the key's order.  Otherwise, it returns whether the scan must walk the
+// pool in the reverse of the pool's order.
+func (o *Optimizer) scanOrderForTop(scan *dag.PoolScan, chain dag.Seq, sortKeys order.SortKeys) (*bool, error) {
+	if len(sortKeys) != 1 || len(chain) == 0 {
+		return nil, nil
+	}
+	top, ok := chain[0].(*dag.Top)
+	if !ok || len(top.Exprs) != 1 {
+		return nil, nil
+	}
+	e := top.Exprs[0]
+	if this, ok := e.Key.(*dag.This); !ok || !sortKeys.Primary().Key.Equal(this.Path) {
+		return nil, nil
+	}
+	reverse := e.Order != sortKeys.Primary().Order
+	if e.Nulls != e.Order.NullsMax(true) {
+		// A scan in either direction places nulls where the maximum
+		// value is, so the head is correct only if there are no null keys.
+		pool, err := o.lookupPool(scan.ID)
+		if err != nil {
+			return nil, err
+		}
+		snap, err := pool.Snapshot(o.ctx, scan.Commit)
+		if err != nil {
+			return nil, err
+		}
+		for _, object := range snap.SelectAll() {
+			if object.Min.IsNull() || object.Max.IsNull() {
+				return nil, nil
+			}
+		}
+	}
+	return &reverse, nil
+}
+
 func (o *Optimizer) SortKeys(seq dag.Seq) ([]order.SortKeys, error) {
 	return o.propagateSortKey(dag.CopySeq(seq), []order.SortKeys{nil})
 }
@@ -366,7 +514,12 @@ func (o *Optimizer) propagateSortKeyOp(op dag.Op, parents []order.SortKeys) ([]o
 	}
 	switch op := op.(type) {
 	case *dag.Aggregate:
-		if parent.IsNil() {
+		if len(op.OutputSort) != 0 {
+			// The aggregation orders its output itself and so has no
+			// use for an input order.
+			return []order.SortKeys{sortKeysOfSortExprs(op.OutputSort[:1])}, nil
+		}
+		if parent.IsNil() || op.Lateness != 0 {
 			return []order.SortKeys{nil}, nil
 		}
 		//XXX handle only primary sortKey for now
@@ -447,14 +600,14 @@ func (o *Optimizer) sortKeysOfSource(op dag.Op) (order.SortKeys, error) {
 		return op.SortKeys, nil
 	case *dag.FileScan:
 		return nil, nil
-	case *dag.HTTPScan:
+	case *dag.HTTPScan, *dag.SummaryScan:
 		return nil, nil
 	case *dag.PoolScan:
 		return o.sortKey(op.ID)
 	case *dag.Lister:
-		return o.sortKey(op.Pool)
+		return o.scanSortKey(op.Pool, op.Reverse)
 	case *dag.SeqScan:
-		return o.sortKey(op.Pool)
+		return o.scanSortKey(op.Pool, op.Reverse)
 	case *dag.CommitMetaScan:
 		if op.Tap && op.Meta == "objects" {
 			// For a tap into the object stream, we compile the downstream
@@ -476,6 +629,34 @@ func (o *Optimizer) sortKey(id ksuid.KSUID) (order.SortKeys, error) {
 	return pool.SortKeys, nil
 }
 
+// scanSortKey returns the sort order of a scan of a pool, which is the
+// reverse of the pool's sort order if reverse is true.
+func (o *Optimizer) scanSortKey(id ksuid.KSUID, reverse bool) (order.SortKeys, error) {
+	sortKeys, err := o.sortKey(id)
+	if err != nil || !reverse {
+		return sortKeys, err
+	}
+	var out order.SortKeys
+	for _, k := range sortKeys {
+		out = append(out, order.NewSortKey(!k.Order, k.Key))
+	}
+	return out, nil
+}
+
+// partitionPruner returns a pruner of the data objects of the pool with
+// the given ID whose partitions rule out filter or nil if the pool is not
+// partitioned.
+func (o *Optimizer) partitionPruner(filter dag.Expr, id ksuid.KSUID) (dag.Expr, error) {
+	if filter == nil {
+		return nil, nil
+	}
+	pool, err := o.lookupPool(id)
+	if err != nil {
+		return nil, err
+	}
+	return newPartitionPruner(filter, pool.Partition), nil
+}
+
 func (o *Optimizer) lookupPool(id ksuid.KSUID) (*lake.Pool, error) {
 	if o.lake == nil {
 		return nil, errors.New("internal error: lake operation cannot be used in non-lake context")
@@ -705,6 +886,72 @@ func replaceSortAndHeadOrTailWithTop(seq dag.Seq) dag.Seq {
 	return seq
 }
 
+// pushTopIntoAggregate tells each final aggregation that is followed by a
+// Top to produce only the groups that the Top would produce so that the
+// remaining groups are never materialized as output.  The Top is retained
+// since the groups of a partitioned aggregation are limited separately.
+func pushTopIntoAggregate(seq dag.Seq) {
+	walkT(reflect.ValueOf(&seq), func(seq dag.Seq) dag.Seq {
+		for i := 0; i+1 < len(seq); i++ {
+			a, ok := seq[i].(*dag.Aggregate)
+			if !ok || a.PartialsOut || len(a.Keys) == 0 || len(a.Emit) != 0 {
+				// The expressions of the top would refer to the
+				// output of the emit rather than to the groups.
+				continue
+			}
+			if top, ok := seq[i+1].(*dag.Top); ok && len(top.Exprs) > 0 {
+				a.TopLimit = top.Limit
+				a.TopExprs = top.Exprs
+			}
+		}
+		return seq
+	})
+}
+
+// pushSortIntoAggregate removes each Sort that follows a final aggregation of
+// unsorted input and orders by a prefix of the aggregation's grouping keys
+// and tells the aggregation to produce its output in that order instead,
+// which it does more cheaply by sorting its table of groups.
+func pushSortIntoAggregate(seq dag.Seq) dag.Seq {
+	walkT(reflect.ValueOf(&seq), func(seq dag.Seq) dag.Seq {
+		for i := 0; i+1 < len(seq); i++ {
+			a, ok := seq[i].(*dag.Aggregate)
+			if !ok || a.PartialsOut || a.InputSortDir != 0 || a.TopLimit != 0 || a.Lateness != 0 {
+				continue
+			}
+			sort, ok := seq[i+1].(*dag.Sort)
+			if !ok || !sortsByKeys(sort.Exprs, a.Keys) {
+				continue
+			}
+			a.OutputSort = slices.Clone(sort.Exprs)
+			for _, k := range a.Keys[len(sort.Exprs):] {
+				a.OutputSort = append(a.OutputSort, dag.SortExpr{Key: k.LHS, Order: order.Asc, Nulls: order.NullsLast})
+			}
+			seq = slices.Delete(seq, i+1, i+2)
+		}
+		return seq
+	})
+	return seq
+}
+
+// sortsByKeys returns true if exprs is a nonempty prefix of the keys.
+func sortsByKeys(exprs []dag.SortExpr, keys []dag.Assignment) bool {
+	if len(exprs) == 0 || len(exprs) > len(keys) {
+		return false
+	}
+	for i, e := range exprs {
+		this, ok := e.Key.(*dag.This)
+		if !ok || e.Collation != "" {
+			return false
+		}
+		key, ok := keys[i].LHS.(*dag.This)
+		if !ok || !slices.Equal(this.Path, key.Path) {
+			return false
+		}
+	}
+	return true
+}
+
 func walkT[T any](v reflect.Value, post func(T) T) {
 	switch v.Kind() {
 	case reflect.Array, reflect.Slice:
@@ -732,8 +979,10 @@ func walkT[T any](v reflect.Value, post func(T) T) {
 func setPushdownUnordered(seq dag.Seq, unordered bool) bool {
 	for i := len(seq) - 1; i >= 0; i-- {
 		switch op := seq[i].(type) {
-		case *dag.Aggregate, *dag.Combine, *dag.Distinct, *dag.Join, *dag.Sort, *dag.Top,
-			*dag.DefaultScan, *dag.HTTPScan, *dag.PoolScan,
+		case *dag.Aggregate:
+			unordered = !usesInputOrder(op)
+		case *dag.Combine, *dag.Distinct, *dag.Join, *dag.Sort, *dag.Top,
+			*dag.DefaultScan, *dag.HTTPScan, *dag.PoolScan, *dag.SummaryScan,
 			*dag.CommitMetaScan, *dag.LakeMetaScan, *dag.PoolMetaScan:
 			unordered = true
 		case *dag.FileScan:
@@ -744,7 +993,7 @@ func setPushdownUnordered(seq dag.Seq, unordered bool) bool {
 				setPushdownUnordered(p, true)
 			}
 			unordered = true
-		case *dag.Merge:
+		case *dag.Merge, *dag.Sequence:
 			unordered = false
 		case *dag.Mirror:
 			unordered = setPushdownUnordered(op.Main, unordered)
@@ -79,8 +79,14 @@ func (o *Optimizer) parallelizeFileScan(seq dag.Seq, replicas int) (dag.Seq, err
 		return nil, err
 	}
 	if n < len(seq) {
-		switch seq[n].(type) {
-		case *dag.Aggregate, *dag.Sort, *dag.Top:
+		switch op := seq[n].(type) {
+		case *dag.Aggregate:
+			if usesInputOrder(op) {
+				// The parallel paths would lose the order of the file.
+				return nil, nil
+			}
+			return parallelizeHead(seq, n, sortExprs, replicas), nil
+		case *dag.Sort, *dag.Top:
 			return parallelizeHead(seq, n, sortExprs, replicas), nil
 		}
 	}
@@ -180,9 +186,26 @@ func (o *Optimizer) liftIntoParPaths(seq dag.Seq) {
 			// Need an unmodified aggregate to split into its parials pieces.
 			return
 		}
+		if op.Lateness != 0 {
+			// The watermark of each path would advance independently.
+			return
+		}
+		if merge != nil && usesInputOrder(op) {
+			// The order of the input among the paths would be lost.
+			return
+		}
 		for k := range paths {
 			partial := dag.CopyOp(op).(*dag.Aggregate)
 			partial.PartialsOut = true
+			partial.TopLimit = 0
+			partial.TopExprs = nil
+			partial.OutputSort = nil
+			partial.Emit = nil
+			// Unless the paths are merged in order, the partial
+			// aggregations need only reduce the data flowing into
+			// the ingress aggregate so they may output their groups
+			// whenever their tables fill.
+			partial.Combiner = merge == nil
 			paths[k].Append(partial)
 		}
 		op.PartialsIn = true
@@ -275,6 +298,10 @@ func (o *Optimizer) concurrentPath(seq dag.Seq, sortKeys order.SortKeys) (length
 				// results from the aggregate as a streaming operation.
 				return k, sortExprsForSortKeys(sortKeys), true, nil
 			}
+			if !sortKeys.IsNil() && usesInputOrder(op) {
+				// Keep the input ordered since the results depend on it.
+				return k, sortExprsForSortKeys(sortKeys), true, nil
+			}
 			return k, nil, false, nil
 		case *dag.Sort:
 			if len(op.Exprs) == 0 {
@@ -296,7 +323,7 @@ func (o *Optimizer) concurrentPath(seq dag.Seq, sortKeys order.SortKeys) (length
 			// upstream sort is the same as the Load destination sort we
 			// request a merge and set the Load operator to do a sorted write.
 			return k, nil, false, nil
-		case *dag.Fork, *dag.Scatter, *dag.Mirror, *dag.Head, *dag.Tail, *dag.Uniq, *dag.Fuse, *dag.Join, *dag.Output:
+		case *dag.Fork, *dag.Scatter, *dag.Mirror, *dag.Head, *dag.Tail, *dag.Uniq, *dag.Fuse, *dag.Join, *dag.Output, *dag.Into, *dag.Sequence:
 			return k, sortExprsForSortKeys(sortKeys), true, nil
 		default:
 			next, err := o.analyzeSortKeys(op, sortKeys)
@@ -323,3 +350,18 @@ func sortExprsForSortKeys(keys order.SortKeys) []dag.SortExpr {
 	}
 	return exprs
 }
+
+// usesInputOrder returns true if a has a lateness, which relies on the order
+// of the times of its input, or the results of any of its aggregate
+// functions depend on the order of its input.
+func usesInputOrder(a *dag.Aggregate) bool {
+	if a.Lateness != 0 {
+		return true
+	}
+	for _, assignment := range a.Aggs {
+		if agg, ok := assignment.RHS.(*dag.Agg); ok && (agg.Name == "first" || agg.Name == "last") {
+			return true
+		}
+	}
+	return false
+}
@@ -6,8 +6,10 @@ import (
 	"unicode/utf8"
 
 	"github.com/brimdata/super/compiler/dag"
+	"github.com/brimdata/super/lake/pools"
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/pkg/nano"
 	"github.com/brimdata/super/sup"
 )
 
@@ -18,6 +20,173 @@ func maybeNewRangePruner(pred dag.Expr, sortKeys order.SortKeys) dag.Expr {
 	return nil
 }
 
+// newObjectPruner returns a predicate that when applied to a data object
+// returns true if either keyPruner, the pool key range pruner for pred, or the
+// ranges of the object's fields recorded in its stats can for certain rule
+// out that pred would be true for any value in the object.
+func newObjectPruner(pred dag.Expr, keyPruner dag.Expr) dag.Expr {
+	if pred == nil {
+		return keyPruner
+	}
+	return orPruners(keyPruner, newStatsPruner(pred))
+}
+
+// orPruners returns a pruner that prunes what either a or b prunes.  Either
+// may be nil.
+func orPruners(a, b dag.Expr) dag.Expr {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	return dag.NewBinaryExpr("or", a, b)
+}
+
+// newPartitionPruner returns a predicate that is true for a data object of a
+// pool partitioned by p whose partition rules out that pred would be true for
+// any of its values, or nil if pred does not compare the partition field or
+// expression with a literal.  The predicate uses plain comparisons rather
+// than compare() so that a null partition, which holds values whose
+// partition field is null or missing (or, for a binned partition, is not a
+// time or duration), or a partition of a type unlike the literal's never
+// prunes.
+func newPartitionPruner(pred dag.Expr, p pools.Partition) dag.Expr {
+	e, ok := pred.(*dag.BinaryExpr)
+	if !ok || p.IsZero() {
+		return nil
+	}
+	switch e.Op {
+	case "and":
+		return orPruners(newPartitionPruner(e.LHS, p), newPartitionPruner(e.RHS, p))
+	case "or":
+		lhs := newPartitionPruner(e.LHS, p)
+		rhs := newPartitionPruner(e.RHS, p)
+		if lhs == nil || rhs == nil {
+			return nil
+		}
+		return dag.NewBinaryExpr("and", lhs, rhs)
+	case "==", "<", "<=", ">", ">=":
+		return partitionPrunerPred(e, p)
+	default:
+		return nil
+	}
+}
+
+func partitionPrunerPred(e *dag.BinaryExpr, p pools.Partition) dag.Expr {
+	lhs, literal, op := e.LHS, e.RHS, e.Op
+	if _, ok := lhs.(*dag.Literal); ok {
+		lhs, literal, op = literal, lhs, reverseComparator(op)
+	}
+	lit, ok := literal.(*dag.Literal)
+	if !ok {
+		return nil
+	}
+	partition := &dag.This{Kind: "This", Path: field.Path{"partition"}}
+	var bin nano.Duration
+	switch lhs := lhs.(type) {
+	case *dag.This:
+		// The partition field is compared so each of the object's
+		// values is in the range [partition, partition+bin).
+		if !p.Path().Equal(lhs.Path) {
+			return nil
+		}
+		bin = p.Bin
+	case *dag.Call:
+		// The partition expression is compared so each of the object's
+		// values is equal to partition.
+		if !isPartitionExpr(lhs, p) {
+			return nil
+		}
+	default:
+		return nil
+	}
+	if bin == 0 {
+		switch op {
+		case "<":
+			return dag.NewBinaryExpr(">=", partition, lit)
+		case "<=":
+			return dag.NewBinaryExpr(">", partition, lit)
+		case ">":
+			return dag.NewBinaryExpr("<=", partition, lit)
+		case ">=":
+			return dag.NewBinaryExpr("<", partition, lit)
+		case "==":
+			return dag.NewBinaryExpr("or",
+				dag.NewBinaryExpr("<", partition, lit),
+				dag.NewBinaryExpr(">", partition, lit))
+		}
+		panic("partitionPrunerPred unknown op " + op)
+	}
+	end := dag.NewBinaryExpr("+", partition, &dag.Literal{Kind: "Literal", Value: bin.String()})
+	switch op {
+	case "<":
+		return dag.NewBinaryExpr(">=", partition, lit)
+	case "<=":
+		return dag.NewBinaryExpr(">", partition, lit)
+	case ">", ">=":
+		return dag.NewBinaryExpr("<=", end, lit)
+	case "==":
+		return dag.NewBinaryExpr("or",
+			dag.NewBinaryExpr(">", partition, lit),
+			dag.NewBinaryExpr("<=", end, lit))
+	}
+	panic("partitionPrunerPred unknown op " + op)
+}
+
+// isPartitionExpr returns true if e is bucket(field, bin) for the field and
+// bin of the binned partition p.
+func isPartitionExpr(e *dag.Call, p pools.Partition) bool {
+	if e.Name != "bucket" || len(e.Args) != 2 || p.Bin == 0 {
+		return false
+	}
+	this, ok := e.Args[0].(*dag.This)
+	if !ok || !p.Path().Equal(this.Path) {
+		return false
+	}
+	lit, ok := e.Args[1].(*dag.Literal)
+	if !ok {
+		return false
+	}
+	bin, err := nano.ParseDuration(lit.Value)
+	return err == nil && bin == p.Bin
+}
+
+// newStatsPruner returns a predicate that is true for a data object whose
+// stats rule out that pred would be true for any of its values.  Since the
+// metadata pruner is true when a match is possible, we negate it.  Fields
+// absent from the stats yield errors, which never prune.
+func newStatsPruner(pred dag.Expr) dag.Expr {
+	e := newMetadataPruner(pred)
+	if e == nil {
+		return nil
+	}
+	return &dag.UnaryExpr{
+		Kind:    "UnaryExpr",
+		Op:      "!",
+		Operand: prefixPaths(e, "stats"),
+	}
+}
+
+// prefixPaths returns a copy of an expression created by newMetadataPruner
+// with each field reference relative to the record field name.
+func prefixPaths(e dag.Expr, name string) dag.Expr {
+	switch e := e.(type) {
+	case *dag.BinaryExpr:
+		return dag.NewBinaryExpr(e.Op, prefixPaths(e.LHS, name), prefixPaths(e.RHS, name))
+	case *dag.Call:
+		args := make([]dag.Expr, 0, len(e.Args))
+		for _, arg := range e.Args {
+			args = append(args, prefixPaths(arg, name))
+		}
+		return &dag.Call{Kind: "Call", Name: e.Name, Args: args}
+	case *dag.This:
+		return &dag.This{Kind: "This", Path: append(field.Path{name}, e.Path...)}
+	default:
+		return e
+	}
+}
+
 // newRangePruner returns a new predicate based on the input predicate pred
 // that when applied to an input value (i.e., "this") with fields from/to, returns
 // true if comparisons in pred against literal values can for certain rule out
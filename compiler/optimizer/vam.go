@@ -63,7 +63,7 @@ func vectorize(seq dag.Seq, n int) dag.Seq {
 // along with the field name.
 func IsCountByString(o dag.Op) (string, bool) {
 	s, ok := o.(*dag.Aggregate)
-	if ok && len(s.Aggs) == 1 && len(s.Keys) == 1 && isCount(s.Aggs[0]) {
+	if ok && len(s.Aggs) == 1 && len(s.Keys) == 1 && s.Lateness == 0 && isCount(s.Aggs[0]) {
 		return isSingleField(s.Keys[0])
 	}
 	return "", false
@@ -33,8 +33,16 @@ func Analyze(ctx context.Context, ast *parser.AST, env *exec.Environment, extInp
 func Optimize(ctx context.Context, seq dag.Seq, env *exec.Environment, parallel int) (dag.Seq, error) {
 	// Call optimize to possible push down a filter predicate into the
 	// kernel.Reader so that the BSUP scanner can do Boyer-Moore.
+	seq, err := useObjectMetadata(ctx, seq, env)
+	if err != nil {
+		return nil, err
+	}
+	seq, err = useSummaries(ctx, seq, env)
+	if err != nil {
+		return nil, err
+	}
 	o := optimizer.New(ctx, env)
-	seq, err := o.Optimize(seq)
+	seq, err = o.Optimize(seq)
 	if err != nil {
 		return nil, err
 	}
@@ -84,11 +92,11 @@ func CompileWithAST(rctx *runtime.Context, ast *parser.AST, env *exec.Environmen
 			return nil, err
 		}
 	}
-	outputs, meter, err := Build(rctx, dag, env, readers)
+	outputs, b, err := BuildWithBuilder(rctx, dag, env, readers)
 	if err != nil {
 		return nil, err
 	}
-	return exec.NewQuery(rctx, bundleOutputs(rctx, outputs), meter), nil
+	return exec.NewQueryWithStats(rctx, bundleOutputs(rctx, outputs), b.Meter(), dag, b.OpStats()), nil
 }
 
 func Compile(rctx *runtime.Context, env *exec.Environment, optimize bool, parallel int, readers []zio.Reader, query string, filenames ...string) (*exec.Query, error) {
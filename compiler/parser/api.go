@@ -36,6 +36,37 @@ func (a *AST) ConvertToDeleteWhere(pool, branch string) error {
 	return nil
 }
 
+// Declare places the query in the scope of decls, e.g., the declarations
+// of a session.
+func (a *AST) Declare(decls []ast.Decl) {
+	if len(decls) == 0 || len(a.seq) == 0 {
+		return
+	}
+	a.seq = ast.Seq{&ast.Scope{
+		Kind:  "Scope",
+		Decls: decls,
+		Body:  a.seq,
+	}}
+}
+
+// ParseDecls parses text comprising only declarations.
+func ParseDecls(text string) ([]ast.Decl, error) {
+	// A scope requires a body so we parse the declarations with an
+	// empty pipeline appended.
+	a, err := ParseQuery(text + "\npass")
+	if err != nil {
+		return nil, err
+	}
+	if len(a.seq) == 1 {
+		if scope, ok := a.seq[0].(*ast.Scope); ok && len(scope.Body) == 1 {
+			if _, ok := scope.Body[0].(*ast.Pass); ok {
+				return scope.Decls, nil
+			}
+		}
+	}
+	return nil, errors.New("text must contain only declarations")
+}
+
 // ParseQuery parses a query text and an optional set of include files and
 // tracks include file names and line numbers for error reporting.
 func ParseQuery(query string, filenames ...string) (*AST, error) {
@@ -66,7 +97,7 @@ func convertParseErrs(err error, files *srcfiles.List) error {
 		if !ok {
 			return err
 		}
-		files.AddError("parse error", pe.pos.offset, -1)
+		files.AddError(srcfiles.CodeParse, "parse error", pe.pos.offset, -1)
 	}
 	return nil
 }
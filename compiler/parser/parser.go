@@ -1588,50 +1588,50 @@ var g = &grammar{
 								},
 								&labeledExpr{
 									pos:   position{line: 179, col: 35, offset: 4512},
-									label: "limit",
+									label: "args",
 									expr: &ruleRefExpr{
-										pos:  position{line: 179, col: 41, offset: 4518},
-										name: "LimitArg",
+										pos:  position{line: 179, col: 40, offset: 4517},
+										name: "AggregateArgs",
 									},
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 187, col: 5, offset: 4702},
+						pos: position{line: 188, col: 5, offset: 4723},
 						run: (*parser).callonAggregation10,
 						expr: &seqExpr{
-							pos: position{line: 187, col: 5, offset: 4702},
+							pos: position{line: 188, col: 5, offset: 4723},
 							exprs: []any{
 								&zeroOrOneExpr{
-									pos: position{line: 187, col: 5, offset: 4702},
+									pos: position{line: 188, col: 5, offset: 4723},
 									expr: &ruleRefExpr{
-										pos:  position{line: 187, col: 5, offset: 4702},
+										pos:  position{line: 188, col: 5, offset: 4723},
 										name: "Aggregate",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 187, col: 16, offset: 4713},
+									pos:   position{line: 188, col: 16, offset: 4734},
 									label: "aggs",
 									expr: &ruleRefExpr{
-										pos:  position{line: 187, col: 21, offset: 4718},
+										pos:  position{line: 188, col: 21, offset: 4739},
 										name: "AggAssignments",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 187, col: 36, offset: 4733},
+									pos:   position{line: 188, col: 36, offset: 4754},
 									label: "keys",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 187, col: 41, offset: 4738},
+										pos: position{line: 188, col: 41, offset: 4759},
 										expr: &seqExpr{
-											pos: position{line: 187, col: 42, offset: 4739},
+											pos: position{line: 188, col: 42, offset: 4760},
 											exprs: []any{
 												&ruleRefExpr{
-													pos:  position{line: 187, col: 42, offset: 4739},
+													pos:  position{line: 188, col: 42, offset: 4760},
 													name: "_",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 187, col: 44, offset: 4741},
+													pos:  position{line: 188, col: 44, offset: 4762},
 													name: "AggregateKeys",
 												},
 											},
@@ -1639,11 +1639,11 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 187, col: 60, offset: 4757},
-									label: "limit",
+									pos:   position{line: 188, col: 60, offset: 4778},
+									label: "args",
 									expr: &ruleRefExpr{
-										pos:  position{line: 187, col: 66, offset: 4763},
-										name: "LimitArg",
+										pos:  position{line: 188, col: 65, offset: 4783},
+										name: "AggregateArgs",
 									},
 								},
 							},
@@ -1656,25 +1656,25 @@ var g = &grammar{
 		},
 		{
 			name: "Aggregate",
-			pos:  position{line: 200, col: 1, offset: 5047},
+			pos:  position{line: 201, col: 1, offset: 5076},
 			expr: &seqExpr{
-				pos: position{line: 200, col: 13, offset: 5059},
+				pos: position{line: 201, col: 13, offset: 5088},
 				exprs: []any{
 					&choiceExpr{
-						pos: position{line: 200, col: 14, offset: 5060},
+						pos: position{line: 201, col: 14, offset: 5089},
 						alternatives: []any{
 							&ruleRefExpr{
-								pos:  position{line: 200, col: 14, offset: 5060},
+								pos:  position{line: 201, col: 14, offset: 5089},
 								name: "AGGREGATE",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 200, col: 26, offset: 5072},
+								pos:  position{line: 201, col: 26, offset: 5101},
 								name: "SUMMARIZE",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 200, col: 37, offset: 5083},
+						pos:  position{line: 201, col: 37, offset: 5112},
 						name: "_",
 					},
 				},
@@ -1684,43 +1684,135 @@ var g = &grammar{
 		},
 		{
 			name: "AggregateKeys",
-			pos:  position{line: 202, col: 1, offset: 5086},
+			pos:  position{line: 203, col: 1, offset: 5115},
 			expr: &actionExpr{
-				pos: position{line: 203, col: 5, offset: 5104},
+				pos: position{line: 204, col: 5, offset: 5133},
 				run: (*parser).callonAggregateKeys1,
 				expr: &seqExpr{
-					pos: position{line: 203, col: 5, offset: 5104},
+					pos: position{line: 204, col: 5, offset: 5133},
 					exprs: []any{
 						&zeroOrOneExpr{
-							pos: position{line: 203, col: 5, offset: 5104},
+							pos: position{line: 204, col: 5, offset: 5133},
 							expr: &seqExpr{
-								pos: position{line: 203, col: 6, offset: 5105},
+								pos: position{line: 204, col: 6, offset: 5134},
 								exprs: []any{
 									&ruleRefExpr{
-										pos:  position{line: 203, col: 6, offset: 5105},
+										pos:  position{line: 204, col: 6, offset: 5134},
 										name: "GROUP",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 203, col: 12, offset: 5111},
+										pos:  position{line: 204, col: 12, offset: 5140},
 										name: "_",
 									},
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 203, col: 16, offset: 5115},
+							pos:  position{line: 204, col: 16, offset: 5144},
 							name: "BY",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 203, col: 19, offset: 5118},
+							pos:  position{line: 204, col: 19, offset: 5147},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 203, col: 21, offset: 5120},
+							pos:   position{line: 204, col: 21, offset: 5149},
 							label: "columns",
 							expr: &ruleRefExpr{
-								pos:  position{line: 203, col: 29, offset: 5128},
-								name: "FlexAssignments",
+								pos:  position{line: 204, col: 29, offset: 5157},
+								name: "GroupKeys",
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "GroupKeys",
+			pos:  position{line: 206, col: 1, offset: 5192},
+			expr: &actionExpr{
+				pos: position{line: 207, col: 5, offset: 5206},
+				run: (*parser).callonGroupKeys1,
+				expr: &seqExpr{
+					pos: position{line: 207, col: 5, offset: 5206},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 207, col: 5, offset: 5206},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 207, col: 11, offset: 5212},
+								name: "GroupKey",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 207, col: 20, offset: 5221},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 207, col: 25, offset: 5226},
+								expr: &actionExpr{
+									pos: position{line: 207, col: 26, offset: 5227},
+									run: (*parser).callonGroupKeys7,
+									expr: &seqExpr{
+										pos: position{line: 207, col: 26, offset: 5227},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 207, col: 26, offset: 5227},
+												name: "__",
+											},
+											&litMatcher{
+												pos:        position{line: 207, col: 29, offset: 5230},
+												val:        ",",
+												ignoreCase: false,
+												want:       "\",\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 207, col: 33, offset: 5234},
+												name: "__",
+											},
+											&labeledExpr{
+												pos:   position{line: 207, col: 36, offset: 5237},
+												label: "k",
+												expr: &ruleRefExpr{
+													pos:  position{line: 207, col: 38, offset: 5239},
+													name: "GroupKey",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "GroupKey",
+			pos:  position{line: 213, col: 1, offset: 5406},
+			expr: &actionExpr{
+				pos: position{line: 214, col: 5, offset: 5419},
+				run: (*parser).callonGroupKey1,
+				expr: &seqExpr{
+					pos: position{line: 214, col: 5, offset: 5419},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 214, col: 5, offset: 5419},
+							label: "a",
+							expr: &ruleRefExpr{
+								pos:  position{line: 214, col: 7, offset: 5421},
+								name: "FlexAssignment",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 214, col: 22, offset: 5436},
+							label: "collate",
+							expr: &ruleRefExpr{
+								pos:  position{line: 214, col: 30, offset: 5444},
+								name: "OptCollate",
 							},
 						},
 					},
@@ -1730,44 +1822,98 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "LimitArg",
-			pos:  position{line: 205, col: 1, offset: 5169},
+			name: "AggregateArgs",
+			pos:  position{line: 226, col: 1, offset: 5834},
 			expr: &choiceExpr{
-				pos: position{line: 206, col: 5, offset: 5182},
+				pos: position{line: 227, col: 5, offset: 5852},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 206, col: 5, offset: 5182},
-						run: (*parser).callonLimitArg2,
+						pos: position{line: 227, col: 5, offset: 5852},
+						run: (*parser).callonAggregateArgs2,
 						expr: &seqExpr{
-							pos: position{line: 206, col: 5, offset: 5182},
+							pos: position{line: 227, col: 5, offset: 5852},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 206, col: 5, offset: 5182},
+									pos:  position{line: 227, col: 5, offset: 5852},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 206, col: 7, offset: 5184},
+									pos:  position{line: 227, col: 7, offset: 5854},
 									name: "WITH",
 								},
-								&ruleRefExpr{
-									pos:  position{line: 206, col: 12, offset: 5189},
-									name: "_",
+								&labeledExpr{
+									pos:   position{line: 227, col: 12, offset: 5859},
+									label: "args",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 227, col: 17, offset: 5864},
+										expr: &actionExpr{
+											pos: position{line: 227, col: 18, offset: 5865},
+											run: (*parser).callonAggregateArgs8,
+											expr: &seqExpr{
+												pos: position{line: 227, col: 18, offset: 5865},
+												exprs: []any{
+													&ruleRefExpr{
+														pos:  position{line: 227, col: 18, offset: 5865},
+														name: "_",
+													},
+													&labeledExpr{
+														pos:   position{line: 227, col: 20, offset: 5867},
+														label: "a",
+														expr: &ruleRefExpr{
+															pos:  position{line: 227, col: 22, offset: 5869},
+															name: "AggregateArg",
+														},
+													},
+												},
+											},
+										},
+									},
 								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 228, col: 5, offset: 5934},
+						run: (*parser).callonAggregateArgs13,
+						expr: &litMatcher{
+							pos:        position{line: 228, col: 5, offset: 5934},
+							val:        "",
+							ignoreCase: false,
+							want:       "\"\"",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "AggregateArg",
+			pos:  position{line: 230, col: 1, offset: 5971},
+			expr: &choiceExpr{
+				pos: position{line: 231, col: 5, offset: 5988},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 231, col: 5, offset: 5988},
+						run: (*parser).callonAggregateArg2,
+						expr: &seqExpr{
+							pos: position{line: 231, col: 5, offset: 5988},
+							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 206, col: 14, offset: 5191},
+									pos:        position{line: 231, col: 5, offset: 5988},
 									val:        "-limit",
 									ignoreCase: false,
 									want:       "\"-limit\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 206, col: 23, offset: 5200},
+									pos:  position{line: 231, col: 14, offset: 5997},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 206, col: 25, offset: 5202},
+									pos:   position{line: 231, col: 16, offset: 5999},
 									label: "limit",
 									expr: &ruleRefExpr{
-										pos:  position{line: 206, col: 31, offset: 5208},
+										pos:  position{line: 231, col: 22, offset: 6005},
 										name: "UInt",
 									},
 								},
@@ -1775,13 +1921,30 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 207, col: 5, offset: 5239},
-						run: (*parser).callonLimitArg11,
-						expr: &litMatcher{
-							pos:        position{line: 207, col: 5, offset: 5239},
-							val:        "",
-							ignoreCase: false,
-							want:       "\"\"",
+						pos: position{line: 232, col: 5, offset: 6078},
+						run: (*parser).callonAggregateArg8,
+						expr: &seqExpr{
+							pos: position{line: 232, col: 5, offset: 6078},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 232, col: 5, offset: 6078},
+									val:        "-lateness",
+									ignoreCase: false,
+									want:       "\"-lateness\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 232, col: 17, offset: 6090},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 232, col: 19, offset: 6092},
+									label: "d",
+									expr: &ruleRefExpr{
+										pos:  position{line: 232, col: 21, offset: 6094},
+										name: "Duration",
+									},
+								},
+							},
 						},
 					},
 				},
@@ -1791,22 +1954,22 @@ var g = &grammar{
 		},
 		{
 			name: "FlexAssignment",
-			pos:  position{line: 212, col: 1, offset: 5499},
+			pos:  position{line: 237, col: 1, offset: 6405},
 			expr: &choiceExpr{
-				pos: position{line: 213, col: 5, offset: 5518},
+				pos: position{line: 238, col: 5, offset: 6424},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 213, col: 5, offset: 5518},
+						pos:  position{line: 238, col: 5, offset: 6424},
 						name: "Assignment",
 					},
 					&actionExpr{
-						pos: position{line: 214, col: 5, offset: 5533},
+						pos: position{line: 239, col: 5, offset: 6439},
 						run: (*parser).callonFlexAssignment3,
 						expr: &labeledExpr{
-							pos:   position{line: 214, col: 5, offset: 5533},
+							pos:   position{line: 239, col: 5, offset: 6439},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 214, col: 10, offset: 5538},
+								pos:  position{line: 239, col: 10, offset: 6444},
 								name: "Expr",
 							},
 						},
@@ -1818,51 +1981,51 @@ var g = &grammar{
 		},
 		{
 			name: "FlexAssignments",
-			pos:  position{line: 216, col: 1, offset: 5625},
+			pos:  position{line: 241, col: 1, offset: 6531},
 			expr: &actionExpr{
-				pos: position{line: 217, col: 5, offset: 5645},
+				pos: position{line: 242, col: 5, offset: 6551},
 				run: (*parser).callonFlexAssignments1,
 				expr: &seqExpr{
-					pos: position{line: 217, col: 5, offset: 5645},
+					pos: position{line: 242, col: 5, offset: 6551},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 217, col: 5, offset: 5645},
+							pos:   position{line: 242, col: 5, offset: 6551},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 217, col: 11, offset: 5651},
+								pos:  position{line: 242, col: 11, offset: 6557},
 								name: "FlexAssignment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 217, col: 26, offset: 5666},
+							pos:   position{line: 242, col: 26, offset: 6572},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 217, col: 31, offset: 5671},
+								pos: position{line: 242, col: 31, offset: 6577},
 								expr: &actionExpr{
-									pos: position{line: 217, col: 32, offset: 5672},
+									pos: position{line: 242, col: 32, offset: 6578},
 									run: (*parser).callonFlexAssignments7,
 									expr: &seqExpr{
-										pos: position{line: 217, col: 32, offset: 5672},
+										pos: position{line: 242, col: 32, offset: 6578},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 217, col: 32, offset: 5672},
+												pos:  position{line: 242, col: 32, offset: 6578},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 217, col: 35, offset: 5675},
+												pos:        position{line: 242, col: 35, offset: 6581},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 217, col: 39, offset: 5679},
+												pos:  position{line: 242, col: 39, offset: 6585},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 217, col: 42, offset: 5682},
+												pos:   position{line: 242, col: 42, offset: 6588},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 217, col: 47, offset: 5687},
+													pos:  position{line: 242, col: 47, offset: 6593},
 													name: "FlexAssignment",
 												},
 											},
@@ -1879,57 +2042,103 @@ var g = &grammar{
 		},
 		{
 			name: "AggAssignment",
-			pos:  position{line: 221, col: 1, offset: 5773},
+			pos:  position{line: 246, col: 1, offset: 6679},
 			expr: &choiceExpr{
-				pos: position{line: 222, col: 5, offset: 5791},
+				pos: position{line: 247, col: 5, offset: 6697},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 222, col: 5, offset: 5791},
+						pos: position{line: 247, col: 5, offset: 6697},
 						run: (*parser).callonAggAssignment2,
 						expr: &seqExpr{
-							pos: position{line: 222, col: 5, offset: 5791},
+							pos: position{line: 247, col: 5, offset: 6697},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 222, col: 5, offset: 5791},
+									pos:   position{line: 247, col: 5, offset: 6697},
 									label: "lval",
 									expr: &ruleRefExpr{
-										pos:  position{line: 222, col: 10, offset: 5796},
+										pos:  position{line: 247, col: 10, offset: 6702},
 										name: "Lval",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 222, col: 15, offset: 5801},
+									pos:  position{line: 247, col: 15, offset: 6707},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 222, col: 18, offset: 5804},
+									pos:        position{line: 247, col: 18, offset: 6710},
 									val:        ":=",
 									ignoreCase: false,
 									want:       "\":=\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 222, col: 23, offset: 5809},
+									pos:  position{line: 247, col: 23, offset: 6715},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 222, col: 26, offset: 5812},
+									pos:   position{line: 247, col: 26, offset: 6718},
 									label: "agg",
 									expr: &ruleRefExpr{
-										pos:  position{line: 222, col: 30, offset: 5816},
+										pos:  position{line: 247, col: 30, offset: 6722},
 										name: "Agg",
 									},
 								},
+								&notExpr{
+									pos: position{line: 247, col: 34, offset: 6726},
+									expr: &ruleRefExpr{
+										pos:  position{line: 247, col: 35, offset: 6727},
+										name: "ExprGuard",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 250, col: 5, offset: 6851},
+						run: (*parser).callonAggAssignment13,
+						expr: &seqExpr{
+							pos: position{line: 250, col: 5, offset: 6851},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 250, col: 5, offset: 6851},
+									label: "lval",
+									expr: &ruleRefExpr{
+										pos:  position{line: 250, col: 10, offset: 6856},
+										name: "Lval",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 250, col: 15, offset: 6861},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 250, col: 18, offset: 6864},
+									val:        ":=",
+									ignoreCase: false,
+									want:       "\":=\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 250, col: 23, offset: 6869},
+									name: "__",
+								},
+								&labeledExpr{
+									pos:   position{line: 250, col: 26, offset: 6872},
+									label: "expr",
+									expr: &ruleRefExpr{
+										pos:  position{line: 250, col: 31, offset: 6877},
+										name: "Expr",
+									},
+								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 225, col: 5, offset: 5934},
-						run: (*parser).callonAggAssignment11,
+						pos: position{line: 253, col: 5, offset: 6997},
+						run: (*parser).callonAggAssignment22,
 						expr: &labeledExpr{
-							pos:   position{line: 225, col: 5, offset: 5934},
+							pos:   position{line: 253, col: 5, offset: 6997},
 							label: "agg",
 							expr: &ruleRefExpr{
-								pos:  position{line: 225, col: 9, offset: 5938},
+								pos:  position{line: 253, col: 9, offset: 7001},
 								name: "Agg",
 							},
 						},
@@ -1941,42 +2150,42 @@ var g = &grammar{
 		},
 		{
 			name: "Agg",
-			pos:  position{line: 229, col: 1, offset: 6033},
+			pos:  position{line: 257, col: 1, offset: 7096},
 			expr: &choiceExpr{
-				pos: position{line: 230, col: 5, offset: 6041},
+				pos: position{line: 258, col: 5, offset: 7104},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 230, col: 5, offset: 6041},
+						pos: position{line: 258, col: 5, offset: 7104},
 						run: (*parser).callonAgg2,
 						expr: &seqExpr{
-							pos: position{line: 230, col: 5, offset: 6041},
+							pos: position{line: 258, col: 5, offset: 7104},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 230, col: 5, offset: 6041},
+									pos: position{line: 258, col: 5, offset: 7104},
 									expr: &ruleRefExpr{
-										pos:  position{line: 230, col: 6, offset: 6042},
+										pos:  position{line: 258, col: 6, offset: 7105},
 										name: "FuncGuard",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 230, col: 16, offset: 6052},
+									pos:   position{line: 258, col: 16, offset: 7115},
 									label: "aggDistinct",
 									expr: &ruleRefExpr{
-										pos:  position{line: 230, col: 28, offset: 6064},
+										pos:  position{line: 258, col: 28, offset: 7127},
 										name: "AggDistinct",
 									},
 								},
 								&notExpr{
-									pos: position{line: 230, col: 40, offset: 6076},
+									pos: position{line: 258, col: 40, offset: 7139},
 									expr: &seqExpr{
-										pos: position{line: 230, col: 42, offset: 6078},
+										pos: position{line: 258, col: 42, offset: 7141},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 230, col: 42, offset: 6078},
+												pos:  position{line: 258, col: 42, offset: 7141},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 230, col: 45, offset: 6081},
+												pos:        position{line: 258, col: 45, offset: 7144},
 												val:        ".",
 												ignoreCase: false,
 												want:       "\".\"",
@@ -1985,12 +2194,12 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 230, col: 50, offset: 6086},
+									pos:   position{line: 258, col: 50, offset: 7149},
 									label: "where",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 230, col: 56, offset: 6092},
+										pos: position{line: 258, col: 56, offset: 7155},
 										expr: &ruleRefExpr{
-											pos:  position{line: 230, col: 56, offset: 6092},
+											pos:  position{line: 258, col: 56, offset: 7155},
 											name: "WhereClause",
 										},
 									},
@@ -1999,81 +2208,119 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 238, col: 5, offset: 6267},
+						pos: position{line: 266, col: 5, offset: 7330},
 						run: (*parser).callonAgg15,
 						expr: &seqExpr{
-							pos: position{line: 238, col: 5, offset: 6267},
+							pos: position{line: 266, col: 5, offset: 7330},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 238, col: 5, offset: 6267},
+									pos: position{line: 266, col: 5, offset: 7330},
 									expr: &ruleRefExpr{
-										pos:  position{line: 238, col: 6, offset: 6268},
+										pos:  position{line: 266, col: 6, offset: 7331},
 										name: "FuncGuard",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 238, col: 16, offset: 6278},
+									pos:   position{line: 266, col: 16, offset: 7341},
 									label: "name",
 									expr: &ruleRefExpr{
-										pos:  position{line: 238, col: 21, offset: 6283},
+										pos:  position{line: 266, col: 21, offset: 7346},
 										name: "AggName",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 238, col: 29, offset: 6291},
+									pos:  position{line: 266, col: 29, offset: 7354},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 238, col: 32, offset: 6294},
+									pos:        position{line: 266, col: 32, offset: 7357},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 238, col: 36, offset: 6298},
+									pos:  position{line: 266, col: 36, offset: 7361},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 238, col: 39, offset: 6301},
+									pos:   position{line: 266, col: 39, offset: 7364},
 									label: "expr",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 238, col: 44, offset: 6306},
+										pos: position{line: 266, col: 44, offset: 7369},
 										expr: &choiceExpr{
-											pos: position{line: 238, col: 45, offset: 6307},
+											pos: position{line: 266, col: 45, offset: 7370},
 											alternatives: []any{
 												&ruleRefExpr{
-													pos:  position{line: 238, col: 45, offset: 6307},
+													pos:  position{line: 266, col: 45, offset: 7370},
 													name: "OverExpr",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 238, col: 56, offset: 6318},
+													pos:  position{line: 266, col: 56, offset: 7381},
 													name: "Expr",
 												},
 											},
 										},
 									},
 								},
+								&labeledExpr{
+									pos:   position{line: 266, col: 63, offset: 7388},
+									label: "params",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 266, col: 70, offset: 7395},
+										expr: &actionExpr{
+											pos: position{line: 266, col: 71, offset: 7396},
+											run: (*parser).callonAgg31,
+											expr: &seqExpr{
+												pos: position{line: 266, col: 71, offset: 7396},
+												exprs: []any{
+													&ruleRefExpr{
+														pos:  position{line: 266, col: 71, offset: 7396},
+														name: "__",
+													},
+													&litMatcher{
+														pos:        position{line: 266, col: 74, offset: 7399},
+														val:        ",",
+														ignoreCase: false,
+														want:       "\",\"",
+													},
+													&ruleRefExpr{
+														pos:  position{line: 266, col: 78, offset: 7403},
+														name: "__",
+													},
+													&labeledExpr{
+														pos:   position{line: 266, col: 81, offset: 7406},
+														label: "e",
+														expr: &ruleRefExpr{
+															pos:  position{line: 266, col: 83, offset: 7408},
+															name: "Expr",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
 								&ruleRefExpr{
-									pos:  position{line: 238, col: 63, offset: 6325},
+									pos:  position{line: 266, col: 108, offset: 7433},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 238, col: 66, offset: 6328},
+									pos:        position{line: 266, col: 111, offset: 7436},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
 								},
 								&notExpr{
-									pos: position{line: 238, col: 70, offset: 6332},
+									pos: position{line: 266, col: 115, offset: 7440},
 									expr: &seqExpr{
-										pos: position{line: 238, col: 72, offset: 6334},
+										pos: position{line: 266, col: 117, offset: 7442},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 238, col: 72, offset: 6334},
+												pos:  position{line: 266, col: 117, offset: 7442},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 238, col: 75, offset: 6337},
+												pos:        position{line: 266, col: 120, offset: 7445},
 												val:        ".",
 												ignoreCase: false,
 												want:       "\".\"",
@@ -2082,12 +2329,12 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 238, col: 80, offset: 6342},
+									pos:   position{line: 266, col: 125, offset: 7450},
 									label: "where",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 238, col: 86, offset: 6348},
+										pos: position{line: 266, col: 131, offset: 7456},
 										expr: &ruleRefExpr{
-											pos:  position{line: 238, col: 86, offset: 6348},
+											pos:  position{line: 266, col: 131, offset: 7456},
 											name: "WhereClause",
 										},
 									},
@@ -2096,13 +2343,13 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 252, col: 5, offset: 6638},
-						run: (*parser).callonAgg38,
+						pos: position{line: 281, col: 5, offset: 7791},
+						run: (*parser).callonAgg47,
 						expr: &labeledExpr{
-							pos:   position{line: 252, col: 5, offset: 6638},
+							pos:   position{line: 281, col: 5, offset: 7791},
 							label: "cs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 252, col: 8, offset: 6641},
+								pos:  position{line: 281, col: 8, offset: 7794},
 								name: "CountStar",
 							},
 						},
@@ -2114,73 +2361,73 @@ var g = &grammar{
 		},
 		{
 			name: "AggDistinct",
-			pos:  position{line: 260, col: 1, offset: 6779},
+			pos:  position{line: 289, col: 1, offset: 7932},
 			expr: &actionExpr{
-				pos: position{line: 261, col: 5, offset: 6795},
+				pos: position{line: 290, col: 5, offset: 7948},
 				run: (*parser).callonAggDistinct1,
 				expr: &seqExpr{
-					pos: position{line: 261, col: 5, offset: 6795},
+					pos: position{line: 290, col: 5, offset: 7948},
 					exprs: []any{
 						&notExpr{
-							pos: position{line: 261, col: 5, offset: 6795},
+							pos: position{line: 290, col: 5, offset: 7948},
 							expr: &ruleRefExpr{
-								pos:  position{line: 261, col: 6, offset: 6796},
+								pos:  position{line: 290, col: 6, offset: 7949},
 								name: "FuncGuard",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 261, col: 16, offset: 6806},
+							pos:   position{line: 290, col: 16, offset: 7959},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 261, col: 21, offset: 6811},
+								pos:  position{line: 290, col: 21, offset: 7964},
 								name: "AggName",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 261, col: 29, offset: 6819},
+							pos:  position{line: 290, col: 29, offset: 7972},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 261, col: 32, offset: 6822},
+							pos:        position{line: 290, col: 32, offset: 7975},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 261, col: 36, offset: 6826},
+							pos:  position{line: 290, col: 36, offset: 7979},
 							name: "__",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 261, col: 39, offset: 6829},
+							pos:  position{line: 290, col: 39, offset: 7982},
 							name: "DISTINCT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 261, col: 48, offset: 6838},
+							pos:  position{line: 290, col: 48, offset: 7991},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 261, col: 50, offset: 6840},
+							pos:   position{line: 290, col: 50, offset: 7993},
 							label: "expr",
 							expr: &choiceExpr{
-								pos: position{line: 261, col: 56, offset: 6846},
+								pos: position{line: 290, col: 56, offset: 7999},
 								alternatives: []any{
 									&ruleRefExpr{
-										pos:  position{line: 261, col: 56, offset: 6846},
+										pos:  position{line: 290, col: 56, offset: 7999},
 										name: "OverExpr",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 261, col: 67, offset: 6857},
+										pos:  position{line: 290, col: 67, offset: 8010},
 										name: "Expr",
 									},
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 261, col: 73, offset: 6863},
+							pos:  position{line: 290, col: 73, offset: 8016},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 261, col: 76, offset: 6866},
+							pos:        position{line: 290, col: 76, offset: 8019},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -2193,20 +2440,20 @@ var g = &grammar{
 		},
 		{
 			name: "AggName",
-			pos:  position{line: 271, col: 1, offset: 7051},
+			pos:  position{line: 300, col: 1, offset: 8204},
 			expr: &choiceExpr{
-				pos: position{line: 272, col: 5, offset: 7063},
+				pos: position{line: 301, col: 5, offset: 8216},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 272, col: 5, offset: 7063},
+						pos:  position{line: 301, col: 5, offset: 8216},
 						name: "IdentifierName",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 273, col: 5, offset: 7082},
+						pos:  position{line: 302, col: 5, offset: 8235},
 						name: "AND",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 274, col: 5, offset: 7090},
+						pos:  position{line: 303, col: 5, offset: 8243},
 						name: "OR",
 					},
 				},
@@ -2216,30 +2463,30 @@ var g = &grammar{
 		},
 		{
 			name: "WhereClause",
-			pos:  position{line: 276, col: 1, offset: 7094},
+			pos:  position{line: 305, col: 1, offset: 8247},
 			expr: &actionExpr{
-				pos: position{line: 276, col: 15, offset: 7108},
+				pos: position{line: 305, col: 15, offset: 8261},
 				run: (*parser).callonWhereClause1,
 				expr: &seqExpr{
-					pos: position{line: 276, col: 15, offset: 7108},
+					pos: position{line: 305, col: 15, offset: 8261},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 276, col: 15, offset: 7108},
+							pos:  position{line: 305, col: 15, offset: 8261},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 276, col: 17, offset: 7110},
+							pos:  position{line: 305, col: 17, offset: 8263},
 							name: "WHERE",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 276, col: 23, offset: 7116},
+							pos:  position{line: 305, col: 23, offset: 8269},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 276, col: 25, offset: 7118},
+							pos:   position{line: 305, col: 25, offset: 8271},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 276, col: 30, offset: 7123},
+								pos:  position{line: 305, col: 30, offset: 8276},
 								name: "LogicalOrExpr",
 							},
 						},
@@ -2251,45 +2498,45 @@ var g = &grammar{
 		},
 		{
 			name: "AggAssignments",
-			pos:  position{line: 278, col: 1, offset: 7159},
+			pos:  position{line: 307, col: 1, offset: 8312},
 			expr: &actionExpr{
-				pos: position{line: 279, col: 5, offset: 7178},
+				pos: position{line: 308, col: 5, offset: 8331},
 				run: (*parser).callonAggAssignments1,
 				expr: &seqExpr{
-					pos: position{line: 279, col: 5, offset: 7178},
+					pos: position{line: 308, col: 5, offset: 8331},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 279, col: 5, offset: 7178},
+							pos:   position{line: 308, col: 5, offset: 8331},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 279, col: 11, offset: 7184},
+								pos:  position{line: 308, col: 11, offset: 8337},
 								name: "AggAssignment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 279, col: 25, offset: 7198},
+							pos:   position{line: 308, col: 25, offset: 8351},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 279, col: 30, offset: 7203},
+								pos: position{line: 308, col: 30, offset: 8356},
 								expr: &seqExpr{
-									pos: position{line: 279, col: 31, offset: 7204},
+									pos: position{line: 308, col: 31, offset: 8357},
 									exprs: []any{
 										&ruleRefExpr{
-											pos:  position{line: 279, col: 31, offset: 7204},
+											pos:  position{line: 308, col: 31, offset: 8357},
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 279, col: 34, offset: 7207},
+											pos:        position{line: 308, col: 34, offset: 8360},
 											val:        ",",
 											ignoreCase: false,
 											want:       "\",\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 279, col: 38, offset: 7211},
+											pos:  position{line: 308, col: 38, offset: 8364},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 279, col: 41, offset: 7214},
+											pos:  position{line: 308, col: 41, offset: 8367},
 											name: "AggAssignment",
 										},
 									},
@@ -2304,43 +2551,43 @@ var g = &grammar{
 		},
 		{
 			name: "CountStar",
-			pos:  position{line: 287, col: 1, offset: 7388},
+			pos:  position{line: 316, col: 1, offset: 8541},
 			expr: &actionExpr{
-				pos: position{line: 287, col: 13, offset: 7400},
+				pos: position{line: 316, col: 13, offset: 8553},
 				run: (*parser).callonCountStar1,
 				expr: &seqExpr{
-					pos: position{line: 287, col: 13, offset: 7400},
+					pos: position{line: 316, col: 13, offset: 8553},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 287, col: 13, offset: 7400},
+							pos:  position{line: 316, col: 13, offset: 8553},
 							name: "COUNT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 287, col: 19, offset: 7406},
+							pos:  position{line: 316, col: 19, offset: 8559},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 287, col: 22, offset: 7409},
+							pos:        position{line: 316, col: 22, offset: 8562},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 287, col: 26, offset: 7413},
+							pos:  position{line: 316, col: 26, offset: 8566},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 287, col: 29, offset: 7416},
+							pos:        position{line: 316, col: 29, offset: 8569},
 							val:        "*",
 							ignoreCase: false,
 							want:       "\"*\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 287, col: 33, offset: 7420},
+							pos:  position{line: 316, col: 33, offset: 8573},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 287, col: 36, offset: 7423},
+							pos:        position{line: 316, col: 36, offset: 8576},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -2353,28 +2600,28 @@ var g = &grammar{
 		},
 		{
 			name: "Operator",
-			pos:  position{line: 297, col: 1, offset: 7617},
+			pos:  position{line: 326, col: 1, offset: 8770},
 			expr: &choiceExpr{
-				pos: position{line: 298, col: 5, offset: 7630},
+				pos: position{line: 327, col: 5, offset: 8783},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 298, col: 5, offset: 7630},
+						pos: position{line: 327, col: 5, offset: 8783},
 						run: (*parser).callonOperator2,
 						expr: &seqExpr{
-							pos: position{line: 298, col: 5, offset: 7630},
+							pos: position{line: 327, col: 5, offset: 8783},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 298, col: 5, offset: 7630},
+									pos:   position{line: 327, col: 5, offset: 8783},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 298, col: 8, offset: 7633},
+										pos:  position{line: 327, col: 8, offset: 8786},
 										name: "SelectOp",
 									},
 								},
 								&andExpr{
-									pos: position{line: 298, col: 17, offset: 7642},
+									pos: position{line: 327, col: 17, offset: 8795},
 									expr: &ruleRefExpr{
-										pos:  position{line: 298, col: 18, offset: 7643},
+										pos:  position{line: 327, col: 18, offset: 8796},
 										name: "EndOfOp",
 									},
 								},
@@ -2382,123 +2629,135 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 299, col: 5, offset: 7674},
+						pos:  position{line: 328, col: 5, offset: 8827},
 						name: "ForkOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 300, col: 5, offset: 7685},
+						pos:  position{line: 329, col: 5, offset: 8838},
 						name: "SwitchOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 301, col: 5, offset: 7699},
+						pos:  position{line: 330, col: 5, offset: 8852},
 						name: "FromForkOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 302, col: 5, offset: 7714},
+						pos:  position{line: 331, col: 5, offset: 8867},
 						name: "SearchOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 303, col: 5, offset: 7727},
+						pos:  position{line: 332, col: 5, offset: 8880},
 						name: "AssertOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 304, col: 5, offset: 7740},
+						pos:  position{line: 333, col: 5, offset: 8893},
 						name: "SortOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 305, col: 5, offset: 7751},
+						pos:  position{line: 334, col: 5, offset: 8904},
 						name: "TopOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 306, col: 5, offset: 7761},
+						pos:  position{line: 335, col: 5, offset: 8914},
 						name: "CutOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 307, col: 5, offset: 7771},
+						pos:  position{line: 336, col: 5, offset: 8924},
 						name: "DistinctOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 308, col: 5, offset: 7786},
+						pos:  position{line: 337, col: 5, offset: 8939},
 						name: "DropOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 309, col: 5, offset: 7797},
+						pos:  position{line: 338, col: 5, offset: 8950},
 						name: "HeadOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 310, col: 5, offset: 7808},
+						pos:  position{line: 339, col: 5, offset: 8961},
 						name: "TailOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 311, col: 5, offset: 7819},
+						pos:  position{line: 340, col: 5, offset: 8972},
 						name: "SkipOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 312, col: 5, offset: 7830},
+						pos:  position{line: 341, col: 5, offset: 8983},
 						name: "WhereOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 313, col: 5, offset: 7842},
+						pos:  position{line: 342, col: 5, offset: 8995},
 						name: "UniqOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 314, col: 5, offset: 7853},
+						pos:  position{line: 343, col: 5, offset: 9006},
 						name: "PutOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 315, col: 5, offset: 7863},
+						pos:  position{line: 344, col: 5, offset: 9016},
 						name: "RenameOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 316, col: 5, offset: 7876},
+						pos:  position{line: 345, col: 5, offset: 9029},
 						name: "FuseOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 317, col: 5, offset: 7887},
+						pos:  position{line: 346, col: 5, offset: 9040},
 						name: "ShapeOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 318, col: 5, offset: 7899},
+						pos:  position{line: 347, col: 5, offset: 9052},
 						name: "JoinOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 319, col: 5, offset: 7910},
+						pos:  position{line: 348, col: 5, offset: 9063},
 						name: "SampleOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 320, col: 5, offset: 7923},
+						pos:  position{line: 349, col: 5, offset: 9076},
+						name: "GraphOp",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 350, col: 5, offset: 9088},
+						name: "SequenceOp",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 351, col: 5, offset: 9103},
 						name: "FromOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 321, col: 5, offset: 7934},
+						pos:  position{line: 352, col: 5, offset: 9114},
 						name: "PassOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 322, col: 5, offset: 7945},
+						pos:  position{line: 353, col: 5, offset: 9125},
 						name: "ExplodeOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 323, col: 5, offset: 7959},
+						pos:  position{line: 354, col: 5, offset: 9139},
 						name: "MergeOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 324, col: 5, offset: 7971},
+						pos:  position{line: 355, col: 5, offset: 9151},
 						name: "OverOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 325, col: 5, offset: 7982},
+						pos:  position{line: 356, col: 5, offset: 9162},
 						name: "YieldOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 326, col: 5, offset: 7994},
+						pos:  position{line: 357, col: 5, offset: 9174},
 						name: "LoadOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 327, col: 5, offset: 8005},
+						pos:  position{line: 358, col: 5, offset: 9185},
 						name: "OutputOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 328, col: 5, offset: 8018},
+						pos:  position{line: 359, col: 5, offset: 9198},
+						name: "IntoOp",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 360, col: 5, offset: 9209},
 						name: "DebugOp",
 					},
 				},
@@ -2508,132 +2767,132 @@ var g = &grammar{
 		},
 		{
 			name: "PipeKeyword",
-			pos:  position{line: 330, col: 1, offset: 8027},
+			pos:  position{line: 362, col: 1, offset: 9218},
 			expr: &choiceExpr{
-				pos: position{line: 331, col: 5, offset: 8043},
+				pos: position{line: 363, col: 5, offset: 9234},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 331, col: 5, offset: 8043},
+						pos:  position{line: 363, col: 5, offset: 9234},
 						name: "SELECT",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 331, col: 14, offset: 8052},
+						pos:  position{line: 363, col: 14, offset: 9243},
 						name: "FORK",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 331, col: 21, offset: 8059},
+						pos:  position{line: 363, col: 21, offset: 9250},
 						name: "SWITCH",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 331, col: 30, offset: 8068},
+						pos:  position{line: 363, col: 30, offset: 9259},
 						name: "FROM",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 331, col: 37, offset: 8075},
+						pos:  position{line: 363, col: 37, offset: 9266},
 						name: "SEARCH",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 331, col: 46, offset: 8084},
+						pos:  position{line: 363, col: 46, offset: 9275},
 						name: "ASSERT",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 331, col: 55, offset: 8093},
+						pos:  position{line: 363, col: 55, offset: 9284},
 						name: "SORT",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 331, col: 62, offset: 8100},
+						pos:  position{line: 363, col: 62, offset: 9291},
 						name: "TOP",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 331, col: 67, offset: 8105},
+						pos:  position{line: 363, col: 67, offset: 9296},
 						name: "CUT",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 331, col: 73, offset: 8111},
+						pos:  position{line: 363, col: 73, offset: 9302},
 						name: "DROP",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 332, col: 5, offset: 8120},
+						pos:  position{line: 364, col: 5, offset: 9311},
 						name: "HEAD",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 332, col: 12, offset: 8127},
+						pos:  position{line: 364, col: 12, offset: 9318},
 						name: "TAIL",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 332, col: 19, offset: 8134},
+						pos:  position{line: 364, col: 19, offset: 9325},
 						name: "WHERE",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 332, col: 27, offset: 8142},
+						pos:  position{line: 364, col: 27, offset: 9333},
 						name: "UNIQ",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 332, col: 34, offset: 8149},
+						pos:  position{line: 364, col: 34, offset: 9340},
 						name: "PUT",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 332, col: 40, offset: 8155},
+						pos:  position{line: 364, col: 40, offset: 9346},
 						name: "RENAME",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 332, col: 49, offset: 8164},
+						pos:  position{line: 364, col: 49, offset: 9355},
 						name: "FUSE",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 332, col: 56, offset: 8171},
+						pos:  position{line: 364, col: 56, offset: 9362},
 						name: "SHAPE",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 332, col: 64, offset: 8179},
+						pos:  position{line: 364, col: 64, offset: 9370},
 						name: "JOIN",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 332, col: 71, offset: 8186},
+						pos:  position{line: 364, col: 71, offset: 9377},
 						name: "SAMPLE",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 333, col: 5, offset: 8197},
+						pos:  position{line: 365, col: 5, offset: 9388},
 						name: "FROM",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 333, col: 12, offset: 8204},
+						pos:  position{line: 365, col: 12, offset: 9395},
 						name: "PASS",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 333, col: 19, offset: 8211},
+						pos:  position{line: 365, col: 19, offset: 9402},
 						name: "EXPLODE",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 333, col: 29, offset: 8221},
+						pos:  position{line: 365, col: 29, offset: 9412},
 						name: "MERGE",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 333, col: 37, offset: 8229},
+						pos:  position{line: 365, col: 37, offset: 9420},
 						name: "OVER",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 333, col: 44, offset: 8236},
+						pos:  position{line: 365, col: 44, offset: 9427},
 						name: "YIELD",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 333, col: 52, offset: 8244},
+						pos:  position{line: 365, col: 52, offset: 9435},
 						name: "LOAD",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 333, col: 59, offset: 8251},
+						pos:  position{line: 365, col: 59, offset: 9442},
 						name: "OUTPUT",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 333, col: 68, offset: 8260},
+						pos:  position{line: 365, col: 68, offset: 9451},
 						name: "DEBUG",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 334, col: 5, offset: 8270},
+						pos:  position{line: 366, col: 5, offset: 9461},
 						name: "AGGREGATE",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 334, col: 17, offset: 8282},
+						pos:  position{line: 366, col: 17, offset: 9473},
 						name: "SUMMARIZE",
 					},
 				},
@@ -2643,44 +2902,44 @@ var g = &grammar{
 		},
 		{
 			name: "ForkOp",
-			pos:  position{line: 336, col: 2, offset: 8294},
+			pos:  position{line: 368, col: 2, offset: 9485},
 			expr: &actionExpr{
-				pos: position{line: 337, col: 4, offset: 8306},
+				pos: position{line: 369, col: 4, offset: 9497},
 				run: (*parser).callonForkOp1,
 				expr: &seqExpr{
-					pos: position{line: 337, col: 4, offset: 8306},
+					pos: position{line: 369, col: 4, offset: 9497},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 337, col: 4, offset: 8306},
+							pos:  position{line: 369, col: 4, offset: 9497},
 							name: "FORK",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 337, col: 9, offset: 8311},
+							pos:  position{line: 369, col: 9, offset: 9502},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 337, col: 12, offset: 8314},
+							pos:        position{line: 369, col: 12, offset: 9505},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 337, col: 16, offset: 8318},
+							pos:   position{line: 369, col: 16, offset: 9509},
 							label: "paths",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 337, col: 22, offset: 8324},
+								pos: position{line: 369, col: 22, offset: 9515},
 								expr: &ruleRefExpr{
-									pos:  position{line: 337, col: 22, offset: 8324},
+									pos:  position{line: 369, col: 22, offset: 9515},
 									name: "Path",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 337, col: 28, offset: 8330},
+							pos:  position{line: 369, col: 28, offset: 9521},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 337, col: 31, offset: 8333},
+							pos:        position{line: 369, col: 31, offset: 9524},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -2693,32 +2952,32 @@ var g = &grammar{
 		},
 		{
 			name: "Path",
-			pos:  position{line: 349, col: 1, offset: 8582},
+			pos:  position{line: 381, col: 1, offset: 9773},
 			expr: &actionExpr{
-				pos: position{line: 349, col: 8, offset: 8589},
+				pos: position{line: 381, col: 8, offset: 9780},
 				run: (*parser).callonPath1,
 				expr: &seqExpr{
-					pos: position{line: 349, col: 8, offset: 8589},
+					pos: position{line: 381, col: 8, offset: 9780},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 349, col: 8, offset: 8589},
+							pos:  position{line: 381, col: 8, offset: 9780},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 349, col: 11, offset: 8592},
+							pos:        position{line: 381, col: 11, offset: 9783},
 							val:        "=>",
 							ignoreCase: false,
 							want:       "\"=>\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 349, col: 16, offset: 8597},
+							pos:  position{line: 381, col: 16, offset: 9788},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 349, col: 19, offset: 8600},
+							pos:   position{line: 381, col: 19, offset: 9791},
 							label: "seq",
 							expr: &ruleRefExpr{
-								pos:  position{line: 349, col: 23, offset: 8604},
+								pos:  position{line: 381, col: 23, offset: 9795},
 								name: "Seq",
 							},
 						},
@@ -2730,59 +2989,59 @@ var g = &grammar{
 		},
 		{
 			name: "SwitchOp",
-			pos:  position{line: 351, col: 1, offset: 8629},
+			pos:  position{line: 383, col: 1, offset: 9820},
 			expr: &choiceExpr{
-				pos: position{line: 352, col: 5, offset: 8642},
+				pos: position{line: 384, col: 5, offset: 9833},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 352, col: 5, offset: 8642},
+						pos: position{line: 384, col: 5, offset: 9833},
 						run: (*parser).callonSwitchOp2,
 						expr: &seqExpr{
-							pos: position{line: 352, col: 5, offset: 8642},
+							pos: position{line: 384, col: 5, offset: 9833},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 352, col: 5, offset: 8642},
+									pos:  position{line: 384, col: 5, offset: 9833},
 									name: "SWITCH",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 352, col: 12, offset: 8649},
+									pos:  position{line: 384, col: 12, offset: 9840},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 352, col: 14, offset: 8651},
+									pos:   position{line: 384, col: 14, offset: 9842},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 352, col: 19, offset: 8656},
+										pos:  position{line: 384, col: 19, offset: 9847},
 										name: "Expr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 352, col: 24, offset: 8661},
+									pos:  position{line: 384, col: 24, offset: 9852},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 352, col: 26, offset: 8663},
+									pos:        position{line: 384, col: 26, offset: 9854},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 352, col: 30, offset: 8667},
+									pos:   position{line: 384, col: 30, offset: 9858},
 									label: "cases",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 352, col: 36, offset: 8673},
+										pos: position{line: 384, col: 36, offset: 9864},
 										expr: &ruleRefExpr{
-											pos:  position{line: 352, col: 36, offset: 8673},
+											pos:  position{line: 384, col: 36, offset: 9864},
 											name: "SwitchPath",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 352, col: 48, offset: 8685},
+									pos:  position{line: 384, col: 48, offset: 9876},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 352, col: 51, offset: 8688},
+									pos:        position{line: 384, col: 51, offset: 9879},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -2791,42 +3050,42 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 360, col: 5, offset: 8868},
+						pos: position{line: 392, col: 5, offset: 10059},
 						run: (*parser).callonSwitchOp15,
 						expr: &seqExpr{
-							pos: position{line: 360, col: 5, offset: 8868},
+							pos: position{line: 392, col: 5, offset: 10059},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 360, col: 5, offset: 8868},
+									pos:  position{line: 392, col: 5, offset: 10059},
 									name: "SWITCH",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 360, col: 12, offset: 8875},
+									pos:  position{line: 392, col: 12, offset: 10066},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 360, col: 15, offset: 8878},
+									pos:        position{line: 392, col: 15, offset: 10069},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 360, col: 19, offset: 8882},
+									pos:   position{line: 392, col: 19, offset: 10073},
 									label: "cases",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 360, col: 25, offset: 8888},
+										pos: position{line: 392, col: 25, offset: 10079},
 										expr: &ruleRefExpr{
-											pos:  position{line: 360, col: 25, offset: 8888},
+											pos:  position{line: 392, col: 25, offset: 10079},
 											name: "SwitchPath",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 360, col: 37, offset: 8900},
+									pos:  position{line: 392, col: 37, offset: 10091},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 360, col: 40, offset: 8903},
+									pos:        position{line: 392, col: 40, offset: 10094},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -2841,30 +3100,30 @@ var g = &grammar{
 		},
 		{
 			name: "SwitchPath",
-			pos:  position{line: 368, col: 1, offset: 9047},
+			pos:  position{line: 400, col: 1, offset: 10238},
 			expr: &actionExpr{
-				pos: position{line: 369, col: 5, offset: 9062},
+				pos: position{line: 401, col: 5, offset: 10253},
 				run: (*parser).callonSwitchPath1,
 				expr: &seqExpr{
-					pos: position{line: 369, col: 5, offset: 9062},
+					pos: position{line: 401, col: 5, offset: 10253},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 369, col: 5, offset: 9062},
+							pos:  position{line: 401, col: 5, offset: 10253},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 369, col: 8, offset: 9065},
+							pos:   position{line: 401, col: 8, offset: 10256},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 369, col: 13, offset: 9070},
+								pos:  position{line: 401, col: 13, offset: 10261},
 								name: "Case",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 369, col: 18, offset: 9075},
+							pos:   position{line: 401, col: 18, offset: 10266},
 							label: "path",
 							expr: &ruleRefExpr{
-								pos:  position{line: 369, col: 23, offset: 9080},
+								pos:  position{line: 401, col: 23, offset: 10271},
 								name: "Path",
 							},
 						},
@@ -2876,29 +3135,29 @@ var g = &grammar{
 		},
 		{
 			name: "Case",
-			pos:  position{line: 377, col: 1, offset: 9227},
+			pos:  position{line: 409, col: 1, offset: 10418},
 			expr: &choiceExpr{
-				pos: position{line: 378, col: 5, offset: 9236},
+				pos: position{line: 410, col: 5, offset: 10427},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 378, col: 5, offset: 9236},
+						pos: position{line: 410, col: 5, offset: 10427},
 						run: (*parser).callonCase2,
 						expr: &seqExpr{
-							pos: position{line: 378, col: 5, offset: 9236},
+							pos: position{line: 410, col: 5, offset: 10427},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 378, col: 5, offset: 9236},
+									pos:  position{line: 410, col: 5, offset: 10427},
 									name: "CASE",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 378, col: 10, offset: 9241},
+									pos:  position{line: 410, col: 10, offset: 10432},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 378, col: 12, offset: 9243},
+									pos:   position{line: 410, col: 12, offset: 10434},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 378, col: 17, offset: 9248},
+										pos:  position{line: 410, col: 17, offset: 10439},
 										name: "Expr",
 									},
 								},
@@ -2906,10 +3165,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 379, col: 5, offset: 9278},
+						pos: position{line: 411, col: 5, offset: 10469},
 						run: (*parser).callonCase8,
 						expr: &ruleRefExpr{
-							pos:  position{line: 379, col: 5, offset: 9278},
+							pos:  position{line: 411, col: 5, offset: 10469},
 							name: "DEFAULT",
 						},
 					},
@@ -2920,44 +3179,44 @@ var g = &grammar{
 		},
 		{
 			name: "FromForkOp",
-			pos:  position{line: 381, col: 1, offset: 9307},
+			pos:  position{line: 413, col: 1, offset: 10498},
 			expr: &actionExpr{
-				pos: position{line: 382, col: 5, offset: 9322},
+				pos: position{line: 414, col: 5, offset: 10513},
 				run: (*parser).callonFromForkOp1,
 				expr: &seqExpr{
-					pos: position{line: 382, col: 5, offset: 9322},
+					pos: position{line: 414, col: 5, offset: 10513},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 382, col: 5, offset: 9322},
+							pos:  position{line: 414, col: 5, offset: 10513},
 							name: "FROM",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 382, col: 10, offset: 9327},
+							pos:  position{line: 414, col: 10, offset: 10518},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 382, col: 13, offset: 9330},
+							pos:        position{line: 414, col: 13, offset: 10521},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 382, col: 17, offset: 9334},
+							pos:   position{line: 414, col: 17, offset: 10525},
 							label: "trunks",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 382, col: 24, offset: 9341},
+								pos: position{line: 414, col: 24, offset: 10532},
 								expr: &ruleRefExpr{
-									pos:  position{line: 382, col: 24, offset: 9341},
+									pos:  position{line: 414, col: 24, offset: 10532},
 									name: "FromPath",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 382, col: 34, offset: 9351},
+							pos:  position{line: 414, col: 34, offset: 10542},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 382, col: 37, offset: 9354},
+							pos:        position{line: 414, col: 37, offset: 10545},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -2970,55 +3229,55 @@ var g = &grammar{
 		},
 		{
 			name: "FromPath",
-			pos:  position{line: 390, col: 1, offset: 9502},
+			pos:  position{line: 422, col: 1, offset: 10693},
 			expr: &actionExpr{
-				pos: position{line: 391, col: 5, offset: 9515},
+				pos: position{line: 423, col: 5, offset: 10706},
 				run: (*parser).callonFromPath1,
 				expr: &seqExpr{
-					pos: position{line: 391, col: 5, offset: 9515},
+					pos: position{line: 423, col: 5, offset: 10706},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 391, col: 5, offset: 9515},
+							pos:  position{line: 423, col: 5, offset: 10706},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 391, col: 8, offset: 9518},
+							pos:   position{line: 423, col: 8, offset: 10709},
 							label: "source",
 							expr: &ruleRefExpr{
-								pos:  position{line: 391, col: 15, offset: 9525},
+								pos:  position{line: 423, col: 15, offset: 10716},
 								name: "FromSource",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 391, col: 26, offset: 9536},
+							pos:   position{line: 423, col: 26, offset: 10727},
 							label: "seq",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 391, col: 30, offset: 9540},
+								pos: position{line: 423, col: 30, offset: 10731},
 								expr: &actionExpr{
-									pos: position{line: 391, col: 31, offset: 9541},
+									pos: position{line: 423, col: 31, offset: 10732},
 									run: (*parser).callonFromPath8,
 									expr: &seqExpr{
-										pos: position{line: 391, col: 31, offset: 9541},
+										pos: position{line: 423, col: 31, offset: 10732},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 391, col: 31, offset: 9541},
+												pos:  position{line: 423, col: 31, offset: 10732},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 391, col: 34, offset: 9544},
+												pos:        position{line: 423, col: 34, offset: 10735},
 												val:        "=>",
 												ignoreCase: false,
 												want:       "\"=>\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 391, col: 39, offset: 9549},
+												pos:  position{line: 423, col: 39, offset: 10740},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 391, col: 42, offset: 9552},
+												pos:   position{line: 423, col: 42, offset: 10743},
 												label: "s",
 												expr: &ruleRefExpr{
-													pos:  position{line: 391, col: 44, offset: 9554},
+													pos:  position{line: 423, col: 44, offset: 10745},
 													name: "Seq",
 												},
 											},
@@ -3035,29 +3294,29 @@ var g = &grammar{
 		},
 		{
 			name: "FromSource",
-			pos:  position{line: 399, col: 1, offset: 9734},
+			pos:  position{line: 431, col: 1, offset: 10925},
 			expr: &choiceExpr{
-				pos: position{line: 400, col: 5, offset: 9749},
+				pos: position{line: 432, col: 5, offset: 10940},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 400, col: 5, offset: 9749},
+						pos: position{line: 432, col: 5, offset: 10940},
 						run: (*parser).callonFromSource2,
 						expr: &seqExpr{
-							pos: position{line: 400, col: 5, offset: 9749},
+							pos: position{line: 432, col: 5, offset: 10940},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 400, col: 5, offset: 9749},
+									pos:  position{line: 432, col: 5, offset: 10940},
 									name: "FromKeyWord",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 400, col: 17, offset: 9761},
+									pos:  position{line: 432, col: 17, offset: 10952},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 400, col: 19, offset: 9763},
+									pos:   position{line: 432, col: 19, offset: 10954},
 									label: "elem",
 									expr: &ruleRefExpr{
-										pos:  position{line: 400, col: 24, offset: 9768},
+										pos:  position{line: 432, col: 24, offset: 10959},
 										name: "FromElem",
 									},
 								},
@@ -3065,7 +3324,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 407, col: 5, offset: 9939},
+						pos:  position{line: 439, col: 5, offset: 11130},
 						name: "PassOp",
 					},
 				},
@@ -3075,40 +3334,40 @@ var g = &grammar{
 		},
 		{
 			name: "SearchOp",
-			pos:  position{line: 409, col: 1, offset: 9947},
+			pos:  position{line: 441, col: 1, offset: 11138},
 			expr: &actionExpr{
-				pos: position{line: 410, col: 5, offset: 9960},
+				pos: position{line: 442, col: 5, offset: 11151},
 				run: (*parser).callonSearchOp1,
 				expr: &seqExpr{
-					pos: position{line: 410, col: 5, offset: 9960},
+					pos: position{line: 442, col: 5, offset: 11151},
 					exprs: []any{
 						&choiceExpr{
-							pos: position{line: 410, col: 6, offset: 9961},
+							pos: position{line: 442, col: 6, offset: 11152},
 							alternatives: []any{
 								&seqExpr{
-									pos: position{line: 410, col: 6, offset: 9961},
+									pos: position{line: 442, col: 6, offset: 11152},
 									exprs: []any{
 										&ruleRefExpr{
-											pos:  position{line: 410, col: 6, offset: 9961},
+											pos:  position{line: 442, col: 6, offset: 11152},
 											name: "SEARCH",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 410, col: 13, offset: 9968},
+											pos:  position{line: 442, col: 13, offset: 11159},
 											name: "_",
 										},
 									},
 								},
 								&seqExpr{
-									pos: position{line: 410, col: 17, offset: 9972},
+									pos: position{line: 442, col: 17, offset: 11163},
 									exprs: []any{
 										&litMatcher{
-											pos:        position{line: 410, col: 17, offset: 9972},
+											pos:        position{line: 442, col: 17, offset: 11163},
 											val:        "?",
 											ignoreCase: false,
 											want:       "\"?\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 410, col: 21, offset: 9976},
+											pos:  position{line: 442, col: 21, offset: 11167},
 											name: "__",
 										},
 									},
@@ -3116,10 +3375,10 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 410, col: 25, offset: 9980},
+							pos:   position{line: 442, col: 25, offset: 11171},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 410, col: 30, offset: 9985},
+								pos:  position{line: 442, col: 30, offset: 11176},
 								name: "SearchBoolean",
 							},
 						},
@@ -3131,32 +3390,32 @@ var g = &grammar{
 		},
 		{
 			name: "AssertOp",
-			pos:  position{line: 414, col: 1, offset: 10085},
+			pos:  position{line: 446, col: 1, offset: 11276},
 			expr: &actionExpr{
-				pos: position{line: 415, col: 5, offset: 10098},
+				pos: position{line: 447, col: 5, offset: 11289},
 				run: (*parser).callonAssertOp1,
 				expr: &seqExpr{
-					pos: position{line: 415, col: 5, offset: 10098},
+					pos: position{line: 447, col: 5, offset: 11289},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 415, col: 5, offset: 10098},
+							pos:  position{line: 447, col: 5, offset: 11289},
 							name: "ASSERT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 415, col: 12, offset: 10105},
+							pos:  position{line: 447, col: 12, offset: 11296},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 415, col: 14, offset: 10107},
+							pos:   position{line: 447, col: 14, offset: 11298},
 							label: "expr",
 							expr: &actionExpr{
-								pos: position{line: 415, col: 20, offset: 10113},
+								pos: position{line: 447, col: 20, offset: 11304},
 								run: (*parser).callonAssertOp6,
 								expr: &labeledExpr{
-									pos:   position{line: 415, col: 20, offset: 10113},
+									pos:   position{line: 447, col: 20, offset: 11304},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 415, col: 22, offset: 10115},
+										pos:  position{line: 447, col: 22, offset: 11306},
 										name: "Expr",
 									},
 								},
@@ -3170,33 +3429,33 @@ var g = &grammar{
 		},
 		{
 			name: "SortOp",
-			pos:  position{line: 424, col: 1, offset: 10345},
+			pos:  position{line: 456, col: 1, offset: 11536},
 			expr: &actionExpr{
-				pos: position{line: 425, col: 5, offset: 10356},
+				pos: position{line: 457, col: 5, offset: 11547},
 				run: (*parser).callonSortOp1,
 				expr: &seqExpr{
-					pos: position{line: 425, col: 5, offset: 10356},
+					pos: position{line: 457, col: 5, offset: 11547},
 					exprs: []any{
 						&choiceExpr{
-							pos: position{line: 425, col: 6, offset: 10357},
+							pos: position{line: 457, col: 6, offset: 11548},
 							alternatives: []any{
 								&ruleRefExpr{
-									pos:  position{line: 425, col: 6, offset: 10357},
+									pos:  position{line: 457, col: 6, offset: 11548},
 									name: "SORT",
 								},
 								&seqExpr{
-									pos: position{line: 425, col: 13, offset: 10364},
+									pos: position{line: 457, col: 13, offset: 11555},
 									exprs: []any{
 										&ruleRefExpr{
-											pos:  position{line: 425, col: 13, offset: 10364},
+											pos:  position{line: 457, col: 13, offset: 11555},
 											name: "ORDER",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 425, col: 19, offset: 10370},
+											pos:  position{line: 457, col: 19, offset: 11561},
 											name: "_",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 425, col: 21, offset: 10372},
+											pos:  position{line: 457, col: 21, offset: 11563},
 											name: "BY",
 										},
 									},
@@ -3204,40 +3463,40 @@ var g = &grammar{
 							},
 						},
 						&andExpr{
-							pos: position{line: 425, col: 25, offset: 10376},
+							pos: position{line: 457, col: 25, offset: 11567},
 							expr: &ruleRefExpr{
-								pos:  position{line: 425, col: 26, offset: 10377},
+								pos:  position{line: 457, col: 26, offset: 11568},
 								name: "EOKW",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 425, col: 31, offset: 10382},
+							pos:   position{line: 457, col: 31, offset: 11573},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 425, col: 36, offset: 10387},
+								pos:  position{line: 457, col: 36, offset: 11578},
 								name: "SortArgs",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 425, col: 45, offset: 10396},
+							pos:   position{line: 457, col: 45, offset: 11587},
 							label: "exprs",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 425, col: 51, offset: 10402},
+								pos: position{line: 457, col: 51, offset: 11593},
 								expr: &actionExpr{
-									pos: position{line: 425, col: 52, offset: 10403},
+									pos: position{line: 457, col: 52, offset: 11594},
 									run: (*parser).callonSortOp15,
 									expr: &seqExpr{
-										pos: position{line: 425, col: 52, offset: 10403},
+										pos: position{line: 457, col: 52, offset: 11594},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 425, col: 52, offset: 10403},
+												pos:  position{line: 457, col: 52, offset: 11594},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 425, col: 55, offset: 10406},
+												pos:   position{line: 457, col: 55, offset: 11597},
 												label: "e",
 												expr: &ruleRefExpr{
-													pos:  position{line: 425, col: 57, offset: 10408},
+													pos:  position{line: 457, col: 57, offset: 11599},
 													name: "OrderByList",
 												},
 											},
@@ -3254,30 +3513,30 @@ var g = &grammar{
 		},
 		{
 			name: "SortArgs",
-			pos:  position{line: 440, col: 1, offset: 10718},
+			pos:  position{line: 472, col: 1, offset: 11909},
 			expr: &actionExpr{
-				pos: position{line: 440, col: 12, offset: 10729},
+				pos: position{line: 472, col: 12, offset: 11920},
 				run: (*parser).callonSortArgs1,
 				expr: &labeledExpr{
-					pos:   position{line: 440, col: 12, offset: 10729},
+					pos:   position{line: 472, col: 12, offset: 11920},
 					label: "args",
 					expr: &zeroOrMoreExpr{
-						pos: position{line: 440, col: 17, offset: 10734},
+						pos: position{line: 472, col: 17, offset: 11925},
 						expr: &actionExpr{
-							pos: position{line: 440, col: 18, offset: 10735},
+							pos: position{line: 472, col: 18, offset: 11926},
 							run: (*parser).callonSortArgs4,
 							expr: &seqExpr{
-								pos: position{line: 440, col: 18, offset: 10735},
+								pos: position{line: 472, col: 18, offset: 11926},
 								exprs: []any{
 									&ruleRefExpr{
-										pos:  position{line: 440, col: 18, offset: 10735},
+										pos:  position{line: 472, col: 18, offset: 11926},
 										name: "_",
 									},
 									&labeledExpr{
-										pos:   position{line: 440, col: 20, offset: 10737},
+										pos:   position{line: 472, col: 20, offset: 11928},
 										label: "a",
 										expr: &ruleRefExpr{
-											pos:  position{line: 440, col: 22, offset: 10739},
+											pos:  position{line: 472, col: 22, offset: 11930},
 											name: "SortArg",
 										},
 									},
@@ -3292,12 +3551,12 @@ var g = &grammar{
 		},
 		{
 			name: "SortArg",
-			pos:  position{line: 442, col: 1, offset: 10796},
+			pos:  position{line: 474, col: 1, offset: 11987},
 			expr: &actionExpr{
-				pos: position{line: 443, col: 5, offset: 10808},
+				pos: position{line: 475, col: 5, offset: 11999},
 				run: (*parser).callonSortArg1,
 				expr: &litMatcher{
-					pos:        position{line: 443, col: 5, offset: 10808},
+					pos:        position{line: 475, col: 5, offset: 11999},
 					val:        "-r",
 					ignoreCase: false,
 					want:       "\"-r\"",
@@ -3308,52 +3567,52 @@ var g = &grammar{
 		},
 		{
 			name: "TopOp",
-			pos:  position{line: 445, col: 1, offset: 10872},
+			pos:  position{line: 477, col: 1, offset: 12063},
 			expr: &actionExpr{
-				pos: position{line: 446, col: 5, offset: 10882},
+				pos: position{line: 478, col: 5, offset: 12073},
 				run: (*parser).callonTopOp1,
 				expr: &seqExpr{
-					pos: position{line: 446, col: 5, offset: 10882},
+					pos: position{line: 478, col: 5, offset: 12073},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 446, col: 5, offset: 10882},
+							pos:  position{line: 478, col: 5, offset: 12073},
 							name: "TOP",
 						},
 						&andExpr{
-							pos: position{line: 446, col: 9, offset: 10886},
+							pos: position{line: 478, col: 9, offset: 12077},
 							expr: &ruleRefExpr{
-								pos:  position{line: 446, col: 10, offset: 10887},
+								pos:  position{line: 478, col: 10, offset: 12078},
 								name: "EOKW",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 446, col: 15, offset: 10892},
+							pos:   position{line: 478, col: 15, offset: 12083},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 446, col: 20, offset: 10897},
+								pos:  position{line: 478, col: 20, offset: 12088},
 								name: "SortArgs",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 446, col: 29, offset: 10906},
+							pos:   position{line: 478, col: 29, offset: 12097},
 							label: "limit",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 446, col: 35, offset: 10912},
+								pos: position{line: 478, col: 35, offset: 12103},
 								expr: &actionExpr{
-									pos: position{line: 446, col: 36, offset: 10913},
+									pos: position{line: 478, col: 36, offset: 12104},
 									run: (*parser).callonTopOp10,
 									expr: &seqExpr{
-										pos: position{line: 446, col: 36, offset: 10913},
+										pos: position{line: 478, col: 36, offset: 12104},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 446, col: 36, offset: 10913},
+												pos:  position{line: 478, col: 36, offset: 12104},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 446, col: 38, offset: 10915},
+												pos:   position{line: 478, col: 38, offset: 12106},
 												label: "e",
 												expr: &ruleRefExpr{
-													pos:  position{line: 446, col: 40, offset: 10917},
+													pos:  position{line: 478, col: 40, offset: 12108},
 													name: "Expr",
 												},
 											},
@@ -3363,25 +3622,25 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 446, col: 65, offset: 10942},
+							pos:   position{line: 478, col: 65, offset: 12133},
 							label: "exprs",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 446, col: 71, offset: 10948},
+								pos: position{line: 478, col: 71, offset: 12139},
 								expr: &actionExpr{
-									pos: position{line: 446, col: 72, offset: 10949},
+									pos: position{line: 478, col: 72, offset: 12140},
 									run: (*parser).callonTopOp17,
 									expr: &seqExpr{
-										pos: position{line: 446, col: 72, offset: 10949},
+										pos: position{line: 478, col: 72, offset: 12140},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 446, col: 72, offset: 10949},
+												pos:  position{line: 478, col: 72, offset: 12140},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 446, col: 74, offset: 10951},
+												pos:   position{line: 478, col: 74, offset: 12142},
 												label: "e",
 												expr: &ruleRefExpr{
-													pos:  position{line: 446, col: 76, offset: 10953},
+													pos:  position{line: 478, col: 76, offset: 12144},
 													name: "OrderByList",
 												},
 											},
@@ -3398,26 +3657,26 @@ var g = &grammar{
 		},
 		{
 			name: "CutOp",
-			pos:  position{line: 464, col: 1, offset: 11333},
+			pos:  position{line: 496, col: 1, offset: 12524},
 			expr: &actionExpr{
-				pos: position{line: 465, col: 5, offset: 11343},
+				pos: position{line: 497, col: 5, offset: 12534},
 				run: (*parser).callonCutOp1,
 				expr: &seqExpr{
-					pos: position{line: 465, col: 5, offset: 11343},
+					pos: position{line: 497, col: 5, offset: 12534},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 465, col: 5, offset: 11343},
+							pos:  position{line: 497, col: 5, offset: 12534},
 							name: "CUT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 465, col: 9, offset: 11347},
+							pos:  position{line: 497, col: 9, offset: 12538},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 465, col: 11, offset: 11349},
+							pos:   position{line: 497, col: 11, offset: 12540},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 465, col: 16, offset: 11354},
+								pos:  position{line: 497, col: 16, offset: 12545},
 								name: "FlexAssignments",
 							},
 						},
@@ -3429,27 +3688,77 @@ var g = &grammar{
 		},
 		{
 			name: "DistinctOp",
-			pos:  position{line: 473, col: 1, offset: 11502},
-			expr: &actionExpr{
-				pos: position{line: 474, col: 5, offset: 11517},
-				run: (*parser).callonDistinctOp1,
-				expr: &seqExpr{
-					pos: position{line: 474, col: 5, offset: 11517},
-					exprs: []any{
-						&ruleRefExpr{
-							pos:  position{line: 474, col: 5, offset: 11517},
-							name: "DISTINCT",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 474, col: 14, offset: 11526},
-							name: "_",
+			pos:  position{line: 505, col: 1, offset: 12693},
+			expr: &choiceExpr{
+				pos: position{line: 506, col: 5, offset: 12708},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 506, col: 5, offset: 12708},
+						run: (*parser).callonDistinctOp2,
+						expr: &seqExpr{
+							pos: position{line: 506, col: 5, offset: 12708},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 506, col: 5, offset: 12708},
+									name: "DISTINCT",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 506, col: 14, offset: 12717},
+									name: "_",
+								},
+								&notExpr{
+									pos: position{line: 506, col: 16, offset: 12719},
+									expr: &ruleRefExpr{
+										pos:  position{line: 506, col: 17, offset: 12720},
+										name: "EndOfOp",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 506, col: 25, offset: 12728},
+									label: "e",
+									expr: &ruleRefExpr{
+										pos:  position{line: 506, col: 27, offset: 12730},
+										name: "Expr",
+									},
+								},
+							},
 						},
-						&labeledExpr{
-							pos:   position{line: 474, col: 16, offset: 11528},
-							label: "e",
-							expr: &ruleRefExpr{
-								pos:  position{line: 474, col: 18, offset: 11530},
-								name: "Expr",
+					},
+					&actionExpr{
+						pos: position{line: 513, col: 5, offset: 12869},
+						run: (*parser).callonDistinctOp10,
+						expr: &seqExpr{
+							pos: position{line: 513, col: 5, offset: 12869},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 513, col: 5, offset: 12869},
+									name: "DISTINCT",
+								},
+								&notExpr{
+									pos: position{line: 513, col: 14, offset: 12878},
+									expr: &seqExpr{
+										pos: position{line: 513, col: 16, offset: 12880},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 513, col: 16, offset: 12880},
+												name: "__",
+											},
+											&litMatcher{
+												pos:        position{line: 513, col: 19, offset: 12883},
+												val:        "(",
+												ignoreCase: false,
+												want:       "\"(\"",
+											},
+										},
+									},
+								},
+								&andExpr{
+									pos: position{line: 513, col: 24, offset: 12888},
+									expr: &ruleRefExpr{
+										pos:  position{line: 513, col: 25, offset: 12889},
+										name: "EOKW",
+									},
+								},
 							},
 						},
 					},
@@ -3460,26 +3769,26 @@ var g = &grammar{
 		},
 		{
 			name: "DropOp",
-			pos:  position{line: 482, col: 1, offset: 11666},
+			pos:  position{line: 520, col: 1, offset: 12995},
 			expr: &actionExpr{
-				pos: position{line: 483, col: 5, offset: 11677},
+				pos: position{line: 521, col: 5, offset: 13006},
 				run: (*parser).callonDropOp1,
 				expr: &seqExpr{
-					pos: position{line: 483, col: 5, offset: 11677},
+					pos: position{line: 521, col: 5, offset: 13006},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 483, col: 5, offset: 11677},
+							pos:  position{line: 521, col: 5, offset: 13006},
 							name: "DROP",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 483, col: 10, offset: 11682},
+							pos:  position{line: 521, col: 10, offset: 13011},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 483, col: 12, offset: 11684},
+							pos:   position{line: 521, col: 12, offset: 13013},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 483, col: 17, offset: 11689},
+								pos:  position{line: 521, col: 17, offset: 13018},
 								name: "Lvals",
 							},
 						},
@@ -3491,45 +3800,45 @@ var g = &grammar{
 		},
 		{
 			name: "HeadOp",
-			pos:  position{line: 491, col: 1, offset: 11829},
+			pos:  position{line: 529, col: 1, offset: 13158},
 			expr: &choiceExpr{
-				pos: position{line: 492, col: 5, offset: 11840},
+				pos: position{line: 530, col: 5, offset: 13169},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 492, col: 5, offset: 11840},
+						pos: position{line: 530, col: 5, offset: 13169},
 						run: (*parser).callonHeadOp2,
 						expr: &seqExpr{
-							pos: position{line: 492, col: 5, offset: 11840},
+							pos: position{line: 530, col: 5, offset: 13169},
 							exprs: []any{
 								&choiceExpr{
-									pos: position{line: 492, col: 6, offset: 11841},
+									pos: position{line: 530, col: 6, offset: 13170},
 									alternatives: []any{
 										&ruleRefExpr{
-											pos:  position{line: 492, col: 6, offset: 11841},
+											pos:  position{line: 530, col: 6, offset: 13170},
 											name: "HEAD",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 492, col: 13, offset: 11848},
+											pos:  position{line: 530, col: 13, offset: 13177},
 											name: "LIMIT",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 492, col: 20, offset: 11855},
+									pos:  position{line: 530, col: 20, offset: 13184},
 									name: "_",
 								},
 								&notExpr{
-									pos: position{line: 492, col: 22, offset: 11857},
+									pos: position{line: 530, col: 22, offset: 13186},
 									expr: &ruleRefExpr{
-										pos:  position{line: 492, col: 23, offset: 11858},
+										pos:  position{line: 530, col: 23, offset: 13187},
 										name: "EndOfOp",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 492, col: 31, offset: 11866},
+									pos:   position{line: 530, col: 31, offset: 13195},
 									label: "count",
 									expr: &ruleRefExpr{
-										pos:  position{line: 492, col: 37, offset: 11872},
+										pos:  position{line: 530, col: 37, offset: 13201},
 										name: "Expr",
 									},
 								},
@@ -3537,26 +3846,26 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 499, col: 5, offset: 12002},
+						pos: position{line: 537, col: 5, offset: 13331},
 						run: (*parser).callonHeadOp12,
 						expr: &seqExpr{
-							pos: position{line: 499, col: 5, offset: 12002},
+							pos: position{line: 537, col: 5, offset: 13331},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 499, col: 5, offset: 12002},
+									pos:  position{line: 537, col: 5, offset: 13331},
 									name: "HEAD",
 								},
 								&notExpr{
-									pos: position{line: 499, col: 10, offset: 12007},
+									pos: position{line: 537, col: 10, offset: 13336},
 									expr: &seqExpr{
-										pos: position{line: 499, col: 12, offset: 12009},
+										pos: position{line: 537, col: 12, offset: 13338},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 499, col: 12, offset: 12009},
+												pos:  position{line: 537, col: 12, offset: 13338},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 499, col: 15, offset: 12012},
+												pos:        position{line: 537, col: 15, offset: 13341},
 												val:        "(",
 												ignoreCase: false,
 												want:       "\"(\"",
@@ -3565,9 +3874,9 @@ var g = &grammar{
 									},
 								},
 								&andExpr{
-									pos: position{line: 499, col: 20, offset: 12017},
+									pos: position{line: 537, col: 20, offset: 13346},
 									expr: &ruleRefExpr{
-										pos:  position{line: 499, col: 21, offset: 12018},
+										pos:  position{line: 537, col: 21, offset: 13347},
 										name: "EOKW",
 									},
 								},
@@ -3581,36 +3890,36 @@ var g = &grammar{
 		},
 		{
 			name: "TailOp",
-			pos:  position{line: 506, col: 1, offset: 12112},
+			pos:  position{line: 544, col: 1, offset: 13441},
 			expr: &choiceExpr{
-				pos: position{line: 507, col: 5, offset: 12123},
+				pos: position{line: 545, col: 5, offset: 13452},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 507, col: 5, offset: 12123},
+						pos: position{line: 545, col: 5, offset: 13452},
 						run: (*parser).callonTailOp2,
 						expr: &seqExpr{
-							pos: position{line: 507, col: 5, offset: 12123},
+							pos: position{line: 545, col: 5, offset: 13452},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 507, col: 5, offset: 12123},
+									pos:  position{line: 545, col: 5, offset: 13452},
 									name: "TAIL",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 507, col: 10, offset: 12128},
+									pos:  position{line: 545, col: 10, offset: 13457},
 									name: "_",
 								},
 								&notExpr{
-									pos: position{line: 507, col: 12, offset: 12130},
+									pos: position{line: 545, col: 12, offset: 13459},
 									expr: &ruleRefExpr{
-										pos:  position{line: 507, col: 13, offset: 12131},
+										pos:  position{line: 545, col: 13, offset: 13460},
 										name: "EndOfOp",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 507, col: 21, offset: 12139},
+									pos:   position{line: 545, col: 21, offset: 13468},
 									label: "count",
 									expr: &ruleRefExpr{
-										pos:  position{line: 507, col: 27, offset: 12145},
+										pos:  position{line: 545, col: 27, offset: 13474},
 										name: "Expr",
 									},
 								},
@@ -3618,26 +3927,26 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 514, col: 5, offset: 12275},
+						pos: position{line: 552, col: 5, offset: 13604},
 						run: (*parser).callonTailOp10,
 						expr: &seqExpr{
-							pos: position{line: 514, col: 5, offset: 12275},
+							pos: position{line: 552, col: 5, offset: 13604},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 514, col: 5, offset: 12275},
+									pos:  position{line: 552, col: 5, offset: 13604},
 									name: "TAIL",
 								},
 								&notExpr{
-									pos: position{line: 514, col: 10, offset: 12280},
+									pos: position{line: 552, col: 10, offset: 13609},
 									expr: &seqExpr{
-										pos: position{line: 514, col: 12, offset: 12282},
+										pos: position{line: 552, col: 12, offset: 13611},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 514, col: 12, offset: 12282},
+												pos:  position{line: 552, col: 12, offset: 13611},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 514, col: 15, offset: 12285},
+												pos:        position{line: 552, col: 15, offset: 13614},
 												val:        "(",
 												ignoreCase: false,
 												want:       "\"(\"",
@@ -3646,9 +3955,9 @@ var g = &grammar{
 									},
 								},
 								&andExpr{
-									pos: position{line: 514, col: 20, offset: 12290},
+									pos: position{line: 552, col: 20, offset: 13619},
 									expr: &ruleRefExpr{
-										pos:  position{line: 514, col: 21, offset: 12291},
+										pos:  position{line: 552, col: 21, offset: 13620},
 										name: "EOKW",
 									},
 								},
@@ -3662,26 +3971,26 @@ var g = &grammar{
 		},
 		{
 			name: "SkipOp",
-			pos:  position{line: 521, col: 1, offset: 12385},
+			pos:  position{line: 559, col: 1, offset: 13714},
 			expr: &actionExpr{
-				pos: position{line: 522, col: 5, offset: 12396},
+				pos: position{line: 560, col: 5, offset: 13725},
 				run: (*parser).callonSkipOp1,
 				expr: &seqExpr{
-					pos: position{line: 522, col: 5, offset: 12396},
+					pos: position{line: 560, col: 5, offset: 13725},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 522, col: 5, offset: 12396},
+							pos:  position{line: 560, col: 5, offset: 13725},
 							name: "SKIP",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 522, col: 10, offset: 12401},
+							pos:  position{line: 560, col: 10, offset: 13730},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 522, col: 12, offset: 12403},
+							pos:   position{line: 560, col: 12, offset: 13732},
 							label: "count",
 							expr: &ruleRefExpr{
-								pos:  position{line: 522, col: 18, offset: 12409},
+								pos:  position{line: 560, col: 18, offset: 13738},
 								name: "Expr",
 							},
 						},
@@ -3693,26 +4002,26 @@ var g = &grammar{
 		},
 		{
 			name: "WhereOp",
-			pos:  position{line: 530, col: 1, offset: 12536},
+			pos:  position{line: 568, col: 1, offset: 13865},
 			expr: &actionExpr{
-				pos: position{line: 531, col: 5, offset: 12548},
+				pos: position{line: 569, col: 5, offset: 13877},
 				run: (*parser).callonWhereOp1,
 				expr: &seqExpr{
-					pos: position{line: 531, col: 5, offset: 12548},
+					pos: position{line: 569, col: 5, offset: 13877},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 531, col: 5, offset: 12548},
+							pos:  position{line: 569, col: 5, offset: 13877},
 							name: "WHERE",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 531, col: 11, offset: 12554},
+							pos:  position{line: 569, col: 11, offset: 13883},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 531, col: 13, offset: 12556},
+							pos:   position{line: 569, col: 13, offset: 13885},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 531, col: 18, offset: 12561},
+								pos:  position{line: 569, col: 18, offset: 13890},
 								name: "Expr",
 							},
 						},
@@ -3724,26 +4033,26 @@ var g = &grammar{
 		},
 		{
 			name: "UniqOp",
-			pos:  position{line: 539, col: 1, offset: 12688},
+			pos:  position{line: 577, col: 1, offset: 14017},
 			expr: &choiceExpr{
-				pos: position{line: 540, col: 5, offset: 12699},
+				pos: position{line: 578, col: 5, offset: 14028},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 540, col: 5, offset: 12699},
+						pos: position{line: 578, col: 5, offset: 14028},
 						run: (*parser).callonUniqOp2,
 						expr: &seqExpr{
-							pos: position{line: 540, col: 5, offset: 12699},
+							pos: position{line: 578, col: 5, offset: 14028},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 540, col: 5, offset: 12699},
+									pos:  position{line: 578, col: 5, offset: 14028},
 									name: "UNIQ",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 540, col: 10, offset: 12704},
+									pos:  position{line: 578, col: 10, offset: 14033},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 540, col: 12, offset: 12706},
+									pos:        position{line: 578, col: 12, offset: 14035},
 									val:        "-c",
 									ignoreCase: false,
 									want:       "\"-c\"",
@@ -3752,26 +4061,26 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 543, col: 5, offset: 12791},
+						pos: position{line: 581, col: 5, offset: 14120},
 						run: (*parser).callonUniqOp7,
 						expr: &seqExpr{
-							pos: position{line: 543, col: 5, offset: 12791},
+							pos: position{line: 581, col: 5, offset: 14120},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 543, col: 5, offset: 12791},
+									pos:  position{line: 581, col: 5, offset: 14120},
 									name: "UNIQ",
 								},
 								&notExpr{
-									pos: position{line: 543, col: 10, offset: 12796},
+									pos: position{line: 581, col: 10, offset: 14125},
 									expr: &seqExpr{
-										pos: position{line: 543, col: 12, offset: 12798},
+										pos: position{line: 581, col: 12, offset: 14127},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 543, col: 12, offset: 12798},
+												pos:  position{line: 581, col: 12, offset: 14127},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 543, col: 15, offset: 12801},
+												pos:        position{line: 581, col: 15, offset: 14130},
 												val:        "(",
 												ignoreCase: false,
 												want:       "\"(\"",
@@ -3780,9 +4089,9 @@ var g = &grammar{
 									},
 								},
 								&andExpr{
-									pos: position{line: 543, col: 20, offset: 12806},
+									pos: position{line: 581, col: 20, offset: 14135},
 									expr: &ruleRefExpr{
-										pos:  position{line: 543, col: 21, offset: 12807},
+										pos:  position{line: 581, col: 21, offset: 14136},
 										name: "EOKW",
 									},
 								},
@@ -3796,26 +4105,26 @@ var g = &grammar{
 		},
 		{
 			name: "PutOp",
-			pos:  position{line: 547, col: 1, offset: 12876},
+			pos:  position{line: 585, col: 1, offset: 14205},
 			expr: &actionExpr{
-				pos: position{line: 548, col: 5, offset: 12886},
+				pos: position{line: 586, col: 5, offset: 14215},
 				run: (*parser).callonPutOp1,
 				expr: &seqExpr{
-					pos: position{line: 548, col: 5, offset: 12886},
+					pos: position{line: 586, col: 5, offset: 14215},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 548, col: 5, offset: 12886},
+							pos:  position{line: 586, col: 5, offset: 14215},
 							name: "PUT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 548, col: 9, offset: 12890},
+							pos:  position{line: 586, col: 9, offset: 14219},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 548, col: 11, offset: 12892},
+							pos:   position{line: 586, col: 11, offset: 14221},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 548, col: 16, offset: 12897},
+								pos:  position{line: 586, col: 16, offset: 14226},
 								name: "Assignments",
 							},
 						},
@@ -3827,59 +4136,59 @@ var g = &grammar{
 		},
 		{
 			name: "RenameOp",
-			pos:  position{line: 556, col: 1, offset: 13047},
+			pos:  position{line: 594, col: 1, offset: 14376},
 			expr: &actionExpr{
-				pos: position{line: 557, col: 5, offset: 13060},
+				pos: position{line: 595, col: 5, offset: 14389},
 				run: (*parser).callonRenameOp1,
 				expr: &seqExpr{
-					pos: position{line: 557, col: 5, offset: 13060},
+					pos: position{line: 595, col: 5, offset: 14389},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 557, col: 5, offset: 13060},
+							pos:  position{line: 595, col: 5, offset: 14389},
 							name: "RENAME",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 557, col: 12, offset: 13067},
+							pos:  position{line: 595, col: 12, offset: 14396},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 557, col: 14, offset: 13069},
+							pos:   position{line: 595, col: 14, offset: 14398},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 557, col: 20, offset: 13075},
+								pos:  position{line: 595, col: 20, offset: 14404},
 								name: "Assignment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 557, col: 31, offset: 13086},
+							pos:   position{line: 595, col: 31, offset: 14415},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 557, col: 36, offset: 13091},
+								pos: position{line: 595, col: 36, offset: 14420},
 								expr: &actionExpr{
-									pos: position{line: 557, col: 37, offset: 13092},
+									pos: position{line: 595, col: 37, offset: 14421},
 									run: (*parser).callonRenameOp9,
 									expr: &seqExpr{
-										pos: position{line: 557, col: 37, offset: 13092},
+										pos: position{line: 595, col: 37, offset: 14421},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 557, col: 37, offset: 13092},
+												pos:  position{line: 595, col: 37, offset: 14421},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 557, col: 40, offset: 13095},
+												pos:        position{line: 595, col: 40, offset: 14424},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 557, col: 44, offset: 13099},
+												pos:  position{line: 595, col: 44, offset: 14428},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 557, col: 47, offset: 13102},
+												pos:   position{line: 595, col: 47, offset: 14431},
 												label: "cl",
 												expr: &ruleRefExpr{
-													pos:  position{line: 557, col: 50, offset: 13105},
+													pos:  position{line: 595, col: 50, offset: 14434},
 													name: "Assignment",
 												},
 											},
@@ -3896,28 +4205,28 @@ var g = &grammar{
 		},
 		{
 			name: "FuseOp",
-			pos:  position{line: 570, col: 1, offset: 13570},
+			pos:  position{line: 608, col: 1, offset: 14899},
 			expr: &actionExpr{
-				pos: position{line: 571, col: 5, offset: 13581},
+				pos: position{line: 609, col: 5, offset: 14910},
 				run: (*parser).callonFuseOp1,
 				expr: &seqExpr{
-					pos: position{line: 571, col: 5, offset: 13581},
+					pos: position{line: 609, col: 5, offset: 14910},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 571, col: 5, offset: 13581},
+							pos:  position{line: 609, col: 5, offset: 14910},
 							name: "FUSE",
 						},
 						&notExpr{
-							pos: position{line: 571, col: 10, offset: 13586},
+							pos: position{line: 609, col: 10, offset: 14915},
 							expr: &seqExpr{
-								pos: position{line: 571, col: 12, offset: 13588},
+								pos: position{line: 609, col: 12, offset: 14917},
 								exprs: []any{
 									&ruleRefExpr{
-										pos:  position{line: 571, col: 12, offset: 13588},
+										pos:  position{line: 609, col: 12, offset: 14917},
 										name: "__",
 									},
 									&litMatcher{
-										pos:        position{line: 571, col: 15, offset: 13591},
+										pos:        position{line: 609, col: 15, offset: 14920},
 										val:        "(",
 										ignoreCase: false,
 										want:       "\"(\"",
@@ -3926,9 +4235,9 @@ var g = &grammar{
 							},
 						},
 						&andExpr{
-							pos: position{line: 571, col: 20, offset: 13596},
+							pos: position{line: 609, col: 20, offset: 14925},
 							expr: &ruleRefExpr{
-								pos:  position{line: 571, col: 21, offset: 13597},
+								pos:  position{line: 609, col: 21, offset: 14926},
 								name: "EOKW",
 							},
 						},
@@ -3940,28 +4249,28 @@ var g = &grammar{
 		},
 		{
 			name: "ShapeOp",
-			pos:  position{line: 575, col: 1, offset: 13666},
+			pos:  position{line: 613, col: 1, offset: 14995},
 			expr: &actionExpr{
-				pos: position{line: 576, col: 5, offset: 13678},
+				pos: position{line: 614, col: 5, offset: 15007},
 				run: (*parser).callonShapeOp1,
 				expr: &seqExpr{
-					pos: position{line: 576, col: 5, offset: 13678},
+					pos: position{line: 614, col: 5, offset: 15007},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 576, col: 5, offset: 13678},
+							pos:  position{line: 614, col: 5, offset: 15007},
 							name: "SHAPE",
 						},
 						&notExpr{
-							pos: position{line: 576, col: 11, offset: 13684},
+							pos: position{line: 614, col: 11, offset: 15013},
 							expr: &seqExpr{
-								pos: position{line: 576, col: 13, offset: 13686},
+								pos: position{line: 614, col: 13, offset: 15015},
 								exprs: []any{
 									&ruleRefExpr{
-										pos:  position{line: 576, col: 13, offset: 13686},
+										pos:  position{line: 614, col: 13, offset: 15015},
 										name: "__",
 									},
 									&litMatcher{
-										pos:        position{line: 576, col: 16, offset: 13689},
+										pos:        position{line: 614, col: 16, offset: 15018},
 										val:        "(",
 										ignoreCase: false,
 										want:       "\"(\"",
@@ -3970,9 +4279,9 @@ var g = &grammar{
 							},
 						},
 						&andExpr{
-							pos: position{line: 576, col: 21, offset: 13694},
+							pos: position{line: 614, col: 21, offset: 15023},
 							expr: &ruleRefExpr{
-								pos:  position{line: 576, col: 22, offset: 13695},
+								pos:  position{line: 614, col: 22, offset: 15024},
 								name: "EOKW",
 							},
 						},
@@ -3984,56 +4293,146 @@ var g = &grammar{
 		},
 		{
 			name: "JoinOp",
-			pos:  position{line: 580, col: 1, offset: 13766},
-			expr: &actionExpr{
-				pos: position{line: 581, col: 5, offset: 13777},
-				run: (*parser).callonJoinOp1,
-				expr: &seqExpr{
-					pos: position{line: 581, col: 5, offset: 13777},
-					exprs: []any{
-						&labeledExpr{
-							pos:   position{line: 581, col: 5, offset: 13777},
-							label: "style",
-							expr: &ruleRefExpr{
-								pos:  position{line: 581, col: 11, offset: 13783},
-								name: "JoinStyle",
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 581, col: 21, offset: 13793},
-							name: "JOIN",
-						},
-						&labeledExpr{
-							pos:   position{line: 581, col: 26, offset: 13798},
-							label: "rightInput",
-							expr: &ruleRefExpr{
-								pos:  position{line: 581, col: 37, offset: 13809},
-								name: "JoinRightInput",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 581, col: 52, offset: 13824},
-							label: "e",
-							expr: &ruleRefExpr{
-								pos:  position{line: 581, col: 54, offset: 13826},
-								name: "JoinExpr",
+			pos:  position{line: 618, col: 1, offset: 15095},
+			expr: &choiceExpr{
+				pos: position{line: 619, col: 5, offset: 15106},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 619, col: 5, offset: 15106},
+						run: (*parser).callonJoinOp2,
+						expr: &seqExpr{
+							pos: position{line: 619, col: 5, offset: 15106},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 619, col: 5, offset: 15106},
+									name: "CROSS",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 619, col: 11, offset: 15112},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 619, col: 13, offset: 15114},
+									name: "JOIN",
+								},
+								&labeledExpr{
+									pos:   position{line: 619, col: 18, offset: 15119},
+									label: "rightInput",
+									expr: &ruleRefExpr{
+										pos:  position{line: 619, col: 29, offset: 15130},
+										name: "JoinRightInput",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 619, col: 44, offset: 15145},
+									label: "optArgs",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 619, col: 52, offset: 15153},
+										expr: &seqExpr{
+											pos: position{line: 619, col: 53, offset: 15154},
+											exprs: []any{
+												&ruleRefExpr{
+													pos:  position{line: 619, col: 53, offset: 15154},
+													name: "_",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 619, col: 55, offset: 15156},
+													name: "FlexAssignments",
+												},
+											},
+										},
+									},
+								},
 							},
 						},
-						&labeledExpr{
-							pos:   position{line: 581, col: 63, offset: 13835},
-							label: "optArgs",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 581, col: 71, offset: 13843},
-								expr: &seqExpr{
-									pos: position{line: 581, col: 72, offset: 13844},
-									exprs: []any{
-										&ruleRefExpr{
-											pos:  position{line: 581, col: 72, offset: 13844},
-											name: "_",
+					},
+					&actionExpr{
+						pos: position{line: 633, col: 5, offset: 15486},
+						run: (*parser).callonJoinOp14,
+						expr: &seqExpr{
+							pos: position{line: 633, col: 5, offset: 15486},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 633, col: 5, offset: 15486},
+									label: "style",
+									expr: &ruleRefExpr{
+										pos:  position{line: 633, col: 11, offset: 15492},
+										name: "JoinStyle",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 633, col: 21, offset: 15502},
+									name: "JOIN",
+								},
+								&labeledExpr{
+									pos:   position{line: 633, col: 26, offset: 15507},
+									label: "rightInput",
+									expr: &ruleRefExpr{
+										pos:  position{line: 633, col: 37, offset: 15518},
+										name: "JoinRightInput",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 633, col: 52, offset: 15533},
+									label: "e",
+									expr: &ruleRefExpr{
+										pos:  position{line: 633, col: 54, offset: 15535},
+										name: "JoinExpr",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 633, col: 63, offset: 15544},
+									label: "tolerance",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 633, col: 73, offset: 15554},
+										expr: &actionExpr{
+											pos: position{line: 633, col: 74, offset: 15555},
+											run: (*parser).callonJoinOp25,
+											expr: &seqExpr{
+												pos: position{line: 633, col: 74, offset: 15555},
+												exprs: []any{
+													&ruleRefExpr{
+														pos:  position{line: 633, col: 74, offset: 15555},
+														name: "_",
+													},
+													&ruleRefExpr{
+														pos:  position{line: 633, col: 76, offset: 15557},
+														name: "WITHIN",
+													},
+													&ruleRefExpr{
+														pos:  position{line: 633, col: 83, offset: 15564},
+														name: "_",
+													},
+													&labeledExpr{
+														pos:   position{line: 633, col: 85, offset: 15566},
+														label: "t",
+														expr: &ruleRefExpr{
+															pos:  position{line: 633, col: 87, offset: 15568},
+															name: "Expr",
+														},
+													},
+												},
+											},
 										},
-										&ruleRefExpr{
-											pos:  position{line: 581, col: 74, offset: 13846},
-											name: "FlexAssignments",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 633, col: 112, offset: 15593},
+									label: "optArgs",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 633, col: 120, offset: 15601},
+										expr: &seqExpr{
+											pos: position{line: 633, col: 121, offset: 15602},
+											exprs: []any{
+												&ruleRefExpr{
+													pos:  position{line: 633, col: 121, offset: 15602},
+													name: "_",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 633, col: 123, offset: 15604},
+													name: "FlexAssignments",
+												},
+											},
 										},
 									},
 								},
@@ -4047,83 +4446,134 @@ var g = &grammar{
 		},
 		{
 			name: "JoinStyle",
-			pos:  position{line: 597, col: 1, offset: 14212},
+			pos:  position{line: 652, col: 1, offset: 16049},
 			expr: &choiceExpr{
-				pos: position{line: 598, col: 5, offset: 14226},
+				pos: position{line: 653, col: 5, offset: 16063},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 598, col: 5, offset: 14226},
+						pos: position{line: 653, col: 5, offset: 16063},
 						run: (*parser).callonJoinStyle2,
 						expr: &seqExpr{
-							pos: position{line: 598, col: 5, offset: 14226},
+							pos: position{line: 653, col: 5, offset: 16063},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 598, col: 5, offset: 14226},
+									pos:  position{line: 653, col: 5, offset: 16063},
 									name: "ANTI",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 598, col: 10, offset: 14231},
+									pos:  position{line: 653, col: 10, offset: 16068},
 									name: "_",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 599, col: 5, offset: 14261},
+						pos: position{line: 654, col: 5, offset: 16098},
 						run: (*parser).callonJoinStyle6,
 						expr: &seqExpr{
-							pos: position{line: 599, col: 5, offset: 14261},
+							pos: position{line: 654, col: 5, offset: 16098},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 599, col: 5, offset: 14261},
-									name: "INNER",
+									pos:  position{line: 654, col: 5, offset: 16098},
+									name: "ASOF",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 599, col: 11, offset: 14267},
+									pos:  position{line: 654, col: 10, offset: 16103},
 									name: "_",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 600, col: 5, offset: 14297},
+						pos: position{line: 655, col: 5, offset: 16133},
 						run: (*parser).callonJoinStyle10,
 						expr: &seqExpr{
-							pos: position{line: 600, col: 5, offset: 14297},
+							pos: position{line: 655, col: 5, offset: 16133},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 600, col: 5, offset: 14297},
-									name: "LEFT",
+									pos:  position{line: 655, col: 5, offset: 16133},
+									name: "INNER",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 600, col: 11, offset: 14303},
+									pos:  position{line: 655, col: 11, offset: 16139},
 									name: "_",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 601, col: 5, offset: 14332},
+						pos: position{line: 656, col: 5, offset: 16169},
 						run: (*parser).callonJoinStyle14,
 						expr: &seqExpr{
-							pos: position{line: 601, col: 5, offset: 14332},
+							pos: position{line: 656, col: 5, offset: 16169},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 601, col: 5, offset: 14332},
-									name: "RIGHT",
+									pos:  position{line: 656, col: 5, offset: 16169},
+									name: "LEFT",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 601, col: 11, offset: 14338},
+									pos:  position{line: 656, col: 11, offset: 16175},
 									name: "_",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 602, col: 5, offset: 14368},
+						pos: position{line: 657, col: 5, offset: 16204},
 						run: (*parser).callonJoinStyle18,
+						expr: &seqExpr{
+							pos: position{line: 657, col: 5, offset: 16204},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 657, col: 5, offset: 16204},
+									name: "LOOKUP",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 657, col: 12, offset: 16211},
+									name: "_",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 658, col: 5, offset: 16242},
+						run: (*parser).callonJoinStyle22,
+						expr: &seqExpr{
+							pos: position{line: 658, col: 5, offset: 16242},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 658, col: 5, offset: 16242},
+									name: "RIGHT",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 658, col: 11, offset: 16248},
+									name: "_",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 659, col: 5, offset: 16278},
+						run: (*parser).callonJoinStyle26,
+						expr: &seqExpr{
+							pos: position{line: 659, col: 5, offset: 16278},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 659, col: 5, offset: 16278},
+									name: "SEMI",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 659, col: 10, offset: 16283},
+									name: "_",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 660, col: 5, offset: 16313},
+						run: (*parser).callonJoinStyle30,
 						expr: &litMatcher{
-							pos:        position{line: 602, col: 5, offset: 14368},
+							pos:        position{line: 660, col: 5, offset: 16313},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -4136,44 +4586,44 @@ var g = &grammar{
 		},
 		{
 			name: "JoinRightInput",
-			pos:  position{line: 604, col: 1, offset: 14396},
+			pos:  position{line: 662, col: 1, offset: 16341},
 			expr: &choiceExpr{
-				pos: position{line: 605, col: 5, offset: 14415},
+				pos: position{line: 663, col: 5, offset: 16360},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 605, col: 5, offset: 14415},
+						pos: position{line: 663, col: 5, offset: 16360},
 						run: (*parser).callonJoinRightInput2,
 						expr: &seqExpr{
-							pos: position{line: 605, col: 5, offset: 14415},
+							pos: position{line: 663, col: 5, offset: 16360},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 605, col: 5, offset: 14415},
+									pos:  position{line: 663, col: 5, offset: 16360},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 605, col: 8, offset: 14418},
+									pos:        position{line: 663, col: 8, offset: 16363},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 605, col: 12, offset: 14422},
+									pos:  position{line: 663, col: 12, offset: 16367},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 605, col: 15, offset: 14425},
+									pos:   position{line: 663, col: 15, offset: 16370},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 605, col: 17, offset: 14427},
+										pos:  position{line: 663, col: 17, offset: 16372},
 										name: "Seq",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 605, col: 21, offset: 14431},
+									pos:  position{line: 663, col: 21, offset: 16376},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 605, col: 24, offset: 14434},
+									pos:        position{line: 663, col: 24, offset: 16379},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -4182,10 +4632,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 606, col: 5, offset: 14460},
+						pos: position{line: 664, col: 5, offset: 16405},
 						run: (*parser).callonJoinRightInput11,
 						expr: &litMatcher{
-							pos:        position{line: 606, col: 5, offset: 14460},
+							pos:        position{line: 664, col: 5, offset: 16405},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -4198,36 +4648,36 @@ var g = &grammar{
 		},
 		{
 			name: "JoinKey",
-			pos:  position{line: 608, col: 1, offset: 14484},
+			pos:  position{line: 666, col: 1, offset: 16429},
 			expr: &choiceExpr{
-				pos: position{line: 609, col: 5, offset: 14496},
+				pos: position{line: 667, col: 5, offset: 16441},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 609, col: 5, offset: 14496},
+						pos:  position{line: 667, col: 5, offset: 16441},
 						name: "Lval",
 					},
 					&actionExpr{
-						pos: position{line: 610, col: 5, offset: 14505},
+						pos: position{line: 668, col: 5, offset: 16450},
 						run: (*parser).callonJoinKey3,
 						expr: &seqExpr{
-							pos: position{line: 610, col: 5, offset: 14505},
+							pos: position{line: 668, col: 5, offset: 16450},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 610, col: 5, offset: 14505},
+									pos:        position{line: 668, col: 5, offset: 16450},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 610, col: 9, offset: 14509},
+									pos:   position{line: 668, col: 9, offset: 16454},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 610, col: 14, offset: 14514},
+										pos:  position{line: 668, col: 14, offset: 16459},
 										name: "Expr",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 610, col: 19, offset: 14519},
+									pos:        position{line: 668, col: 19, offset: 16464},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -4242,44 +4692,44 @@ var g = &grammar{
 		},
 		{
 			name: "SampleOp",
-			pos:  position{line: 612, col: 1, offset: 14545},
+			pos:  position{line: 670, col: 1, offset: 16490},
 			expr: &actionExpr{
-				pos: position{line: 613, col: 5, offset: 14558},
+				pos: position{line: 671, col: 5, offset: 16503},
 				run: (*parser).callonSampleOp1,
 				expr: &seqExpr{
-					pos: position{line: 613, col: 5, offset: 14558},
+					pos: position{line: 671, col: 5, offset: 16503},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 613, col: 5, offset: 14558},
+							pos:  position{line: 671, col: 5, offset: 16503},
 							name: "SAMPLE",
 						},
 						&andExpr{
-							pos: position{line: 613, col: 12, offset: 14565},
+							pos: position{line: 671, col: 12, offset: 16510},
 							expr: &ruleRefExpr{
-								pos:  position{line: 613, col: 13, offset: 14566},
+								pos:  position{line: 671, col: 13, offset: 16511},
 								name: "EOKW",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 613, col: 18, offset: 14571},
+							pos:   position{line: 671, col: 18, offset: 16516},
 							label: "expr",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 613, col: 23, offset: 14576},
+								pos: position{line: 671, col: 23, offset: 16521},
 								expr: &actionExpr{
-									pos: position{line: 613, col: 24, offset: 14577},
+									pos: position{line: 671, col: 24, offset: 16522},
 									run: (*parser).callonSampleOp8,
 									expr: &seqExpr{
-										pos: position{line: 613, col: 24, offset: 14577},
+										pos: position{line: 671, col: 24, offset: 16522},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 613, col: 24, offset: 14577},
+												pos:  position{line: 671, col: 24, offset: 16522},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 613, col: 26, offset: 14579},
+												pos:   position{line: 671, col: 26, offset: 16524},
 												label: "e",
 												expr: &ruleRefExpr{
-													pos:  position{line: 613, col: 28, offset: 14581},
+													pos:  position{line: 671, col: 28, offset: 16526},
 													name: "Lval",
 												},
 											},
@@ -4294,17 +4744,177 @@ var g = &grammar{
 			leader:        false,
 			leftRecursive: false,
 		},
+		{
+			name: "GraphOp",
+			pos:  position{line: 679, col: 1, offset: 16696},
+			expr: &actionExpr{
+				pos: position{line: 680, col: 5, offset: 16708},
+				run: (*parser).callonGraphOp1,
+				expr: &seqExpr{
+					pos: position{line: 680, col: 5, offset: 16708},
+					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 680, col: 5, offset: 16708},
+							name: "GRAPH",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 680, col: 11, offset: 16714},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 680, col: 13, offset: 16716},
+							label: "src",
+							expr: &ruleRefExpr{
+								pos:  position{line: 680, col: 17, offset: 16720},
+								name: "Expr",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 680, col: 22, offset: 16725},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 680, col: 25, offset: 16728},
+							val:        ",",
+							ignoreCase: false,
+							want:       "\",\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 680, col: 29, offset: 16732},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 680, col: 32, offset: 16735},
+							label: "dst",
+							expr: &ruleRefExpr{
+								pos:  position{line: 680, col: 36, offset: 16739},
+								name: "Expr",
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "SequenceOp",
+			pos:  position{line: 689, col: 1, offset: 16893},
+			expr: &actionExpr{
+				pos: position{line: 690, col: 5, offset: 16908},
+				run: (*parser).callonSequenceOp1,
+				expr: &seqExpr{
+					pos: position{line: 690, col: 5, offset: 16908},
+					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 690, col: 5, offset: 16908},
+							name: "SEQUENCE",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 690, col: 14, offset: 16917},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 690, col: 16, offset: 16919},
+							label: "steps",
+							expr: &ruleRefExpr{
+								pos:  position{line: 690, col: 22, offset: 16925},
+								name: "FlexAssignments",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 690, col: 38, offset: 16941},
+							label: "keys",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 690, col: 43, offset: 16946},
+								expr: &seqExpr{
+									pos: position{line: 690, col: 44, offset: 16947},
+									exprs: []any{
+										&ruleRefExpr{
+											pos:  position{line: 690, col: 44, offset: 16947},
+											name: "_",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 690, col: 46, offset: 16949},
+											name: "AggregateKeys",
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 690, col: 62, offset: 16965},
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 690, col: 64, offset: 16967},
+							name: "WITHIN",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 690, col: 71, offset: 16974},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 690, col: 73, offset: 16976},
+							label: "window",
+							expr: &ruleRefExpr{
+								pos:  position{line: 690, col: 80, offset: 16983},
+								name: "Duration",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 690, col: 89, offset: 16992},
+							label: "time",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 690, col: 94, offset: 16997},
+								expr: &actionExpr{
+									pos: position{line: 690, col: 95, offset: 16998},
+									run: (*parser).callonSequenceOp19,
+									expr: &seqExpr{
+										pos: position{line: 690, col: 95, offset: 16998},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 690, col: 95, offset: 16998},
+												name: "_",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 690, col: 97, offset: 17000},
+												name: "ON",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 690, col: 100, offset: 17003},
+												name: "_",
+											},
+											&labeledExpr{
+												pos:   position{line: 690, col: 102, offset: 17005},
+												label: "e",
+												expr: &ruleRefExpr{
+													pos:  position{line: 690, col: 104, offset: 17007},
+													name: "Expr",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
 		{
 			name: "OpAssignment",
-			pos:  position{line: 626, col: 1, offset: 15020},
+			pos:  position{line: 711, col: 1, offset: 17656},
 			expr: &actionExpr{
-				pos: position{line: 627, col: 5, offset: 15037},
+				pos: position{line: 712, col: 5, offset: 17673},
 				run: (*parser).callonOpAssignment1,
 				expr: &labeledExpr{
-					pos:   position{line: 627, col: 5, offset: 15037},
+					pos:   position{line: 712, col: 5, offset: 17673},
 					label: "a",
 					expr: &ruleRefExpr{
-						pos:  position{line: 627, col: 7, offset: 15039},
+						pos:  position{line: 712, col: 7, offset: 17675},
 						name: "Assignments",
 					},
 				},
@@ -4314,69 +4924,69 @@ var g = &grammar{
 		},
 		{
 			name: "LoadOp",
-			pos:  position{line: 635, col: 1, offset: 15211},
+			pos:  position{line: 720, col: 1, offset: 17847},
 			expr: &actionExpr{
-				pos: position{line: 636, col: 5, offset: 15222},
+				pos: position{line: 721, col: 5, offset: 17858},
 				run: (*parser).callonLoadOp1,
 				expr: &seqExpr{
-					pos: position{line: 636, col: 5, offset: 15222},
+					pos: position{line: 721, col: 5, offset: 17858},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 636, col: 5, offset: 15222},
+							pos:  position{line: 721, col: 5, offset: 17858},
 							name: "LOAD",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 636, col: 10, offset: 15227},
+							pos:  position{line: 721, col: 10, offset: 17863},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 636, col: 12, offset: 15229},
+							pos:   position{line: 721, col: 12, offset: 17865},
 							label: "pool",
 							expr: &ruleRefExpr{
-								pos:  position{line: 636, col: 17, offset: 15234},
+								pos:  position{line: 721, col: 17, offset: 17870},
 								name: "Name",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 636, col: 22, offset: 15239},
+							pos:   position{line: 721, col: 22, offset: 17875},
 							label: "branch",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 636, col: 29, offset: 15246},
+								pos: position{line: 721, col: 29, offset: 17882},
 								expr: &ruleRefExpr{
-									pos:  position{line: 636, col: 29, offset: 15246},
+									pos:  position{line: 721, col: 29, offset: 17882},
 									name: "PoolBranch",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 636, col: 41, offset: 15258},
+							pos:   position{line: 721, col: 41, offset: 17894},
 							label: "author",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 636, col: 48, offset: 15265},
+								pos: position{line: 721, col: 48, offset: 17901},
 								expr: &ruleRefExpr{
-									pos:  position{line: 636, col: 48, offset: 15265},
+									pos:  position{line: 721, col: 48, offset: 17901},
 									name: "AuthorArg",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 636, col: 59, offset: 15276},
+							pos:   position{line: 721, col: 59, offset: 17912},
 							label: "message",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 636, col: 67, offset: 15284},
+								pos: position{line: 721, col: 67, offset: 17920},
 								expr: &ruleRefExpr{
-									pos:  position{line: 636, col: 67, offset: 15284},
+									pos:  position{line: 721, col: 67, offset: 17920},
 									name: "MessageArg",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 636, col: 79, offset: 15296},
+							pos:   position{line: 721, col: 79, offset: 17932},
 							label: "meta",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 636, col: 84, offset: 15301},
+								pos: position{line: 721, col: 84, offset: 17937},
 								expr: &ruleRefExpr{
-									pos:  position{line: 636, col: 84, offset: 15301},
+									pos:  position{line: 721, col: 84, offset: 17937},
 									name: "MetaArg",
 								},
 							},
@@ -4389,30 +4999,30 @@ var g = &grammar{
 		},
 		{
 			name: "AuthorArg",
-			pos:  position{line: 648, col: 1, offset: 15583},
+			pos:  position{line: 733, col: 1, offset: 18219},
 			expr: &actionExpr{
-				pos: position{line: 649, col: 5, offset: 15597},
+				pos: position{line: 734, col: 5, offset: 18233},
 				run: (*parser).callonAuthorArg1,
 				expr: &seqExpr{
-					pos: position{line: 649, col: 5, offset: 15597},
+					pos: position{line: 734, col: 5, offset: 18233},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 649, col: 5, offset: 15597},
+							pos:  position{line: 734, col: 5, offset: 18233},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 649, col: 7, offset: 15599},
+							pos:  position{line: 734, col: 7, offset: 18235},
 							name: "AUTHOR",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 649, col: 14, offset: 15606},
+							pos:  position{line: 734, col: 14, offset: 18242},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 649, col: 16, offset: 15608},
+							pos:   position{line: 734, col: 16, offset: 18244},
 							label: "n",
 							expr: &ruleRefExpr{
-								pos:  position{line: 649, col: 18, offset: 15610},
+								pos:  position{line: 734, col: 18, offset: 18246},
 								name: "Name",
 							},
 						},
@@ -4424,30 +5034,30 @@ var g = &grammar{
 		},
 		{
 			name: "MessageArg",
-			pos:  position{line: 651, col: 1, offset: 15634},
+			pos:  position{line: 736, col: 1, offset: 18270},
 			expr: &actionExpr{
-				pos: position{line: 652, col: 5, offset: 15649},
+				pos: position{line: 737, col: 5, offset: 18285},
 				run: (*parser).callonMessageArg1,
 				expr: &seqExpr{
-					pos: position{line: 652, col: 5, offset: 15649},
+					pos: position{line: 737, col: 5, offset: 18285},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 652, col: 5, offset: 15649},
+							pos:  position{line: 737, col: 5, offset: 18285},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 652, col: 7, offset: 15651},
+							pos:  position{line: 737, col: 7, offset: 18287},
 							name: "MESSAGE",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 652, col: 15, offset: 15659},
+							pos:  position{line: 737, col: 15, offset: 18295},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 652, col: 17, offset: 15661},
+							pos:   position{line: 737, col: 17, offset: 18297},
 							label: "n",
 							expr: &ruleRefExpr{
-								pos:  position{line: 652, col: 19, offset: 15663},
+								pos:  position{line: 737, col: 19, offset: 18299},
 								name: "Name",
 							},
 						},
@@ -4459,30 +5069,59 @@ var g = &grammar{
 		},
 		{
 			name: "MetaArg",
-			pos:  position{line: 654, col: 1, offset: 15687},
+			pos:  position{line: 739, col: 1, offset: 18323},
 			expr: &actionExpr{
-				pos: position{line: 655, col: 5, offset: 15699},
+				pos: position{line: 740, col: 5, offset: 18335},
 				run: (*parser).callonMetaArg1,
 				expr: &seqExpr{
-					pos: position{line: 655, col: 5, offset: 15699},
+					pos: position{line: 740, col: 5, offset: 18335},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 655, col: 5, offset: 15699},
+							pos:  position{line: 740, col: 5, offset: 18335},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 655, col: 7, offset: 15701},
+							pos:  position{line: 740, col: 7, offset: 18337},
 							name: "META",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 655, col: 12, offset: 15706},
+							pos:  position{line: 740, col: 12, offset: 18342},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 655, col: 14, offset: 15708},
+							pos:   position{line: 740, col: 14, offset: 18344},
 							label: "n",
 							expr: &ruleRefExpr{
-								pos:  position{line: 655, col: 16, offset: 15710},
+								pos:  position{line: 740, col: 16, offset: 18346},
+								name: "Name",
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "PoolBranch",
+			pos:  position{line: 742, col: 1, offset: 18370},
+			expr: &actionExpr{
+				pos: position{line: 743, col: 5, offset: 18385},
+				run: (*parser).callonPoolBranch1,
+				expr: &seqExpr{
+					pos: position{line: 743, col: 5, offset: 18385},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 743, col: 5, offset: 18385},
+							val:        "@",
+							ignoreCase: false,
+							want:       "\"@\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 743, col: 9, offset: 18389},
+							label: "branch",
+							expr: &ruleRefExpr{
+								pos:  position{line: 743, col: 16, offset: 18396},
 								name: "Name",
 							},
 						},
@@ -4493,28 +5132,195 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "PoolBranch",
-			pos:  position{line: 657, col: 1, offset: 15734},
+			name: "OutputOp",
+			pos:  position{line: 745, col: 1, offset: 18425},
+			expr: &actionExpr{
+				pos: position{line: 746, col: 5, offset: 18438},
+				run: (*parser).callonOutputOp1,
+				expr: &seqExpr{
+					pos: position{line: 746, col: 5, offset: 18438},
+					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 746, col: 5, offset: 18438},
+							name: "OUTPUT",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 746, col: 12, offset: 18445},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 746, col: 14, offset: 18447},
+							label: "name",
+							expr: &ruleRefExpr{
+								pos:  position{line: 746, col: 19, offset: 18452},
+								name: "Identifier",
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "IntoOp",
+			pos:  position{line: 754, col: 1, offset: 18586},
+			expr: &choiceExpr{
+				pos: position{line: 755, col: 5, offset: 18597},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 755, col: 5, offset: 18597},
+						run: (*parser).callonIntoOp2,
+						expr: &seqExpr{
+							pos: position{line: 755, col: 5, offset: 18597},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 755, col: 5, offset: 18597},
+									name: "INTO",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 755, col: 10, offset: 18602},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 755, col: 12, offset: 18604},
+									label: "temp",
+									expr: &ruleRefExpr{
+										pos:  position{line: 755, col: 17, offset: 18609},
+										name: "TempTable",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 762, col: 5, offset: 18743},
+						run: (*parser).callonIntoOp8,
+						expr: &seqExpr{
+							pos: position{line: 762, col: 5, offset: 18743},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 762, col: 5, offset: 18743},
+									name: "INTO",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 762, col: 10, offset: 18748},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 762, col: 12, offset: 18750},
+									label: "pool",
+									expr: &ruleRefExpr{
+										pos:  position{line: 762, col: 17, offset: 18755},
+										name: "Name",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 762, col: 22, offset: 18760},
+									label: "branch",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 762, col: 29, offset: 18767},
+										expr: &ruleRefExpr{
+											pos:  position{line: 762, col: 29, offset: 18767},
+											name: "PoolBranch",
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 762, col: 41, offset: 18779},
+									label: "author",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 762, col: 48, offset: 18786},
+										expr: &ruleRefExpr{
+											pos:  position{line: 762, col: 48, offset: 18786},
+											name: "AuthorArg",
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 762, col: 59, offset: 18797},
+									label: "message",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 762, col: 67, offset: 18805},
+										expr: &ruleRefExpr{
+											pos:  position{line: 762, col: 67, offset: 18805},
+											name: "MessageArg",
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 762, col: 79, offset: 18817},
+									label: "meta",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 762, col: 84, offset: 18822},
+										expr: &ruleRefExpr{
+											pos:  position{line: 762, col: 84, offset: 18822},
+											name: "MetaArg",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "TempTable",
+			pos:  position{line: 774, col: 1, offset: 19104},
 			expr: &actionExpr{
-				pos: position{line: 658, col: 5, offset: 15749},
-				run: (*parser).callonPoolBranch1,
+				pos: position{line: 775, col: 5, offset: 19118},
+				run: (*parser).callonTempTable1,
 				expr: &seqExpr{
-					pos: position{line: 658, col: 5, offset: 15749},
+					pos: position{line: 775, col: 5, offset: 19118},
 					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 775, col: 5, offset: 19118},
+							name: "TEMP",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 775, col: 10, offset: 19123},
+							name: "__",
+						},
 						&litMatcher{
-							pos:        position{line: 658, col: 5, offset: 15749},
-							val:        "@",
+							pos:        position{line: 775, col: 13, offset: 19126},
+							val:        "(",
 							ignoreCase: false,
-							want:       "\"@\"",
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 775, col: 17, offset: 19130},
+							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 658, col: 9, offset: 15753},
-							label: "branch",
-							expr: &ruleRefExpr{
-								pos:  position{line: 658, col: 16, offset: 15760},
-								name: "Name",
+							pos:   position{line: 775, col: 20, offset: 19133},
+							label: "name",
+							expr: &choiceExpr{
+								pos: position{line: 775, col: 26, offset: 19139},
+								alternatives: []any{
+									&ruleRefExpr{
+										pos:  position{line: 775, col: 26, offset: 19139},
+										name: "DoubleQuotedString",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 775, col: 47, offset: 19160},
+										name: "SingleQuotedString",
+									},
+								},
 							},
 						},
+						&ruleRefExpr{
+							pos:  position{line: 775, col: 67, offset: 19180},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 775, col: 70, offset: 19183},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
 					},
 				},
 			},
@@ -4522,30 +5328,50 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "OutputOp",
-			pos:  position{line: 660, col: 1, offset: 15789},
+			name: "GenerateSource",
+			pos:  position{line: 783, col: 1, offset: 19305},
 			expr: &actionExpr{
-				pos: position{line: 661, col: 5, offset: 15802},
-				run: (*parser).callonOutputOp1,
+				pos: position{line: 784, col: 5, offset: 19324},
+				run: (*parser).callonGenerateSource1,
 				expr: &seqExpr{
-					pos: position{line: 661, col: 5, offset: 15802},
+					pos: position{line: 784, col: 5, offset: 19324},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 661, col: 5, offset: 15802},
-							name: "OUTPUT",
+							pos:  position{line: 784, col: 5, offset: 19324},
+							name: "GENERATE",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 661, col: 12, offset: 15809},
-							name: "_",
+							pos:  position{line: 784, col: 14, offset: 19333},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 784, col: 17, offset: 19336},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 784, col: 21, offset: 19340},
+							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 661, col: 14, offset: 15811},
-							label: "name",
+							pos:   position{line: 784, col: 24, offset: 19343},
+							label: "spec",
 							expr: &ruleRefExpr{
-								pos:  position{line: 661, col: 19, offset: 15816},
-								name: "Identifier",
+								pos:  position{line: 784, col: 29, offset: 19348},
+								name: "Expr",
 							},
 						},
+						&ruleRefExpr{
+							pos:  position{line: 784, col: 34, offset: 19353},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 784, col: 37, offset: 19356},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
 					},
 				},
 			},
@@ -4554,44 +5380,44 @@ var g = &grammar{
 		},
 		{
 			name: "DebugOp",
-			pos:  position{line: 669, col: 1, offset: 15950},
+			pos:  position{line: 792, col: 1, offset: 19488},
 			expr: &actionExpr{
-				pos: position{line: 670, col: 5, offset: 15962},
+				pos: position{line: 793, col: 5, offset: 19500},
 				run: (*parser).callonDebugOp1,
 				expr: &seqExpr{
-					pos: position{line: 670, col: 5, offset: 15962},
+					pos: position{line: 793, col: 5, offset: 19500},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 670, col: 5, offset: 15962},
+							pos:  position{line: 793, col: 5, offset: 19500},
 							name: "DEBUG",
 						},
 						&andExpr{
-							pos: position{line: 670, col: 11, offset: 15968},
+							pos: position{line: 793, col: 11, offset: 19506},
 							expr: &ruleRefExpr{
-								pos:  position{line: 670, col: 12, offset: 15969},
+								pos:  position{line: 793, col: 12, offset: 19507},
 								name: "EOKW",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 670, col: 17, offset: 15974},
+							pos:   position{line: 793, col: 17, offset: 19512},
 							label: "expr",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 670, col: 22, offset: 15979},
+								pos: position{line: 793, col: 22, offset: 19517},
 								expr: &actionExpr{
-									pos: position{line: 670, col: 23, offset: 15980},
+									pos: position{line: 793, col: 23, offset: 19518},
 									run: (*parser).callonDebugOp8,
 									expr: &seqExpr{
-										pos: position{line: 670, col: 23, offset: 15980},
+										pos: position{line: 793, col: 23, offset: 19518},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 670, col: 23, offset: 15980},
+												pos:  position{line: 793, col: 23, offset: 19518},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 670, col: 25, offset: 15982},
+												pos:   position{line: 793, col: 25, offset: 19520},
 												label: "e",
 												expr: &ruleRefExpr{
-													pos:  position{line: 670, col: 27, offset: 15984},
+													pos:  position{line: 793, col: 27, offset: 19522},
 													name: "Expr",
 												},
 											},
@@ -4608,26 +5434,26 @@ var g = &grammar{
 		},
 		{
 			name: "FromOp",
-			pos:  position{line: 681, col: 1, offset: 16177},
+			pos:  position{line: 804, col: 1, offset: 19715},
 			expr: &actionExpr{
-				pos: position{line: 682, col: 5, offset: 16188},
+				pos: position{line: 805, col: 5, offset: 19726},
 				run: (*parser).callonFromOp1,
 				expr: &seqExpr{
-					pos: position{line: 682, col: 5, offset: 16188},
+					pos: position{line: 805, col: 5, offset: 19726},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 682, col: 5, offset: 16188},
+							pos:  position{line: 805, col: 5, offset: 19726},
 							name: "FromKeyWord",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 682, col: 17, offset: 16200},
+							pos:  position{line: 805, col: 17, offset: 19738},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 682, col: 19, offset: 16202},
+							pos:   position{line: 805, col: 19, offset: 19740},
 							label: "elems",
 							expr: &ruleRefExpr{
-								pos:  position{line: 682, col: 25, offset: 16208},
+								pos:  position{line: 805, col: 25, offset: 19746},
 								name: "FromElems",
 							},
 						},
@@ -4639,16 +5465,16 @@ var g = &grammar{
 		},
 		{
 			name: "FromKeyWord",
-			pos:  position{line: 690, col: 1, offset: 16351},
+			pos:  position{line: 813, col: 1, offset: 19889},
 			expr: &choiceExpr{
-				pos: position{line: 691, col: 5, offset: 16367},
+				pos: position{line: 814, col: 5, offset: 19905},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 691, col: 5, offset: 16367},
+						pos:  position{line: 814, col: 5, offset: 19905},
 						name: "FROM",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 692, col: 5, offset: 16376},
+						pos:  position{line: 815, col: 5, offset: 19914},
 						name: "DeprecatedFroms",
 					},
 				},
@@ -4658,24 +5484,24 @@ var g = &grammar{
 		},
 		{
 			name: "DeprecatedFroms",
-			pos:  position{line: 694, col: 1, offset: 16393},
+			pos:  position{line: 817, col: 1, offset: 19931},
 			expr: &choiceExpr{
-				pos: position{line: 694, col: 19, offset: 16411},
+				pos: position{line: 817, col: 19, offset: 19949},
 				alternatives: []any{
 					&litMatcher{
-						pos:        position{line: 694, col: 19, offset: 16411},
+						pos:        position{line: 817, col: 19, offset: 19949},
 						val:        "get",
 						ignoreCase: false,
 						want:       "\"get\"",
 					},
 					&litMatcher{
-						pos:        position{line: 694, col: 27, offset: 16419},
+						pos:        position{line: 817, col: 27, offset: 19957},
 						val:        "file",
 						ignoreCase: false,
 						want:       "\"file\"",
 					},
 					&litMatcher{
-						pos:        position{line: 694, col: 36, offset: 16428},
+						pos:        position{line: 817, col: 36, offset: 19966},
 						val:        "pool",
 						ignoreCase: false,
 						want:       "\"pool\"",
@@ -4687,51 +5513,51 @@ var g = &grammar{
 		},
 		{
 			name: "FromElems",
-			pos:  position{line: 696, col: 1, offset: 16436},
+			pos:  position{line: 819, col: 1, offset: 19974},
 			expr: &actionExpr{
-				pos: position{line: 697, col: 5, offset: 16450},
+				pos: position{line: 820, col: 5, offset: 19988},
 				run: (*parser).callonFromElems1,
 				expr: &seqExpr{
-					pos: position{line: 697, col: 5, offset: 16450},
+					pos: position{line: 820, col: 5, offset: 19988},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 697, col: 5, offset: 16450},
+							pos:   position{line: 820, col: 5, offset: 19988},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 697, col: 11, offset: 16456},
+								pos:  position{line: 820, col: 11, offset: 19994},
 								name: "FromElem",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 697, col: 20, offset: 16465},
+							pos:   position{line: 820, col: 20, offset: 20003},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 697, col: 25, offset: 16470},
+								pos: position{line: 820, col: 25, offset: 20008},
 								expr: &actionExpr{
-									pos: position{line: 697, col: 27, offset: 16472},
+									pos: position{line: 820, col: 27, offset: 20010},
 									run: (*parser).callonFromElems7,
 									expr: &seqExpr{
-										pos: position{line: 697, col: 27, offset: 16472},
+										pos: position{line: 820, col: 27, offset: 20010},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 697, col: 27, offset: 16472},
+												pos:  position{line: 820, col: 27, offset: 20010},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 697, col: 30, offset: 16475},
+												pos:        position{line: 820, col: 30, offset: 20013},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 697, col: 34, offset: 16479},
+												pos:  position{line: 820, col: 34, offset: 20017},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 697, col: 37, offset: 16482},
+												pos:   position{line: 820, col: 37, offset: 20020},
 												label: "elem",
 												expr: &ruleRefExpr{
-													pos:  position{line: 697, col: 42, offset: 16487},
+													pos:  position{line: 820, col: 42, offset: 20025},
 													name: "FromElem",
 												},
 											},
@@ -4748,42 +5574,42 @@ var g = &grammar{
 		},
 		{
 			name: "FromElem",
-			pos:  position{line: 701, col: 1, offset: 16571},
+			pos:  position{line: 824, col: 1, offset: 20109},
 			expr: &actionExpr{
-				pos: position{line: 702, col: 5, offset: 16584},
+				pos: position{line: 825, col: 5, offset: 20122},
 				run: (*parser).callonFromElem1,
 				expr: &seqExpr{
-					pos: position{line: 702, col: 5, offset: 16584},
+					pos: position{line: 825, col: 5, offset: 20122},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 702, col: 5, offset: 16584},
+							pos:   position{line: 825, col: 5, offset: 20122},
 							label: "entity",
 							expr: &ruleRefExpr{
-								pos:  position{line: 702, col: 12, offset: 16591},
+								pos:  position{line: 825, col: 12, offset: 20129},
 								name: "FromEntity",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 702, col: 23, offset: 16602},
+							pos:   position{line: 825, col: 23, offset: 20140},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 702, col: 28, offset: 16607},
+								pos:  position{line: 825, col: 28, offset: 20145},
 								name: "FromArgs",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 702, col: 37, offset: 16616},
+							pos:   position{line: 825, col: 37, offset: 20154},
 							label: "o",
 							expr: &ruleRefExpr{
-								pos:  position{line: 702, col: 39, offset: 16618},
+								pos:  position{line: 825, col: 39, offset: 20156},
 								name: "OptOrdinality",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 702, col: 53, offset: 16632},
+							pos:   position{line: 825, col: 53, offset: 20170},
 							label: "alias",
 							expr: &ruleRefExpr{
-								pos:  position{line: 702, col: 59, offset: 16638},
+								pos:  position{line: 825, col: 59, offset: 20176},
 								name: "OptAlias",
 							},
 						},
@@ -4795,46 +5621,46 @@ var g = &grammar{
 		},
 		{
 			name: "FromEntity",
-			pos:  position{line: 720, col: 1, offset: 17032},
+			pos:  position{line: 843, col: 1, offset: 20570},
 			expr: &choiceExpr{
-				pos: position{line: 721, col: 5, offset: 17047},
+				pos: position{line: 844, col: 5, offset: 20585},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 721, col: 5, offset: 17047},
+						pos: position{line: 844, col: 5, offset: 20585},
 						run: (*parser).callonFromEntity2,
 						expr: &labeledExpr{
-							pos:   position{line: 721, col: 5, offset: 17047},
+							pos:   position{line: 844, col: 5, offset: 20585},
 							label: "url",
 							expr: &ruleRefExpr{
-								pos:  position{line: 721, col: 9, offset: 17051},
+								pos:  position{line: 844, col: 9, offset: 20589},
 								name: "UnquotedURL",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 728, col: 5, offset: 17183},
+						pos:  position{line: 851, col: 5, offset: 20721},
 						name: "Regexp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 729, col: 5, offset: 17194},
+						pos:  position{line: 852, col: 5, offset: 20732},
 						name: "Glob",
 					},
 					&actionExpr{
-						pos: position{line: 730, col: 5, offset: 17203},
+						pos: position{line: 853, col: 5, offset: 20741},
 						run: (*parser).callonFromEntity7,
 						expr: &seqExpr{
-							pos: position{line: 730, col: 5, offset: 17203},
+							pos: position{line: 853, col: 5, offset: 20741},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 730, col: 5, offset: 17203},
+									pos:        position{line: 853, col: 5, offset: 20741},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
 								},
 								&notExpr{
-									pos: position{line: 730, col: 9, offset: 17207},
+									pos: position{line: 853, col: 9, offset: 20745},
 									expr: &ruleRefExpr{
-										pos:  position{line: 730, col: 10, offset: 17208},
+										pos:  position{line: 853, col: 10, offset: 20746},
 										name: "ExprGuard",
 									},
 								},
@@ -4842,43 +5668,43 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 731, col: 5, offset: 17289},
+						pos: position{line: 854, col: 5, offset: 20827},
 						run: (*parser).callonFromEntity12,
 						expr: &seqExpr{
-							pos: position{line: 731, col: 5, offset: 17289},
+							pos: position{line: 854, col: 5, offset: 20827},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 731, col: 5, offset: 17289},
+									pos:  position{line: 854, col: 5, offset: 20827},
 									name: "EVAL",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 731, col: 10, offset: 17294},
+									pos:  position{line: 854, col: 10, offset: 20832},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 731, col: 13, offset: 17297},
+									pos:        position{line: 854, col: 13, offset: 20835},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 731, col: 17, offset: 17301},
+									pos:  position{line: 854, col: 17, offset: 20839},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 731, col: 20, offset: 17304},
+									pos:   position{line: 854, col: 20, offset: 20842},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 731, col: 22, offset: 17306},
+										pos:  position{line: 854, col: 22, offset: 20844},
 										name: "Expr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 731, col: 27, offset: 17311},
+									pos:  position{line: 854, col: 27, offset: 20849},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 731, col: 30, offset: 17314},
+									pos:        position{line: 854, col: 30, offset: 20852},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -4887,35 +5713,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 738, col: 5, offset: 17450},
+						pos: position{line: 861, col: 5, offset: 20988},
 						run: (*parser).callonFromEntity22,
 						expr: &labeledExpr{
-							pos:   position{line: 738, col: 5, offset: 17450},
+							pos:   position{line: 861, col: 5, offset: 20988},
 							label: "meta",
 							expr: &ruleRefExpr{
-								pos:  position{line: 738, col: 10, offset: 17455},
+								pos:  position{line: 861, col: 10, offset: 20993},
 								name: "PoolMeta",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 745, col: 5, offset: 17598},
+						pos: position{line: 868, col: 5, offset: 21136},
 						run: (*parser).callonFromEntity25,
 						expr: &seqExpr{
-							pos: position{line: 745, col: 5, offset: 17598},
+							pos: position{line: 868, col: 5, offset: 21136},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 745, col: 5, offset: 17598},
+									pos:   position{line: 868, col: 5, offset: 21136},
 									label: "join",
 									expr: &ruleRefExpr{
-										pos:  position{line: 745, col: 10, offset: 17603},
+										pos:  position{line: 868, col: 10, offset: 21141},
 										name: "JoinOperation",
 									},
 								},
 								&notExpr{
-									pos: position{line: 745, col: 24, offset: 17617},
+									pos: position{line: 868, col: 24, offset: 21155},
 									expr: &ruleRefExpr{
-										pos:  position{line: 745, col: 25, offset: 17618},
+										pos:  position{line: 868, col: 25, offset: 21156},
 										name: "AliasClause",
 									},
 								},
@@ -4923,35 +5749,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 746, col: 5, offset: 17653},
+						pos: position{line: 869, col: 5, offset: 21191},
 						run: (*parser).callonFromEntity31,
 						expr: &seqExpr{
-							pos: position{line: 746, col: 5, offset: 17653},
+							pos: position{line: 869, col: 5, offset: 21191},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 746, col: 5, offset: 17653},
+									pos:        position{line: 869, col: 5, offset: 21191},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 746, col: 9, offset: 17657},
+									pos:  position{line: 869, col: 9, offset: 21195},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 746, col: 12, offset: 17660},
+									pos:   position{line: 869, col: 12, offset: 21198},
 									label: "join",
 									expr: &ruleRefExpr{
-										pos:  position{line: 746, col: 17, offset: 17665},
+										pos:  position{line: 869, col: 17, offset: 21203},
 										name: "JoinOperation",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 746, col: 31, offset: 17679},
+									pos:  position{line: 869, col: 31, offset: 21217},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 746, col: 34, offset: 17682},
+									pos:        position{line: 869, col: 34, offset: 21220},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -4960,35 +5786,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 747, col: 5, offset: 17711},
+						pos: position{line: 870, col: 5, offset: 21249},
 						run: (*parser).callonFromEntity39,
 						expr: &seqExpr{
-							pos: position{line: 747, col: 5, offset: 17711},
+							pos: position{line: 870, col: 5, offset: 21249},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 747, col: 5, offset: 17711},
+									pos:        position{line: 870, col: 5, offset: 21249},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 747, col: 9, offset: 17715},
+									pos:  position{line: 870, col: 9, offset: 21253},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 747, col: 12, offset: 17718},
+									pos:   position{line: 870, col: 12, offset: 21256},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 747, col: 14, offset: 17720},
+										pos:  position{line: 870, col: 14, offset: 21258},
 										name: "SQLPipe",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 747, col: 22, offset: 17728},
+									pos:  position{line: 870, col: 22, offset: 21266},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 747, col: 25, offset: 17731},
+									pos:        position{line: 870, col: 25, offset: 21269},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -4996,14 +5822,22 @@ var g = &grammar{
 							},
 						},
 					},
+					&ruleRefExpr{
+						pos:  position{line: 873, col: 5, offset: 21305},
+						name: "TempTable",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 874, col: 5, offset: 21319},
+						name: "GenerateSource",
+					},
 					&actionExpr{
-						pos: position{line: 750, col: 6, offset: 17768},
-						run: (*parser).callonFromEntity47,
+						pos: position{line: 875, col: 6, offset: 21339},
+						run: (*parser).callonFromEntity49,
 						expr: &labeledExpr{
-							pos:   position{line: 750, col: 6, offset: 17768},
+							pos:   position{line: 875, col: 6, offset: 21339},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 750, col: 11, offset: 17773},
+								pos:  position{line: 875, col: 11, offset: 21344},
 								name: "Name",
 							},
 						},
@@ -5015,40 +5849,109 @@ var g = &grammar{
 		},
 		{
 			name: "FromArgs",
-			pos:  position{line: 753, col: 1, offset: 17871},
+			pos:  position{line: 878, col: 1, offset: 21442},
 			expr: &choiceExpr{
-				pos: position{line: 754, col: 5, offset: 17884},
+				pos: position{line: 879, col: 5, offset: 21455},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 754, col: 5, offset: 17884},
+						pos: position{line: 879, col: 5, offset: 21455},
 						run: (*parser).callonFromArgs2,
 						expr: &seqExpr{
-							pos: position{line: 754, col: 5, offset: 17884},
+							pos: position{line: 879, col: 5, offset: 21455},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 754, col: 5, offset: 17884},
+									pos:   position{line: 879, col: 5, offset: 21455},
 									label: "commit",
 									expr: &ruleRefExpr{
-										pos:  position{line: 754, col: 12, offset: 17891},
+										pos:  position{line: 879, col: 12, offset: 21462},
 										name: "PoolCommit",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 754, col: 23, offset: 17902},
+									pos:   position{line: 879, col: 23, offset: 21473},
+									label: "asOf",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 879, col: 28, offset: 21478},
+										expr: &ruleRefExpr{
+											pos:  position{line: 879, col: 28, offset: 21478},
+											name: "PoolAsOf",
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 879, col: 38, offset: 21488},
+									label: "meta",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 879, col: 43, offset: 21493},
+										expr: &ruleRefExpr{
+											pos:  position{line: 879, col: 43, offset: 21493},
+											name: "PoolMeta",
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 879, col: 53, offset: 21503},
+									label: "r",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 879, col: 55, offset: 21505},
+										expr: &ruleRefExpr{
+											pos:  position{line: 879, col: 55, offset: 21505},
+											name: "RangeArg",
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 879, col: 65, offset: 21515},
+									label: "tap",
+									expr: &ruleRefExpr{
+										pos:  position{line: 879, col: 69, offset: 21519},
+										name: "TapArg",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 895, col: 5, offset: 21883},
+						run: (*parser).callonFromArgs17,
+						expr: &seqExpr{
+							pos: position{line: 895, col: 5, offset: 21883},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 895, col: 5, offset: 21883},
+									label: "asOf",
+									expr: &ruleRefExpr{
+										pos:  position{line: 895, col: 10, offset: 21888},
+										name: "PoolAsOf",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 895, col: 19, offset: 21897},
 									label: "meta",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 754, col: 28, offset: 17907},
+										pos: position{line: 895, col: 24, offset: 21902},
 										expr: &ruleRefExpr{
-											pos:  position{line: 754, col: 28, offset: 17907},
+											pos:  position{line: 895, col: 24, offset: 21902},
 											name: "PoolMeta",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 754, col: 38, offset: 17917},
+									pos:   position{line: 895, col: 34, offset: 21912},
+									label: "r",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 895, col: 36, offset: 21914},
+										expr: &ruleRefExpr{
+											pos:  position{line: 895, col: 36, offset: 21914},
+											name: "RangeArg",
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 895, col: 46, offset: 21924},
 									label: "tap",
 									expr: &ruleRefExpr{
-										pos:  position{line: 754, col: 42, offset: 17921},
+										pos:  position{line: 895, col: 50, offset: 21928},
 										name: "TapArg",
 									},
 								},
@@ -5056,24 +5959,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 763, col: 5, offset: 18125},
-						run: (*parser).callonFromArgs11,
+						pos: position{line: 908, col: 5, offset: 22218},
+						run: (*parser).callonFromArgs29,
 						expr: &seqExpr{
-							pos: position{line: 763, col: 5, offset: 18125},
+							pos: position{line: 908, col: 5, offset: 22218},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 763, col: 5, offset: 18125},
+									pos:   position{line: 908, col: 5, offset: 22218},
 									label: "meta",
 									expr: &ruleRefExpr{
-										pos:  position{line: 763, col: 10, offset: 18130},
+										pos:  position{line: 908, col: 10, offset: 22223},
 										name: "PoolMeta",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 763, col: 19, offset: 18139},
+									pos:   position{line: 908, col: 19, offset: 22232},
+									label: "r",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 908, col: 21, offset: 22234},
+										expr: &ruleRefExpr{
+											pos:  position{line: 908, col: 21, offset: 22234},
+											name: "RangeArg",
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 908, col: 31, offset: 22244},
 									label: "tap",
 									expr: &ruleRefExpr{
-										pos:  position{line: 763, col: 23, offset: 18143},
+										pos:  position{line: 908, col: 35, offset: 22248},
 										name: "TapArg",
 									},
 								},
@@ -5081,41 +5995,66 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 771, col: 5, offset: 18309},
-						run: (*parser).callonFromArgs17,
+						pos: position{line: 920, col: 5, offset: 22501},
+						run: (*parser).callonFromArgs38,
+						expr: &seqExpr{
+							pos: position{line: 920, col: 5, offset: 22501},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 920, col: 5, offset: 22501},
+									label: "r",
+									expr: &ruleRefExpr{
+										pos:  position{line: 920, col: 7, offset: 22503},
+										name: "RangeArg",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 920, col: 16, offset: 22512},
+									label: "tap",
+									expr: &ruleRefExpr{
+										pos:  position{line: 920, col: 20, offset: 22516},
+										name: "TapArg",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 928, col: 5, offset: 22683},
+						run: (*parser).callonFromArgs44,
 						expr: &seqExpr{
-							pos: position{line: 771, col: 5, offset: 18309},
+							pos: position{line: 928, col: 5, offset: 22683},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 771, col: 5, offset: 18309},
+									pos:   position{line: 928, col: 5, offset: 22683},
 									label: "format",
 									expr: &ruleRefExpr{
-										pos:  position{line: 771, col: 12, offset: 18316},
+										pos:  position{line: 928, col: 12, offset: 22690},
 										name: "FormatArg",
 									},
 								},
 								&notExpr{
-									pos: position{line: 771, col: 22, offset: 18326},
+									pos: position{line: 928, col: 22, offset: 22700},
 									expr: &seqExpr{
-										pos: position{line: 771, col: 24, offset: 18328},
+										pos: position{line: 928, col: 24, offset: 22702},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 771, col: 24, offset: 18328},
+												pos:  position{line: 928, col: 24, offset: 22702},
 												name: "_",
 											},
 											&choiceExpr{
-												pos: position{line: 771, col: 27, offset: 18331},
+												pos: position{line: 928, col: 27, offset: 22705},
 												alternatives: []any{
 													&ruleRefExpr{
-														pos:  position{line: 771, col: 27, offset: 18331},
+														pos:  position{line: 928, col: 27, offset: 22705},
 														name: "METHOD",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 771, col: 36, offset: 18340},
+														pos:  position{line: 928, col: 36, offset: 22714},
 														name: "HEADERS",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 771, col: 46, offset: 18350},
+														pos:  position{line: 928, col: 46, offset: 22724},
 														name: "BODY",
 													},
 												},
@@ -5127,51 +6066,51 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 778, col: 5, offset: 18495},
-						run: (*parser).callonFromArgs28,
+						pos: position{line: 935, col: 5, offset: 22869},
+						run: (*parser).callonFromArgs55,
 						expr: &seqExpr{
-							pos: position{line: 778, col: 5, offset: 18495},
+							pos: position{line: 935, col: 5, offset: 22869},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 778, col: 5, offset: 18495},
+									pos:   position{line: 935, col: 5, offset: 22869},
 									label: "format",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 778, col: 12, offset: 18502},
+										pos: position{line: 935, col: 12, offset: 22876},
 										expr: &ruleRefExpr{
-											pos:  position{line: 778, col: 12, offset: 18502},
+											pos:  position{line: 935, col: 12, offset: 22876},
 											name: "FormatArg",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 778, col: 23, offset: 18513},
+									pos:   position{line: 935, col: 23, offset: 22887},
 									label: "method",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 778, col: 30, offset: 18520},
+										pos: position{line: 935, col: 30, offset: 22894},
 										expr: &ruleRefExpr{
-											pos:  position{line: 778, col: 30, offset: 18520},
+											pos:  position{line: 935, col: 30, offset: 22894},
 											name: "MethodArg",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 778, col: 41, offset: 18531},
+									pos:   position{line: 935, col: 41, offset: 22905},
 									label: "headers",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 778, col: 49, offset: 18539},
+										pos: position{line: 935, col: 49, offset: 22913},
 										expr: &ruleRefExpr{
-											pos:  position{line: 778, col: 49, offset: 18539},
+											pos:  position{line: 935, col: 49, offset: 22913},
 											name: "HeadersArg",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 778, col: 61, offset: 18551},
+									pos:   position{line: 935, col: 61, offset: 22925},
 									label: "body",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 778, col: 66, offset: 18556},
+										pos: position{line: 935, col: 66, offset: 22930},
 										expr: &ruleRefExpr{
-											pos:  position{line: 778, col: 66, offset: 18556},
+											pos:  position{line: 935, col: 66, offset: 22930},
 											name: "BodyArg",
 										},
 									},
@@ -5186,30 +6125,30 @@ var g = &grammar{
 		},
 		{
 			name: "FormatArg",
-			pos:  position{line: 795, col: 1, offset: 18972},
+			pos:  position{line: 952, col: 1, offset: 23346},
 			expr: &actionExpr{
-				pos: position{line: 795, col: 13, offset: 18984},
+				pos: position{line: 952, col: 13, offset: 23358},
 				run: (*parser).callonFormatArg1,
 				expr: &seqExpr{
-					pos: position{line: 795, col: 13, offset: 18984},
+					pos: position{line: 952, col: 13, offset: 23358},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 795, col: 13, offset: 18984},
+							pos:  position{line: 952, col: 13, offset: 23358},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 795, col: 15, offset: 18986},
+							pos:  position{line: 952, col: 15, offset: 23360},
 							name: "FORMAT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 795, col: 22, offset: 18993},
+							pos:  position{line: 952, col: 22, offset: 23367},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 795, col: 24, offset: 18995},
+							pos:   position{line: 952, col: 24, offset: 23369},
 							label: "n",
 							expr: &ruleRefExpr{
-								pos:  position{line: 795, col: 26, offset: 18997},
+								pos:  position{line: 952, col: 26, offset: 23371},
 								name: "Name",
 							},
 						},
@@ -5221,30 +6160,30 @@ var g = &grammar{
 		},
 		{
 			name: "MethodArg",
-			pos:  position{line: 797, col: 1, offset: 19021},
+			pos:  position{line: 954, col: 1, offset: 23395},
 			expr: &actionExpr{
-				pos: position{line: 797, col: 13, offset: 19033},
+				pos: position{line: 954, col: 13, offset: 23407},
 				run: (*parser).callonMethodArg1,
 				expr: &seqExpr{
-					pos: position{line: 797, col: 13, offset: 19033},
+					pos: position{line: 954, col: 13, offset: 23407},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 797, col: 13, offset: 19033},
+							pos:  position{line: 954, col: 13, offset: 23407},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 797, col: 15, offset: 19035},
+							pos:  position{line: 954, col: 15, offset: 23409},
 							name: "METHOD",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 797, col: 22, offset: 19042},
+							pos:  position{line: 954, col: 22, offset: 23416},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 797, col: 24, offset: 19044},
+							pos:   position{line: 954, col: 24, offset: 23418},
 							label: "n",
 							expr: &ruleRefExpr{
-								pos:  position{line: 797, col: 26, offset: 19046},
+								pos:  position{line: 954, col: 26, offset: 23420},
 								name: "Name",
 							},
 						},
@@ -5256,30 +6195,30 @@ var g = &grammar{
 		},
 		{
 			name: "HeadersArg",
-			pos:  position{line: 799, col: 1, offset: 19070},
+			pos:  position{line: 956, col: 1, offset: 23444},
 			expr: &actionExpr{
-				pos: position{line: 799, col: 14, offset: 19083},
+				pos: position{line: 956, col: 14, offset: 23457},
 				run: (*parser).callonHeadersArg1,
 				expr: &seqExpr{
-					pos: position{line: 799, col: 14, offset: 19083},
+					pos: position{line: 956, col: 14, offset: 23457},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 799, col: 14, offset: 19083},
+							pos:  position{line: 956, col: 14, offset: 23457},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 799, col: 16, offset: 19085},
+							pos:  position{line: 956, col: 16, offset: 23459},
 							name: "HEADERS",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 799, col: 24, offset: 19093},
+							pos:  position{line: 956, col: 24, offset: 23467},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 799, col: 26, offset: 19095},
+							pos:   position{line: 956, col: 26, offset: 23469},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 799, col: 28, offset: 19097},
+								pos:  position{line: 956, col: 28, offset: 23471},
 								name: "Record",
 							},
 						},
@@ -5291,30 +6230,30 @@ var g = &grammar{
 		},
 		{
 			name: "BodyArg",
-			pos:  position{line: 801, col: 1, offset: 19123},
+			pos:  position{line: 958, col: 1, offset: 23497},
 			expr: &actionExpr{
-				pos: position{line: 801, col: 11, offset: 19133},
+				pos: position{line: 958, col: 11, offset: 23507},
 				run: (*parser).callonBodyArg1,
 				expr: &seqExpr{
-					pos: position{line: 801, col: 11, offset: 19133},
+					pos: position{line: 958, col: 11, offset: 23507},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 801, col: 11, offset: 19133},
+							pos:  position{line: 958, col: 11, offset: 23507},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 801, col: 13, offset: 19135},
+							pos:  position{line: 958, col: 13, offset: 23509},
 							name: "BODY",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 801, col: 18, offset: 19140},
+							pos:  position{line: 958, col: 18, offset: 23514},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 801, col: 20, offset: 19142},
+							pos:   position{line: 958, col: 20, offset: 23516},
 							label: "n",
 							expr: &ruleRefExpr{
-								pos:  position{line: 801, col: 22, offset: 19144},
+								pos:  position{line: 958, col: 22, offset: 23518},
 								name: "Name",
 							},
 						},
@@ -5326,24 +6265,24 @@ var g = &grammar{
 		},
 		{
 			name: "UnquotedURL",
-			pos:  position{line: 803, col: 1, offset: 19168},
+			pos:  position{line: 960, col: 1, offset: 23542},
 			expr: &actionExpr{
-				pos: position{line: 803, col: 15, offset: 19182},
+				pos: position{line: 960, col: 15, offset: 23556},
 				run: (*parser).callonUnquotedURL1,
 				expr: &seqExpr{
-					pos: position{line: 803, col: 15, offset: 19182},
+					pos: position{line: 960, col: 15, offset: 23556},
 					exprs: []any{
 						&choiceExpr{
-							pos: position{line: 803, col: 16, offset: 19183},
+							pos: position{line: 960, col: 16, offset: 23557},
 							alternatives: []any{
 								&litMatcher{
-									pos:        position{line: 803, col: 16, offset: 19183},
+									pos:        position{line: 960, col: 16, offset: 23557},
 									val:        "http://",
 									ignoreCase: false,
 									want:       "\"http://\"",
 								},
 								&litMatcher{
-									pos:        position{line: 803, col: 28, offset: 19195},
+									pos:        position{line: 960, col: 28, offset: 23569},
 									val:        "https://",
 									ignoreCase: false,
 									want:       "\"https://\"",
@@ -5351,9 +6290,9 @@ var g = &grammar{
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 803, col: 40, offset: 19207},
+							pos: position{line: 960, col: 40, offset: 23581},
 							expr: &ruleRefExpr{
-								pos:  position{line: 803, col: 40, offset: 19207},
+								pos:  position{line: 960, col: 40, offset: 23581},
 								name: "URLChar",
 							},
 						},
@@ -5365,9 +6304,9 @@ var g = &grammar{
 		},
 		{
 			name: "URLChar",
-			pos:  position{line: 805, col: 1, offset: 19248},
+			pos:  position{line: 962, col: 1, offset: 23622},
 			expr: &charClassMatcher{
-				pos:        position{line: 805, col: 11, offset: 19258},
+				pos:        position{line: 962, col: 11, offset: 23632},
 				val:        "[0-9a-zA-Z!@$%&_=,./?:[\\]~+-]",
 				chars:      []rune{'!', '@', '$', '%', '&', '_', '=', ',', '.', '/', '?', ':', '[', ']', '~', '+', '-'},
 				ranges:     []rune{'0', '9', 'a', 'z', 'A', 'Z'},
@@ -5379,30 +6318,30 @@ var g = &grammar{
 		},
 		{
 			name: "PoolAt",
-			pos:  position{line: 808, col: 1, offset: 19322},
+			pos:  position{line: 965, col: 1, offset: 23696},
 			expr: &actionExpr{
-				pos: position{line: 809, col: 5, offset: 19333},
+				pos: position{line: 966, col: 5, offset: 23707},
 				run: (*parser).callonPoolAt1,
 				expr: &seqExpr{
-					pos: position{line: 809, col: 5, offset: 19333},
+					pos: position{line: 966, col: 5, offset: 23707},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 809, col: 5, offset: 19333},
+							pos:  position{line: 966, col: 5, offset: 23707},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 809, col: 7, offset: 19335},
+							pos:  position{line: 966, col: 7, offset: 23709},
 							name: "AT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 809, col: 10, offset: 19338},
+							pos:  position{line: 966, col: 10, offset: 23712},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 809, col: 12, offset: 19340},
+							pos:   position{line: 966, col: 12, offset: 23714},
 							label: "id",
 							expr: &ruleRefExpr{
-								pos:  position{line: 809, col: 15, offset: 19343},
+								pos:  position{line: 966, col: 15, offset: 23717},
 								name: "KSUID",
 							},
 						},
@@ -5414,14 +6353,14 @@ var g = &grammar{
 		},
 		{
 			name: "KSUID",
-			pos:  position{line: 812, col: 1, offset: 19409},
+			pos:  position{line: 969, col: 1, offset: 23783},
 			expr: &actionExpr{
-				pos: position{line: 812, col: 9, offset: 19417},
+				pos: position{line: 969, col: 9, offset: 23791},
 				run: (*parser).callonKSUID1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 812, col: 9, offset: 19417},
+					pos: position{line: 969, col: 9, offset: 23791},
 					expr: &charClassMatcher{
-						pos:        position{line: 812, col: 10, offset: 19418},
+						pos:        position{line: 969, col: 10, offset: 23792},
 						val:        "[0-9a-zA-Z]",
 						ranges:     []rune{'0', '9', 'a', 'z', 'A', 'Z'},
 						ignoreCase: false,
@@ -5434,24 +6373,24 @@ var g = &grammar{
 		},
 		{
 			name: "PoolCommit",
-			pos:  position{line: 814, col: 1, offset: 19464},
+			pos:  position{line: 971, col: 1, offset: 23838},
 			expr: &actionExpr{
-				pos: position{line: 815, col: 5, offset: 19479},
+				pos: position{line: 972, col: 5, offset: 23853},
 				run: (*parser).callonPoolCommit1,
 				expr: &seqExpr{
-					pos: position{line: 815, col: 5, offset: 19479},
+					pos: position{line: 972, col: 5, offset: 23853},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 815, col: 5, offset: 19479},
+							pos:        position{line: 972, col: 5, offset: 23853},
 							val:        "@",
 							ignoreCase: false,
 							want:       "\"@\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 815, col: 9, offset: 19483},
+							pos:   position{line: 972, col: 9, offset: 23857},
 							label: "n",
 							expr: &ruleRefExpr{
-								pos:  position{line: 815, col: 11, offset: 19485},
+								pos:  position{line: 972, col: 11, offset: 23859},
 								name: "Name",
 							},
 						},
@@ -5461,26 +6400,55 @@ var g = &grammar{
 			leader:        false,
 			leftRecursive: false,
 		},
+		{
+			name: "PoolAsOf",
+			pos:  position{line: 974, col: 1, offset: 23883},
+			expr: &actionExpr{
+				pos: position{line: 975, col: 5, offset: 23896},
+				run: (*parser).callonPoolAsOf1,
+				expr: &seqExpr{
+					pos: position{line: 975, col: 5, offset: 23896},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 975, col: 5, offset: 23896},
+							val:        ":",
+							ignoreCase: false,
+							want:       "\":\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 975, col: 9, offset: 23900},
+							label: "t",
+							expr: &ruleRefExpr{
+								pos:  position{line: 975, col: 11, offset: 23902},
+								name: "Time",
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
 		{
 			name: "PoolMeta",
-			pos:  position{line: 817, col: 1, offset: 19509},
+			pos:  position{line: 977, col: 1, offset: 23926},
 			expr: &actionExpr{
-				pos: position{line: 818, col: 5, offset: 19522},
+				pos: position{line: 978, col: 5, offset: 23939},
 				run: (*parser).callonPoolMeta1,
 				expr: &seqExpr{
-					pos: position{line: 818, col: 5, offset: 19522},
+					pos: position{line: 978, col: 5, offset: 23939},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 818, col: 5, offset: 19522},
+							pos:        position{line: 978, col: 5, offset: 23939},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 818, col: 9, offset: 19526},
+							pos:   position{line: 978, col: 9, offset: 23943},
 							label: "n",
 							expr: &ruleRefExpr{
-								pos:  position{line: 818, col: 11, offset: 19528},
+								pos:  position{line: 978, col: 11, offset: 23945},
 								name: "Name",
 							},
 						},
@@ -5490,34 +6458,89 @@ var g = &grammar{
 			leader:        false,
 			leftRecursive: false,
 		},
+		{
+			name: "RangeArg",
+			pos:  position{line: 980, col: 1, offset: 23969},
+			expr: &actionExpr{
+				pos: position{line: 981, col: 5, offset: 23982},
+				run: (*parser).callonRangeArg1,
+				expr: &seqExpr{
+					pos: position{line: 981, col: 5, offset: 23982},
+					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 981, col: 5, offset: 23982},
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 981, col: 7, offset: 23984},
+							name: "RANGE",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 981, col: 13, offset: 23990},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 981, col: 15, offset: 23992},
+							label: "lower",
+							expr: &ruleRefExpr{
+								pos:  position{line: 981, col: 21, offset: 23998},
+								name: "Time",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 981, col: 26, offset: 24003},
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 981, col: 28, offset: 24005},
+							name: "TO",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 981, col: 31, offset: 24008},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 981, col: 33, offset: 24010},
+							label: "upper",
+							expr: &ruleRefExpr{
+								pos:  position{line: 981, col: 39, offset: 24016},
+								name: "Time",
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
 		{
 			name: "TapArg",
-			pos:  position{line: 820, col: 1, offset: 19552},
+			pos:  position{line: 990, col: 1, offset: 24198},
 			expr: &choiceExpr{
-				pos: position{line: 821, col: 5, offset: 19563},
+				pos: position{line: 991, col: 5, offset: 24209},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 821, col: 5, offset: 19563},
+						pos: position{line: 991, col: 5, offset: 24209},
 						run: (*parser).callonTapArg2,
 						expr: &seqExpr{
-							pos: position{line: 821, col: 5, offset: 19563},
+							pos: position{line: 991, col: 5, offset: 24209},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 821, col: 5, offset: 19563},
+									pos:  position{line: 991, col: 5, offset: 24209},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 821, col: 7, offset: 19565},
+									pos:  position{line: 991, col: 7, offset: 24211},
 									name: "TAP",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 822, col: 5, offset: 19594},
+						pos: position{line: 992, col: 5, offset: 24240},
 						run: (*parser).callonTapArg6,
 						expr: &litMatcher{
-							pos:        position{line: 822, col: 5, offset: 19594},
+							pos:        position{line: 992, col: 5, offset: 24240},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -5530,28 +6553,28 @@ var g = &grammar{
 		},
 		{
 			name: "PassOp",
-			pos:  position{line: 824, col: 1, offset: 19620},
+			pos:  position{line: 994, col: 1, offset: 24266},
 			expr: &actionExpr{
-				pos: position{line: 825, col: 5, offset: 19631},
+				pos: position{line: 995, col: 5, offset: 24277},
 				run: (*parser).callonPassOp1,
 				expr: &seqExpr{
-					pos: position{line: 825, col: 5, offset: 19631},
+					pos: position{line: 995, col: 5, offset: 24277},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 825, col: 5, offset: 19631},
+							pos:  position{line: 995, col: 5, offset: 24277},
 							name: "PASS",
 						},
 						&notExpr{
-							pos: position{line: 825, col: 10, offset: 19636},
+							pos: position{line: 995, col: 10, offset: 24282},
 							expr: &seqExpr{
-								pos: position{line: 825, col: 12, offset: 19638},
+								pos: position{line: 995, col: 12, offset: 24284},
 								exprs: []any{
 									&ruleRefExpr{
-										pos:  position{line: 825, col: 12, offset: 19638},
+										pos:  position{line: 995, col: 12, offset: 24284},
 										name: "__",
 									},
 									&litMatcher{
-										pos:        position{line: 825, col: 15, offset: 19641},
+										pos:        position{line: 995, col: 15, offset: 24287},
 										val:        "(",
 										ignoreCase: false,
 										want:       "\"(\"",
@@ -5560,9 +6583,9 @@ var g = &grammar{
 							},
 						},
 						&andExpr{
-							pos: position{line: 825, col: 20, offset: 19646},
+							pos: position{line: 995, col: 20, offset: 24292},
 							expr: &ruleRefExpr{
-								pos:  position{line: 825, col: 21, offset: 19647},
+								pos:  position{line: 995, col: 21, offset: 24293},
 								name: "EOKW",
 							},
 						},
@@ -5574,44 +6597,44 @@ var g = &grammar{
 		},
 		{
 			name: "ExplodeOp",
-			pos:  position{line: 831, col: 1, offset: 19838},
+			pos:  position{line: 1001, col: 1, offset: 24484},
 			expr: &actionExpr{
-				pos: position{line: 832, col: 5, offset: 19852},
+				pos: position{line: 1002, col: 5, offset: 24498},
 				run: (*parser).callonExplodeOp1,
 				expr: &seqExpr{
-					pos: position{line: 832, col: 5, offset: 19852},
+					pos: position{line: 1002, col: 5, offset: 24498},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 832, col: 5, offset: 19852},
+							pos:  position{line: 1002, col: 5, offset: 24498},
 							name: "EXPLODE",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 832, col: 13, offset: 19860},
+							pos:  position{line: 1002, col: 13, offset: 24506},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 832, col: 15, offset: 19862},
+							pos:   position{line: 1002, col: 15, offset: 24508},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 832, col: 20, offset: 19867},
+								pos:  position{line: 1002, col: 20, offset: 24513},
 								name: "Exprs",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 832, col: 26, offset: 19873},
+							pos:   position{line: 1002, col: 26, offset: 24519},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 832, col: 30, offset: 19877},
+								pos:  position{line: 1002, col: 30, offset: 24523},
 								name: "TypeArg",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 832, col: 38, offset: 19885},
+							pos:   position{line: 1002, col: 38, offset: 24531},
 							label: "as",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 832, col: 41, offset: 19888},
+								pos: position{line: 1002, col: 41, offset: 24534},
 								expr: &ruleRefExpr{
-									pos:  position{line: 832, col: 41, offset: 19888},
+									pos:  position{line: 1002, col: 41, offset: 24534},
 									name: "AsArg",
 								},
 							},
@@ -5624,26 +6647,26 @@ var g = &grammar{
 		},
 		{
 			name: "MergeOp",
-			pos:  position{line: 845, col: 1, offset: 20130},
+			pos:  position{line: 1015, col: 1, offset: 24776},
 			expr: &actionExpr{
-				pos: position{line: 846, col: 5, offset: 20142},
+				pos: position{line: 1016, col: 5, offset: 24788},
 				run: (*parser).callonMergeOp1,
 				expr: &seqExpr{
-					pos: position{line: 846, col: 5, offset: 20142},
+					pos: position{line: 1016, col: 5, offset: 24788},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 846, col: 5, offset: 20142},
+							pos:  position{line: 1016, col: 5, offset: 24788},
 							name: "MERGE",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 846, col: 11, offset: 20148},
+							pos:  position{line: 1016, col: 11, offset: 24794},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 846, col: 13, offset: 20150},
+							pos:   position{line: 1016, col: 13, offset: 24796},
 							label: "exprs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 846, col: 19, offset: 20156},
+								pos:  position{line: 1016, col: 19, offset: 24802},
 								name: "OrderByList",
 							},
 						},
@@ -5655,56 +6678,56 @@ var g = &grammar{
 		},
 		{
 			name: "OverOp",
-			pos:  position{line: 854, col: 1, offset: 20298},
+			pos:  position{line: 1024, col: 1, offset: 24944},
 			expr: &actionExpr{
-				pos: position{line: 855, col: 5, offset: 20309},
+				pos: position{line: 1025, col: 5, offset: 24955},
 				run: (*parser).callonOverOp1,
 				expr: &seqExpr{
-					pos: position{line: 855, col: 5, offset: 20309},
+					pos: position{line: 1025, col: 5, offset: 24955},
 					exprs: []any{
 						&choiceExpr{
-							pos: position{line: 855, col: 6, offset: 20310},
+							pos: position{line: 1025, col: 6, offset: 24956},
 							alternatives: []any{
 								&ruleRefExpr{
-									pos:  position{line: 855, col: 6, offset: 20310},
+									pos:  position{line: 1025, col: 6, offset: 24956},
 									name: "OVER",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 855, col: 13, offset: 20317},
+									pos:  position{line: 1025, col: 13, offset: 24963},
 									name: "UNNEST",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 855, col: 21, offset: 20325},
+							pos:  position{line: 1025, col: 21, offset: 24971},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 855, col: 23, offset: 20327},
+							pos:   position{line: 1025, col: 23, offset: 24973},
 							label: "exprs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 855, col: 29, offset: 20333},
+								pos:  position{line: 1025, col: 29, offset: 24979},
 								name: "Exprs",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 855, col: 35, offset: 20339},
+							pos:   position{line: 1025, col: 35, offset: 24985},
 							label: "locals",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 855, col: 42, offset: 20346},
+								pos: position{line: 1025, col: 42, offset: 24992},
 								expr: &ruleRefExpr{
-									pos:  position{line: 855, col: 42, offset: 20346},
+									pos:  position{line: 1025, col: 42, offset: 24992},
 									name: "Locals",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 855, col: 50, offset: 20354},
+							pos:   position{line: 1025, col: 50, offset: 25000},
 							label: "body",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 855, col: 55, offset: 20359},
+								pos: position{line: 1025, col: 55, offset: 25005},
 								expr: &ruleRefExpr{
-									pos:  position{line: 855, col: 55, offset: 20359},
+									pos:  position{line: 1025, col: 55, offset: 25005},
 									name: "Lateral",
 								},
 							},
@@ -5717,54 +6740,54 @@ var g = &grammar{
 		},
 		{
 			name: "Lateral",
-			pos:  position{line: 870, col: 1, offset: 20684},
+			pos:  position{line: 1040, col: 1, offset: 25330},
 			expr: &choiceExpr{
-				pos: position{line: 871, col: 5, offset: 20696},
+				pos: position{line: 1041, col: 5, offset: 25342},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 871, col: 5, offset: 20696},
+						pos: position{line: 1041, col: 5, offset: 25342},
 						run: (*parser).callonLateral2,
 						expr: &seqExpr{
-							pos: position{line: 871, col: 5, offset: 20696},
+							pos: position{line: 1041, col: 5, offset: 25342},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 871, col: 5, offset: 20696},
+									pos:  position{line: 1041, col: 5, offset: 25342},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 871, col: 8, offset: 20699},
+									pos:        position{line: 1041, col: 8, offset: 25345},
 									val:        "=>",
 									ignoreCase: false,
 									want:       "\"=>\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 871, col: 13, offset: 20704},
+									pos:  position{line: 1041, col: 13, offset: 25350},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 871, col: 16, offset: 20707},
+									pos:        position{line: 1041, col: 16, offset: 25353},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 871, col: 20, offset: 20711},
+									pos:  position{line: 1041, col: 20, offset: 25357},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 871, col: 23, offset: 20714},
+									pos:   position{line: 1041, col: 23, offset: 25360},
 									label: "scope",
 									expr: &ruleRefExpr{
-										pos:  position{line: 871, col: 29, offset: 20720},
+										pos:  position{line: 1041, col: 29, offset: 25366},
 										name: "Scope",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 871, col: 35, offset: 20726},
+									pos:  position{line: 1041, col: 35, offset: 25372},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 871, col: 38, offset: 20729},
+									pos:        position{line: 1041, col: 38, offset: 25375},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -5773,49 +6796,49 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 874, col: 5, offset: 20810},
+						pos: position{line: 1044, col: 5, offset: 25456},
 						run: (*parser).callonLateral13,
 						expr: &seqExpr{
-							pos: position{line: 874, col: 5, offset: 20810},
+							pos: position{line: 1044, col: 5, offset: 25456},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 874, col: 5, offset: 20810},
+									pos:  position{line: 1044, col: 5, offset: 25456},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 874, col: 8, offset: 20813},
+									pos:        position{line: 1044, col: 8, offset: 25459},
 									val:        "=>",
 									ignoreCase: false,
 									want:       "\"=>\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 874, col: 13, offset: 20818},
+									pos:  position{line: 1044, col: 13, offset: 25464},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 874, col: 16, offset: 20821},
+									pos:        position{line: 1044, col: 16, offset: 25467},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 874, col: 20, offset: 20825},
+									pos:  position{line: 1044, col: 20, offset: 25471},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 874, col: 23, offset: 20828},
+									pos:   position{line: 1044, col: 23, offset: 25474},
 									label: "seq",
 									expr: &ruleRefExpr{
-										pos:  position{line: 874, col: 27, offset: 20832},
+										pos:  position{line: 1044, col: 27, offset: 25478},
 										name: "Seq",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 874, col: 31, offset: 20836},
+									pos:  position{line: 1044, col: 31, offset: 25482},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 874, col: 34, offset: 20839},
+									pos:        position{line: 1044, col: 34, offset: 25485},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -5830,63 +6853,63 @@ var g = &grammar{
 		},
 		{
 			name: "Locals",
-			pos:  position{line: 878, col: 1, offset: 20895},
+			pos:  position{line: 1048, col: 1, offset: 25541},
 			expr: &actionExpr{
-				pos: position{line: 879, col: 5, offset: 20906},
+				pos: position{line: 1049, col: 5, offset: 25552},
 				run: (*parser).callonLocals1,
 				expr: &seqExpr{
-					pos: position{line: 879, col: 5, offset: 20906},
+					pos: position{line: 1049, col: 5, offset: 25552},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 879, col: 5, offset: 20906},
+							pos:  position{line: 1049, col: 5, offset: 25552},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 879, col: 7, offset: 20908},
+							pos:  position{line: 1049, col: 7, offset: 25554},
 							name: "WITH",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 879, col: 12, offset: 20913},
+							pos:  position{line: 1049, col: 12, offset: 25559},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 879, col: 14, offset: 20915},
+							pos:   position{line: 1049, col: 14, offset: 25561},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 879, col: 20, offset: 20921},
+								pos:  position{line: 1049, col: 20, offset: 25567},
 								name: "LocalsAssignment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 879, col: 37, offset: 20938},
+							pos:   position{line: 1049, col: 37, offset: 25584},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 879, col: 42, offset: 20943},
+								pos: position{line: 1049, col: 42, offset: 25589},
 								expr: &actionExpr{
-									pos: position{line: 879, col: 43, offset: 20944},
+									pos: position{line: 1049, col: 43, offset: 25590},
 									run: (*parser).callonLocals10,
 									expr: &seqExpr{
-										pos: position{line: 879, col: 43, offset: 20944},
+										pos: position{line: 1049, col: 43, offset: 25590},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 879, col: 43, offset: 20944},
+												pos:  position{line: 1049, col: 43, offset: 25590},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 879, col: 46, offset: 20947},
+												pos:        position{line: 1049, col: 46, offset: 25593},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 879, col: 50, offset: 20951},
+												pos:  position{line: 1049, col: 50, offset: 25597},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 879, col: 53, offset: 20954},
+												pos:   position{line: 1049, col: 53, offset: 25600},
 												label: "a",
 												expr: &ruleRefExpr{
-													pos:  position{line: 879, col: 55, offset: 20956},
+													pos:  position{line: 1049, col: 55, offset: 25602},
 													name: "LocalsAssignment",
 												},
 											},
@@ -5903,45 +6926,45 @@ var g = &grammar{
 		},
 		{
 			name: "LocalsAssignment",
-			pos:  position{line: 883, col: 1, offset: 21041},
+			pos:  position{line: 1053, col: 1, offset: 25687},
 			expr: &actionExpr{
-				pos: position{line: 884, col: 5, offset: 21062},
+				pos: position{line: 1054, col: 5, offset: 25708},
 				run: (*parser).callonLocalsAssignment1,
 				expr: &seqExpr{
-					pos: position{line: 884, col: 5, offset: 21062},
+					pos: position{line: 1054, col: 5, offset: 25708},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 884, col: 5, offset: 21062},
+							pos:   position{line: 1054, col: 5, offset: 25708},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 884, col: 10, offset: 21067},
+								pos:  position{line: 1054, col: 10, offset: 25713},
 								name: "Identifier",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 884, col: 21, offset: 21078},
+							pos:   position{line: 1054, col: 21, offset: 25724},
 							label: "opt",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 884, col: 25, offset: 21082},
+								pos: position{line: 1054, col: 25, offset: 25728},
 								expr: &seqExpr{
-									pos: position{line: 884, col: 26, offset: 21083},
+									pos: position{line: 1054, col: 26, offset: 25729},
 									exprs: []any{
 										&ruleRefExpr{
-											pos:  position{line: 884, col: 26, offset: 21083},
+											pos:  position{line: 1054, col: 26, offset: 25729},
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 884, col: 29, offset: 21086},
+											pos:        position{line: 1054, col: 29, offset: 25732},
 											val:        "=",
 											ignoreCase: false,
 											want:       "\"=\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 884, col: 33, offset: 21090},
+											pos:  position{line: 1054, col: 33, offset: 25736},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 884, col: 36, offset: 21093},
+											pos:  position{line: 1054, col: 36, offset: 25739},
 											name: "Expr",
 										},
 									},
@@ -5956,26 +6979,26 @@ var g = &grammar{
 		},
 		{
 			name: "YieldOp",
-			pos:  position{line: 896, col: 1, offset: 21317},
+			pos:  position{line: 1066, col: 1, offset: 25963},
 			expr: &actionExpr{
-				pos: position{line: 897, col: 5, offset: 21329},
+				pos: position{line: 1067, col: 5, offset: 25975},
 				run: (*parser).callonYieldOp1,
 				expr: &seqExpr{
-					pos: position{line: 897, col: 5, offset: 21329},
+					pos: position{line: 1067, col: 5, offset: 25975},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 897, col: 5, offset: 21329},
+							pos:  position{line: 1067, col: 5, offset: 25975},
 							name: "YIELD",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 897, col: 11, offset: 21335},
+							pos:  position{line: 1067, col: 11, offset: 25981},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 897, col: 13, offset: 21337},
+							pos:   position{line: 1067, col: 13, offset: 25983},
 							label: "exprs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 897, col: 19, offset: 21343},
+								pos:  position{line: 1067, col: 19, offset: 25989},
 								name: "Exprs",
 							},
 						},
@@ -5987,30 +7010,30 @@ var g = &grammar{
 		},
 		{
 			name: "TypeArg",
-			pos:  position{line: 905, col: 1, offset: 21487},
+			pos:  position{line: 1075, col: 1, offset: 26133},
 			expr: &actionExpr{
-				pos: position{line: 906, col: 5, offset: 21499},
+				pos: position{line: 1076, col: 5, offset: 26145},
 				run: (*parser).callonTypeArg1,
 				expr: &seqExpr{
-					pos: position{line: 906, col: 5, offset: 21499},
+					pos: position{line: 1076, col: 5, offset: 26145},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 906, col: 5, offset: 21499},
+							pos:  position{line: 1076, col: 5, offset: 26145},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 906, col: 7, offset: 21501},
+							pos:  position{line: 1076, col: 7, offset: 26147},
 							name: "BY",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 906, col: 10, offset: 21504},
+							pos:  position{line: 1076, col: 10, offset: 26150},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 906, col: 12, offset: 21506},
+							pos:   position{line: 1076, col: 12, offset: 26152},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 906, col: 16, offset: 21510},
+								pos:  position{line: 1076, col: 16, offset: 26156},
 								name: "Type",
 							},
 						},
@@ -6022,30 +7045,30 @@ var g = &grammar{
 		},
 		{
 			name: "AsArg",
-			pos:  position{line: 908, col: 1, offset: 21536},
+			pos:  position{line: 1078, col: 1, offset: 26182},
 			expr: &actionExpr{
-				pos: position{line: 909, col: 5, offset: 21546},
+				pos: position{line: 1079, col: 5, offset: 26192},
 				run: (*parser).callonAsArg1,
 				expr: &seqExpr{
-					pos: position{line: 909, col: 5, offset: 21546},
+					pos: position{line: 1079, col: 5, offset: 26192},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 909, col: 5, offset: 21546},
+							pos:  position{line: 1079, col: 5, offset: 26192},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 909, col: 7, offset: 21548},
+							pos:  position{line: 1079, col: 7, offset: 26194},
 							name: "AS",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 909, col: 10, offset: 21551},
+							pos:  position{line: 1079, col: 10, offset: 26197},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 909, col: 12, offset: 21553},
+							pos:   position{line: 1079, col: 12, offset: 26199},
 							label: "lhs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 909, col: 16, offset: 21557},
+								pos:  position{line: 1079, col: 16, offset: 26203},
 								name: "Lval",
 							},
 						},
@@ -6057,9 +7080,9 @@ var g = &grammar{
 		},
 		{
 			name: "Lval",
-			pos:  position{line: 913, col: 1, offset: 21608},
+			pos:  position{line: 1083, col: 1, offset: 26254},
 			expr: &ruleRefExpr{
-				pos:  position{line: 913, col: 8, offset: 21615},
+				pos:  position{line: 1083, col: 8, offset: 26261},
 				name: "DerefExpr",
 			},
 			leader:        false,
@@ -6067,51 +7090,51 @@ var g = &grammar{
 		},
 		{
 			name: "Lvals",
-			pos:  position{line: 915, col: 1, offset: 21626},
+			pos:  position{line: 1085, col: 1, offset: 26272},
 			expr: &actionExpr{
-				pos: position{line: 916, col: 5, offset: 21636},
+				pos: position{line: 1086, col: 5, offset: 26282},
 				run: (*parser).callonLvals1,
 				expr: &seqExpr{
-					pos: position{line: 916, col: 5, offset: 21636},
+					pos: position{line: 1086, col: 5, offset: 26282},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 916, col: 5, offset: 21636},
+							pos:   position{line: 1086, col: 5, offset: 26282},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 916, col: 11, offset: 21642},
+								pos:  position{line: 1086, col: 11, offset: 26288},
 								name: "Lval",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 916, col: 16, offset: 21647},
+							pos:   position{line: 1086, col: 16, offset: 26293},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 916, col: 21, offset: 21652},
+								pos: position{line: 1086, col: 21, offset: 26298},
 								expr: &actionExpr{
-									pos: position{line: 916, col: 22, offset: 21653},
+									pos: position{line: 1086, col: 22, offset: 26299},
 									run: (*parser).callonLvals7,
 									expr: &seqExpr{
-										pos: position{line: 916, col: 22, offset: 21653},
+										pos: position{line: 1086, col: 22, offset: 26299},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 916, col: 22, offset: 21653},
+												pos:  position{line: 1086, col: 22, offset: 26299},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 916, col: 25, offset: 21656},
+												pos:        position{line: 1086, col: 25, offset: 26302},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 916, col: 29, offset: 21660},
+												pos:  position{line: 1086, col: 29, offset: 26306},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 916, col: 32, offset: 21663},
+												pos:   position{line: 1086, col: 32, offset: 26309},
 												label: "lval",
 												expr: &ruleRefExpr{
-													pos:  position{line: 916, col: 37, offset: 21668},
+													pos:  position{line: 1086, col: 37, offset: 26314},
 													name: "Lval",
 												},
 											},
@@ -6128,51 +7151,51 @@ var g = &grammar{
 		},
 		{
 			name: "Assignments",
-			pos:  position{line: 920, col: 1, offset: 21744},
+			pos:  position{line: 1090, col: 1, offset: 26390},
 			expr: &actionExpr{
-				pos: position{line: 921, col: 5, offset: 21760},
+				pos: position{line: 1091, col: 5, offset: 26406},
 				run: (*parser).callonAssignments1,
 				expr: &seqExpr{
-					pos: position{line: 921, col: 5, offset: 21760},
+					pos: position{line: 1091, col: 5, offset: 26406},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 921, col: 5, offset: 21760},
+							pos:   position{line: 1091, col: 5, offset: 26406},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 921, col: 11, offset: 21766},
+								pos:  position{line: 1091, col: 11, offset: 26412},
 								name: "Assignment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 921, col: 22, offset: 21777},
+							pos:   position{line: 1091, col: 22, offset: 26423},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 921, col: 27, offset: 21782},
+								pos: position{line: 1091, col: 27, offset: 26428},
 								expr: &actionExpr{
-									pos: position{line: 921, col: 28, offset: 21783},
+									pos: position{line: 1091, col: 28, offset: 26429},
 									run: (*parser).callonAssignments7,
 									expr: &seqExpr{
-										pos: position{line: 921, col: 28, offset: 21783},
+										pos: position{line: 1091, col: 28, offset: 26429},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 921, col: 28, offset: 21783},
+												pos:  position{line: 1091, col: 28, offset: 26429},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 921, col: 31, offset: 21786},
+												pos:        position{line: 1091, col: 31, offset: 26432},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 921, col: 35, offset: 21790},
+												pos:  position{line: 1091, col: 35, offset: 26436},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 921, col: 38, offset: 21793},
+												pos:   position{line: 1091, col: 38, offset: 26439},
 												label: "a",
 												expr: &ruleRefExpr{
-													pos:  position{line: 921, col: 40, offset: 21795},
+													pos:  position{line: 1091, col: 40, offset: 26441},
 													name: "Assignment",
 												},
 											},
@@ -6189,40 +7212,40 @@ var g = &grammar{
 		},
 		{
 			name: "Assignment",
-			pos:  position{line: 925, col: 1, offset: 21870},
+			pos:  position{line: 1095, col: 1, offset: 26516},
 			expr: &actionExpr{
-				pos: position{line: 926, col: 5, offset: 21885},
+				pos: position{line: 1096, col: 5, offset: 26531},
 				run: (*parser).callonAssignment1,
 				expr: &seqExpr{
-					pos: position{line: 926, col: 5, offset: 21885},
+					pos: position{line: 1096, col: 5, offset: 26531},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 926, col: 5, offset: 21885},
+							pos:   position{line: 1096, col: 5, offset: 26531},
 							label: "lhs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 926, col: 9, offset: 21889},
+								pos:  position{line: 1096, col: 9, offset: 26535},
 								name: "Lval",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 926, col: 14, offset: 21894},
+							pos:  position{line: 1096, col: 14, offset: 26540},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 926, col: 17, offset: 21897},
+							pos:        position{line: 1096, col: 17, offset: 26543},
 							val:        ":=",
 							ignoreCase: false,
 							want:       "\":=\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 926, col: 22, offset: 21902},
+							pos:  position{line: 1096, col: 22, offset: 26548},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 926, col: 25, offset: 21905},
+							pos:   position{line: 1096, col: 25, offset: 26551},
 							label: "rhs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 926, col: 29, offset: 21909},
+								pos:  position{line: 1096, col: 29, offset: 26555},
 								name: "Expr",
 							},
 						},
@@ -6234,9 +7257,9 @@ var g = &grammar{
 		},
 		{
 			name: "Expr",
-			pos:  position{line: 935, col: 1, offset: 22080},
+			pos:  position{line: 1105, col: 1, offset: 26726},
 			expr: &ruleRefExpr{
-				pos:  position{line: 935, col: 8, offset: 22087},
+				pos:  position{line: 1105, col: 8, offset: 26733},
 				name: "ConditionalExpr",
 			},
 			leader:        false,
@@ -6244,63 +7267,63 @@ var g = &grammar{
 		},
 		{
 			name: "ConditionalExpr",
-			pos:  position{line: 937, col: 1, offset: 22104},
+			pos:  position{line: 1107, col: 1, offset: 26750},
 			expr: &actionExpr{
-				pos: position{line: 938, col: 5, offset: 22124},
+				pos: position{line: 1108, col: 5, offset: 26770},
 				run: (*parser).callonConditionalExpr1,
 				expr: &seqExpr{
-					pos: position{line: 938, col: 5, offset: 22124},
+					pos: position{line: 1108, col: 5, offset: 26770},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 938, col: 5, offset: 22124},
+							pos:   position{line: 1108, col: 5, offset: 26770},
 							label: "cond",
 							expr: &ruleRefExpr{
-								pos:  position{line: 938, col: 10, offset: 22129},
+								pos:  position{line: 1108, col: 10, offset: 26775},
 								name: "LogicalOrExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 938, col: 24, offset: 22143},
+							pos:   position{line: 1108, col: 24, offset: 26789},
 							label: "opt",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 938, col: 28, offset: 22147},
+								pos: position{line: 1108, col: 28, offset: 26793},
 								expr: &seqExpr{
-									pos: position{line: 938, col: 29, offset: 22148},
+									pos: position{line: 1108, col: 29, offset: 26794},
 									exprs: []any{
 										&ruleRefExpr{
-											pos:  position{line: 938, col: 29, offset: 22148},
+											pos:  position{line: 1108, col: 29, offset: 26794},
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 938, col: 32, offset: 22151},
+											pos:        position{line: 1108, col: 32, offset: 26797},
 											val:        "?",
 											ignoreCase: false,
 											want:       "\"?\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 938, col: 36, offset: 22155},
+											pos:  position{line: 1108, col: 36, offset: 26801},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 938, col: 39, offset: 22158},
+											pos:  position{line: 1108, col: 39, offset: 26804},
 											name: "Expr",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 938, col: 44, offset: 22163},
+											pos:  position{line: 1108, col: 44, offset: 26809},
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 938, col: 47, offset: 22166},
+											pos:        position{line: 1108, col: 47, offset: 26812},
 											val:        ":",
 											ignoreCase: false,
 											want:       "\":\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 938, col: 51, offset: 22170},
+											pos:  position{line: 1108, col: 51, offset: 26816},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 938, col: 54, offset: 22173},
+											pos:  position{line: 1108, col: 54, offset: 26819},
 											name: "Expr",
 										},
 									},
@@ -6315,53 +7338,53 @@ var g = &grammar{
 		},
 		{
 			name: "LogicalOrExpr",
-			pos:  position{line: 952, col: 1, offset: 22494},
+			pos:  position{line: 1122, col: 1, offset: 27140},
 			expr: &actionExpr{
-				pos: position{line: 953, col: 5, offset: 22512},
+				pos: position{line: 1123, col: 5, offset: 27158},
 				run: (*parser).callonLogicalOrExpr1,
 				expr: &seqExpr{
-					pos: position{line: 953, col: 5, offset: 22512},
+					pos: position{line: 1123, col: 5, offset: 27158},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 953, col: 5, offset: 22512},
+							pos:   position{line: 1123, col: 5, offset: 27158},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 953, col: 11, offset: 22518},
+								pos:  position{line: 1123, col: 11, offset: 27164},
 								name: "LogicalAndExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 954, col: 5, offset: 22537},
+							pos:   position{line: 1124, col: 5, offset: 27183},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 954, col: 10, offset: 22542},
+								pos: position{line: 1124, col: 10, offset: 27188},
 								expr: &actionExpr{
-									pos: position{line: 954, col: 11, offset: 22543},
+									pos: position{line: 1124, col: 11, offset: 27189},
 									run: (*parser).callonLogicalOrExpr7,
 									expr: &seqExpr{
-										pos: position{line: 954, col: 11, offset: 22543},
+										pos: position{line: 1124, col: 11, offset: 27189},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 954, col: 11, offset: 22543},
+												pos:  position{line: 1124, col: 11, offset: 27189},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 954, col: 14, offset: 22546},
+												pos:   position{line: 1124, col: 14, offset: 27192},
 												label: "op",
 												expr: &ruleRefExpr{
-													pos:  position{line: 954, col: 17, offset: 22549},
+													pos:  position{line: 1124, col: 17, offset: 27195},
 													name: "OR",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 954, col: 20, offset: 22552},
+												pos:  position{line: 1124, col: 20, offset: 27198},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 954, col: 23, offset: 22555},
+												pos:   position{line: 1124, col: 23, offset: 27201},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 954, col: 28, offset: 22560},
+													pos:  position{line: 1124, col: 28, offset: 27206},
 													name: "LogicalAndExpr",
 												},
 											},
@@ -6378,53 +7401,53 @@ var g = &grammar{
 		},
 		{
 			name: "LogicalAndExpr",
-			pos:  position{line: 958, col: 1, offset: 22674},
+			pos:  position{line: 1128, col: 1, offset: 27320},
 			expr: &actionExpr{
-				pos: position{line: 959, col: 5, offset: 22693},
+				pos: position{line: 1129, col: 5, offset: 27339},
 				run: (*parser).callonLogicalAndExpr1,
 				expr: &seqExpr{
-					pos: position{line: 959, col: 5, offset: 22693},
+					pos: position{line: 1129, col: 5, offset: 27339},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 959, col: 5, offset: 22693},
+							pos:   position{line: 1129, col: 5, offset: 27339},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 959, col: 11, offset: 22699},
+								pos:  position{line: 1129, col: 11, offset: 27345},
 								name: "NotExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 960, col: 5, offset: 22711},
+							pos:   position{line: 1130, col: 5, offset: 27357},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 960, col: 10, offset: 22716},
+								pos: position{line: 1130, col: 10, offset: 27362},
 								expr: &actionExpr{
-									pos: position{line: 960, col: 11, offset: 22717},
+									pos: position{line: 1130, col: 11, offset: 27363},
 									run: (*parser).callonLogicalAndExpr7,
 									expr: &seqExpr{
-										pos: position{line: 960, col: 11, offset: 22717},
+										pos: position{line: 1130, col: 11, offset: 27363},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 960, col: 11, offset: 22717},
+												pos:  position{line: 1130, col: 11, offset: 27363},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 960, col: 14, offset: 22720},
+												pos:   position{line: 1130, col: 14, offset: 27366},
 												label: "op",
 												expr: &ruleRefExpr{
-													pos:  position{line: 960, col: 17, offset: 22723},
+													pos:  position{line: 1130, col: 17, offset: 27369},
 													name: "AND",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 960, col: 21, offset: 22727},
+												pos:  position{line: 1130, col: 21, offset: 27373},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 960, col: 24, offset: 22730},
+												pos:   position{line: 1130, col: 24, offset: 27376},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 960, col: 29, offset: 22735},
+													pos:  position{line: 1130, col: 29, offset: 27381},
 													name: "NotExpr",
 												},
 											},
@@ -6441,43 +7464,43 @@ var g = &grammar{
 		},
 		{
 			name: "NotExpr",
-			pos:  position{line: 964, col: 1, offset: 22842},
+			pos:  position{line: 1134, col: 1, offset: 27488},
 			expr: &choiceExpr{
-				pos: position{line: 965, col: 5, offset: 22854},
+				pos: position{line: 1135, col: 5, offset: 27500},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 965, col: 5, offset: 22854},
+						pos: position{line: 1135, col: 5, offset: 27500},
 						run: (*parser).callonNotExpr2,
 						expr: &seqExpr{
-							pos: position{line: 965, col: 5, offset: 22854},
+							pos: position{line: 1135, col: 5, offset: 27500},
 							exprs: []any{
 								&choiceExpr{
-									pos: position{line: 965, col: 6, offset: 22855},
+									pos: position{line: 1135, col: 6, offset: 27501},
 									alternatives: []any{
 										&seqExpr{
-											pos: position{line: 965, col: 6, offset: 22855},
+											pos: position{line: 1135, col: 6, offset: 27501},
 											exprs: []any{
 												&ruleRefExpr{
-													pos:  position{line: 965, col: 6, offset: 22855},
+													pos:  position{line: 1135, col: 6, offset: 27501},
 													name: "NOT",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 965, col: 10, offset: 22859},
+													pos:  position{line: 1135, col: 10, offset: 27505},
 													name: "_",
 												},
 											},
 										},
 										&seqExpr{
-											pos: position{line: 965, col: 14, offset: 22863},
+											pos: position{line: 1135, col: 14, offset: 27509},
 											exprs: []any{
 												&litMatcher{
-													pos:        position{line: 965, col: 14, offset: 22863},
+													pos:        position{line: 1135, col: 14, offset: 27509},
 													val:        "!",
 													ignoreCase: false,
 													want:       "\"!\"",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 965, col: 18, offset: 22867},
+													pos:  position{line: 1135, col: 18, offset: 27513},
 													name: "__",
 												},
 											},
@@ -6485,10 +7508,10 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 965, col: 22, offset: 22871},
+									pos:   position{line: 1135, col: 22, offset: 27517},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 965, col: 24, offset: 22873},
+										pos:  position{line: 1135, col: 24, offset: 27519},
 										name: "NotExpr",
 									},
 								},
@@ -6496,7 +7519,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 973, col: 5, offset: 23039},
+						pos:  position{line: 1143, col: 5, offset: 27685},
 						name: "BetweenExpr",
 					},
 				},
@@ -6506,42 +7529,42 @@ var g = &grammar{
 		},
 		{
 			name: "BetweenExpr",
-			pos:  position{line: 975, col: 1, offset: 23054},
+			pos:  position{line: 1145, col: 1, offset: 27700},
 			expr: &choiceExpr{
-				pos: position{line: 976, col: 5, offset: 23070},
+				pos: position{line: 1146, col: 5, offset: 27716},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 976, col: 5, offset: 23070},
+						pos: position{line: 1146, col: 5, offset: 27716},
 						run: (*parser).callonBetweenExpr2,
 						expr: &seqExpr{
-							pos: position{line: 976, col: 5, offset: 23070},
+							pos: position{line: 1146, col: 5, offset: 27716},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 976, col: 5, offset: 23070},
+									pos:   position{line: 1146, col: 5, offset: 27716},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 976, col: 10, offset: 23075},
+										pos:  position{line: 1146, col: 10, offset: 27721},
 										name: "ComparisonExpr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 976, col: 25, offset: 23090},
+									pos:  position{line: 1146, col: 25, offset: 27736},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 976, col: 27, offset: 23092},
+									pos:   position{line: 1146, col: 27, offset: 27738},
 									label: "not",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 976, col: 31, offset: 23096},
+										pos: position{line: 1146, col: 31, offset: 27742},
 										expr: &seqExpr{
-											pos: position{line: 976, col: 32, offset: 23097},
+											pos: position{line: 1146, col: 32, offset: 27743},
 											exprs: []any{
 												&ruleRefExpr{
-													pos:  position{line: 976, col: 32, offset: 23097},
+													pos:  position{line: 1146, col: 32, offset: 27743},
 													name: "NOT",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 976, col: 36, offset: 23101},
+													pos:  position{line: 1146, col: 36, offset: 27747},
 													name: "_",
 												},
 											},
@@ -6549,38 +7572,38 @@ var g = &grammar{
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 976, col: 40, offset: 23105},
+									pos:  position{line: 1146, col: 40, offset: 27751},
 									name: "BETWEEN",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 976, col: 48, offset: 23113},
+									pos:  position{line: 1146, col: 48, offset: 27759},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 976, col: 50, offset: 23115},
+									pos:   position{line: 1146, col: 50, offset: 27761},
 									label: "lower",
 									expr: &ruleRefExpr{
-										pos:  position{line: 976, col: 56, offset: 23121},
+										pos:  position{line: 1146, col: 56, offset: 27767},
 										name: "BetweenExpr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 976, col: 68, offset: 23133},
+									pos:  position{line: 1146, col: 68, offset: 27779},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 976, col: 70, offset: 23135},
+									pos:  position{line: 1146, col: 70, offset: 27781},
 									name: "AND",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 976, col: 74, offset: 23139},
+									pos:  position{line: 1146, col: 74, offset: 27785},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 976, col: 76, offset: 23141},
+									pos:   position{line: 1146, col: 76, offset: 27787},
 									label: "upper",
 									expr: &ruleRefExpr{
-										pos:  position{line: 976, col: 82, offset: 23147},
+										pos:  position{line: 1146, col: 82, offset: 27793},
 										name: "BetweenExpr",
 									},
 								},
@@ -6588,7 +7611,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 986, col: 5, offset: 23379},
+						pos:  position{line: 1156, col: 5, offset: 28025},
 						name: "ComparisonExpr",
 					},
 				},
@@ -6598,46 +7621,46 @@ var g = &grammar{
 		},
 		{
 			name: "ComparisonExpr",
-			pos:  position{line: 988, col: 1, offset: 23395},
+			pos:  position{line: 1158, col: 1, offset: 28041},
 			expr: &choiceExpr{
-				pos: position{line: 989, col: 5, offset: 23414},
+				pos: position{line: 1159, col: 5, offset: 28060},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 989, col: 5, offset: 23414},
+						pos: position{line: 1159, col: 5, offset: 28060},
 						run: (*parser).callonComparisonExpr2,
 						expr: &seqExpr{
-							pos: position{line: 989, col: 5, offset: 23414},
+							pos: position{line: 1159, col: 5, offset: 28060},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 989, col: 5, offset: 23414},
+									pos:   position{line: 1159, col: 5, offset: 28060},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 989, col: 10, offset: 23419},
+										pos:  position{line: 1159, col: 10, offset: 28065},
 										name: "AdditiveExpr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 989, col: 23, offset: 23432},
+									pos:  position{line: 1159, col: 23, offset: 28078},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 989, col: 25, offset: 23434},
+									pos:  position{line: 1159, col: 25, offset: 28080},
 									name: "IS",
 								},
 								&labeledExpr{
-									pos:   position{line: 989, col: 28, offset: 23437},
+									pos:   position{line: 1159, col: 28, offset: 28083},
 									label: "not",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 989, col: 32, offset: 23441},
+										pos: position{line: 1159, col: 32, offset: 28087},
 										expr: &seqExpr{
-											pos: position{line: 989, col: 33, offset: 23442},
+											pos: position{line: 1159, col: 33, offset: 28088},
 											exprs: []any{
 												&ruleRefExpr{
-													pos:  position{line: 989, col: 33, offset: 23442},
+													pos:  position{line: 1159, col: 33, offset: 28088},
 													name: "_",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 989, col: 35, offset: 23444},
+													pos:  position{line: 1159, col: 35, offset: 28090},
 													name: "NOT",
 												},
 											},
@@ -6645,82 +7668,82 @@ var g = &grammar{
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 989, col: 41, offset: 23450},
+									pos:  position{line: 1159, col: 41, offset: 28096},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 989, col: 43, offset: 23452},
+									pos:  position{line: 1159, col: 43, offset: 28098},
 									name: "NULL",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 997, col: 5, offset: 23620},
+						pos: position{line: 1167, col: 5, offset: 28266},
 						run: (*parser).callonComparisonExpr15,
 						expr: &seqExpr{
-							pos: position{line: 997, col: 5, offset: 23620},
+							pos: position{line: 1167, col: 5, offset: 28266},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 997, col: 5, offset: 23620},
+									pos:   position{line: 1167, col: 5, offset: 28266},
 									label: "lhs",
 									expr: &ruleRefExpr{
-										pos:  position{line: 997, col: 9, offset: 23624},
+										pos:  position{line: 1167, col: 9, offset: 28270},
 										name: "AdditiveExpr",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 997, col: 22, offset: 23637},
+									pos:   position{line: 1167, col: 22, offset: 28283},
 									label: "opAndRHS",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 997, col: 31, offset: 23646},
+										pos: position{line: 1167, col: 31, offset: 28292},
 										expr: &choiceExpr{
-											pos: position{line: 997, col: 32, offset: 23647},
+											pos: position{line: 1167, col: 32, offset: 28293},
 											alternatives: []any{
 												&seqExpr{
-													pos: position{line: 997, col: 32, offset: 23647},
+													pos: position{line: 1167, col: 32, offset: 28293},
 													exprs: []any{
 														&ruleRefExpr{
-															pos:  position{line: 997, col: 32, offset: 23647},
+															pos:  position{line: 1167, col: 32, offset: 28293},
 															name: "__",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 997, col: 35, offset: 23650},
+															pos:  position{line: 1167, col: 35, offset: 28296},
 															name: "Comparator",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 997, col: 46, offset: 23661},
+															pos:  position{line: 1167, col: 46, offset: 28307},
 															name: "__",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 997, col: 49, offset: 23664},
+															pos:  position{line: 1167, col: 49, offset: 28310},
 															name: "AdditiveExpr",
 														},
 													},
 												},
 												&seqExpr{
-													pos: position{line: 997, col: 64, offset: 23679},
+													pos: position{line: 1167, col: 64, offset: 28325},
 													exprs: []any{
 														&ruleRefExpr{
-															pos:  position{line: 997, col: 64, offset: 23679},
+															pos:  position{line: 1167, col: 64, offset: 28325},
 															name: "__",
 														},
 														&actionExpr{
-															pos: position{line: 997, col: 68, offset: 23683},
+															pos: position{line: 1167, col: 68, offset: 28329},
 															run: (*parser).callonComparisonExpr29,
 															expr: &litMatcher{
-																pos:        position{line: 997, col: 68, offset: 23683},
+																pos:        position{line: 1167, col: 68, offset: 28329},
 																val:        "~",
 																ignoreCase: false,
 																want:       "\"~\"",
 															},
 														},
 														&ruleRefExpr{
-															pos:  position{line: 997, col: 104, offset: 23719},
+															pos:  position{line: 1167, col: 104, offset: 28365},
 															name: "__",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 997, col: 107, offset: 23722},
+															pos:  position{line: 1167, col: 107, offset: 28368},
 															name: "Regexp",
 														},
 													},
@@ -6739,53 +7762,53 @@ var g = &grammar{
 		},
 		{
 			name: "AdditiveExpr",
-			pos:  position{line: 1010, col: 1, offset: 24008},
+			pos:  position{line: 1180, col: 1, offset: 28654},
 			expr: &actionExpr{
-				pos: position{line: 1011, col: 5, offset: 24025},
+				pos: position{line: 1181, col: 5, offset: 28671},
 				run: (*parser).callonAdditiveExpr1,
 				expr: &seqExpr{
-					pos: position{line: 1011, col: 5, offset: 24025},
+					pos: position{line: 1181, col: 5, offset: 28671},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1011, col: 5, offset: 24025},
+							pos:   position{line: 1181, col: 5, offset: 28671},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1011, col: 11, offset: 24031},
+								pos:  position{line: 1181, col: 11, offset: 28677},
 								name: "MultiplicativeExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1012, col: 5, offset: 24054},
+							pos:   position{line: 1182, col: 5, offset: 28700},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1012, col: 10, offset: 24059},
+								pos: position{line: 1182, col: 10, offset: 28705},
 								expr: &actionExpr{
-									pos: position{line: 1012, col: 11, offset: 24060},
+									pos: position{line: 1182, col: 11, offset: 28706},
 									run: (*parser).callonAdditiveExpr7,
 									expr: &seqExpr{
-										pos: position{line: 1012, col: 11, offset: 24060},
+										pos: position{line: 1182, col: 11, offset: 28706},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1012, col: 11, offset: 24060},
+												pos:  position{line: 1182, col: 11, offset: 28706},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 1012, col: 14, offset: 24063},
+												pos:   position{line: 1182, col: 14, offset: 28709},
 												label: "op",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1012, col: 17, offset: 24066},
+													pos:  position{line: 1182, col: 17, offset: 28712},
 													name: "AdditiveOperator",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1012, col: 34, offset: 24083},
+												pos:  position{line: 1182, col: 34, offset: 28729},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 1012, col: 37, offset: 24086},
+												pos:   position{line: 1182, col: 37, offset: 28732},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1012, col: 42, offset: 24091},
+													pos:  position{line: 1182, col: 42, offset: 28737},
 													name: "MultiplicativeExpr",
 												},
 											},
@@ -6802,21 +7825,21 @@ var g = &grammar{
 		},
 		{
 			name: "AdditiveOperator",
-			pos:  position{line: 1016, col: 1, offset: 24209},
+			pos:  position{line: 1186, col: 1, offset: 28855},
 			expr: &actionExpr{
-				pos: position{line: 1016, col: 20, offset: 24228},
+				pos: position{line: 1186, col: 20, offset: 28874},
 				run: (*parser).callonAdditiveOperator1,
 				expr: &choiceExpr{
-					pos: position{line: 1016, col: 21, offset: 24229},
+					pos: position{line: 1186, col: 21, offset: 28875},
 					alternatives: []any{
 						&litMatcher{
-							pos:        position{line: 1016, col: 21, offset: 24229},
+							pos:        position{line: 1186, col: 21, offset: 28875},
 							val:        "+",
 							ignoreCase: false,
 							want:       "\"+\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1016, col: 27, offset: 24235},
+							pos:        position{line: 1186, col: 27, offset: 28881},
 							val:        "-",
 							ignoreCase: false,
 							want:       "\"-\"",
@@ -6829,53 +7852,53 @@ var g = &grammar{
 		},
 		{
 			name: "MultiplicativeExpr",
-			pos:  position{line: 1018, col: 1, offset: 24272},
+			pos:  position{line: 1188, col: 1, offset: 28918},
 			expr: &actionExpr{
-				pos: position{line: 1019, col: 5, offset: 24295},
+				pos: position{line: 1189, col: 5, offset: 28941},
 				run: (*parser).callonMultiplicativeExpr1,
 				expr: &seqExpr{
-					pos: position{line: 1019, col: 5, offset: 24295},
+					pos: position{line: 1189, col: 5, offset: 28941},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1019, col: 5, offset: 24295},
+							pos:   position{line: 1189, col: 5, offset: 28941},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1019, col: 11, offset: 24301},
+								pos:  position{line: 1189, col: 11, offset: 28947},
 								name: "ConcatExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1020, col: 5, offset: 24316},
+							pos:   position{line: 1190, col: 5, offset: 28962},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1020, col: 10, offset: 24321},
+								pos: position{line: 1190, col: 10, offset: 28967},
 								expr: &actionExpr{
-									pos: position{line: 1020, col: 11, offset: 24322},
+									pos: position{line: 1190, col: 11, offset: 28968},
 									run: (*parser).callonMultiplicativeExpr7,
 									expr: &seqExpr{
-										pos: position{line: 1020, col: 11, offset: 24322},
+										pos: position{line: 1190, col: 11, offset: 28968},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1020, col: 11, offset: 24322},
+												pos:  position{line: 1190, col: 11, offset: 28968},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 1020, col: 14, offset: 24325},
+												pos:   position{line: 1190, col: 14, offset: 28971},
 												label: "op",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1020, col: 17, offset: 24328},
+													pos:  position{line: 1190, col: 17, offset: 28974},
 													name: "MultiplicativeOperator",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1020, col: 40, offset: 24351},
+												pos:  position{line: 1190, col: 40, offset: 28997},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 1020, col: 43, offset: 24354},
+												pos:   position{line: 1190, col: 43, offset: 29000},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1020, col: 48, offset: 24359},
+													pos:  position{line: 1190, col: 48, offset: 29005},
 													name: "ConcatExpr",
 												},
 											},
@@ -6892,27 +7915,27 @@ var g = &grammar{
 		},
 		{
 			name: "MultiplicativeOperator",
-			pos:  position{line: 1024, col: 1, offset: 24469},
+			pos:  position{line: 1194, col: 1, offset: 29115},
 			expr: &actionExpr{
-				pos: position{line: 1024, col: 26, offset: 24494},
+				pos: position{line: 1194, col: 26, offset: 29140},
 				run: (*parser).callonMultiplicativeOperator1,
 				expr: &choiceExpr{
-					pos: position{line: 1024, col: 27, offset: 24495},
+					pos: position{line: 1194, col: 27, offset: 29141},
 					alternatives: []any{
 						&litMatcher{
-							pos:        position{line: 1024, col: 27, offset: 24495},
+							pos:        position{line: 1194, col: 27, offset: 29141},
 							val:        "*",
 							ignoreCase: false,
 							want:       "\"*\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1024, col: 33, offset: 24501},
+							pos:        position{line: 1194, col: 33, offset: 29147},
 							val:        "/",
 							ignoreCase: false,
 							want:       "\"/\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1024, col: 39, offset: 24507},
+							pos:        position{line: 1194, col: 39, offset: 29153},
 							val:        "%",
 							ignoreCase: false,
 							want:       "\"%\"",
@@ -6925,51 +7948,51 @@ var g = &grammar{
 		},
 		{
 			name: "ConcatExpr",
-			pos:  position{line: 1026, col: 1, offset: 24544},
+			pos:  position{line: 1196, col: 1, offset: 29190},
 			expr: &actionExpr{
-				pos: position{line: 1027, col: 5, offset: 24560},
+				pos: position{line: 1197, col: 5, offset: 29206},
 				run: (*parser).callonConcatExpr1,
 				expr: &seqExpr{
-					pos: position{line: 1027, col: 5, offset: 24560},
+					pos: position{line: 1197, col: 5, offset: 29206},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1027, col: 5, offset: 24560},
+							pos:   position{line: 1197, col: 5, offset: 29206},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1027, col: 11, offset: 24566},
+								pos:  position{line: 1197, col: 11, offset: 29212},
 								name: "UnaryPlusOrMinus",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1028, col: 5, offset: 24587},
+							pos:   position{line: 1198, col: 5, offset: 29233},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1028, col: 10, offset: 24592},
+								pos: position{line: 1198, col: 10, offset: 29238},
 								expr: &actionExpr{
-									pos: position{line: 1028, col: 11, offset: 24593},
+									pos: position{line: 1198, col: 11, offset: 29239},
 									run: (*parser).callonConcatExpr7,
 									expr: &seqExpr{
-										pos: position{line: 1028, col: 11, offset: 24593},
+										pos: position{line: 1198, col: 11, offset: 29239},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1028, col: 11, offset: 24593},
+												pos:  position{line: 1198, col: 11, offset: 29239},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 1028, col: 14, offset: 24596},
+												pos:        position{line: 1198, col: 14, offset: 29242},
 												val:        "||",
 												ignoreCase: false,
 												want:       "\"||\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1028, col: 19, offset: 24601},
+												pos:  position{line: 1198, col: 19, offset: 29247},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 1028, col: 22, offset: 24604},
+												pos:   position{line: 1198, col: 22, offset: 29250},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1028, col: 27, offset: 24609},
+													pos:  position{line: 1198, col: 27, offset: 29255},
 													name: "UnaryPlusOrMinus",
 												},
 											},
@@ -6986,40 +8009,40 @@ var g = &grammar{
 		},
 		{
 			name: "UnaryPlusOrMinus",
-			pos:  position{line: 1032, col: 1, offset: 24727},
+			pos:  position{line: 1202, col: 1, offset: 29373},
 			expr: &choiceExpr{
-				pos: position{line: 1033, col: 5, offset: 24748},
+				pos: position{line: 1203, col: 5, offset: 29394},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1033, col: 5, offset: 24748},
+						pos: position{line: 1203, col: 5, offset: 29394},
 						run: (*parser).callonUnaryPlusOrMinus2,
 						expr: &seqExpr{
-							pos: position{line: 1033, col: 5, offset: 24748},
+							pos: position{line: 1203, col: 5, offset: 29394},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1033, col: 5, offset: 24748},
+									pos: position{line: 1203, col: 5, offset: 29394},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1033, col: 6, offset: 24749},
+										pos:  position{line: 1203, col: 6, offset: 29395},
 										name: "Literal",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1033, col: 14, offset: 24757},
+									pos:   position{line: 1203, col: 14, offset: 29403},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1033, col: 17, offset: 24760},
+										pos:  position{line: 1203, col: 17, offset: 29406},
 										name: "PlusOrMinusOp",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1033, col: 31, offset: 24774},
+									pos:  position{line: 1203, col: 31, offset: 29420},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1033, col: 34, offset: 24777},
+									pos:   position{line: 1203, col: 34, offset: 29423},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1033, col: 36, offset: 24779},
+										pos:  position{line: 1203, col: 36, offset: 29425},
 										name: "UnaryPlusOrMinus",
 									},
 								},
@@ -7027,7 +8050,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1042, col: 5, offset: 24963},
+						pos:  position{line: 1212, col: 5, offset: 29609},
 						name: "DerefExpr",
 					},
 				},
@@ -7037,21 +8060,21 @@ var g = &grammar{
 		},
 		{
 			name: "PlusOrMinusOp",
-			pos:  position{line: 1044, col: 1, offset: 24974},
+			pos:  position{line: 1214, col: 1, offset: 29620},
 			expr: &actionExpr{
-				pos: position{line: 1044, col: 17, offset: 24990},
+				pos: position{line: 1214, col: 17, offset: 29636},
 				run: (*parser).callonPlusOrMinusOp1,
 				expr: &choiceExpr{
-					pos: position{line: 1044, col: 18, offset: 24991},
+					pos: position{line: 1214, col: 18, offset: 29637},
 					alternatives: []any{
 						&litMatcher{
-							pos:        position{line: 1044, col: 18, offset: 24991},
+							pos:        position{line: 1214, col: 18, offset: 29637},
 							val:        "+",
 							ignoreCase: false,
 							want:       "\"+\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1044, col: 24, offset: 24997},
+							pos:        position{line: 1214, col: 24, offset: 29643},
 							val:        "-",
 							ignoreCase: false,
 							want:       "\"-\"",
@@ -7064,73 +8087,73 @@ var g = &grammar{
 		},
 		{
 			name: "DerefExpr",
-			pos:  position{line: 1046, col: 1, offset: 25034},
+			pos:  position{line: 1216, col: 1, offset: 29680},
 			expr: &choiceExpr{
-				pos: position{line: 1047, col: 5, offset: 25048},
+				pos: position{line: 1217, col: 5, offset: 29694},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1047, col: 5, offset: 25048},
+						pos: position{line: 1217, col: 5, offset: 29694},
 						run: (*parser).callonDerefExpr2,
 						expr: &seqExpr{
-							pos: position{line: 1047, col: 5, offset: 25048},
+							pos: position{line: 1217, col: 5, offset: 29694},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1047, col: 5, offset: 25048},
+									pos:   position{line: 1217, col: 5, offset: 29694},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1047, col: 10, offset: 25053},
+										pos:  position{line: 1217, col: 10, offset: 29699},
 										name: "DerefExpr",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1047, col: 20, offset: 25063},
+									pos:        position{line: 1217, col: 20, offset: 29709},
 									val:        "[",
 									ignoreCase: false,
 									want:       "\"[\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1047, col: 24, offset: 25067},
+									pos:  position{line: 1217, col: 24, offset: 29713},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1047, col: 27, offset: 25070},
+									pos:   position{line: 1217, col: 27, offset: 29716},
 									label: "from",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1047, col: 32, offset: 25075},
+										pos:  position{line: 1217, col: 32, offset: 29721},
 										name: "AdditiveExpr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1047, col: 45, offset: 25088},
+									pos:  position{line: 1217, col: 45, offset: 29734},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1047, col: 48, offset: 25091},
+									pos:        position{line: 1217, col: 48, offset: 29737},
 									val:        ":",
 									ignoreCase: false,
 									want:       "\":\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1047, col: 52, offset: 25095},
+									pos:  position{line: 1217, col: 52, offset: 29741},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1047, col: 55, offset: 25098},
+									pos:   position{line: 1217, col: 55, offset: 29744},
 									label: "to",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1047, col: 58, offset: 25101},
+										pos: position{line: 1217, col: 58, offset: 29747},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1047, col: 58, offset: 25101},
+											pos:  position{line: 1217, col: 58, offset: 29747},
 											name: "AdditiveExpr",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1047, col: 72, offset: 25115},
+									pos:  position{line: 1217, col: 72, offset: 29761},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1047, col: 75, offset: 25118},
+									pos:        position{line: 1217, col: 75, offset: 29764},
 									val:        "]",
 									ignoreCase: false,
 									want:       "\"]\"",
@@ -7139,49 +8162,49 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1059, col: 5, offset: 25357},
+						pos: position{line: 1229, col: 5, offset: 30003},
 						run: (*parser).callonDerefExpr18,
 						expr: &seqExpr{
-							pos: position{line: 1059, col: 5, offset: 25357},
+							pos: position{line: 1229, col: 5, offset: 30003},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1059, col: 5, offset: 25357},
+									pos:   position{line: 1229, col: 5, offset: 30003},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1059, col: 10, offset: 25362},
+										pos:  position{line: 1229, col: 10, offset: 30008},
 										name: "DerefExpr",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1059, col: 20, offset: 25372},
+									pos:        position{line: 1229, col: 20, offset: 30018},
 									val:        "[",
 									ignoreCase: false,
 									want:       "\"[\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1059, col: 24, offset: 25376},
+									pos:  position{line: 1229, col: 24, offset: 30022},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1059, col: 27, offset: 25379},
+									pos:        position{line: 1229, col: 27, offset: 30025},
 									val:        ":",
 									ignoreCase: false,
 									want:       "\":\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1059, col: 31, offset: 25383},
+									pos:  position{line: 1229, col: 31, offset: 30029},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1059, col: 34, offset: 25386},
+									pos:   position{line: 1229, col: 34, offset: 30032},
 									label: "to",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1059, col: 37, offset: 25389},
+										pos:  position{line: 1229, col: 37, offset: 30035},
 										name: "AdditiveExpr",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1059, col: 50, offset: 25402},
+									pos:        position{line: 1229, col: 50, offset: 30048},
 									val:        "]",
 									ignoreCase: false,
 									want:       "\"]\"",
@@ -7190,35 +8213,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1067, col: 5, offset: 25566},
+						pos: position{line: 1237, col: 5, offset: 30212},
 						run: (*parser).callonDerefExpr29,
 						expr: &seqExpr{
-							pos: position{line: 1067, col: 5, offset: 25566},
+							pos: position{line: 1237, col: 5, offset: 30212},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1067, col: 5, offset: 25566},
+									pos:   position{line: 1237, col: 5, offset: 30212},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1067, col: 10, offset: 25571},
+										pos:  position{line: 1237, col: 10, offset: 30217},
 										name: "DerefExpr",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1067, col: 20, offset: 25581},
+									pos:        position{line: 1237, col: 20, offset: 30227},
 									val:        "[",
 									ignoreCase: false,
 									want:       "\"[\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1067, col: 24, offset: 25585},
+									pos:   position{line: 1237, col: 24, offset: 30231},
 									label: "index",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1067, col: 30, offset: 25591},
+										pos:  position{line: 1237, col: 30, offset: 30237},
 										name: "Expr",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1067, col: 35, offset: 25596},
+									pos:        position{line: 1237, col: 35, offset: 30242},
 									val:        "]",
 									ignoreCase: false,
 									want:       "\"]\"",
@@ -7227,30 +8250,30 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1075, col: 5, offset: 25766},
+						pos: position{line: 1245, col: 5, offset: 30412},
 						run: (*parser).callonDerefExpr37,
 						expr: &seqExpr{
-							pos: position{line: 1075, col: 5, offset: 25766},
+							pos: position{line: 1245, col: 5, offset: 30412},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1075, col: 5, offset: 25766},
+									pos:   position{line: 1245, col: 5, offset: 30412},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1075, col: 10, offset: 25771},
+										pos:  position{line: 1245, col: 10, offset: 30417},
 										name: "DerefExpr",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1075, col: 20, offset: 25781},
+									pos:        position{line: 1245, col: 20, offset: 30427},
 									val:        ".",
 									ignoreCase: false,
 									want:       "\".\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1075, col: 24, offset: 25785},
+									pos:   position{line: 1245, col: 24, offset: 30431},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1075, col: 27, offset: 25788},
+										pos:  position{line: 1245, col: 27, offset: 30434},
 										name: "DerefKey",
 									},
 								},
@@ -7258,11 +8281,11 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1084, col: 5, offset: 25976},
+						pos:  position{line: 1254, col: 5, offset: 30622},
 						name: "FuncExpr",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1085, col: 5, offset: 25989},
+						pos:  position{line: 1255, col: 5, offset: 30635},
 						name: "Primary",
 					},
 				},
@@ -7272,34 +8295,34 @@ var g = &grammar{
 		},
 		{
 			name: "DerefKey",
-			pos:  position{line: 1087, col: 1, offset: 25998},
+			pos:  position{line: 1257, col: 1, offset: 30644},
 			expr: &choiceExpr{
-				pos: position{line: 1088, col: 5, offset: 26011},
+				pos: position{line: 1258, col: 5, offset: 30657},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1088, col: 5, offset: 26011},
+						pos:  position{line: 1258, col: 5, offset: 30657},
 						name: "Identifier",
 					},
 					&actionExpr{
-						pos: position{line: 1089, col: 5, offset: 26027},
+						pos: position{line: 1259, col: 5, offset: 30673},
 						run: (*parser).callonDerefKey3,
 						expr: &labeledExpr{
-							pos:   position{line: 1089, col: 5, offset: 26027},
+							pos:   position{line: 1259, col: 5, offset: 30673},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1089, col: 7, offset: 26029},
+								pos:  position{line: 1259, col: 7, offset: 30675},
 								name: "DoubleQuotedString",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1090, col: 5, offset: 26121},
+						pos: position{line: 1260, col: 5, offset: 30767},
 						run: (*parser).callonDerefKey6,
 						expr: &labeledExpr{
-							pos:   position{line: 1090, col: 5, offset: 26121},
+							pos:   position{line: 1260, col: 5, offset: 30767},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1090, col: 7, offset: 26123},
+								pos:  position{line: 1260, col: 7, offset: 30769},
 								name: "BacktickString",
 							},
 						},
@@ -7311,16 +8334,16 @@ var g = &grammar{
 		},
 		{
 			name: "FuncExpr",
-			pos:  position{line: 1092, col: 1, offset: 26212},
+			pos:  position{line: 1262, col: 1, offset: 30858},
 			expr: &choiceExpr{
-				pos: position{line: 1093, col: 5, offset: 26225},
+				pos: position{line: 1263, col: 5, offset: 30871},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1093, col: 5, offset: 26225},
+						pos:  position{line: 1263, col: 5, offset: 30871},
 						name: "Cast",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1094, col: 5, offset: 26234},
+						pos:  position{line: 1264, col: 5, offset: 30880},
 						name: "Function",
 					},
 				},
@@ -7330,20 +8353,20 @@ var g = &grammar{
 		},
 		{
 			name: "FuncGuard",
-			pos:  position{line: 1096, col: 1, offset: 26244},
+			pos:  position{line: 1266, col: 1, offset: 30890},
 			expr: &seqExpr{
-				pos: position{line: 1096, col: 13, offset: 26256},
+				pos: position{line: 1266, col: 13, offset: 30902},
 				exprs: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1096, col: 13, offset: 26256},
+						pos:  position{line: 1266, col: 13, offset: 30902},
 						name: "NotFuncs",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1096, col: 22, offset: 26265},
+						pos:  position{line: 1266, col: 22, offset: 30911},
 						name: "__",
 					},
 					&litMatcher{
-						pos:        position{line: 1096, col: 25, offset: 26268},
+						pos:        position{line: 1266, col: 25, offset: 30914},
 						val:        "(",
 						ignoreCase: false,
 						want:       "\"(\"",
@@ -7355,16 +8378,16 @@ var g = &grammar{
 		},
 		{
 			name: "NotFuncs",
-			pos:  position{line: 1098, col: 1, offset: 26273},
+			pos:  position{line: 1268, col: 1, offset: 30919},
 			expr: &choiceExpr{
-				pos: position{line: 1099, col: 5, offset: 26286},
+				pos: position{line: 1269, col: 5, offset: 30932},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1099, col: 5, offset: 26286},
+						pos:  position{line: 1269, col: 5, offset: 30932},
 						name: "NOT",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1100, col: 5, offset: 26294},
+						pos:  position{line: 1270, col: 5, offset: 30940},
 						name: "SELECT",
 					},
 				},
@@ -7374,58 +8397,58 @@ var g = &grammar{
 		},
 		{
 			name: "Cast",
-			pos:  position{line: 1102, col: 1, offset: 26302},
+			pos:  position{line: 1272, col: 1, offset: 30948},
 			expr: &actionExpr{
-				pos: position{line: 1103, col: 5, offset: 26311},
+				pos: position{line: 1273, col: 5, offset: 30957},
 				run: (*parser).callonCast1,
 				expr: &seqExpr{
-					pos: position{line: 1103, col: 5, offset: 26311},
+					pos: position{line: 1273, col: 5, offset: 30957},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1103, col: 5, offset: 26311},
+							pos:   position{line: 1273, col: 5, offset: 30957},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1103, col: 9, offset: 26315},
+								pos:  position{line: 1273, col: 9, offset: 30961},
 								name: "TypeLiteral",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1103, col: 21, offset: 26327},
+							pos:  position{line: 1273, col: 21, offset: 30973},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1103, col: 24, offset: 26330},
+							pos:        position{line: 1273, col: 24, offset: 30976},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1103, col: 28, offset: 26334},
+							pos:  position{line: 1273, col: 28, offset: 30980},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1103, col: 31, offset: 26337},
+							pos:   position{line: 1273, col: 31, offset: 30983},
 							label: "expr",
 							expr: &choiceExpr{
-								pos: position{line: 1103, col: 37, offset: 26343},
+								pos: position{line: 1273, col: 37, offset: 30989},
 								alternatives: []any{
 									&ruleRefExpr{
-										pos:  position{line: 1103, col: 37, offset: 26343},
+										pos:  position{line: 1273, col: 37, offset: 30989},
 										name: "OverExpr",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1103, col: 48, offset: 26354},
+										pos:  position{line: 1273, col: 48, offset: 31000},
 										name: "Expr",
 									},
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1103, col: 54, offset: 26360},
+							pos:  position{line: 1273, col: 54, offset: 31006},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1103, col: 57, offset: 26363},
+							pos:        position{line: 1273, col: 57, offset: 31009},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -7438,85 +8461,85 @@ var g = &grammar{
 		},
 		{
 			name: "Function",
-			pos:  position{line: 1107, col: 1, offset: 26476},
+			pos:  position{line: 1277, col: 1, offset: 31122},
 			expr: &choiceExpr{
-				pos: position{line: 1108, col: 5, offset: 26489},
+				pos: position{line: 1278, col: 5, offset: 31135},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1108, col: 5, offset: 26489},
+						pos:  position{line: 1278, col: 5, offset: 31135},
 						name: "Grep",
 					},
 					&actionExpr{
-						pos: position{line: 1110, col: 5, offset: 26576},
+						pos: position{line: 1280, col: 5, offset: 31222},
 						run: (*parser).callonFunction3,
 						expr: &seqExpr{
-							pos: position{line: 1110, col: 5, offset: 26576},
+							pos: position{line: 1280, col: 5, offset: 31222},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 1110, col: 5, offset: 26576},
+									pos:  position{line: 1280, col: 5, offset: 31222},
 									name: "REGEXP",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1110, col: 12, offset: 26583},
+									pos:  position{line: 1280, col: 12, offset: 31229},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1110, col: 15, offset: 26586},
+									pos:        position{line: 1280, col: 15, offset: 31232},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1110, col: 19, offset: 26590},
+									pos:  position{line: 1280, col: 19, offset: 31236},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1110, col: 22, offset: 26593},
+									pos:   position{line: 1280, col: 22, offset: 31239},
 									label: "arg0",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1110, col: 27, offset: 26598},
+										pos:  position{line: 1280, col: 27, offset: 31244},
 										name: "RegexpPrimitive",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1110, col: 43, offset: 26614},
+									pos:  position{line: 1280, col: 43, offset: 31260},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1110, col: 46, offset: 26617},
+									pos:        position{line: 1280, col: 46, offset: 31263},
 									val:        ",",
 									ignoreCase: false,
 									want:       "\",\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1110, col: 50, offset: 26621},
+									pos:  position{line: 1280, col: 50, offset: 31267},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1110, col: 53, offset: 26624},
+									pos:   position{line: 1280, col: 53, offset: 31270},
 									label: "arg1",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1110, col: 58, offset: 26629},
+										pos:  position{line: 1280, col: 58, offset: 31275},
 										name: "Expr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1110, col: 63, offset: 26634},
+									pos:  position{line: 1280, col: 63, offset: 31280},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1110, col: 66, offset: 26637},
+									pos:        position{line: 1280, col: 66, offset: 31283},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1110, col: 70, offset: 26641},
+									pos:   position{line: 1280, col: 70, offset: 31287},
 									label: "where",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1110, col: 76, offset: 26647},
+										pos: position{line: 1280, col: 76, offset: 31293},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1110, col: 76, offset: 26647},
+											pos:  position{line: 1280, col: 76, offset: 31293},
 											name: "WhereClause",
 										},
 									},
@@ -7525,98 +8548,98 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1114, col: 5, offset: 26826},
+						pos: position{line: 1284, col: 5, offset: 31472},
 						run: (*parser).callonFunction21,
 						expr: &seqExpr{
-							pos: position{line: 1114, col: 5, offset: 26826},
+							pos: position{line: 1284, col: 5, offset: 31472},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 1114, col: 5, offset: 26826},
+									pos:  position{line: 1284, col: 5, offset: 31472},
 									name: "REGEXP_REPLACE",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1114, col: 20, offset: 26841},
+									pos:  position{line: 1284, col: 20, offset: 31487},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1114, col: 23, offset: 26844},
+									pos:        position{line: 1284, col: 23, offset: 31490},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1114, col: 27, offset: 26848},
+									pos:  position{line: 1284, col: 27, offset: 31494},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1114, col: 30, offset: 26851},
+									pos:   position{line: 1284, col: 30, offset: 31497},
 									label: "arg0",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1114, col: 35, offset: 26856},
+										pos:  position{line: 1284, col: 35, offset: 31502},
 										name: "Expr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1114, col: 40, offset: 26861},
+									pos:  position{line: 1284, col: 40, offset: 31507},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1114, col: 43, offset: 26864},
+									pos:        position{line: 1284, col: 43, offset: 31510},
 									val:        ",",
 									ignoreCase: false,
 									want:       "\",\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1114, col: 47, offset: 26868},
+									pos:  position{line: 1284, col: 47, offset: 31514},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1114, col: 50, offset: 26871},
+									pos:   position{line: 1284, col: 50, offset: 31517},
 									label: "arg1",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1114, col: 55, offset: 26876},
+										pos:  position{line: 1284, col: 55, offset: 31522},
 										name: "RegexpPrimitive",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1114, col: 71, offset: 26892},
+									pos:  position{line: 1284, col: 71, offset: 31538},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1114, col: 74, offset: 26895},
+									pos:        position{line: 1284, col: 74, offset: 31541},
 									val:        ",",
 									ignoreCase: false,
 									want:       "\",\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1114, col: 78, offset: 26899},
+									pos:  position{line: 1284, col: 78, offset: 31545},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1114, col: 81, offset: 26902},
+									pos:   position{line: 1284, col: 81, offset: 31548},
 									label: "arg2",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1114, col: 86, offset: 26907},
+										pos:  position{line: 1284, col: 86, offset: 31553},
 										name: "Expr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1114, col: 91, offset: 26912},
+									pos:  position{line: 1284, col: 91, offset: 31558},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1114, col: 94, offset: 26915},
+									pos:        position{line: 1284, col: 94, offset: 31561},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1114, col: 98, offset: 26919},
+									pos:   position{line: 1284, col: 98, offset: 31565},
 									label: "where",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1114, col: 104, offset: 26925},
+										pos: position{line: 1284, col: 104, offset: 31571},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1114, col: 104, offset: 26925},
+											pos:  position{line: 1284, col: 104, offset: 31571},
 											name: "WhereClause",
 										},
 									},
@@ -7625,81 +8648,81 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1118, col: 5, offset: 27119},
+						pos: position{line: 1288, col: 5, offset: 31765},
 						run: (*parser).callonFunction44,
 						expr: &seqExpr{
-							pos: position{line: 1118, col: 5, offset: 27119},
+							pos: position{line: 1288, col: 5, offset: 31765},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1118, col: 5, offset: 27119},
+									pos: position{line: 1288, col: 5, offset: 31765},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1118, col: 6, offset: 27120},
+										pos:  position{line: 1288, col: 6, offset: 31766},
 										name: "FuncGuard",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1118, col: 16, offset: 27130},
+									pos:  position{line: 1288, col: 16, offset: 31776},
 									name: "EXTRACT",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1118, col: 24, offset: 27138},
+									pos:  position{line: 1288, col: 24, offset: 31784},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1118, col: 27, offset: 27141},
+									pos:        position{line: 1288, col: 27, offset: 31787},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1118, col: 31, offset: 27145},
+									pos:  position{line: 1288, col: 31, offset: 31791},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1118, col: 34, offset: 27148},
+									pos:   position{line: 1288, col: 34, offset: 31794},
 									label: "part",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1118, col: 39, offset: 27153},
+										pos:  position{line: 1288, col: 39, offset: 31799},
 										name: "Expr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1118, col: 44, offset: 27158},
+									pos:  position{line: 1288, col: 44, offset: 31804},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1118, col: 46, offset: 27160},
+									pos:  position{line: 1288, col: 46, offset: 31806},
 									name: "FROM",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1118, col: 51, offset: 27165},
+									pos:  position{line: 1288, col: 51, offset: 31811},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 1118, col: 53, offset: 27167},
+									pos:   position{line: 1288, col: 53, offset: 31813},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1118, col: 55, offset: 27169},
+										pos:  position{line: 1288, col: 55, offset: 31815},
 										name: "Expr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1118, col: 60, offset: 27174},
+									pos:  position{line: 1288, col: 60, offset: 31820},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1118, col: 63, offset: 27177},
+									pos:        position{line: 1288, col: 63, offset: 31823},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1118, col: 67, offset: 27181},
+									pos:   position{line: 1288, col: 67, offset: 31827},
 									label: "where",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1118, col: 73, offset: 27187},
+										pos: position{line: 1288, col: 73, offset: 31833},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1118, col: 73, offset: 27187},
+											pos:  position{line: 1288, col: 73, offset: 31833},
 											name: "WhereClause",
 										},
 									},
@@ -7708,70 +8731,70 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1126, col: 5, offset: 27366},
+						pos: position{line: 1296, col: 5, offset: 32012},
 						run: (*parser).callonFunction64,
 						expr: &seqExpr{
-							pos: position{line: 1126, col: 5, offset: 27366},
+							pos: position{line: 1296, col: 5, offset: 32012},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1126, col: 5, offset: 27366},
+									pos: position{line: 1296, col: 5, offset: 32012},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1126, col: 6, offset: 27367},
+										pos:  position{line: 1296, col: 6, offset: 32013},
 										name: "FuncGuard",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1126, col: 16, offset: 27377},
+									pos:  position{line: 1296, col: 16, offset: 32023},
 									name: "CAST",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1126, col: 21, offset: 27382},
+									pos:  position{line: 1296, col: 21, offset: 32028},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1126, col: 24, offset: 27385},
+									pos:        position{line: 1296, col: 24, offset: 32031},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1126, col: 28, offset: 27389},
+									pos:  position{line: 1296, col: 28, offset: 32035},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1126, col: 31, offset: 27392},
+									pos:   position{line: 1296, col: 31, offset: 32038},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1126, col: 33, offset: 27394},
+										pos:  position{line: 1296, col: 33, offset: 32040},
 										name: "Expr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1126, col: 38, offset: 27399},
+									pos:  position{line: 1296, col: 38, offset: 32045},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1126, col: 40, offset: 27401},
+									pos:  position{line: 1296, col: 40, offset: 32047},
 									name: "AS",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1126, col: 43, offset: 27404},
+									pos:  position{line: 1296, col: 43, offset: 32050},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 1126, col: 45, offset: 27406},
+									pos:   position{line: 1296, col: 45, offset: 32052},
 									label: "typ",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1126, col: 49, offset: 27410},
+										pos:  position{line: 1296, col: 49, offset: 32056},
 										name: "Identifier",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1126, col: 60, offset: 27421},
+									pos:  position{line: 1296, col: 60, offset: 32067},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1126, col: 63, offset: 27424},
+									pos:        position{line: 1296, col: 63, offset: 32070},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -7780,72 +8803,72 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1134, col: 5, offset: 27583},
+						pos: position{line: 1304, col: 5, offset: 32229},
 						run: (*parser).callonFunction81,
 						expr: &seqExpr{
-							pos: position{line: 1134, col: 5, offset: 27583},
+							pos: position{line: 1304, col: 5, offset: 32229},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1134, col: 5, offset: 27583},
+									pos: position{line: 1304, col: 5, offset: 32229},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1134, col: 6, offset: 27584},
+										pos:  position{line: 1304, col: 6, offset: 32230},
 										name: "FuncGuard",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1134, col: 16, offset: 27594},
+									pos:  position{line: 1304, col: 16, offset: 32240},
 									name: "SUBSTRING",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1134, col: 26, offset: 27604},
+									pos:  position{line: 1304, col: 26, offset: 32250},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1134, col: 29, offset: 27607},
+									pos:        position{line: 1304, col: 29, offset: 32253},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1134, col: 33, offset: 27611},
+									pos:  position{line: 1304, col: 33, offset: 32257},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1134, col: 36, offset: 27614},
+									pos:   position{line: 1304, col: 36, offset: 32260},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1134, col: 41, offset: 27619},
+										pos:  position{line: 1304, col: 41, offset: 32265},
 										name: "Expr",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1134, col: 46, offset: 27624},
+									pos:   position{line: 1304, col: 46, offset: 32270},
 									label: "from",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1134, col: 51, offset: 27629},
+										pos: position{line: 1304, col: 51, offset: 32275},
 										expr: &actionExpr{
-											pos: position{line: 1134, col: 52, offset: 27630},
+											pos: position{line: 1304, col: 52, offset: 32276},
 											run: (*parser).callonFunction93,
 											expr: &seqExpr{
-												pos: position{line: 1134, col: 52, offset: 27630},
+												pos: position{line: 1304, col: 52, offset: 32276},
 												exprs: []any{
 													&ruleRefExpr{
-														pos:  position{line: 1134, col: 52, offset: 27630},
+														pos:  position{line: 1304, col: 52, offset: 32276},
 														name: "_",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 1134, col: 54, offset: 27632},
+														pos:  position{line: 1304, col: 54, offset: 32278},
 														name: "FROM",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 1134, col: 59, offset: 27637},
+														pos:  position{line: 1304, col: 59, offset: 32283},
 														name: "_",
 													},
 													&labeledExpr{
-														pos:   position{line: 1134, col: 61, offset: 27639},
+														pos:   position{line: 1304, col: 61, offset: 32285},
 														label: "e",
 														expr: &ruleRefExpr{
-															pos:  position{line: 1134, col: 63, offset: 27641},
+															pos:  position{line: 1304, col: 63, offset: 32287},
 															name: "Expr",
 														},
 													},
@@ -7855,33 +8878,33 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1134, col: 88, offset: 27666},
+									pos:   position{line: 1304, col: 88, offset: 32312},
 									label: "for_",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1134, col: 93, offset: 27671},
+										pos: position{line: 1304, col: 93, offset: 32317},
 										expr: &actionExpr{
-											pos: position{line: 1134, col: 94, offset: 27672},
+											pos: position{line: 1304, col: 94, offset: 32318},
 											run: (*parser).callonFunction102,
 											expr: &seqExpr{
-												pos: position{line: 1134, col: 94, offset: 27672},
+												pos: position{line: 1304, col: 94, offset: 32318},
 												exprs: []any{
 													&ruleRefExpr{
-														pos:  position{line: 1134, col: 94, offset: 27672},
+														pos:  position{line: 1304, col: 94, offset: 32318},
 														name: "_",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 1134, col: 96, offset: 27674},
+														pos:  position{line: 1304, col: 96, offset: 32320},
 														name: "FOR",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 1134, col: 100, offset: 27678},
+														pos:  position{line: 1304, col: 100, offset: 32324},
 														name: "_",
 													},
 													&labeledExpr{
-														pos:   position{line: 1134, col: 102, offset: 27680},
+														pos:   position{line: 1304, col: 102, offset: 32326},
 														label: "e",
 														expr: &ruleRefExpr{
-															pos:  position{line: 1134, col: 104, offset: 27682},
+															pos:  position{line: 1304, col: 104, offset: 32328},
 															name: "Expr",
 														},
 													},
@@ -7891,7 +8914,7 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1134, col: 129, offset: 27707},
+									pos:        position{line: 1304, col: 129, offset: 32353},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -7900,65 +8923,65 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1148, col: 5, offset: 27990},
+						pos: position{line: 1318, col: 5, offset: 32636},
 						run: (*parser).callonFunction110,
 						expr: &seqExpr{
-							pos: position{line: 1148, col: 5, offset: 27990},
+							pos: position{line: 1318, col: 5, offset: 32636},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1148, col: 5, offset: 27990},
+									pos: position{line: 1318, col: 5, offset: 32636},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1148, col: 6, offset: 27991},
+										pos:  position{line: 1318, col: 6, offset: 32637},
 										name: "FuncGuard",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1148, col: 16, offset: 28001},
+									pos:   position{line: 1318, col: 16, offset: 32647},
 									label: "fn",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1148, col: 19, offset: 28004},
+										pos:  position{line: 1318, col: 19, offset: 32650},
 										name: "Identifier",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1148, col: 30, offset: 28015},
+									pos:  position{line: 1318, col: 30, offset: 32661},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1148, col: 33, offset: 28018},
+									pos:        position{line: 1318, col: 33, offset: 32664},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1148, col: 37, offset: 28022},
+									pos:  position{line: 1318, col: 37, offset: 32668},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1148, col: 40, offset: 28025},
+									pos:   position{line: 1318, col: 40, offset: 32671},
 									label: "args",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1148, col: 45, offset: 28030},
+										pos:  position{line: 1318, col: 45, offset: 32676},
 										name: "FunctionArgs",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1148, col: 58, offset: 28043},
+									pos:  position{line: 1318, col: 58, offset: 32689},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1148, col: 61, offset: 28046},
+									pos:        position{line: 1318, col: 61, offset: 32692},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1148, col: 65, offset: 28050},
+									pos:   position{line: 1318, col: 65, offset: 32696},
 									label: "where",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1148, col: 71, offset: 28056},
+										pos: position{line: 1318, col: 71, offset: 32702},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1148, col: 71, offset: 28056},
+											pos:  position{line: 1318, col: 71, offset: 32702},
 											name: "WhereClause",
 										},
 									},
@@ -7967,7 +8990,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1151, col: 5, offset: 28127},
+						pos:  position{line: 1321, col: 5, offset: 32773},
 						name: "CountStar",
 					},
 				},
@@ -7977,15 +9000,15 @@ var g = &grammar{
 		},
 		{
 			name: "RegexpPrimitive",
-			pos:  position{line: 1153, col: 1, offset: 28138},
+			pos:  position{line: 1323, col: 1, offset: 32784},
 			expr: &actionExpr{
-				pos: position{line: 1154, col: 5, offset: 28158},
+				pos: position{line: 1324, col: 5, offset: 32804},
 				run: (*parser).callonRegexpPrimitive1,
 				expr: &labeledExpr{
-					pos:   position{line: 1154, col: 5, offset: 28158},
+					pos:   position{line: 1324, col: 5, offset: 32804},
 					label: "pat",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1154, col: 9, offset: 28162},
+						pos:  position{line: 1324, col: 9, offset: 32808},
 						name: "RegexpPattern",
 					},
 				},
@@ -7995,24 +9018,24 @@ var g = &grammar{
 		},
 		{
 			name: "FunctionArgs",
-			pos:  position{line: 1156, col: 1, offset: 28233},
+			pos:  position{line: 1326, col: 1, offset: 32879},
 			expr: &choiceExpr{
-				pos: position{line: 1157, col: 5, offset: 28250},
+				pos: position{line: 1327, col: 5, offset: 32896},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1157, col: 5, offset: 28250},
+						pos: position{line: 1327, col: 5, offset: 32896},
 						run: (*parser).callonFunctionArgs2,
 						expr: &labeledExpr{
-							pos:   position{line: 1157, col: 5, offset: 28250},
+							pos:   position{line: 1327, col: 5, offset: 32896},
 							label: "o",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1157, col: 7, offset: 28252},
+								pos:  position{line: 1327, col: 7, offset: 32898},
 								name: "OverExpr",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1158, col: 5, offset: 28290},
+						pos:  position{line: 1328, col: 5, offset: 32936},
 						name: "OptionalExprs",
 					},
 				},
@@ -8022,96 +9045,96 @@ var g = &grammar{
 		},
 		{
 			name: "Grep",
-			pos:  position{line: 1160, col: 1, offset: 28305},
+			pos:  position{line: 1330, col: 1, offset: 32951},
 			expr: &actionExpr{
-				pos: position{line: 1161, col: 5, offset: 28314},
+				pos: position{line: 1331, col: 5, offset: 32960},
 				run: (*parser).callonGrep1,
 				expr: &seqExpr{
-					pos: position{line: 1161, col: 5, offset: 28314},
+					pos: position{line: 1331, col: 5, offset: 32960},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 1161, col: 5, offset: 28314},
+							pos:  position{line: 1331, col: 5, offset: 32960},
 							name: "GREP",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1161, col: 10, offset: 28319},
+							pos:  position{line: 1331, col: 10, offset: 32965},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1161, col: 13, offset: 28322},
+							pos:        position{line: 1331, col: 13, offset: 32968},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1161, col: 17, offset: 28326},
+							pos:  position{line: 1331, col: 17, offset: 32972},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1161, col: 20, offset: 28329},
+							pos:   position{line: 1331, col: 20, offset: 32975},
 							label: "pattern",
 							expr: &choiceExpr{
-								pos: position{line: 1161, col: 29, offset: 28338},
+								pos: position{line: 1331, col: 29, offset: 32984},
 								alternatives: []any{
 									&ruleRefExpr{
-										pos:  position{line: 1161, col: 29, offset: 28338},
+										pos:  position{line: 1331, col: 29, offset: 32984},
 										name: "Regexp",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1161, col: 38, offset: 28347},
+										pos:  position{line: 1331, col: 38, offset: 32993},
 										name: "Glob",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1161, col: 45, offset: 28354},
+										pos:  position{line: 1331, col: 45, offset: 33000},
 										name: "Expr",
 									},
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1161, col: 51, offset: 28360},
+							pos:  position{line: 1331, col: 51, offset: 33006},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1161, col: 54, offset: 28363},
+							pos:   position{line: 1331, col: 54, offset: 33009},
 							label: "opt",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1161, col: 58, offset: 28367},
+								pos: position{line: 1331, col: 58, offset: 33013},
 								expr: &actionExpr{
-									pos: position{line: 1161, col: 59, offset: 28368},
+									pos: position{line: 1331, col: 59, offset: 33014},
 									run: (*parser).callonGrep15,
 									expr: &seqExpr{
-										pos: position{line: 1161, col: 59, offset: 28368},
+										pos: position{line: 1331, col: 59, offset: 33014},
 										exprs: []any{
 											&litMatcher{
-												pos:        position{line: 1161, col: 59, offset: 28368},
+												pos:        position{line: 1331, col: 59, offset: 33014},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1161, col: 63, offset: 28372},
+												pos:  position{line: 1331, col: 63, offset: 33018},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 1161, col: 66, offset: 28375},
+												pos:   position{line: 1331, col: 66, offset: 33021},
 												label: "e",
 												expr: &choiceExpr{
-													pos: position{line: 1161, col: 69, offset: 28378},
+													pos: position{line: 1331, col: 69, offset: 33024},
 													alternatives: []any{
 														&ruleRefExpr{
-															pos:  position{line: 1161, col: 69, offset: 28378},
+															pos:  position{line: 1331, col: 69, offset: 33024},
 															name: "OverExpr",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 1161, col: 80, offset: 28389},
+															pos:  position{line: 1331, col: 80, offset: 33035},
 															name: "Expr",
 														},
 													},
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1161, col: 86, offset: 28395},
+												pos:  position{line: 1331, col: 86, offset: 33041},
 												name: "__",
 											},
 										},
@@ -8120,7 +9143,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1161, col: 109, offset: 28418},
+							pos:        position{line: 1331, col: 109, offset: 33064},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -8133,19 +9156,19 @@ var g = &grammar{
 		},
 		{
 			name: "OptionalExprs",
-			pos:  position{line: 1173, col: 1, offset: 28631},
+			pos:  position{line: 1343, col: 1, offset: 33277},
 			expr: &choiceExpr{
-				pos: position{line: 1174, col: 5, offset: 28649},
+				pos: position{line: 1344, col: 5, offset: 33295},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1174, col: 5, offset: 28649},
+						pos:  position{line: 1344, col: 5, offset: 33295},
 						name: "Exprs",
 					},
 					&actionExpr{
-						pos: position{line: 1175, col: 5, offset: 28659},
+						pos: position{line: 1345, col: 5, offset: 33305},
 						run: (*parser).callonOptionalExprs3,
 						expr: &ruleRefExpr{
-							pos:  position{line: 1175, col: 5, offset: 28659},
+							pos:  position{line: 1345, col: 5, offset: 33305},
 							name: "__",
 						},
 					},
@@ -8156,51 +9179,51 @@ var g = &grammar{
 		},
 		{
 			name: "Exprs",
-			pos:  position{line: 1177, col: 1, offset: 28687},
+			pos:  position{line: 1347, col: 1, offset: 33333},
 			expr: &actionExpr{
-				pos: position{line: 1178, col: 5, offset: 28697},
+				pos: position{line: 1348, col: 5, offset: 33343},
 				run: (*parser).callonExprs1,
 				expr: &seqExpr{
-					pos: position{line: 1178, col: 5, offset: 28697},
+					pos: position{line: 1348, col: 5, offset: 33343},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1178, col: 5, offset: 28697},
+							pos:   position{line: 1348, col: 5, offset: 33343},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1178, col: 11, offset: 28703},
+								pos:  position{line: 1348, col: 11, offset: 33349},
 								name: "Expr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1178, col: 16, offset: 28708},
+							pos:   position{line: 1348, col: 16, offset: 33354},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1178, col: 21, offset: 28713},
+								pos: position{line: 1348, col: 21, offset: 33359},
 								expr: &actionExpr{
-									pos: position{line: 1178, col: 22, offset: 28714},
+									pos: position{line: 1348, col: 22, offset: 33360},
 									run: (*parser).callonExprs7,
 									expr: &seqExpr{
-										pos: position{line: 1178, col: 22, offset: 28714},
+										pos: position{line: 1348, col: 22, offset: 33360},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1178, col: 22, offset: 28714},
+												pos:  position{line: 1348, col: 22, offset: 33360},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 1178, col: 25, offset: 28717},
+												pos:        position{line: 1348, col: 25, offset: 33363},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1178, col: 29, offset: 28721},
+												pos:  position{line: 1348, col: 29, offset: 33367},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 1178, col: 32, offset: 28724},
+												pos:   position{line: 1348, col: 32, offset: 33370},
 												label: "e",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1178, col: 34, offset: 28726},
+													pos:  position{line: 1348, col: 34, offset: 33372},
 													name: "Expr",
 												},
 											},
@@ -8217,56 +9240,56 @@ var g = &grammar{
 		},
 		{
 			name: "Primary",
-			pos:  position{line: 1182, col: 1, offset: 28799},
+			pos:  position{line: 1352, col: 1, offset: 33445},
 			expr: &choiceExpr{
-				pos: position{line: 1183, col: 5, offset: 28811},
+				pos: position{line: 1353, col: 5, offset: 33457},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1183, col: 5, offset: 28811},
+						pos:  position{line: 1353, col: 5, offset: 33457},
 						name: "CaseExpr",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1184, col: 5, offset: 28824},
+						pos:  position{line: 1354, col: 5, offset: 33470},
 						name: "Record",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1185, col: 5, offset: 28835},
+						pos:  position{line: 1355, col: 5, offset: 33481},
 						name: "Array",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1186, col: 5, offset: 28845},
+						pos:  position{line: 1356, col: 5, offset: 33491},
 						name: "Set",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1187, col: 5, offset: 28853},
+						pos:  position{line: 1357, col: 5, offset: 33499},
 						name: "Map",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1188, col: 5, offset: 28861},
+						pos:  position{line: 1358, col: 5, offset: 33507},
 						name: "SQLTimeValue",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1189, col: 5, offset: 28878},
+						pos:  position{line: 1359, col: 5, offset: 33524},
 						name: "Literal",
 					},
 					&actionExpr{
-						pos: position{line: 1190, col: 5, offset: 28890},
+						pos: position{line: 1360, col: 5, offset: 33536},
 						run: (*parser).callonPrimary9,
 						expr: &seqExpr{
-							pos: position{line: 1190, col: 5, offset: 28890},
+							pos: position{line: 1360, col: 5, offset: 33536},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1190, col: 5, offset: 28890},
+									pos: position{line: 1360, col: 5, offset: 33536},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1190, col: 6, offset: 28891},
+										pos:  position{line: 1360, col: 6, offset: 33537},
 										name: "PipeKeyword",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1190, col: 18, offset: 28903},
+									pos:   position{line: 1360, col: 18, offset: 33549},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1190, col: 21, offset: 28906},
+										pos:  position{line: 1360, col: 21, offset: 33552},
 										name: "Identifier",
 									},
 								},
@@ -8274,39 +9297,39 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1191, col: 5, offset: 28940},
+						pos:  position{line: 1361, col: 5, offset: 33586},
 						name: "Tuple",
 					},
 					&actionExpr{
-						pos: position{line: 1192, col: 5, offset: 28950},
+						pos: position{line: 1362, col: 5, offset: 33596},
 						run: (*parser).callonPrimary16,
 						expr: &seqExpr{
-							pos: position{line: 1192, col: 5, offset: 28950},
+							pos: position{line: 1362, col: 5, offset: 33596},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1192, col: 5, offset: 28950},
+									pos:        position{line: 1362, col: 5, offset: 33596},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1192, col: 9, offset: 28954},
+									pos:  position{line: 1362, col: 9, offset: 33600},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1192, col: 12, offset: 28957},
+									pos:   position{line: 1362, col: 12, offset: 33603},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1192, col: 17, offset: 28962},
+										pos:  position{line: 1362, col: 17, offset: 33608},
 										name: "OverExpr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1192, col: 26, offset: 28971},
+									pos:  position{line: 1362, col: 26, offset: 33617},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1192, col: 29, offset: 28974},
+									pos:        position{line: 1362, col: 29, offset: 33620},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -8315,35 +9338,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1193, col: 5, offset: 29003},
+						pos: position{line: 1363, col: 5, offset: 33649},
 						run: (*parser).callonPrimary24,
 						expr: &seqExpr{
-							pos: position{line: 1193, col: 5, offset: 29003},
+							pos: position{line: 1363, col: 5, offset: 33649},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1193, col: 5, offset: 29003},
+									pos:        position{line: 1363, col: 5, offset: 33649},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1193, col: 9, offset: 29007},
+									pos:  position{line: 1363, col: 9, offset: 33653},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1193, col: 12, offset: 29010},
+									pos:   position{line: 1363, col: 12, offset: 33656},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1193, col: 17, offset: 29015},
+										pos:  position{line: 1363, col: 17, offset: 33661},
 										name: "Expr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1193, col: 22, offset: 29020},
+									pos:  position{line: 1363, col: 22, offset: 33666},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1193, col: 25, offset: 29023},
+									pos:        position{line: 1363, col: 25, offset: 33669},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -8358,53 +9381,53 @@ var g = &grammar{
 		},
 		{
 			name: "CaseExpr",
-			pos:  position{line: 1195, col: 1, offset: 29049},
+			pos:  position{line: 1365, col: 1, offset: 33695},
 			expr: &choiceExpr{
-				pos: position{line: 1196, col: 5, offset: 29062},
+				pos: position{line: 1366, col: 5, offset: 33708},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1196, col: 5, offset: 29062},
+						pos: position{line: 1366, col: 5, offset: 33708},
 						run: (*parser).callonCaseExpr2,
 						expr: &seqExpr{
-							pos: position{line: 1196, col: 5, offset: 29062},
+							pos: position{line: 1366, col: 5, offset: 33708},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 1196, col: 5, offset: 29062},
+									pos:  position{line: 1366, col: 5, offset: 33708},
 									name: "CASE",
 								},
 								&labeledExpr{
-									pos:   position{line: 1196, col: 10, offset: 29067},
+									pos:   position{line: 1366, col: 10, offset: 33713},
 									label: "cases",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 1196, col: 16, offset: 29073},
+										pos: position{line: 1366, col: 16, offset: 33719},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1196, col: 16, offset: 29073},
+											pos:  position{line: 1366, col: 16, offset: 33719},
 											name: "When",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1196, col: 22, offset: 29079},
+									pos:   position{line: 1366, col: 22, offset: 33725},
 									label: "else_",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1196, col: 28, offset: 29085},
+										pos: position{line: 1366, col: 28, offset: 33731},
 										expr: &seqExpr{
-											pos: position{line: 1196, col: 29, offset: 29086},
+											pos: position{line: 1366, col: 29, offset: 33732},
 											exprs: []any{
 												&ruleRefExpr{
-													pos:  position{line: 1196, col: 29, offset: 29086},
+													pos:  position{line: 1366, col: 29, offset: 33732},
 													name: "_",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1196, col: 31, offset: 29088},
+													pos:  position{line: 1366, col: 31, offset: 33734},
 													name: "ELSE",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1196, col: 36, offset: 29093},
+													pos:  position{line: 1366, col: 36, offset: 33739},
 													name: "_",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1196, col: 38, offset: 29095},
+													pos:  position{line: 1366, col: 38, offset: 33741},
 													name: "Expr",
 												},
 											},
@@ -8412,24 +9435,24 @@ var g = &grammar{
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1196, col: 45, offset: 29102},
+									pos:  position{line: 1366, col: 45, offset: 33748},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1196, col: 47, offset: 29104},
+									pos:  position{line: 1366, col: 47, offset: 33750},
 									name: "END",
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 1196, col: 51, offset: 29108},
+									pos: position{line: 1366, col: 51, offset: 33754},
 									expr: &seqExpr{
-										pos: position{line: 1196, col: 52, offset: 29109},
+										pos: position{line: 1366, col: 52, offset: 33755},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1196, col: 52, offset: 29109},
+												pos:  position{line: 1366, col: 52, offset: 33755},
 												name: "_",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1196, col: 54, offset: 29111},
+												pos:  position{line: 1366, col: 54, offset: 33757},
 												name: "CASE",
 											},
 										},
@@ -8439,60 +9462,60 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1220, col: 5, offset: 29760},
+						pos: position{line: 1390, col: 5, offset: 34406},
 						run: (*parser).callonCaseExpr21,
 						expr: &seqExpr{
-							pos: position{line: 1220, col: 5, offset: 29760},
+							pos: position{line: 1390, col: 5, offset: 34406},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 1220, col: 5, offset: 29760},
+									pos:  position{line: 1390, col: 5, offset: 34406},
 									name: "CASE",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1220, col: 10, offset: 29765},
+									pos:  position{line: 1390, col: 10, offset: 34411},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 1220, col: 12, offset: 29767},
+									pos:   position{line: 1390, col: 12, offset: 34413},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1220, col: 17, offset: 29772},
+										pos:  position{line: 1390, col: 17, offset: 34418},
 										name: "Expr",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1220, col: 22, offset: 29777},
+									pos:   position{line: 1390, col: 22, offset: 34423},
 									label: "whens",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 1220, col: 28, offset: 29783},
+										pos: position{line: 1390, col: 28, offset: 34429},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1220, col: 28, offset: 29783},
+											pos:  position{line: 1390, col: 28, offset: 34429},
 											name: "When",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1220, col: 34, offset: 29789},
+									pos:   position{line: 1390, col: 34, offset: 34435},
 									label: "else_",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1220, col: 40, offset: 29795},
+										pos: position{line: 1390, col: 40, offset: 34441},
 										expr: &seqExpr{
-											pos: position{line: 1220, col: 41, offset: 29796},
+											pos: position{line: 1390, col: 41, offset: 34442},
 											exprs: []any{
 												&ruleRefExpr{
-													pos:  position{line: 1220, col: 41, offset: 29796},
+													pos:  position{line: 1390, col: 41, offset: 34442},
 													name: "_",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1220, col: 43, offset: 29798},
+													pos:  position{line: 1390, col: 43, offset: 34444},
 													name: "ELSE",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1220, col: 48, offset: 29803},
+													pos:  position{line: 1390, col: 48, offset: 34449},
 													name: "_",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1220, col: 50, offset: 29805},
+													pos:  position{line: 1390, col: 50, offset: 34451},
 													name: "Expr",
 												},
 											},
@@ -8500,24 +9523,24 @@ var g = &grammar{
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1220, col: 57, offset: 29812},
+									pos:  position{line: 1390, col: 57, offset: 34458},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1220, col: 59, offset: 29814},
+									pos:  position{line: 1390, col: 59, offset: 34460},
 									name: "END",
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 1220, col: 63, offset: 29818},
+									pos: position{line: 1390, col: 63, offset: 34464},
 									expr: &seqExpr{
-										pos: position{line: 1220, col: 64, offset: 29819},
+										pos: position{line: 1390, col: 64, offset: 34465},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1220, col: 64, offset: 29819},
+												pos:  position{line: 1390, col: 64, offset: 34465},
 												name: "_",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1220, col: 66, offset: 29821},
+												pos:  position{line: 1390, col: 66, offset: 34467},
 												name: "CASE",
 											},
 										},
@@ -8533,50 +9556,50 @@ var g = &grammar{
 		},
 		{
 			name: "When",
-			pos:  position{line: 1233, col: 1, offset: 30127},
+			pos:  position{line: 1403, col: 1, offset: 34773},
 			expr: &actionExpr{
-				pos: position{line: 1234, col: 5, offset: 30136},
+				pos: position{line: 1404, col: 5, offset: 34782},
 				run: (*parser).callonWhen1,
 				expr: &seqExpr{
-					pos: position{line: 1234, col: 5, offset: 30136},
+					pos: position{line: 1404, col: 5, offset: 34782},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 1234, col: 5, offset: 30136},
+							pos:  position{line: 1404, col: 5, offset: 34782},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1234, col: 7, offset: 30138},
+							pos:  position{line: 1404, col: 7, offset: 34784},
 							name: "WHEN",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1234, col: 12, offset: 30143},
+							pos:  position{line: 1404, col: 12, offset: 34789},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 1234, col: 14, offset: 30145},
+							pos:   position{line: 1404, col: 14, offset: 34791},
 							label: "cond",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1234, col: 19, offset: 30150},
+								pos:  position{line: 1404, col: 19, offset: 34796},
 								name: "Expr",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1234, col: 24, offset: 30155},
+							pos:  position{line: 1404, col: 24, offset: 34801},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1234, col: 26, offset: 30157},
+							pos:  position{line: 1404, col: 26, offset: 34803},
 							name: "THEN",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1234, col: 31, offset: 30162},
+							pos:  position{line: 1404, col: 31, offset: 34808},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 1234, col: 33, offset: 30164},
+							pos:   position{line: 1404, col: 33, offset: 34810},
 							label: "then",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1234, col: 38, offset: 30169},
+								pos:  position{line: 1404, col: 38, offset: 34815},
 								name: "Expr",
 							},
 						},
@@ -8588,57 +9611,57 @@ var g = &grammar{
 		},
 		{
 			name: "OverExpr",
-			pos:  position{line: 1243, col: 1, offset: 30328},
+			pos:  position{line: 1413, col: 1, offset: 34974},
 			expr: &actionExpr{
-				pos: position{line: 1244, col: 5, offset: 30341},
+				pos: position{line: 1414, col: 5, offset: 34987},
 				run: (*parser).callonOverExpr1,
 				expr: &seqExpr{
-					pos: position{line: 1244, col: 5, offset: 30341},
+					pos: position{line: 1414, col: 5, offset: 34987},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 1244, col: 5, offset: 30341},
+							pos:  position{line: 1414, col: 5, offset: 34987},
 							name: "OVER",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1244, col: 10, offset: 30346},
+							pos:  position{line: 1414, col: 10, offset: 34992},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 1244, col: 12, offset: 30348},
+							pos:   position{line: 1414, col: 12, offset: 34994},
 							label: "exprs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1244, col: 18, offset: 30354},
+								pos:  position{line: 1414, col: 18, offset: 35000},
 								name: "Exprs",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1244, col: 24, offset: 30360},
+							pos:   position{line: 1414, col: 24, offset: 35006},
 							label: "locals",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1244, col: 31, offset: 30367},
+								pos: position{line: 1414, col: 31, offset: 35013},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1244, col: 31, offset: 30367},
+									pos:  position{line: 1414, col: 31, offset: 35013},
 									name: "Locals",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1244, col: 39, offset: 30375},
+							pos:  position{line: 1414, col: 39, offset: 35021},
 							name: "__",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1244, col: 42, offset: 30378},
+							pos:  position{line: 1414, col: 42, offset: 35024},
 							name: "Pipe",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1244, col: 47, offset: 30383},
+							pos:  position{line: 1414, col: 47, offset: 35029},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1244, col: 50, offset: 30386},
+							pos:   position{line: 1414, col: 50, offset: 35032},
 							label: "body",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1244, col: 55, offset: 30391},
+								pos:  position{line: 1414, col: 55, offset: 35037},
 								name: "Seq",
 							},
 						},
@@ -8650,37 +9673,37 @@ var g = &grammar{
 		},
 		{
 			name: "Record",
-			pos:  position{line: 1254, col: 1, offset: 30622},
+			pos:  position{line: 1424, col: 1, offset: 35268},
 			expr: &actionExpr{
-				pos: position{line: 1255, col: 5, offset: 30633},
+				pos: position{line: 1425, col: 5, offset: 35279},
 				run: (*parser).callonRecord1,
 				expr: &seqExpr{
-					pos: position{line: 1255, col: 5, offset: 30633},
+					pos: position{line: 1425, col: 5, offset: 35279},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1255, col: 5, offset: 30633},
+							pos:        position{line: 1425, col: 5, offset: 35279},
 							val:        "{",
 							ignoreCase: false,
 							want:       "\"{\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1255, col: 9, offset: 30637},
+							pos:  position{line: 1425, col: 9, offset: 35283},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1255, col: 12, offset: 30640},
+							pos:   position{line: 1425, col: 12, offset: 35286},
 							label: "elems",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1255, col: 18, offset: 30646},
+								pos:  position{line: 1425, col: 18, offset: 35292},
 								name: "RecordElems",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1255, col: 30, offset: 30658},
+							pos:  position{line: 1425, col: 30, offset: 35304},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1255, col: 33, offset: 30661},
+							pos:        position{line: 1425, col: 33, offset: 35307},
 							val:        "}",
 							ignoreCase: false,
 							want:       "\"}\"",
@@ -8693,31 +9716,31 @@ var g = &grammar{
 		},
 		{
 			name: "RecordElems",
-			pos:  position{line: 1263, col: 1, offset: 30819},
+			pos:  position{line: 1433, col: 1, offset: 35465},
 			expr: &choiceExpr{
-				pos: position{line: 1264, col: 5, offset: 30835},
+				pos: position{line: 1434, col: 5, offset: 35481},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1264, col: 5, offset: 30835},
+						pos: position{line: 1434, col: 5, offset: 35481},
 						run: (*parser).callonRecordElems2,
 						expr: &seqExpr{
-							pos: position{line: 1264, col: 5, offset: 30835},
+							pos: position{line: 1434, col: 5, offset: 35481},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1264, col: 5, offset: 30835},
+									pos:   position{line: 1434, col: 5, offset: 35481},
 									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1264, col: 11, offset: 30841},
+										pos:  position{line: 1434, col: 11, offset: 35487},
 										name: "RecordElem",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1264, col: 22, offset: 30852},
+									pos:   position{line: 1434, col: 22, offset: 35498},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1264, col: 27, offset: 30857},
+										pos: position{line: 1434, col: 27, offset: 35503},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1264, col: 27, offset: 30857},
+											pos:  position{line: 1434, col: 27, offset: 35503},
 											name: "RecordElemTail",
 										},
 									},
@@ -8726,10 +9749,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1267, col: 5, offset: 30920},
+						pos: position{line: 1437, col: 5, offset: 35566},
 						run: (*parser).callonRecordElems9,
 						expr: &ruleRefExpr{
-							pos:  position{line: 1267, col: 5, offset: 30920},
+							pos:  position{line: 1437, col: 5, offset: 35566},
 							name: "__",
 						},
 					},
@@ -8740,32 +9763,32 @@ var g = &grammar{
 		},
 		{
 			name: "RecordElemTail",
-			pos:  position{line: 1269, col: 1, offset: 30944},
+			pos:  position{line: 1439, col: 1, offset: 35590},
 			expr: &actionExpr{
-				pos: position{line: 1269, col: 18, offset: 30961},
+				pos: position{line: 1439, col: 18, offset: 35607},
 				run: (*parser).callonRecordElemTail1,
 				expr: &seqExpr{
-					pos: position{line: 1269, col: 18, offset: 30961},
+					pos: position{line: 1439, col: 18, offset: 35607},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 1269, col: 18, offset: 30961},
+							pos:  position{line: 1439, col: 18, offset: 35607},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1269, col: 21, offset: 30964},
+							pos:        position{line: 1439, col: 21, offset: 35610},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1269, col: 25, offset: 30968},
+							pos:  position{line: 1439, col: 25, offset: 35614},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1269, col: 28, offset: 30971},
+							pos:   position{line: 1439, col: 28, offset: 35617},
 							label: "elem",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1269, col: 33, offset: 30976},
+								pos:  position{line: 1439, col: 33, offset: 35622},
 								name: "RecordElem",
 							},
 						},
@@ -8777,20 +9800,20 @@ var g = &grammar{
 		},
 		{
 			name: "RecordElem",
-			pos:  position{line: 1271, col: 1, offset: 31009},
+			pos:  position{line: 1441, col: 1, offset: 35655},
 			expr: &choiceExpr{
-				pos: position{line: 1272, col: 5, offset: 31024},
+				pos: position{line: 1442, col: 5, offset: 35670},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1272, col: 5, offset: 31024},
+						pos:  position{line: 1442, col: 5, offset: 35670},
 						name: "Spread",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1273, col: 5, offset: 31035},
+						pos:  position{line: 1443, col: 5, offset: 35681},
 						name: "FieldExpr",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1274, col: 5, offset: 31049},
+						pos:  position{line: 1444, col: 5, offset: 35695},
 						name: "Identifier",
 					},
 				},
@@ -8800,28 +9823,28 @@ var g = &grammar{
 		},
 		{
 			name: "Spread",
-			pos:  position{line: 1276, col: 1, offset: 31061},
+			pos:  position{line: 1446, col: 1, offset: 35707},
 			expr: &actionExpr{
-				pos: position{line: 1277, col: 5, offset: 31072},
+				pos: position{line: 1447, col: 5, offset: 35718},
 				run: (*parser).callonSpread1,
 				expr: &seqExpr{
-					pos: position{line: 1277, col: 5, offset: 31072},
+					pos: position{line: 1447, col: 5, offset: 35718},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1277, col: 5, offset: 31072},
+							pos:        position{line: 1447, col: 5, offset: 35718},
 							val:        "...",
 							ignoreCase: false,
 							want:       "\"...\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1277, col: 11, offset: 31078},
+							pos:  position{line: 1447, col: 11, offset: 35724},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1277, col: 14, offset: 31081},
+							pos:   position{line: 1447, col: 14, offset: 35727},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1277, col: 19, offset: 31086},
+								pos:  position{line: 1447, col: 19, offset: 35732},
 								name: "Expr",
 							},
 						},
@@ -8833,40 +9856,40 @@ var g = &grammar{
 		},
 		{
 			name: "FieldExpr",
-			pos:  position{line: 1281, col: 1, offset: 31182},
+			pos:  position{line: 1451, col: 1, offset: 35828},
 			expr: &actionExpr{
-				pos: position{line: 1282, col: 5, offset: 31196},
+				pos: position{line: 1452, col: 5, offset: 35842},
 				run: (*parser).callonFieldExpr1,
 				expr: &seqExpr{
-					pos: position{line: 1282, col: 5, offset: 31196},
+					pos: position{line: 1452, col: 5, offset: 35842},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1282, col: 5, offset: 31196},
+							pos:   position{line: 1452, col: 5, offset: 35842},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1282, col: 10, offset: 31201},
+								pos:  position{line: 1452, col: 10, offset: 35847},
 								name: "Name",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1282, col: 15, offset: 31206},
+							pos:  position{line: 1452, col: 15, offset: 35852},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1282, col: 18, offset: 31209},
+							pos:        position{line: 1452, col: 18, offset: 35855},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1282, col: 22, offset: 31213},
+							pos:  position{line: 1452, col: 22, offset: 35859},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1282, col: 25, offset: 31216},
+							pos:   position{line: 1452, col: 25, offset: 35862},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1282, col: 31, offset: 31222},
+								pos:  position{line: 1452, col: 31, offset: 35868},
 								name: "Expr",
 							},
 						},
@@ -8878,37 +9901,37 @@ var g = &grammar{
 		},
 		{
 			name: "Array",
-			pos:  position{line: 1291, col: 1, offset: 31391},
+			pos:  position{line: 1461, col: 1, offset: 36037},
 			expr: &actionExpr{
-				pos: position{line: 1292, col: 5, offset: 31401},
+				pos: position{line: 1462, col: 5, offset: 36047},
 				run: (*parser).callonArray1,
 				expr: &seqExpr{
-					pos: position{line: 1292, col: 5, offset: 31401},
+					pos: position{line: 1462, col: 5, offset: 36047},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1292, col: 5, offset: 31401},
+							pos:        position{line: 1462, col: 5, offset: 36047},
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1292, col: 9, offset: 31405},
+							pos:  position{line: 1462, col: 9, offset: 36051},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1292, col: 12, offset: 31408},
+							pos:   position{line: 1462, col: 12, offset: 36054},
 							label: "elems",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1292, col: 18, offset: 31414},
+								pos:  position{line: 1462, col: 18, offset: 36060},
 								name: "VectorElems",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1292, col: 30, offset: 31426},
+							pos:  position{line: 1462, col: 30, offset: 36072},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1292, col: 33, offset: 31429},
+							pos:        position{line: 1462, col: 33, offset: 36075},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
@@ -8921,37 +9944,37 @@ var g = &grammar{
 		},
 		{
 			name: "Set",
-			pos:  position{line: 1300, col: 1, offset: 31585},
+			pos:  position{line: 1470, col: 1, offset: 36231},
 			expr: &actionExpr{
-				pos: position{line: 1301, col: 5, offset: 31593},
+				pos: position{line: 1471, col: 5, offset: 36239},
 				run: (*parser).callonSet1,
 				expr: &seqExpr{
-					pos: position{line: 1301, col: 5, offset: 31593},
+					pos: position{line: 1471, col: 5, offset: 36239},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1301, col: 5, offset: 31593},
+							pos:        position{line: 1471, col: 5, offset: 36239},
 							val:        "|[",
 							ignoreCase: false,
 							want:       "\"|[\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1301, col: 10, offset: 31598},
+							pos:  position{line: 1471, col: 10, offset: 36244},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1301, col: 13, offset: 31601},
+							pos:   position{line: 1471, col: 13, offset: 36247},
 							label: "elems",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1301, col: 19, offset: 31607},
+								pos:  position{line: 1471, col: 19, offset: 36253},
 								name: "VectorElems",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1301, col: 31, offset: 31619},
+							pos:  position{line: 1471, col: 31, offset: 36265},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1301, col: 34, offset: 31622},
+							pos:        position{line: 1471, col: 34, offset: 36268},
 							val:        "]|",
 							ignoreCase: false,
 							want:       "\"]|\"",
@@ -8964,54 +9987,54 @@ var g = &grammar{
 		},
 		{
 			name: "VectorElems",
-			pos:  position{line: 1309, col: 1, offset: 31775},
+			pos:  position{line: 1479, col: 1, offset: 36421},
 			expr: &choiceExpr{
-				pos: position{line: 1310, col: 5, offset: 31791},
+				pos: position{line: 1480, col: 5, offset: 36437},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1310, col: 5, offset: 31791},
+						pos: position{line: 1480, col: 5, offset: 36437},
 						run: (*parser).callonVectorElems2,
 						expr: &seqExpr{
-							pos: position{line: 1310, col: 5, offset: 31791},
+							pos: position{line: 1480, col: 5, offset: 36437},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1310, col: 5, offset: 31791},
+									pos:   position{line: 1480, col: 5, offset: 36437},
 									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1310, col: 11, offset: 31797},
+										pos:  position{line: 1480, col: 11, offset: 36443},
 										name: "VectorElem",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1310, col: 22, offset: 31808},
+									pos:   position{line: 1480, col: 22, offset: 36454},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1310, col: 27, offset: 31813},
+										pos: position{line: 1480, col: 27, offset: 36459},
 										expr: &actionExpr{
-											pos: position{line: 1310, col: 28, offset: 31814},
+											pos: position{line: 1480, col: 28, offset: 36460},
 											run: (*parser).callonVectorElems8,
 											expr: &seqExpr{
-												pos: position{line: 1310, col: 28, offset: 31814},
+												pos: position{line: 1480, col: 28, offset: 36460},
 												exprs: []any{
 													&ruleRefExpr{
-														pos:  position{line: 1310, col: 28, offset: 31814},
+														pos:  position{line: 1480, col: 28, offset: 36460},
 														name: "__",
 													},
 													&litMatcher{
-														pos:        position{line: 1310, col: 31, offset: 31817},
+														pos:        position{line: 1480, col: 31, offset: 36463},
 														val:        ",",
 														ignoreCase: false,
 														want:       "\",\"",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 1310, col: 35, offset: 31821},
+														pos:  position{line: 1480, col: 35, offset: 36467},
 														name: "__",
 													},
 													&labeledExpr{
-														pos:   position{line: 1310, col: 38, offset: 31824},
+														pos:   position{line: 1480, col: 38, offset: 36470},
 														label: "e",
 														expr: &ruleRefExpr{
-															pos:  position{line: 1310, col: 40, offset: 31826},
+															pos:  position{line: 1480, col: 40, offset: 36472},
 															name: "VectorElem",
 														},
 													},
@@ -9024,10 +10047,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1313, col: 5, offset: 31908},
+						pos: position{line: 1483, col: 5, offset: 36554},
 						run: (*parser).callonVectorElems15,
 						expr: &ruleRefExpr{
-							pos:  position{line: 1313, col: 5, offset: 31908},
+							pos:  position{line: 1483, col: 5, offset: 36554},
 							name: "__",
 						},
 					},
@@ -9038,22 +10061,22 @@ var g = &grammar{
 		},
 		{
 			name: "VectorElem",
-			pos:  position{line: 1315, col: 1, offset: 31932},
+			pos:  position{line: 1485, col: 1, offset: 36578},
 			expr: &choiceExpr{
-				pos: position{line: 1316, col: 5, offset: 31947},
+				pos: position{line: 1486, col: 5, offset: 36593},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1316, col: 5, offset: 31947},
+						pos:  position{line: 1486, col: 5, offset: 36593},
 						name: "Spread",
 					},
 					&actionExpr{
-						pos: position{line: 1317, col: 5, offset: 31958},
+						pos: position{line: 1487, col: 5, offset: 36604},
 						run: (*parser).callonVectorElem3,
 						expr: &labeledExpr{
-							pos:   position{line: 1317, col: 5, offset: 31958},
+							pos:   position{line: 1487, col: 5, offset: 36604},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1317, col: 7, offset: 31960},
+								pos:  position{line: 1487, col: 7, offset: 36606},
 								name: "Expr",
 							},
 						},
@@ -9065,37 +10088,37 @@ var g = &grammar{
 		},
 		{
 			name: "Map",
-			pos:  position{line: 1319, col: 1, offset: 32051},
+			pos:  position{line: 1489, col: 1, offset: 36697},
 			expr: &actionExpr{
-				pos: position{line: 1320, col: 5, offset: 32059},
+				pos: position{line: 1490, col: 5, offset: 36705},
 				run: (*parser).callonMap1,
 				expr: &seqExpr{
-					pos: position{line: 1320, col: 5, offset: 32059},
+					pos: position{line: 1490, col: 5, offset: 36705},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1320, col: 5, offset: 32059},
+							pos:        position{line: 1490, col: 5, offset: 36705},
 							val:        "|{",
 							ignoreCase: false,
 							want:       "\"|{\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1320, col: 10, offset: 32064},
+							pos:  position{line: 1490, col: 10, offset: 36710},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1320, col: 13, offset: 32067},
+							pos:   position{line: 1490, col: 13, offset: 36713},
 							label: "exprs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1320, col: 19, offset: 32073},
+								pos:  position{line: 1490, col: 19, offset: 36719},
 								name: "Entries",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1320, col: 27, offset: 32081},
+							pos:  position{line: 1490, col: 27, offset: 36727},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1320, col: 30, offset: 32084},
+							pos:        position{line: 1490, col: 30, offset: 36730},
 							val:        "}|",
 							ignoreCase: false,
 							want:       "\"}|\"",
@@ -9108,31 +10131,31 @@ var g = &grammar{
 		},
 		{
 			name: "Entries",
-			pos:  position{line: 1328, col: 1, offset: 32238},
+			pos:  position{line: 1498, col: 1, offset: 36884},
 			expr: &choiceExpr{
-				pos: position{line: 1329, col: 5, offset: 32250},
+				pos: position{line: 1499, col: 5, offset: 36896},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1329, col: 5, offset: 32250},
+						pos: position{line: 1499, col: 5, offset: 36896},
 						run: (*parser).callonEntries2,
 						expr: &seqExpr{
-							pos: position{line: 1329, col: 5, offset: 32250},
+							pos: position{line: 1499, col: 5, offset: 36896},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1329, col: 5, offset: 32250},
+									pos:   position{line: 1499, col: 5, offset: 36896},
 									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1329, col: 11, offset: 32256},
+										pos:  position{line: 1499, col: 11, offset: 36902},
 										name: "Entry",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1329, col: 17, offset: 32262},
+									pos:   position{line: 1499, col: 17, offset: 36908},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1329, col: 22, offset: 32267},
+										pos: position{line: 1499, col: 22, offset: 36913},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1329, col: 22, offset: 32267},
+											pos:  position{line: 1499, col: 22, offset: 36913},
 											name: "EntryTail",
 										},
 									},
@@ -9141,10 +10164,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1332, col: 5, offset: 32325},
+						pos: position{line: 1502, col: 5, offset: 36971},
 						run: (*parser).callonEntries9,
 						expr: &ruleRefExpr{
-							pos:  position{line: 1332, col: 5, offset: 32325},
+							pos:  position{line: 1502, col: 5, offset: 36971},
 							name: "__",
 						},
 					},
@@ -9155,32 +10178,32 @@ var g = &grammar{
 		},
 		{
 			name: "EntryTail",
-			pos:  position{line: 1335, col: 1, offset: 32350},
+			pos:  position{line: 1505, col: 1, offset: 36996},
 			expr: &actionExpr{
-				pos: position{line: 1335, col: 13, offset: 32362},
+				pos: position{line: 1505, col: 13, offset: 37008},
 				run: (*parser).callonEntryTail1,
 				expr: &seqExpr{
-					pos: position{line: 1335, col: 13, offset: 32362},
+					pos: position{line: 1505, col: 13, offset: 37008},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 1335, col: 13, offset: 32362},
+							pos:  position{line: 1505, col: 13, offset: 37008},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1335, col: 16, offset: 32365},
+							pos:        position{line: 1505, col: 16, offset: 37011},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1335, col: 20, offset: 32369},
+							pos:  position{line: 1505, col: 20, offset: 37015},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1335, col: 23, offset: 32372},
+							pos:   position{line: 1505, col: 23, offset: 37018},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1335, col: 25, offset: 32374},
+								pos:  position{line: 1505, col: 25, offset: 37020},
 								name: "Entry",
 							},
 						},
@@ -9192,40 +10215,40 @@ var g = &grammar{
 		},
 		{
 			name: "Entry",
-			pos:  position{line: 1337, col: 1, offset: 32399},
+			pos:  position{line: 1507, col: 1, offset: 37045},
 			expr: &actionExpr{
-				pos: position{line: 1338, col: 5, offset: 32409},
+				pos: position{line: 1508, col: 5, offset: 37055},
 				run: (*parser).callonEntry1,
 				expr: &seqExpr{
-					pos: position{line: 1338, col: 5, offset: 32409},
+					pos: position{line: 1508, col: 5, offset: 37055},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1338, col: 5, offset: 32409},
+							pos:   position{line: 1508, col: 5, offset: 37055},
 							label: "key",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1338, col: 9, offset: 32413},
+								pos:  position{line: 1508, col: 9, offset: 37059},
 								name: "Expr",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1338, col: 14, offset: 32418},
+							pos:  position{line: 1508, col: 14, offset: 37064},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1338, col: 17, offset: 32421},
+							pos:        position{line: 1508, col: 17, offset: 37067},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1338, col: 21, offset: 32425},
+							pos:  position{line: 1508, col: 21, offset: 37071},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1338, col: 24, offset: 32428},
+							pos:   position{line: 1508, col: 24, offset: 37074},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1338, col: 30, offset: 32434},
+								pos:  position{line: 1508, col: 30, offset: 37080},
 								name: "Expr",
 							},
 						},
@@ -9237,61 +10260,61 @@ var g = &grammar{
 		},
 		{
 			name: "Tuple",
-			pos:  position{line: 1342, col: 1, offset: 32537},
+			pos:  position{line: 1512, col: 1, offset: 37183},
 			expr: &actionExpr{
-				pos: position{line: 1343, col: 5, offset: 32547},
+				pos: position{line: 1513, col: 5, offset: 37193},
 				run: (*parser).callonTuple1,
 				expr: &seqExpr{
-					pos: position{line: 1343, col: 5, offset: 32547},
+					pos: position{line: 1513, col: 5, offset: 37193},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1343, col: 5, offset: 32547},
+							pos:        position{line: 1513, col: 5, offset: 37193},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1343, col: 9, offset: 32551},
+							pos:  position{line: 1513, col: 9, offset: 37197},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1343, col: 12, offset: 32554},
+							pos:   position{line: 1513, col: 12, offset: 37200},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1343, col: 18, offset: 32560},
+								pos:  position{line: 1513, col: 18, offset: 37206},
 								name: "Expr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1343, col: 23, offset: 32565},
+							pos:   position{line: 1513, col: 23, offset: 37211},
 							label: "rest",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1343, col: 28, offset: 32570},
+								pos: position{line: 1513, col: 28, offset: 37216},
 								expr: &actionExpr{
-									pos: position{line: 1343, col: 29, offset: 32571},
+									pos: position{line: 1513, col: 29, offset: 37217},
 									run: (*parser).callonTuple9,
 									expr: &seqExpr{
-										pos: position{line: 1343, col: 29, offset: 32571},
+										pos: position{line: 1513, col: 29, offset: 37217},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1343, col: 29, offset: 32571},
+												pos:  position{line: 1513, col: 29, offset: 37217},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 1343, col: 32, offset: 32574},
+												pos:        position{line: 1513, col: 32, offset: 37220},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1343, col: 36, offset: 32578},
+												pos:  position{line: 1513, col: 36, offset: 37224},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 1343, col: 39, offset: 32581},
+												pos:   position{line: 1513, col: 39, offset: 37227},
 												label: "e",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1343, col: 41, offset: 32583},
+													pos:  position{line: 1513, col: 41, offset: 37229},
 													name: "Expr",
 												},
 											},
@@ -9301,11 +10324,11 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1343, col: 66, offset: 32608},
+							pos:  position{line: 1513, col: 66, offset: 37254},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1343, col: 69, offset: 32611},
+							pos:        position{line: 1513, col: 69, offset: 37257},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -9318,39 +10341,39 @@ var g = &grammar{
 		},
 		{
 			name: "SQLTimeValue",
-			pos:  position{line: 1351, col: 1, offset: 32770},
+			pos:  position{line: 1521, col: 1, offset: 37416},
 			expr: &actionExpr{
-				pos: position{line: 1352, col: 5, offset: 32787},
+				pos: position{line: 1522, col: 5, offset: 37433},
 				run: (*parser).callonSQLTimeValue1,
 				expr: &seqExpr{
-					pos: position{line: 1352, col: 5, offset: 32787},
+					pos: position{line: 1522, col: 5, offset: 37433},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1352, col: 5, offset: 32787},
+							pos:   position{line: 1522, col: 5, offset: 37433},
 							label: "typ",
 							expr: &choiceExpr{
-								pos: position{line: 1352, col: 10, offset: 32792},
+								pos: position{line: 1522, col: 10, offset: 37438},
 								alternatives: []any{
 									&ruleRefExpr{
-										pos:  position{line: 1352, col: 10, offset: 32792},
+										pos:  position{line: 1522, col: 10, offset: 37438},
 										name: "DATE",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1352, col: 17, offset: 32799},
+										pos:  position{line: 1522, col: 17, offset: 37445},
 										name: "TIMESTAMP",
 									},
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1352, col: 28, offset: 32810},
+							pos:  position{line: 1522, col: 28, offset: 37456},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 1352, col: 30, offset: 32812},
+							pos:   position{line: 1522, col: 30, offset: 37458},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1352, col: 32, offset: 32814},
+								pos:  position{line: 1522, col: 32, offset: 37460},
 								name: "StringLiteral",
 							},
 						},
@@ -9362,56 +10385,56 @@ var g = &grammar{
 		},
 		{
 			name: "Literal",
-			pos:  position{line: 1363, col: 1, offset: 33031},
+			pos:  position{line: 1533, col: 1, offset: 37677},
 			expr: &choiceExpr{
-				pos: position{line: 1364, col: 5, offset: 33043},
+				pos: position{line: 1534, col: 5, offset: 37689},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1364, col: 5, offset: 33043},
+						pos:  position{line: 1534, col: 5, offset: 37689},
 						name: "TypeLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1365, col: 5, offset: 33059},
+						pos:  position{line: 1535, col: 5, offset: 37705},
 						name: "StringLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1366, col: 5, offset: 33077},
+						pos:  position{line: 1536, col: 5, offset: 37723},
 						name: "FString",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1367, col: 5, offset: 33089},
+						pos:  position{line: 1537, col: 5, offset: 37735},
 						name: "SubnetLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1368, col: 5, offset: 33107},
+						pos:  position{line: 1538, col: 5, offset: 37753},
 						name: "AddressLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1369, col: 5, offset: 33126},
+						pos:  position{line: 1539, col: 5, offset: 37772},
 						name: "BytesLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1370, col: 5, offset: 33143},
+						pos:  position{line: 1540, col: 5, offset: 37789},
 						name: "Duration",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1371, col: 5, offset: 33156},
+						pos:  position{line: 1541, col: 5, offset: 37802},
 						name: "Time",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1372, col: 5, offset: 33165},
+						pos:  position{line: 1542, col: 5, offset: 37811},
 						name: "FloatLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1373, col: 5, offset: 33182},
+						pos:  position{line: 1543, col: 5, offset: 37828},
 						name: "IntegerLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1374, col: 5, offset: 33201},
+						pos:  position{line: 1544, col: 5, offset: 37847},
 						name: "BooleanLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1375, col: 5, offset: 33220},
+						pos:  position{line: 1545, col: 5, offset: 37866},
 						name: "NullLiteral",
 					},
 				},
@@ -9421,28 +10444,28 @@ var g = &grammar{
 		},
 		{
 			name: "SubnetLiteral",
-			pos:  position{line: 1377, col: 1, offset: 33233},
+			pos:  position{line: 1547, col: 1, offset: 37879},
 			expr: &choiceExpr{
-				pos: position{line: 1378, col: 5, offset: 33251},
+				pos: position{line: 1548, col: 5, offset: 37897},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1378, col: 5, offset: 33251},
+						pos: position{line: 1548, col: 5, offset: 37897},
 						run: (*parser).callonSubnetLiteral2,
 						expr: &seqExpr{
-							pos: position{line: 1378, col: 5, offset: 33251},
+							pos: position{line: 1548, col: 5, offset: 37897},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1378, col: 5, offset: 33251},
+									pos:   position{line: 1548, col: 5, offset: 37897},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1378, col: 7, offset: 33253},
+										pos:  position{line: 1548, col: 7, offset: 37899},
 										name: "IP6Net",
 									},
 								},
 								&notExpr{
-									pos: position{line: 1378, col: 14, offset: 33260},
+									pos: position{line: 1548, col: 14, offset: 37906},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1378, col: 15, offset: 33261},
+										pos:  position{line: 1548, col: 15, offset: 37907},
 										name: "IdentifierRest",
 									},
 								},
@@ -9450,13 +10473,13 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1381, col: 5, offset: 33341},
+						pos: position{line: 1551, col: 5, offset: 37987},
 						run: (*parser).callonSubnetLiteral8,
 						expr: &labeledExpr{
-							pos:   position{line: 1381, col: 5, offset: 33341},
+							pos:   position{line: 1551, col: 5, offset: 37987},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1381, col: 7, offset: 33343},
+								pos:  position{line: 1551, col: 7, offset: 37989},
 								name: "IP4Net",
 							},
 						},
@@ -9468,28 +10491,28 @@ var g = &grammar{
 		},
 		{
 			name: "AddressLiteral",
-			pos:  position{line: 1385, col: 1, offset: 33412},
+			pos:  position{line: 1555, col: 1, offset: 38058},
 			expr: &choiceExpr{
-				pos: position{line: 1386, col: 5, offset: 33431},
+				pos: position{line: 1556, col: 5, offset: 38077},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1386, col: 5, offset: 33431},
+						pos: position{line: 1556, col: 5, offset: 38077},
 						run: (*parser).callonAddressLiteral2,
 						expr: &seqExpr{
-							pos: position{line: 1386, col: 5, offset: 33431},
+							pos: position{line: 1556, col: 5, offset: 38077},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1386, col: 5, offset: 33431},
+									pos:   position{line: 1556, col: 5, offset: 38077},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1386, col: 7, offset: 33433},
+										pos:  position{line: 1556, col: 7, offset: 38079},
 										name: "IP6",
 									},
 								},
 								&notExpr{
-									pos: position{line: 1386, col: 11, offset: 33437},
+									pos: position{line: 1556, col: 11, offset: 38083},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1386, col: 12, offset: 33438},
+										pos:  position{line: 1556, col: 12, offset: 38084},
 										name: "IdentifierRest",
 									},
 								},
@@ -9497,13 +10520,13 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1389, col: 5, offset: 33517},
+						pos: position{line: 1559, col: 5, offset: 38163},
 						run: (*parser).callonAddressLiteral8,
 						expr: &labeledExpr{
-							pos:   position{line: 1389, col: 5, offset: 33517},
+							pos:   position{line: 1559, col: 5, offset: 38163},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1389, col: 7, offset: 33519},
+								pos:  position{line: 1559, col: 7, offset: 38165},
 								name: "IP",
 							},
 						},
@@ -9515,15 +10538,15 @@ var g = &grammar{
 		},
 		{
 			name: "FloatLiteral",
-			pos:  position{line: 1393, col: 1, offset: 33583},
+			pos:  position{line: 1563, col: 1, offset: 38229},
 			expr: &actionExpr{
-				pos: position{line: 1394, col: 5, offset: 33600},
+				pos: position{line: 1564, col: 5, offset: 38246},
 				run: (*parser).callonFloatLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 1394, col: 5, offset: 33600},
+					pos:   position{line: 1564, col: 5, offset: 38246},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1394, col: 7, offset: 33602},
+						pos:  position{line: 1564, col: 7, offset: 38248},
 						name: "FloatString",
 					},
 				},
@@ -9533,15 +10556,15 @@ var g = &grammar{
 		},
 		{
 			name: "IntegerLiteral",
-			pos:  position{line: 1398, col: 1, offset: 33680},
+			pos:  position{line: 1568, col: 1, offset: 38326},
 			expr: &actionExpr{
-				pos: position{line: 1399, col: 5, offset: 33699},
+				pos: position{line: 1569, col: 5, offset: 38345},
 				run: (*parser).callonIntegerLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 1399, col: 5, offset: 33699},
+					pos:   position{line: 1569, col: 5, offset: 38345},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1399, col: 7, offset: 33701},
+						pos:  position{line: 1569, col: 7, offset: 38347},
 						name: "IntString",
 					},
 				},
@@ -9551,23 +10574,23 @@ var g = &grammar{
 		},
 		{
 			name: "BooleanLiteral",
-			pos:  position{line: 1403, col: 1, offset: 33775},
+			pos:  position{line: 1573, col: 1, offset: 38421},
 			expr: &choiceExpr{
-				pos: position{line: 1404, col: 5, offset: 33794},
+				pos: position{line: 1574, col: 5, offset: 38440},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1404, col: 5, offset: 33794},
+						pos: position{line: 1574, col: 5, offset: 38440},
 						run: (*parser).callonBooleanLiteral2,
 						expr: &ruleRefExpr{
-							pos:  position{line: 1404, col: 5, offset: 33794},
+							pos:  position{line: 1574, col: 5, offset: 38440},
 							name: "TRUE",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1405, col: 5, offset: 33852},
+						pos: position{line: 1575, col: 5, offset: 38498},
 						run: (*parser).callonBooleanLiteral4,
 						expr: &ruleRefExpr{
-							pos:  position{line: 1405, col: 5, offset: 33852},
+							pos:  position{line: 1575, col: 5, offset: 38498},
 							name: "FALSE",
 						},
 					},
@@ -9578,12 +10601,12 @@ var g = &grammar{
 		},
 		{
 			name: "NullLiteral",
-			pos:  position{line: 1407, col: 1, offset: 33908},
+			pos:  position{line: 1577, col: 1, offset: 38554},
 			expr: &actionExpr{
-				pos: position{line: 1408, col: 5, offset: 33924},
+				pos: position{line: 1578, col: 5, offset: 38570},
 				run: (*parser).callonNullLiteral1,
 				expr: &ruleRefExpr{
-					pos:  position{line: 1408, col: 5, offset: 33924},
+					pos:  position{line: 1578, col: 5, offset: 38570},
 					name: "NULL",
 				},
 			},
@@ -9592,23 +10615,23 @@ var g = &grammar{
 		},
 		{
 			name: "BytesLiteral",
-			pos:  position{line: 1410, col: 1, offset: 33974},
+			pos:  position{line: 1580, col: 1, offset: 38620},
 			expr: &actionExpr{
-				pos: position{line: 1411, col: 5, offset: 33991},
+				pos: position{line: 1581, col: 5, offset: 38637},
 				run: (*parser).callonBytesLiteral1,
 				expr: &seqExpr{
-					pos: position{line: 1411, col: 5, offset: 33991},
+					pos: position{line: 1581, col: 5, offset: 38637},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1411, col: 5, offset: 33991},
+							pos:        position{line: 1581, col: 5, offset: 38637},
 							val:        "0x",
 							ignoreCase: false,
 							want:       "\"0x\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1411, col: 10, offset: 33996},
+							pos: position{line: 1581, col: 10, offset: 38642},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1411, col: 10, offset: 33996},
+								pos:  position{line: 1581, col: 10, offset: 38642},
 								name: "HexDigit",
 							},
 						},
@@ -9620,29 +10643,29 @@ var g = &grammar{
 		},
 		{
 			name: "TypeLiteral",
-			pos:  position{line: 1415, col: 1, offset: 34070},
+			pos:  position{line: 1585, col: 1, offset: 38716},
 			expr: &actionExpr{
-				pos: position{line: 1416, col: 5, offset: 34086},
+				pos: position{line: 1586, col: 5, offset: 38732},
 				run: (*parser).callonTypeLiteral1,
 				expr: &seqExpr{
-					pos: position{line: 1416, col: 5, offset: 34086},
+					pos: position{line: 1586, col: 5, offset: 38732},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1416, col: 5, offset: 34086},
+							pos:        position{line: 1586, col: 5, offset: 38732},
 							val:        "<",
 							ignoreCase: false,
 							want:       "\"<\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1416, col: 9, offset: 34090},
+							pos:   position{line: 1586, col: 9, offset: 38736},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1416, col: 13, offset: 34094},
+								pos:  position{line: 1586, col: 13, offset: 38740},
 								name: "Type",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1416, col: 18, offset: 34099},
+							pos:        position{line: 1586, col: 18, offset: 38745},
 							val:        ">",
 							ignoreCase: false,
 							want:       "\">\"",
@@ -9655,16 +10678,16 @@ var g = &grammar{
 		},
 		{
 			name: "Type",
-			pos:  position{line: 1424, col: 1, offset: 34232},
+			pos:  position{line: 1594, col: 1, offset: 38878},
 			expr: &choiceExpr{
-				pos: position{line: 1425, col: 5, offset: 34241},
+				pos: position{line: 1595, col: 5, offset: 38887},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1425, col: 5, offset: 34241},
+						pos:  position{line: 1595, col: 5, offset: 38887},
 						name: "AmbiguousType",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1426, col: 5, offset: 34259},
+						pos:  position{line: 1596, col: 5, offset: 38905},
 						name: "ComplexType",
 					},
 				},
@@ -9674,28 +10697,28 @@ var g = &grammar{
 		},
 		{
 			name: "AmbiguousType",
-			pos:  position{line: 1428, col: 1, offset: 34272},
+			pos:  position{line: 1598, col: 1, offset: 38918},
 			expr: &choiceExpr{
-				pos: position{line: 1429, col: 5, offset: 34290},
+				pos: position{line: 1599, col: 5, offset: 38936},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1429, col: 5, offset: 34290},
+						pos: position{line: 1599, col: 5, offset: 38936},
 						run: (*parser).callonAmbiguousType2,
 						expr: &seqExpr{
-							pos: position{line: 1429, col: 5, offset: 34290},
+							pos: position{line: 1599, col: 5, offset: 38936},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1429, col: 5, offset: 34290},
+									pos:   position{line: 1599, col: 5, offset: 38936},
 									label: "name",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1429, col: 10, offset: 34295},
+										pos:  position{line: 1599, col: 10, offset: 38941},
 										name: "PrimitiveType",
 									},
 								},
 								&notExpr{
-									pos: position{line: 1429, col: 24, offset: 34309},
+									pos: position{line: 1599, col: 24, offset: 38955},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1429, col: 25, offset: 34310},
+										pos:  position{line: 1599, col: 25, offset: 38956},
 										name: "IdentifierRest",
 									},
 								},
@@ -9703,43 +10726,43 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1430, col: 5, offset: 34350},
+						pos: position{line: 1600, col: 5, offset: 38996},
 						run: (*parser).callonAmbiguousType8,
 						expr: &seqExpr{
-							pos: position{line: 1430, col: 5, offset: 34350},
+							pos: position{line: 1600, col: 5, offset: 38996},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 1430, col: 5, offset: 34350},
+									pos:  position{line: 1600, col: 5, offset: 38996},
 									name: "ERROR",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1430, col: 11, offset: 34356},
+									pos:  position{line: 1600, col: 11, offset: 39002},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1430, col: 14, offset: 34359},
+									pos:        position{line: 1600, col: 14, offset: 39005},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1430, col: 18, offset: 34363},
+									pos:  position{line: 1600, col: 18, offset: 39009},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1430, col: 21, offset: 34366},
+									pos:   position{line: 1600, col: 21, offset: 39012},
 									label: "t",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1430, col: 23, offset: 34368},
+										pos:  position{line: 1600, col: 23, offset: 39014},
 										name: "Type",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1430, col: 28, offset: 34373},
+									pos:  position{line: 1600, col: 28, offset: 39019},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1430, col: 31, offset: 34376},
+									pos:        position{line: 1600, col: 31, offset: 39022},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -9748,43 +10771,43 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1437, col: 5, offset: 34516},
+						pos: position{line: 1607, col: 5, offset: 39162},
 						run: (*parser).callonAmbiguousType18,
 						expr: &seqExpr{
-							pos: position{line: 1437, col: 5, offset: 34516},
+							pos: position{line: 1607, col: 5, offset: 39162},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1437, col: 5, offset: 34516},
+									pos:   position{line: 1607, col: 5, offset: 39162},
 									label: "name",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1437, col: 10, offset: 34521},
+										pos:  position{line: 1607, col: 10, offset: 39167},
 										name: "Name",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1437, col: 15, offset: 34526},
+									pos:   position{line: 1607, col: 15, offset: 39172},
 									label: "opt",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1437, col: 19, offset: 34530},
+										pos: position{line: 1607, col: 19, offset: 39176},
 										expr: &seqExpr{
-											pos: position{line: 1437, col: 20, offset: 34531},
+											pos: position{line: 1607, col: 20, offset: 39177},
 											exprs: []any{
 												&ruleRefExpr{
-													pos:  position{line: 1437, col: 20, offset: 34531},
+													pos:  position{line: 1607, col: 20, offset: 39177},
 													name: "__",
 												},
 												&litMatcher{
-													pos:        position{line: 1437, col: 23, offset: 34534},
+													pos:        position{line: 1607, col: 23, offset: 39180},
 													val:        "=",
 													ignoreCase: false,
 													want:       "\"=\"",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1437, col: 27, offset: 34538},
+													pos:  position{line: 1607, col: 27, offset: 39184},
 													name: "__",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1437, col: 30, offset: 34541},
+													pos:  position{line: 1607, col: 30, offset: 39187},
 													name: "Type",
 												},
 											},
@@ -9795,31 +10818,31 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1448, col: 5, offset: 34866},
+						pos: position{line: 1618, col: 5, offset: 39512},
 						run: (*parser).callonAmbiguousType29,
 						expr: &seqExpr{
-							pos: position{line: 1448, col: 5, offset: 34866},
+							pos: position{line: 1618, col: 5, offset: 39512},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1448, col: 5, offset: 34866},
+									pos:        position{line: 1618, col: 5, offset: 39512},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1448, col: 9, offset: 34870},
+									pos:  position{line: 1618, col: 9, offset: 39516},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1448, col: 12, offset: 34873},
+									pos:   position{line: 1618, col: 12, offset: 39519},
 									label: "types",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1448, col: 18, offset: 34879},
+										pos:  position{line: 1618, col: 18, offset: 39525},
 										name: "TypeList",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1448, col: 27, offset: 34888},
+									pos:        position{line: 1618, col: 27, offset: 39534},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -9834,28 +10857,28 @@ var g = &grammar{
 		},
 		{
 			name: "TypeList",
-			pos:  position{line: 1456, col: 1, offset: 35032},
+			pos:  position{line: 1626, col: 1, offset: 39678},
 			expr: &actionExpr{
-				pos: position{line: 1457, col: 5, offset: 35045},
+				pos: position{line: 1627, col: 5, offset: 39691},
 				run: (*parser).callonTypeList1,
 				expr: &seqExpr{
-					pos: position{line: 1457, col: 5, offset: 35045},
+					pos: position{line: 1627, col: 5, offset: 39691},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1457, col: 5, offset: 35045},
+							pos:   position{line: 1627, col: 5, offset: 39691},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1457, col: 11, offset: 35051},
+								pos:  position{line: 1627, col: 11, offset: 39697},
 								name: "Type",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1457, col: 16, offset: 35056},
+							pos:   position{line: 1627, col: 16, offset: 39702},
 							label: "rest",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1457, col: 21, offset: 35061},
+								pos: position{line: 1627, col: 21, offset: 39707},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1457, col: 21, offset: 35061},
+									pos:  position{line: 1627, col: 21, offset: 39707},
 									name: "TypeListTail",
 								},
 							},
@@ -9868,32 +10891,32 @@ var g = &grammar{
 		},
 		{
 			name: "TypeListTail",
-			pos:  position{line: 1461, col: 1, offset: 35119},
+			pos:  position{line: 1631, col: 1, offset: 39765},
 			expr: &actionExpr{
-				pos: position{line: 1461, col: 16, offset: 35134},
+				pos: position{line: 1631, col: 16, offset: 39780},
 				run: (*parser).callonTypeListTail1,
 				expr: &seqExpr{
-					pos: position{line: 1461, col: 16, offset: 35134},
+					pos: position{line: 1631, col: 16, offset: 39780},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 1461, col: 16, offset: 35134},
+							pos:  position{line: 1631, col: 16, offset: 39780},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1461, col: 19, offset: 35137},
+							pos:        position{line: 1631, col: 19, offset: 39783},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1461, col: 23, offset: 35141},
+							pos:  position{line: 1631, col: 23, offset: 39787},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1461, col: 26, offset: 35144},
+							pos:   position{line: 1631, col: 26, offset: 39790},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1461, col: 30, offset: 35148},
+								pos:  position{line: 1631, col: 30, offset: 39794},
 								name: "Type",
 							},
 						},
@@ -9905,40 +10928,40 @@ var g = &grammar{
 		},
 		{
 			name: "ComplexType",
-			pos:  position{line: 1463, col: 1, offset: 35174},
+			pos:  position{line: 1633, col: 1, offset: 39820},
 			expr: &choiceExpr{
-				pos: position{line: 1464, col: 5, offset: 35190},
+				pos: position{line: 1634, col: 5, offset: 39836},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1464, col: 5, offset: 35190},
+						pos: position{line: 1634, col: 5, offset: 39836},
 						run: (*parser).callonComplexType2,
 						expr: &seqExpr{
-							pos: position{line: 1464, col: 5, offset: 35190},
+							pos: position{line: 1634, col: 5, offset: 39836},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1464, col: 5, offset: 35190},
+									pos:        position{line: 1634, col: 5, offset: 39836},
 									val:        "{",
 									ignoreCase: false,
 									want:       "\"{\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1464, col: 9, offset: 35194},
+									pos:  position{line: 1634, col: 9, offset: 39840},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1464, col: 12, offset: 35197},
+									pos:   position{line: 1634, col: 12, offset: 39843},
 									label: "fields",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1464, col: 19, offset: 35204},
+										pos:  position{line: 1634, col: 19, offset: 39850},
 										name: "TypeFieldList",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1464, col: 33, offset: 35218},
+									pos:  position{line: 1634, col: 33, offset: 39864},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1464, col: 36, offset: 35221},
+									pos:        position{line: 1634, col: 36, offset: 39867},
 									val:        "}",
 									ignoreCase: false,
 									want:       "\"}\"",
@@ -9947,35 +10970,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1471, col: 5, offset: 35383},
+						pos: position{line: 1641, col: 5, offset: 40029},
 						run: (*parser).callonComplexType10,
 						expr: &seqExpr{
-							pos: position{line: 1471, col: 5, offset: 35383},
+							pos: position{line: 1641, col: 5, offset: 40029},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1471, col: 5, offset: 35383},
+									pos:        position{line: 1641, col: 5, offset: 40029},
 									val:        "[",
 									ignoreCase: false,
 									want:       "\"[\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1471, col: 9, offset: 35387},
+									pos:  position{line: 1641, col: 9, offset: 40033},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1471, col: 12, offset: 35390},
+									pos:   position{line: 1641, col: 12, offset: 40036},
 									label: "typ",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1471, col: 16, offset: 35394},
+										pos:  position{line: 1641, col: 16, offset: 40040},
 										name: "Type",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1471, col: 21, offset: 35399},
+									pos:  position{line: 1641, col: 21, offset: 40045},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1471, col: 24, offset: 35402},
+									pos:        position{line: 1641, col: 24, offset: 40048},
 									val:        "]",
 									ignoreCase: false,
 									want:       "\"]\"",
@@ -9984,35 +11007,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1478, col: 5, offset: 35544},
+						pos: position{line: 1648, col: 5, offset: 40190},
 						run: (*parser).callonComplexType18,
 						expr: &seqExpr{
-							pos: position{line: 1478, col: 5, offset: 35544},
+							pos: position{line: 1648, col: 5, offset: 40190},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1478, col: 5, offset: 35544},
+									pos:        position{line: 1648, col: 5, offset: 40190},
 									val:        "|[",
 									ignoreCase: false,
 									want:       "\"|[\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1478, col: 10, offset: 35549},
+									pos:  position{line: 1648, col: 10, offset: 40195},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1478, col: 13, offset: 35552},
+									pos:   position{line: 1648, col: 13, offset: 40198},
 									label: "typ",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1478, col: 17, offset: 35556},
+										pos:  position{line: 1648, col: 17, offset: 40202},
 										name: "Type",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1478, col: 22, offset: 35561},
+									pos:  position{line: 1648, col: 22, offset: 40207},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1478, col: 25, offset: 35564},
+									pos:        position{line: 1648, col: 25, offset: 40210},
 									val:        "]|",
 									ignoreCase: false,
 									want:       "\"]|\"",
@@ -10021,57 +11044,57 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1485, col: 5, offset: 35703},
+						pos: position{line: 1655, col: 5, offset: 40349},
 						run: (*parser).callonComplexType26,
 						expr: &seqExpr{
-							pos: position{line: 1485, col: 5, offset: 35703},
+							pos: position{line: 1655, col: 5, offset: 40349},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1485, col: 5, offset: 35703},
+									pos:        position{line: 1655, col: 5, offset: 40349},
 									val:        "|{",
 									ignoreCase: false,
 									want:       "\"|{\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1485, col: 10, offset: 35708},
+									pos:  position{line: 1655, col: 10, offset: 40354},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1485, col: 13, offset: 35711},
+									pos:   position{line: 1655, col: 13, offset: 40357},
 									label: "keyType",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1485, col: 21, offset: 35719},
+										pos:  position{line: 1655, col: 21, offset: 40365},
 										name: "Type",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1485, col: 26, offset: 35724},
+									pos:  position{line: 1655, col: 26, offset: 40370},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1485, col: 29, offset: 35727},
+									pos:        position{line: 1655, col: 29, offset: 40373},
 									val:        ":",
 									ignoreCase: false,
 									want:       "\":\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1485, col: 33, offset: 35731},
+									pos:  position{line: 1655, col: 33, offset: 40377},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1485, col: 36, offset: 35734},
+									pos:   position{line: 1655, col: 36, offset: 40380},
 									label: "valType",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1485, col: 44, offset: 35742},
+										pos:  position{line: 1655, col: 44, offset: 40388},
 										name: "Type",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1485, col: 49, offset: 35747},
+									pos:  position{line: 1655, col: 49, offset: 40393},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1485, col: 52, offset: 35750},
+									pos:        position{line: 1655, col: 52, offset: 40396},
 									val:        "}|",
 									ignoreCase: false,
 									want:       "\"}|\"",
@@ -10086,30 +11109,30 @@ var g = &grammar{
 		},
 		{
 			name: "StringLiteral",
-			pos:  position{line: 1494, col: 1, offset: 35924},
+			pos:  position{line: 1664, col: 1, offset: 40570},
 			expr: &choiceExpr{
-				pos: position{line: 1495, col: 5, offset: 35942},
+				pos: position{line: 1665, col: 5, offset: 40588},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1495, col: 5, offset: 35942},
+						pos: position{line: 1665, col: 5, offset: 40588},
 						run: (*parser).callonStringLiteral2,
 						expr: &labeledExpr{
-							pos:   position{line: 1495, col: 5, offset: 35942},
+							pos:   position{line: 1665, col: 5, offset: 40588},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1495, col: 7, offset: 35944},
+								pos:  position{line: 1665, col: 7, offset: 40590},
 								name: "DoubleQuotedString",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1496, col: 5, offset: 36051},
+						pos: position{line: 1666, col: 5, offset: 40697},
 						run: (*parser).callonStringLiteral5,
 						expr: &labeledExpr{
-							pos:   position{line: 1496, col: 5, offset: 36051},
+							pos:   position{line: 1666, col: 5, offset: 40697},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1496, col: 7, offset: 36053},
+								pos:  position{line: 1666, col: 7, offset: 40699},
 								name: "SingleQuotedString",
 							},
 						},
@@ -10121,35 +11144,35 @@ var g = &grammar{
 		},
 		{
 			name: "FString",
-			pos:  position{line: 1498, col: 1, offset: 36127},
+			pos:  position{line: 1668, col: 1, offset: 40773},
 			expr: &choiceExpr{
-				pos: position{line: 1499, col: 5, offset: 36139},
+				pos: position{line: 1669, col: 5, offset: 40785},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1499, col: 5, offset: 36139},
+						pos: position{line: 1669, col: 5, offset: 40785},
 						run: (*parser).callonFString2,
 						expr: &seqExpr{
-							pos: position{line: 1499, col: 5, offset: 36139},
+							pos: position{line: 1669, col: 5, offset: 40785},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1499, col: 5, offset: 36139},
+									pos:        position{line: 1669, col: 5, offset: 40785},
 									val:        "f\"",
 									ignoreCase: false,
 									want:       "\"f\\\"\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1499, col: 11, offset: 36145},
+									pos:   position{line: 1669, col: 11, offset: 40791},
 									label: "v",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1499, col: 13, offset: 36147},
+										pos: position{line: 1669, col: 13, offset: 40793},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1499, col: 13, offset: 36147},
+											pos:  position{line: 1669, col: 13, offset: 40793},
 											name: "FStringDoubleQuotedElem",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1499, col: 38, offset: 36172},
+									pos:        position{line: 1669, col: 38, offset: 40818},
 									val:        "\"",
 									ignoreCase: false,
 									want:       "\"\\\"\"",
@@ -10158,30 +11181,30 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1506, col: 5, offset: 36318},
+						pos: position{line: 1676, col: 5, offset: 40964},
 						run: (*parser).callonFString9,
 						expr: &seqExpr{
-							pos: position{line: 1506, col: 5, offset: 36318},
+							pos: position{line: 1676, col: 5, offset: 40964},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1506, col: 5, offset: 36318},
+									pos:        position{line: 1676, col: 5, offset: 40964},
 									val:        "f'",
 									ignoreCase: false,
 									want:       "\"f'\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1506, col: 10, offset: 36323},
+									pos:   position{line: 1676, col: 10, offset: 40969},
 									label: "v",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1506, col: 12, offset: 36325},
+										pos: position{line: 1676, col: 12, offset: 40971},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1506, col: 12, offset: 36325},
+											pos:  position{line: 1676, col: 12, offset: 40971},
 											name: "FStringSingleQuotedElem",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1506, col: 37, offset: 36350},
+									pos:        position{line: 1676, col: 37, offset: 40996},
 									val:        "'",
 									ignoreCase: false,
 									want:       "\"'\"",
@@ -10196,24 +11219,24 @@ var g = &grammar{
 		},
 		{
 			name: "FStringDoubleQuotedElem",
-			pos:  position{line: 1514, col: 1, offset: 36493},
+			pos:  position{line: 1684, col: 1, offset: 41139},
 			expr: &choiceExpr{
-				pos: position{line: 1515, col: 5, offset: 36521},
+				pos: position{line: 1685, col: 5, offset: 41167},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1515, col: 5, offset: 36521},
+						pos:  position{line: 1685, col: 5, offset: 41167},
 						name: "FStringExpr",
 					},
 					&actionExpr{
-						pos: position{line: 1516, col: 5, offset: 36537},
+						pos: position{line: 1686, col: 5, offset: 41183},
 						run: (*parser).callonFStringDoubleQuotedElem3,
 						expr: &labeledExpr{
-							pos:   position{line: 1516, col: 5, offset: 36537},
+							pos:   position{line: 1686, col: 5, offset: 41183},
 							label: "v",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1516, col: 7, offset: 36539},
+								pos: position{line: 1686, col: 7, offset: 41185},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1516, col: 7, offset: 36539},
+									pos:  position{line: 1686, col: 7, offset: 41185},
 									name: "FStringDoubleQuotedChar",
 								},
 							},
@@ -10226,27 +11249,27 @@ var g = &grammar{
 		},
 		{
 			name: "FStringDoubleQuotedChar",
-			pos:  position{line: 1520, col: 1, offset: 36662},
+			pos:  position{line: 1690, col: 1, offset: 41308},
 			expr: &choiceExpr{
-				pos: position{line: 1521, col: 5, offset: 36690},
+				pos: position{line: 1691, col: 5, offset: 41336},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1521, col: 5, offset: 36690},
+						pos: position{line: 1691, col: 5, offset: 41336},
 						run: (*parser).callonFStringDoubleQuotedChar2,
 						expr: &seqExpr{
-							pos: position{line: 1521, col: 5, offset: 36690},
+							pos: position{line: 1691, col: 5, offset: 41336},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1521, col: 5, offset: 36690},
+									pos:        position{line: 1691, col: 5, offset: 41336},
 									val:        "\\",
 									ignoreCase: false,
 									want:       "\"\\\\\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1521, col: 10, offset: 36695},
+									pos:   position{line: 1691, col: 10, offset: 41341},
 									label: "v",
 									expr: &litMatcher{
-										pos:        position{line: 1521, col: 12, offset: 36697},
+										pos:        position{line: 1691, col: 12, offset: 41343},
 										val:        "{",
 										ignoreCase: false,
 										want:       "\"{\"",
@@ -10256,25 +11279,25 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1522, col: 5, offset: 36723},
+						pos: position{line: 1692, col: 5, offset: 41369},
 						run: (*parser).callonFStringDoubleQuotedChar7,
 						expr: &seqExpr{
-							pos: position{line: 1522, col: 5, offset: 36723},
+							pos: position{line: 1692, col: 5, offset: 41369},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1522, col: 5, offset: 36723},
+									pos: position{line: 1692, col: 5, offset: 41369},
 									expr: &litMatcher{
-										pos:        position{line: 1522, col: 7, offset: 36725},
+										pos:        position{line: 1692, col: 7, offset: 41371},
 										val:        "{",
 										ignoreCase: false,
 										want:       "\"{\"",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1522, col: 12, offset: 36730},
+									pos:   position{line: 1692, col: 12, offset: 41376},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1522, col: 14, offset: 36732},
+										pos:  position{line: 1692, col: 14, offset: 41378},
 										name: "DoubleQuotedChar",
 									},
 								},
@@ -10288,24 +11311,24 @@ var g = &grammar{
 		},
 		{
 			name: "FStringSingleQuotedElem",
-			pos:  position{line: 1524, col: 1, offset: 36768},
+			pos:  position{line: 1694, col: 1, offset: 41414},
 			expr: &choiceExpr{
-				pos: position{line: 1525, col: 5, offset: 36796},
+				pos: position{line: 1695, col: 5, offset: 41442},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1525, col: 5, offset: 36796},
+						pos:  position{line: 1695, col: 5, offset: 41442},
 						name: "FStringExpr",
 					},
 					&actionExpr{
-						pos: position{line: 1526, col: 5, offset: 36812},
+						pos: position{line: 1696, col: 5, offset: 41458},
 						run: (*parser).callonFStringSingleQuotedElem3,
 						expr: &labeledExpr{
-							pos:   position{line: 1526, col: 5, offset: 36812},
+							pos:   position{line: 1696, col: 5, offset: 41458},
 							label: "v",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1526, col: 7, offset: 36814},
+								pos: position{line: 1696, col: 7, offset: 41460},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1526, col: 7, offset: 36814},
+									pos:  position{line: 1696, col: 7, offset: 41460},
 									name: "FStringSingleQuotedChar",
 								},
 							},
@@ -10318,27 +11341,27 @@ var g = &grammar{
 		},
 		{
 			name: "FStringSingleQuotedChar",
-			pos:  position{line: 1530, col: 1, offset: 36937},
+			pos:  position{line: 1700, col: 1, offset: 41583},
 			expr: &choiceExpr{
-				pos: position{line: 1531, col: 5, offset: 36965},
+				pos: position{line: 1701, col: 5, offset: 41611},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1531, col: 5, offset: 36965},
+						pos: position{line: 1701, col: 5, offset: 41611},
 						run: (*parser).callonFStringSingleQuotedChar2,
 						expr: &seqExpr{
-							pos: position{line: 1531, col: 5, offset: 36965},
+							pos: position{line: 1701, col: 5, offset: 41611},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1531, col: 5, offset: 36965},
+									pos:        position{line: 1701, col: 5, offset: 41611},
 									val:        "\\",
 									ignoreCase: false,
 									want:       "\"\\\\\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1531, col: 10, offset: 36970},
+									pos:   position{line: 1701, col: 10, offset: 41616},
 									label: "v",
 									expr: &litMatcher{
-										pos:        position{line: 1531, col: 12, offset: 36972},
+										pos:        position{line: 1701, col: 12, offset: 41618},
 										val:        "{",
 										ignoreCase: false,
 										want:       "\"{\"",
@@ -10348,25 +11371,25 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1532, col: 5, offset: 36998},
+						pos: position{line: 1702, col: 5, offset: 41644},
 						run: (*parser).callonFStringSingleQuotedChar7,
 						expr: &seqExpr{
-							pos: position{line: 1532, col: 5, offset: 36998},
+							pos: position{line: 1702, col: 5, offset: 41644},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1532, col: 5, offset: 36998},
+									pos: position{line: 1702, col: 5, offset: 41644},
 									expr: &litMatcher{
-										pos:        position{line: 1532, col: 7, offset: 37000},
+										pos:        position{line: 1702, col: 7, offset: 41646},
 										val:        "{",
 										ignoreCase: false,
 										want:       "\"{\"",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1532, col: 12, offset: 37005},
+									pos:   position{line: 1702, col: 12, offset: 41651},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1532, col: 14, offset: 37007},
+										pos:  position{line: 1702, col: 14, offset: 41653},
 										name: "SingleQuotedChar",
 									},
 								},
@@ -10380,37 +11403,37 @@ var g = &grammar{
 		},
 		{
 			name: "FStringExpr",
-			pos:  position{line: 1534, col: 1, offset: 37043},
+			pos:  position{line: 1704, col: 1, offset: 41689},
 			expr: &actionExpr{
-				pos: position{line: 1535, col: 5, offset: 37059},
+				pos: position{line: 1705, col: 5, offset: 41705},
 				run: (*parser).callonFStringExpr1,
 				expr: &seqExpr{
-					pos: position{line: 1535, col: 5, offset: 37059},
+					pos: position{line: 1705, col: 5, offset: 41705},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1535, col: 5, offset: 37059},
+							pos:        position{line: 1705, col: 5, offset: 41705},
 							val:        "{",
 							ignoreCase: false,
 							want:       "\"{\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1535, col: 9, offset: 37063},
+							pos:  position{line: 1705, col: 9, offset: 41709},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1535, col: 12, offset: 37066},
+							pos:   position{line: 1705, col: 12, offset: 41712},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1535, col: 14, offset: 37068},
+								pos:  position{line: 1705, col: 14, offset: 41714},
 								name: "Expr",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1535, col: 19, offset: 37073},
+							pos:  position{line: 1705, col: 19, offset: 41719},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1535, col: 22, offset: 37076},
+							pos:        position{line: 1705, col: 22, offset: 41722},
 							val:        "}",
 							ignoreCase: false,
 							want:       "\"}\"",
@@ -10423,129 +11446,129 @@ var g = &grammar{
 		},
 		{
 			name: "PrimitiveType",
-			pos:  position{line: 1543, col: 1, offset: 37211},
+			pos:  position{line: 1713, col: 1, offset: 41857},
 			expr: &actionExpr{
-				pos: position{line: 1544, col: 5, offset: 37229},
+				pos: position{line: 1714, col: 5, offset: 41875},
 				run: (*parser).callonPrimitiveType1,
 				expr: &choiceExpr{
-					pos: position{line: 1544, col: 9, offset: 37233},
+					pos: position{line: 1714, col: 9, offset: 41879},
 					alternatives: []any{
 						&litMatcher{
-							pos:        position{line: 1544, col: 9, offset: 37233},
+							pos:        position{line: 1714, col: 9, offset: 41879},
 							val:        "uint8",
 							ignoreCase: false,
 							want:       "\"uint8\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1544, col: 19, offset: 37243},
+							pos:        position{line: 1714, col: 19, offset: 41889},
 							val:        "uint16",
 							ignoreCase: false,
 							want:       "\"uint16\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1544, col: 30, offset: 37254},
+							pos:        position{line: 1714, col: 30, offset: 41900},
 							val:        "uint32",
 							ignoreCase: false,
 							want:       "\"uint32\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1544, col: 41, offset: 37265},
+							pos:        position{line: 1714, col: 41, offset: 41911},
 							val:        "uint64",
 							ignoreCase: false,
 							want:       "\"uint64\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1545, col: 9, offset: 37282},
+							pos:        position{line: 1715, col: 9, offset: 41928},
 							val:        "int8",
 							ignoreCase: false,
 							want:       "\"int8\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1545, col: 18, offset: 37291},
+							pos:        position{line: 1715, col: 18, offset: 41937},
 							val:        "int16",
 							ignoreCase: false,
 							want:       "\"int16\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1545, col: 28, offset: 37301},
+							pos:        position{line: 1715, col: 28, offset: 41947},
 							val:        "int32",
 							ignoreCase: false,
 							want:       "\"int32\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1545, col: 38, offset: 37311},
+							pos:        position{line: 1715, col: 38, offset: 41957},
 							val:        "int64",
 							ignoreCase: false,
 							want:       "\"int64\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1546, col: 9, offset: 37327},
+							pos:        position{line: 1716, col: 9, offset: 41973},
 							val:        "float16",
 							ignoreCase: false,
 							want:       "\"float16\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1546, col: 21, offset: 37339},
+							pos:        position{line: 1716, col: 21, offset: 41985},
 							val:        "float32",
 							ignoreCase: false,
 							want:       "\"float32\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1546, col: 33, offset: 37351},
+							pos:        position{line: 1716, col: 33, offset: 41997},
 							val:        "float64",
 							ignoreCase: false,
 							want:       "\"float64\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1547, col: 9, offset: 37369},
+							pos:        position{line: 1717, col: 9, offset: 42015},
 							val:        "bool",
 							ignoreCase: false,
 							want:       "\"bool\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1547, col: 18, offset: 37378},
+							pos:        position{line: 1717, col: 18, offset: 42024},
 							val:        "string",
 							ignoreCase: false,
 							want:       "\"string\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1548, col: 9, offset: 37395},
+							pos:        position{line: 1718, col: 9, offset: 42041},
 							val:        "duration",
 							ignoreCase: false,
 							want:       "\"duration\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1548, col: 22, offset: 37408},
+							pos:        position{line: 1718, col: 22, offset: 42054},
 							val:        "time",
 							ignoreCase: false,
 							want:       "\"time\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1549, col: 9, offset: 37423},
+							pos:        position{line: 1719, col: 9, offset: 42069},
 							val:        "bytes",
 							ignoreCase: false,
 							want:       "\"bytes\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1550, col: 9, offset: 37439},
+							pos:        position{line: 1720, col: 9, offset: 42085},
 							val:        "ip",
 							ignoreCase: false,
 							want:       "\"ip\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1550, col: 16, offset: 37446},
+							pos:        position{line: 1720, col: 16, offset: 42092},
 							val:        "net",
 							ignoreCase: false,
 							want:       "\"net\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1551, col: 9, offset: 37460},
+							pos:        position{line: 1721, col: 9, offset: 42106},
 							val:        "type",
 							ignoreCase: false,
 							want:       "\"type\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1551, col: 18, offset: 37469},
+							pos:        position{line: 1721, col: 18, offset: 42115},
 							val:        "null",
 							ignoreCase: false,
 							want:       "\"null\"",
@@ -10558,31 +11581,31 @@ var g = &grammar{
 		},
 		{
 			name: "TypeFieldList",
-			pos:  position{line: 1559, col: 1, offset: 37654},
+			pos:  position{line: 1729, col: 1, offset: 42300},
 			expr: &choiceExpr{
-				pos: position{line: 1560, col: 5, offset: 37672},
+				pos: position{line: 1730, col: 5, offset: 42318},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1560, col: 5, offset: 37672},
+						pos: position{line: 1730, col: 5, offset: 42318},
 						run: (*parser).callonTypeFieldList2,
 						expr: &seqExpr{
-							pos: position{line: 1560, col: 5, offset: 37672},
+							pos: position{line: 1730, col: 5, offset: 42318},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1560, col: 5, offset: 37672},
+									pos:   position{line: 1730, col: 5, offset: 42318},
 									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1560, col: 11, offset: 37678},
+										pos:  position{line: 1730, col: 11, offset: 42324},
 										name: "TypeField",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1560, col: 21, offset: 37688},
+									pos:   position{line: 1730, col: 21, offset: 42334},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1560, col: 26, offset: 37693},
+										pos: position{line: 1730, col: 26, offset: 42339},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1560, col: 26, offset: 37693},
+											pos:  position{line: 1730, col: 26, offset: 42339},
 											name: "TypeFieldListTail",
 										},
 									},
@@ -10591,10 +11614,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1563, col: 5, offset: 37759},
+						pos: position{line: 1733, col: 5, offset: 42405},
 						run: (*parser).callonTypeFieldList9,
 						expr: &litMatcher{
-							pos:        position{line: 1563, col: 5, offset: 37759},
+							pos:        position{line: 1733, col: 5, offset: 42405},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -10607,32 +11630,32 @@ var g = &grammar{
 		},
 		{
 			name: "TypeFieldListTail",
-			pos:  position{line: 1565, col: 1, offset: 37783},
+			pos:  position{line: 1735, col: 1, offset: 42429},
 			expr: &actionExpr{
-				pos: position{line: 1565, col: 21, offset: 37803},
+				pos: position{line: 1735, col: 21, offset: 42449},
 				run: (*parser).callonTypeFieldListTail1,
 				expr: &seqExpr{
-					pos: position{line: 1565, col: 21, offset: 37803},
+					pos: position{line: 1735, col: 21, offset: 42449},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 1565, col: 21, offset: 37803},
+							pos:  position{line: 1735, col: 21, offset: 42449},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1565, col: 24, offset: 37806},
+							pos:        position{line: 1735, col: 24, offset: 42452},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1565, col: 28, offset: 37810},
+							pos:  position{line: 1735, col: 28, offset: 42456},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1565, col: 31, offset: 37813},
+							pos:   position{line: 1735, col: 31, offset: 42459},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1565, col: 35, offset: 37817},
+								pos:  position{line: 1735, col: 35, offset: 42463},
 								name: "TypeField",
 							},
 						},
@@ -10644,40 +11667,40 @@ var g = &grammar{
 		},
 		{
 			name: "TypeField",
-			pos:  position{line: 1567, col: 1, offset: 37848},
+			pos:  position{line: 1737, col: 1, offset: 42494},
 			expr: &actionExpr{
-				pos: position{line: 1568, col: 5, offset: 37862},
+				pos: position{line: 1738, col: 5, offset: 42508},
 				run: (*parser).callonTypeField1,
 				expr: &seqExpr{
-					pos: position{line: 1568, col: 5, offset: 37862},
+					pos: position{line: 1738, col: 5, offset: 42508},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1568, col: 5, offset: 37862},
+							pos:   position{line: 1738, col: 5, offset: 42508},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1568, col: 10, offset: 37867},
+								pos:  position{line: 1738, col: 10, offset: 42513},
 								name: "Name",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1568, col: 15, offset: 37872},
+							pos:  position{line: 1738, col: 15, offset: 42518},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1568, col: 18, offset: 37875},
+							pos:        position{line: 1738, col: 18, offset: 42521},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1568, col: 22, offset: 37879},
+							pos:  position{line: 1738, col: 22, offset: 42525},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1568, col: 25, offset: 37882},
+							pos:   position{line: 1738, col: 25, offset: 42528},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1568, col: 29, offset: 37886},
+								pos:  position{line: 1738, col: 29, offset: 42532},
 								name: "Type",
 							},
 						},
@@ -10689,66 +11712,66 @@ var g = &grammar{
 		},
 		{
 			name: "Name",
-			pos:  position{line: 1576, col: 1, offset: 38035},
+			pos:  position{line: 1746, col: 1, offset: 42681},
 			expr: &choiceExpr{
-				pos: position{line: 1577, col: 5, offset: 38044},
+				pos: position{line: 1747, col: 5, offset: 42690},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1577, col: 5, offset: 38044},
+						pos: position{line: 1747, col: 5, offset: 42690},
 						run: (*parser).callonName2,
 						expr: &labeledExpr{
-							pos:   position{line: 1577, col: 5, offset: 38044},
+							pos:   position{line: 1747, col: 5, offset: 42690},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1577, col: 7, offset: 38046},
+								pos:  position{line: 1747, col: 7, offset: 42692},
 								name: "DottedIDs",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1578, col: 5, offset: 38136},
+						pos: position{line: 1748, col: 5, offset: 42782},
 						run: (*parser).callonName5,
 						expr: &labeledExpr{
-							pos:   position{line: 1578, col: 5, offset: 38136},
+							pos:   position{line: 1748, col: 5, offset: 42782},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1578, col: 7, offset: 38138},
+								pos:  position{line: 1748, col: 7, offset: 42784},
 								name: "IdentifierName",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1579, col: 5, offset: 38228},
+						pos: position{line: 1749, col: 5, offset: 42874},
 						run: (*parser).callonName8,
 						expr: &labeledExpr{
-							pos:   position{line: 1579, col: 5, offset: 38228},
+							pos:   position{line: 1749, col: 5, offset: 42874},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1579, col: 7, offset: 38230},
+								pos:  position{line: 1749, col: 7, offset: 42876},
 								name: "DoubleQuotedString",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1580, col: 5, offset: 38320},
+						pos: position{line: 1750, col: 5, offset: 42966},
 						run: (*parser).callonName11,
 						expr: &labeledExpr{
-							pos:   position{line: 1580, col: 5, offset: 38320},
+							pos:   position{line: 1750, col: 5, offset: 42966},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1580, col: 7, offset: 38322},
+								pos:  position{line: 1750, col: 7, offset: 42968},
 								name: "SingleQuotedString",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1581, col: 5, offset: 38412},
+						pos: position{line: 1751, col: 5, offset: 43058},
 						run: (*parser).callonName14,
 						expr: &labeledExpr{
-							pos:   position{line: 1581, col: 5, offset: 38412},
+							pos:   position{line: 1751, col: 5, offset: 43058},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1581, col: 7, offset: 38414},
+								pos:  position{line: 1751, col: 7, offset: 43060},
 								name: "KSUID",
 							},
 						},
@@ -10760,22 +11783,22 @@ var g = &grammar{
 		},
 		{
 			name: "DottedIDs",
-			pos:  position{line: 1583, col: 1, offset: 38501},
+			pos:  position{line: 1753, col: 1, offset: 43147},
 			expr: &actionExpr{
-				pos: position{line: 1584, col: 5, offset: 38515},
+				pos: position{line: 1754, col: 5, offset: 43161},
 				run: (*parser).callonDottedIDs1,
 				expr: &seqExpr{
-					pos: position{line: 1584, col: 5, offset: 38515},
+					pos: position{line: 1754, col: 5, offset: 43161},
 					exprs: []any{
 						&choiceExpr{
-							pos: position{line: 1584, col: 6, offset: 38516},
+							pos: position{line: 1754, col: 6, offset: 43162},
 							alternatives: []any{
 								&ruleRefExpr{
-									pos:  position{line: 1584, col: 6, offset: 38516},
+									pos:  position{line: 1754, col: 6, offset: 43162},
 									name: "IdentifierStart",
 								},
 								&litMatcher{
-									pos:        position{line: 1584, col: 24, offset: 38534},
+									pos:        position{line: 1754, col: 24, offset: 43180},
 									val:        ".",
 									ignoreCase: false,
 									want:       "\".\"",
@@ -10783,16 +11806,16 @@ var g = &grammar{
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1584, col: 29, offset: 38539},
+							pos: position{line: 1754, col: 29, offset: 43185},
 							expr: &choiceExpr{
-								pos: position{line: 1584, col: 30, offset: 38540},
+								pos: position{line: 1754, col: 30, offset: 43186},
 								alternatives: []any{
 									&ruleRefExpr{
-										pos:  position{line: 1584, col: 30, offset: 38540},
+										pos:  position{line: 1754, col: 30, offset: 43186},
 										name: "IdentifierRest",
 									},
 									&litMatcher{
-										pos:        position{line: 1584, col: 47, offset: 38557},
+										pos:        position{line: 1754, col: 47, offset: 43203},
 										val:        ".",
 										ignoreCase: false,
 										want:       "\".\"",
@@ -10808,15 +11831,15 @@ var g = &grammar{
 		},
 		{
 			name: "Identifier",
-			pos:  position{line: 1586, col: 1, offset: 38595},
+			pos:  position{line: 1756, col: 1, offset: 43241},
 			expr: &actionExpr{
-				pos: position{line: 1587, col: 5, offset: 38610},
+				pos: position{line: 1757, col: 5, offset: 43256},
 				run: (*parser).callonIdentifier1,
 				expr: &labeledExpr{
-					pos:   position{line: 1587, col: 5, offset: 38610},
+					pos:   position{line: 1757, col: 5, offset: 43256},
 					label: "id",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1587, col: 8, offset: 38613},
+						pos:  position{line: 1757, col: 8, offset: 43259},
 						name: "IdentifierName",
 					},
 				},
@@ -10826,51 +11849,51 @@ var g = &grammar{
 		},
 		{
 			name: "Identifiers",
-			pos:  position{line: 1595, col: 1, offset: 38746},
+			pos:  position{line: 1765, col: 1, offset: 43392},
 			expr: &actionExpr{
-				pos: position{line: 1596, col: 5, offset: 38762},
+				pos: position{line: 1766, col: 5, offset: 43408},
 				run: (*parser).callonIdentifiers1,
 				expr: &seqExpr{
-					pos: position{line: 1596, col: 5, offset: 38762},
+					pos: position{line: 1766, col: 5, offset: 43408},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1596, col: 5, offset: 38762},
+							pos:   position{line: 1766, col: 5, offset: 43408},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1596, col: 11, offset: 38768},
+								pos:  position{line: 1766, col: 11, offset: 43414},
 								name: "Identifier",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1596, col: 22, offset: 38779},
+							pos:   position{line: 1766, col: 22, offset: 43425},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1596, col: 27, offset: 38784},
+								pos: position{line: 1766, col: 27, offset: 43430},
 								expr: &actionExpr{
-									pos: position{line: 1596, col: 28, offset: 38785},
+									pos: position{line: 1766, col: 28, offset: 43431},
 									run: (*parser).callonIdentifiers7,
 									expr: &seqExpr{
-										pos: position{line: 1596, col: 28, offset: 38785},
+										pos: position{line: 1766, col: 28, offset: 43431},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1596, col: 28, offset: 38785},
+												pos:  position{line: 1766, col: 28, offset: 43431},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 1596, col: 31, offset: 38788},
+												pos:        position{line: 1766, col: 31, offset: 43434},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1596, col: 35, offset: 38792},
+												pos:  position{line: 1766, col: 35, offset: 43438},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 1596, col: 38, offset: 38795},
+												pos:   position{line: 1766, col: 38, offset: 43441},
 												label: "name",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1596, col: 43, offset: 38800},
+													pos:  position{line: 1766, col: 43, offset: 43446},
 													name: "Identifier",
 												},
 											},
@@ -10887,34 +11910,34 @@ var g = &grammar{
 		},
 		{
 			name: "SQLIdentifier",
-			pos:  position{line: 1600, col: 1, offset: 38878},
+			pos:  position{line: 1770, col: 1, offset: 43524},
 			expr: &choiceExpr{
-				pos: position{line: 1601, col: 5, offset: 38896},
+				pos: position{line: 1771, col: 5, offset: 43542},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1601, col: 5, offset: 38896},
+						pos:  position{line: 1771, col: 5, offset: 43542},
 						name: "Identifier",
 					},
 					&actionExpr{
-						pos: position{line: 1602, col: 5, offset: 38911},
+						pos: position{line: 1772, col: 5, offset: 43557},
 						run: (*parser).callonSQLIdentifier3,
 						expr: &labeledExpr{
-							pos:   position{line: 1602, col: 5, offset: 38911},
+							pos:   position{line: 1772, col: 5, offset: 43557},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1602, col: 7, offset: 38913},
+								pos:  position{line: 1772, col: 7, offset: 43559},
 								name: "BacktickString",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1603, col: 5, offset: 39001},
+						pos: position{line: 1773, col: 5, offset: 43647},
 						run: (*parser).callonSQLIdentifier6,
 						expr: &labeledExpr{
-							pos:   position{line: 1603, col: 5, offset: 39001},
+							pos:   position{line: 1773, col: 5, offset: 43647},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1603, col: 7, offset: 39003},
+								pos:  position{line: 1773, col: 7, offset: 43649},
 								name: "DoubleQuotedString",
 							},
 						},
@@ -10926,29 +11949,29 @@ var g = &grammar{
 		},
 		{
 			name: "IdentifierName",
-			pos:  position{line: 1605, col: 1, offset: 39088},
+			pos:  position{line: 1775, col: 1, offset: 43734},
 			expr: &choiceExpr{
-				pos: position{line: 1606, col: 5, offset: 39107},
+				pos: position{line: 1776, col: 5, offset: 43753},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1606, col: 5, offset: 39107},
+						pos: position{line: 1776, col: 5, offset: 43753},
 						run: (*parser).callonIdentifierName2,
 						expr: &seqExpr{
-							pos: position{line: 1606, col: 5, offset: 39107},
+							pos: position{line: 1776, col: 5, offset: 43753},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1606, col: 5, offset: 39107},
+									pos: position{line: 1776, col: 5, offset: 43753},
 									expr: &seqExpr{
-										pos: position{line: 1606, col: 7, offset: 39109},
+										pos: position{line: 1776, col: 7, offset: 43755},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1606, col: 7, offset: 39109},
+												pos:  position{line: 1776, col: 7, offset: 43755},
 												name: "IDGuard",
 											},
 											&notExpr{
-												pos: position{line: 1606, col: 15, offset: 39117},
+												pos: position{line: 1776, col: 15, offset: 43763},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1606, col: 16, offset: 39118},
+													pos:  position{line: 1776, col: 16, offset: 43764},
 													name: "IdentifierRest",
 												},
 											},
@@ -10956,13 +11979,13 @@ var g = &grammar{
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1606, col: 32, offset: 39134},
+									pos:  position{line: 1776, col: 32, offset: 43780},
 									name: "IdentifierStart",
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 1606, col: 48, offset: 39150},
+									pos: position{line: 1776, col: 48, offset: 43796},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1606, col: 48, offset: 39150},
+										pos:  position{line: 1776, col: 48, offset: 43796},
 										name: "IdentifierRest",
 									},
 								},
@@ -10970,32 +11993,32 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1607, col: 5, offset: 39201},
+						pos: position{line: 1777, col: 5, offset: 43847},
 						run: (*parser).callonIdentifierName12,
 						expr: &litMatcher{
-							pos:        position{line: 1607, col: 5, offset: 39201},
+							pos:        position{line: 1777, col: 5, offset: 43847},
 							val:        "$",
 							ignoreCase: false,
 							want:       "\"$\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1608, col: 5, offset: 39240},
+						pos: position{line: 1778, col: 5, offset: 43886},
 						run: (*parser).callonIdentifierName14,
 						expr: &seqExpr{
-							pos: position{line: 1608, col: 5, offset: 39240},
+							pos: position{line: 1778, col: 5, offset: 43886},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1608, col: 5, offset: 39240},
+									pos:        position{line: 1778, col: 5, offset: 43886},
 									val:        "\\",
 									ignoreCase: false,
 									want:       "\"\\\\\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1608, col: 10, offset: 39245},
+									pos:   position{line: 1778, col: 10, offset: 43891},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1608, col: 13, offset: 39248},
+										pos:  position{line: 1778, col: 13, offset: 43894},
 										name: "IDGuard",
 									},
 								},
@@ -11003,17 +12026,17 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1610, col: 5, offset: 39339},
+						pos: position{line: 1780, col: 5, offset: 43985},
 						run: (*parser).callonIdentifierName19,
 						expr: &litMatcher{
-							pos:        position{line: 1610, col: 5, offset: 39339},
+							pos:        position{line: 1780, col: 5, offset: 43985},
 							val:        "type",
 							ignoreCase: false,
 							want:       "\"type\"",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1611, col: 5, offset: 39381},
+						pos:  position{line: 1781, col: 5, offset: 44027},
 						name: "BacktickString",
 					},
 				},
@@ -11023,22 +12046,22 @@ var g = &grammar{
 		},
 		{
 			name: "IdentifierStart",
-			pos:  position{line: 1613, col: 1, offset: 39398},
+			pos:  position{line: 1783, col: 1, offset: 44044},
 			expr: &choiceExpr{
-				pos: position{line: 1614, col: 5, offset: 39418},
+				pos: position{line: 1784, col: 5, offset: 44064},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1614, col: 5, offset: 39418},
+						pos:  position{line: 1784, col: 5, offset: 44064},
 						name: "UnicodeLetter",
 					},
 					&litMatcher{
-						pos:        position{line: 1615, col: 5, offset: 39436},
+						pos:        position{line: 1785, col: 5, offset: 44082},
 						val:        "$",
 						ignoreCase: false,
 						want:       "\"$\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1616, col: 5, offset: 39444},
+						pos:        position{line: 1786, col: 5, offset: 44090},
 						val:        "_",
 						ignoreCase: false,
 						want:       "\"_\"",
@@ -11050,24 +12073,24 @@ var g = &grammar{
 		},
 		{
 			name: "IdentifierRest",
-			pos:  position{line: 1618, col: 1, offset: 39449},
+			pos:  position{line: 1788, col: 1, offset: 44095},
 			expr: &choiceExpr{
-				pos: position{line: 1619, col: 5, offset: 39468},
+				pos: position{line: 1789, col: 5, offset: 44114},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1619, col: 5, offset: 39468},
+						pos:  position{line: 1789, col: 5, offset: 44114},
 						name: "IdentifierStart",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1620, col: 5, offset: 39488},
+						pos:  position{line: 1790, col: 5, offset: 44134},
 						name: "UnicodeCombiningMark",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1621, col: 5, offset: 39513},
+						pos:  position{line: 1791, col: 5, offset: 44159},
 						name: "UnicodeDigit",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1622, col: 5, offset: 39530},
+						pos:  position{line: 1792, col: 5, offset: 44176},
 						name: "UnicodeConnectorPunctuation",
 					},
 				},
@@ -11077,24 +12100,24 @@ var g = &grammar{
 		},
 		{
 			name: "IDGuard",
-			pos:  position{line: 1624, col: 1, offset: 39559},
+			pos:  position{line: 1794, col: 1, offset: 44205},
 			expr: &choiceExpr{
-				pos: position{line: 1625, col: 5, offset: 39571},
+				pos: position{line: 1795, col: 5, offset: 44217},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1625, col: 5, offset: 39571},
+						pos:  position{line: 1795, col: 5, offset: 44217},
 						name: "BooleanLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1626, col: 5, offset: 39590},
+						pos:  position{line: 1796, col: 5, offset: 44236},
 						name: "NullLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1627, col: 5, offset: 39606},
+						pos:  position{line: 1797, col: 5, offset: 44252},
 						name: "NaN",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1628, col: 5, offset: 39614},
+						pos:  position{line: 1798, col: 5, offset: 44260},
 						name: "Infinity",
 					},
 				},
@@ -11104,25 +12127,25 @@ var g = &grammar{
 		},
 		{
 			name: "Time",
-			pos:  position{line: 1630, col: 1, offset: 39624},
+			pos:  position{line: 1800, col: 1, offset: 44270},
 			expr: &actionExpr{
-				pos: position{line: 1631, col: 5, offset: 39633},
+				pos: position{line: 1801, col: 5, offset: 44279},
 				run: (*parser).callonTime1,
 				expr: &seqExpr{
-					pos: position{line: 1631, col: 5, offset: 39633},
+					pos: position{line: 1801, col: 5, offset: 44279},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 1631, col: 5, offset: 39633},
+							pos:  position{line: 1801, col: 5, offset: 44279},
 							name: "FullDate",
 						},
 						&litMatcher{
-							pos:        position{line: 1631, col: 14, offset: 39642},
+							pos:        position{line: 1801, col: 14, offset: 44288},
 							val:        "T",
 							ignoreCase: false,
 							want:       "\"T\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1631, col: 18, offset: 39646},
+							pos:  position{line: 1801, col: 18, offset: 44292},
 							name: "FullTime",
 						},
 					},
@@ -11133,32 +12156,32 @@ var g = &grammar{
 		},
 		{
 			name: "FullDate",
-			pos:  position{line: 1635, col: 1, offset: 39722},
+			pos:  position{line: 1805, col: 1, offset: 44368},
 			expr: &seqExpr{
-				pos: position{line: 1635, col: 12, offset: 39733},
+				pos: position{line: 1805, col: 12, offset: 44379},
 				exprs: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1635, col: 12, offset: 39733},
+						pos:  position{line: 1805, col: 12, offset: 44379},
 						name: "D4",
 					},
 					&litMatcher{
-						pos:        position{line: 1635, col: 15, offset: 39736},
+						pos:        position{line: 1805, col: 15, offset: 44382},
 						val:        "-",
 						ignoreCase: false,
 						want:       "\"-\"",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1635, col: 19, offset: 39740},
+						pos:  position{line: 1805, col: 19, offset: 44386},
 						name: "D2",
 					},
 					&litMatcher{
-						pos:        position{line: 1635, col: 22, offset: 39743},
+						pos:        position{line: 1805, col: 22, offset: 44389},
 						val:        "-",
 						ignoreCase: false,
 						want:       "\"-\"",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1635, col: 26, offset: 39747},
+						pos:  position{line: 1805, col: 26, offset: 44393},
 						name: "D2",
 					},
 				},
@@ -11168,33 +12191,33 @@ var g = &grammar{
 		},
 		{
 			name: "D4",
-			pos:  position{line: 1637, col: 1, offset: 39751},
+			pos:  position{line: 1807, col: 1, offset: 44397},
 			expr: &seqExpr{
-				pos: position{line: 1637, col: 6, offset: 39756},
+				pos: position{line: 1807, col: 6, offset: 44402},
 				exprs: []any{
 					&charClassMatcher{
-						pos:        position{line: 1637, col: 6, offset: 39756},
+						pos:        position{line: 1807, col: 6, offset: 44402},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
 						inverted:   false,
 					},
 					&charClassMatcher{
-						pos:        position{line: 1637, col: 11, offset: 39761},
+						pos:        position{line: 1807, col: 11, offset: 44407},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
 						inverted:   false,
 					},
 					&charClassMatcher{
-						pos:        position{line: 1637, col: 16, offset: 39766},
+						pos:        position{line: 1807, col: 16, offset: 44412},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
 						inverted:   false,
 					},
 					&charClassMatcher{
-						pos:        position{line: 1637, col: 21, offset: 39771},
+						pos:        position{line: 1807, col: 21, offset: 44417},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -11207,19 +12230,19 @@ var g = &grammar{
 		},
 		{
 			name: "D2",
-			pos:  position{line: 1638, col: 1, offset: 39777},
+			pos:  position{line: 1808, col: 1, offset: 44423},
 			expr: &seqExpr{
-				pos: position{line: 1638, col: 6, offset: 39782},
+				pos: position{line: 1808, col: 6, offset: 44428},
 				exprs: []any{
 					&charClassMatcher{
-						pos:        position{line: 1638, col: 6, offset: 39782},
+						pos:        position{line: 1808, col: 6, offset: 44428},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
 						inverted:   false,
 					},
 					&charClassMatcher{
-						pos:        position{line: 1638, col: 11, offset: 39787},
+						pos:        position{line: 1808, col: 11, offset: 44433},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -11232,16 +12255,16 @@ var g = &grammar{
 		},
 		{
 			name: "FullTime",
-			pos:  position{line: 1640, col: 1, offset: 39794},
+			pos:  position{line: 1810, col: 1, offset: 44440},
 			expr: &seqExpr{
-				pos: position{line: 1640, col: 12, offset: 39805},
+				pos: position{line: 1810, col: 12, offset: 44451},
 				exprs: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1640, col: 12, offset: 39805},
+						pos:  position{line: 1810, col: 12, offset: 44451},
 						name: "PartialTime",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1640, col: 24, offset: 39817},
+						pos:  position{line: 1810, col: 24, offset: 44463},
 						name: "TimeOffset",
 					},
 				},
@@ -11251,49 +12274,49 @@ var g = &grammar{
 		},
 		{
 			name: "PartialTime",
-			pos:  position{line: 1642, col: 1, offset: 39829},
+			pos:  position{line: 1812, col: 1, offset: 44475},
 			expr: &seqExpr{
-				pos: position{line: 1642, col: 15, offset: 39843},
+				pos: position{line: 1812, col: 15, offset: 44489},
 				exprs: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1642, col: 15, offset: 39843},
+						pos:  position{line: 1812, col: 15, offset: 44489},
 						name: "D2",
 					},
 					&litMatcher{
-						pos:        position{line: 1642, col: 18, offset: 39846},
+						pos:        position{line: 1812, col: 18, offset: 44492},
 						val:        ":",
 						ignoreCase: false,
 						want:       "\":\"",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1642, col: 22, offset: 39850},
+						pos:  position{line: 1812, col: 22, offset: 44496},
 						name: "D2",
 					},
 					&litMatcher{
-						pos:        position{line: 1642, col: 25, offset: 39853},
+						pos:        position{line: 1812, col: 25, offset: 44499},
 						val:        ":",
 						ignoreCase: false,
 						want:       "\":\"",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1642, col: 29, offset: 39857},
+						pos:  position{line: 1812, col: 29, offset: 44503},
 						name: "D2",
 					},
 					&zeroOrOneExpr{
-						pos: position{line: 1642, col: 32, offset: 39860},
+						pos: position{line: 1812, col: 32, offset: 44506},
 						expr: &seqExpr{
-							pos: position{line: 1642, col: 33, offset: 39861},
+							pos: position{line: 1812, col: 33, offset: 44507},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1642, col: 33, offset: 39861},
+									pos:        position{line: 1812, col: 33, offset: 44507},
 									val:        ".",
 									ignoreCase: false,
 									want:       "\".\"",
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 1642, col: 37, offset: 39865},
+									pos: position{line: 1812, col: 37, offset: 44511},
 									expr: &charClassMatcher{
-										pos:        position{line: 1642, col: 37, offset: 39865},
+										pos:        position{line: 1812, col: 37, offset: 44511},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
@@ -11310,30 +12333,30 @@ var g = &grammar{
 		},
 		{
 			name: "TimeOffset",
-			pos:  position{line: 1644, col: 1, offset: 39875},
+			pos:  position{line: 1814, col: 1, offset: 44521},
 			expr: &choiceExpr{
-				pos: position{line: 1645, col: 5, offset: 39890},
+				pos: position{line: 1815, col: 5, offset: 44536},
 				alternatives: []any{
 					&litMatcher{
-						pos:        position{line: 1645, col: 5, offset: 39890},
+						pos:        position{line: 1815, col: 5, offset: 44536},
 						val:        "Z",
 						ignoreCase: false,
 						want:       "\"Z\"",
 					},
 					&seqExpr{
-						pos: position{line: 1646, col: 5, offset: 39898},
+						pos: position{line: 1816, col: 5, offset: 44544},
 						exprs: []any{
 							&choiceExpr{
-								pos: position{line: 1646, col: 6, offset: 39899},
+								pos: position{line: 1816, col: 6, offset: 44545},
 								alternatives: []any{
 									&litMatcher{
-										pos:        position{line: 1646, col: 6, offset: 39899},
+										pos:        position{line: 1816, col: 6, offset: 44545},
 										val:        "+",
 										ignoreCase: false,
 										want:       "\"+\"",
 									},
 									&litMatcher{
-										pos:        position{line: 1646, col: 12, offset: 39905},
+										pos:        position{line: 1816, col: 12, offset: 44551},
 										val:        "-",
 										ignoreCase: false,
 										want:       "\"-\"",
@@ -11341,34 +12364,34 @@ var g = &grammar{
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1646, col: 17, offset: 39910},
+								pos:  position{line: 1816, col: 17, offset: 44556},
 								name: "D2",
 							},
 							&litMatcher{
-								pos:        position{line: 1646, col: 20, offset: 39913},
+								pos:        position{line: 1816, col: 20, offset: 44559},
 								val:        ":",
 								ignoreCase: false,
 								want:       "\":\"",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1646, col: 24, offset: 39917},
+								pos:  position{line: 1816, col: 24, offset: 44563},
 								name: "D2",
 							},
 							&zeroOrOneExpr{
-								pos: position{line: 1646, col: 27, offset: 39920},
+								pos: position{line: 1816, col: 27, offset: 44566},
 								expr: &seqExpr{
-									pos: position{line: 1646, col: 28, offset: 39921},
+									pos: position{line: 1816, col: 28, offset: 44567},
 									exprs: []any{
 										&litMatcher{
-											pos:        position{line: 1646, col: 28, offset: 39921},
+											pos:        position{line: 1816, col: 28, offset: 44567},
 											val:        ".",
 											ignoreCase: false,
 											want:       "\".\"",
 										},
 										&oneOrMoreExpr{
-											pos: position{line: 1646, col: 32, offset: 39925},
+											pos: position{line: 1816, col: 32, offset: 44571},
 											expr: &charClassMatcher{
-												pos:        position{line: 1646, col: 32, offset: 39925},
+												pos:        position{line: 1816, col: 32, offset: 44571},
 												val:        "[0-9]",
 												ranges:     []rune{'0', '9'},
 												ignoreCase: false,
@@ -11387,33 +12410,33 @@ var g = &grammar{
 		},
 		{
 			name: "Duration",
-			pos:  position{line: 1648, col: 1, offset: 39935},
+			pos:  position{line: 1818, col: 1, offset: 44581},
 			expr: &actionExpr{
-				pos: position{line: 1649, col: 5, offset: 39948},
+				pos: position{line: 1819, col: 5, offset: 44594},
 				run: (*parser).callonDuration1,
 				expr: &seqExpr{
-					pos: position{line: 1649, col: 5, offset: 39948},
+					pos: position{line: 1819, col: 5, offset: 44594},
 					exprs: []any{
 						&zeroOrOneExpr{
-							pos: position{line: 1649, col: 5, offset: 39948},
+							pos: position{line: 1819, col: 5, offset: 44594},
 							expr: &litMatcher{
-								pos:        position{line: 1649, col: 5, offset: 39948},
+								pos:        position{line: 1819, col: 5, offset: 44594},
 								val:        "-",
 								ignoreCase: false,
 								want:       "\"-\"",
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 1649, col: 10, offset: 39953},
+							pos: position{line: 1819, col: 10, offset: 44599},
 							expr: &seqExpr{
-								pos: position{line: 1649, col: 11, offset: 39954},
+								pos: position{line: 1819, col: 11, offset: 44600},
 								exprs: []any{
 									&ruleRefExpr{
-										pos:  position{line: 1649, col: 11, offset: 39954},
+										pos:  position{line: 1819, col: 11, offset: 44600},
 										name: "Decimal",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1649, col: 19, offset: 39962},
+										pos:  position{line: 1819, col: 19, offset: 44608},
 										name: "TimeUnit",
 									},
 								},
@@ -11427,27 +12450,27 @@ var g = &grammar{
 		},
 		{
 			name: "Decimal",
-			pos:  position{line: 1653, col: 1, offset: 40044},
+			pos:  position{line: 1823, col: 1, offset: 44690},
 			expr: &seqExpr{
-				pos: position{line: 1653, col: 11, offset: 40054},
+				pos: position{line: 1823, col: 11, offset: 44700},
 				exprs: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1653, col: 11, offset: 40054},
+						pos:  position{line: 1823, col: 11, offset: 44700},
 						name: "UInt",
 					},
 					&zeroOrOneExpr{
-						pos: position{line: 1653, col: 16, offset: 40059},
+						pos: position{line: 1823, col: 16, offset: 44705},
 						expr: &seqExpr{
-							pos: position{line: 1653, col: 17, offset: 40060},
+							pos: position{line: 1823, col: 17, offset: 44706},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1653, col: 17, offset: 40060},
+									pos:        position{line: 1823, col: 17, offset: 44706},
 									val:        ".",
 									ignoreCase: false,
 									want:       "\".\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1653, col: 21, offset: 40064},
+									pos:  position{line: 1823, col: 21, offset: 44710},
 									name: "UInt",
 								},
 							},
@@ -11460,60 +12483,60 @@ var g = &grammar{
 		},
 		{
 			name: "TimeUnit",
-			pos:  position{line: 1655, col: 1, offset: 40072},
+			pos:  position{line: 1825, col: 1, offset: 44718},
 			expr: &choiceExpr{
-				pos: position{line: 1656, col: 5, offset: 40085},
+				pos: position{line: 1826, col: 5, offset: 44731},
 				alternatives: []any{
 					&litMatcher{
-						pos:        position{line: 1656, col: 5, offset: 40085},
+						pos:        position{line: 1826, col: 5, offset: 44731},
 						val:        "ns",
 						ignoreCase: false,
 						want:       "\"ns\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1657, col: 5, offset: 40094},
+						pos:        position{line: 1827, col: 5, offset: 44740},
 						val:        "us",
 						ignoreCase: false,
 						want:       "\"us\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1658, col: 5, offset: 40103},
+						pos:        position{line: 1828, col: 5, offset: 44749},
 						val:        "ms",
 						ignoreCase: false,
 						want:       "\"ms\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1659, col: 5, offset: 40112},
+						pos:        position{line: 1829, col: 5, offset: 44758},
 						val:        "s",
 						ignoreCase: false,
 						want:       "\"s\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1660, col: 5, offset: 40120},
+						pos:        position{line: 1830, col: 5, offset: 44766},
 						val:        "m",
 						ignoreCase: false,
 						want:       "\"m\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1661, col: 5, offset: 40128},
+						pos:        position{line: 1831, col: 5, offset: 44774},
 						val:        "h",
 						ignoreCase: false,
 						want:       "\"h\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1662, col: 5, offset: 40136},
+						pos:        position{line: 1832, col: 5, offset: 44782},
 						val:        "d",
 						ignoreCase: false,
 						want:       "\"d\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1663, col: 5, offset: 40144},
+						pos:        position{line: 1833, col: 5, offset: 44790},
 						val:        "w",
 						ignoreCase: false,
 						want:       "\"w\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1664, col: 5, offset: 40152},
+						pos:        position{line: 1834, col: 5, offset: 44798},
 						val:        "y",
 						ignoreCase: false,
 						want:       "\"y\"",
@@ -11525,45 +12548,45 @@ var g = &grammar{
 		},
 		{
 			name: "IP",
-			pos:  position{line: 1666, col: 1, offset: 40157},
+			pos:  position{line: 1836, col: 1, offset: 44803},
 			expr: &actionExpr{
-				pos: position{line: 1667, col: 5, offset: 40164},
+				pos: position{line: 1837, col: 5, offset: 44810},
 				run: (*parser).callonIP1,
 				expr: &seqExpr{
-					pos: position{line: 1667, col: 5, offset: 40164},
+					pos: position{line: 1837, col: 5, offset: 44810},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 1667, col: 5, offset: 40164},
+							pos:  position{line: 1837, col: 5, offset: 44810},
 							name: "UInt",
 						},
 						&litMatcher{
-							pos:        position{line: 1667, col: 10, offset: 40169},
+							pos:        position{line: 1837, col: 10, offset: 44815},
 							val:        ".",
 							ignoreCase: false,
 							want:       "\".\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1667, col: 14, offset: 40173},
+							pos:  position{line: 1837, col: 14, offset: 44819},
 							name: "UInt",
 						},
 						&litMatcher{
-							pos:        position{line: 1667, col: 19, offset: 40178},
+							pos:        position{line: 1837, col: 19, offset: 44824},
 							val:        ".",
 							ignoreCase: false,
 							want:       "\".\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1667, col: 23, offset: 40182},
+							pos:  position{line: 1837, col: 23, offset: 44828},
 							name: "UInt",
 						},
 						&litMatcher{
-							pos:        position{line: 1667, col: 28, offset: 40187},
+							pos:        position{line: 1837, col: 28, offset: 44833},
 							val:        ".",
 							ignoreCase: false,
 							want:       "\".\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1667, col: 32, offset: 40191},
+							pos:  position{line: 1837, col: 32, offset: 44837},
 							name: "UInt",
 						},
 					},
@@ -11574,43 +12597,43 @@ var g = &grammar{
 		},
 		{
 			name: "IP6",
-			pos:  position{line: 1669, col: 1, offset: 40228},
+			pos:  position{line: 1839, col: 1, offset: 44874},
 			expr: &actionExpr{
-				pos: position{line: 1670, col: 5, offset: 40236},
+				pos: position{line: 1840, col: 5, offset: 44882},
 				run: (*parser).callonIP61,
 				expr: &seqExpr{
-					pos: position{line: 1670, col: 5, offset: 40236},
+					pos: position{line: 1840, col: 5, offset: 44882},
 					exprs: []any{
 						&notExpr{
-							pos: position{line: 1670, col: 5, offset: 40236},
+							pos: position{line: 1840, col: 5, offset: 44882},
 							expr: &seqExpr{
-								pos: position{line: 1670, col: 7, offset: 40238},
+								pos: position{line: 1840, col: 7, offset: 44884},
 								exprs: []any{
 									&ruleRefExpr{
-										pos:  position{line: 1670, col: 7, offset: 40238},
+										pos:  position{line: 1840, col: 7, offset: 44884},
 										name: "Hex",
 									},
 									&litMatcher{
-										pos:        position{line: 1670, col: 11, offset: 40242},
+										pos:        position{line: 1840, col: 11, offset: 44888},
 										val:        ":",
 										ignoreCase: false,
 										want:       "\":\"",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1670, col: 15, offset: 40246},
+										pos:  position{line: 1840, col: 15, offset: 44892},
 										name: "Hex",
 									},
 									&notExpr{
-										pos: position{line: 1670, col: 19, offset: 40250},
+										pos: position{line: 1840, col: 19, offset: 44896},
 										expr: &choiceExpr{
-											pos: position{line: 1670, col: 21, offset: 40252},
+											pos: position{line: 1840, col: 21, offset: 44898},
 											alternatives: []any{
 												&ruleRefExpr{
-													pos:  position{line: 1670, col: 21, offset: 40252},
+													pos:  position{line: 1840, col: 21, offset: 44898},
 													name: "HexDigit",
 												},
 												&litMatcher{
-													pos:        position{line: 1670, col: 32, offset: 40263},
+													pos:        position{line: 1840, col: 32, offset: 44909},
 													val:        ":",
 													ignoreCase: false,
 													want:       "\":\"",
@@ -11622,10 +12645,10 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1670, col: 38, offset: 40269},
+							pos:   position{line: 1840, col: 38, offset: 44915},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1670, col: 40, offset: 40271},
+								pos:  position{line: 1840, col: 40, offset: 44917},
 								name: "IP6Variations",
 							},
 						},
@@ -11637,32 +12660,32 @@ var g = &grammar{
 		},
 		{
 			name: "IP6Variations",
-			pos:  position{line: 1674, col: 1, offset: 40435},
+			pos:  position{line: 1844, col: 1, offset: 45081},
 			expr: &choiceExpr{
-				pos: position{line: 1675, col: 5, offset: 40453},
+				pos: position{line: 1845, col: 5, offset: 45099},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1675, col: 5, offset: 40453},
+						pos: position{line: 1845, col: 5, offset: 45099},
 						run: (*parser).callonIP6Variations2,
 						expr: &seqExpr{
-							pos: position{line: 1675, col: 5, offset: 40453},
+							pos: position{line: 1845, col: 5, offset: 45099},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1675, col: 5, offset: 40453},
+									pos:   position{line: 1845, col: 5, offset: 45099},
 									label: "a",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 1675, col: 7, offset: 40455},
+										pos: position{line: 1845, col: 7, offset: 45101},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1675, col: 7, offset: 40455},
+											pos:  position{line: 1845, col: 7, offset: 45101},
 											name: "HexColon",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1675, col: 17, offset: 40465},
+									pos:   position{line: 1845, col: 17, offset: 45111},
 									label: "b",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1675, col: 19, offset: 40467},
+										pos:  position{line: 1845, col: 19, offset: 45113},
 										name: "IP6Tail",
 									},
 								},
@@ -11670,52 +12693,52 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1678, col: 5, offset: 40531},
+						pos: position{line: 1848, col: 5, offset: 45177},
 						run: (*parser).callonIP6Variations9,
 						expr: &seqExpr{
-							pos: position{line: 1678, col: 5, offset: 40531},
+							pos: position{line: 1848, col: 5, offset: 45177},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1678, col: 5, offset: 40531},
+									pos:   position{line: 1848, col: 5, offset: 45177},
 									label: "a",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1678, col: 7, offset: 40533},
+										pos:  position{line: 1848, col: 7, offset: 45179},
 										name: "Hex",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1678, col: 11, offset: 40537},
+									pos:   position{line: 1848, col: 11, offset: 45183},
 									label: "b",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1678, col: 13, offset: 40539},
+										pos: position{line: 1848, col: 13, offset: 45185},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1678, col: 13, offset: 40539},
+											pos:  position{line: 1848, col: 13, offset: 45185},
 											name: "ColonHex",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1678, col: 23, offset: 40549},
+									pos:        position{line: 1848, col: 23, offset: 45195},
 									val:        "::",
 									ignoreCase: false,
 									want:       "\"::\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1678, col: 28, offset: 40554},
+									pos:   position{line: 1848, col: 28, offset: 45200},
 									label: "d",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1678, col: 30, offset: 40556},
+										pos: position{line: 1848, col: 30, offset: 45202},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1678, col: 30, offset: 40556},
+											pos:  position{line: 1848, col: 30, offset: 45202},
 											name: "HexColon",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1678, col: 40, offset: 40566},
+									pos:   position{line: 1848, col: 40, offset: 45212},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1678, col: 42, offset: 40568},
+										pos:  position{line: 1848, col: 42, offset: 45214},
 										name: "IP6Tail",
 									},
 								},
@@ -11723,33 +12746,33 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1681, col: 5, offset: 40667},
+						pos: position{line: 1851, col: 5, offset: 45313},
 						run: (*parser).callonIP6Variations22,
 						expr: &seqExpr{
-							pos: position{line: 1681, col: 5, offset: 40667},
+							pos: position{line: 1851, col: 5, offset: 45313},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1681, col: 5, offset: 40667},
+									pos:        position{line: 1851, col: 5, offset: 45313},
 									val:        "::",
 									ignoreCase: false,
 									want:       "\"::\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1681, col: 10, offset: 40672},
+									pos:   position{line: 1851, col: 10, offset: 45318},
 									label: "a",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1681, col: 12, offset: 40674},
+										pos: position{line: 1851, col: 12, offset: 45320},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1681, col: 12, offset: 40674},
+											pos:  position{line: 1851, col: 12, offset: 45320},
 											name: "HexColon",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1681, col: 22, offset: 40684},
+									pos:   position{line: 1851, col: 22, offset: 45330},
 									label: "b",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1681, col: 24, offset: 40686},
+										pos:  position{line: 1851, col: 24, offset: 45332},
 										name: "IP6Tail",
 									},
 								},
@@ -11757,32 +12780,32 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1684, col: 5, offset: 40757},
+						pos: position{line: 1854, col: 5, offset: 45403},
 						run: (*parser).callonIP6Variations30,
 						expr: &seqExpr{
-							pos: position{line: 1684, col: 5, offset: 40757},
+							pos: position{line: 1854, col: 5, offset: 45403},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1684, col: 5, offset: 40757},
+									pos:   position{line: 1854, col: 5, offset: 45403},
 									label: "a",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1684, col: 7, offset: 40759},
+										pos:  position{line: 1854, col: 7, offset: 45405},
 										name: "Hex",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1684, col: 11, offset: 40763},
+									pos:   position{line: 1854, col: 11, offset: 45409},
 									label: "b",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1684, col: 13, offset: 40765},
+										pos: position{line: 1854, col: 13, offset: 45411},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1684, col: 13, offset: 40765},
+											pos:  position{line: 1854, col: 13, offset: 45411},
 											name: "ColonHex",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1684, col: 23, offset: 40775},
+									pos:        position{line: 1854, col: 23, offset: 45421},
 									val:        "::",
 									ignoreCase: false,
 									want:       "\"::\"",
@@ -11791,10 +12814,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1687, col: 5, offset: 40843},
+						pos: position{line: 1857, col: 5, offset: 45489},
 						run: (*parser).callonIP6Variations38,
 						expr: &litMatcher{
-							pos:        position{line: 1687, col: 5, offset: 40843},
+							pos:        position{line: 1857, col: 5, offset: 45489},
 							val:        "::",
 							ignoreCase: false,
 							want:       "\"::\"",
@@ -11807,16 +12830,16 @@ var g = &grammar{
 		},
 		{
 			name: "IP6Tail",
-			pos:  position{line: 1691, col: 1, offset: 40880},
+			pos:  position{line: 1861, col: 1, offset: 45526},
 			expr: &choiceExpr{
-				pos: position{line: 1692, col: 5, offset: 40892},
+				pos: position{line: 1862, col: 5, offset: 45538},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1692, col: 5, offset: 40892},
+						pos:  position{line: 1862, col: 5, offset: 45538},
 						name: "IP",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1693, col: 5, offset: 40899},
+						pos:  position{line: 1863, col: 5, offset: 45545},
 						name: "Hex",
 					},
 				},
@@ -11826,24 +12849,24 @@ var g = &grammar{
 		},
 		{
 			name: "ColonHex",
-			pos:  position{line: 1695, col: 1, offset: 40904},
+			pos:  position{line: 1865, col: 1, offset: 45550},
 			expr: &actionExpr{
-				pos: position{line: 1695, col: 12, offset: 40915},
+				pos: position{line: 1865, col: 12, offset: 45561},
 				run: (*parser).callonColonHex1,
 				expr: &seqExpr{
-					pos: position{line: 1695, col: 12, offset: 40915},
+					pos: position{line: 1865, col: 12, offset: 45561},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1695, col: 12, offset: 40915},
+							pos:        position{line: 1865, col: 12, offset: 45561},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1695, col: 16, offset: 40919},
+							pos:   position{line: 1865, col: 16, offset: 45565},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1695, col: 18, offset: 40921},
+								pos:  position{line: 1865, col: 18, offset: 45567},
 								name: "Hex",
 							},
 						},
@@ -11855,23 +12878,23 @@ var g = &grammar{
 		},
 		{
 			name: "HexColon",
-			pos:  position{line: 1697, col: 1, offset: 40959},
+			pos:  position{line: 1867, col: 1, offset: 45605},
 			expr: &actionExpr{
-				pos: position{line: 1697, col: 12, offset: 40970},
+				pos: position{line: 1867, col: 12, offset: 45616},
 				run: (*parser).callonHexColon1,
 				expr: &seqExpr{
-					pos: position{line: 1697, col: 12, offset: 40970},
+					pos: position{line: 1867, col: 12, offset: 45616},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1697, col: 12, offset: 40970},
+							pos:   position{line: 1867, col: 12, offset: 45616},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1697, col: 14, offset: 40972},
+								pos:  position{line: 1867, col: 14, offset: 45618},
 								name: "Hex",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1697, col: 18, offset: 40976},
+							pos:        position{line: 1867, col: 18, offset: 45622},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
@@ -11884,32 +12907,32 @@ var g = &grammar{
 		},
 		{
 			name: "IP4Net",
-			pos:  position{line: 1699, col: 1, offset: 41014},
+			pos:  position{line: 1869, col: 1, offset: 45660},
 			expr: &actionExpr{
-				pos: position{line: 1700, col: 5, offset: 41025},
+				pos: position{line: 1870, col: 5, offset: 45671},
 				run: (*parser).callonIP4Net1,
 				expr: &seqExpr{
-					pos: position{line: 1700, col: 5, offset: 41025},
+					pos: position{line: 1870, col: 5, offset: 45671},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1700, col: 5, offset: 41025},
+							pos:   position{line: 1870, col: 5, offset: 45671},
 							label: "a",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1700, col: 7, offset: 41027},
+								pos:  position{line: 1870, col: 7, offset: 45673},
 								name: "IP",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1700, col: 10, offset: 41030},
+							pos:        position{line: 1870, col: 10, offset: 45676},
 							val:        "/",
 							ignoreCase: false,
 							want:       "\"/\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1700, col: 14, offset: 41034},
+							pos:   position{line: 1870, col: 14, offset: 45680},
 							label: "m",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1700, col: 16, offset: 41036},
+								pos:  position{line: 1870, col: 16, offset: 45682},
 								name: "UIntString",
 							},
 						},
@@ -11921,32 +12944,32 @@ var g = &grammar{
 		},
 		{
 			name: "IP6Net",
-			pos:  position{line: 1704, col: 1, offset: 41104},
+			pos:  position{line: 1874, col: 1, offset: 45750},
 			expr: &actionExpr{
-				pos: position{line: 1705, col: 5, offset: 41115},
+				pos: position{line: 1875, col: 5, offset: 45761},
 				run: (*parser).callonIP6Net1,
 				expr: &seqExpr{
-					pos: position{line: 1705, col: 5, offset: 41115},
+					pos: position{line: 1875, col: 5, offset: 45761},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1705, col: 5, offset: 41115},
+							pos:   position{line: 1875, col: 5, offset: 45761},
 							label: "a",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1705, col: 7, offset: 41117},
+								pos:  position{line: 1875, col: 7, offset: 45763},
 								name: "IP6",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1705, col: 11, offset: 41121},
+							pos:        position{line: 1875, col: 11, offset: 45767},
 							val:        "/",
 							ignoreCase: false,
 							want:       "\"/\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1705, col: 15, offset: 41125},
+							pos:   position{line: 1875, col: 15, offset: 45771},
 							label: "m",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1705, col: 17, offset: 41127},
+								pos:  position{line: 1875, col: 17, offset: 45773},
 								name: "UIntString",
 							},
 						},
@@ -11958,15 +12981,15 @@ var g = &grammar{
 		},
 		{
 			name: "UInt",
-			pos:  position{line: 1709, col: 1, offset: 41195},
+			pos:  position{line: 1879, col: 1, offset: 45841},
 			expr: &actionExpr{
-				pos: position{line: 1710, col: 4, offset: 41203},
+				pos: position{line: 1880, col: 4, offset: 45849},
 				run: (*parser).callonUInt1,
 				expr: &labeledExpr{
-					pos:   position{line: 1710, col: 4, offset: 41203},
+					pos:   position{line: 1880, col: 4, offset: 45849},
 					label: "s",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1710, col: 6, offset: 41205},
+						pos:  position{line: 1880, col: 6, offset: 45851},
 						name: "UIntString",
 					},
 				},
@@ -11976,16 +12999,16 @@ var g = &grammar{
 		},
 		{
 			name: "IntString",
-			pos:  position{line: 1712, col: 1, offset: 41245},
+			pos:  position{line: 1882, col: 1, offset: 45891},
 			expr: &choiceExpr{
-				pos: position{line: 1713, col: 5, offset: 41259},
+				pos: position{line: 1883, col: 5, offset: 45905},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1713, col: 5, offset: 41259},
+						pos:  position{line: 1883, col: 5, offset: 45905},
 						name: "UIntString",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1714, col: 5, offset: 41274},
+						pos:  position{line: 1884, col: 5, offset: 45920},
 						name: "MinusIntString",
 					},
 				},
@@ -11995,14 +13018,14 @@ var g = &grammar{
 		},
 		{
 			name: "UIntString",
-			pos:  position{line: 1716, col: 1, offset: 41290},
+			pos:  position{line: 1886, col: 1, offset: 45936},
 			expr: &actionExpr{
-				pos: position{line: 1716, col: 14, offset: 41303},
+				pos: position{line: 1886, col: 14, offset: 45949},
 				run: (*parser).callonUIntString1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1716, col: 14, offset: 41303},
+					pos: position{line: 1886, col: 14, offset: 45949},
 					expr: &charClassMatcher{
-						pos:        position{line: 1716, col: 14, offset: 41303},
+						pos:        position{line: 1886, col: 14, offset: 45949},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -12015,21 +13038,21 @@ var g = &grammar{
 		},
 		{
 			name: "MinusIntString",
-			pos:  position{line: 1718, col: 1, offset: 41342},
+			pos:  position{line: 1888, col: 1, offset: 45988},
 			expr: &actionExpr{
-				pos: position{line: 1719, col: 5, offset: 41361},
+				pos: position{line: 1889, col: 5, offset: 46007},
 				run: (*parser).callonMinusIntString1,
 				expr: &seqExpr{
-					pos: position{line: 1719, col: 5, offset: 41361},
+					pos: position{line: 1889, col: 5, offset: 46007},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1719, col: 5, offset: 41361},
+							pos:        position{line: 1889, col: 5, offset: 46007},
 							val:        "-",
 							ignoreCase: false,
 							want:       "\"-\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1719, col: 9, offset: 41365},
+							pos:  position{line: 1889, col: 9, offset: 46011},
 							name: "UIntString",
 						},
 					},
@@ -12040,29 +13063,29 @@ var g = &grammar{
 		},
 		{
 			name: "FloatString",
-			pos:  position{line: 1721, col: 1, offset: 41408},
+			pos:  position{line: 1891, col: 1, offset: 46054},
 			expr: &choiceExpr{
-				pos: position{line: 1722, col: 5, offset: 41424},
+				pos: position{line: 1892, col: 5, offset: 46070},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1722, col: 5, offset: 41424},
+						pos: position{line: 1892, col: 5, offset: 46070},
 						run: (*parser).callonFloatString2,
 						expr: &seqExpr{
-							pos: position{line: 1722, col: 5, offset: 41424},
+							pos: position{line: 1892, col: 5, offset: 46070},
 							exprs: []any{
 								&zeroOrOneExpr{
-									pos: position{line: 1722, col: 5, offset: 41424},
+									pos: position{line: 1892, col: 5, offset: 46070},
 									expr: &litMatcher{
-										pos:        position{line: 1722, col: 5, offset: 41424},
+										pos:        position{line: 1892, col: 5, offset: 46070},
 										val:        "-",
 										ignoreCase: false,
 										want:       "\"-\"",
 									},
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 1722, col: 10, offset: 41429},
+									pos: position{line: 1892, col: 10, offset: 46075},
 									expr: &charClassMatcher{
-										pos:        position{line: 1722, col: 10, offset: 41429},
+										pos:        position{line: 1892, col: 10, offset: 46075},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
@@ -12070,15 +13093,15 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1722, col: 17, offset: 41436},
+									pos:        position{line: 1892, col: 17, offset: 46082},
 									val:        ".",
 									ignoreCase: false,
 									want:       "\".\"",
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 1722, col: 21, offset: 41440},
+									pos: position{line: 1892, col: 21, offset: 46086},
 									expr: &charClassMatcher{
-										pos:        position{line: 1722, col: 21, offset: 41440},
+										pos:        position{line: 1892, col: 21, offset: 46086},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
@@ -12086,9 +13109,9 @@ var g = &grammar{
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 1722, col: 28, offset: 41447},
+									pos: position{line: 1892, col: 28, offset: 46093},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1722, col: 28, offset: 41447},
+										pos:  position{line: 1892, col: 28, offset: 46093},
 										name: "ExponentPart",
 									},
 								},
@@ -12096,30 +13119,30 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1723, col: 5, offset: 41496},
+						pos: position{line: 1893, col: 5, offset: 46142},
 						run: (*parser).callonFloatString13,
 						expr: &seqExpr{
-							pos: position{line: 1723, col: 5, offset: 41496},
+							pos: position{line: 1893, col: 5, offset: 46142},
 							exprs: []any{
 								&zeroOrOneExpr{
-									pos: position{line: 1723, col: 5, offset: 41496},
+									pos: position{line: 1893, col: 5, offset: 46142},
 									expr: &litMatcher{
-										pos:        position{line: 1723, col: 5, offset: 41496},
+										pos:        position{line: 1893, col: 5, offset: 46142},
 										val:        "-",
 										ignoreCase: false,
 										want:       "\"-\"",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1723, col: 10, offset: 41501},
+									pos:        position{line: 1893, col: 10, offset: 46147},
 									val:        ".",
 									ignoreCase: false,
 									want:       "\".\"",
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 1723, col: 14, offset: 41505},
+									pos: position{line: 1893, col: 14, offset: 46151},
 									expr: &charClassMatcher{
-										pos:        position{line: 1723, col: 14, offset: 41505},
+										pos:        position{line: 1893, col: 14, offset: 46151},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
@@ -12127,9 +13150,9 @@ var g = &grammar{
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 1723, col: 21, offset: 41512},
+									pos: position{line: 1893, col: 21, offset: 46158},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1723, col: 21, offset: 41512},
+										pos:  position{line: 1893, col: 21, offset: 46158},
 										name: "ExponentPart",
 									},
 								},
@@ -12137,17 +13160,17 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1724, col: 5, offset: 41561},
+						pos: position{line: 1894, col: 5, offset: 46207},
 						run: (*parser).callonFloatString22,
 						expr: &choiceExpr{
-							pos: position{line: 1724, col: 6, offset: 41562},
+							pos: position{line: 1894, col: 6, offset: 46208},
 							alternatives: []any{
 								&ruleRefExpr{
-									pos:  position{line: 1724, col: 6, offset: 41562},
+									pos:  position{line: 1894, col: 6, offset: 46208},
 									name: "NaN",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1724, col: 12, offset: 41568},
+									pos:  position{line: 1894, col: 12, offset: 46214},
 									name: "Infinity",
 								},
 							},
@@ -12160,20 +13183,20 @@ var g = &grammar{
 		},
 		{
 			name: "ExponentPart",
-			pos:  position{line: 1727, col: 1, offset: 41611},
+			pos:  position{line: 1897, col: 1, offset: 46257},
 			expr: &seqExpr{
-				pos: position{line: 1727, col: 16, offset: 41626},
+				pos: position{line: 1897, col: 16, offset: 46272},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 1727, col: 16, offset: 41626},
+						pos:        position{line: 1897, col: 16, offset: 46272},
 						val:        "e",
 						ignoreCase: true,
 						want:       "\"e\"i",
 					},
 					&zeroOrOneExpr{
-						pos: position{line: 1727, col: 21, offset: 41631},
+						pos: position{line: 1897, col: 21, offset: 46277},
 						expr: &charClassMatcher{
-							pos:        position{line: 1727, col: 21, offset: 41631},
+							pos:        position{line: 1897, col: 21, offset: 46277},
 							val:        "[+-]",
 							chars:      []rune{'+', '-'},
 							ignoreCase: false,
@@ -12181,7 +13204,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1727, col: 27, offset: 41637},
+						pos:  position{line: 1897, col: 27, offset: 46283},
 						name: "UIntString",
 					},
 				},
@@ -12191,9 +13214,9 @@ var g = &grammar{
 		},
 		{
 			name: "NaN",
-			pos:  position{line: 1729, col: 1, offset: 41649},
+			pos:  position{line: 1899, col: 1, offset: 46295},
 			expr: &litMatcher{
-				pos:        position{line: 1729, col: 7, offset: 41655},
+				pos:        position{line: 1899, col: 7, offset: 46301},
 				val:        "NaN",
 				ignoreCase: false,
 				want:       "\"NaN\"",
@@ -12203,23 +13226,23 @@ var g = &grammar{
 		},
 		{
 			name: "Infinity",
-			pos:  position{line: 1731, col: 1, offset: 41662},
+			pos:  position{line: 1901, col: 1, offset: 46308},
 			expr: &seqExpr{
-				pos: position{line: 1731, col: 12, offset: 41673},
+				pos: position{line: 1901, col: 12, offset: 46319},
 				exprs: []any{
 					&zeroOrOneExpr{
-						pos: position{line: 1731, col: 12, offset: 41673},
+						pos: position{line: 1901, col: 12, offset: 46319},
 						expr: &choiceExpr{
-							pos: position{line: 1731, col: 13, offset: 41674},
+							pos: position{line: 1901, col: 13, offset: 46320},
 							alternatives: []any{
 								&litMatcher{
-									pos:        position{line: 1731, col: 13, offset: 41674},
+									pos:        position{line: 1901, col: 13, offset: 46320},
 									val:        "-",
 									ignoreCase: false,
 									want:       "\"-\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1731, col: 19, offset: 41680},
+									pos:        position{line: 1901, col: 19, offset: 46326},
 									val:        "+",
 									ignoreCase: false,
 									want:       "\"+\"",
@@ -12228,7 +13251,7 @@ var g = &grammar{
 						},
 					},
 					&litMatcher{
-						pos:        position{line: 1731, col: 25, offset: 41686},
+						pos:        position{line: 1901, col: 25, offset: 46332},
 						val:        "Inf",
 						ignoreCase: false,
 						want:       "\"Inf\"",
@@ -12240,14 +13263,14 @@ var g = &grammar{
 		},
 		{
 			name: "Hex",
-			pos:  position{line: 1733, col: 1, offset: 41693},
+			pos:  position{line: 1903, col: 1, offset: 46339},
 			expr: &actionExpr{
-				pos: position{line: 1733, col: 7, offset: 41699},
+				pos: position{line: 1903, col: 7, offset: 46345},
 				run: (*parser).callonHex1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1733, col: 7, offset: 41699},
+					pos: position{line: 1903, col: 7, offset: 46345},
 					expr: &ruleRefExpr{
-						pos:  position{line: 1733, col: 7, offset: 41699},
+						pos:  position{line: 1903, col: 7, offset: 46345},
 						name: "HexDigit",
 					},
 				},
@@ -12257,9 +13280,9 @@ var g = &grammar{
 		},
 		{
 			name: "HexDigit",
-			pos:  position{line: 1735, col: 1, offset: 41741},
+			pos:  position{line: 1905, col: 1, offset: 46387},
 			expr: &charClassMatcher{
-				pos:        position{line: 1735, col: 12, offset: 41752},
+				pos:        position{line: 1905, col: 12, offset: 46398},
 				val:        "[0-9a-fA-F]",
 				ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
 				ignoreCase: false,
@@ -12270,32 +13293,32 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuotedString",
-			pos:  position{line: 1737, col: 1, offset: 41765},
+			pos:  position{line: 1907, col: 1, offset: 46411},
 			expr: &actionExpr{
-				pos: position{line: 1738, col: 5, offset: 41788},
+				pos: position{line: 1908, col: 5, offset: 46434},
 				run: (*parser).callonSingleQuotedString1,
 				expr: &seqExpr{
-					pos: position{line: 1738, col: 5, offset: 41788},
+					pos: position{line: 1908, col: 5, offset: 46434},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1738, col: 5, offset: 41788},
+							pos:        position{line: 1908, col: 5, offset: 46434},
 							val:        "'",
 							ignoreCase: false,
 							want:       "\"'\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1738, col: 9, offset: 41792},
+							pos:   position{line: 1908, col: 9, offset: 46438},
 							label: "v",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1738, col: 11, offset: 41794},
+								pos: position{line: 1908, col: 11, offset: 46440},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1738, col: 11, offset: 41794},
+									pos:  position{line: 1908, col: 11, offset: 46440},
 									name: "SingleQuotedChar",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1738, col: 29, offset: 41812},
+							pos:        position{line: 1908, col: 29, offset: 46458},
 							val:        "'",
 							ignoreCase: false,
 							want:       "\"'\"",
@@ -12308,32 +13331,32 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuotedString",
-			pos:  position{line: 1740, col: 1, offset: 41846},
+			pos:  position{line: 1910, col: 1, offset: 46492},
 			expr: &actionExpr{
-				pos: position{line: 1741, col: 5, offset: 41869},
+				pos: position{line: 1911, col: 5, offset: 46515},
 				run: (*parser).callonDoubleQuotedString1,
 				expr: &seqExpr{
-					pos: position{line: 1741, col: 5, offset: 41869},
+					pos: position{line: 1911, col: 5, offset: 46515},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1741, col: 5, offset: 41869},
+							pos:        position{line: 1911, col: 5, offset: 46515},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1741, col: 9, offset: 41873},
+							pos:   position{line: 1911, col: 9, offset: 46519},
 							label: "v",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1741, col: 11, offset: 41875},
+								pos: position{line: 1911, col: 11, offset: 46521},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1741, col: 11, offset: 41875},
+									pos:  position{line: 1911, col: 11, offset: 46521},
 									name: "DoubleQuotedChar",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1741, col: 29, offset: 41893},
+							pos:        position{line: 1911, col: 29, offset: 46539},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
@@ -12346,57 +13369,57 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuotedChar",
-			pos:  position{line: 1743, col: 1, offset: 41927},
+			pos:  position{line: 1913, col: 1, offset: 46573},
 			expr: &choiceExpr{
-				pos: position{line: 1744, col: 5, offset: 41948},
+				pos: position{line: 1914, col: 5, offset: 46594},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1744, col: 5, offset: 41948},
+						pos: position{line: 1914, col: 5, offset: 46594},
 						run: (*parser).callonDoubleQuotedChar2,
 						expr: &seqExpr{
-							pos: position{line: 1744, col: 5, offset: 41948},
+							pos: position{line: 1914, col: 5, offset: 46594},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1744, col: 5, offset: 41948},
+									pos: position{line: 1914, col: 5, offset: 46594},
 									expr: &choiceExpr{
-										pos: position{line: 1744, col: 7, offset: 41950},
+										pos: position{line: 1914, col: 7, offset: 46596},
 										alternatives: []any{
 											&litMatcher{
-												pos:        position{line: 1744, col: 7, offset: 41950},
+												pos:        position{line: 1914, col: 7, offset: 46596},
 												val:        "\"",
 												ignoreCase: false,
 												want:       "\"\\\"\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1744, col: 13, offset: 41956},
+												pos:  position{line: 1914, col: 13, offset: 46602},
 												name: "EscapedChar",
 											},
 										},
 									},
 								},
 								&anyMatcher{
-									line: 1744, col: 26, offset: 41969,
+									line: 1914, col: 26, offset: 46615,
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1745, col: 5, offset: 42006},
+						pos: position{line: 1915, col: 5, offset: 46652},
 						run: (*parser).callonDoubleQuotedChar9,
 						expr: &seqExpr{
-							pos: position{line: 1745, col: 5, offset: 42006},
+							pos: position{line: 1915, col: 5, offset: 46652},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1745, col: 5, offset: 42006},
+									pos:        position{line: 1915, col: 5, offset: 46652},
 									val:        "\\",
 									ignoreCase: false,
 									want:       "\"\\\\\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1745, col: 10, offset: 42011},
+									pos:   position{line: 1915, col: 10, offset: 46657},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1745, col: 12, offset: 42013},
+										pos:  position{line: 1915, col: 12, offset: 46659},
 										name: "EscapeSequence",
 									},
 								},
@@ -12410,32 +13433,32 @@ var g = &grammar{
 		},
 		{
 			name: "BacktickString",
-			pos:  position{line: 1747, col: 1, offset: 42047},
+			pos:  position{line: 1917, col: 1, offset: 46693},
 			expr: &actionExpr{
-				pos: position{line: 1748, col: 5, offset: 42066},
+				pos: position{line: 1918, col: 5, offset: 46712},
 				run: (*parser).callonBacktickString1,
 				expr: &seqExpr{
-					pos: position{line: 1748, col: 5, offset: 42066},
+					pos: position{line: 1918, col: 5, offset: 46712},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1748, col: 5, offset: 42066},
+							pos:        position{line: 1918, col: 5, offset: 46712},
 							val:        "`",
 							ignoreCase: false,
 							want:       "\"`\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1748, col: 9, offset: 42070},
+							pos:   position{line: 1918, col: 9, offset: 46716},
 							label: "v",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1748, col: 11, offset: 42072},
+								pos: position{line: 1918, col: 11, offset: 46718},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1748, col: 11, offset: 42072},
+									pos:  position{line: 1918, col: 11, offset: 46718},
 									name: "BacktickChar",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1748, col: 25, offset: 42086},
+							pos:        position{line: 1918, col: 25, offset: 46732},
 							val:        "`",
 							ignoreCase: false,
 							want:       "\"`\"",
@@ -12448,57 +13471,57 @@ var g = &grammar{
 		},
 		{
 			name: "BacktickChar",
-			pos:  position{line: 1750, col: 1, offset: 42120},
+			pos:  position{line: 1920, col: 1, offset: 46766},
 			expr: &choiceExpr{
-				pos: position{line: 1751, col: 5, offset: 42137},
+				pos: position{line: 1921, col: 5, offset: 46783},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1751, col: 5, offset: 42137},
+						pos: position{line: 1921, col: 5, offset: 46783},
 						run: (*parser).callonBacktickChar2,
 						expr: &seqExpr{
-							pos: position{line: 1751, col: 5, offset: 42137},
+							pos: position{line: 1921, col: 5, offset: 46783},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1751, col: 5, offset: 42137},
+									pos: position{line: 1921, col: 5, offset: 46783},
 									expr: &choiceExpr{
-										pos: position{line: 1751, col: 7, offset: 42139},
+										pos: position{line: 1921, col: 7, offset: 46785},
 										alternatives: []any{
 											&litMatcher{
-												pos:        position{line: 1751, col: 7, offset: 42139},
+												pos:        position{line: 1921, col: 7, offset: 46785},
 												val:        "`",
 												ignoreCase: false,
 												want:       "\"`\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1751, col: 13, offset: 42145},
+												pos:  position{line: 1921, col: 13, offset: 46791},
 												name: "EscapedChar",
 											},
 										},
 									},
 								},
 								&anyMatcher{
-									line: 1751, col: 26, offset: 42158,
+									line: 1921, col: 26, offset: 46804,
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1752, col: 5, offset: 42195},
+						pos: position{line: 1922, col: 5, offset: 46841},
 						run: (*parser).callonBacktickChar9,
 						expr: &seqExpr{
-							pos: position{line: 1752, col: 5, offset: 42195},
+							pos: position{line: 1922, col: 5, offset: 46841},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1752, col: 5, offset: 42195},
+									pos:        position{line: 1922, col: 5, offset: 46841},
 									val:        "\\",
 									ignoreCase: false,
 									want:       "\"\\\\\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1752, col: 10, offset: 42200},
+									pos:   position{line: 1922, col: 10, offset: 46846},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1752, col: 12, offset: 42202},
+										pos:  position{line: 1922, col: 12, offset: 46848},
 										name: "EscapeSequence",
 									},
 								},
@@ -12512,28 +13535,28 @@ var g = &grammar{
 		},
 		{
 			name: "KeyWord",
-			pos:  position{line: 1754, col: 1, offset: 42236},
+			pos:  position{line: 1924, col: 1, offset: 46882},
 			expr: &actionExpr{
-				pos: position{line: 1755, col: 5, offset: 42248},
+				pos: position{line: 1925, col: 5, offset: 46894},
 				run: (*parser).callonKeyWord1,
 				expr: &seqExpr{
-					pos: position{line: 1755, col: 5, offset: 42248},
+					pos: position{line: 1925, col: 5, offset: 46894},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1755, col: 5, offset: 42248},
+							pos:   position{line: 1925, col: 5, offset: 46894},
 							label: "head",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1755, col: 10, offset: 42253},
+								pos:  position{line: 1925, col: 10, offset: 46899},
 								name: "KeyWordStart",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1755, col: 23, offset: 42266},
+							pos:   position{line: 1925, col: 23, offset: 46912},
 							label: "tail",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1755, col: 28, offset: 42271},
+								pos: position{line: 1925, col: 28, offset: 46917},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1755, col: 28, offset: 42271},
+									pos:  position{line: 1925, col: 28, offset: 46917},
 									name: "KeyWordRest",
 								},
 							},
@@ -12546,16 +13569,16 @@ var g = &grammar{
 		},
 		{
 			name: "KeyWordStart",
-			pos:  position{line: 1757, col: 1, offset: 42333},
+			pos:  position{line: 1927, col: 1, offset: 46979},
 			expr: &choiceExpr{
-				pos: position{line: 1758, col: 5, offset: 42350},
+				pos: position{line: 1928, col: 5, offset: 46996},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1758, col: 5, offset: 42350},
+						pos:  position{line: 1928, col: 5, offset: 46996},
 						name: "KeyWordChars",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1759, col: 5, offset: 42367},
+						pos:  position{line: 1929, col: 5, offset: 47013},
 						name: "KeyWordEsc",
 					},
 				},
@@ -12565,16 +13588,16 @@ var g = &grammar{
 		},
 		{
 			name: "KeyWordRest",
-			pos:  position{line: 1761, col: 1, offset: 42379},
+			pos:  position{line: 1931, col: 1, offset: 47025},
 			expr: &choiceExpr{
-				pos: position{line: 1762, col: 5, offset: 42395},
+				pos: position{line: 1932, col: 5, offset: 47041},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1762, col: 5, offset: 42395},
+						pos:  position{line: 1932, col: 5, offset: 47041},
 						name: "KeyWordStart",
 					},
 					&charClassMatcher{
-						pos:        position{line: 1763, col: 5, offset: 42412},
+						pos:        position{line: 1933, col: 5, offset: 47058},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -12587,19 +13610,19 @@ var g = &grammar{
 		},
 		{
 			name: "KeyWordChars",
-			pos:  position{line: 1765, col: 1, offset: 42419},
+			pos:  position{line: 1935, col: 1, offset: 47065},
 			expr: &actionExpr{
-				pos: position{line: 1765, col: 16, offset: 42434},
+				pos: position{line: 1935, col: 16, offset: 47080},
 				run: (*parser).callonKeyWordChars1,
 				expr: &choiceExpr{
-					pos: position{line: 1765, col: 17, offset: 42435},
+					pos: position{line: 1935, col: 17, offset: 47081},
 					alternatives: []any{
 						&ruleRefExpr{
-							pos:  position{line: 1765, col: 17, offset: 42435},
+							pos:  position{line: 1935, col: 17, offset: 47081},
 							name: "UnicodeLetter",
 						},
 						&charClassMatcher{
-							pos:        position{line: 1765, col: 33, offset: 42451},
+							pos:        position{line: 1935, col: 33, offset: 47097},
 							val:        "[_.:/%#@~]",
 							chars:      []rune{'_', '.', ':', '/', '%', '#', '@', '~'},
 							ignoreCase: false,
@@ -12613,31 +13636,31 @@ var g = &grammar{
 		},
 		{
 			name: "KeyWordEsc",
-			pos:  position{line: 1767, col: 1, offset: 42495},
+			pos:  position{line: 1937, col: 1, offset: 47141},
 			expr: &actionExpr{
-				pos: position{line: 1767, col: 14, offset: 42508},
+				pos: position{line: 1937, col: 14, offset: 47154},
 				run: (*parser).callonKeyWordEsc1,
 				expr: &seqExpr{
-					pos: position{line: 1767, col: 14, offset: 42508},
+					pos: position{line: 1937, col: 14, offset: 47154},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1767, col: 14, offset: 42508},
+							pos:        position{line: 1937, col: 14, offset: 47154},
 							val:        "\\",
 							ignoreCase: false,
 							want:       "\"\\\\\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1767, col: 19, offset: 42513},
+							pos:   position{line: 1937, col: 19, offset: 47159},
 							label: "s",
 							expr: &choiceExpr{
-								pos: position{line: 1767, col: 22, offset: 42516},
+								pos: position{line: 1937, col: 22, offset: 47162},
 								alternatives: []any{
 									&ruleRefExpr{
-										pos:  position{line: 1767, col: 22, offset: 42516},
+										pos:  position{line: 1937, col: 22, offset: 47162},
 										name: "KeywordEscape",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1767, col: 38, offset: 42532},
+										pos:  position{line: 1937, col: 38, offset: 47178},
 										name: "EscapeSequence",
 									},
 								},
@@ -12651,42 +13674,42 @@ var g = &grammar{
 		},
 		{
 			name: "GlobPattern",
-			pos:  position{line: 1769, col: 1, offset: 42567},
+			pos:  position{line: 1939, col: 1, offset: 47213},
 			expr: &actionExpr{
-				pos: position{line: 1770, col: 5, offset: 42583},
+				pos: position{line: 1940, col: 5, offset: 47229},
 				run: (*parser).callonGlobPattern1,
 				expr: &seqExpr{
-					pos: position{line: 1770, col: 5, offset: 42583},
+					pos: position{line: 1940, col: 5, offset: 47229},
 					exprs: []any{
 						&andExpr{
-							pos: position{line: 1770, col: 5, offset: 42583},
+							pos: position{line: 1940, col: 5, offset: 47229},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1770, col: 6, offset: 42584},
+								pos:  position{line: 1940, col: 6, offset: 47230},
 								name: "GlobProperStart",
 							},
 						},
 						&andExpr{
-							pos: position{line: 1770, col: 22, offset: 42600},
+							pos: position{line: 1940, col: 22, offset: 47246},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1770, col: 23, offset: 42601},
+								pos:  position{line: 1940, col: 23, offset: 47247},
 								name: "GlobHasStar",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1770, col: 35, offset: 42613},
+							pos:   position{line: 1940, col: 35, offset: 47259},
 							label: "head",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1770, col: 40, offset: 42618},
+								pos:  position{line: 1940, col: 40, offset: 47264},
 								name: "GlobStart",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1770, col: 50, offset: 42628},
+							pos:   position{line: 1940, col: 50, offset: 47274},
 							label: "tail",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1770, col: 55, offset: 42633},
+								pos: position{line: 1940, col: 55, offset: 47279},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1770, col: 55, offset: 42633},
+									pos:  position{line: 1940, col: 55, offset: 47279},
 									name: "GlobRest",
 								},
 							},
@@ -12699,28 +13722,28 @@ var g = &grammar{
 		},
 		{
 			name: "GlobProperStart",
-			pos:  position{line: 1774, col: 1, offset: 42702},
+			pos:  position{line: 1944, col: 1, offset: 47348},
 			expr: &choiceExpr{
-				pos: position{line: 1774, col: 19, offset: 42720},
+				pos: position{line: 1944, col: 19, offset: 47366},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1774, col: 19, offset: 42720},
+						pos:  position{line: 1944, col: 19, offset: 47366},
 						name: "KeyWordStart",
 					},
 					&seqExpr{
-						pos: position{line: 1774, col: 34, offset: 42735},
+						pos: position{line: 1944, col: 34, offset: 47381},
 						exprs: []any{
 							&oneOrMoreExpr{
-								pos: position{line: 1774, col: 34, offset: 42735},
+								pos: position{line: 1944, col: 34, offset: 47381},
 								expr: &litMatcher{
-									pos:        position{line: 1774, col: 34, offset: 42735},
+									pos:        position{line: 1944, col: 34, offset: 47381},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1774, col: 39, offset: 42740},
+								pos:  position{line: 1944, col: 39, offset: 47386},
 								name: "KeyWordRest",
 							},
 						},
@@ -12732,19 +13755,19 @@ var g = &grammar{
 		},
 		{
 			name: "GlobHasStar",
-			pos:  position{line: 1775, col: 1, offset: 42752},
+			pos:  position{line: 1945, col: 1, offset: 47398},
 			expr: &seqExpr{
-				pos: position{line: 1775, col: 15, offset: 42766},
+				pos: position{line: 1945, col: 15, offset: 47412},
 				exprs: []any{
 					&zeroOrMoreExpr{
-						pos: position{line: 1775, col: 15, offset: 42766},
+						pos: position{line: 1945, col: 15, offset: 47412},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1775, col: 15, offset: 42766},
+							pos:  position{line: 1945, col: 15, offset: 47412},
 							name: "KeyWordRest",
 						},
 					},
 					&litMatcher{
-						pos:        position{line: 1775, col: 28, offset: 42779},
+						pos:        position{line: 1945, col: 28, offset: 47425},
 						val:        "*",
 						ignoreCase: false,
 						want:       "\"*\"",
@@ -12756,23 +13779,23 @@ var g = &grammar{
 		},
 		{
 			name: "GlobStart",
-			pos:  position{line: 1777, col: 1, offset: 42784},
+			pos:  position{line: 1947, col: 1, offset: 47430},
 			expr: &choiceExpr{
-				pos: position{line: 1778, col: 5, offset: 42798},
+				pos: position{line: 1948, col: 5, offset: 47444},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1778, col: 5, offset: 42798},
+						pos:  position{line: 1948, col: 5, offset: 47444},
 						name: "KeyWordChars",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1779, col: 5, offset: 42815},
+						pos:  position{line: 1949, col: 5, offset: 47461},
 						name: "GlobEsc",
 					},
 					&actionExpr{
-						pos: position{line: 1780, col: 5, offset: 42827},
+						pos: position{line: 1950, col: 5, offset: 47473},
 						run: (*parser).callonGlobStart4,
 						expr: &litMatcher{
-							pos:        position{line: 1780, col: 5, offset: 42827},
+							pos:        position{line: 1950, col: 5, offset: 47473},
 							val:        "*",
 							ignoreCase: false,
 							want:       "\"*\"",
@@ -12785,16 +13808,16 @@ var g = &grammar{
 		},
 		{
 			name: "GlobRest",
-			pos:  position{line: 1782, col: 1, offset: 42852},
+			pos:  position{line: 1952, col: 1, offset: 47498},
 			expr: &choiceExpr{
-				pos: position{line: 1783, col: 5, offset: 42865},
+				pos: position{line: 1953, col: 5, offset: 47511},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1783, col: 5, offset: 42865},
+						pos:  position{line: 1953, col: 5, offset: 47511},
 						name: "GlobStart",
 					},
 					&charClassMatcher{
-						pos:        position{line: 1784, col: 5, offset: 42879},
+						pos:        position{line: 1954, col: 5, offset: 47525},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -12807,31 +13830,31 @@ var g = &grammar{
 		},
 		{
 			name: "GlobEsc",
-			pos:  position{line: 1786, col: 1, offset: 42886},
+			pos:  position{line: 1956, col: 1, offset: 47532},
 			expr: &actionExpr{
-				pos: position{line: 1786, col: 11, offset: 42896},
+				pos: position{line: 1956, col: 11, offset: 47542},
 				run: (*parser).callonGlobEsc1,
 				expr: &seqExpr{
-					pos: position{line: 1786, col: 11, offset: 42896},
+					pos: position{line: 1956, col: 11, offset: 47542},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1786, col: 11, offset: 42896},
+							pos:        position{line: 1956, col: 11, offset: 47542},
 							val:        "\\",
 							ignoreCase: false,
 							want:       "\"\\\\\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1786, col: 16, offset: 42901},
+							pos:   position{line: 1956, col: 16, offset: 47547},
 							label: "s",
 							expr: &choiceExpr{
-								pos: position{line: 1786, col: 19, offset: 42904},
+								pos: position{line: 1956, col: 19, offset: 47550},
 								alternatives: []any{
 									&ruleRefExpr{
-										pos:  position{line: 1786, col: 19, offset: 42904},
+										pos:  position{line: 1956, col: 19, offset: 47550},
 										name: "GlobEscape",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1786, col: 32, offset: 42917},
+										pos:  position{line: 1956, col: 32, offset: 47563},
 										name: "EscapeSequence",
 									},
 								},
@@ -12845,32 +13868,32 @@ var g = &grammar{
 		},
 		{
 			name: "GlobEscape",
-			pos:  position{line: 1788, col: 1, offset: 42952},
+			pos:  position{line: 1958, col: 1, offset: 47598},
 			expr: &choiceExpr{
-				pos: position{line: 1789, col: 5, offset: 42967},
+				pos: position{line: 1959, col: 5, offset: 47613},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1789, col: 5, offset: 42967},
+						pos: position{line: 1959, col: 5, offset: 47613},
 						run: (*parser).callonGlobEscape2,
 						expr: &litMatcher{
-							pos:        position{line: 1789, col: 5, offset: 42967},
+							pos:        position{line: 1959, col: 5, offset: 47613},
 							val:        "=",
 							ignoreCase: false,
 							want:       "\"=\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1790, col: 5, offset: 42995},
+						pos: position{line: 1960, col: 5, offset: 47641},
 						run: (*parser).callonGlobEscape4,
 						expr: &litMatcher{
-							pos:        position{line: 1790, col: 5, offset: 42995},
+							pos:        position{line: 1960, col: 5, offset: 47641},
 							val:        "*",
 							ignoreCase: false,
 							want:       "\"*\"",
 						},
 					},
 					&charClassMatcher{
-						pos:        position{line: 1791, col: 5, offset: 43025},
+						pos:        position{line: 1961, col: 5, offset: 47671},
 						val:        "[+-]",
 						chars:      []rune{'+', '-'},
 						ignoreCase: false,
@@ -12883,57 +13906,57 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuotedChar",
-			pos:  position{line: 1793, col: 1, offset: 43031},
+			pos:  position{line: 1963, col: 1, offset: 47677},
 			expr: &choiceExpr{
-				pos: position{line: 1794, col: 5, offset: 43052},
+				pos: position{line: 1964, col: 5, offset: 47698},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1794, col: 5, offset: 43052},
+						pos: position{line: 1964, col: 5, offset: 47698},
 						run: (*parser).callonSingleQuotedChar2,
 						expr: &seqExpr{
-							pos: position{line: 1794, col: 5, offset: 43052},
+							pos: position{line: 1964, col: 5, offset: 47698},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1794, col: 5, offset: 43052},
+									pos: position{line: 1964, col: 5, offset: 47698},
 									expr: &choiceExpr{
-										pos: position{line: 1794, col: 7, offset: 43054},
+										pos: position{line: 1964, col: 7, offset: 47700},
 										alternatives: []any{
 											&litMatcher{
-												pos:        position{line: 1794, col: 7, offset: 43054},
+												pos:        position{line: 1964, col: 7, offset: 47700},
 												val:        "'",
 												ignoreCase: false,
 												want:       "\"'\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1794, col: 13, offset: 43060},
+												pos:  position{line: 1964, col: 13, offset: 47706},
 												name: "EscapedChar",
 											},
 										},
 									},
 								},
 								&anyMatcher{
-									line: 1794, col: 26, offset: 43073,
+									line: 1964, col: 26, offset: 47719,
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1795, col: 5, offset: 43110},
+						pos: position{line: 1965, col: 5, offset: 47756},
 						run: (*parser).callonSingleQuotedChar9,
 						expr: &seqExpr{
-							pos: position{line: 1795, col: 5, offset: 43110},
+							pos: position{line: 1965, col: 5, offset: 47756},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1795, col: 5, offset: 43110},
+									pos:        position{line: 1965, col: 5, offset: 47756},
 									val:        "\\",
 									ignoreCase: false,
 									want:       "\"\\\\\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1795, col: 10, offset: 43115},
+									pos:   position{line: 1965, col: 10, offset: 47761},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1795, col: 12, offset: 43117},
+										pos:  position{line: 1965, col: 12, offset: 47763},
 										name: "EscapeSequence",
 									},
 								},
@@ -12947,16 +13970,16 @@ var g = &grammar{
 		},
 		{
 			name: "EscapeSequence",
-			pos:  position{line: 1797, col: 1, offset: 43151},
+			pos:  position{line: 1967, col: 1, offset: 47797},
 			expr: &choiceExpr{
-				pos: position{line: 1798, col: 5, offset: 43170},
+				pos: position{line: 1968, col: 5, offset: 47816},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1798, col: 5, offset: 43170},
+						pos:  position{line: 1968, col: 5, offset: 47816},
 						name: "SingleCharEscape",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1799, col: 5, offset: 43191},
+						pos:  position{line: 1969, col: 5, offset: 47837},
 						name: "UnicodeEscape",
 					},
 				},
@@ -12966,87 +13989,87 @@ var g = &grammar{
 		},
 		{
 			name: "SingleCharEscape",
-			pos:  position{line: 1801, col: 1, offset: 43206},
+			pos:  position{line: 1971, col: 1, offset: 47852},
 			expr: &choiceExpr{
-				pos: position{line: 1802, col: 5, offset: 43227},
+				pos: position{line: 1972, col: 5, offset: 47873},
 				alternatives: []any{
 					&litMatcher{
-						pos:        position{line: 1802, col: 5, offset: 43227},
+						pos:        position{line: 1972, col: 5, offset: 47873},
 						val:        "'",
 						ignoreCase: false,
 						want:       "\"'\"",
 					},
 					&actionExpr{
-						pos: position{line: 1803, col: 5, offset: 43235},
+						pos: position{line: 1973, col: 5, offset: 47881},
 						run: (*parser).callonSingleCharEscape3,
 						expr: &litMatcher{
-							pos:        position{line: 1803, col: 5, offset: 43235},
+							pos:        position{line: 1973, col: 5, offset: 47881},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
 						},
 					},
 					&litMatcher{
-						pos:        position{line: 1804, col: 5, offset: 43275},
+						pos:        position{line: 1974, col: 5, offset: 47921},
 						val:        "\\",
 						ignoreCase: false,
 						want:       "\"\\\\\"",
 					},
 					&actionExpr{
-						pos: position{line: 1805, col: 5, offset: 43284},
+						pos: position{line: 1975, col: 5, offset: 47930},
 						run: (*parser).callonSingleCharEscape6,
 						expr: &litMatcher{
-							pos:        position{line: 1805, col: 5, offset: 43284},
+							pos:        position{line: 1975, col: 5, offset: 47930},
 							val:        "b",
 							ignoreCase: false,
 							want:       "\"b\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1806, col: 5, offset: 43313},
+						pos: position{line: 1976, col: 5, offset: 47959},
 						run: (*parser).callonSingleCharEscape8,
 						expr: &litMatcher{
-							pos:        position{line: 1806, col: 5, offset: 43313},
+							pos:        position{line: 1976, col: 5, offset: 47959},
 							val:        "f",
 							ignoreCase: false,
 							want:       "\"f\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1807, col: 5, offset: 43342},
+						pos: position{line: 1977, col: 5, offset: 47988},
 						run: (*parser).callonSingleCharEscape10,
 						expr: &litMatcher{
-							pos:        position{line: 1807, col: 5, offset: 43342},
+							pos:        position{line: 1977, col: 5, offset: 47988},
 							val:        "n",
 							ignoreCase: false,
 							want:       "\"n\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1808, col: 5, offset: 43371},
+						pos: position{line: 1978, col: 5, offset: 48017},
 						run: (*parser).callonSingleCharEscape12,
 						expr: &litMatcher{
-							pos:        position{line: 1808, col: 5, offset: 43371},
+							pos:        position{line: 1978, col: 5, offset: 48017},
 							val:        "r",
 							ignoreCase: false,
 							want:       "\"r\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1809, col: 5, offset: 43400},
+						pos: position{line: 1979, col: 5, offset: 48046},
 						run: (*parser).callonSingleCharEscape14,
 						expr: &litMatcher{
-							pos:        position{line: 1809, col: 5, offset: 43400},
+							pos:        position{line: 1979, col: 5, offset: 48046},
 							val:        "t",
 							ignoreCase: false,
 							want:       "\"t\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1810, col: 5, offset: 43429},
+						pos: position{line: 1980, col: 5, offset: 48075},
 						run: (*parser).callonSingleCharEscape16,
 						expr: &litMatcher{
-							pos:        position{line: 1810, col: 5, offset: 43429},
+							pos:        position{line: 1980, col: 5, offset: 48075},
 							val:        "v",
 							ignoreCase: false,
 							want:       "\"v\"",
@@ -13059,32 +14082,32 @@ var g = &grammar{
 		},
 		{
 			name: "KeywordEscape",
-			pos:  position{line: 1812, col: 1, offset: 43455},
+			pos:  position{line: 1982, col: 1, offset: 48101},
 			expr: &choiceExpr{
-				pos: position{line: 1813, col: 5, offset: 43473},
+				pos: position{line: 1983, col: 5, offset: 48119},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1813, col: 5, offset: 43473},
+						pos: position{line: 1983, col: 5, offset: 48119},
 						run: (*parser).callonKeywordEscape2,
 						expr: &litMatcher{
-							pos:        position{line: 1813, col: 5, offset: 43473},
+							pos:        position{line: 1983, col: 5, offset: 48119},
 							val:        "=",
 							ignoreCase: false,
 							want:       "\"=\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1814, col: 5, offset: 43501},
+						pos: position{line: 1984, col: 5, offset: 48147},
 						run: (*parser).callonKeywordEscape4,
 						expr: &litMatcher{
-							pos:        position{line: 1814, col: 5, offset: 43501},
+							pos:        position{line: 1984, col: 5, offset: 48147},
 							val:        "*",
 							ignoreCase: false,
 							want:       "\"*\"",
 						},
 					},
 					&charClassMatcher{
-						pos:        position{line: 1815, col: 5, offset: 43529},
+						pos:        position{line: 1985, col: 5, offset: 48175},
 						val:        "[+-]",
 						chars:      []rune{'+', '-'},
 						ignoreCase: false,
@@ -13097,42 +14120,42 @@ var g = &grammar{
 		},
 		{
 			name: "UnicodeEscape",
-			pos:  position{line: 1817, col: 1, offset: 43535},
+			pos:  position{line: 1987, col: 1, offset: 48181},
 			expr: &choiceExpr{
-				pos: position{line: 1818, col: 5, offset: 43553},
+				pos: position{line: 1988, col: 5, offset: 48199},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1818, col: 5, offset: 43553},
+						pos: position{line: 1988, col: 5, offset: 48199},
 						run: (*parser).callonUnicodeEscape2,
 						expr: &seqExpr{
-							pos: position{line: 1818, col: 5, offset: 43553},
+							pos: position{line: 1988, col: 5, offset: 48199},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1818, col: 5, offset: 43553},
+									pos:        position{line: 1988, col: 5, offset: 48199},
 									val:        "u",
 									ignoreCase: false,
 									want:       "\"u\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1818, col: 9, offset: 43557},
+									pos:   position{line: 1988, col: 9, offset: 48203},
 									label: "chars",
 									expr: &seqExpr{
-										pos: position{line: 1818, col: 16, offset: 43564},
+										pos: position{line: 1988, col: 16, offset: 48210},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1818, col: 16, offset: 43564},
+												pos:  position{line: 1988, col: 16, offset: 48210},
 												name: "HexDigit",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1818, col: 25, offset: 43573},
+												pos:  position{line: 1988, col: 25, offset: 48219},
 												name: "HexDigit",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1818, col: 34, offset: 43582},
+												pos:  position{line: 1988, col: 34, offset: 48228},
 												name: "HexDigit",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1818, col: 43, offset: 43591},
+												pos:  position{line: 1988, col: 43, offset: 48237},
 												name: "HexDigit",
 											},
 										},
@@ -13142,65 +14165,65 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1821, col: 5, offset: 43654},
+						pos: position{line: 1991, col: 5, offset: 48300},
 						run: (*parser).callonUnicodeEscape11,
 						expr: &seqExpr{
-							pos: position{line: 1821, col: 5, offset: 43654},
+							pos: position{line: 1991, col: 5, offset: 48300},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1821, col: 5, offset: 43654},
+									pos:        position{line: 1991, col: 5, offset: 48300},
 									val:        "u",
 									ignoreCase: false,
 									want:       "\"u\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1821, col: 9, offset: 43658},
+									pos:        position{line: 1991, col: 9, offset: 48304},
 									val:        "{",
 									ignoreCase: false,
 									want:       "\"{\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1821, col: 13, offset: 43662},
+									pos:   position{line: 1991, col: 13, offset: 48308},
 									label: "chars",
 									expr: &seqExpr{
-										pos: position{line: 1821, col: 20, offset: 43669},
+										pos: position{line: 1991, col: 20, offset: 48315},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1821, col: 20, offset: 43669},
+												pos:  position{line: 1991, col: 20, offset: 48315},
 												name: "HexDigit",
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 1821, col: 29, offset: 43678},
+												pos: position{line: 1991, col: 29, offset: 48324},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1821, col: 29, offset: 43678},
+													pos:  position{line: 1991, col: 29, offset: 48324},
 													name: "HexDigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 1821, col: 39, offset: 43688},
+												pos: position{line: 1991, col: 39, offset: 48334},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1821, col: 39, offset: 43688},
+													pos:  position{line: 1991, col: 39, offset: 48334},
 													name: "HexDigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 1821, col: 49, offset: 43698},
+												pos: position{line: 1991, col: 49, offset: 48344},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1821, col: 49, offset: 43698},
+													pos:  position{line: 1991, col: 49, offset: 48344},
 													name: "HexDigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 1821, col: 59, offset: 43708},
+												pos: position{line: 1991, col: 59, offset: 48354},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1821, col: 59, offset: 43708},
+													pos:  position{line: 1991, col: 59, offset: 48354},
 													name: "HexDigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 1821, col: 69, offset: 43718},
+												pos: position{line: 1991, col: 69, offset: 48364},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1821, col: 69, offset: 43718},
+													pos:  position{line: 1991, col: 69, offset: 48364},
 													name: "HexDigit",
 												},
 											},
@@ -13208,7 +14231,7 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1821, col: 80, offset: 43729},
+									pos:        position{line: 1991, col: 80, offset: 48375},
 									val:        "}",
 									ignoreCase: false,
 									want:       "\"}\"",
@@ -13223,37 +14246,37 @@ var g = &grammar{
 		},
 		{
 			name: "RegexpPattern",
-			pos:  position{line: 1825, col: 1, offset: 43783},
+			pos:  position{line: 1995, col: 1, offset: 48429},
 			expr: &actionExpr{
-				pos: position{line: 1826, col: 5, offset: 43801},
+				pos: position{line: 1996, col: 5, offset: 48447},
 				run: (*parser).callonRegexpPattern1,
 				expr: &seqExpr{
-					pos: position{line: 1826, col: 5, offset: 43801},
+					pos: position{line: 1996, col: 5, offset: 48447},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1826, col: 5, offset: 43801},
+							pos:        position{line: 1996, col: 5, offset: 48447},
 							val:        "/",
 							ignoreCase: false,
 							want:       "\"/\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1826, col: 9, offset: 43805},
+							pos:   position{line: 1996, col: 9, offset: 48451},
 							label: "body",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1826, col: 14, offset: 43810},
+								pos:  position{line: 1996, col: 14, offset: 48456},
 								name: "RegexpBody",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1826, col: 25, offset: 43821},
+							pos:        position{line: 1996, col: 25, offset: 48467},
 							val:        "/",
 							ignoreCase: false,
 							want:       "\"/\"",
 						},
 						&notExpr{
-							pos: position{line: 1826, col: 29, offset: 43825},
+							pos: position{line: 1996, col: 29, offset: 48471},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1826, col: 30, offset: 43826},
+								pos:  position{line: 1996, col: 30, offset: 48472},
 								name: "KeyWordStart",
 							},
 						},
@@ -13265,33 +14288,33 @@ var g = &grammar{
 		},
 		{
 			name: "RegexpBody",
-			pos:  position{line: 1828, col: 1, offset: 43861},
+			pos:  position{line: 1998, col: 1, offset: 48507},
 			expr: &actionExpr{
-				pos: position{line: 1829, col: 5, offset: 43876},
+				pos: position{line: 1999, col: 5, offset: 48522},
 				run: (*parser).callonRegexpBody1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1829, col: 5, offset: 43876},
+					pos: position{line: 1999, col: 5, offset: 48522},
 					expr: &choiceExpr{
-						pos: position{line: 1829, col: 6, offset: 43877},
+						pos: position{line: 1999, col: 6, offset: 48523},
 						alternatives: []any{
 							&charClassMatcher{
-								pos:        position{line: 1829, col: 6, offset: 43877},
+								pos:        position{line: 1999, col: 6, offset: 48523},
 								val:        "[^/\\\\]",
 								chars:      []rune{'/', '\\'},
 								ignoreCase: false,
 								inverted:   true,
 							},
 							&seqExpr{
-								pos: position{line: 1829, col: 15, offset: 43886},
+								pos: position{line: 1999, col: 15, offset: 48532},
 								exprs: []any{
 									&litMatcher{
-										pos:        position{line: 1829, col: 15, offset: 43886},
+										pos:        position{line: 1999, col: 15, offset: 48532},
 										val:        "\\",
 										ignoreCase: false,
 										want:       "\"\\\\\"",
 									},
 									&anyMatcher{
-										line: 1829, col: 20, offset: 43891,
+										line: 1999, col: 20, offset: 48537,
 									},
 								},
 							},
@@ -13304,9 +14327,9 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedChar",
-			pos:  position{line: 1831, col: 1, offset: 43927},
+			pos:  position{line: 2001, col: 1, offset: 48573},
 			expr: &charClassMatcher{
-				pos:        position{line: 1832, col: 5, offset: 43943},
+				pos:        position{line: 2002, col: 5, offset: 48589},
 				val:        "[\\x00-\\x1f\\\\]",
 				chars:      []rune{'\\'},
 				ranges:     []rune{'\x00', '\x1f'},
@@ -13318,11 +14341,11 @@ var g = &grammar{
 		},
 		{
 			name: "_",
-			pos:  position{line: 1834, col: 1, offset: 43958},
+			pos:  position{line: 2004, col: 1, offset: 48604},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 1834, col: 5, offset: 43962},
+				pos: position{line: 2004, col: 5, offset: 48608},
 				expr: &ruleRefExpr{
-					pos:  position{line: 1834, col: 5, offset: 43962},
+					pos:  position{line: 2004, col: 5, offset: 48608},
 					name: "AnySpace",
 				},
 			},
@@ -13331,11 +14354,11 @@ var g = &grammar{
 		},
 		{
 			name: "__",
-			pos:  position{line: 1836, col: 1, offset: 43973},
+			pos:  position{line: 2006, col: 1, offset: 48619},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 1836, col: 6, offset: 43978},
+				pos: position{line: 2006, col: 6, offset: 48624},
 				expr: &ruleRefExpr{
-					pos:  position{line: 1836, col: 6, offset: 43978},
+					pos:  position{line: 2006, col: 6, offset: 48624},
 					name: "AnySpace",
 				},
 			},
@@ -13344,20 +14367,20 @@ var g = &grammar{
 		},
 		{
 			name: "AnySpace",
-			pos:  position{line: 1838, col: 1, offset: 43989},
+			pos:  position{line: 2008, col: 1, offset: 48635},
 			expr: &choiceExpr{
-				pos: position{line: 1839, col: 5, offset: 44002},
+				pos: position{line: 2009, col: 5, offset: 48648},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1839, col: 5, offset: 44002},
+						pos:  position{line: 2009, col: 5, offset: 48648},
 						name: "WhiteSpace",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1840, col: 5, offset: 44017},
+						pos:  position{line: 2010, col: 5, offset: 48663},
 						name: "LineTerminator",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1841, col: 5, offset: 44036},
+						pos:  position{line: 2011, col: 5, offset: 48682},
 						name: "Comment",
 					},
 				},
@@ -13367,32 +14390,32 @@ var g = &grammar{
 		},
 		{
 			name: "UnicodeLetter",
-			pos:  position{line: 1843, col: 1, offset: 44045},
+			pos:  position{line: 2013, col: 1, offset: 48691},
 			expr: &choiceExpr{
-				pos: position{line: 1844, col: 5, offset: 44063},
+				pos: position{line: 2014, col: 5, offset: 48709},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1844, col: 5, offset: 44063},
+						pos:  position{line: 2014, col: 5, offset: 48709},
 						name: "Lu",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1845, col: 5, offset: 44070},
+						pos:  position{line: 2015, col: 5, offset: 48716},
 						name: "Ll",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1846, col: 5, offset: 44077},
+						pos:  position{line: 2016, col: 5, offset: 48723},
 						name: "Lt",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1847, col: 5, offset: 44084},
+						pos:  position{line: 2017, col: 5, offset: 48730},
 						name: "Lm",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1848, col: 5, offset: 44091},
+						pos:  position{line: 2018, col: 5, offset: 48737},
 						name: "Lo",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1849, col: 5, offset: 44098},
+						pos:  position{line: 2019, col: 5, offset: 48744},
 						name: "Nl",
 					},
 				},
@@ -13402,16 +14425,16 @@ var g = &grammar{
 		},
 		{
 			name: "UnicodeCombiningMark",
-			pos:  position{line: 1851, col: 1, offset: 44102},
+			pos:  position{line: 2021, col: 1, offset: 48748},
 			expr: &choiceExpr{
-				pos: position{line: 1852, col: 5, offset: 44127},
+				pos: position{line: 2022, col: 5, offset: 48773},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1852, col: 5, offset: 44127},
+						pos:  position{line: 2022, col: 5, offset: 48773},
 						name: "Mn",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1853, col: 5, offset: 44134},
+						pos:  position{line: 2023, col: 5, offset: 48780},
 						name: "Mc",
 					},
 				},
@@ -13421,9 +14444,9 @@ var g = &grammar{
 		},
 		{
 			name: "UnicodeDigit",
-			pos:  position{line: 1855, col: 1, offset: 44138},
+			pos:  position{line: 2025, col: 1, offset: 48784},
 			expr: &ruleRefExpr{
-				pos:  position{line: 1856, col: 5, offset: 44155},
+				pos:  position{line: 2026, col: 5, offset: 48801},
 				name: "Nd",
 			},
 			leader:        false,
@@ -13431,9 +14454,9 @@ var g = &grammar{
 		},
 		{
 			name: "UnicodeConnectorPunctuation",
-			pos:  position{line: 1858, col: 1, offset: 44159},
+			pos:  position{line: 2028, col: 1, offset: 48805},
 			expr: &ruleRefExpr{
-				pos:  position{line: 1859, col: 5, offset: 44191},
+				pos:  position{line: 2029, col: 5, offset: 48837},
 				name: "Pc",
 			},
 			leader:        false,
@@ -13441,9 +14464,9 @@ var g = &grammar{
 		},
 		{
 			name: "Ll",
-			pos:  position{line: 1865, col: 1, offset: 44372},
+			pos:  position{line: 2035, col: 1, offset: 49018},
 			expr: &charClassMatcher{
-				pos:        position{line: 1865, col: 6, offset: 44377},
+				pos:        position{line: 2035, col: 6, offset: 49023},
 				val:        "[\\u0061-\\u007A\\u00B5\\u00DF-\\u00F6\\u00F8-\\u00FF\\u0101\\u0103\\u0105\\u0107\\u0109\\u010B\\u010D\\u010F\\u0111\\u0113\\u0115\\u0117\\u0119\\u011B\\u011D\\u011F\\u0121\\u0123\\u0125\\u0127\\u0129\\u012B\\u012D\\u012F\\u0131\\u0133\\u0135\\u0137-\\u0138\\u013A\\u013C\\u013E\\u0140\\u0142\\u0144\\u0146\\u0148-\\u0149\\u014B\\u014D\\u014F\\u0151\\u0153\\u0155\\u0157\\u0159\\u015B\\u015D\\u015F\\u0161\\u0163\\u0165\\u0167\\u0169\\u016B\\u016D\\u016F\\u0171\\u0173\\u0175\\u0177\\u017A\\u017C\\u017E-\\u0180\\u0183\\u0185\\u0188\\u018C-\\u018D\\u0192\\u0195\\u0199-\\u019B\\u019E\\u01A1\\u01A3\\u01A5\\u01A8\\u01AA-\\u01AB\\u01AD\\u01B0\\u01B4\\u01B6\\u01B9-\\u01BA\\u01BD-\\u01BF\\u01C6\\u01C9\\u01CC\\u01CE\\u01D0\\u01D2\\u01D4\\u01D6\\u01D8\\u01DA\\u01DC-\\u01DD\\u01DF\\u01E1\\u01E3\\u01E5\\u01E7\\u01E9\\u01EB\\u01ED\\u01EF-\\u01F0\\u01F3\\u01F5\\u01F9\\u01FB\\u01FD\\u01FF\\u0201\\u0203\\u0205\\u0207\\u0209\\u020B\\u020D\\u020F\\u0211\\u0213\\u0215\\u0217\\u0219\\u021B\\u021D\\u021F\\u0221\\u0223\\u0225\\u0227\\u0229\\u022B\\u022D\\u022F\\u0231\\u0233-\\u0239\\u023C\\u023F-\\u0240\\u0242\\u0247\\u0249\\u024B\\u024D\\u024F-\\u0293\\u0295-\\u02AF\\u0371\\u0373\\u0377\\u037B-\\u037D\\u0390\\u03AC-\\u03CE\\u03D0-\\u03D1\\u03D5-\\u03D7\\u03D9\\u03DB\\u03DD\\u03DF\\u03E1\\u03E3\\u03E5\\u03E7\\u03E9\\u03EB\\u03ED\\u03EF-\\u03F3\\u03F5\\u03F8\\u03FB-\\u03FC\\u0430-\\u045F\\u0461\\u0463\\u0465\\u0467\\u0469\\u046B\\u046D\\u046F\\u0471\\u0473\\u0475\\u0477\\u0479\\u047B\\u047D\\u047F\\u0481\\u048B\\u048D\\u048F\\u0491\\u0493\\u0495\\u0497\\u0499\\u049B\\u049D\\u049F\\u04A1\\u04A3\\u04A5\\u04A7\\u04A9\\u04AB\\u04AD\\u04AF\\u04B1\\u04B3\\u04B5\\u04B7\\u04B9\\u04BB\\u04BD\\u04BF\\u04C2\\u04C4\\u04C6\\u04C8\\u04CA\\u04CC\\u04CE-\\u04CF\\u04D1\\u04D3\\u04D5\\u04D7\\u04D9\\u04DB\\u04DD\\u04DF\\u04E1\\u04E3\\u04E5\\u04E7\\u04E9\\u04EB\\u04ED\\u04EF\\u04F1\\u04F3\\u04F5\\u04F7\\u04F9\\u04FB\\u04FD\\u04FF\\u0501\\u0503\\u0505\\u0507\\u0509\\u050B\\u050D\\u050F\\u0511\\u0513\\u0515\\u0517\\u0519\\u051B\\u051D\\u051F\\u0521\\u0523\\u0525\\u0527\\u0529\\u052B\\u052D\\u052F\\u0560-\\u0588\\u10D0-\\u10FA\\u10FD-\\u10FF\\u13F8-\\u13FD\\u1C80-\\u1C88\\u1D00-\\u1D2B\\u1D6B-\\u1D77\\u1D79-\\u1D9A\\u1E01\\u1E03\\u1E05\\u1E07\\u1E09\\u1E0B\\u1E0D\\u1E0F\\u1E11\\u1E13\\u1E15\\u1E17\\u1E19\\u1E1B\\u1E1D\\u1E1F\\u1E21\\u1E23\\u1E25\\u1E27\\u1E29\\u1E2B\\u1E2D\\u1E2F\\u1E31\\u1E33\\u1E35\\u1E37\\u1E39\\u1E3B\\u1E3D\\u1E3F\\u1E41\\u1E43\\u1E45\\u1E47\\u1E49\\u1E4B\\u1E4D\\u1E4F\\u1E51\\u1E53\\u1E55\\u1E57\\u1E59\\u1E5B\\u1E5D\\u1E5F\\u1E61\\u1E63\\u1E65\\u1E67\\u1E69\\u1E6B\\u1E6D\\u1E6F\\u1E71\\u1E73\\u1E75\\u1E77\\u1E79\\u1E7B\\u1E7D\\u1E7F\\u1E81\\u1E83\\u1E85\\u1E87\\u1E89\\u1E8B\\u1E8D\\u1E8F\\u1E91\\u1E93\\u1E95-\\u1E9D\\u1E9F\\u1EA1\\u1EA3\\u1EA5\\u1EA7\\u1EA9\\u1EAB\\u1EAD\\u1EAF\\u1EB1\\u1EB3\\u1EB5\\u1EB7\\u1EB9\\u1EBB\\u1EBD\\u1EBF\\u1EC1\\u1EC3\\u1EC5\\u1EC7\\u1EC9\\u1ECB\\u1ECD\\u1ECF\\u1ED1\\u1ED3\\u1ED5\\u1ED7\\u1ED9\\u1EDB\\u1EDD\\u1EDF\\u1EE1\\u1EE3\\u1EE5\\u1EE7\\u1EE9\\u1EEB\\u1EED\\u1EEF\\u1EF1\\u1EF3\\u1EF5\\u1EF7\\u1EF9\\u1EFB\\u1EFD\\u1EFF-\\u1F07\\u1F10-\\u1F15\\u1F20-\\u1F27\\u1F30-\\u1F37\\u1F40-\\u1F45\\u1F50-\\u1F57\\u1F60-\\u1F67\\u1F70-\\u1F7D\\u1F80-\\u1F87\\u1F90-\\u1F97\\u1FA0-\\u1FA7\\u1FB0-\\u1FB4\\u1FB6-\\u1FB7\\u1FBE\\u1FC2-\\u1FC4\\u1FC6-\\u1FC7\\u1FD0-\\u1FD3\\u1FD6-\\u1FD7\\u1FE0-\\u1FE7\\u1FF2-\\u1FF4\\u1FF6-\\u1FF7\\u210A\\u210E-\\u210F\\u2113\\u212F\\u2134\\u2139\\u213C-\\u213D\\u2146-\\u2149\\u214E\\u2184\\u2C30-\\u2C5E\\u2C61\\u2C65-\\u2C66\\u2C68\\u2C6A\\u2C6C\\u2C71\\u2C73-\\u2C74\\u2C76-\\u2C7B\\u2C81\\u2C83\\u2C85\\u2C87\\u2C89\\u2C8B\\u2C8D\\u2C8F\\u2C91\\u2C93\\u2C95\\u2C97\\u2C99\\u2C9B\\u2C9D\\u2C9F\\u2CA1\\u2CA3\\u2CA5\\u2CA7\\u2CA9\\u2CAB\\u2CAD\\u2CAF\\u2CB1\\u2CB3\\u2CB5\\u2CB7\\u2CB9\\u2CBB\\u2CBD\\u2CBF\\u2CC1\\u2CC3\\u2CC5\\u2CC7\\u2CC9\\u2CCB\\u2CCD\\u2CCF\\u2CD1\\u2CD3\\u2CD5\\u2CD7\\u2CD9\\u2CDB\\u2CDD\\u2CDF\\u2CE1\\u2CE3-\\u2CE4\\u2CEC\\u2CEE\\u2CF3\\u2D00-\\u2D25\\u2D27\\u2D2D\\uA641\\uA643\\uA645\\uA647\\uA649\\uA64B\\uA64D\\uA64F\\uA651\\uA653\\uA655\\uA657\\uA659\\uA65B\\uA65D\\uA65F\\uA661\\uA663\\uA665\\uA667\\uA669\\uA66B\\uA66D\\uA681\\uA683\\uA685\\uA687\\uA689\\uA68B\\uA68D\\uA68F\\uA691\\uA693\\uA695\\uA697\\uA699\\uA69B\\uA723\\uA725\\uA727\\uA729\\uA72B\\uA72D\\uA72F-\\uA731\\uA733\\uA735\\uA737\\uA739\\uA73B\\uA73D\\uA73F\\uA741\\uA743\\uA745\\uA747\\uA749\\uA74B\\uA74D\\uA74F\\uA751\\uA753\\uA755\\uA757\\uA759\\uA75B\\uA75D\\uA75F\\uA761\\uA763\\uA765\\uA767\\uA769\\uA76B\\uA76D\\uA76F\\uA771-\\uA778\\uA77A\\uA77C\\uA77F\\uA781\\uA783\\uA785\\uA787\\uA78C\\uA78E\\uA791\\uA793-\\uA795\\uA797\\uA799\\uA79B\\uA79D\\uA79F\\uA7A1\\uA7A3\\uA7A5\\uA7A7\\uA7A9\\uA7AF\\uA7B5\\uA7B7\\uA7B9\\uA7FA\\uAB30-\\uAB5A\\uAB60-\\uAB65\\uAB70-\\uABBF\\uFB00-\\uFB06\\uFB13-\\uFB17\\uFF41-\\uFF5A]",
 				chars:      []rune{'µ', 'ā', 'ă', 'ą', 'ć', 'ĉ', 'ċ', 'č', 'ď', 'đ', 'ē', 'ĕ', 'ė', 'ę', 'ě', 'ĝ', 'ğ', 'ġ', 'ģ', 'ĥ', 'ħ', 'ĩ', 'ī', 'ĭ', 'į', 'ı', 'ĳ', 'ĵ', 'ĺ', 'ļ', 'ľ', 'ŀ', 'ł', 'ń', 'ņ', 'ŋ', 'ō', 'ŏ', 'ő', 'œ', 'ŕ', 'ŗ', 'ř', 'ś', 'ŝ', 'ş', 'š', 'ţ', 'ť', 'ŧ', 'ũ', 'ū', 'ŭ', 'ů', 'ű', 'ų', 'ŵ', 'ŷ', 'ź', 'ż', 'ƃ', 'ƅ', 'ƈ', 'ƒ', 'ƕ', 'ƞ', 'ơ', 'ƣ', 'ƥ', 'ƨ', 'ƭ', 'ư', 'ƴ', 'ƶ', 'ǆ', 'ǉ', 'ǌ', 'ǎ', 'ǐ', 'ǒ', 'ǔ', 'ǖ', 'ǘ', 'ǚ', 'ǟ', 'ǡ', 'ǣ', 'ǥ', 'ǧ', 'ǩ', 'ǫ', 'ǭ', 'ǳ', 'ǵ', 'ǹ', 'ǻ', 'ǽ', 'ǿ', 'ȁ', 'ȃ', 'ȅ', 'ȇ', 'ȉ', 'ȋ', 'ȍ', 'ȏ', 'ȑ', 'ȓ', 'ȕ', 'ȗ', 'ș', 'ț', 'ȝ', 'ȟ', 'ȡ', 'ȣ', 'ȥ', 'ȧ', 'ȩ', 'ȫ', 'ȭ', 'ȯ', 'ȱ', 'ȼ', 'ɂ', 'ɇ', 'ɉ', 'ɋ', 'ɍ', 'ͱ', 'ͳ', 'ͷ', 'ΐ', 'ϙ', 'ϛ', 'ϝ', 'ϟ', 'ϡ', 'ϣ', 'ϥ', 'ϧ', 'ϩ', 'ϫ', 'ϭ', 'ϵ', 'ϸ', 'ѡ', 'ѣ', 'ѥ', 'ѧ', 'ѩ', 'ѫ', 'ѭ', 'ѯ', 'ѱ', 'ѳ', 'ѵ', 'ѷ', 'ѹ', 'ѻ', 'ѽ', 'ѿ', 'ҁ', 'ҋ', 'ҍ', 'ҏ', 'ґ', 'ғ', 'ҕ', 'җ', 'ҙ', 'қ', 'ҝ', 'ҟ', 'ҡ', 'ң', 'ҥ', 'ҧ', 'ҩ', 'ҫ', 'ҭ', 'ү', 'ұ', 'ҳ', 'ҵ', 'ҷ', 'ҹ', 'һ', 'ҽ', 'ҿ', 'ӂ', 'ӄ', 'ӆ', 'ӈ', 'ӊ', 'ӌ', 'ӑ', 'ӓ', 'ӕ', 'ӗ', 'ә', 'ӛ', 'ӝ', 'ӟ', 'ӡ', 'ӣ', 'ӥ', 'ӧ', 'ө', 'ӫ', 'ӭ', 'ӯ', 'ӱ', 'ӳ', 'ӵ', 'ӷ', 'ӹ', 'ӻ', 'ӽ', 'ӿ', 'ԁ', 'ԃ', 'ԅ', 'ԇ', 'ԉ', 'ԋ', 'ԍ', 'ԏ', 'ԑ', 'ԓ', 'ԕ', 'ԗ', 'ԙ', 'ԛ', 'ԝ', 'ԟ', 'ԡ', 'ԣ', 'ԥ', 'ԧ', 'ԩ', 'ԫ', 'ԭ', 'ԯ', 'ḁ', 'ḃ', 'ḅ', 'ḇ', 'ḉ', 'ḋ', 'ḍ', 'ḏ', 'ḑ', 'ḓ', 'ḕ', 'ḗ', 'ḙ', 'ḛ', 'ḝ', 'ḟ', 'ḡ', 'ḣ', 'ḥ', 'ḧ', 'ḩ', 'ḫ', 'ḭ', 'ḯ', 'ḱ', 'ḳ', 'ḵ', 'ḷ', 'ḹ', 'ḻ', 'ḽ', 'ḿ', 'ṁ', 'ṃ', 'ṅ', 'ṇ', 'ṉ', 'ṋ', 'ṍ', 'ṏ', 'ṑ', 'ṓ', 'ṕ', 'ṗ', 'ṙ', 'ṛ', 'ṝ', 'ṟ', 'ṡ', 'ṣ', 'ṥ', 'ṧ', 'ṩ', 'ṫ', 'ṭ', 'ṯ', 'ṱ', 'ṳ', 'ṵ', 'ṷ', 'ṹ', 'ṻ', 'ṽ', 'ṿ', 'ẁ', 'ẃ', 'ẅ', 'ẇ', 'ẉ', 'ẋ', 'ẍ', 'ẏ', 'ẑ', 'ẓ', 'ẟ', 'ạ', 'ả', 'ấ', 'ầ', 'ẩ', 'ẫ', 'ậ', 'ắ', 'ằ', 'ẳ', 'ẵ', 'ặ', 'ẹ', 'ẻ', 'ẽ', 'ế', 'ề', 'ể', 'ễ', 'ệ', 'ỉ', 'ị', 'ọ', 'ỏ', 'ố', 'ồ', 'ổ', 'ỗ', 'ộ', 'ớ', 'ờ', 'ở', 'ỡ', 'ợ', 'ụ', 'ủ', 'ứ', 'ừ', 'ử', 'ữ', 'ự', 'ỳ', 'ỵ', 'ỷ', 'ỹ', 'ỻ', 'ỽ', 'ι', 'ℊ', 'ℓ', 'ℯ', 'ℴ', 'ℹ', 'ⅎ', 'ↄ', 'ⱡ', 'ⱨ', 'ⱪ', 'ⱬ', 'ⱱ', 'ⲁ', 'ⲃ', 'ⲅ', 'ⲇ', 'ⲉ', 'ⲋ', 'ⲍ', 'ⲏ', 'ⲑ', 'ⲓ', 'ⲕ', 'ⲗ', 'ⲙ', 'ⲛ', 'ⲝ', 'ⲟ', 'ⲡ', 'ⲣ', 'ⲥ', 'ⲧ', 'ⲩ', 'ⲫ', 'ⲭ', 'ⲯ', 'ⲱ', 'ⲳ', 'ⲵ', 'ⲷ', 'ⲹ', 'ⲻ', 'ⲽ', 'ⲿ', 'ⳁ', 'ⳃ', 'ⳅ', 'ⳇ', 'ⳉ', 'ⳋ', 'ⳍ', 'ⳏ', 'ⳑ', 'ⳓ', 'ⳕ', 'ⳗ', 'ⳙ', 'ⳛ', 'ⳝ', 'ⳟ', 'ⳡ', 'ⳬ', 'ⳮ', 'ⳳ', 'ⴧ', 'ⴭ', 'ꙁ', 'ꙃ', 'ꙅ', 'ꙇ', 'ꙉ', 'ꙋ', 'ꙍ', 'ꙏ', 'ꙑ', 'ꙓ', 'ꙕ', 'ꙗ', 'ꙙ', 'ꙛ', 'ꙝ', 'ꙟ', 'ꙡ', 'ꙣ', 'ꙥ', 'ꙧ', 'ꙩ', 'ꙫ', 'ꙭ', 'ꚁ', 'ꚃ', 'ꚅ', 'ꚇ', 'ꚉ', 'ꚋ', 'ꚍ', 'ꚏ', 'ꚑ', 'ꚓ', 'ꚕ', 'ꚗ', 'ꚙ', 'ꚛ', 'ꜣ', 'ꜥ', 'ꜧ', 'ꜩ', 'ꜫ', 'ꜭ', 'ꜳ', 'ꜵ', 'ꜷ', 'ꜹ', 'ꜻ', 'ꜽ', 'ꜿ', 'ꝁ', 'ꝃ', 'ꝅ', 'ꝇ', 'ꝉ', 'ꝋ', 'ꝍ', 'ꝏ', 'ꝑ', 'ꝓ', 'ꝕ', 'ꝗ', 'ꝙ', 'ꝛ', 'ꝝ', 'ꝟ', 'ꝡ', 'ꝣ', 'ꝥ', 'ꝧ', 'ꝩ', 'ꝫ', 'ꝭ', 'ꝯ', 'ꝺ', 'ꝼ', 'ꝿ', 'ꞁ', 'ꞃ', 'ꞅ', 'ꞇ', 'ꞌ', 'ꞎ', 'ꞑ', 'ꞗ', 'ꞙ', 'ꞛ', 'ꞝ', 'ꞟ', 'ꞡ', 'ꞣ', 'ꞥ', 'ꞧ', 'ꞩ', 'ꞯ', 'ꞵ', 'ꞷ', 'ꞹ', 'ꟺ'},
 				ranges:     []rune{'a', 'z', 'ß', 'ö', 'ø', 'ÿ', 'ķ', 'ĸ', 'ň', 'ŉ', 'ž', 'ƀ', 'ƌ', 'ƍ', 'ƙ', 'ƛ', 'ƪ', 'ƫ', 'ƹ', 'ƺ', 'ƽ', 'ƿ', 'ǜ', 'ǝ', 'ǯ', 'ǰ', 'ȳ', 'ȹ', 'ȿ', 'ɀ', 'ɏ', 'ʓ', 'ʕ', 'ʯ', 'ͻ', 'ͽ', 'ά', 'ώ', 'ϐ', 'ϑ', 'ϕ', 'ϗ', 'ϯ', 'ϳ', 'ϻ', 'ϼ', 'а', 'џ', 'ӎ', 'ӏ', 'ՠ', 'ֈ', 'ა', 'ჺ', 'ჽ', 'ჿ', 'ᏸ', 'ᏽ', 'ᲀ', 'ᲈ', 'ᴀ', 'ᴫ', 'ᵫ', 'ᵷ', 'ᵹ', 'ᶚ', 'ẕ', 'ẝ', 'ỿ', 'ἇ', 'ἐ', 'ἕ', 'ἠ', 'ἧ', 'ἰ', 'ἷ', 'ὀ', 'ὅ', 'ὐ', 'ὗ', 'ὠ', 'ὧ', 'ὰ', 'ώ', 'ᾀ', 'ᾇ', 'ᾐ', 'ᾗ', 'ᾠ', 'ᾧ', 'ᾰ', 'ᾴ', 'ᾶ', 'ᾷ', 'ῂ', 'ῄ', 'ῆ', 'ῇ', 'ῐ', 'ΐ', 'ῖ', 'ῗ', 'ῠ', 'ῧ', 'ῲ', 'ῴ', 'ῶ', 'ῷ', 'ℎ', 'ℏ', 'ℼ', 'ℽ', 'ⅆ', 'ⅉ', 'ⰰ', 'ⱞ', 'ⱥ', 'ⱦ', 'ⱳ', 'ⱴ', 'ⱶ', 'ⱻ', 'ⳣ', 'ⳤ', 'ⴀ', 'ⴥ', 'ꜯ', 'ꜱ', 'ꝱ', 'ꝸ', 'ꞓ', 'ꞕ', 'ꬰ', 'ꭚ', 'ꭠ', 'ꭥ', 'ꭰ', 'ꮿ', 'ﬀ', 'ﬆ', 'ﬓ', 'ﬗ', 'ａ', 'ｚ'},
@@ -13455,9 +14478,9 @@ var g = &grammar{
 		},
 		{
 			name: "Lm",
-			pos:  position{line: 1868, col: 1, offset: 48529},
+			pos:  position{line: 2038, col: 1, offset: 53175},
 			expr: &charClassMatcher{
-				pos:        position{line: 1868, col: 6, offset: 48534},
+				pos:        position{line: 2038, col: 6, offset: 53180},
 				val:        "[\\u02B0-\\u02C1\\u02C6-\\u02D1\\u02E0-\\u02E4\\u02EC\\u02EE\\u0374\\u037A\\u0559\\u0640\\u06E5-\\u06E6\\u07F4-\\u07F5\\u07FA\\u081A\\u0824\\u0828\\u0971\\u0E46\\u0EC6\\u10FC\\u17D7\\u1843\\u1AA7\\u1C78-\\u1C7D\\u1D2C-\\u1D6A\\u1D78\\u1D9B-\\u1DBF\\u2071\\u207F\\u2090-\\u209C\\u2C7C-\\u2C7D\\u2D6F\\u2E2F\\u3005\\u3031-\\u3035\\u303B\\u309D-\\u309E\\u30FC-\\u30FE\\uA015\\uA4F8-\\uA4FD\\uA60C\\uA67F\\uA69C-\\uA69D\\uA717-\\uA71F\\uA770\\uA788\\uA7F8-\\uA7F9\\uA9CF\\uA9E6\\uAA70\\uAADD\\uAAF3-\\uAAF4\\uAB5C-\\uAB5F\\uFF70\\uFF9E-\\uFF9F]",
 				chars:      []rune{'ˬ', 'ˮ', 'ʹ', 'ͺ', 'ՙ', 'ـ', 'ߺ', 'ࠚ', 'ࠤ', 'ࠨ', 'ॱ', 'ๆ', 'ໆ', 'ჼ', 'ៗ', 'ᡃ', 'ᪧ', 'ᵸ', 'ⁱ', 'ⁿ', 'ⵯ', 'ⸯ', '々', '〻', 'ꀕ', 'ꘌ', 'ꙿ', 'ꝰ', 'ꞈ', 'ꧏ', 'ꧦ', 'ꩰ', 'ꫝ', 'ｰ'},
 				ranges:     []rune{'ʰ', 'ˁ', 'ˆ', 'ˑ', 'ˠ', 'ˤ', 'ۥ', 'ۦ', 'ߴ', 'ߵ', 'ᱸ', 'ᱽ', 'ᴬ', 'ᵪ', 'ᶛ', 'ᶿ', 'ₐ', 'ₜ', 'ⱼ', 'ⱽ', '〱', '〵', 'ゝ', 'ゞ', 'ー', 'ヾ', 'ꓸ', 'ꓽ', 'ꚜ', 'ꚝ', 'ꜗ', 'ꜟ', 'ꟸ', 'ꟹ', 'ꫳ', 'ꫴ', 'ꭜ', 'ꭟ', 'ﾞ', 'ﾟ'},
@@ -13469,9 +14492,9 @@ var g = &grammar{
 		},
 		{
 			name: "Lo",
-			pos:  position{line: 1871, col: 1, offset: 49019},
+			pos:  position{line: 2041, col: 1, offset: 53665},
 			expr: &charClassMatcher{
-				pos:        position{line: 1871, col: 6, offset: 49024},
+				pos:        position{line: 2041, col: 6, offset: 53670},
 				val:        "[\\u00AA\\u00BA\\u01BB\\u01C0-\\u01C3\\u0294\\u05D0-\\u05EA\\u05EF-\\u05F2\\u0620-\\u063F\\u0641-\\u064A\\u066E-\\u066F\\u0671-\\u06D3\\u06D5\\u06EE-\\u06EF\\u06FA-\\u06FC\\u06FF\\u0710\\u0712-\\u072F\\u074D-\\u07A5\\u07B1\\u07CA-\\u07EA\\u0800-\\u0815\\u0840-\\u0858\\u0860-\\u086A\\u08A0-\\u08B4\\u08B6-\\u08BD\\u0904-\\u0939\\u093D\\u0950\\u0958-\\u0961\\u0972-\\u0980\\u0985-\\u098C\\u098F-\\u0990\\u0993-\\u09A8\\u09AA-\\u09B0\\u09B2\\u09B6-\\u09B9\\u09BD\\u09CE\\u09DC-\\u09DD\\u09DF-\\u09E1\\u09F0-\\u09F1\\u09FC\\u0A05-\\u0A0A\\u0A0F-\\u0A10\\u0A13-\\u0A28\\u0A2A-\\u0A30\\u0A32-\\u0A33\\u0A35-\\u0A36\\u0A38-\\u0A39\\u0A59-\\u0A5C\\u0A5E\\u0A72-\\u0A74\\u0A85-\\u0A8D\\u0A8F-\\u0A91\\u0A93-\\u0AA8\\u0AAA-\\u0AB0\\u0AB2-\\u0AB3\\u0AB5-\\u0AB9\\u0ABD\\u0AD0\\u0AE0-\\u0AE1\\u0AF9\\u0B05-\\u0B0C\\u0B0F-\\u0B10\\u0B13-\\u0B28\\u0B2A-\\u0B30\\u0B32-\\u0B33\\u0B35-\\u0B39\\u0B3D\\u0B5C-\\u0B5D\\u0B5F-\\u0B61\\u0B71\\u0B83\\u0B85-\\u0B8A\\u0B8E-\\u0B90\\u0B92-\\u0B95\\u0B99-\\u0B9A\\u0B9C\\u0B9E-\\u0B9F\\u0BA3-\\u0BA4\\u0BA8-\\u0BAA\\u0BAE-\\u0BB9\\u0BD0\\u0C05-\\u0C0C\\u0C0E-\\u0C10\\u0C12-\\u0C28\\u0C2A-\\u0C39\\u0C3D\\u0C58-\\u0C5A\\u0C60-\\u0C61\\u0C80\\u0C85-\\u0C8C\\u0C8E-\\u0C90\\u0C92-\\u0CA8\\u0CAA-\\u0CB3\\u0CB5-\\u0CB9\\u0CBD\\u0CDE\\u0CE0-\\u0CE1\\u0CF1-\\u0CF2\\u0D05-\\u0D0C\\u0D0E-\\u0D10\\u0D12-\\u0D3A\\u0D3D\\u0D4E\\u0D54-\\u0D56\\u0D5F-\\u0D61\\u0D7A-\\u0D7F\\u0D85-\\u0D96\\u0D9A-\\u0DB1\\u0DB3-\\u0DBB\\u0DBD\\u0DC0-\\u0DC6\\u0E01-\\u0E30\\u0E32-\\u0E33\\u0E40-\\u0E45\\u0E81-\\u0E82\\u0E84\\u0E87-\\u0E88\\u0E8A\\u0E8D\\u0E94-\\u0E97\\u0E99-\\u0E9F\\u0EA1-\\u0EA3\\u0EA5\\u0EA7\\u0EAA-\\u0EAB\\u0EAD-\\u0EB0\\u0EB2-\\u0EB3\\u0EBD\\u0EC0-\\u0EC4\\u0EDC-\\u0EDF\\u0F00\\u0F40-\\u0F47\\u0F49-\\u0F6C\\u0F88-\\u0F8C\\u1000-\\u102A\\u103F\\u1050-\\u1055\\u105A-\\u105D\\u1061\\u1065-\\u1066\\u106E-\\u1070\\u1075-\\u1081\\u108E\\u1100-\\u1248\\u124A-\\u124D\\u1250-\\u1256\\u1258\\u125A-\\u125D\\u1260-\\u1288\\u128A-\\u128D\\u1290-\\u12B0\\u12B2-\\u12B5\\u12B8-\\u12BE\\u12C0\\u12C2-\\u12C5\\u12C8-\\u12D6\\u12D8-\\u1310\\u1312-\\u1315\\u1318-\\u135A\\u1380-\\u138F\\u1401-\\u166C\\u166F-\\u167F\\u1681-\\u169A\\u16A0-\\u16EA\\u16F1-\\u16F8\\u1700-\\u170C\\u170E-\\u1711\\u1720-\\u1731\\u1740-\\u1751\\u1760-\\u176C\\u176E-\\u1770\\u1780-\\u17B3\\u17DC\\u1820-\\u1842\\u1844-\\u1878\\u1880-\\u1884\\u1887-\\u18A8\\u18AA\\u18B0-\\u18F5\\u1900-\\u191E\\u1950-\\u196D\\u1970-\\u1974\\u1980-\\u19AB\\u19B0-\\u19C9\\u1A00-\\u1A16\\u1A20-\\u1A54\\u1B05-\\u1B33\\u1B45-\\u1B4B\\u1B83-\\u1BA0\\u1BAE-\\u1BAF\\u1BBA-\\u1BE5\\u1C00-\\u1C23\\u1C4D-\\u1C4F\\u1C5A-\\u1C77\\u1CE9-\\u1CEC\\u1CEE-\\u1CF1\\u1CF5-\\u1CF6\\u2135-\\u2138\\u2D30-\\u2D67\\u2D80-\\u2D96\\u2DA0-\\u2DA6\\u2DA8-\\u2DAE\\u2DB0-\\u2DB6\\u2DB8-\\u2DBE\\u2DC0-\\u2DC6\\u2DC8-\\u2DCE\\u2DD0-\\u2DD6\\u2DD8-\\u2DDE\\u3006\\u303C\\u3041-\\u3096\\u309F\\u30A1-\\u30FA\\u30FF\\u3105-\\u312F\\u3131-\\u318E\\u31A0-\\u31BA\\u31F0-\\u31FF\\u3400-\\u4DB5\\u4E00-\\u9FEF\\uA000-\\uA014\\uA016-\\uA48C\\uA4D0-\\uA4F7\\uA500-\\uA60B\\uA610-\\uA61F\\uA62A-\\uA62B\\uA66E\\uA6A0-\\uA6E5\\uA78F\\uA7F7\\uA7FB-\\uA801\\uA803-\\uA805\\uA807-\\uA80A\\uA80C-\\uA822\\uA840-\\uA873\\uA882-\\uA8B3\\uA8F2-\\uA8F7\\uA8FB\\uA8FD-\\uA8FE\\uA90A-\\uA925\\uA930-\\uA946\\uA960-\\uA97C\\uA984-\\uA9B2\\uA9E0-\\uA9E4\\uA9E7-\\uA9EF\\uA9FA-\\uA9FE\\uAA00-\\uAA28\\uAA40-\\uAA42\\uAA44-\\uAA4B\\uAA60-\\uAA6F\\uAA71-\\uAA76\\uAA7A\\uAA7E-\\uAAAF\\uAAB1\\uAAB5-\\uAAB6\\uAAB9-\\uAABD\\uAAC0\\uAAC2\\uAADB-\\uAADC\\uAAE0-\\uAAEA\\uAAF2\\uAB01-\\uAB06\\uAB09-\\uAB0E\\uAB11-\\uAB16\\uAB20-\\uAB26\\uAB28-\\uAB2E\\uABC0-\\uABE2\\uAC00-\\uD7A3\\uD7B0-\\uD7C6\\uD7CB-\\uD7FB\\uF900-\\uFA6D\\uFA70-\\uFAD9\\uFB1D\\uFB1F-\\uFB28\\uFB2A-\\uFB36\\uFB38-\\uFB3C\\uFB3E\\uFB40-\\uFB41\\uFB43-\\uFB44\\uFB46-\\uFBB1\\uFBD3-\\uFD3D\\uFD50-\\uFD8F\\uFD92-\\uFDC7\\uFDF0-\\uFDFB\\uFE70-\\uFE74\\uFE76-\\uFEFC\\uFF66-\\uFF6F\\uFF71-\\uFF9D\\uFFA0-\\uFFBE\\uFFC2-\\uFFC7\\uFFCA-\\uFFCF\\uFFD2-\\uFFD7\\uFFDA-\\uFFDC]",
 				chars:      []rune{'ª', 'º', 'ƻ', 'ʔ', 'ە', 'ۿ', 'ܐ', 'ޱ', 'ऽ', 'ॐ', 'ল', 'ঽ', 'ৎ', 'ৼ', 'ਫ਼', 'ઽ', 'ૐ', 'ૹ', 'ଽ', 'ୱ', 'ஃ', 'ஜ', 'ௐ', 'ఽ', 'ಀ', 'ಽ', 'ೞ', 'ഽ', 'ൎ', 'ල', 'ຄ', 'ຊ', 'ຍ', 'ລ', 'ວ', 'ຽ', 'ༀ', 'ဿ', 'ၡ', 'ႎ', 'ቘ', 'ዀ', 'ៜ', 'ᢪ', '〆', '〼', 'ゟ', 'ヿ', 'ꙮ', 'ꞏ', 'ꟷ', 'ꣻ', 'ꩺ', 'ꪱ', 'ꫀ', 'ꫂ', 'ꫲ', 'יִ', 'מּ'},
 				ranges:     []rune{'ǀ', 'ǃ', 'א', 'ת', 'ׯ', 'ײ', 'ؠ', 'ؿ', 'ف', 'ي', 'ٮ', 'ٯ', 'ٱ', 'ۓ', 'ۮ', 'ۯ', 'ۺ', 'ۼ', 'ܒ', 'ܯ', 'ݍ', 'ޥ', 'ߊ', 'ߪ', 'ࠀ', 'ࠕ', 'ࡀ', 'ࡘ', 'ࡠ', 'ࡪ', 'ࢠ', 'ࢴ', 'ࢶ', 'ࢽ', 'ऄ', 'ह', 'क़', 'ॡ', 'ॲ', 'ঀ', 'অ', 'ঌ', 'এ', 'ঐ', 'ও', 'ন', 'প', 'র', 'শ', 'হ', 'ড়', 'ঢ়', 'য়', 'ৡ', 'ৰ', 'ৱ', 'ਅ', 'ਊ', 'ਏ', 'ਐ', 'ਓ', 'ਨ', 'ਪ', 'ਰ', 'ਲ', 'ਲ਼', 'ਵ', 'ਸ਼', 'ਸ', 'ਹ', 'ਖ਼', 'ੜ', 'ੲ', 'ੴ', 'અ', 'ઍ', 'એ', 'ઑ', 'ઓ', 'ન', 'પ', 'ર', 'લ', 'ળ', 'વ', 'હ', 'ૠ', 'ૡ', 'ଅ', 'ଌ', 'ଏ', 'ଐ', 'ଓ', 'ନ', 'ପ', 'ର', 'ଲ', 'ଳ', 'ଵ', 'ହ', 'ଡ଼', 'ଢ଼', 'ୟ', 'ୡ', 'அ', 'ஊ', 'எ', 'ஐ', 'ஒ', 'க', 'ங', 'ச', 'ஞ', 'ட', 'ண', 'த', 'ந', 'ப', 'ம', 'ஹ', 'అ', 'ఌ', 'ఎ', 'ఐ', 'ఒ', 'న', 'ప', 'హ', 'ౘ', 'ౚ', 'ౠ', 'ౡ', 'ಅ', 'ಌ', 'ಎ', 'ಐ', 'ಒ', 'ನ', 'ಪ', 'ಳ', 'ವ', 'ಹ', 'ೠ', 'ೡ', 'ೱ', 'ೲ', 'അ', 'ഌ', 'എ', 'ഐ', 'ഒ', 'ഺ', 'ൔ', 'ൖ', 'ൟ', 'ൡ', 'ൺ', 'ൿ', 'අ', 'ඖ', 'ක', 'න', 'ඳ', 'ර', 'ව', 'ෆ', 'ก', 'ะ', 'า', 'ำ', 'เ', 'ๅ', 'ກ', 'ຂ', 'ງ', 'ຈ', 'ດ', 'ທ', 'ນ', 'ຟ', 'ມ', 'ຣ', 'ສ', 'ຫ', 'ອ', 'ະ', 'າ', 'ຳ', 'ເ', 'ໄ', 'ໜ', 'ໟ', 'ཀ', 'ཇ', 'ཉ', 'ཬ', 'ྈ', 'ྌ', 'က', 'ဪ', 'ၐ', 'ၕ', 'ၚ', 'ၝ', 'ၥ', 'ၦ', 'ၮ', 'ၰ', 'ၵ', 'ႁ', 'ᄀ', 'ቈ', 'ቊ', 'ቍ', 'ቐ', 'ቖ', 'ቚ', 'ቝ', 'በ', 'ኈ', 'ኊ', 'ኍ', 'ነ', 'ኰ', 'ኲ', 'ኵ', 'ኸ', 'ኾ', 'ዂ', 'ዅ', 'ወ', 'ዖ', 'ዘ', 'ጐ', 'ጒ', 'ጕ', 'ጘ', 'ፚ', 'ᎀ', 'ᎏ', 'ᐁ', 'ᙬ', 'ᙯ', 'ᙿ', 'ᚁ', 'ᚚ', 'ᚠ', 'ᛪ', 'ᛱ', 'ᛸ', 'ᜀ', 'ᜌ', 'ᜎ', 'ᜑ', 'ᜠ', 'ᜱ', 'ᝀ', 'ᝑ', 'ᝠ', 'ᝬ', 'ᝮ', 'ᝰ', 'ក', 'ឳ', 'ᠠ', 'ᡂ', 'ᡄ', 'ᡸ', 'ᢀ', 'ᢄ', 'ᢇ', 'ᢨ', 'ᢰ', 'ᣵ', 'ᤀ', 'ᤞ', 'ᥐ', 'ᥭ', 'ᥰ', 'ᥴ', 'ᦀ', 'ᦫ', 'ᦰ', 'ᧉ', 'ᨀ', 'ᨖ', 'ᨠ', 'ᩔ', 'ᬅ', 'ᬳ', 'ᭅ', 'ᭋ', 'ᮃ', 'ᮠ', 'ᮮ', 'ᮯ', 'ᮺ', 'ᯥ', 'ᰀ', 'ᰣ', 'ᱍ', 'ᱏ', 'ᱚ', 'ᱷ', 'ᳩ', 'ᳬ', 'ᳮ', 'ᳱ', 'ᳵ', 'ᳶ', 'ℵ', 'ℸ', 'ⴰ', 'ⵧ', 'ⶀ', 'ⶖ', 'ⶠ', 'ⶦ', 'ⶨ', 'ⶮ', 'ⶰ', 'ⶶ', 'ⶸ', 'ⶾ', 'ⷀ', 'ⷆ', 'ⷈ', 'ⷎ', 'ⷐ', 'ⷖ', 'ⷘ', 'ⷞ', 'ぁ', 'ゖ', 'ァ', 'ヺ', 'ㄅ', 'ㄯ', 'ㄱ', 'ㆎ', 'ㆠ', 'ㆺ', 'ㇰ', 'ㇿ', '㐀', '䶵', '一', '鿯', 'ꀀ', 'ꀔ', 'ꀖ', 'ꒌ', 'ꓐ', 'ꓷ', 'ꔀ', 'ꘋ', 'ꘐ', 'ꘟ', 'ꘪ', 'ꘫ', 'ꚠ', 'ꛥ', 'ꟻ', 'ꠁ', 'ꠃ', 'ꠅ', 'ꠇ', 'ꠊ', 'ꠌ', 'ꠢ', 'ꡀ', 'ꡳ', 'ꢂ', 'ꢳ', 'ꣲ', 'ꣷ', 'ꣽ', 'ꣾ', 'ꤊ', 'ꤥ', 'ꤰ', 'ꥆ', 'ꥠ', 'ꥼ', 'ꦄ', 'ꦲ', 'ꧠ', 'ꧤ', 'ꧧ', 'ꧯ', 'ꧺ', 'ꧾ', 'ꨀ', 'ꨨ', 'ꩀ', 'ꩂ', 'ꩄ', 'ꩋ', 'ꩠ', 'ꩯ', 'ꩱ', 'ꩶ', 'ꩾ', 'ꪯ', 'ꪵ', 'ꪶ', 'ꪹ', 'ꪽ', 'ꫛ', 'ꫜ', 'ꫠ', 'ꫪ', 'ꬁ', 'ꬆ', 'ꬉ', 'ꬎ', 'ꬑ', 'ꬖ', 'ꬠ', 'ꬦ', 'ꬨ', 'ꬮ', 'ꯀ', 'ꯢ', '가', '힣', 'ힰ', 'ퟆ', 'ퟋ', 'ퟻ', '豈', '舘', '並', '龎', 'ײַ', 'ﬨ', 'שׁ', 'זּ', 'טּ', 'לּ', 'נּ', 'סּ', 'ףּ', 'פּ', 'צּ', 'ﮱ', 'ﯓ', 'ﴽ', 'ﵐ', 'ﶏ', 'ﶒ', 'ﷇ', 'ﷰ', 'ﷻ', 'ﹰ', 'ﹴ', 'ﹶ', 'ﻼ', 'ｦ', 'ｯ', 'ｱ', 'ﾝ', 'ﾠ', 'ﾾ', 'ￂ', 'ￇ', 'ￊ', 'ￏ', 'ￒ', 'ￗ', 'ￚ', 'ￜ'},
@@ -13483,9 +14506,9 @@ var g = &grammar{
 		},
 		{
 			name: "Lt",
-			pos:  position{line: 1874, col: 1, offset: 52471},
+			pos:  position{line: 2044, col: 1, offset: 57117},
 			expr: &charClassMatcher{
-				pos:        position{line: 1874, col: 6, offset: 52476},
+				pos:        position{line: 2044, col: 6, offset: 57122},
 				val:        "[\\u01C5\\u01C8\\u01CB\\u01F2\\u1F88-\\u1F8F\\u1F98-\\u1F9F\\u1FA8-\\u1FAF\\u1FBC\\u1FCC\\u1FFC]",
 				chars:      []rune{'ǅ', 'ǈ', 'ǋ', 'ǲ', 'ᾼ', 'ῌ', 'ῼ'},
 				ranges:     []rune{'ᾈ', 'ᾏ', 'ᾘ', 'ᾟ', 'ᾨ', 'ᾯ'},
@@ -13497,9 +14520,9 @@ var g = &grammar{
 		},
 		{
 			name: "Lu",
-			pos:  position{line: 1877, col: 1, offset: 52582},
+			pos:  position{line: 2047, col: 1, offset: 57228},
 			expr: &charClassMatcher{
-				pos:        position{line: 1877, col: 6, offset: 52587},
+				pos:        position{line: 2047, col: 6, offset: 57233},
 				val:        "[\\u0041-\\u005A\\u00C0-\\u00D6\\u00D8-\\u00DE\\u0100\\u0102\\u0104\\u0106\\u0108\\u010A\\u010C\\u010E\\u0110\\u0112\\u0114\\u0116\\u0118\\u011A\\u011C\\u011E\\u0120\\u0122\\u0124\\u0126\\u0128\\u012A\\u012C\\u012E\\u0130\\u0132\\u0134\\u0136\\u0139\\u013B\\u013D\\u013F\\u0141\\u0143\\u0145\\u0147\\u014A\\u014C\\u014E\\u0150\\u0152\\u0154\\u0156\\u0158\\u015A\\u015C\\u015E\\u0160\\u0162\\u0164\\u0166\\u0168\\u016A\\u016C\\u016E\\u0170\\u0172\\u0174\\u0176\\u0178-\\u0179\\u017B\\u017D\\u0181-\\u0182\\u0184\\u0186-\\u0187\\u0189-\\u018B\\u018E-\\u0191\\u0193-\\u0194\\u0196-\\u0198\\u019C-\\u019D\\u019F-\\u01A0\\u01A2\\u01A4\\u01A6-\\u01A7\\u01A9\\u01AC\\u01AE-\\u01AF\\u01B1-\\u01B3\\u01B5\\u01B7-\\u01B8\\u01BC\\u01C4\\u01C7\\u01CA\\u01CD\\u01CF\\u01D1\\u01D3\\u01D5\\u01D7\\u01D9\\u01DB\\u01DE\\u01E0\\u01E2\\u01E4\\u01E6\\u01E8\\u01EA\\u01EC\\u01EE\\u01F1\\u01F4\\u01F6-\\u01F8\\u01FA\\u01FC\\u01FE\\u0200\\u0202\\u0204\\u0206\\u0208\\u020A\\u020C\\u020E\\u0210\\u0212\\u0214\\u0216\\u0218\\u021A\\u021C\\u021E\\u0220\\u0222\\u0224\\u0226\\u0228\\u022A\\u022C\\u022E\\u0230\\u0232\\u023A-\\u023B\\u023D-\\u023E\\u0241\\u0243-\\u0246\\u0248\\u024A\\u024C\\u024E\\u0370\\u0372\\u0376\\u037F\\u0386\\u0388-\\u038A\\u038C\\u038E-\\u038F\\u0391-\\u03A1\\u03A3-\\u03AB\\u03CF\\u03D2-\\u03D4\\u03D8\\u03DA\\u03DC\\u03DE\\u03E0\\u03E2\\u03E4\\u03E6\\u03E8\\u03EA\\u03EC\\u03EE\\u03F4\\u03F7\\u03F9-\\u03FA\\u03FD-\\u042F\\u0460\\u0462\\u0464\\u0466\\u0468\\u046A\\u046C\\u046E\\u0470\\u0472\\u0474\\u0476\\u0478\\u047A\\u047C\\u047E\\u0480\\u048A\\u048C\\u048E\\u0490\\u0492\\u0494\\u0496\\u0498\\u049A\\u049C\\u049E\\u04A0\\u04A2\\u04A4\\u04A6\\u04A8\\u04AA\\u04AC\\u04AE\\u04B0\\u04B2\\u04B4\\u04B6\\u04B8\\u04BA\\u04BC\\u04BE\\u04C0-\\u04C1\\u04C3\\u04C5\\u04C7\\u04C9\\u04CB\\u04CD\\u04D0\\u04D2\\u04D4\\u04D6\\u04D8\\u04DA\\u04DC\\u04DE\\u04E0\\u04E2\\u04E4\\u04E6\\u04E8\\u04EA\\u04EC\\u04EE\\u04F0\\u04F2\\u04F4\\u04F6\\u04F8\\u04FA\\u04FC\\u04FE\\u0500\\u0502\\u0504\\u0506\\u0508\\u050A\\u050C\\u050E\\u0510\\u0512\\u0514\\u0516\\u0518\\u051A\\u051C\\u051E\\u0520\\u0522\\u0524\\u0526\\u0528\\u052A\\u052C\\u052E\\u0531-\\u0556\\u10A0-\\u10C5\\u10C7\\u10CD\\u13A0-\\u13F5\\u1C90-\\u1CBA\\u1CBD-\\u1CBF\\u1E00\\u1E02\\u1E04\\u1E06\\u1E08\\u1E0A\\u1E0C\\u1E0E\\u1E10\\u1E12\\u1E14\\u1E16\\u1E18\\u1E1A\\u1E1C\\u1E1E\\u1E20\\u1E22\\u1E24\\u1E26\\u1E28\\u1E2A\\u1E2C\\u1E2E\\u1E30\\u1E32\\u1E34\\u1E36\\u1E38\\u1E3A\\u1E3C\\u1E3E\\u1E40\\u1E42\\u1E44\\u1E46\\u1E48\\u1E4A\\u1E4C\\u1E4E\\u1E50\\u1E52\\u1E54\\u1E56\\u1E58\\u1E5A\\u1E5C\\u1E5E\\u1E60\\u1E62\\u1E64\\u1E66\\u1E68\\u1E6A\\u1E6C\\u1E6E\\u1E70\\u1E72\\u1E74\\u1E76\\u1E78\\u1E7A\\u1E7C\\u1E7E\\u1E80\\u1E82\\u1E84\\u1E86\\u1E88\\u1E8A\\u1E8C\\u1E8E\\u1E90\\u1E92\\u1E94\\u1E9E\\u1EA0\\u1EA2\\u1EA4\\u1EA6\\u1EA8\\u1EAA\\u1EAC\\u1EAE\\u1EB0\\u1EB2\\u1EB4\\u1EB6\\u1EB8\\u1EBA\\u1EBC\\u1EBE\\u1EC0\\u1EC2\\u1EC4\\u1EC6\\u1EC8\\u1ECA\\u1ECC\\u1ECE\\u1ED0\\u1ED2\\u1ED4\\u1ED6\\u1ED8\\u1EDA\\u1EDC\\u1EDE\\u1EE0\\u1EE2\\u1EE4\\u1EE6\\u1EE8\\u1EEA\\u1EEC\\u1EEE\\u1EF0\\u1EF2\\u1EF4\\u1EF6\\u1EF8\\u1EFA\\u1EFC\\u1EFE\\u1F08-\\u1F0F\\u1F18-\\u1F1D\\u1F28-\\u1F2F\\u1F38-\\u1F3F\\u1F48-\\u1F4D\\u1F59\\u1F5B\\u1F5D\\u1F5F\\u1F68-\\u1F6F\\u1FB8-\\u1FBB\\u1FC8-\\u1FCB\\u1FD8-\\u1FDB\\u1FE8-\\u1FEC\\u1FF8-\\u1FFB\\u2102\\u2107\\u210B-\\u210D\\u2110-\\u2112\\u2115\\u2119-\\u211D\\u2124\\u2126\\u2128\\u212A-\\u212D\\u2130-\\u2133\\u213E-\\u213F\\u2145\\u2183\\u2C00-\\u2C2E\\u2C60\\u2C62-\\u2C64\\u2C67\\u2C69\\u2C6B\\u2C6D-\\u2C70\\u2C72\\u2C75\\u2C7E-\\u2C80\\u2C82\\u2C84\\u2C86\\u2C88\\u2C8A\\u2C8C\\u2C8E\\u2C90\\u2C92\\u2C94\\u2C96\\u2C98\\u2C9A\\u2C9C\\u2C9E\\u2CA0\\u2CA2\\u2CA4\\u2CA6\\u2CA8\\u2CAA\\u2CAC\\u2CAE\\u2CB0\\u2CB2\\u2CB4\\u2CB6\\u2CB8\\u2CBA\\u2CBC\\u2CBE\\u2CC0\\u2CC2\\u2CC4\\u2CC6\\u2CC8\\u2CCA\\u2CCC\\u2CCE\\u2CD0\\u2CD2\\u2CD4\\u2CD6\\u2CD8\\u2CDA\\u2CDC\\u2CDE\\u2CE0\\u2CE2\\u2CEB\\u2CED\\u2CF2\\uA640\\uA642\\uA644\\uA646\\uA648\\uA64A\\uA64C\\uA64E\\uA650\\uA652\\uA654\\uA656\\uA658\\uA65A\\uA65C\\uA65E\\uA660\\uA662\\uA664\\uA666\\uA668\\uA66A\\uA66C\\uA680\\uA682\\uA684\\uA686\\uA688\\uA68A\\uA68C\\uA68E\\uA690\\uA692\\uA694\\uA696\\uA698\\uA69A\\uA722\\uA724\\uA726\\uA728\\uA72A\\uA72C\\uA72E\\uA732\\uA734\\uA736\\uA738\\uA73A\\uA73C\\uA73E\\uA740\\uA742\\uA744\\uA746\\uA748\\uA74A\\uA74C\\uA74E\\uA750\\uA752\\uA754\\uA756\\uA758\\uA75A\\uA75C\\uA75E\\uA760\\uA762\\uA764\\uA766\\uA768\\uA76A\\uA76C\\uA76E\\uA779\\uA77B\\uA77D-\\uA77E\\uA780\\uA782\\uA784\\uA786\\uA78B\\uA78D\\uA790\\uA792\\uA796\\uA798\\uA79A\\uA79C\\uA79E\\uA7A0\\uA7A2\\uA7A4\\uA7A6\\uA7A8\\uA7AA-\\uA7AE\\uA7B0-\\uA7B4\\uA7B6\\uA7B8\\uFF21-\\uFF3A]",
 				chars:      []rune{'Ā', 'Ă', 'Ą', 'Ć', 'Ĉ', 'Ċ', 'Č', 'Ď', 'Đ', 'Ē', 'Ĕ', 'Ė', 'Ę', 'Ě', 'Ĝ', 'Ğ', 'Ġ', 'Ģ', 'Ĥ', 'Ħ', 'Ĩ', 'Ī', 'Ĭ', 'Į', 'İ', 'Ĳ', 'Ĵ', 'Ķ', 'Ĺ', 'Ļ', 'Ľ', 'Ŀ', 'Ł', 'Ń', 'Ņ', 'Ň', 'Ŋ', 'Ō', 'Ŏ', 'Ő', 'Œ', 'Ŕ', 'Ŗ', 'Ř', 'Ś', 'Ŝ', 'Ş', 'Š', 'Ţ', 'Ť', 'Ŧ', 'Ũ', 'Ū', 'Ŭ', 'Ů', 'Ű', 'Ų', 'Ŵ', 'Ŷ', 'Ż', 'Ž', 'Ƅ', 'Ƣ', 'Ƥ', 'Ʃ', 'Ƭ', 'Ƶ', 'Ƽ', 'Ǆ', 'Ǉ', 'Ǌ', 'Ǎ', 'Ǐ', 'Ǒ', 'Ǔ', 'Ǖ', 'Ǘ', 'Ǚ', 'Ǜ', 'Ǟ', 'Ǡ', 'Ǣ', 'Ǥ', 'Ǧ', 'Ǩ', 'Ǫ', 'Ǭ', 'Ǯ', 'Ǳ', 'Ǵ', 'Ǻ', 'Ǽ', 'Ǿ', 'Ȁ', 'Ȃ', 'Ȅ', 'Ȇ', 'Ȉ', 'Ȋ', 'Ȍ', 'Ȏ', 'Ȑ', 'Ȓ', 'Ȕ', 'Ȗ', 'Ș', 'Ț', 'Ȝ', 'Ȟ', 'Ƞ', 'Ȣ', 'Ȥ', 'Ȧ', 'Ȩ', 'Ȫ', 'Ȭ', 'Ȯ', 'Ȱ', 'Ȳ', 'Ɂ', 'Ɉ', 'Ɋ', 'Ɍ', 'Ɏ', 'Ͱ', 'Ͳ', 'Ͷ', 'Ϳ', 'Ά', 'Ό', 'Ϗ', 'Ϙ', 'Ϛ', 'Ϝ', 'Ϟ', 'Ϡ', 'Ϣ', 'Ϥ', 'Ϧ', 'Ϩ', 'Ϫ', 'Ϭ', 'Ϯ', 'ϴ', 'Ϸ', 'Ѡ', 'Ѣ', 'Ѥ', 'Ѧ', 'Ѩ', 'Ѫ', 'Ѭ', 'Ѯ', 'Ѱ', 'Ѳ', 'Ѵ', 'Ѷ', 'Ѹ', 'Ѻ', 'Ѽ', 'Ѿ', 'Ҁ', 'Ҋ', 'Ҍ', 'Ҏ', 'Ґ', 'Ғ', 'Ҕ', 'Җ', 'Ҙ', 'Қ', 'Ҝ', 'Ҟ', 'Ҡ', 'Ң', 'Ҥ', 'Ҧ', 'Ҩ', 'Ҫ', 'Ҭ', 'Ү', 'Ұ', 'Ҳ', 'Ҵ', 'Ҷ', 'Ҹ', 'Һ', 'Ҽ', 'Ҿ', 'Ӄ', 'Ӆ', 'Ӈ', 'Ӊ', 'Ӌ', 'Ӎ', 'Ӑ', 'Ӓ', 'Ӕ', 'Ӗ', 'Ә', 'Ӛ', 'Ӝ', 'Ӟ', 'Ӡ', 'Ӣ', 'Ӥ', 'Ӧ', 'Ө', 'Ӫ', 'Ӭ', 'Ӯ', 'Ӱ', 'Ӳ', 'Ӵ', 'Ӷ', 'Ӹ', 'Ӻ', 'Ӽ', 'Ӿ', 'Ԁ', 'Ԃ', 'Ԅ', 'Ԇ', 'Ԉ', 'Ԋ', 'Ԍ', 'Ԏ', 'Ԑ', 'Ԓ', 'Ԕ', 'Ԗ', 'Ԙ', 'Ԛ', 'Ԝ', 'Ԟ', 'Ԡ', 'Ԣ', 'Ԥ', 'Ԧ', 'Ԩ', 'Ԫ', 'Ԭ', 'Ԯ', 'Ⴧ', 'Ⴭ', 'Ḁ', 'Ḃ', 'Ḅ', 'Ḇ', 'Ḉ', 'Ḋ', 'Ḍ', 'Ḏ', 'Ḑ', 'Ḓ', 'Ḕ', 'Ḗ', 'Ḙ', 'Ḛ', 'Ḝ', 'Ḟ', 'Ḡ', 'Ḣ', 'Ḥ', 'Ḧ', 'Ḩ', 'Ḫ', 'Ḭ', 'Ḯ', 'Ḱ', 'Ḳ', 'Ḵ', 'Ḷ', 'Ḹ', 'Ḻ', 'Ḽ', 'Ḿ', 'Ṁ', 'Ṃ', 'Ṅ', 'Ṇ', 'Ṉ', 'Ṋ', 'Ṍ', 'Ṏ', 'Ṑ', 'Ṓ', 'Ṕ', 'Ṗ', 'Ṙ', 'Ṛ', 'Ṝ', 'Ṟ', 'Ṡ', 'Ṣ', 'Ṥ', 'Ṧ', 'Ṩ', 'Ṫ', 'Ṭ', 'Ṯ', 'Ṱ', 'Ṳ', 'Ṵ', 'Ṷ', 'Ṹ', 'Ṻ', 'Ṽ', 'Ṿ', 'Ẁ', 'Ẃ', 'Ẅ', 'Ẇ', 'Ẉ', 'Ẋ', 'Ẍ', 'Ẏ', 'Ẑ', 'Ẓ', 'Ẕ', 'ẞ', 'Ạ', 'Ả', 'Ấ', 'Ầ', 'Ẩ', 'Ẫ', 'Ậ', 'Ắ', 'Ằ', 'Ẳ', 'Ẵ', 'Ặ', 'Ẹ', 'Ẻ', 'Ẽ', 'Ế', 'Ề', 'Ể', 'Ễ', 'Ệ', 'Ỉ', 'Ị', 'Ọ', 'Ỏ', 'Ố', 'Ồ', 'Ổ', 'Ỗ', 'Ộ', 'Ớ', 'Ờ', 'Ở', 'Ỡ', 'Ợ', 'Ụ', 'Ủ', 'Ứ', 'Ừ', 'Ử', 'Ữ', 'Ự', 'Ỳ', 'Ỵ', 'Ỷ', 'Ỹ', 'Ỻ', 'Ỽ', 'Ỿ', 'Ὑ', 'Ὓ', 'Ὕ', 'Ὗ', 'ℂ', 'ℇ', 'ℕ', 'ℤ', 'Ω', 'ℨ', 'ⅅ', 'Ↄ', 'Ⱡ', 'Ⱨ', 'Ⱪ', 'Ⱬ', 'Ⱳ', 'Ⱶ', 'Ⲃ', 'Ⲅ', 'Ⲇ', 'Ⲉ', 'Ⲋ', 'Ⲍ', 'Ⲏ', 'Ⲑ', 'Ⲓ', 'Ⲕ', 'Ⲗ', 'Ⲙ', 'Ⲛ', 'Ⲝ', 'Ⲟ', 'Ⲡ', 'Ⲣ', 'Ⲥ', 'Ⲧ', 'Ⲩ', 'Ⲫ', 'Ⲭ', 'Ⲯ', 'Ⲱ', 'Ⲳ', 'Ⲵ', 'Ⲷ', 'Ⲹ', 'Ⲻ', 'Ⲽ', 'Ⲿ', 'Ⳁ', 'Ⳃ', 'Ⳅ', 'Ⳇ', 'Ⳉ', 'Ⳋ', 'Ⳍ', 'Ⳏ', 'Ⳑ', 'Ⳓ', 'Ⳕ', 'Ⳗ', 'Ⳙ', 'Ⳛ', 'Ⳝ', 'Ⳟ', 'Ⳡ', 'Ⳣ', 'Ⳬ', 'Ⳮ', 'Ⳳ', 'Ꙁ', 'Ꙃ', 'Ꙅ', 'Ꙇ', 'Ꙉ', 'Ꙋ', 'Ꙍ', 'Ꙏ', 'Ꙑ', 'Ꙓ', 'Ꙕ', 'Ꙗ', 'Ꙙ', 'Ꙛ', 'Ꙝ', 'Ꙟ', 'Ꙡ', 'Ꙣ', 'Ꙥ', 'Ꙧ', 'Ꙩ', 'Ꙫ', 'Ꙭ', 'Ꚁ', 'Ꚃ', 'Ꚅ', 'Ꚇ', 'Ꚉ', 'Ꚋ', 'Ꚍ', 'Ꚏ', 'Ꚑ', 'Ꚓ', 'Ꚕ', 'Ꚗ', 'Ꚙ', 'Ꚛ', 'Ꜣ', 'Ꜥ', 'Ꜧ', 'Ꜩ', 'Ꜫ', 'Ꜭ', 'Ꜯ', 'Ꜳ', 'Ꜵ', 'Ꜷ', 'Ꜹ', 'Ꜻ', 'Ꜽ', 'Ꜿ', 'Ꝁ', 'Ꝃ', 'Ꝅ', 'Ꝇ', 'Ꝉ', 'Ꝋ', 'Ꝍ', 'Ꝏ', 'Ꝑ', 'Ꝓ', 'Ꝕ', 'Ꝗ', 'Ꝙ', 'Ꝛ', 'Ꝝ', 'Ꝟ', 'Ꝡ', 'Ꝣ', 'Ꝥ', 'Ꝧ', 'Ꝩ', 'Ꝫ', 'Ꝭ', 'Ꝯ', 'Ꝺ', 'Ꝼ', 'Ꞁ', 'Ꞃ', 'Ꞅ', 'Ꞇ', 'Ꞌ', 'Ɥ', 'Ꞑ', 'Ꞓ', 'Ꞗ', 'Ꞙ', 'Ꞛ', 'Ꞝ', 'Ꞟ', 'Ꞡ', 'Ꞣ', 'Ꞥ', 'Ꞧ', 'Ꞩ', 'Ꞷ', 'Ꞹ'},
 				ranges:     []rune{'A', 'Z', 'À', 'Ö', 'Ø', 'Þ', 'Ÿ', 'Ź', 'Ɓ', 'Ƃ', 'Ɔ', 'Ƈ', 'Ɖ', 'Ƌ', 'Ǝ', 'Ƒ', 'Ɠ', 'Ɣ', 'Ɩ', 'Ƙ', 'Ɯ', 'Ɲ', 'Ɵ', 'Ơ', 'Ʀ', 'Ƨ', 'Ʈ', 'Ư', 'Ʊ', 'Ƴ', 'Ʒ', 'Ƹ', 'Ƕ', 'Ǹ', 'Ⱥ', 'Ȼ', 'Ƚ', 'Ⱦ', 'Ƀ', 'Ɇ', 'Έ', 'Ί', 'Ύ', 'Ώ', 'Α', 'Ρ', 'Σ', 'Ϋ', 'ϒ', 'ϔ', 'Ϲ', 'Ϻ', 'Ͻ', 'Я', 'Ӏ', 'Ӂ', 'Ա', 'Ֆ', 'Ⴀ', 'Ⴥ', 'Ꭰ', 'Ᏽ', 'Ა', 'Ჺ', 'Ჽ', 'Ჿ', 'Ἀ', 'Ἇ', 'Ἐ', 'Ἕ', 'Ἠ', 'Ἧ', 'Ἰ', 'Ἷ', 'Ὀ', 'Ὅ', 'Ὠ', 'Ὧ', 'Ᾰ', 'Ά', 'Ὲ', 'Ή', 'Ῐ', 'Ί', 'Ῠ', 'Ῥ', 'Ὸ', 'Ώ', 'ℋ', 'ℍ', 'ℐ', 'ℒ', 'ℙ', 'ℝ', 'K', 'ℭ', 'ℰ', 'ℳ', 'ℾ', 'ℿ', 'Ⰰ', 'Ⱞ', 'Ɫ', 'Ɽ', 'Ɑ', 'Ɒ', 'Ȿ', 'Ⲁ', 'Ᵹ', 'Ꝿ', 'Ɦ', 'Ɪ', 'Ʞ', 'Ꞵ', 'Ａ', 'Ｚ'},
@@ -13511,9 +14534,9 @@ var g = &grammar{
 		},
 		{
 			name: "Mc",
-			pos:  position{line: 1880, col: 1, offset: 56588},
+			pos:  position{line: 2050, col: 1, offset: 61234},
 			expr: &charClassMatcher{
-				pos:        position{line: 1880, col: 6, offset: 56593},
+				pos:        position{line: 2050, col: 6, offset: 61239},
 				val:        "[\\u0903\\u093B\\u093E-\\u0940\\u0949-\\u094C\\u094E-\\u094F\\u0982-\\u0983\\u09BE-\\u09C0\\u09C7-\\u09C8\\u09CB-\\u09CC\\u09D7\\u0A03\\u0A3E-\\u0A40\\u0A83\\u0ABE-\\u0AC0\\u0AC9\\u0ACB-\\u0ACC\\u0B02-\\u0B03\\u0B3E\\u0B40\\u0B47-\\u0B48\\u0B4B-\\u0B4C\\u0B57\\u0BBE-\\u0BBF\\u0BC1-\\u0BC2\\u0BC6-\\u0BC8\\u0BCA-\\u0BCC\\u0BD7\\u0C01-\\u0C03\\u0C41-\\u0C44\\u0C82-\\u0C83\\u0CBE\\u0CC0-\\u0CC4\\u0CC7-\\u0CC8\\u0CCA-\\u0CCB\\u0CD5-\\u0CD6\\u0D02-\\u0D03\\u0D3E-\\u0D40\\u0D46-\\u0D48\\u0D4A-\\u0D4C\\u0D57\\u0D82-\\u0D83\\u0DCF-\\u0DD1\\u0DD8-\\u0DDF\\u0DF2-\\u0DF3\\u0F3E-\\u0F3F\\u0F7F\\u102B-\\u102C\\u1031\\u1038\\u103B-\\u103C\\u1056-\\u1057\\u1062-\\u1064\\u1067-\\u106D\\u1083-\\u1084\\u1087-\\u108C\\u108F\\u109A-\\u109C\\u17B6\\u17BE-\\u17C5\\u17C7-\\u17C8\\u1923-\\u1926\\u1929-\\u192B\\u1930-\\u1931\\u1933-\\u1938\\u1A19-\\u1A1A\\u1A55\\u1A57\\u1A61\\u1A63-\\u1A64\\u1A6D-\\u1A72\\u1B04\\u1B35\\u1B3B\\u1B3D-\\u1B41\\u1B43-\\u1B44\\u1B82\\u1BA1\\u1BA6-\\u1BA7\\u1BAA\\u1BE7\\u1BEA-\\u1BEC\\u1BEE\\u1BF2-\\u1BF3\\u1C24-\\u1C2B\\u1C34-\\u1C35\\u1CE1\\u1CF2-\\u1CF3\\u1CF7\\u302E-\\u302F\\uA823-\\uA824\\uA827\\uA880-\\uA881\\uA8B4-\\uA8C3\\uA952-\\uA953\\uA983\\uA9B4-\\uA9B5\\uA9BA-\\uA9BB\\uA9BD-\\uA9C0\\uAA2F-\\uAA30\\uAA33-\\uAA34\\uAA4D\\uAA7B\\uAA7D\\uAAEB\\uAAEE-\\uAAEF\\uAAF5\\uABE3-\\uABE4\\uABE6-\\uABE7\\uABE9-\\uABEA\\uABEC]",
 				chars:      []rune{'ः', 'ऻ', 'ৗ', 'ਃ', 'ઃ', 'ૉ', 'ା', 'ୀ', 'ୗ', 'ௗ', 'ಾ', 'ൗ', 'ཿ', 'ေ', 'း', 'ႏ', 'ា', 'ᩕ', 'ᩗ', 'ᩡ', 'ᬄ', 'ᬵ', 'ᬻ', 'ᮂ', 'ᮡ', '᮪', 'ᯧ', 'ᯮ', '᳡', '᳷', 'ꠧ', 'ꦃ', 'ꩍ', 'ꩻ', 'ꩽ', 'ꫫ', 'ꫵ', '꯬'},
 				ranges:     []rune{'ा', 'ी', 'ॉ', 'ौ', 'ॎ', 'ॏ', 'ং', 'ঃ', 'া', 'ী', 'ে', 'ৈ', 'ো', 'ৌ', 'ਾ', 'ੀ', 'ા', 'ી', 'ો', 'ૌ', 'ଂ', 'ଃ', 'େ', 'ୈ', 'ୋ', 'ୌ', 'ா', 'ி', 'ு', 'ூ', 'ெ', 'ை', 'ொ', 'ௌ', 'ఁ', 'ః', 'ు', 'ౄ', 'ಂ', 'ಃ', 'ೀ', 'ೄ', 'ೇ', 'ೈ', 'ೊ', 'ೋ', 'ೕ', 'ೖ', 'ം', 'ഃ', 'ാ', 'ീ', 'െ', 'ൈ', 'ൊ', 'ൌ', 'ං', 'ඃ', 'ා', 'ෑ', 'ෘ', 'ෟ', 'ෲ', 'ෳ', '༾', '༿', 'ါ', 'ာ', 'ျ', 'ြ', 'ၖ', 'ၗ', 'ၢ', 'ၤ', 'ၧ', 'ၭ', 'ႃ', 'ႄ', 'ႇ', 'ႌ', 'ႚ', 'ႜ', 'ើ', 'ៅ', 'ះ', 'ៈ', 'ᤣ', 'ᤦ', 'ᤩ', 'ᤫ', 'ᤰ', 'ᤱ', 'ᤳ', 'ᤸ', 'ᨙ', 'ᨚ', 'ᩣ', 'ᩤ', 'ᩭ', 'ᩲ', 'ᬽ', 'ᭁ', 'ᭃ', '᭄', 'ᮦ', 'ᮧ', 'ᯪ', 'ᯬ', '᯲', '᯳', 'ᰤ', 'ᰫ', 'ᰴ', 'ᰵ', 'ᳲ', 'ᳳ', '〮', '〯', 'ꠣ', 'ꠤ', 'ꢀ', 'ꢁ', 'ꢴ', 'ꣃ', 'ꥒ', '꥓', 'ꦴ', 'ꦵ', 'ꦺ', 'ꦻ', 'ꦽ', '꧀', 'ꨯ', 'ꨰ', 'ꨳ', 'ꨴ', 'ꫮ', 'ꫯ', 'ꯣ', 'ꯤ', 'ꯦ', 'ꯧ', 'ꯩ', 'ꯪ'},
@@ -13525,9 +14548,9 @@ var g = &grammar{
 		},
 		{
 			name: "Mn",
-			pos:  position{line: 1883, col: 1, offset: 57781},
+			pos:  position{line: 2053, col: 1, offset: 62427},
 			expr: &charClassMatcher{
-				pos:        position{line: 1883, col: 6, offset: 57786},
+				pos:        position{line: 2053, col: 6, offset: 62432},
 				val:        "[\\u0300-\\u036F\\u0483-\\u0487\\u0591-\\u05BD\\u05BF\\u05C1-\\u05C2\\u05C4-\\u05C5\\u05C7\\u0610-\\u061A\\u064B-\\u065F\\u0670\\u06D6-\\u06DC\\u06DF-\\u06E4\\u06E7-\\u06E8\\u06EA-\\u06ED\\u0711\\u0730-\\u074A\\u07A6-\\u07B0\\u07EB-\\u07F3\\u07FD\\u0816-\\u0819\\u081B-\\u0823\\u0825-\\u0827\\u0829-\\u082D\\u0859-\\u085B\\u08D3-\\u08E1\\u08E3-\\u0902\\u093A\\u093C\\u0941-\\u0948\\u094D\\u0951-\\u0957\\u0962-\\u0963\\u0981\\u09BC\\u09C1-\\u09C4\\u09CD\\u09E2-\\u09E3\\u09FE\\u0A01-\\u0A02\\u0A3C\\u0A41-\\u0A42\\u0A47-\\u0A48\\u0A4B-\\u0A4D\\u0A51\\u0A70-\\u0A71\\u0A75\\u0A81-\\u0A82\\u0ABC\\u0AC1-\\u0AC5\\u0AC7-\\u0AC8\\u0ACD\\u0AE2-\\u0AE3\\u0AFA-\\u0AFF\\u0B01\\u0B3C\\u0B3F\\u0B41-\\u0B44\\u0B4D\\u0B56\\u0B62-\\u0B63\\u0B82\\u0BC0\\u0BCD\\u0C00\\u0C04\\u0C3E-\\u0C40\\u0C46-\\u0C48\\u0C4A-\\u0C4D\\u0C55-\\u0C56\\u0C62-\\u0C63\\u0C81\\u0CBC\\u0CBF\\u0CC6\\u0CCC-\\u0CCD\\u0CE2-\\u0CE3\\u0D00-\\u0D01\\u0D3B-\\u0D3C\\u0D41-\\u0D44\\u0D4D\\u0D62-\\u0D63\\u0DCA\\u0DD2-\\u0DD4\\u0DD6\\u0E31\\u0E34-\\u0E3A\\u0E47-\\u0E4E\\u0EB1\\u0EB4-\\u0EB9\\u0EBB-\\u0EBC\\u0EC8-\\u0ECD\\u0F18-\\u0F19\\u0F35\\u0F37\\u0F39\\u0F71-\\u0F7E\\u0F80-\\u0F84\\u0F86-\\u0F87\\u0F8D-\\u0F97\\u0F99-\\u0FBC\\u0FC6\\u102D-\\u1030\\u1032-\\u1037\\u1039-\\u103A\\u103D-\\u103E\\u1058-\\u1059\\u105E-\\u1060\\u1071-\\u1074\\u1082\\u1085-\\u1086\\u108D\\u109D\\u135D-\\u135F\\u1712-\\u1714\\u1732-\\u1734\\u1752-\\u1753\\u1772-\\u1773\\u17B4-\\u17B5\\u17B7-\\u17BD\\u17C6\\u17C9-\\u17D3\\u17DD\\u180B-\\u180D\\u1885-\\u1886\\u18A9\\u1920-\\u1922\\u1927-\\u1928\\u1932\\u1939-\\u193B\\u1A17-\\u1A18\\u1A1B\\u1A56\\u1A58-\\u1A5E\\u1A60\\u1A62\\u1A65-\\u1A6C\\u1A73-\\u1A7C\\u1A7F\\u1AB0-\\u1ABD\\u1B00-\\u1B03\\u1B34\\u1B36-\\u1B3A\\u1B3C\\u1B42\\u1B6B-\\u1B73\\u1B80-\\u1B81\\u1BA2-\\u1BA5\\u1BA8-\\u1BA9\\u1BAB-\\u1BAD\\u1BE6\\u1BE8-\\u1BE9\\u1BED\\u1BEF-\\u1BF1\\u1C2C-\\u1C33\\u1C36-\\u1C37\\u1CD0-\\u1CD2\\u1CD4-\\u1CE0\\u1CE2-\\u1CE8\\u1CED\\u1CF4\\u1CF8-\\u1CF9\\u1DC0-\\u1DF9\\u1DFB-\\u1DFF\\u20D0-\\u20DC\\u20E1\\u20E5-\\u20F0\\u2CEF-\\u2CF1\\u2D7F\\u2DE0-\\u2DFF\\u302A-\\u302D\\u3099-\\u309A\\uA66F\\uA674-\\uA67D\\uA69E-\\uA69F\\uA6F0-\\uA6F1\\uA802\\uA806\\uA80B\\uA825-\\uA826\\uA8C4-\\uA8C5\\uA8E0-\\uA8F1\\uA8FF\\uA926-\\uA92D\\uA947-\\uA951\\uA980-\\uA982\\uA9B3\\uA9B6-\\uA9B9\\uA9BC\\uA9E5\\uAA29-\\uAA2E\\uAA31-\\uAA32\\uAA35-\\uAA36\\uAA43\\uAA4C\\uAA7C\\uAAB0\\uAAB2-\\uAAB4\\uAAB7-\\uAAB8\\uAABE-\\uAABF\\uAAC1\\uAAEC-\\uAAED\\uAAF6\\uABE5\\uABE8\\uABED\\uFB1E\\uFE00-\\uFE0F\\uFE20-\\uFE2F]",
 				chars:      []rune{'ֿ', 'ׇ', 'ٰ', 'ܑ', '߽', 'ऺ', '़', '्', 'ঁ', '়', '্', '৾', '਼', 'ੑ', 'ੵ', '઼', '્', 'ଁ', '଼', 'ି', '୍', 'ୖ', 'ஂ', 'ீ', '்', 'ఀ', 'ఄ', 'ಁ', '಼', 'ಿ', 'ೆ', '്', '්', 'ූ', 'ั', 'ັ', '༵', '༷', '༹', '࿆', 'ႂ', 'ႍ', 'ႝ', 'ំ', '៝', 'ᢩ', 'ᤲ', 'ᨛ', 'ᩖ', '᩠', 'ᩢ', '᩿', '᬴', 'ᬼ', 'ᭂ', '᯦', 'ᯭ', '᳭', '᳴', '⃡', '⵿', '꙯', 'ꠂ', '꠆', 'ꠋ', 'ꣿ', '꦳', 'ꦼ', 'ꧥ', 'ꩃ', 'ꩌ', 'ꩼ', 'ꪰ', '꫁', '꫶', 'ꯥ', 'ꯨ', '꯭', 'ﬞ'},
 				ranges:     []rune{'̀', 'ͯ', '҃', '҇', '֑', 'ֽ', 'ׁ', 'ׂ', 'ׄ', 'ׅ', 'ؐ', 'ؚ', 'ً', 'ٟ', 'ۖ', 'ۜ', '۟', 'ۤ', 'ۧ', 'ۨ', '۪', 'ۭ', 'ܰ', '݊', 'ަ', 'ް', '߫', '߳', 'ࠖ', '࠙', 'ࠛ', 'ࠣ', 'ࠥ', 'ࠧ', 'ࠩ', '࠭', '࡙', '࡛', '࣓', '࣡', 'ࣣ', 'ं', 'ु', 'ै', '॑', 'ॗ', 'ॢ', 'ॣ', 'ু', 'ৄ', 'ৢ', 'ৣ', 'ਁ', 'ਂ', 'ੁ', 'ੂ', 'ੇ', 'ੈ', 'ੋ', '੍', 'ੰ', 'ੱ', 'ઁ', 'ં', 'ુ', 'ૅ', 'ે', 'ૈ', 'ૢ', 'ૣ', 'ૺ', '૿', 'ୁ', 'ୄ', 'ୢ', 'ୣ', 'ా', 'ీ', 'ె', 'ై', 'ొ', '్', 'ౕ', 'ౖ', 'ౢ', 'ౣ', 'ೌ', '್', 'ೢ', 'ೣ', 'ഀ', 'ഁ', '഻', '഼', 'ു', 'ൄ', 'ൢ', 'ൣ', 'ි', 'ු', 'ิ', 'ฺ', '็', '๎', 'ິ', 'ູ', 'ົ', 'ຼ', '່', 'ໍ', '༘', '༙', 'ཱ', 'ཾ', 'ྀ', '྄', '྆', '྇', 'ྍ', 'ྗ', 'ྙ', 'ྼ', 'ိ', 'ူ', 'ဲ', '့', '္', '်', 'ွ', 'ှ', 'ၘ', 'ၙ', 'ၞ', 'ၠ', 'ၱ', 'ၴ', 'ႅ', 'ႆ', '፝', '፟', 'ᜒ', '᜔', 'ᜲ', '᜴', 'ᝒ', 'ᝓ', 'ᝲ', 'ᝳ', '឴', '឵', 'ិ', 'ួ', '៉', '៓', '᠋', '᠍', 'ᢅ', 'ᢆ', 'ᤠ', 'ᤢ', 'ᤧ', 'ᤨ', '᤹', '᤻', 'ᨗ', 'ᨘ', 'ᩘ', 'ᩞ', 'ᩥ', 'ᩬ', 'ᩳ', '᩼', '᪰', '᪽', 'ᬀ', 'ᬃ', 'ᬶ', 'ᬺ', '᭫', '᭳', 'ᮀ', 'ᮁ', 'ᮢ', 'ᮥ', 'ᮨ', 'ᮩ', '᮫', 'ᮭ', 'ᯨ', 'ᯩ', 'ᯯ', 'ᯱ', 'ᰬ', 'ᰳ', 'ᰶ', '᰷', '᳐', '᳒', '᳔', '᳠', '᳢', '᳨', '᳸', '᳹', '᷀', '᷹', '᷻', '᷿', '⃐', '⃜', '⃥', '⃰', '⳯', '⳱', 'ⷠ', 'ⷿ', '〪', '〭', '゙', '゚', 'ꙴ', '꙽', 'ꚞ', 'ꚟ', '꛰', '꛱', 'ꠥ', 'ꠦ', '꣄', 'ꣅ', '꣠', '꣱', 'ꤦ', '꤭', 'ꥇ', 'ꥑ', 'ꦀ', 'ꦂ', 'ꦶ', 'ꦹ', 'ꨩ', 'ꨮ', 'ꨱ', 'ꨲ', 'ꨵ', 'ꨶ', 'ꪲ', 'ꪴ', 'ꪷ', 'ꪸ', 'ꪾ', '꪿', 'ꫬ', 'ꫭ', '︀', '️', '︠', '︯'},
@@ -13539,9 +14562,9 @@ var g = &grammar{
 		},
 		{
 			name: "Nd",
-			pos:  position{line: 1886, col: 1, offset: 59966},
+			pos:  position{line: 2056, col: 1, offset: 64612},
 			expr: &charClassMatcher{
-				pos:        position{line: 1886, col: 6, offset: 59971},
+				pos:        position{line: 2056, col: 6, offset: 64617},
 				val:        "[\\u0030-\\u0039\\u0660-\\u0669\\u06F0-\\u06F9\\u07C0-\\u07C9\\u0966-\\u096F\\u09E6-\\u09EF\\u0A66-\\u0A6F\\u0AE6-\\u0AEF\\u0B66-\\u0B6F\\u0BE6-\\u0BEF\\u0C66-\\u0C6F\\u0CE6-\\u0CEF\\u0D66-\\u0D6F\\u0DE6-\\u0DEF\\u0E50-\\u0E59\\u0ED0-\\u0ED9\\u0F20-\\u0F29\\u1040-\\u1049\\u1090-\\u1099\\u17E0-\\u17E9\\u1810-\\u1819\\u1946-\\u194F\\u19D0-\\u19D9\\u1A80-\\u1A89\\u1A90-\\u1A99\\u1B50-\\u1B59\\u1BB0-\\u1BB9\\u1C40-\\u1C49\\u1C50-\\u1C59\\uA620-\\uA629\\uA8D0-\\uA8D9\\uA900-\\uA909\\uA9D0-\\uA9D9\\uA9F0-\\uA9F9\\uAA50-\\uAA59\\uABF0-\\uABF9\\uFF10-\\uFF19]",
 				ranges:     []rune{'0', '9', '٠', '٩', '۰', '۹', '߀', '߉', '०', '९', '০', '৯', '੦', '੯', '૦', '૯', '୦', '୯', '௦', '௯', '౦', '౯', '೦', '೯', '൦', '൯', '෦', '෯', '๐', '๙', '໐', '໙', '༠', '༩', '၀', '၉', '႐', '႙', '០', '៩', '᠐', '᠙', '᥆', '᥏', '᧐', '᧙', '᪀', '᪉', '᪐', '᪙', '᭐', '᭙', '᮰', '᮹', '᱀', '᱉', '᱐', '᱙', '꘠', '꘩', '꣐', '꣙', '꤀', '꤉', '꧐', '꧙', '꧰', '꧹', '꩐', '꩙', '꯰', '꯹', '０', '９'},
 				ignoreCase: false,
@@ -13552,9 +14575,9 @@ var g = &grammar{
 		},
 		{
 			name: "Nl",
-			pos:  position{line: 1889, col: 1, offset: 60474},
+			pos:  position{line: 2059, col: 1, offset: 65120},
 			expr: &charClassMatcher{
-				pos:        position{line: 1889, col: 6, offset: 60479},
+				pos:        position{line: 2059, col: 6, offset: 65125},
 				val:        "[\\u16EE-\\u16F0\\u2160-\\u2182\\u2185-\\u2188\\u3007\\u3021-\\u3029\\u3038-\\u303A\\uA6E6-\\uA6EF]",
 				chars:      []rune{'〇'},
 				ranges:     []rune{'ᛮ', 'ᛰ', 'Ⅰ', 'ↂ', 'ↅ', 'ↈ', '〡', '〩', '〸', '〺', 'ꛦ', 'ꛯ'},
@@ -13566,9 +14589,9 @@ var g = &grammar{
 		},
 		{
 			name: "Pc",
-			pos:  position{line: 1892, col: 1, offset: 60593},
+			pos:  position{line: 2062, col: 1, offset: 65239},
 			expr: &charClassMatcher{
-				pos:        position{line: 1892, col: 6, offset: 60598},
+				pos:        position{line: 2062, col: 6, offset: 65244},
 				val:        "[\\u005F\\u203F-\\u2040\\u2054\\uFE33-\\uFE34\\uFE4D-\\uFE4F\\uFF3F]",
 				chars:      []rune{'_', '⁔', '＿'},
 				ranges:     []rune{'‿', '⁀', '︳', '︴', '﹍', '﹏'},
@@ -13580,9 +14603,9 @@ var g = &grammar{
 		},
 		{
 			name: "Zs",
-			pos:  position{line: 1895, col: 1, offset: 60679},
+			pos:  position{line: 2065, col: 1, offset: 65325},
 			expr: &charClassMatcher{
-				pos:        position{line: 1895, col: 6, offset: 60684},
+				pos:        position{line: 2065, col: 6, offset: 65330},
 				val:        "[\\u0020\\u00A0\\u1680\\u2000-\\u200A\\u202F\\u205F\\u3000]",
 				chars:      []rune{' ', '\u00a0', '\u1680', '\u202f', '\u205f', '\u3000'},
 				ranges:     []rune{'\u2000', '\u200a'},
@@ -13594,9 +14617,9 @@ var g = &grammar{
 		},
 		{
 			name: "SourceCharacter",
-			pos:  position{line: 1897, col: 1, offset: 60737},
+			pos:  position{line: 2067, col: 1, offset: 65383},
 			expr: &anyMatcher{
-				line: 1898, col: 5, offset: 60757,
+				line: 2068, col: 5, offset: 65403,
 			},
 			leader:        false,
 			leftRecursive: false,
@@ -13604,48 +14627,48 @@ var g = &grammar{
 		{
 			name:        "WhiteSpace",
 			displayName: "\"whitespace\"",
-			pos:         position{line: 1900, col: 1, offset: 60760},
+			pos:         position{line: 2070, col: 1, offset: 65406},
 			expr: &choiceExpr{
-				pos: position{line: 1901, col: 5, offset: 60788},
+				pos: position{line: 2071, col: 5, offset: 65434},
 				alternatives: []any{
 					&litMatcher{
-						pos:        position{line: 1901, col: 5, offset: 60788},
+						pos:        position{line: 2071, col: 5, offset: 65434},
 						val:        "\t",
 						ignoreCase: false,
 						want:       "\"\\t\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1902, col: 5, offset: 60797},
+						pos:        position{line: 2072, col: 5, offset: 65443},
 						val:        "\v",
 						ignoreCase: false,
 						want:       "\"\\v\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1903, col: 5, offset: 60806},
+						pos:        position{line: 2073, col: 5, offset: 65452},
 						val:        "\f",
 						ignoreCase: false,
 						want:       "\"\\f\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1904, col: 5, offset: 60815},
+						pos:        position{line: 2074, col: 5, offset: 65461},
 						val:        " ",
 						ignoreCase: false,
 						want:       "\" \"",
 					},
 					&litMatcher{
-						pos:        position{line: 1905, col: 5, offset: 60823},
+						pos:        position{line: 2075, col: 5, offset: 65469},
 						val:        "\u00a0",
 						ignoreCase: false,
 						want:       "\"\\u00a0\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1906, col: 5, offset: 60836},
+						pos:        position{line: 2076, col: 5, offset: 65482},
 						val:        "\ufeff",
 						ignoreCase: false,
 						want:       "\"\\ufeff\"",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1907, col: 5, offset: 60849},
+						pos:  position{line: 2077, col: 5, offset: 65495},
 						name: "Zs",
 					},
 				},
@@ -13655,9 +14678,9 @@ var g = &grammar{
 		},
 		{
 			name: "LineTerminator",
-			pos:  position{line: 1909, col: 1, offset: 60853},
+			pos:  position{line: 2079, col: 1, offset: 65499},
 			expr: &charClassMatcher{
-				pos:        position{line: 1910, col: 5, offset: 60872},
+				pos:        position{line: 2080, col: 5, offset: 65518},
 				val:        "[\\n\\r\\u2028\\u2029]",
 				chars:      []rune{'\n', '\r', '\u2028', '\u2029'},
 				ignoreCase: false,
@@ -13669,9 +14692,9 @@ var g = &grammar{
 		{
 			name:        "Comment",
 			displayName: "\"comment\"",
-			pos:         position{line: 1916, col: 1, offset: 61202},
+			pos:         position{line: 2086, col: 1, offset: 65848},
 			expr: &ruleRefExpr{
-				pos:  position{line: 1919, col: 5, offset: 61273},
+				pos:  position{line: 2089, col: 5, offset: 65919},
 				name: "SingleLineComment",
 			},
 			leader:        false,
@@ -13679,39 +14702,39 @@ var g = &grammar{
 		},
 		{
 			name: "MultiLineComment",
-			pos:  position{line: 1921, col: 1, offset: 61292},
+			pos:  position{line: 2091, col: 1, offset: 65938},
 			expr: &seqExpr{
-				pos: position{line: 1922, col: 5, offset: 61313},
+				pos: position{line: 2092, col: 5, offset: 65959},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 1922, col: 5, offset: 61313},
+						pos:        position{line: 2092, col: 5, offset: 65959},
 						val:        "/*",
 						ignoreCase: false,
 						want:       "\"/*\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1922, col: 10, offset: 61318},
+						pos: position{line: 2092, col: 10, offset: 65964},
 						expr: &seqExpr{
-							pos: position{line: 1922, col: 11, offset: 61319},
+							pos: position{line: 2092, col: 11, offset: 65965},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1922, col: 11, offset: 61319},
+									pos: position{line: 2092, col: 11, offset: 65965},
 									expr: &litMatcher{
-										pos:        position{line: 1922, col: 12, offset: 61320},
+										pos:        position{line: 2092, col: 12, offset: 65966},
 										val:        "*/",
 										ignoreCase: false,
 										want:       "\"*/\"",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1922, col: 17, offset: 61325},
+									pos:  position{line: 2092, col: 17, offset: 65971},
 									name: "SourceCharacter",
 								},
 							},
 						},
 					},
 					&litMatcher{
-						pos:        position{line: 1922, col: 35, offset: 61343},
+						pos:        position{line: 2092, col: 35, offset: 65989},
 						val:        "*/",
 						ignoreCase: false,
 						want:       "\"*/\"",
@@ -13723,33 +14746,33 @@ var g = &grammar{
 		},
 		{
 			name: "SingleLineComment",
-			pos:  position{line: 1924, col: 1, offset: 61349},
+			pos:  position{line: 2094, col: 1, offset: 65995},
 			expr: &choiceExpr{
-				pos: position{line: 1925, col: 5, offset: 61371},
+				pos: position{line: 2095, col: 5, offset: 66017},
 				alternatives: []any{
 					&seqExpr{
-						pos: position{line: 1925, col: 5, offset: 61371},
+						pos: position{line: 2095, col: 5, offset: 66017},
 						exprs: []any{
 							&litMatcher{
-								pos:        position{line: 1925, col: 5, offset: 61371},
+								pos:        position{line: 2095, col: 5, offset: 66017},
 								val:        "//",
 								ignoreCase: false,
 								want:       "\"//\"",
 							},
 							&zeroOrMoreExpr{
-								pos: position{line: 1925, col: 10, offset: 61376},
+								pos: position{line: 2095, col: 10, offset: 66022},
 								expr: &seqExpr{
-									pos: position{line: 1925, col: 11, offset: 61377},
+									pos: position{line: 2095, col: 11, offset: 66023},
 									exprs: []any{
 										&notExpr{
-											pos: position{line: 1925, col: 11, offset: 61377},
+											pos: position{line: 2095, col: 11, offset: 66023},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1925, col: 12, offset: 61378},
+												pos:  position{line: 2095, col: 12, offset: 66024},
 												name: "LineTerminator",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1925, col: 27, offset: 61393},
+											pos:  position{line: 2095, col: 27, offset: 66039},
 											name: "SourceCharacter",
 										},
 									},
@@ -13758,28 +14781,28 @@ var g = &grammar{
 						},
 					},
 					&seqExpr{
-						pos: position{line: 1926, col: 5, offset: 61415},
+						pos: position{line: 2096, col: 5, offset: 66061},
 						exprs: []any{
 							&litMatcher{
-								pos:        position{line: 1926, col: 5, offset: 61415},
+								pos:        position{line: 2096, col: 5, offset: 66061},
 								val:        "--",
 								ignoreCase: false,
 								want:       "\"--\"",
 							},
 							&zeroOrMoreExpr{
-								pos: position{line: 1926, col: 10, offset: 61420},
+								pos: position{line: 2096, col: 10, offset: 66066},
 								expr: &seqExpr{
-									pos: position{line: 1926, col: 11, offset: 61421},
+									pos: position{line: 2096, col: 11, offset: 66067},
 									exprs: []any{
 										&notExpr{
-											pos: position{line: 1926, col: 11, offset: 61421},
+											pos: position{line: 2096, col: 11, offset: 66067},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1926, col: 12, offset: 61422},
+												pos:  position{line: 2096, col: 12, offset: 66068},
 												name: "LineTerminator",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1926, col: 27, offset: 61437},
+											pos:  position{line: 2096, col: 27, offset: 66083},
 											name: "SourceCharacter",
 										},
 									},
@@ -13794,19 +14817,19 @@ var g = &grammar{
 		},
 		{
 			name: "EOL",
-			pos:  position{line: 1928, col: 1, offset: 61456},
+			pos:  position{line: 2098, col: 1, offset: 66102},
 			expr: &seqExpr{
-				pos: position{line: 1928, col: 7, offset: 61462},
+				pos: position{line: 2098, col: 7, offset: 66108},
 				exprs: []any{
 					&zeroOrMoreExpr{
-						pos: position{line: 1928, col: 7, offset: 61462},
+						pos: position{line: 2098, col: 7, offset: 66108},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1928, col: 7, offset: 61462},
+							pos:  position{line: 2098, col: 7, offset: 66108},
 							name: "WhiteSpace",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1928, col: 19, offset: 61474},
+						pos:  position{line: 2098, col: 19, offset: 66120},
 						name: "LineTerminator",
 					},
 				},
@@ -13816,16 +14839,16 @@ var g = &grammar{
 		},
 		{
 			name: "EOT",
-			pos:  position{line: 1930, col: 1, offset: 61490},
+			pos:  position{line: 2100, col: 1, offset: 66136},
 			expr: &choiceExpr{
-				pos: position{line: 1930, col: 7, offset: 61496},
+				pos: position{line: 2100, col: 7, offset: 66142},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1930, col: 7, offset: 61496},
+						pos:  position{line: 2100, col: 7, offset: 66142},
 						name: "_",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1930, col: 11, offset: 61500},
+						pos:  position{line: 2100, col: 11, offset: 66146},
 						name: "EOF",
 					},
 				},
@@ -13835,11 +14858,11 @@ var g = &grammar{
 		},
 		{
 			name: "EOF",
-			pos:  position{line: 1932, col: 1, offset: 61505},
+			pos:  position{line: 2102, col: 1, offset: 66151},
 			expr: &notExpr{
-				pos: position{line: 1932, col: 7, offset: 61511},
+				pos: position{line: 2102, col: 7, offset: 66157},
 				expr: &anyMatcher{
-					line: 1932, col: 8, offset: 61512,
+					line: 2102, col: 8, offset: 66158,
 				},
 			},
 			leader:        false,
@@ -13847,11 +14870,11 @@ var g = &grammar{
 		},
 		{
 			name: "EOKW",
-			pos:  position{line: 1934, col: 1, offset: 61515},
+			pos:  position{line: 2104, col: 1, offset: 66161},
 			expr: &notExpr{
-				pos: position{line: 1934, col: 8, offset: 61522},
+				pos: position{line: 2104, col: 8, offset: 66168},
 				expr: &ruleRefExpr{
-					pos:  position{line: 1934, col: 9, offset: 61523},
+					pos:  position{line: 2104, col: 9, offset: 66169},
 					name: "KeyWordChars",
 				},
 			},
@@ -13860,15 +14883,15 @@ var g = &grammar{
 		},
 		{
 			name: "SQLPipe",
-			pos:  position{line: 1938, col: 1, offset: 61559},
+			pos:  position{line: 2108, col: 1, offset: 66205},
 			expr: &actionExpr{
-				pos: position{line: 1939, col: 5, offset: 61572},
+				pos: position{line: 2109, col: 5, offset: 66218},
 				run: (*parser).callonSQLPipe1,
 				expr: &labeledExpr{
-					pos:   position{line: 1939, col: 5, offset: 61572},
+					pos:   position{line: 2109, col: 5, offset: 66218},
 					label: "s",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1939, col: 7, offset: 61574},
+						pos:  position{line: 2109, col: 7, offset: 66220},
 						name: "Seq",
 					},
 				},
@@ -13878,27 +14901,27 @@ var g = &grammar{
 		},
 		{
 			name: "SelectOp",
-			pos:  position{line: 1947, col: 1, offset: 61720},
+			pos:  position{line: 2117, col: 1, offset: 66366},
 			expr: &actionExpr{
-				pos: position{line: 1947, col: 12, offset: 61731},
+				pos: position{line: 2117, col: 12, offset: 66377},
 				run: (*parser).callonSelectOp1,
 				expr: &seqExpr{
-					pos: position{line: 1947, col: 12, offset: 61731},
+					pos: position{line: 2117, col: 12, offset: 66377},
 					exprs: []any{
 						&notExpr{
-							pos: position{line: 1947, col: 12, offset: 61731},
+							pos: position{line: 2117, col: 12, offset: 66377},
 							expr: &litMatcher{
-								pos:        position{line: 1947, col: 13, offset: 61732},
+								pos:        position{line: 2117, col: 13, offset: 66378},
 								val:        "(",
 								ignoreCase: false,
 								want:       "\"(\"",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1947, col: 17, offset: 61736},
+							pos:   position{line: 2117, col: 17, offset: 66382},
 							label: "op",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1947, col: 20, offset: 61739},
+								pos:  position{line: 2117, col: 20, offset: 66385},
 								name: "SelectExpr",
 							},
 						},
@@ -13910,73 +14933,73 @@ var g = &grammar{
 		},
 		{
 			name: "SelectExpr",
-			pos:  position{line: 1949, col: 1, offset: 61769},
+			pos:  position{line: 2119, col: 1, offset: 66415},
 			expr: &actionExpr{
-				pos: position{line: 1950, col: 5, offset: 61785},
+				pos: position{line: 2120, col: 5, offset: 66431},
 				run: (*parser).callonSelectExpr1,
 				expr: &seqExpr{
-					pos: position{line: 1950, col: 5, offset: 61785},
+					pos: position{line: 2120, col: 5, offset: 66431},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1950, col: 5, offset: 61785},
+							pos:   position{line: 2120, col: 5, offset: 66431},
 							label: "with",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1950, col: 10, offset: 61790},
+								pos:  position{line: 2120, col: 10, offset: 66436},
 								name: "OptWithClause",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1951, col: 5, offset: 61808},
+							pos:   position{line: 2121, col: 5, offset: 66454},
 							label: "body",
 							expr: &choiceExpr{
-								pos: position{line: 1952, col: 9, offset: 61823},
+								pos: position{line: 2122, col: 9, offset: 66469},
 								alternatives: []any{
 									&ruleRefExpr{
-										pos:  position{line: 1952, col: 9, offset: 61823},
+										pos:  position{line: 2122, col: 9, offset: 66469},
 										name: "SetOperation",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1953, col: 9, offset: 61844},
+										pos:  position{line: 2123, col: 9, offset: 66490},
 										name: "Select",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1954, col: 9, offset: 61859},
+										pos:  position{line: 2124, col: 9, offset: 66505},
 										name: "FromSelect",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1955, col: 9, offset: 61878},
+										pos:  position{line: 2125, col: 9, offset: 66524},
 										name: "Values",
 									},
 									&actionExpr{
-										pos: position{line: 1956, col: 9, offset: 61893},
+										pos: position{line: 2126, col: 9, offset: 66539},
 										run: (*parser).callonSelectExpr11,
 										expr: &seqExpr{
-											pos: position{line: 1956, col: 9, offset: 61893},
+											pos: position{line: 2126, col: 9, offset: 66539},
 											exprs: []any{
 												&litMatcher{
-													pos:        position{line: 1956, col: 9, offset: 61893},
+													pos:        position{line: 2126, col: 9, offset: 66539},
 													val:        "(",
 													ignoreCase: false,
 													want:       "\"(\"",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1956, col: 13, offset: 61897},
+													pos:  position{line: 2126, col: 13, offset: 66543},
 													name: "__",
 												},
 												&labeledExpr{
-													pos:   position{line: 1956, col: 16, offset: 61900},
+													pos:   position{line: 2126, col: 16, offset: 66546},
 													label: "s",
 													expr: &ruleRefExpr{
-														pos:  position{line: 1956, col: 18, offset: 61902},
+														pos:  position{line: 2126, col: 18, offset: 66548},
 														name: "SQLPipe",
 													},
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1956, col: 26, offset: 61910},
+													pos:  position{line: 2126, col: 26, offset: 66556},
 													name: "__",
 												},
 												&litMatcher{
-													pos:        position{line: 1956, col: 28, offset: 61912},
+													pos:        position{line: 2126, col: 28, offset: 66558},
 													val:        ")",
 													ignoreCase: false,
 													want:       "\")\"",
@@ -13988,18 +15011,18 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1958, col: 5, offset: 61949},
+							pos:   position{line: 2128, col: 5, offset: 66595},
 							label: "orderby",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1958, col: 13, offset: 61957},
+								pos:  position{line: 2128, col: 13, offset: 66603},
 								name: "OptOrderByClause",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1959, col: 5, offset: 61978},
+							pos:   position{line: 2129, col: 5, offset: 66624},
 							label: "loff",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1959, col: 10, offset: 61983},
+								pos:  position{line: 2129, col: 10, offset: 66629},
 								name: "OptSQLLimitOffset",
 							},
 						},
@@ -14011,74 +15034,74 @@ var g = &grammar{
 		},
 		{
 			name: "Select",
-			pos:  position{line: 1979, col: 1, offset: 62379},
+			pos:  position{line: 2149, col: 1, offset: 67025},
 			expr: &actionExpr{
-				pos: position{line: 1980, col: 5, offset: 62391},
+				pos: position{line: 2150, col: 5, offset: 67037},
 				run: (*parser).callonSelect1,
 				expr: &seqExpr{
-					pos: position{line: 1980, col: 5, offset: 62391},
+					pos: position{line: 2150, col: 5, offset: 67037},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 1980, col: 5, offset: 62391},
+							pos:  position{line: 2150, col: 5, offset: 67037},
 							name: "SELECT",
 						},
 						&labeledExpr{
-							pos:   position{line: 1981, col: 5, offset: 62403},
+							pos:   position{line: 2151, col: 5, offset: 67049},
 							label: "distinct",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1981, col: 14, offset: 62412},
+								pos:  position{line: 2151, col: 14, offset: 67058},
 								name: "OptDistinct",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1982, col: 5, offset: 62428},
+							pos:   position{line: 2152, col: 5, offset: 67074},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1982, col: 11, offset: 62434},
+								pos:  position{line: 2152, col: 11, offset: 67080},
 								name: "OptSelectValue",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1985, col: 5, offset: 62574},
+							pos:  position{line: 2155, col: 5, offset: 67220},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 1985, col: 7, offset: 62576},
+							pos:   position{line: 2155, col: 7, offset: 67222},
 							label: "selection",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1985, col: 17, offset: 62586},
+								pos:  position{line: 2155, col: 17, offset: 67232},
 								name: "Selection",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1986, col: 5, offset: 62600},
+							pos:   position{line: 2156, col: 5, offset: 67246},
 							label: "from",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1986, col: 10, offset: 62605},
+								pos:  position{line: 2156, col: 10, offset: 67251},
 								name: "OptFromClause",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1987, col: 5, offset: 62623},
+							pos:   position{line: 2157, col: 5, offset: 67269},
 							label: "where",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1987, col: 11, offset: 62629},
+								pos:  position{line: 2157, col: 11, offset: 67275},
 								name: "OptWhereClause",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1988, col: 5, offset: 62648},
+							pos:   position{line: 2158, col: 5, offset: 67294},
 							label: "group",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1988, col: 11, offset: 62654},
+								pos:  position{line: 2158, col: 11, offset: 67300},
 								name: "OptGroupClause",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1989, col: 5, offset: 62673},
+							pos:   position{line: 2159, col: 5, offset: 67319},
 							label: "having",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1989, col: 12, offset: 62680},
+								pos:  position{line: 2159, col: 12, offset: 67326},
 								name: "OptHavingClause",
 							},
 						},
@@ -14090,78 +15113,78 @@ var g = &grammar{
 		},
 		{
 			name: "FromSelect",
-			pos:  position{line: 2015, col: 1, offset: 63286},
+			pos:  position{line: 2185, col: 1, offset: 67932},
 			expr: &actionExpr{
-				pos: position{line: 2016, col: 5, offset: 63301},
+				pos: position{line: 2186, col: 5, offset: 67947},
 				run: (*parser).callonFromSelect1,
 				expr: &seqExpr{
-					pos: position{line: 2016, col: 5, offset: 63301},
+					pos: position{line: 2186, col: 5, offset: 67947},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 2016, col: 5, offset: 63301},
+							pos:   position{line: 2186, col: 5, offset: 67947},
 							label: "from",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2016, col: 10, offset: 63306},
+								pos:  position{line: 2186, col: 10, offset: 67952},
 								name: "FromOp",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2016, col: 17, offset: 63313},
+							pos:  position{line: 2186, col: 17, offset: 67959},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2016, col: 19, offset: 63315},
+							pos:  position{line: 2186, col: 19, offset: 67961},
 							name: "SELECT",
 						},
 						&labeledExpr{
-							pos:   position{line: 2017, col: 5, offset: 63327},
+							pos:   position{line: 2187, col: 5, offset: 67973},
 							label: "distinct",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2017, col: 14, offset: 63336},
+								pos:  position{line: 2187, col: 14, offset: 67982},
 								name: "OptDistinct",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2018, col: 5, offset: 63352},
+							pos:   position{line: 2188, col: 5, offset: 67998},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2018, col: 11, offset: 63358},
+								pos:  position{line: 2188, col: 11, offset: 68004},
 								name: "OptSelectValue",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2021, col: 5, offset: 63498},
+							pos:  position{line: 2191, col: 5, offset: 68144},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 2021, col: 7, offset: 63500},
+							pos:   position{line: 2191, col: 7, offset: 68146},
 							label: "selection",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2021, col: 17, offset: 63510},
+								pos:  position{line: 2191, col: 17, offset: 68156},
 								name: "Selection",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2022, col: 5, offset: 63524},
+							pos:   position{line: 2192, col: 5, offset: 68170},
 							label: "where",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2022, col: 11, offset: 63530},
+								pos:  position{line: 2192, col: 11, offset: 68176},
 								name: "OptWhereClause",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2023, col: 5, offset: 63549},
+							pos:   position{line: 2193, col: 5, offset: 68195},
 							label: "group",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2023, col: 11, offset: 63555},
+								pos:  position{line: 2193, col: 11, offset: 68201},
 								name: "OptGroupClause",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2024, col: 5, offset: 63574},
+							pos:   position{line: 2194, col: 5, offset: 68220},
 							label: "having",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2024, col: 12, offset: 63581},
+								pos:  position{line: 2194, col: 12, offset: 68227},
 								name: "OptHavingClause",
 							},
 						},
@@ -14173,26 +15196,26 @@ var g = &grammar{
 		},
 		{
 			name: "Values",
-			pos:  position{line: 2048, col: 1, offset: 64154},
+			pos:  position{line: 2218, col: 1, offset: 68800},
 			expr: &actionExpr{
-				pos: position{line: 2049, col: 5, offset: 64165},
+				pos: position{line: 2219, col: 5, offset: 68811},
 				run: (*parser).callonValues1,
 				expr: &seqExpr{
-					pos: position{line: 2049, col: 5, offset: 64165},
+					pos: position{line: 2219, col: 5, offset: 68811},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 2049, col: 5, offset: 64165},
+							pos:  position{line: 2219, col: 5, offset: 68811},
 							name: "VALUES",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2049, col: 12, offset: 64172},
+							pos:  position{line: 2219, col: 12, offset: 68818},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 2049, col: 14, offset: 64174},
+							pos:   position{line: 2219, col: 14, offset: 68820},
 							label: "exprs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2049, col: 20, offset: 64180},
+								pos:  position{line: 2219, col: 20, offset: 68826},
 								name: "Exprs",
 							},
 						},
@@ -14204,49 +15227,49 @@ var g = &grammar{
 		},
 		{
 			name: "OptDistinct",
-			pos:  position{line: 2057, col: 1, offset: 64326},
+			pos:  position{line: 2227, col: 1, offset: 68972},
 			expr: &choiceExpr{
-				pos: position{line: 2058, col: 5, offset: 64342},
+				pos: position{line: 2228, col: 5, offset: 68988},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2058, col: 5, offset: 64342},
+						pos: position{line: 2228, col: 5, offset: 68988},
 						run: (*parser).callonOptDistinct2,
 						expr: &seqExpr{
-							pos: position{line: 2058, col: 5, offset: 64342},
+							pos: position{line: 2228, col: 5, offset: 68988},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2058, col: 5, offset: 64342},
+									pos:  position{line: 2228, col: 5, offset: 68988},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2058, col: 7, offset: 64344},
+									pos:  position{line: 2228, col: 7, offset: 68990},
 									name: "ALL",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2059, col: 5, offset: 64381},
+						pos: position{line: 2229, col: 5, offset: 69027},
 						run: (*parser).callonOptDistinct6,
 						expr: &seqExpr{
-							pos: position{line: 2059, col: 5, offset: 64381},
+							pos: position{line: 2229, col: 5, offset: 69027},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2059, col: 5, offset: 64381},
+									pos:  position{line: 2229, col: 5, offset: 69027},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2059, col: 7, offset: 64383},
+									pos:  position{line: 2229, col: 7, offset: 69029},
 									name: "DISTINCT",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2060, col: 5, offset: 64419},
+						pos: position{line: 2230, col: 5, offset: 69065},
 						run: (*parser).callonOptDistinct10,
 						expr: &litMatcher{
-							pos:        position{line: 2060, col: 5, offset: 64419},
+							pos:        position{line: 2230, col: 5, offset: 69065},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -14259,57 +15282,57 @@ var g = &grammar{
 		},
 		{
 			name: "OptSelectValue",
-			pos:  position{line: 2062, col: 1, offset: 64458},
+			pos:  position{line: 2232, col: 1, offset: 69104},
 			expr: &choiceExpr{
-				pos: position{line: 2063, col: 5, offset: 64477},
+				pos: position{line: 2233, col: 5, offset: 69123},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2063, col: 5, offset: 64477},
+						pos: position{line: 2233, col: 5, offset: 69123},
 						run: (*parser).callonOptSelectValue2,
 						expr: &seqExpr{
-							pos: position{line: 2063, col: 5, offset: 64477},
+							pos: position{line: 2233, col: 5, offset: 69123},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2063, col: 5, offset: 64477},
+									pos:  position{line: 2233, col: 5, offset: 69123},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2063, col: 7, offset: 64479},
+									pos:  position{line: 2233, col: 7, offset: 69125},
 									name: "AS",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2063, col: 10, offset: 64482},
+									pos:  position{line: 2233, col: 10, offset: 69128},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2063, col: 12, offset: 64484},
+									pos:  position{line: 2233, col: 12, offset: 69130},
 									name: "VALUE",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2064, col: 5, offset: 64516},
+						pos: position{line: 2234, col: 5, offset: 69162},
 						run: (*parser).callonOptSelectValue8,
 						expr: &seqExpr{
-							pos: position{line: 2064, col: 5, offset: 64516},
+							pos: position{line: 2234, col: 5, offset: 69162},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2064, col: 5, offset: 64516},
+									pos:  position{line: 2234, col: 5, offset: 69162},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2064, col: 7, offset: 64518},
+									pos:  position{line: 2234, col: 7, offset: 69164},
 									name: "VALUE",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2065, col: 5, offset: 64589},
+						pos: position{line: 2235, col: 5, offset: 69235},
 						run: (*parser).callonOptSelectValue12,
 						expr: &litMatcher{
-							pos:        position{line: 2065, col: 5, offset: 64589},
+							pos:        position{line: 2235, col: 5, offset: 69235},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -14322,19 +15345,19 @@ var g = &grammar{
 		},
 		{
 			name: "OptWithClause",
-			pos:  position{line: 2067, col: 1, offset: 64632},
+			pos:  position{line: 2237, col: 1, offset: 69278},
 			expr: &choiceExpr{
-				pos: position{line: 2068, col: 5, offset: 64651},
+				pos: position{line: 2238, col: 5, offset: 69297},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 2068, col: 5, offset: 64651},
+						pos:  position{line: 2238, col: 5, offset: 69297},
 						name: "WithClause",
 					},
 					&actionExpr{
-						pos: position{line: 2069, col: 5, offset: 64666},
+						pos: position{line: 2239, col: 5, offset: 69312},
 						run: (*parser).callonOptWithClause3,
 						expr: &litMatcher{
-							pos:        position{line: 2069, col: 5, offset: 64666},
+							pos:        position{line: 2239, col: 5, offset: 69312},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -14347,39 +15370,39 @@ var g = &grammar{
 		},
 		{
 			name: "WithClause",
-			pos:  position{line: 2071, col: 1, offset: 64699},
+			pos:  position{line: 2241, col: 1, offset: 69345},
 			expr: &actionExpr{
-				pos: position{line: 2072, col: 5, offset: 64715},
+				pos: position{line: 2242, col: 5, offset: 69361},
 				run: (*parser).callonWithClause1,
 				expr: &seqExpr{
-					pos: position{line: 2072, col: 5, offset: 64715},
+					pos: position{line: 2242, col: 5, offset: 69361},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 2072, col: 5, offset: 64715},
+							pos:  position{line: 2242, col: 5, offset: 69361},
 							name: "WITH",
 						},
 						&labeledExpr{
-							pos:   position{line: 2072, col: 10, offset: 64720},
+							pos:   position{line: 2242, col: 10, offset: 69366},
 							label: "r",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2072, col: 12, offset: 64722},
+								pos:  position{line: 2242, col: 12, offset: 69368},
 								name: "OptRecursive",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2072, col: 25, offset: 64735},
+							pos:  position{line: 2242, col: 25, offset: 69381},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 2072, col: 27, offset: 64737},
+							pos:   position{line: 2242, col: 27, offset: 69383},
 							label: "ctes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2072, col: 32, offset: 64742},
+								pos:  position{line: 2242, col: 32, offset: 69388},
 								name: "CteList",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2072, col: 40, offset: 64750},
+							pos:  position{line: 2242, col: 40, offset: 69396},
 							name: "__",
 						},
 					},
@@ -14390,32 +15413,32 @@ var g = &grammar{
 		},
 		{
 			name: "OptRecursive",
-			pos:  position{line: 2081, col: 1, offset: 64930},
+			pos:  position{line: 2251, col: 1, offset: 69576},
 			expr: &choiceExpr{
-				pos: position{line: 2082, col: 5, offset: 64948},
+				pos: position{line: 2252, col: 5, offset: 69594},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2082, col: 5, offset: 64948},
+						pos: position{line: 2252, col: 5, offset: 69594},
 						run: (*parser).callonOptRecursive2,
 						expr: &seqExpr{
-							pos: position{line: 2082, col: 5, offset: 64948},
+							pos: position{line: 2252, col: 5, offset: 69594},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2082, col: 5, offset: 64948},
+									pos:  position{line: 2252, col: 5, offset: 69594},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2082, col: 7, offset: 64950},
+									pos:  position{line: 2252, col: 7, offset: 69596},
 									name: "RECURSIVE",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2083, col: 5, offset: 64986},
+						pos: position{line: 2253, col: 5, offset: 69632},
 						run: (*parser).callonOptRecursive6,
 						expr: &litMatcher{
-							pos:        position{line: 2083, col: 5, offset: 64986},
+							pos:        position{line: 2253, col: 5, offset: 69632},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -14428,51 +15451,51 @@ var g = &grammar{
 		},
 		{
 			name: "CteList",
-			pos:  position{line: 2085, col: 1, offset: 65025},
+			pos:  position{line: 2255, col: 1, offset: 69671},
 			expr: &actionExpr{
-				pos: position{line: 2085, col: 11, offset: 65035},
+				pos: position{line: 2255, col: 11, offset: 69681},
 				run: (*parser).callonCteList1,
 				expr: &seqExpr{
-					pos: position{line: 2085, col: 11, offset: 65035},
+					pos: position{line: 2255, col: 11, offset: 69681},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 2085, col: 11, offset: 65035},
+							pos:   position{line: 2255, col: 11, offset: 69681},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2085, col: 17, offset: 65041},
+								pos:  position{line: 2255, col: 17, offset: 69687},
 								name: "Cte",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2085, col: 21, offset: 65045},
+							pos:   position{line: 2255, col: 21, offset: 69691},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 2085, col: 26, offset: 65050},
+								pos: position{line: 2255, col: 26, offset: 69696},
 								expr: &actionExpr{
-									pos: position{line: 2085, col: 28, offset: 65052},
+									pos: position{line: 2255, col: 28, offset: 69698},
 									run: (*parser).callonCteList7,
 									expr: &seqExpr{
-										pos: position{line: 2085, col: 28, offset: 65052},
+										pos: position{line: 2255, col: 28, offset: 69698},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 2085, col: 28, offset: 65052},
+												pos:  position{line: 2255, col: 28, offset: 69698},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 2085, col: 31, offset: 65055},
+												pos:        position{line: 2255, col: 31, offset: 69701},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 2085, col: 35, offset: 65059},
+												pos:  position{line: 2255, col: 35, offset: 69705},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 2085, col: 38, offset: 65062},
+												pos:   position{line: 2255, col: 38, offset: 69708},
 												label: "cte",
 												expr: &ruleRefExpr{
-													pos:  position{line: 2085, col: 42, offset: 65066},
+													pos:  position{line: 2255, col: 42, offset: 69712},
 													name: "Cte",
 												},
 											},
@@ -14489,65 +15512,65 @@ var g = &grammar{
 		},
 		{
 			name: "Cte",
-			pos:  position{line: 2089, col: 1, offset: 65135},
+			pos:  position{line: 2259, col: 1, offset: 69781},
 			expr: &actionExpr{
-				pos: position{line: 2090, col: 5, offset: 65143},
+				pos: position{line: 2260, col: 5, offset: 69789},
 				run: (*parser).callonCte1,
 				expr: &seqExpr{
-					pos: position{line: 2090, col: 5, offset: 65143},
+					pos: position{line: 2260, col: 5, offset: 69789},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 2090, col: 5, offset: 65143},
+							pos:   position{line: 2260, col: 5, offset: 69789},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2090, col: 10, offset: 65148},
+								pos:  position{line: 2260, col: 10, offset: 69794},
 								name: "SQLIdentifier",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2090, col: 24, offset: 65162},
+							pos:  position{line: 2260, col: 24, offset: 69808},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2090, col: 26, offset: 65164},
+							pos:  position{line: 2260, col: 26, offset: 69810},
 							name: "AS",
 						},
 						&labeledExpr{
-							pos:   position{line: 2090, col: 29, offset: 65167},
+							pos:   position{line: 2260, col: 29, offset: 69813},
 							label: "m",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2090, col: 31, offset: 65169},
+								pos:  position{line: 2260, col: 31, offset: 69815},
 								name: "OptMaterialized",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2090, col: 47, offset: 65185},
+							pos:  position{line: 2260, col: 47, offset: 69831},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 2090, col: 50, offset: 65188},
+							pos:        position{line: 2260, col: 50, offset: 69834},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2090, col: 54, offset: 65192},
+							pos:  position{line: 2260, col: 54, offset: 69838},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 2090, col: 57, offset: 65195},
+							pos:   position{line: 2260, col: 57, offset: 69841},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2090, col: 59, offset: 65197},
+								pos:  position{line: 2260, col: 59, offset: 69843},
 								name: "SQLPipe",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2090, col: 67, offset: 65205},
+							pos:  position{line: 2260, col: 67, offset: 69851},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 2090, col: 70, offset: 65208},
+							pos:        position{line: 2260, col: 70, offset: 69854},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -14560,65 +15583,65 @@ var g = &grammar{
 		},
 		{
 			name: "OptMaterialized",
-			pos:  position{line: 2099, col: 1, offset: 65391},
+			pos:  position{line: 2269, col: 1, offset: 70037},
 			expr: &choiceExpr{
-				pos: position{line: 2100, col: 5, offset: 65412},
+				pos: position{line: 2270, col: 5, offset: 70058},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2100, col: 5, offset: 65412},
+						pos: position{line: 2270, col: 5, offset: 70058},
 						run: (*parser).callonOptMaterialized2,
 						expr: &seqExpr{
-							pos: position{line: 2100, col: 5, offset: 65412},
+							pos: position{line: 2270, col: 5, offset: 70058},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2100, col: 5, offset: 65412},
+									pos:  position{line: 2270, col: 5, offset: 70058},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2100, col: 7, offset: 65414},
+									pos:  position{line: 2270, col: 7, offset: 70060},
 									name: "MATERIALIZED",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2100, col: 20, offset: 65427},
+									pos:  position{line: 2270, col: 20, offset: 70073},
 									name: "_",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2101, col: 5, offset: 65466},
+						pos: position{line: 2271, col: 5, offset: 70112},
 						run: (*parser).callonOptMaterialized7,
 						expr: &seqExpr{
-							pos: position{line: 2101, col: 5, offset: 65466},
+							pos: position{line: 2271, col: 5, offset: 70112},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2101, col: 5, offset: 65466},
+									pos:  position{line: 2271, col: 5, offset: 70112},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2101, col: 7, offset: 65468},
+									pos:  position{line: 2271, col: 7, offset: 70114},
 									name: "NOT",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2101, col: 11, offset: 65472},
+									pos:  position{line: 2271, col: 11, offset: 70118},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2101, col: 13, offset: 65474},
+									pos:  position{line: 2271, col: 13, offset: 70120},
 									name: "MATERIALIZED",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2101, col: 26, offset: 65487},
+									pos:  position{line: 2271, col: 26, offset: 70133},
 									name: "_",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2102, col: 5, offset: 65518},
+						pos: position{line: 2272, col: 5, offset: 70164},
 						run: (*parser).callonOptMaterialized14,
 						expr: &litMatcher{
-							pos:        position{line: 2102, col: 5, offset: 65518},
+							pos:        position{line: 2272, col: 5, offset: 70164},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -14631,25 +15654,25 @@ var g = &grammar{
 		},
 		{
 			name: "OptAllClause",
-			pos:  position{line: 2104, col: 1, offset: 65573},
+			pos:  position{line: 2274, col: 1, offset: 70219},
 			expr: &choiceExpr{
-				pos: position{line: 2105, col: 5, offset: 65590},
+				pos: position{line: 2275, col: 5, offset: 70236},
 				alternatives: []any{
 					&seqExpr{
-						pos: position{line: 2105, col: 5, offset: 65590},
+						pos: position{line: 2275, col: 5, offset: 70236},
 						exprs: []any{
 							&ruleRefExpr{
-								pos:  position{line: 2105, col: 5, offset: 65590},
+								pos:  position{line: 2275, col: 5, offset: 70236},
 								name: "_",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 2105, col: 7, offset: 65592},
+								pos:  position{line: 2275, col: 7, offset: 70238},
 								name: "ALL",
 							},
 						},
 					},
 					&litMatcher{
-						pos:        position{line: 2106, col: 5, offset: 65601},
+						pos:        position{line: 2276, col: 5, offset: 70247},
 						val:        "",
 						ignoreCase: false,
 						want:       "\"\"",
@@ -14661,25 +15684,25 @@ var g = &grammar{
 		},
 		{
 			name: "OptFromClause",
-			pos:  position{line: 2108, col: 1, offset: 65605},
+			pos:  position{line: 2278, col: 1, offset: 70251},
 			expr: &choiceExpr{
-				pos: position{line: 2109, col: 5, offset: 65623},
+				pos: position{line: 2279, col: 5, offset: 70269},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2109, col: 5, offset: 65623},
+						pos: position{line: 2279, col: 5, offset: 70269},
 						run: (*parser).callonOptFromClause2,
 						expr: &seqExpr{
-							pos: position{line: 2109, col: 5, offset: 65623},
+							pos: position{line: 2279, col: 5, offset: 70269},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2109, col: 5, offset: 65623},
+									pos:  position{line: 2279, col: 5, offset: 70269},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 2109, col: 7, offset: 65625},
+									pos:   position{line: 2279, col: 7, offset: 70271},
 									label: "from",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2109, col: 12, offset: 65630},
+										pos:  position{line: 2279, col: 12, offset: 70276},
 										name: "FromOp",
 									},
 								},
@@ -14687,10 +15710,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2112, col: 5, offset: 65672},
+						pos: position{line: 2282, col: 5, offset: 70318},
 						run: (*parser).callonOptFromClause7,
 						expr: &litMatcher{
-							pos:        position{line: 2112, col: 5, offset: 65672},
+							pos:        position{line: 2282, col: 5, offset: 70318},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -14703,27 +15726,27 @@ var g = &grammar{
 		},
 		{
 			name: "OptWhereClause",
-			pos:  position{line: 2114, col: 1, offset: 65713},
+			pos:  position{line: 2284, col: 1, offset: 70359},
 			expr: &choiceExpr{
-				pos: position{line: 2115, col: 5, offset: 65732},
+				pos: position{line: 2285, col: 5, offset: 70378},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2115, col: 5, offset: 65732},
+						pos: position{line: 2285, col: 5, offset: 70378},
 						run: (*parser).callonOptWhereClause2,
 						expr: &labeledExpr{
-							pos:   position{line: 2115, col: 5, offset: 65732},
+							pos:   position{line: 2285, col: 5, offset: 70378},
 							label: "where",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2115, col: 11, offset: 65738},
+								pos:  position{line: 2285, col: 11, offset: 70384},
 								name: "WhereClause",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2116, col: 5, offset: 65780},
+						pos: position{line: 2286, col: 5, offset: 70426},
 						run: (*parser).callonOptWhereClause5,
 						expr: &litMatcher{
-							pos:        position{line: 2116, col: 5, offset: 65780},
+							pos:        position{line: 2286, col: 5, offset: 70426},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -14736,25 +15759,25 @@ var g = &grammar{
 		},
 		{
 			name: "OptGroupClause",
-			pos:  position{line: 2118, col: 1, offset: 65825},
+			pos:  position{line: 2288, col: 1, offset: 70471},
 			expr: &choiceExpr{
-				pos: position{line: 2119, col: 5, offset: 65844},
+				pos: position{line: 2289, col: 5, offset: 70490},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2119, col: 5, offset: 65844},
+						pos: position{line: 2289, col: 5, offset: 70490},
 						run: (*parser).callonOptGroupClause2,
 						expr: &seqExpr{
-							pos: position{line: 2119, col: 5, offset: 65844},
+							pos: position{line: 2289, col: 5, offset: 70490},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2119, col: 5, offset: 65844},
+									pos:  position{line: 2289, col: 5, offset: 70490},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 2119, col: 7, offset: 65846},
+									pos:   position{line: 2289, col: 7, offset: 70492},
 									label: "group",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2119, col: 13, offset: 65852},
+										pos:  position{line: 2289, col: 13, offset: 70498},
 										name: "GroupClause",
 									},
 								},
@@ -14762,10 +15785,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2120, col: 5, offset: 65890},
+						pos: position{line: 2290, col: 5, offset: 70536},
 						run: (*parser).callonOptGroupClause7,
 						expr: &litMatcher{
-							pos:        position{line: 2120, col: 5, offset: 65890},
+							pos:        position{line: 2290, col: 5, offset: 70536},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -14778,34 +15801,34 @@ var g = &grammar{
 		},
 		{
 			name: "GroupClause",
-			pos:  position{line: 2122, col: 1, offset: 65931},
+			pos:  position{line: 2292, col: 1, offset: 70577},
 			expr: &actionExpr{
-				pos: position{line: 2123, col: 5, offset: 65947},
+				pos: position{line: 2293, col: 5, offset: 70593},
 				run: (*parser).callonGroupClause1,
 				expr: &seqExpr{
-					pos: position{line: 2123, col: 5, offset: 65947},
+					pos: position{line: 2293, col: 5, offset: 70593},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 2123, col: 5, offset: 65947},
+							pos:  position{line: 2293, col: 5, offset: 70593},
 							name: "GROUP",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2123, col: 11, offset: 65953},
+							pos:  position{line: 2293, col: 11, offset: 70599},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2123, col: 13, offset: 65955},
+							pos:  position{line: 2293, col: 13, offset: 70601},
 							name: "BY",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2123, col: 16, offset: 65958},
+							pos:  position{line: 2293, col: 16, offset: 70604},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 2123, col: 18, offset: 65960},
+							pos:   position{line: 2293, col: 18, offset: 70606},
 							label: "list",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2123, col: 23, offset: 65965},
+								pos:  position{line: 2293, col: 23, offset: 70611},
 								name: "GroupByList",
 							},
 						},
@@ -14817,51 +15840,51 @@ var g = &grammar{
 		},
 		{
 			name: "GroupByList",
-			pos:  position{line: 2125, col: 1, offset: 65999},
+			pos:  position{line: 2295, col: 1, offset: 70645},
 			expr: &actionExpr{
-				pos: position{line: 2126, col: 5, offset: 66016},
+				pos: position{line: 2296, col: 5, offset: 70662},
 				run: (*parser).callonGroupByList1,
 				expr: &seqExpr{
-					pos: position{line: 2126, col: 5, offset: 66016},
+					pos: position{line: 2296, col: 5, offset: 70662},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 2126, col: 5, offset: 66016},
+							pos:   position{line: 2296, col: 5, offset: 70662},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2126, col: 11, offset: 66022},
+								pos:  position{line: 2296, col: 11, offset: 70668},
 								name: "GroupByItem",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2126, col: 23, offset: 66034},
+							pos:   position{line: 2296, col: 23, offset: 70680},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 2126, col: 28, offset: 66039},
+								pos: position{line: 2296, col: 28, offset: 70685},
 								expr: &actionExpr{
-									pos: position{line: 2126, col: 30, offset: 66041},
+									pos: position{line: 2296, col: 30, offset: 70687},
 									run: (*parser).callonGroupByList7,
 									expr: &seqExpr{
-										pos: position{line: 2126, col: 30, offset: 66041},
+										pos: position{line: 2296, col: 30, offset: 70687},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 2126, col: 30, offset: 66041},
+												pos:  position{line: 2296, col: 30, offset: 70687},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 2126, col: 33, offset: 66044},
+												pos:        position{line: 2296, col: 33, offset: 70690},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 2126, col: 37, offset: 66048},
+												pos:  position{line: 2296, col: 37, offset: 70694},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 2126, col: 40, offset: 66051},
+												pos:   position{line: 2296, col: 40, offset: 70697},
 												label: "g",
 												expr: &ruleRefExpr{
-													pos:  position{line: 2126, col: 42, offset: 66053},
+													pos:  position{line: 2296, col: 42, offset: 70699},
 													name: "GroupByItem",
 												},
 											},
@@ -14878,9 +15901,9 @@ var g = &grammar{
 		},
 		{
 			name: "GroupByItem",
-			pos:  position{line: 2130, col: 1, offset: 66134},
+			pos:  position{line: 2300, col: 1, offset: 70780},
 			expr: &ruleRefExpr{
-				pos:  position{line: 2130, col: 15, offset: 66148},
+				pos:  position{line: 2300, col: 15, offset: 70794},
 				name: "Expr",
 			},
 			leader:        false,
@@ -14888,25 +15911,25 @@ var g = &grammar{
 		},
 		{
 			name: "OptHavingClause",
-			pos:  position{line: 2132, col: 1, offset: 66154},
+			pos:  position{line: 2302, col: 1, offset: 70800},
 			expr: &choiceExpr{
-				pos: position{line: 2133, col: 5, offset: 66174},
+				pos: position{line: 2303, col: 5, offset: 70820},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2133, col: 5, offset: 66174},
+						pos: position{line: 2303, col: 5, offset: 70820},
 						run: (*parser).callonOptHavingClause2,
 						expr: &seqExpr{
-							pos: position{line: 2133, col: 5, offset: 66174},
+							pos: position{line: 2303, col: 5, offset: 70820},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2133, col: 5, offset: 66174},
+									pos:  position{line: 2303, col: 5, offset: 70820},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 2133, col: 7, offset: 66176},
+									pos:   position{line: 2303, col: 7, offset: 70822},
 									label: "h",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2133, col: 9, offset: 66178},
+										pos:  position{line: 2303, col: 9, offset: 70824},
 										name: "HavingClause",
 									},
 								},
@@ -14914,10 +15937,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2134, col: 5, offset: 66213},
+						pos: position{line: 2304, col: 5, offset: 70859},
 						run: (*parser).callonOptHavingClause7,
 						expr: &litMatcher{
-							pos:        position{line: 2134, col: 5, offset: 66213},
+							pos:        position{line: 2304, col: 5, offset: 70859},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -14930,26 +15953,26 @@ var g = &grammar{
 		},
 		{
 			name: "HavingClause",
-			pos:  position{line: 2136, col: 1, offset: 66237},
+			pos:  position{line: 2306, col: 1, offset: 70883},
 			expr: &actionExpr{
-				pos: position{line: 2137, col: 5, offset: 66254},
+				pos: position{line: 2307, col: 5, offset: 70900},
 				run: (*parser).callonHavingClause1,
 				expr: &seqExpr{
-					pos: position{line: 2137, col: 5, offset: 66254},
+					pos: position{line: 2307, col: 5, offset: 70900},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 2137, col: 5, offset: 66254},
+							pos:  position{line: 2307, col: 5, offset: 70900},
 							name: "HAVING",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2137, col: 12, offset: 66261},
+							pos:  position{line: 2307, col: 12, offset: 70907},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 2137, col: 14, offset: 66263},
+							pos:   position{line: 2307, col: 14, offset: 70909},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2137, col: 16, offset: 66265},
+								pos:  position{line: 2307, col: 16, offset: 70911},
 								name: "Expr",
 							},
 						},
@@ -14961,16 +15984,16 @@ var g = &grammar{
 		},
 		{
 			name: "JoinOperation",
-			pos:  position{line: 2139, col: 1, offset: 66289},
+			pos:  position{line: 2309, col: 1, offset: 70935},
 			expr: &choiceExpr{
-				pos: position{line: 2140, col: 5, offset: 66307},
+				pos: position{line: 2310, col: 5, offset: 70953},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 2140, col: 5, offset: 66307},
+						pos:  position{line: 2310, col: 5, offset: 70953},
 						name: "CrossJoin",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2141, col: 5, offset: 66321},
+						pos:  position{line: 2311, col: 5, offset: 70967},
 						name: "ConditionJoin",
 					},
 				},
@@ -14980,30 +16003,30 @@ var g = &grammar{
 		},
 		{
 			name: "CrossJoin",
-			pos:  position{line: 2143, col: 1, offset: 66336},
+			pos:  position{line: 2313, col: 1, offset: 70982},
 			expr: &actionExpr{
-				pos: position{line: 2144, col: 5, offset: 66350},
+				pos: position{line: 2314, col: 5, offset: 70996},
 				run: (*parser).callonCrossJoin1,
 				expr: &seqExpr{
-					pos: position{line: 2144, col: 5, offset: 66350},
+					pos: position{line: 2314, col: 5, offset: 70996},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 2144, col: 5, offset: 66350},
+							pos:   position{line: 2314, col: 5, offset: 70996},
 							label: "left",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2144, col: 10, offset: 66355},
+								pos:  position{line: 2314, col: 10, offset: 71001},
 								name: "FromElem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2144, col: 19, offset: 66364},
+							pos:  position{line: 2314, col: 19, offset: 71010},
 							name: "CrossJoinOp",
 						},
 						&labeledExpr{
-							pos:   position{line: 2144, col: 31, offset: 66376},
+							pos:   position{line: 2314, col: 31, offset: 71022},
 							label: "right",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2144, col: 37, offset: 66382},
+								pos:  position{line: 2314, col: 37, offset: 71028},
 								name: "FromElem",
 							},
 						},
@@ -15015,50 +16038,50 @@ var g = &grammar{
 		},
 		{
 			name: "CrossJoinOp",
-			pos:  position{line: 2153, col: 1, offset: 66584},
+			pos:  position{line: 2323, col: 1, offset: 71230},
 			expr: &choiceExpr{
-				pos: position{line: 2154, col: 5, offset: 66601},
+				pos: position{line: 2324, col: 5, offset: 71247},
 				alternatives: []any{
 					&seqExpr{
-						pos: position{line: 2154, col: 5, offset: 66601},
+						pos: position{line: 2324, col: 5, offset: 71247},
 						exprs: []any{
 							&ruleRefExpr{
-								pos:  position{line: 2154, col: 5, offset: 66601},
+								pos:  position{line: 2324, col: 5, offset: 71247},
 								name: "__",
 							},
 							&litMatcher{
-								pos:        position{line: 2154, col: 8, offset: 66604},
+								pos:        position{line: 2324, col: 8, offset: 71250},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 2154, col: 12, offset: 66608},
+								pos:  position{line: 2324, col: 12, offset: 71254},
 								name: "__",
 							},
 						},
 					},
 					&seqExpr{
-						pos: position{line: 2155, col: 5, offset: 66616},
+						pos: position{line: 2325, col: 5, offset: 71262},
 						exprs: []any{
 							&ruleRefExpr{
-								pos:  position{line: 2155, col: 5, offset: 66616},
+								pos:  position{line: 2325, col: 5, offset: 71262},
 								name: "_",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 2155, col: 7, offset: 66618},
+								pos:  position{line: 2325, col: 7, offset: 71264},
 								name: "CROSS",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 2155, col: 13, offset: 66624},
+								pos:  position{line: 2325, col: 13, offset: 71270},
 								name: "_",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 2155, col: 15, offset: 66626},
+								pos:  position{line: 2325, col: 15, offset: 71272},
 								name: "JOIN",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 2155, col: 20, offset: 66631},
+								pos:  position{line: 2325, col: 20, offset: 71277},
 								name: "_",
 							},
 						},
@@ -15070,46 +16093,46 @@ var g = &grammar{
 		},
 		{
 			name: "ConditionJoin",
-			pos:  position{line: 2157, col: 1, offset: 66635},
+			pos:  position{line: 2327, col: 1, offset: 71281},
 			expr: &actionExpr{
-				pos: position{line: 2158, col: 5, offset: 66653},
+				pos: position{line: 2328, col: 5, offset: 71299},
 				run: (*parser).callonConditionJoin1,
 				expr: &seqExpr{
-					pos: position{line: 2158, col: 5, offset: 66653},
+					pos: position{line: 2328, col: 5, offset: 71299},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 2158, col: 5, offset: 66653},
+							pos:   position{line: 2328, col: 5, offset: 71299},
 							label: "left",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2158, col: 10, offset: 66658},
+								pos:  position{line: 2328, col: 10, offset: 71304},
 								name: "FromElem",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2158, col: 19, offset: 66667},
+							pos:   position{line: 2328, col: 19, offset: 71313},
 							label: "style",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2158, col: 25, offset: 66673},
+								pos:  position{line: 2328, col: 25, offset: 71319},
 								name: "SQLJoinStyle",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2158, col: 38, offset: 66686},
+							pos:  position{line: 2328, col: 38, offset: 71332},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 2158, col: 40, offset: 66688},
+							pos:   position{line: 2328, col: 40, offset: 71334},
 							label: "right",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2158, col: 46, offset: 66694},
+								pos:  position{line: 2328, col: 46, offset: 71340},
 								name: "FromElem",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2158, col: 55, offset: 66703},
+							pos:   position{line: 2328, col: 55, offset: 71349},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2158, col: 57, offset: 66705},
+								pos:  position{line: 2328, col: 57, offset: 71351},
 								name: "JoinExpr",
 							},
 						},
@@ -15121,161 +16144,161 @@ var g = &grammar{
 		},
 		{
 			name: "SQLJoinStyle",
-			pos:  position{line: 2169, col: 1, offset: 66974},
+			pos:  position{line: 2339, col: 1, offset: 71620},
 			expr: &choiceExpr{
-				pos: position{line: 2170, col: 5, offset: 66992},
+				pos: position{line: 2340, col: 5, offset: 71638},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2170, col: 5, offset: 66992},
+						pos: position{line: 2340, col: 5, offset: 71638},
 						run: (*parser).callonSQLJoinStyle2,
 						expr: &seqExpr{
-							pos: position{line: 2170, col: 5, offset: 66992},
+							pos: position{line: 2340, col: 5, offset: 71638},
 							exprs: []any{
 								&zeroOrOneExpr{
-									pos: position{line: 2170, col: 5, offset: 66992},
+									pos: position{line: 2340, col: 5, offset: 71638},
 									expr: &seqExpr{
-										pos: position{line: 2170, col: 6, offset: 66993},
+										pos: position{line: 2340, col: 6, offset: 71639},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 2170, col: 6, offset: 66993},
+												pos:  position{line: 2340, col: 6, offset: 71639},
 												name: "_",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 2170, col: 8, offset: 66995},
+												pos:  position{line: 2340, col: 8, offset: 71641},
 												name: "INNER",
 											},
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2170, col: 16, offset: 67003},
+									pos:  position{line: 2340, col: 16, offset: 71649},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2170, col: 18, offset: 67005},
+									pos:  position{line: 2340, col: 18, offset: 71651},
 									name: "JOIN",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2171, col: 5, offset: 67050},
+						pos: position{line: 2341, col: 5, offset: 71696},
 						run: (*parser).callonSQLJoinStyle10,
 						expr: &seqExpr{
-							pos: position{line: 2171, col: 5, offset: 67050},
+							pos: position{line: 2341, col: 5, offset: 71696},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2171, col: 5, offset: 67050},
+									pos:  position{line: 2341, col: 5, offset: 71696},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2171, col: 7, offset: 67052},
+									pos:  position{line: 2341, col: 7, offset: 71698},
 									name: "FULL",
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 2171, col: 12, offset: 67057},
+									pos: position{line: 2341, col: 12, offset: 71703},
 									expr: &seqExpr{
-										pos: position{line: 2171, col: 13, offset: 67058},
+										pos: position{line: 2341, col: 13, offset: 71704},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 2171, col: 13, offset: 67058},
+												pos:  position{line: 2341, col: 13, offset: 71704},
 												name: "_",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 2171, col: 15, offset: 67060},
+												pos:  position{line: 2341, col: 15, offset: 71706},
 												name: "OUTER",
 											},
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2171, col: 23, offset: 67068},
+									pos:  position{line: 2341, col: 23, offset: 71714},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2171, col: 25, offset: 67070},
+									pos:  position{line: 2341, col: 25, offset: 71716},
 									name: "JOIN",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2172, col: 5, offset: 67104},
+						pos: position{line: 2342, col: 5, offset: 71750},
 						run: (*parser).callonSQLJoinStyle20,
 						expr: &seqExpr{
-							pos: position{line: 2172, col: 5, offset: 67104},
+							pos: position{line: 2342, col: 5, offset: 71750},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2172, col: 5, offset: 67104},
+									pos:  position{line: 2342, col: 5, offset: 71750},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2172, col: 7, offset: 67106},
+									pos:  position{line: 2342, col: 7, offset: 71752},
 									name: "LEFT",
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 2172, col: 12, offset: 67111},
+									pos: position{line: 2342, col: 12, offset: 71757},
 									expr: &seqExpr{
-										pos: position{line: 2172, col: 13, offset: 67112},
+										pos: position{line: 2342, col: 13, offset: 71758},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 2172, col: 13, offset: 67112},
+												pos:  position{line: 2342, col: 13, offset: 71758},
 												name: "_",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 2172, col: 15, offset: 67114},
+												pos:  position{line: 2342, col: 15, offset: 71760},
 												name: "OUTER",
 											},
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2172, col: 23, offset: 67122},
+									pos:  position{line: 2342, col: 23, offset: 71768},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2172, col: 25, offset: 67124},
+									pos:  position{line: 2342, col: 25, offset: 71770},
 									name: "JOIN",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2173, col: 5, offset: 67158},
+						pos: position{line: 2343, col: 5, offset: 71804},
 						run: (*parser).callonSQLJoinStyle30,
 						expr: &seqExpr{
-							pos: position{line: 2173, col: 5, offset: 67158},
+							pos: position{line: 2343, col: 5, offset: 71804},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2173, col: 5, offset: 67158},
+									pos:  position{line: 2343, col: 5, offset: 71804},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2173, col: 7, offset: 67160},
+									pos:  position{line: 2343, col: 7, offset: 71806},
 									name: "RIGHT",
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 2173, col: 13, offset: 67166},
+									pos: position{line: 2343, col: 13, offset: 71812},
 									expr: &seqExpr{
-										pos: position{line: 2173, col: 14, offset: 67167},
+										pos: position{line: 2343, col: 14, offset: 71813},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 2173, col: 14, offset: 67167},
+												pos:  position{line: 2343, col: 14, offset: 71813},
 												name: "_",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 2173, col: 16, offset: 67169},
+												pos:  position{line: 2343, col: 16, offset: 71815},
 												name: "OUTER",
 											},
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2173, col: 24, offset: 67177},
+									pos:  position{line: 2343, col: 24, offset: 71823},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2173, col: 26, offset: 67179},
+									pos:  position{line: 2343, col: 26, offset: 71825},
 									name: "JOIN",
 								},
 							},
@@ -15288,33 +16311,33 @@ var g = &grammar{
 		},
 		{
 			name: "JoinExpr",
-			pos:  position{line: 2175, col: 1, offset: 67211},
+			pos:  position{line: 2345, col: 1, offset: 71857},
 			expr: &choiceExpr{
-				pos: position{line: 2176, col: 5, offset: 67225},
+				pos: position{line: 2346, col: 5, offset: 71871},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2176, col: 5, offset: 67225},
+						pos: position{line: 2346, col: 5, offset: 71871},
 						run: (*parser).callonJoinExpr2,
 						expr: &seqExpr{
-							pos: position{line: 2176, col: 5, offset: 67225},
+							pos: position{line: 2346, col: 5, offset: 71871},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2176, col: 5, offset: 67225},
+									pos:  position{line: 2346, col: 5, offset: 71871},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2176, col: 7, offset: 67227},
+									pos:  position{line: 2346, col: 7, offset: 71873},
 									name: "ON",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2176, col: 10, offset: 67230},
+									pos:  position{line: 2346, col: 10, offset: 71876},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 2176, col: 12, offset: 67232},
+									pos:   position{line: 2346, col: 12, offset: 71878},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2176, col: 14, offset: 67234},
+										pos:  position{line: 2346, col: 14, offset: 71880},
 										name: "Expr",
 									},
 								},
@@ -15322,47 +16345,47 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2183, col: 5, offset: 67389},
+						pos: position{line: 2353, col: 5, offset: 72035},
 						run: (*parser).callonJoinExpr9,
 						expr: &seqExpr{
-							pos: position{line: 2183, col: 5, offset: 67389},
+							pos: position{line: 2353, col: 5, offset: 72035},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2183, col: 5, offset: 67389},
+									pos:  position{line: 2353, col: 5, offset: 72035},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2183, col: 7, offset: 67391},
+									pos:  position{line: 2353, col: 7, offset: 72037},
 									name: "USING",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2183, col: 13, offset: 67397},
+									pos:  position{line: 2353, col: 13, offset: 72043},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 2183, col: 16, offset: 67400},
+									pos:        position{line: 2353, col: 16, offset: 72046},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2183, col: 20, offset: 67404},
+									pos:  position{line: 2353, col: 20, offset: 72050},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 2183, col: 23, offset: 67407},
+									pos:   position{line: 2353, col: 23, offset: 72053},
 									label: "fields",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2183, col: 30, offset: 67414},
+										pos:  position{line: 2353, col: 30, offset: 72060},
 										name: "Lvals",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2183, col: 36, offset: 67420},
+									pos:  position{line: 2353, col: 36, offset: 72066},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 2183, col: 39, offset: 67423},
+									pos:        position{line: 2353, col: 39, offset: 72069},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -15377,40 +16400,40 @@ var g = &grammar{
 		},
 		{
 			name: "OptOrdinality",
-			pos:  position{line: 2191, col: 1, offset: 67593},
+			pos:  position{line: 2361, col: 1, offset: 72239},
 			expr: &choiceExpr{
-				pos: position{line: 2192, col: 5, offset: 67612},
+				pos: position{line: 2362, col: 5, offset: 72258},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2192, col: 5, offset: 67612},
+						pos: position{line: 2362, col: 5, offset: 72258},
 						run: (*parser).callonOptOrdinality2,
 						expr: &seqExpr{
-							pos: position{line: 2192, col: 5, offset: 67612},
+							pos: position{line: 2362, col: 5, offset: 72258},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2192, col: 5, offset: 67612},
+									pos:  position{line: 2362, col: 5, offset: 72258},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2192, col: 7, offset: 67614},
+									pos:  position{line: 2362, col: 7, offset: 72260},
 									name: "WITH",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2192, col: 12, offset: 67619},
+									pos:  position{line: 2362, col: 12, offset: 72265},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2192, col: 14, offset: 67621},
+									pos:  position{line: 2362, col: 14, offset: 72267},
 									name: "ORDINALITY",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2198, col: 5, offset: 67750},
+						pos: position{line: 2368, col: 5, offset: 72396},
 						run: (*parser).callonOptOrdinality8,
 						expr: &litMatcher{
-							pos:        position{line: 2198, col: 5, offset: 67750},
+							pos:        position{line: 2368, col: 5, offset: 72396},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -15423,25 +16446,25 @@ var g = &grammar{
 		},
 		{
 			name: "OptAlias",
-			pos:  position{line: 2200, col: 1, offset: 67799},
+			pos:  position{line: 2370, col: 1, offset: 72445},
 			expr: &choiceExpr{
-				pos: position{line: 2201, col: 5, offset: 67812},
+				pos: position{line: 2371, col: 5, offset: 72458},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2201, col: 5, offset: 67812},
+						pos: position{line: 2371, col: 5, offset: 72458},
 						run: (*parser).callonOptAlias2,
 						expr: &seqExpr{
-							pos: position{line: 2201, col: 5, offset: 67812},
+							pos: position{line: 2371, col: 5, offset: 72458},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2201, col: 5, offset: 67812},
+									pos:  position{line: 2371, col: 5, offset: 72458},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 2201, col: 7, offset: 67814},
+									pos:   position{line: 2371, col: 7, offset: 72460},
 									label: "a",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2201, col: 9, offset: 67816},
+										pos:  position{line: 2371, col: 9, offset: 72462},
 										name: "AliasClause",
 									},
 								},
@@ -15449,10 +16472,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2202, col: 5, offset: 67850},
+						pos: position{line: 2372, col: 5, offset: 72496},
 						run: (*parser).callonOptAlias7,
 						expr: &litMatcher{
-							pos:        position{line: 2202, col: 5, offset: 67850},
+							pos:        position{line: 2372, col: 5, offset: 72496},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -15465,60 +16488,60 @@ var g = &grammar{
 		},
 		{
 			name: "AliasClause",
-			pos:  position{line: 2204, col: 1, offset: 67887},
+			pos:  position{line: 2374, col: 1, offset: 72533},
 			expr: &actionExpr{
-				pos: position{line: 2205, col: 4, offset: 67902},
+				pos: position{line: 2375, col: 4, offset: 72548},
 				run: (*parser).callonAliasClause1,
 				expr: &seqExpr{
-					pos: position{line: 2205, col: 4, offset: 67902},
+					pos: position{line: 2375, col: 4, offset: 72548},
 					exprs: []any{
 						&zeroOrOneExpr{
-							pos: position{line: 2205, col: 4, offset: 67902},
+							pos: position{line: 2375, col: 4, offset: 72548},
 							expr: &seqExpr{
-								pos: position{line: 2205, col: 5, offset: 67903},
+								pos: position{line: 2375, col: 5, offset: 72549},
 								exprs: []any{
 									&ruleRefExpr{
-										pos:  position{line: 2205, col: 5, offset: 67903},
+										pos:  position{line: 2375, col: 5, offset: 72549},
 										name: "AS",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 2205, col: 8, offset: 67906},
+										pos:  position{line: 2375, col: 8, offset: 72552},
 										name: "_",
 									},
 								},
 							},
 						},
 						&notExpr{
-							pos: position{line: 2205, col: 12, offset: 67910},
+							pos: position{line: 2375, col: 12, offset: 72556},
 							expr: &choiceExpr{
-								pos: position{line: 2205, col: 14, offset: 67912},
+								pos: position{line: 2375, col: 14, offset: 72558},
 								alternatives: []any{
 									&ruleRefExpr{
-										pos:  position{line: 2205, col: 14, offset: 67912},
+										pos:  position{line: 2375, col: 14, offset: 72558},
 										name: "SQLGuard",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 2205, col: 25, offset: 67923},
+										pos:  position{line: 2375, col: 25, offset: 72569},
 										name: "DeprecatedFroms",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2205, col: 42, offset: 67940},
+							pos:   position{line: 2375, col: 42, offset: 72586},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2205, col: 47, offset: 67945},
+								pos:  position{line: 2375, col: 47, offset: 72591},
 								name: "IdentifierName",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2205, col: 62, offset: 67960},
+							pos:   position{line: 2375, col: 62, offset: 72606},
 							label: "cols",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 2205, col: 67, offset: 67965},
+								pos: position{line: 2375, col: 67, offset: 72611},
 								expr: &ruleRefExpr{
-									pos:  position{line: 2205, col: 67, offset: 67965},
+									pos:  position{line: 2375, col: 67, offset: 72611},
 									name: "Columns",
 								},
 							},
@@ -15531,65 +16554,65 @@ var g = &grammar{
 		},
 		{
 			name: "Columns",
-			pos:  position{line: 2213, col: 1, offset: 68164},
+			pos:  position{line: 2383, col: 1, offset: 72810},
 			expr: &actionExpr{
-				pos: position{line: 2214, col: 5, offset: 68176},
+				pos: position{line: 2384, col: 5, offset: 72822},
 				run: (*parser).callonColumns1,
 				expr: &seqExpr{
-					pos: position{line: 2214, col: 5, offset: 68176},
+					pos: position{line: 2384, col: 5, offset: 72822},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 2214, col: 5, offset: 68176},
+							pos:  position{line: 2384, col: 5, offset: 72822},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 2214, col: 8, offset: 68179},
+							pos:        position{line: 2384, col: 8, offset: 72825},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2214, col: 12, offset: 68183},
+							pos:  position{line: 2384, col: 12, offset: 72829},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 2214, col: 15, offset: 68186},
+							pos:   position{line: 2384, col: 15, offset: 72832},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2214, col: 21, offset: 68192},
+								pos:  position{line: 2384, col: 21, offset: 72838},
 								name: "SQLIdentifier",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2214, col: 35, offset: 68206},
+							pos:   position{line: 2384, col: 35, offset: 72852},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 2214, col: 40, offset: 68211},
+								pos: position{line: 2384, col: 40, offset: 72857},
 								expr: &actionExpr{
-									pos: position{line: 2214, col: 42, offset: 68213},
+									pos: position{line: 2384, col: 42, offset: 72859},
 									run: (*parser).callonColumns10,
 									expr: &seqExpr{
-										pos: position{line: 2214, col: 42, offset: 68213},
+										pos: position{line: 2384, col: 42, offset: 72859},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 2214, col: 42, offset: 68213},
+												pos:  position{line: 2384, col: 42, offset: 72859},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 2214, col: 45, offset: 68216},
+												pos:        position{line: 2384, col: 45, offset: 72862},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 2214, col: 49, offset: 68220},
+												pos:  position{line: 2384, col: 49, offset: 72866},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 2214, col: 52, offset: 68223},
+												pos:   position{line: 2384, col: 52, offset: 72869},
 												label: "s",
 												expr: &ruleRefExpr{
-													pos:  position{line: 2214, col: 54, offset: 68225},
+													pos:  position{line: 2384, col: 54, offset: 72871},
 													name: "SQLIdentifier",
 												},
 											},
@@ -15599,11 +16622,11 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2214, col: 87, offset: 68258},
+							pos:  position{line: 2384, col: 87, offset: 72904},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 2214, col: 90, offset: 68261},
+							pos:        position{line: 2384, col: 90, offset: 72907},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -15616,51 +16639,51 @@ var g = &grammar{
 		},
 		{
 			name: "Selection",
-			pos:  position{line: 2218, col: 1, offset: 68333},
+			pos:  position{line: 2388, col: 1, offset: 72979},
 			expr: &actionExpr{
-				pos: position{line: 2219, col: 5, offset: 68347},
+				pos: position{line: 2389, col: 5, offset: 72993},
 				run: (*parser).callonSelection1,
 				expr: &seqExpr{
-					pos: position{line: 2219, col: 5, offset: 68347},
+					pos: position{line: 2389, col: 5, offset: 72993},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 2219, col: 5, offset: 68347},
+							pos:   position{line: 2389, col: 5, offset: 72993},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2219, col: 11, offset: 68353},
+								pos:  position{line: 2389, col: 11, offset: 72999},
 								name: "SelectElem",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2219, col: 22, offset: 68364},
+							pos:   position{line: 2389, col: 22, offset: 73010},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 2219, col: 27, offset: 68369},
+								pos: position{line: 2389, col: 27, offset: 73015},
 								expr: &actionExpr{
-									pos: position{line: 2219, col: 29, offset: 68371},
+									pos: position{line: 2389, col: 29, offset: 73017},
 									run: (*parser).callonSelection7,
 									expr: &seqExpr{
-										pos: position{line: 2219, col: 29, offset: 68371},
+										pos: position{line: 2389, col: 29, offset: 73017},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 2219, col: 29, offset: 68371},
+												pos:  position{line: 2389, col: 29, offset: 73017},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 2219, col: 32, offset: 68374},
+												pos:        position{line: 2389, col: 32, offset: 73020},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 2219, col: 36, offset: 68378},
+												pos:  position{line: 2389, col: 36, offset: 73024},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 2219, col: 39, offset: 68381},
+												pos:   position{line: 2389, col: 39, offset: 73027},
 												label: "s",
 												expr: &ruleRefExpr{
-													pos:  position{line: 2219, col: 41, offset: 68383},
+													pos:  position{line: 2389, col: 41, offset: 73029},
 													name: "SelectElem",
 												},
 											},
@@ -15677,38 +16700,38 @@ var g = &grammar{
 		},
 		{
 			name: "SelectElem",
-			pos:  position{line: 2228, col: 1, offset: 68609},
+			pos:  position{line: 2398, col: 1, offset: 73255},
 			expr: &choiceExpr{
-				pos: position{line: 2229, col: 5, offset: 68625},
+				pos: position{line: 2399, col: 5, offset: 73271},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2229, col: 5, offset: 68625},
+						pos: position{line: 2399, col: 5, offset: 73271},
 						run: (*parser).callonSelectElem2,
 						expr: &seqExpr{
-							pos: position{line: 2229, col: 5, offset: 68625},
+							pos: position{line: 2399, col: 5, offset: 73271},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 2229, col: 5, offset: 68625},
+									pos:   position{line: 2399, col: 5, offset: 73271},
 									label: "expr",
 									expr: &choiceExpr{
-										pos: position{line: 2229, col: 11, offset: 68631},
+										pos: position{line: 2399, col: 11, offset: 73277},
 										alternatives: []any{
 											&ruleRefExpr{
-												pos:  position{line: 2229, col: 11, offset: 68631},
+												pos:  position{line: 2399, col: 11, offset: 73277},
 												name: "AggDistinct",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 2229, col: 25, offset: 68645},
+												pos:  position{line: 2399, col: 25, offset: 73291},
 												name: "Expr",
 											},
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 2229, col: 31, offset: 68651},
+									pos:   position{line: 2399, col: 31, offset: 73297},
 									label: "as",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2229, col: 34, offset: 68654},
+										pos:  position{line: 2399, col: 34, offset: 73300},
 										name: "OptAsClause",
 									},
 								},
@@ -15716,10 +16739,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2240, col: 5, offset: 68876},
+						pos: position{line: 2410, col: 5, offset: 73522},
 						run: (*parser).callonSelectElem10,
 						expr: &litMatcher{
-							pos:        position{line: 2240, col: 5, offset: 68876},
+							pos:        position{line: 2410, col: 5, offset: 73522},
 							val:        "*",
 							ignoreCase: false,
 							want:       "\"*\"",
@@ -15732,33 +16755,33 @@ var g = &grammar{
 		},
 		{
 			name: "OptAsClause",
-			pos:  position{line: 2245, col: 1, offset: 68978},
+			pos:  position{line: 2415, col: 1, offset: 73624},
 			expr: &choiceExpr{
-				pos: position{line: 2246, col: 5, offset: 68995},
+				pos: position{line: 2416, col: 5, offset: 73641},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2246, col: 5, offset: 68995},
+						pos: position{line: 2416, col: 5, offset: 73641},
 						run: (*parser).callonOptAsClause2,
 						expr: &seqExpr{
-							pos: position{line: 2246, col: 5, offset: 68995},
+							pos: position{line: 2416, col: 5, offset: 73641},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2246, col: 5, offset: 68995},
+									pos:  position{line: 2416, col: 5, offset: 73641},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2246, col: 7, offset: 68997},
+									pos:  position{line: 2416, col: 7, offset: 73643},
 									name: "AS",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2246, col: 10, offset: 69000},
+									pos:  position{line: 2416, col: 10, offset: 73646},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 2246, col: 12, offset: 69002},
+									pos:   position{line: 2416, col: 12, offset: 73648},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2246, col: 15, offset: 69005},
+										pos:  position{line: 2416, col: 15, offset: 73651},
 										name: "SQLIdentifier",
 									},
 								},
@@ -15766,27 +16789,27 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2247, col: 5, offset: 69042},
+						pos: position{line: 2417, col: 5, offset: 73688},
 						run: (*parser).callonOptAsClause9,
 						expr: &seqExpr{
-							pos: position{line: 2247, col: 5, offset: 69042},
+							pos: position{line: 2417, col: 5, offset: 73688},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2247, col: 5, offset: 69042},
+									pos:  position{line: 2417, col: 5, offset: 73688},
 									name: "_",
 								},
 								&notExpr{
-									pos: position{line: 2247, col: 7, offset: 69044},
+									pos: position{line: 2417, col: 7, offset: 73690},
 									expr: &ruleRefExpr{
-										pos:  position{line: 2247, col: 8, offset: 69045},
+										pos:  position{line: 2417, col: 8, offset: 73691},
 										name: "SQLGuard",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 2247, col: 17, offset: 69054},
+									pos:   position{line: 2417, col: 17, offset: 73700},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2247, col: 20, offset: 69057},
+										pos:  position{line: 2417, col: 20, offset: 73703},
 										name: "SQLIdentifier",
 									},
 								},
@@ -15794,10 +16817,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2248, col: 5, offset: 69094},
+						pos: position{line: 2418, col: 5, offset: 73740},
 						run: (*parser).callonOptAsClause16,
 						expr: &litMatcher{
-							pos:        position{line: 2248, col: 5, offset: 69094},
+							pos:        position{line: 2418, col: 5, offset: 73740},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -15810,41 +16833,41 @@ var g = &grammar{
 		},
 		{
 			name: "OptOrderByClause",
-			pos:  position{line: 2250, col: 1, offset: 69119},
+			pos:  position{line: 2420, col: 1, offset: 73765},
 			expr: &choiceExpr{
-				pos: position{line: 2251, col: 5, offset: 69141},
+				pos: position{line: 2421, col: 5, offset: 73787},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2251, col: 5, offset: 69141},
+						pos: position{line: 2421, col: 5, offset: 73787},
 						run: (*parser).callonOptOrderByClause2,
 						expr: &seqExpr{
-							pos: position{line: 2251, col: 5, offset: 69141},
+							pos: position{line: 2421, col: 5, offset: 73787},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2251, col: 5, offset: 69141},
+									pos:  position{line: 2421, col: 5, offset: 73787},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2251, col: 7, offset: 69143},
+									pos:  position{line: 2421, col: 7, offset: 73789},
 									name: "ORDER",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2251, col: 13, offset: 69149},
+									pos:  position{line: 2421, col: 13, offset: 73795},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2251, col: 15, offset: 69151},
+									pos:  position{line: 2421, col: 15, offset: 73797},
 									name: "BY",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2251, col: 18, offset: 69154},
+									pos:  position{line: 2421, col: 18, offset: 73800},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 2251, col: 20, offset: 69156},
+									pos:   position{line: 2421, col: 20, offset: 73802},
 									label: "list",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2251, col: 25, offset: 69161},
+										pos:  position{line: 2421, col: 25, offset: 73807},
 										name: "OrderByList",
 									},
 								},
@@ -15852,10 +16875,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2258, col: 5, offset: 69320},
+						pos: position{line: 2428, col: 5, offset: 73966},
 						run: (*parser).callonOptOrderByClause11,
 						expr: &litMatcher{
-							pos:        position{line: 2258, col: 5, offset: 69320},
+							pos:        position{line: 2428, col: 5, offset: 73966},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -15868,51 +16891,51 @@ var g = &grammar{
 		},
 		{
 			name: "OrderByList",
-			pos:  position{line: 2260, col: 1, offset: 69353},
+			pos:  position{line: 2430, col: 1, offset: 73999},
 			expr: &actionExpr{
-				pos: position{line: 2261, col: 5, offset: 69370},
+				pos: position{line: 2431, col: 5, offset: 74016},
 				run: (*parser).callonOrderByList1,
 				expr: &seqExpr{
-					pos: position{line: 2261, col: 5, offset: 69370},
+					pos: position{line: 2431, col: 5, offset: 74016},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 2261, col: 5, offset: 69370},
+							pos:   position{line: 2431, col: 5, offset: 74016},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2261, col: 11, offset: 69376},
+								pos:  position{line: 2431, col: 11, offset: 74022},
 								name: "OrderByItem",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2261, col: 23, offset: 69388},
+							pos:   position{line: 2431, col: 23, offset: 74034},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 2261, col: 28, offset: 69393},
+								pos: position{line: 2431, col: 28, offset: 74039},
 								expr: &actionExpr{
-									pos: position{line: 2261, col: 30, offset: 69395},
+									pos: position{line: 2431, col: 30, offset: 74041},
 									run: (*parser).callonOrderByList7,
 									expr: &seqExpr{
-										pos: position{line: 2261, col: 30, offset: 69395},
+										pos: position{line: 2431, col: 30, offset: 74041},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 2261, col: 30, offset: 69395},
+												pos:  position{line: 2431, col: 30, offset: 74041},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 2261, col: 33, offset: 69398},
+												pos:        position{line: 2431, col: 33, offset: 74044},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 2261, col: 37, offset: 69402},
+												pos:  position{line: 2431, col: 37, offset: 74048},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 2261, col: 40, offset: 69405},
+												pos:   position{line: 2431, col: 40, offset: 74051},
 												label: "o",
 												expr: &ruleRefExpr{
-													pos:  position{line: 2261, col: 42, offset: 69407},
+													pos:  position{line: 2431, col: 42, offset: 74053},
 													name: "OrderByItem",
 												},
 											},
@@ -15929,34 +16952,42 @@ var g = &grammar{
 		},
 		{
 			name: "OrderByItem",
-			pos:  position{line: 2265, col: 1, offset: 69508},
+			pos:  position{line: 2435, col: 1, offset: 74154},
 			expr: &actionExpr{
-				pos: position{line: 2266, col: 5, offset: 69524},
+				pos: position{line: 2436, col: 5, offset: 74170},
 				run: (*parser).callonOrderByItem1,
 				expr: &seqExpr{
-					pos: position{line: 2266, col: 5, offset: 69524},
+					pos: position{line: 2436, col: 5, offset: 74170},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 2266, col: 5, offset: 69524},
+							pos:   position{line: 2436, col: 5, offset: 74170},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2266, col: 7, offset: 69526},
+								pos:  position{line: 2436, col: 7, offset: 74172},
 								name: "Expr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2266, col: 12, offset: 69531},
+							pos:   position{line: 2436, col: 12, offset: 74177},
+							label: "collate",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2436, col: 20, offset: 74185},
+								name: "OptCollate",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2436, col: 31, offset: 74196},
 							label: "order",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2266, col: 18, offset: 69537},
+								pos:  position{line: 2436, col: 37, offset: 74202},
 								name: "OptAscDesc",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2266, col: 29, offset: 69548},
+							pos:   position{line: 2436, col: 48, offset: 74213},
 							label: "nulls",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2266, col: 35, offset: 69554},
+								pos:  position{line: 2436, col: 54, offset: 74219},
 								name: "OptNullsOrder",
 							},
 						},
@@ -15966,51 +16997,128 @@ var g = &grammar{
 			leader:        false,
 			leftRecursive: false,
 		},
+		{
+			name: "OptCollate",
+			pos:  position{line: 2450, col: 1, offset: 74541},
+			expr: &choiceExpr{
+				pos: position{line: 2451, col: 5, offset: 74556},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 2451, col: 5, offset: 74556},
+						run: (*parser).callonOptCollate2,
+						expr: &seqExpr{
+							pos: position{line: 2451, col: 5, offset: 74556},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 2451, col: 5, offset: 74556},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2451, col: 7, offset: 74558},
+									name: "COLLATE",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2451, col: 15, offset: 74566},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 2451, col: 17, offset: 74568},
+									label: "name",
+									expr: &ruleRefExpr{
+										pos:  position{line: 2451, col: 22, offset: 74573},
+										name: "CollationName",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 2452, col: 5, offset: 74612},
+						run: (*parser).callonOptCollate9,
+						expr: &litMatcher{
+							pos:        position{line: 2452, col: 5, offset: 74612},
+							val:        "",
+							ignoreCase: false,
+							want:       "\"\"",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "CollationName",
+			pos:  position{line: 2454, col: 1, offset: 74664},
+			expr: &choiceExpr{
+				pos: position{line: 2455, col: 5, offset: 74682},
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 2455, col: 5, offset: 74682},
+						name: "SQLIdentifier",
+					},
+					&actionExpr{
+						pos: position{line: 2456, col: 5, offset: 74700},
+						run: (*parser).callonCollationName3,
+						expr: &labeledExpr{
+							pos:   position{line: 2456, col: 5, offset: 74700},
+							label: "s",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2456, col: 7, offset: 74702},
+								name: "SingleQuotedString",
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
 		{
 			name: "OptAscDesc",
-			pos:  position{line: 2277, col: 1, offset: 69804},
+			pos:  position{line: 2458, col: 1, offset: 74787},
 			expr: &choiceExpr{
-				pos: position{line: 2278, col: 5, offset: 69819},
+				pos: position{line: 2459, col: 5, offset: 74802},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2278, col: 5, offset: 69819},
+						pos: position{line: 2459, col: 5, offset: 74802},
 						run: (*parser).callonOptAscDesc2,
 						expr: &seqExpr{
-							pos: position{line: 2278, col: 5, offset: 69819},
+							pos: position{line: 2459, col: 5, offset: 74802},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2278, col: 5, offset: 69819},
+									pos:  position{line: 2459, col: 5, offset: 74802},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2278, col: 7, offset: 69821},
+									pos:  position{line: 2459, col: 7, offset: 74804},
 									name: "ASC",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2279, col: 5, offset: 69893},
+						pos: position{line: 2460, col: 5, offset: 74876},
 						run: (*parser).callonOptAscDesc6,
 						expr: &seqExpr{
-							pos: position{line: 2279, col: 5, offset: 69893},
+							pos: position{line: 2460, col: 5, offset: 74876},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2279, col: 5, offset: 69893},
+									pos:  position{line: 2460, col: 5, offset: 74876},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2279, col: 7, offset: 69895},
+									pos:  position{line: 2460, col: 7, offset: 74878},
 									name: "DESC",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2280, col: 5, offset: 69967},
+						pos: position{line: 2461, col: 5, offset: 74950},
 						run: (*parser).callonOptAscDesc10,
 						expr: &litMatcher{
-							pos:        position{line: 2280, col: 5, offset: 69967},
+							pos:        position{line: 2461, col: 5, offset: 74950},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -16023,65 +17131,65 @@ var g = &grammar{
 		},
 		{
 			name: "OptNullsOrder",
-			pos:  position{line: 2282, col: 1, offset: 69999},
+			pos:  position{line: 2463, col: 1, offset: 74982},
 			expr: &choiceExpr{
-				pos: position{line: 2283, col: 5, offset: 70017},
+				pos: position{line: 2464, col: 5, offset: 75000},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2283, col: 5, offset: 70017},
+						pos: position{line: 2464, col: 5, offset: 75000},
 						run: (*parser).callonOptNullsOrder2,
 						expr: &seqExpr{
-							pos: position{line: 2283, col: 5, offset: 70017},
+							pos: position{line: 2464, col: 5, offset: 75000},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2283, col: 5, offset: 70017},
+									pos:  position{line: 2464, col: 5, offset: 75000},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2283, col: 7, offset: 70019},
+									pos:  position{line: 2464, col: 7, offset: 75002},
 									name: "NULLS",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2283, col: 13, offset: 70025},
+									pos:  position{line: 2464, col: 13, offset: 75008},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2283, col: 15, offset: 70027},
+									pos:  position{line: 2464, col: 15, offset: 75010},
 									name: "FIRST",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2284, col: 5, offset: 70103},
+						pos: position{line: 2465, col: 5, offset: 75086},
 						run: (*parser).callonOptNullsOrder8,
 						expr: &seqExpr{
-							pos: position{line: 2284, col: 5, offset: 70103},
+							pos: position{line: 2465, col: 5, offset: 75086},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2284, col: 5, offset: 70103},
+									pos:  position{line: 2465, col: 5, offset: 75086},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2284, col: 7, offset: 70105},
+									pos:  position{line: 2465, col: 7, offset: 75088},
 									name: "NULLS",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2284, col: 13, offset: 70111},
+									pos:  position{line: 2465, col: 13, offset: 75094},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2284, col: 15, offset: 70113},
+									pos:  position{line: 2465, col: 15, offset: 75096},
 									name: "LAST",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2285, col: 5, offset: 70188},
+						pos: position{line: 2466, col: 5, offset: 75171},
 						run: (*parser).callonOptNullsOrder14,
 						expr: &litMatcher{
-							pos:        position{line: 2285, col: 5, offset: 70188},
+							pos:        position{line: 2466, col: 5, offset: 75171},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -16094,25 +17202,25 @@ var g = &grammar{
 		},
 		{
 			name: "OptSQLLimitOffset",
-			pos:  position{line: 2287, col: 1, offset: 70233},
+			pos:  position{line: 2468, col: 1, offset: 75216},
 			expr: &choiceExpr{
-				pos: position{line: 2288, col: 5, offset: 70255},
+				pos: position{line: 2469, col: 5, offset: 75238},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2288, col: 5, offset: 70255},
+						pos: position{line: 2469, col: 5, offset: 75238},
 						run: (*parser).callonOptSQLLimitOffset2,
 						expr: &seqExpr{
-							pos: position{line: 2288, col: 5, offset: 70255},
+							pos: position{line: 2469, col: 5, offset: 75238},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2288, col: 5, offset: 70255},
+									pos:  position{line: 2469, col: 5, offset: 75238},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 2288, col: 7, offset: 70257},
+									pos:   position{line: 2469, col: 7, offset: 75240},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2288, col: 10, offset: 70260},
+										pos:  position{line: 2469, col: 10, offset: 75243},
 										name: "SQLLimitOffset",
 									},
 								},
@@ -16120,10 +17228,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2289, col: 5, offset: 70299},
+						pos: position{line: 2470, col: 5, offset: 75282},
 						run: (*parser).callonOptSQLLimitOffset7,
 						expr: &litMatcher{
-							pos:        position{line: 2289, col: 5, offset: 70299},
+							pos:        position{line: 2470, col: 5, offset: 75282},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -16136,29 +17244,29 @@ var g = &grammar{
 		},
 		{
 			name: "SQLLimitOffset",
-			pos:  position{line: 2291, col: 1, offset: 70340},
+			pos:  position{line: 2472, col: 1, offset: 75323},
 			expr: &choiceExpr{
-				pos: position{line: 2292, col: 5, offset: 70359},
+				pos: position{line: 2473, col: 5, offset: 75342},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2292, col: 5, offset: 70359},
+						pos: position{line: 2473, col: 5, offset: 75342},
 						run: (*parser).callonSQLLimitOffset2,
 						expr: &seqExpr{
-							pos: position{line: 2292, col: 5, offset: 70359},
+							pos: position{line: 2473, col: 5, offset: 75342},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 2292, col: 5, offset: 70359},
+									pos:   position{line: 2473, col: 5, offset: 75342},
 									label: "l",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2292, col: 7, offset: 70361},
+										pos:  position{line: 2473, col: 7, offset: 75344},
 										name: "LimitClause",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 2292, col: 19, offset: 70373},
+									pos:   position{line: 2473, col: 19, offset: 75356},
 									label: "o",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2292, col: 21, offset: 70375},
+										pos:  position{line: 2473, col: 21, offset: 75358},
 										name: "OptOffsetClause",
 									},
 								},
@@ -16166,24 +17274,24 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2305, col: 5, offset: 70639},
+						pos: position{line: 2486, col: 5, offset: 75622},
 						run: (*parser).callonSQLLimitOffset8,
 						expr: &seqExpr{
-							pos: position{line: 2305, col: 5, offset: 70639},
+							pos: position{line: 2486, col: 5, offset: 75622},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 2305, col: 5, offset: 70639},
+									pos:   position{line: 2486, col: 5, offset: 75622},
 									label: "o",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2305, col: 7, offset: 70641},
+										pos:  position{line: 2486, col: 7, offset: 75624},
 										name: "OffsetClause",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 2305, col: 20, offset: 70654},
+									pos:   position{line: 2486, col: 20, offset: 75637},
 									label: "l",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2305, col: 22, offset: 70656},
+										pos:  position{line: 2486, col: 22, offset: 75639},
 										name: "OptLimitClause",
 									},
 								},
@@ -16197,25 +17305,25 @@ var g = &grammar{
 		},
 		{
 			name: "OptLimitClause",
-			pos:  position{line: 2317, col: 1, offset: 70885},
+			pos:  position{line: 2498, col: 1, offset: 75868},
 			expr: &choiceExpr{
-				pos: position{line: 2318, col: 5, offset: 70905},
+				pos: position{line: 2499, col: 5, offset: 75888},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2318, col: 5, offset: 70905},
+						pos: position{line: 2499, col: 5, offset: 75888},
 						run: (*parser).callonOptLimitClause2,
 						expr: &seqExpr{
-							pos: position{line: 2318, col: 5, offset: 70905},
+							pos: position{line: 2499, col: 5, offset: 75888},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2318, col: 5, offset: 70905},
+									pos:  position{line: 2499, col: 5, offset: 75888},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 2318, col: 7, offset: 70907},
+									pos:   position{line: 2499, col: 7, offset: 75890},
 									label: "l",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2318, col: 9, offset: 70909},
+										pos:  position{line: 2499, col: 9, offset: 75892},
 										name: "LimitClause",
 									},
 								},
@@ -16223,10 +17331,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2319, col: 5, offset: 70943},
+						pos: position{line: 2500, col: 5, offset: 75926},
 						run: (*parser).callonOptLimitClause7,
 						expr: &litMatcher{
-							pos:        position{line: 2319, col: 5, offset: 70943},
+							pos:        position{line: 2500, col: 5, offset: 75926},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -16239,50 +17347,50 @@ var g = &grammar{
 		},
 		{
 			name: "LimitClause",
-			pos:  position{line: 2321, col: 1, offset: 70980},
+			pos:  position{line: 2502, col: 1, offset: 75963},
 			expr: &choiceExpr{
-				pos: position{line: 2322, col: 5, offset: 70997},
+				pos: position{line: 2503, col: 5, offset: 75980},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2322, col: 5, offset: 70997},
+						pos: position{line: 2503, col: 5, offset: 75980},
 						run: (*parser).callonLimitClause2,
 						expr: &seqExpr{
-							pos: position{line: 2322, col: 5, offset: 70997},
+							pos: position{line: 2503, col: 5, offset: 75980},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2322, col: 5, offset: 70997},
+									pos:  position{line: 2503, col: 5, offset: 75980},
 									name: "LIMIT",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2322, col: 11, offset: 71003},
+									pos:  position{line: 2503, col: 11, offset: 75986},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2322, col: 13, offset: 71005},
+									pos:  position{line: 2503, col: 13, offset: 75988},
 									name: "ALL",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2323, col: 5, offset: 71033},
+						pos: position{line: 2504, col: 5, offset: 76016},
 						run: (*parser).callonLimitClause7,
 						expr: &seqExpr{
-							pos: position{line: 2323, col: 5, offset: 71033},
+							pos: position{line: 2504, col: 5, offset: 76016},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2323, col: 5, offset: 71033},
+									pos:  position{line: 2504, col: 5, offset: 76016},
 									name: "LIMIT",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2323, col: 11, offset: 71039},
+									pos:  position{line: 2504, col: 11, offset: 76022},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 2323, col: 13, offset: 71041},
+									pos:   position{line: 2504, col: 13, offset: 76024},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2323, col: 15, offset: 71043},
+										pos:  position{line: 2504, col: 15, offset: 76026},
 										name: "Expr",
 									},
 								},
@@ -16296,25 +17404,25 @@ var g = &grammar{
 		},
 		{
 			name: "OptOffsetClause",
-			pos:  position{line: 2325, col: 1, offset: 71067},
+			pos:  position{line: 2506, col: 1, offset: 76050},
 			expr: &choiceExpr{
-				pos: position{line: 2326, col: 5, offset: 71088},
+				pos: position{line: 2507, col: 5, offset: 76071},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2326, col: 5, offset: 71088},
+						pos: position{line: 2507, col: 5, offset: 76071},
 						run: (*parser).callonOptOffsetClause2,
 						expr: &seqExpr{
-							pos: position{line: 2326, col: 5, offset: 71088},
+							pos: position{line: 2507, col: 5, offset: 76071},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2326, col: 5, offset: 71088},
+									pos:  position{line: 2507, col: 5, offset: 76071},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 2326, col: 7, offset: 71090},
+									pos:   position{line: 2507, col: 7, offset: 76073},
 									label: "o",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2326, col: 9, offset: 71092},
+										pos:  position{line: 2507, col: 9, offset: 76075},
 										name: "OffsetClause",
 									},
 								},
@@ -16322,10 +17430,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2327, col: 5, offset: 71128},
+						pos: position{line: 2508, col: 5, offset: 76111},
 						run: (*parser).callonOptOffsetClause7,
 						expr: &litMatcher{
-							pos:        position{line: 2327, col: 5, offset: 71128},
+							pos:        position{line: 2508, col: 5, offset: 76111},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -16338,26 +17446,26 @@ var g = &grammar{
 		},
 		{
 			name: "OffsetClause",
-			pos:  position{line: 2329, col: 1, offset: 71153},
+			pos:  position{line: 2510, col: 1, offset: 76136},
 			expr: &actionExpr{
-				pos: position{line: 2330, col: 5, offset: 71171},
+				pos: position{line: 2511, col: 5, offset: 76154},
 				run: (*parser).callonOffsetClause1,
 				expr: &seqExpr{
-					pos: position{line: 2330, col: 5, offset: 71171},
+					pos: position{line: 2511, col: 5, offset: 76154},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 2330, col: 5, offset: 71171},
+							pos:  position{line: 2511, col: 5, offset: 76154},
 							name: "OFFSET",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2330, col: 12, offset: 71178},
+							pos:  position{line: 2511, col: 12, offset: 76161},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 2330, col: 14, offset: 71180},
+							pos:   position{line: 2511, col: 14, offset: 76163},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2330, col: 16, offset: 71182},
+								pos:  position{line: 2511, col: 16, offset: 76165},
 								name: "Expr",
 							},
 						},
@@ -16369,38 +17477,38 @@ var g = &grammar{
 		},
 		{
 			name: "SetOperation",
-			pos:  position{line: 2332, col: 1, offset: 71207},
+			pos:  position{line: 2513, col: 1, offset: 76190},
 			expr: &actionExpr{
-				pos: position{line: 2333, col: 5, offset: 71224},
+				pos: position{line: 2514, col: 5, offset: 76207},
 				run: (*parser).callonSetOperation1,
 				expr: &seqExpr{
-					pos: position{line: 2333, col: 5, offset: 71224},
+					pos: position{line: 2514, col: 5, offset: 76207},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 2333, col: 5, offset: 71224},
+							pos:   position{line: 2514, col: 5, offset: 76207},
 							label: "left",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2333, col: 10, offset: 71229},
+								pos:  position{line: 2514, col: 10, offset: 76212},
 								name: "SelectExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2333, col: 21, offset: 71240},
+							pos:   position{line: 2514, col: 21, offset: 76223},
 							label: "distinct",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2333, col: 30, offset: 71249},
+								pos:  position{line: 2514, col: 30, offset: 76232},
 								name: "SetOp",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2333, col: 36, offset: 71255},
+							pos:  position{line: 2514, col: 36, offset: 76238},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 2333, col: 38, offset: 71257},
+							pos:   position{line: 2514, col: 38, offset: 76240},
 							label: "right",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2333, col: 44, offset: 71263},
+								pos:  position{line: 2514, col: 44, offset: 76246},
 								name: "SelectExpr",
 							},
 						},
@@ -16412,60 +17520,60 @@ var g = &grammar{
 		},
 		{
 			name: "SetOp",
-			pos:  position{line: 2343, col: 1, offset: 71484},
+			pos:  position{line: 2524, col: 1, offset: 76467},
 			expr: &choiceExpr{
-				pos: position{line: 2344, col: 5, offset: 71495},
+				pos: position{line: 2525, col: 5, offset: 76478},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2344, col: 5, offset: 71495},
+						pos: position{line: 2525, col: 5, offset: 76478},
 						run: (*parser).callonSetOp2,
 						expr: &seqExpr{
-							pos: position{line: 2344, col: 5, offset: 71495},
+							pos: position{line: 2525, col: 5, offset: 76478},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2344, col: 5, offset: 71495},
+									pos:  position{line: 2525, col: 5, offset: 76478},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2344, col: 7, offset: 71497},
+									pos:  position{line: 2525, col: 7, offset: 76480},
 									name: "UNION",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2344, col: 13, offset: 71503},
+									pos:  position{line: 2525, col: 13, offset: 76486},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2344, col: 15, offset: 71505},
+									pos:  position{line: 2525, col: 15, offset: 76488},
 									name: "ALL",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2345, col: 5, offset: 71541},
+						pos: position{line: 2526, col: 5, offset: 76524},
 						run: (*parser).callonSetOp8,
 						expr: &seqExpr{
-							pos: position{line: 2345, col: 5, offset: 71541},
+							pos: position{line: 2526, col: 5, offset: 76524},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2345, col: 5, offset: 71541},
+									pos:  position{line: 2526, col: 5, offset: 76524},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2345, col: 7, offset: 71543},
+									pos:  position{line: 2526, col: 7, offset: 76526},
 									name: "UNION",
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 2345, col: 13, offset: 71549},
+									pos: position{line: 2526, col: 13, offset: 76532},
 									expr: &seqExpr{
-										pos: position{line: 2345, col: 14, offset: 71550},
+										pos: position{line: 2526, col: 14, offset: 76533},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 2345, col: 14, offset: 71550},
+												pos:  position{line: 2526, col: 14, offset: 76533},
 												name: "_",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 2345, col: 16, offset: 71552},
+												pos:  position{line: 2526, col: 16, offset: 76535},
 												name: "DISTINCT",
 											},
 										},
@@ -16481,84 +17589,84 @@ var g = &grammar{
 		},
 		{
 			name: "SQLGuard",
-			pos:  position{line: 2348, col: 1, offset: 71604},
+			pos:  position{line: 2529, col: 1, offset: 76587},
 			expr: &choiceExpr{
-				pos: position{line: 2349, col: 5, offset: 71619},
+				pos: position{line: 2530, col: 5, offset: 76602},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 2349, col: 5, offset: 71619},
+						pos:  position{line: 2530, col: 5, offset: 76602},
 						name: "FROM",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2349, col: 12, offset: 71626},
+						pos:  position{line: 2530, col: 12, offset: 76609},
 						name: "GROUP",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2349, col: 20, offset: 71634},
+						pos:  position{line: 2530, col: 20, offset: 76617},
 						name: "HAVING",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2349, col: 29, offset: 71643},
+						pos:  position{line: 2530, col: 29, offset: 76626},
 						name: "SELECT",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2349, col: 38, offset: 71652},
+						pos:  position{line: 2530, col: 38, offset: 76635},
 						name: "RECURSIVE",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2350, col: 5, offset: 71666},
+						pos:  position{line: 2531, col: 5, offset: 76649},
 						name: "INNER",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2350, col: 13, offset: 71674},
+						pos:  position{line: 2531, col: 13, offset: 76657},
 						name: "LEFT",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2350, col: 20, offset: 71681},
+						pos:  position{line: 2531, col: 20, offset: 76664},
 						name: "RIGHT",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2350, col: 28, offset: 71689},
+						pos:  position{line: 2531, col: 28, offset: 76672},
 						name: "OUTER",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2350, col: 36, offset: 71697},
+						pos:  position{line: 2531, col: 36, offset: 76680},
 						name: "CROSS",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2350, col: 44, offset: 71705},
+						pos:  position{line: 2531, col: 44, offset: 76688},
 						name: "JOIN",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2351, col: 5, offset: 71714},
+						pos:  position{line: 2532, col: 5, offset: 76697},
 						name: "UNION",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2352, col: 5, offset: 71724},
+						pos:  position{line: 2533, col: 5, offset: 76707},
 						name: "ORDER",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2353, col: 5, offset: 71734},
+						pos:  position{line: 2534, col: 5, offset: 76717},
 						name: "OFFSET",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2354, col: 5, offset: 71745},
+						pos:  position{line: 2535, col: 5, offset: 76728},
 						name: "LIMIT",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2355, col: 5, offset: 71755},
+						pos:  position{line: 2536, col: 5, offset: 76738},
 						name: "WHERE",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2356, col: 5, offset: 71766},
+						pos:  position{line: 2537, col: 5, offset: 76749},
 						name: "WITH",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2357, col: 5, offset: 71775},
+						pos:  position{line: 2538, col: 5, offset: 76758},
 						name: "USING",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2358, col: 5, offset: 71785},
+						pos:  position{line: 2539, col: 5, offset: 76768},
 						name: "ON",
 					},
 				},
@@ -16568,20 +17676,20 @@ var g = &grammar{
 		},
 		{
 			name: "AGGREGATE",
-			pos:  position{line: 2360, col: 1, offset: 71789},
+			pos:  position{line: 2541, col: 1, offset: 76772},
 			expr: &seqExpr{
-				pos: position{line: 2360, col: 14, offset: 71802},
+				pos: position{line: 2541, col: 14, offset: 76785},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2360, col: 14, offset: 71802},
+						pos:        position{line: 2541, col: 14, offset: 76785},
 						val:        "aggregate",
 						ignoreCase: true,
 						want:       "\"AGGREGATE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2360, col: 33, offset: 71821},
+						pos: position{line: 2541, col: 33, offset: 76804},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2360, col: 34, offset: 71822},
+							pos:  position{line: 2541, col: 34, offset: 76805},
 							name: "IdentifierRest",
 						},
 					},
@@ -16592,20 +17700,20 @@ var g = &grammar{
 		},
 		{
 			name: "ALL",
-			pos:  position{line: 2361, col: 1, offset: 71837},
+			pos:  position{line: 2542, col: 1, offset: 76820},
 			expr: &seqExpr{
-				pos: position{line: 2361, col: 14, offset: 71850},
+				pos: position{line: 2542, col: 14, offset: 76833},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2361, col: 14, offset: 71850},
+						pos:        position{line: 2542, col: 14, offset: 76833},
 						val:        "all",
 						ignoreCase: true,
 						want:       "\"ALL\"i",
 					},
 					&notExpr{
-						pos: position{line: 2361, col: 33, offset: 71869},
+						pos: position{line: 2542, col: 33, offset: 76852},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2361, col: 34, offset: 71870},
+							pos:  position{line: 2542, col: 34, offset: 76853},
 							name: "IdentifierRest",
 						},
 					},
@@ -16616,23 +17724,23 @@ var g = &grammar{
 		},
 		{
 			name: "AND",
-			pos:  position{line: 2362, col: 1, offset: 71885},
+			pos:  position{line: 2543, col: 1, offset: 76868},
 			expr: &actionExpr{
-				pos: position{line: 2362, col: 14, offset: 71898},
+				pos: position{line: 2543, col: 14, offset: 76881},
 				run: (*parser).callonAND1,
 				expr: &seqExpr{
-					pos: position{line: 2362, col: 14, offset: 71898},
+					pos: position{line: 2543, col: 14, offset: 76881},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 2362, col: 14, offset: 71898},
+							pos:        position{line: 2543, col: 14, offset: 76881},
 							val:        "and",
 							ignoreCase: true,
 							want:       "\"AND\"i",
 						},
 						&notExpr{
-							pos: position{line: 2362, col: 33, offset: 71917},
+							pos: position{line: 2543, col: 33, offset: 76900},
 							expr: &ruleRefExpr{
-								pos:  position{line: 2362, col: 34, offset: 71918},
+								pos:  position{line: 2543, col: 34, offset: 76901},
 								name: "IdentifierRest",
 							},
 						},
@@ -16644,20 +17752,20 @@ var g = &grammar{
 		},
 		{
 			name: "ANTI",
-			pos:  position{line: 2363, col: 1, offset: 71955},
+			pos:  position{line: 2544, col: 1, offset: 76938},
 			expr: &seqExpr{
-				pos: position{line: 2363, col: 14, offset: 71968},
+				pos: position{line: 2544, col: 14, offset: 76951},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2363, col: 14, offset: 71968},
+						pos:        position{line: 2544, col: 14, offset: 76951},
 						val:        "anti",
 						ignoreCase: true,
 						want:       "\"ANTI\"i",
 					},
 					&notExpr{
-						pos: position{line: 2363, col: 33, offset: 71987},
+						pos: position{line: 2544, col: 33, offset: 76970},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2363, col: 34, offset: 71988},
+							pos:  position{line: 2544, col: 34, offset: 76971},
 							name: "IdentifierRest",
 						},
 					},
@@ -16668,20 +17776,20 @@ var g = &grammar{
 		},
 		{
 			name: "AS",
-			pos:  position{line: 2364, col: 1, offset: 72003},
+			pos:  position{line: 2545, col: 1, offset: 76986},
 			expr: &seqExpr{
-				pos: position{line: 2364, col: 14, offset: 72016},
+				pos: position{line: 2545, col: 14, offset: 76999},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2364, col: 14, offset: 72016},
+						pos:        position{line: 2545, col: 14, offset: 76999},
 						val:        "as",
 						ignoreCase: true,
 						want:       "\"AS\"i",
 					},
 					&notExpr{
-						pos: position{line: 2364, col: 33, offset: 72035},
+						pos: position{line: 2545, col: 33, offset: 77018},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2364, col: 34, offset: 72036},
+							pos:  position{line: 2545, col: 34, offset: 77019},
 							name: "IdentifierRest",
 						},
 					},
@@ -16692,23 +17800,23 @@ var g = &grammar{
 		},
 		{
 			name: "ASC",
-			pos:  position{line: 2365, col: 1, offset: 72051},
+			pos:  position{line: 2546, col: 1, offset: 77034},
 			expr: &actionExpr{
-				pos: position{line: 2365, col: 14, offset: 72064},
+				pos: position{line: 2546, col: 14, offset: 77047},
 				run: (*parser).callonASC1,
 				expr: &seqExpr{
-					pos: position{line: 2365, col: 14, offset: 72064},
+					pos: position{line: 2546, col: 14, offset: 77047},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 2365, col: 14, offset: 72064},
+							pos:        position{line: 2546, col: 14, offset: 77047},
 							val:        "asc",
 							ignoreCase: true,
 							want:       "\"ASC\"i",
 						},
 						&notExpr{
-							pos: position{line: 2365, col: 33, offset: 72083},
+							pos: position{line: 2546, col: 33, offset: 77066},
 							expr: &ruleRefExpr{
-								pos:  position{line: 2365, col: 34, offset: 72084},
+								pos:  position{line: 2546, col: 34, offset: 77067},
 								name: "IdentifierRest",
 							},
 						},
@@ -16718,22 +17826,46 @@ var g = &grammar{
 			leader:        false,
 			leftRecursive: false,
 		},
+		{
+			name: "ASOF",
+			pos:  position{line: 2547, col: 1, offset: 77104},
+			expr: &seqExpr{
+				pos: position{line: 2547, col: 14, offset: 77117},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2547, col: 14, offset: 77117},
+						val:        "asof",
+						ignoreCase: true,
+						want:       "\"ASOF\"i",
+					},
+					&notExpr{
+						pos: position{line: 2547, col: 33, offset: 77136},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2547, col: 34, offset: 77137},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
 		{
 			name: "ASSERT",
-			pos:  position{line: 2366, col: 1, offset: 72121},
+			pos:  position{line: 2548, col: 1, offset: 77152},
 			expr: &seqExpr{
-				pos: position{line: 2366, col: 14, offset: 72134},
+				pos: position{line: 2548, col: 14, offset: 77165},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2366, col: 14, offset: 72134},
+						pos:        position{line: 2548, col: 14, offset: 77165},
 						val:        "assert",
 						ignoreCase: true,
 						want:       "\"ASSERT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2366, col: 33, offset: 72153},
+						pos: position{line: 2548, col: 33, offset: 77184},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2366, col: 34, offset: 72154},
+							pos:  position{line: 2548, col: 34, offset: 77185},
 							name: "IdentifierRest",
 						},
 					},
@@ -16744,20 +17876,20 @@ var g = &grammar{
 		},
 		{
 			name: "AT",
-			pos:  position{line: 2367, col: 1, offset: 72169},
+			pos:  position{line: 2549, col: 1, offset: 77200},
 			expr: &seqExpr{
-				pos: position{line: 2367, col: 14, offset: 72182},
+				pos: position{line: 2549, col: 14, offset: 77213},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2367, col: 14, offset: 72182},
+						pos:        position{line: 2549, col: 14, offset: 77213},
 						val:        "at",
 						ignoreCase: true,
 						want:       "\"AT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2367, col: 33, offset: 72201},
+						pos: position{line: 2549, col: 33, offset: 77232},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2367, col: 34, offset: 72202},
+							pos:  position{line: 2549, col: 34, offset: 77233},
 							name: "IdentifierRest",
 						},
 					},
@@ -16768,20 +17900,20 @@ var g = &grammar{
 		},
 		{
 			name: "AUTHOR",
-			pos:  position{line: 2368, col: 1, offset: 72217},
+			pos:  position{line: 2550, col: 1, offset: 77248},
 			expr: &seqExpr{
-				pos: position{line: 2368, col: 14, offset: 72230},
+				pos: position{line: 2550, col: 14, offset: 77261},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2368, col: 14, offset: 72230},
+						pos:        position{line: 2550, col: 14, offset: 77261},
 						val:        "author",
 						ignoreCase: true,
 						want:       "\"AUTHOR\"i",
 					},
 					&notExpr{
-						pos: position{line: 2368, col: 33, offset: 72249},
+						pos: position{line: 2550, col: 33, offset: 77280},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2368, col: 34, offset: 72250},
+							pos:  position{line: 2550, col: 34, offset: 77281},
 							name: "IdentifierRest",
 						},
 					},
@@ -16792,20 +17924,20 @@ var g = &grammar{
 		},
 		{
 			name: "BETWEEN",
-			pos:  position{line: 2369, col: 1, offset: 72265},
+			pos:  position{line: 2551, col: 1, offset: 77296},
 			expr: &seqExpr{
-				pos: position{line: 2369, col: 14, offset: 72278},
+				pos: position{line: 2551, col: 14, offset: 77309},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2369, col: 14, offset: 72278},
+						pos:        position{line: 2551, col: 14, offset: 77309},
 						val:        "between",
 						ignoreCase: true,
 						want:       "\"BETWEEN\"i",
 					},
 					&notExpr{
-						pos: position{line: 2369, col: 33, offset: 72297},
+						pos: position{line: 2551, col: 33, offset: 77328},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2369, col: 34, offset: 72298},
+							pos:  position{line: 2551, col: 34, offset: 77329},
 							name: "IdentifierRest",
 						},
 					},
@@ -16816,20 +17948,20 @@ var g = &grammar{
 		},
 		{
 			name: "BODY",
-			pos:  position{line: 2370, col: 1, offset: 72313},
+			pos:  position{line: 2552, col: 1, offset: 77344},
 			expr: &seqExpr{
-				pos: position{line: 2370, col: 14, offset: 72326},
+				pos: position{line: 2552, col: 14, offset: 77357},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2370, col: 14, offset: 72326},
+						pos:        position{line: 2552, col: 14, offset: 77357},
 						val:        "body",
 						ignoreCase: true,
 						want:       "\"BODY\"i",
 					},
 					&notExpr{
-						pos: position{line: 2370, col: 33, offset: 72345},
+						pos: position{line: 2552, col: 33, offset: 77376},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2370, col: 34, offset: 72346},
+							pos:  position{line: 2552, col: 34, offset: 77377},
 							name: "IdentifierRest",
 						},
 					},
@@ -16840,20 +17972,20 @@ var g = &grammar{
 		},
 		{
 			name: "BY",
-			pos:  position{line: 2371, col: 1, offset: 72361},
+			pos:  position{line: 2553, col: 1, offset: 77392},
 			expr: &seqExpr{
-				pos: position{line: 2371, col: 14, offset: 72374},
+				pos: position{line: 2553, col: 14, offset: 77405},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2371, col: 14, offset: 72374},
+						pos:        position{line: 2553, col: 14, offset: 77405},
 						val:        "by",
 						ignoreCase: true,
 						want:       "\"BY\"i",
 					},
 					&notExpr{
-						pos: position{line: 2371, col: 33, offset: 72393},
+						pos: position{line: 2553, col: 33, offset: 77424},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2371, col: 34, offset: 72394},
+							pos:  position{line: 2553, col: 34, offset: 77425},
 							name: "IdentifierRest",
 						},
 					},
@@ -16864,20 +17996,20 @@ var g = &grammar{
 		},
 		{
 			name: "CASE",
-			pos:  position{line: 2372, col: 1, offset: 72409},
+			pos:  position{line: 2554, col: 1, offset: 77440},
 			expr: &seqExpr{
-				pos: position{line: 2372, col: 14, offset: 72422},
+				pos: position{line: 2554, col: 14, offset: 77453},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2372, col: 14, offset: 72422},
+						pos:        position{line: 2554, col: 14, offset: 77453},
 						val:        "case",
 						ignoreCase: true,
 						want:       "\"CASE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2372, col: 33, offset: 72441},
+						pos: position{line: 2554, col: 33, offset: 77472},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2372, col: 34, offset: 72442},
+							pos:  position{line: 2554, col: 34, offset: 77473},
 							name: "IdentifierRest",
 						},
 					},
@@ -16888,20 +18020,44 @@ var g = &grammar{
 		},
 		{
 			name: "CAST",
-			pos:  position{line: 2373, col: 1, offset: 72457},
+			pos:  position{line: 2555, col: 1, offset: 77488},
 			expr: &seqExpr{
-				pos: position{line: 2373, col: 14, offset: 72470},
+				pos: position{line: 2555, col: 14, offset: 77501},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2373, col: 14, offset: 72470},
+						pos:        position{line: 2555, col: 14, offset: 77501},
 						val:        "cast",
 						ignoreCase: true,
 						want:       "\"CAST\"i",
 					},
 					&notExpr{
-						pos: position{line: 2373, col: 33, offset: 72489},
+						pos: position{line: 2555, col: 33, offset: 77520},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2555, col: 34, offset: 77521},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "COLLATE",
+			pos:  position{line: 2556, col: 1, offset: 77536},
+			expr: &seqExpr{
+				pos: position{line: 2556, col: 14, offset: 77549},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2556, col: 14, offset: 77549},
+						val:        "collate",
+						ignoreCase: true,
+						want:       "\"COLLATE\"i",
+					},
+					&notExpr{
+						pos: position{line: 2556, col: 33, offset: 77568},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2373, col: 34, offset: 72490},
+							pos:  position{line: 2556, col: 34, offset: 77569},
 							name: "IdentifierRest",
 						},
 					},
@@ -16912,20 +18068,20 @@ var g = &grammar{
 		},
 		{
 			name: "CONST",
-			pos:  position{line: 2374, col: 1, offset: 72505},
+			pos:  position{line: 2557, col: 1, offset: 77584},
 			expr: &seqExpr{
-				pos: position{line: 2374, col: 14, offset: 72518},
+				pos: position{line: 2557, col: 14, offset: 77597},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2374, col: 14, offset: 72518},
+						pos:        position{line: 2557, col: 14, offset: 77597},
 						val:        "const",
 						ignoreCase: true,
 						want:       "\"CONST\"i",
 					},
 					&notExpr{
-						pos: position{line: 2374, col: 33, offset: 72537},
+						pos: position{line: 2557, col: 33, offset: 77616},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2374, col: 34, offset: 72538},
+							pos:  position{line: 2557, col: 34, offset: 77617},
 							name: "IdentifierRest",
 						},
 					},
@@ -16936,20 +18092,20 @@ var g = &grammar{
 		},
 		{
 			name: "COUNT",
-			pos:  position{line: 2375, col: 1, offset: 72553},
+			pos:  position{line: 2558, col: 1, offset: 77632},
 			expr: &seqExpr{
-				pos: position{line: 2375, col: 14, offset: 72566},
+				pos: position{line: 2558, col: 14, offset: 77645},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2375, col: 14, offset: 72566},
+						pos:        position{line: 2558, col: 14, offset: 77645},
 						val:        "count",
 						ignoreCase: true,
 						want:       "\"COUNT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2375, col: 33, offset: 72585},
+						pos: position{line: 2558, col: 33, offset: 77664},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2375, col: 34, offset: 72586},
+							pos:  position{line: 2558, col: 34, offset: 77665},
 							name: "IdentifierRest",
 						},
 					},
@@ -16960,20 +18116,20 @@ var g = &grammar{
 		},
 		{
 			name: "CROSS",
-			pos:  position{line: 2376, col: 1, offset: 72601},
+			pos:  position{line: 2559, col: 1, offset: 77680},
 			expr: &seqExpr{
-				pos: position{line: 2376, col: 14, offset: 72614},
+				pos: position{line: 2559, col: 14, offset: 77693},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2376, col: 14, offset: 72614},
+						pos:        position{line: 2559, col: 14, offset: 77693},
 						val:        "cross",
 						ignoreCase: true,
 						want:       "\"CROSS\"i",
 					},
 					&notExpr{
-						pos: position{line: 2376, col: 33, offset: 72633},
+						pos: position{line: 2559, col: 33, offset: 77712},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2376, col: 34, offset: 72634},
+							pos:  position{line: 2559, col: 34, offset: 77713},
 							name: "IdentifierRest",
 						},
 					},
@@ -16984,20 +18140,20 @@ var g = &grammar{
 		},
 		{
 			name: "CUT",
-			pos:  position{line: 2377, col: 1, offset: 72649},
+			pos:  position{line: 2560, col: 1, offset: 77728},
 			expr: &seqExpr{
-				pos: position{line: 2377, col: 14, offset: 72662},
+				pos: position{line: 2560, col: 14, offset: 77741},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2377, col: 14, offset: 72662},
+						pos:        position{line: 2560, col: 14, offset: 77741},
 						val:        "cut",
 						ignoreCase: true,
 						want:       "\"CUT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2377, col: 33, offset: 72681},
+						pos: position{line: 2560, col: 33, offset: 77760},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2377, col: 34, offset: 72682},
+							pos:  position{line: 2560, col: 34, offset: 77761},
 							name: "IdentifierRest",
 						},
 					},
@@ -17008,23 +18164,23 @@ var g = &grammar{
 		},
 		{
 			name: "DATE",
-			pos:  position{line: 2378, col: 1, offset: 72697},
+			pos:  position{line: 2561, col: 1, offset: 77776},
 			expr: &actionExpr{
-				pos: position{line: 2378, col: 14, offset: 72710},
+				pos: position{line: 2561, col: 14, offset: 77789},
 				run: (*parser).callonDATE1,
 				expr: &seqExpr{
-					pos: position{line: 2378, col: 14, offset: 72710},
+					pos: position{line: 2561, col: 14, offset: 77789},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 2378, col: 14, offset: 72710},
+							pos:        position{line: 2561, col: 14, offset: 77789},
 							val:        "date",
 							ignoreCase: true,
 							want:       "\"DATE\"i",
 						},
 						&notExpr{
-							pos: position{line: 2378, col: 33, offset: 72729},
+							pos: position{line: 2561, col: 33, offset: 77808},
 							expr: &ruleRefExpr{
-								pos:  position{line: 2378, col: 34, offset: 72730},
+								pos:  position{line: 2561, col: 34, offset: 77809},
 								name: "IdentifierRest",
 							},
 						},
@@ -17036,20 +18192,20 @@ var g = &grammar{
 		},
 		{
 			name: "DEBUG",
-			pos:  position{line: 2379, col: 1, offset: 72768},
+			pos:  position{line: 2562, col: 1, offset: 77847},
 			expr: &seqExpr{
-				pos: position{line: 2379, col: 14, offset: 72781},
+				pos: position{line: 2562, col: 14, offset: 77860},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2379, col: 14, offset: 72781},
+						pos:        position{line: 2562, col: 14, offset: 77860},
 						val:        "debug",
 						ignoreCase: true,
 						want:       "\"DEBUG\"i",
 					},
 					&notExpr{
-						pos: position{line: 2379, col: 33, offset: 72800},
+						pos: position{line: 2562, col: 33, offset: 77879},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2379, col: 34, offset: 72801},
+							pos:  position{line: 2562, col: 34, offset: 77880},
 							name: "IdentifierRest",
 						},
 					},
@@ -17060,20 +18216,20 @@ var g = &grammar{
 		},
 		{
 			name: "DEFAULT",
-			pos:  position{line: 2380, col: 1, offset: 72816},
+			pos:  position{line: 2563, col: 1, offset: 77895},
 			expr: &seqExpr{
-				pos: position{line: 2380, col: 14, offset: 72829},
+				pos: position{line: 2563, col: 14, offset: 77908},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2380, col: 14, offset: 72829},
+						pos:        position{line: 2563, col: 14, offset: 77908},
 						val:        "default",
 						ignoreCase: true,
 						want:       "\"DEFAULT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2380, col: 33, offset: 72848},
+						pos: position{line: 2563, col: 33, offset: 77927},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2380, col: 34, offset: 72849},
+							pos:  position{line: 2563, col: 34, offset: 77928},
 							name: "IdentifierRest",
 						},
 					},
@@ -17084,23 +18240,23 @@ var g = &grammar{
 		},
 		{
 			name: "DESC",
-			pos:  position{line: 2381, col: 1, offset: 72864},
+			pos:  position{line: 2564, col: 1, offset: 77943},
 			expr: &actionExpr{
-				pos: position{line: 2381, col: 14, offset: 72877},
+				pos: position{line: 2564, col: 14, offset: 77956},
 				run: (*parser).callonDESC1,
 				expr: &seqExpr{
-					pos: position{line: 2381, col: 14, offset: 72877},
+					pos: position{line: 2564, col: 14, offset: 77956},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 2381, col: 14, offset: 72877},
+							pos:        position{line: 2564, col: 14, offset: 77956},
 							val:        "desc",
 							ignoreCase: true,
 							want:       "\"DESC\"i",
 						},
 						&notExpr{
-							pos: position{line: 2381, col: 33, offset: 72896},
+							pos: position{line: 2564, col: 33, offset: 77975},
 							expr: &ruleRefExpr{
-								pos:  position{line: 2381, col: 34, offset: 72897},
+								pos:  position{line: 2564, col: 34, offset: 77976},
 								name: "IdentifierRest",
 							},
 						},
@@ -17112,20 +18268,20 @@ var g = &grammar{
 		},
 		{
 			name: "DISTINCT",
-			pos:  position{line: 2382, col: 1, offset: 72935},
+			pos:  position{line: 2565, col: 1, offset: 78014},
 			expr: &seqExpr{
-				pos: position{line: 2382, col: 14, offset: 72948},
+				pos: position{line: 2565, col: 14, offset: 78027},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2382, col: 14, offset: 72948},
+						pos:        position{line: 2565, col: 14, offset: 78027},
 						val:        "distinct",
 						ignoreCase: true,
 						want:       "\"DISTINCT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2382, col: 33, offset: 72967},
+						pos: position{line: 2565, col: 33, offset: 78046},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2382, col: 34, offset: 72968},
+							pos:  position{line: 2565, col: 34, offset: 78047},
 							name: "IdentifierRest",
 						},
 					},
@@ -17136,20 +18292,20 @@ var g = &grammar{
 		},
 		{
 			name: "DROP",
-			pos:  position{line: 2383, col: 1, offset: 72983},
+			pos:  position{line: 2566, col: 1, offset: 78062},
 			expr: &seqExpr{
-				pos: position{line: 2383, col: 14, offset: 72996},
+				pos: position{line: 2566, col: 14, offset: 78075},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2383, col: 14, offset: 72996},
+						pos:        position{line: 2566, col: 14, offset: 78075},
 						val:        "drop",
 						ignoreCase: true,
 						want:       "\"DROP\"i",
 					},
 					&notExpr{
-						pos: position{line: 2383, col: 33, offset: 73015},
+						pos: position{line: 2566, col: 33, offset: 78094},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2383, col: 34, offset: 73016},
+							pos:  position{line: 2566, col: 34, offset: 78095},
 							name: "IdentifierRest",
 						},
 					},
@@ -17160,20 +18316,20 @@ var g = &grammar{
 		},
 		{
 			name: "ELSE",
-			pos:  position{line: 2384, col: 1, offset: 73032},
+			pos:  position{line: 2567, col: 1, offset: 78111},
 			expr: &seqExpr{
-				pos: position{line: 2384, col: 14, offset: 73045},
+				pos: position{line: 2567, col: 14, offset: 78124},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2384, col: 14, offset: 73045},
+						pos:        position{line: 2567, col: 14, offset: 78124},
 						val:        "else",
 						ignoreCase: true,
 						want:       "\"ELSE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2384, col: 33, offset: 73064},
+						pos: position{line: 2567, col: 33, offset: 78143},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2384, col: 34, offset: 73065},
+							pos:  position{line: 2567, col: 34, offset: 78144},
 							name: "IdentifierRest",
 						},
 					},
@@ -17184,20 +18340,20 @@ var g = &grammar{
 		},
 		{
 			name: "END",
-			pos:  position{line: 2385, col: 1, offset: 73080},
+			pos:  position{line: 2568, col: 1, offset: 78159},
 			expr: &seqExpr{
-				pos: position{line: 2385, col: 14, offset: 73093},
+				pos: position{line: 2568, col: 14, offset: 78172},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2385, col: 14, offset: 73093},
+						pos:        position{line: 2568, col: 14, offset: 78172},
 						val:        "end",
 						ignoreCase: true,
 						want:       "\"END\"i",
 					},
 					&notExpr{
-						pos: position{line: 2385, col: 33, offset: 73112},
+						pos: position{line: 2568, col: 33, offset: 78191},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2385, col: 34, offset: 73113},
+							pos:  position{line: 2568, col: 34, offset: 78192},
 							name: "IdentifierRest",
 						},
 					},
@@ -17208,20 +18364,20 @@ var g = &grammar{
 		},
 		{
 			name: "ERROR",
-			pos:  position{line: 2386, col: 1, offset: 73128},
+			pos:  position{line: 2569, col: 1, offset: 78207},
 			expr: &seqExpr{
-				pos: position{line: 2386, col: 14, offset: 73141},
+				pos: position{line: 2569, col: 14, offset: 78220},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2386, col: 14, offset: 73141},
+						pos:        position{line: 2569, col: 14, offset: 78220},
 						val:        "error",
 						ignoreCase: true,
 						want:       "\"ERROR\"i",
 					},
 					&notExpr{
-						pos: position{line: 2386, col: 33, offset: 73160},
+						pos: position{line: 2569, col: 33, offset: 78239},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2386, col: 34, offset: 73161},
+							pos:  position{line: 2569, col: 34, offset: 78240},
 							name: "IdentifierRest",
 						},
 					},
@@ -17232,20 +18388,20 @@ var g = &grammar{
 		},
 		{
 			name: "EVAL",
-			pos:  position{line: 2387, col: 1, offset: 73176},
+			pos:  position{line: 2570, col: 1, offset: 78255},
 			expr: &seqExpr{
-				pos: position{line: 2387, col: 14, offset: 73189},
+				pos: position{line: 2570, col: 14, offset: 78268},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2387, col: 14, offset: 73189},
+						pos:        position{line: 2570, col: 14, offset: 78268},
 						val:        "eval",
 						ignoreCase: true,
 						want:       "\"EVAL\"i",
 					},
 					&notExpr{
-						pos: position{line: 2387, col: 33, offset: 73208},
+						pos: position{line: 2570, col: 33, offset: 78287},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2387, col: 34, offset: 73209},
+							pos:  position{line: 2570, col: 34, offset: 78288},
 							name: "IdentifierRest",
 						},
 					},
@@ -17256,20 +18412,20 @@ var g = &grammar{
 		},
 		{
 			name: "EXPLODE",
-			pos:  position{line: 2388, col: 1, offset: 73224},
+			pos:  position{line: 2571, col: 1, offset: 78303},
 			expr: &seqExpr{
-				pos: position{line: 2388, col: 14, offset: 73237},
+				pos: position{line: 2571, col: 14, offset: 78316},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2388, col: 14, offset: 73237},
+						pos:        position{line: 2571, col: 14, offset: 78316},
 						val:        "explode",
 						ignoreCase: true,
 						want:       "\"EXPLODE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2388, col: 33, offset: 73256},
+						pos: position{line: 2571, col: 33, offset: 78335},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2388, col: 34, offset: 73257},
+							pos:  position{line: 2571, col: 34, offset: 78336},
 							name: "IdentifierRest",
 						},
 					},
@@ -17280,20 +18436,20 @@ var g = &grammar{
 		},
 		{
 			name: "EXTRACT",
-			pos:  position{line: 2389, col: 1, offset: 73272},
+			pos:  position{line: 2572, col: 1, offset: 78351},
 			expr: &seqExpr{
-				pos: position{line: 2389, col: 14, offset: 73285},
+				pos: position{line: 2572, col: 14, offset: 78364},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2389, col: 14, offset: 73285},
+						pos:        position{line: 2572, col: 14, offset: 78364},
 						val:        "extract",
 						ignoreCase: true,
 						want:       "\"EXTRACT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2389, col: 33, offset: 73304},
+						pos: position{line: 2572, col: 33, offset: 78383},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2389, col: 34, offset: 73305},
+							pos:  position{line: 2572, col: 34, offset: 78384},
 							name: "IdentifierRest",
 						},
 					},
@@ -17304,20 +18460,20 @@ var g = &grammar{
 		},
 		{
 			name: "FALSE",
-			pos:  position{line: 2390, col: 1, offset: 73320},
+			pos:  position{line: 2573, col: 1, offset: 78399},
 			expr: &seqExpr{
-				pos: position{line: 2390, col: 14, offset: 73333},
+				pos: position{line: 2573, col: 14, offset: 78412},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2390, col: 14, offset: 73333},
+						pos:        position{line: 2573, col: 14, offset: 78412},
 						val:        "false",
 						ignoreCase: true,
 						want:       "\"FALSE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2390, col: 33, offset: 73352},
+						pos: position{line: 2573, col: 33, offset: 78431},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2390, col: 34, offset: 73353},
+							pos:  position{line: 2573, col: 34, offset: 78432},
 							name: "IdentifierRest",
 						},
 					},
@@ -17328,20 +18484,20 @@ var g = &grammar{
 		},
 		{
 			name: "FIRST",
-			pos:  position{line: 2391, col: 1, offset: 73368},
+			pos:  position{line: 2574, col: 1, offset: 78447},
 			expr: &seqExpr{
-				pos: position{line: 2391, col: 14, offset: 73381},
+				pos: position{line: 2574, col: 14, offset: 78460},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2391, col: 14, offset: 73381},
+						pos:        position{line: 2574, col: 14, offset: 78460},
 						val:        "first",
 						ignoreCase: true,
 						want:       "\"FIRST\"i",
 					},
 					&notExpr{
-						pos: position{line: 2391, col: 33, offset: 73400},
+						pos: position{line: 2574, col: 33, offset: 78479},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2391, col: 34, offset: 73401},
+							pos:  position{line: 2574, col: 34, offset: 78480},
 							name: "IdentifierRest",
 						},
 					},
@@ -17352,20 +18508,20 @@ var g = &grammar{
 		},
 		{
 			name: "FOR",
-			pos:  position{line: 2392, col: 1, offset: 73416},
+			pos:  position{line: 2575, col: 1, offset: 78495},
 			expr: &seqExpr{
-				pos: position{line: 2392, col: 14, offset: 73429},
+				pos: position{line: 2575, col: 14, offset: 78508},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2392, col: 14, offset: 73429},
+						pos:        position{line: 2575, col: 14, offset: 78508},
 						val:        "for",
 						ignoreCase: true,
 						want:       "\"FOR\"i",
 					},
 					&notExpr{
-						pos: position{line: 2392, col: 33, offset: 73448},
+						pos: position{line: 2575, col: 33, offset: 78527},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2392, col: 34, offset: 73449},
+							pos:  position{line: 2575, col: 34, offset: 78528},
 							name: "IdentifierRest",
 						},
 					},
@@ -17376,20 +18532,20 @@ var g = &grammar{
 		},
 		{
 			name: "FORK",
-			pos:  position{line: 2393, col: 1, offset: 73464},
+			pos:  position{line: 2576, col: 1, offset: 78543},
 			expr: &seqExpr{
-				pos: position{line: 2393, col: 14, offset: 73477},
+				pos: position{line: 2576, col: 14, offset: 78556},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2393, col: 14, offset: 73477},
+						pos:        position{line: 2576, col: 14, offset: 78556},
 						val:        "fork",
 						ignoreCase: true,
 						want:       "\"FORK\"i",
 					},
 					&notExpr{
-						pos: position{line: 2393, col: 33, offset: 73496},
+						pos: position{line: 2576, col: 33, offset: 78575},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2393, col: 34, offset: 73497},
+							pos:  position{line: 2576, col: 34, offset: 78576},
 							name: "IdentifierRest",
 						},
 					},
@@ -17400,20 +18556,20 @@ var g = &grammar{
 		},
 		{
 			name: "FORMAT",
-			pos:  position{line: 2394, col: 1, offset: 73512},
+			pos:  position{line: 2577, col: 1, offset: 78591},
 			expr: &seqExpr{
-				pos: position{line: 2394, col: 14, offset: 73525},
+				pos: position{line: 2577, col: 14, offset: 78604},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2394, col: 14, offset: 73525},
+						pos:        position{line: 2577, col: 14, offset: 78604},
 						val:        "format",
 						ignoreCase: true,
 						want:       "\"FORMAT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2394, col: 33, offset: 73544},
+						pos: position{line: 2577, col: 33, offset: 78623},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2394, col: 34, offset: 73545},
+							pos:  position{line: 2577, col: 34, offset: 78624},
 							name: "IdentifierRest",
 						},
 					},
@@ -17424,20 +18580,20 @@ var g = &grammar{
 		},
 		{
 			name: "FROM",
-			pos:  position{line: 2395, col: 1, offset: 73560},
+			pos:  position{line: 2578, col: 1, offset: 78639},
 			expr: &seqExpr{
-				pos: position{line: 2395, col: 14, offset: 73573},
+				pos: position{line: 2578, col: 14, offset: 78652},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2395, col: 14, offset: 73573},
+						pos:        position{line: 2578, col: 14, offset: 78652},
 						val:        "from",
 						ignoreCase: true,
 						want:       "\"FROM\"i",
 					},
 					&notExpr{
-						pos: position{line: 2395, col: 33, offset: 73592},
+						pos: position{line: 2578, col: 33, offset: 78671},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2395, col: 34, offset: 73593},
+							pos:  position{line: 2578, col: 34, offset: 78672},
 							name: "IdentifierRest",
 						},
 					},
@@ -17448,20 +18604,20 @@ var g = &grammar{
 		},
 		{
 			name: "FULL",
-			pos:  position{line: 2396, col: 1, offset: 73608},
+			pos:  position{line: 2579, col: 1, offset: 78687},
 			expr: &seqExpr{
-				pos: position{line: 2396, col: 14, offset: 73621},
+				pos: position{line: 2579, col: 14, offset: 78700},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2396, col: 14, offset: 73621},
+						pos:        position{line: 2579, col: 14, offset: 78700},
 						val:        "full",
 						ignoreCase: true,
 						want:       "\"FULL\"i",
 					},
 					&notExpr{
-						pos: position{line: 2396, col: 33, offset: 73640},
+						pos: position{line: 2579, col: 33, offset: 78719},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2396, col: 34, offset: 73641},
+							pos:  position{line: 2579, col: 34, offset: 78720},
 							name: "IdentifierRest",
 						},
 					},
@@ -17472,20 +18628,20 @@ var g = &grammar{
 		},
 		{
 			name: "FUNC",
-			pos:  position{line: 2397, col: 1, offset: 73656},
+			pos:  position{line: 2580, col: 1, offset: 78735},
 			expr: &seqExpr{
-				pos: position{line: 2397, col: 14, offset: 73669},
+				pos: position{line: 2580, col: 14, offset: 78748},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2397, col: 14, offset: 73669},
+						pos:        position{line: 2580, col: 14, offset: 78748},
 						val:        "func",
 						ignoreCase: true,
 						want:       "\"FUNC\"i",
 					},
 					&notExpr{
-						pos: position{line: 2397, col: 33, offset: 73688},
+						pos: position{line: 2580, col: 33, offset: 78767},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2397, col: 34, offset: 73689},
+							pos:  position{line: 2580, col: 34, offset: 78768},
 							name: "IdentifierRest",
 						},
 					},
@@ -17496,20 +18652,68 @@ var g = &grammar{
 		},
 		{
 			name: "FUSE",
-			pos:  position{line: 2398, col: 1, offset: 73704},
+			pos:  position{line: 2581, col: 1, offset: 78783},
 			expr: &seqExpr{
-				pos: position{line: 2398, col: 14, offset: 73717},
+				pos: position{line: 2581, col: 14, offset: 78796},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2398, col: 14, offset: 73717},
+						pos:        position{line: 2581, col: 14, offset: 78796},
 						val:        "fuse",
 						ignoreCase: true,
 						want:       "\"FUSE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2398, col: 33, offset: 73736},
+						pos: position{line: 2581, col: 33, offset: 78815},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2581, col: 34, offset: 78816},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "GENERATE",
+			pos:  position{line: 2582, col: 1, offset: 78831},
+			expr: &seqExpr{
+				pos: position{line: 2582, col: 14, offset: 78844},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2582, col: 14, offset: 78844},
+						val:        "generate",
+						ignoreCase: true,
+						want:       "\"GENERATE\"i",
+					},
+					&notExpr{
+						pos: position{line: 2582, col: 33, offset: 78863},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2582, col: 34, offset: 78864},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "GRAPH",
+			pos:  position{line: 2583, col: 1, offset: 78879},
+			expr: &seqExpr{
+				pos: position{line: 2583, col: 14, offset: 78892},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2583, col: 14, offset: 78892},
+						val:        "graph",
+						ignoreCase: true,
+						want:       "\"GRAPH\"i",
+					},
+					&notExpr{
+						pos: position{line: 2583, col: 33, offset: 78911},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2398, col: 34, offset: 73737},
+							pos:  position{line: 2583, col: 34, offset: 78912},
 							name: "IdentifierRest",
 						},
 					},
@@ -17520,20 +18724,20 @@ var g = &grammar{
 		},
 		{
 			name: "GREP",
-			pos:  position{line: 2399, col: 1, offset: 73752},
+			pos:  position{line: 2584, col: 1, offset: 78927},
 			expr: &seqExpr{
-				pos: position{line: 2399, col: 14, offset: 73765},
+				pos: position{line: 2584, col: 14, offset: 78940},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2399, col: 14, offset: 73765},
+						pos:        position{line: 2584, col: 14, offset: 78940},
 						val:        "grep",
 						ignoreCase: true,
 						want:       "\"GREP\"i",
 					},
 					&notExpr{
-						pos: position{line: 2399, col: 33, offset: 73784},
+						pos: position{line: 2584, col: 33, offset: 78959},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2399, col: 34, offset: 73785},
+							pos:  position{line: 2584, col: 34, offset: 78960},
 							name: "IdentifierRest",
 						},
 					},
@@ -17544,20 +18748,20 @@ var g = &grammar{
 		},
 		{
 			name: "GROUP",
-			pos:  position{line: 2400, col: 1, offset: 73800},
+			pos:  position{line: 2585, col: 1, offset: 78975},
 			expr: &seqExpr{
-				pos: position{line: 2400, col: 14, offset: 73813},
+				pos: position{line: 2585, col: 14, offset: 78988},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2400, col: 14, offset: 73813},
+						pos:        position{line: 2585, col: 14, offset: 78988},
 						val:        "group",
 						ignoreCase: true,
 						want:       "\"GROUP\"i",
 					},
 					&notExpr{
-						pos: position{line: 2400, col: 33, offset: 73832},
+						pos: position{line: 2585, col: 33, offset: 79007},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2400, col: 34, offset: 73833},
+							pos:  position{line: 2585, col: 34, offset: 79008},
 							name: "IdentifierRest",
 						},
 					},
@@ -17568,20 +18772,20 @@ var g = &grammar{
 		},
 		{
 			name: "HAVING",
-			pos:  position{line: 2401, col: 1, offset: 73848},
+			pos:  position{line: 2586, col: 1, offset: 79023},
 			expr: &seqExpr{
-				pos: position{line: 2401, col: 14, offset: 73861},
+				pos: position{line: 2586, col: 14, offset: 79036},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2401, col: 14, offset: 73861},
+						pos:        position{line: 2586, col: 14, offset: 79036},
 						val:        "having",
 						ignoreCase: true,
 						want:       "\"HAVING\"i",
 					},
 					&notExpr{
-						pos: position{line: 2401, col: 33, offset: 73880},
+						pos: position{line: 2586, col: 33, offset: 79055},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2401, col: 34, offset: 73881},
+							pos:  position{line: 2586, col: 34, offset: 79056},
 							name: "IdentifierRest",
 						},
 					},
@@ -17592,20 +18796,20 @@ var g = &grammar{
 		},
 		{
 			name: "HEAD",
-			pos:  position{line: 2402, col: 1, offset: 73896},
+			pos:  position{line: 2587, col: 1, offset: 79071},
 			expr: &seqExpr{
-				pos: position{line: 2402, col: 14, offset: 73909},
+				pos: position{line: 2587, col: 14, offset: 79084},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2402, col: 14, offset: 73909},
+						pos:        position{line: 2587, col: 14, offset: 79084},
 						val:        "head",
 						ignoreCase: true,
 						want:       "\"HEAD\"i",
 					},
 					&notExpr{
-						pos: position{line: 2402, col: 33, offset: 73928},
+						pos: position{line: 2587, col: 33, offset: 79103},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2402, col: 34, offset: 73929},
+							pos:  position{line: 2587, col: 34, offset: 79104},
 							name: "IdentifierRest",
 						},
 					},
@@ -17616,20 +18820,20 @@ var g = &grammar{
 		},
 		{
 			name: "HEADERS",
-			pos:  position{line: 2403, col: 1, offset: 73945},
+			pos:  position{line: 2588, col: 1, offset: 79120},
 			expr: &seqExpr{
-				pos: position{line: 2403, col: 14, offset: 73958},
+				pos: position{line: 2588, col: 14, offset: 79133},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2403, col: 14, offset: 73958},
+						pos:        position{line: 2588, col: 14, offset: 79133},
 						val:        "headers",
 						ignoreCase: true,
 						want:       "\"HEADERS\"i",
 					},
 					&notExpr{
-						pos: position{line: 2403, col: 33, offset: 73977},
+						pos: position{line: 2588, col: 33, offset: 79152},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2403, col: 34, offset: 73978},
+							pos:  position{line: 2588, col: 34, offset: 79153},
 							name: "IdentifierRest",
 						},
 					},
@@ -17640,20 +18844,44 @@ var g = &grammar{
 		},
 		{
 			name: "IN",
-			pos:  position{line: 2404, col: 1, offset: 73993},
+			pos:  position{line: 2589, col: 1, offset: 79168},
 			expr: &seqExpr{
-				pos: position{line: 2404, col: 14, offset: 74006},
+				pos: position{line: 2589, col: 14, offset: 79181},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2404, col: 14, offset: 74006},
+						pos:        position{line: 2589, col: 14, offset: 79181},
 						val:        "in",
 						ignoreCase: true,
 						want:       "\"IN\"i",
 					},
 					&notExpr{
-						pos: position{line: 2404, col: 33, offset: 74025},
+						pos: position{line: 2589, col: 33, offset: 79200},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2589, col: 34, offset: 79201},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "INTO",
+			pos:  position{line: 2590, col: 1, offset: 79216},
+			expr: &seqExpr{
+				pos: position{line: 2590, col: 14, offset: 79229},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2590, col: 14, offset: 79229},
+						val:        "into",
+						ignoreCase: true,
+						want:       "\"INTO\"i",
+					},
+					&notExpr{
+						pos: position{line: 2590, col: 33, offset: 79248},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2404, col: 34, offset: 74026},
+							pos:  position{line: 2590, col: 34, offset: 79249},
 							name: "IdentifierRest",
 						},
 					},
@@ -17664,20 +18892,20 @@ var g = &grammar{
 		},
 		{
 			name: "INNER",
-			pos:  position{line: 2405, col: 1, offset: 74041},
+			pos:  position{line: 2591, col: 1, offset: 79264},
 			expr: &seqExpr{
-				pos: position{line: 2405, col: 14, offset: 74054},
+				pos: position{line: 2591, col: 14, offset: 79277},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2405, col: 14, offset: 74054},
+						pos:        position{line: 2591, col: 14, offset: 79277},
 						val:        "inner",
 						ignoreCase: true,
 						want:       "\"INNER\"i",
 					},
 					&notExpr{
-						pos: position{line: 2405, col: 33, offset: 74073},
+						pos: position{line: 2591, col: 33, offset: 79296},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2405, col: 34, offset: 74074},
+							pos:  position{line: 2591, col: 34, offset: 79297},
 							name: "IdentifierRest",
 						},
 					},
@@ -17688,20 +18916,20 @@ var g = &grammar{
 		},
 		{
 			name: "IS",
-			pos:  position{line: 2406, col: 1, offset: 74089},
+			pos:  position{line: 2592, col: 1, offset: 79312},
 			expr: &seqExpr{
-				pos: position{line: 2406, col: 14, offset: 74102},
+				pos: position{line: 2592, col: 14, offset: 79325},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2406, col: 14, offset: 74102},
+						pos:        position{line: 2592, col: 14, offset: 79325},
 						val:        "is",
 						ignoreCase: true,
 						want:       "\"IS\"i",
 					},
 					&notExpr{
-						pos: position{line: 2406, col: 33, offset: 74121},
+						pos: position{line: 2592, col: 33, offset: 79344},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2406, col: 34, offset: 74122},
+							pos:  position{line: 2592, col: 34, offset: 79345},
 							name: "IdentifierRest",
 						},
 					},
@@ -17712,20 +18940,20 @@ var g = &grammar{
 		},
 		{
 			name: "JOIN",
-			pos:  position{line: 2407, col: 1, offset: 74137},
+			pos:  position{line: 2593, col: 1, offset: 79360},
 			expr: &seqExpr{
-				pos: position{line: 2407, col: 14, offset: 74150},
+				pos: position{line: 2593, col: 14, offset: 79373},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2407, col: 14, offset: 74150},
+						pos:        position{line: 2593, col: 14, offset: 79373},
 						val:        "join",
 						ignoreCase: true,
 						want:       "\"JOIN\"i",
 					},
 					&notExpr{
-						pos: position{line: 2407, col: 33, offset: 74169},
+						pos: position{line: 2593, col: 33, offset: 79392},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2407, col: 34, offset: 74170},
+							pos:  position{line: 2593, col: 34, offset: 79393},
 							name: "IdentifierRest",
 						},
 					},
@@ -17736,20 +18964,20 @@ var g = &grammar{
 		},
 		{
 			name: "LAST",
-			pos:  position{line: 2408, col: 1, offset: 74185},
+			pos:  position{line: 2594, col: 1, offset: 79408},
 			expr: &seqExpr{
-				pos: position{line: 2408, col: 14, offset: 74198},
+				pos: position{line: 2594, col: 14, offset: 79421},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2408, col: 14, offset: 74198},
+						pos:        position{line: 2594, col: 14, offset: 79421},
 						val:        "last",
 						ignoreCase: true,
 						want:       "\"LAST\"i",
 					},
 					&notExpr{
-						pos: position{line: 2408, col: 33, offset: 74217},
+						pos: position{line: 2594, col: 33, offset: 79440},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2408, col: 34, offset: 74218},
+							pos:  position{line: 2594, col: 34, offset: 79441},
 							name: "IdentifierRest",
 						},
 					},
@@ -17760,20 +18988,20 @@ var g = &grammar{
 		},
 		{
 			name: "LEFT",
-			pos:  position{line: 2409, col: 1, offset: 74233},
+			pos:  position{line: 2595, col: 1, offset: 79456},
 			expr: &seqExpr{
-				pos: position{line: 2409, col: 14, offset: 74246},
+				pos: position{line: 2595, col: 14, offset: 79469},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2409, col: 14, offset: 74246},
+						pos:        position{line: 2595, col: 14, offset: 79469},
 						val:        "left",
 						ignoreCase: true,
 						want:       "\"LEFT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2409, col: 33, offset: 74265},
+						pos: position{line: 2595, col: 33, offset: 79488},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2409, col: 34, offset: 74266},
+							pos:  position{line: 2595, col: 34, offset: 79489},
 							name: "IdentifierRest",
 						},
 					},
@@ -17784,20 +19012,20 @@ var g = &grammar{
 		},
 		{
 			name: "LIKE",
-			pos:  position{line: 2410, col: 1, offset: 74281},
+			pos:  position{line: 2596, col: 1, offset: 79504},
 			expr: &seqExpr{
-				pos: position{line: 2410, col: 14, offset: 74294},
+				pos: position{line: 2596, col: 14, offset: 79517},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2410, col: 14, offset: 74294},
+						pos:        position{line: 2596, col: 14, offset: 79517},
 						val:        "like",
 						ignoreCase: true,
 						want:       "\"LIKE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2410, col: 32, offset: 74312},
+						pos: position{line: 2596, col: 32, offset: 79535},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2410, col: 33, offset: 74313},
+							pos:  position{line: 2596, col: 33, offset: 79536},
 							name: "IdentifierRest",
 						},
 					},
@@ -17808,20 +19036,20 @@ var g = &grammar{
 		},
 		{
 			name: "LIMIT",
-			pos:  position{line: 2411, col: 1, offset: 74328},
+			pos:  position{line: 2597, col: 1, offset: 79551},
 			expr: &seqExpr{
-				pos: position{line: 2411, col: 14, offset: 74341},
+				pos: position{line: 2597, col: 14, offset: 79564},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2411, col: 14, offset: 74341},
+						pos:        position{line: 2597, col: 14, offset: 79564},
 						val:        "limit",
 						ignoreCase: true,
 						want:       "\"LIMIT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2411, col: 33, offset: 74360},
+						pos: position{line: 2597, col: 33, offset: 79583},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2411, col: 34, offset: 74361},
+							pos:  position{line: 2597, col: 34, offset: 79584},
 							name: "IdentifierRest",
 						},
 					},
@@ -17832,20 +19060,44 @@ var g = &grammar{
 		},
 		{
 			name: "LOAD",
-			pos:  position{line: 2412, col: 1, offset: 74376},
+			pos:  position{line: 2598, col: 1, offset: 79599},
 			expr: &seqExpr{
-				pos: position{line: 2412, col: 14, offset: 74389},
+				pos: position{line: 2598, col: 14, offset: 79612},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2412, col: 14, offset: 74389},
+						pos:        position{line: 2598, col: 14, offset: 79612},
 						val:        "load",
 						ignoreCase: true,
 						want:       "\"LOAD\"i",
 					},
 					&notExpr{
-						pos: position{line: 2412, col: 33, offset: 74408},
+						pos: position{line: 2598, col: 33, offset: 79631},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2598, col: 34, offset: 79632},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "LOOKUP",
+			pos:  position{line: 2599, col: 1, offset: 79647},
+			expr: &seqExpr{
+				pos: position{line: 2599, col: 14, offset: 79660},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2599, col: 14, offset: 79660},
+						val:        "lookup",
+						ignoreCase: true,
+						want:       "\"LOOKUP\"i",
+					},
+					&notExpr{
+						pos: position{line: 2599, col: 33, offset: 79679},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2412, col: 34, offset: 74409},
+							pos:  position{line: 2599, col: 34, offset: 79680},
 							name: "IdentifierRest",
 						},
 					},
@@ -17856,20 +19108,20 @@ var g = &grammar{
 		},
 		{
 			name: "MATERIALIZED",
-			pos:  position{line: 2413, col: 1, offset: 74424},
+			pos:  position{line: 2600, col: 1, offset: 79695},
 			expr: &seqExpr{
-				pos: position{line: 2413, col: 16, offset: 74439},
+				pos: position{line: 2600, col: 16, offset: 79710},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2413, col: 16, offset: 74439},
+						pos:        position{line: 2600, col: 16, offset: 79710},
 						val:        "materialized",
 						ignoreCase: true,
 						want:       "\"MATERIALIZED\"i",
 					},
 					&notExpr{
-						pos: position{line: 2413, col: 33, offset: 74456},
+						pos: position{line: 2600, col: 33, offset: 79727},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2413, col: 34, offset: 74457},
+							pos:  position{line: 2600, col: 34, offset: 79728},
 							name: "IdentifierRest",
 						},
 					},
@@ -17880,20 +19132,20 @@ var g = &grammar{
 		},
 		{
 			name: "MERGE",
-			pos:  position{line: 2414, col: 1, offset: 74472},
+			pos:  position{line: 2601, col: 1, offset: 79743},
 			expr: &seqExpr{
-				pos: position{line: 2414, col: 14, offset: 74485},
+				pos: position{line: 2601, col: 14, offset: 79756},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2414, col: 14, offset: 74485},
+						pos:        position{line: 2601, col: 14, offset: 79756},
 						val:        "merge",
 						ignoreCase: true,
 						want:       "\"MERGE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2414, col: 33, offset: 74504},
+						pos: position{line: 2601, col: 33, offset: 79775},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2414, col: 34, offset: 74505},
+							pos:  position{line: 2601, col: 34, offset: 79776},
 							name: "IdentifierRest",
 						},
 					},
@@ -17904,20 +19156,20 @@ var g = &grammar{
 		},
 		{
 			name: "MESSAGE",
-			pos:  position{line: 2415, col: 1, offset: 74520},
+			pos:  position{line: 2602, col: 1, offset: 79791},
 			expr: &seqExpr{
-				pos: position{line: 2415, col: 14, offset: 74533},
+				pos: position{line: 2602, col: 14, offset: 79804},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2415, col: 14, offset: 74533},
+						pos:        position{line: 2602, col: 14, offset: 79804},
 						val:        "message",
 						ignoreCase: true,
 						want:       "\"MESSAGE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2415, col: 33, offset: 74552},
+						pos: position{line: 2602, col: 33, offset: 79823},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2415, col: 34, offset: 74553},
+							pos:  position{line: 2602, col: 34, offset: 79824},
 							name: "IdentifierRest",
 						},
 					},
@@ -17928,20 +19180,20 @@ var g = &grammar{
 		},
 		{
 			name: "META",
-			pos:  position{line: 2416, col: 1, offset: 74568},
+			pos:  position{line: 2603, col: 1, offset: 79839},
 			expr: &seqExpr{
-				pos: position{line: 2416, col: 14, offset: 74581},
+				pos: position{line: 2603, col: 14, offset: 79852},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2416, col: 14, offset: 74581},
+						pos:        position{line: 2603, col: 14, offset: 79852},
 						val:        "meta",
 						ignoreCase: true,
 						want:       "\"META\"i",
 					},
 					&notExpr{
-						pos: position{line: 2416, col: 33, offset: 74600},
+						pos: position{line: 2603, col: 33, offset: 79871},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2416, col: 34, offset: 74601},
+							pos:  position{line: 2603, col: 34, offset: 79872},
 							name: "IdentifierRest",
 						},
 					},
@@ -17952,20 +19204,20 @@ var g = &grammar{
 		},
 		{
 			name: "METHOD",
-			pos:  position{line: 2417, col: 1, offset: 74616},
+			pos:  position{line: 2604, col: 1, offset: 79887},
 			expr: &seqExpr{
-				pos: position{line: 2417, col: 14, offset: 74629},
+				pos: position{line: 2604, col: 14, offset: 79900},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2417, col: 14, offset: 74629},
+						pos:        position{line: 2604, col: 14, offset: 79900},
 						val:        "method",
 						ignoreCase: true,
 						want:       "\"METHOD\"i",
 					},
 					&notExpr{
-						pos: position{line: 2417, col: 33, offset: 74648},
+						pos: position{line: 2604, col: 33, offset: 79919},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2417, col: 34, offset: 74649},
+							pos:  position{line: 2604, col: 34, offset: 79920},
 							name: "IdentifierRest",
 						},
 					},
@@ -17976,20 +19228,20 @@ var g = &grammar{
 		},
 		{
 			name: "NOT",
-			pos:  position{line: 2418, col: 1, offset: 74664},
+			pos:  position{line: 2605, col: 1, offset: 79935},
 			expr: &seqExpr{
-				pos: position{line: 2418, col: 14, offset: 74677},
+				pos: position{line: 2605, col: 14, offset: 79948},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2418, col: 14, offset: 74677},
+						pos:        position{line: 2605, col: 14, offset: 79948},
 						val:        "not",
 						ignoreCase: true,
 						want:       "\"NOT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2418, col: 33, offset: 74696},
+						pos: position{line: 2605, col: 33, offset: 79967},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2418, col: 34, offset: 74697},
+							pos:  position{line: 2605, col: 34, offset: 79968},
 							name: "IdentifierRest",
 						},
 					},
@@ -18000,20 +19252,20 @@ var g = &grammar{
 		},
 		{
 			name: "NULL",
-			pos:  position{line: 2419, col: 1, offset: 74712},
+			pos:  position{line: 2606, col: 1, offset: 79983},
 			expr: &seqExpr{
-				pos: position{line: 2419, col: 14, offset: 74725},
+				pos: position{line: 2606, col: 14, offset: 79996},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2419, col: 14, offset: 74725},
+						pos:        position{line: 2606, col: 14, offset: 79996},
 						val:        "null",
 						ignoreCase: true,
 						want:       "\"NULL\"i",
 					},
 					&notExpr{
-						pos: position{line: 2419, col: 33, offset: 74744},
+						pos: position{line: 2606, col: 33, offset: 80015},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2419, col: 34, offset: 74745},
+							pos:  position{line: 2606, col: 34, offset: 80016},
 							name: "IdentifierRest",
 						},
 					},
@@ -18024,20 +19276,20 @@ var g = &grammar{
 		},
 		{
 			name: "NULLS",
-			pos:  position{line: 2420, col: 1, offset: 74760},
+			pos:  position{line: 2607, col: 1, offset: 80031},
 			expr: &seqExpr{
-				pos: position{line: 2420, col: 14, offset: 74773},
+				pos: position{line: 2607, col: 14, offset: 80044},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2420, col: 14, offset: 74773},
+						pos:        position{line: 2607, col: 14, offset: 80044},
 						val:        "nulls",
 						ignoreCase: true,
 						want:       "\"NULLS\"i",
 					},
 					&notExpr{
-						pos: position{line: 2420, col: 33, offset: 74792},
+						pos: position{line: 2607, col: 33, offset: 80063},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2420, col: 34, offset: 74793},
+							pos:  position{line: 2607, col: 34, offset: 80064},
 							name: "IdentifierRest",
 						},
 					},
@@ -18048,20 +19300,20 @@ var g = &grammar{
 		},
 		{
 			name: "OFFSET",
-			pos:  position{line: 2421, col: 1, offset: 74808},
+			pos:  position{line: 2608, col: 1, offset: 80079},
 			expr: &seqExpr{
-				pos: position{line: 2421, col: 14, offset: 74821},
+				pos: position{line: 2608, col: 14, offset: 80092},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2421, col: 14, offset: 74821},
+						pos:        position{line: 2608, col: 14, offset: 80092},
 						val:        "offset",
 						ignoreCase: true,
 						want:       "\"OFFSET\"i",
 					},
 					&notExpr{
-						pos: position{line: 2421, col: 33, offset: 74840},
+						pos: position{line: 2608, col: 33, offset: 80111},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2421, col: 34, offset: 74841},
+							pos:  position{line: 2608, col: 34, offset: 80112},
 							name: "IdentifierRest",
 						},
 					},
@@ -18072,20 +19324,20 @@ var g = &grammar{
 		},
 		{
 			name: "ON",
-			pos:  position{line: 2422, col: 1, offset: 74856},
+			pos:  position{line: 2609, col: 1, offset: 80127},
 			expr: &seqExpr{
-				pos: position{line: 2422, col: 14, offset: 74869},
+				pos: position{line: 2609, col: 14, offset: 80140},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2422, col: 14, offset: 74869},
+						pos:        position{line: 2609, col: 14, offset: 80140},
 						val:        "on",
 						ignoreCase: true,
 						want:       "\"ON\"i",
 					},
 					&notExpr{
-						pos: position{line: 2422, col: 33, offset: 74888},
+						pos: position{line: 2609, col: 33, offset: 80159},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2422, col: 34, offset: 74889},
+							pos:  position{line: 2609, col: 34, offset: 80160},
 							name: "IdentifierRest",
 						},
 					},
@@ -18096,20 +19348,20 @@ var g = &grammar{
 		},
 		{
 			name: "OP",
-			pos:  position{line: 2423, col: 1, offset: 74904},
+			pos:  position{line: 2610, col: 1, offset: 80175},
 			expr: &seqExpr{
-				pos: position{line: 2423, col: 14, offset: 74917},
+				pos: position{line: 2610, col: 14, offset: 80188},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2423, col: 14, offset: 74917},
+						pos:        position{line: 2610, col: 14, offset: 80188},
 						val:        "op",
 						ignoreCase: true,
 						want:       "\"OP\"i",
 					},
 					&notExpr{
-						pos: position{line: 2423, col: 33, offset: 74936},
+						pos: position{line: 2610, col: 33, offset: 80207},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2423, col: 34, offset: 74937},
+							pos:  position{line: 2610, col: 34, offset: 80208},
 							name: "IdentifierRest",
 						},
 					},
@@ -18120,23 +19372,23 @@ var g = &grammar{
 		},
 		{
 			name: "OR",
-			pos:  position{line: 2424, col: 1, offset: 74952},
+			pos:  position{line: 2611, col: 1, offset: 80223},
 			expr: &actionExpr{
-				pos: position{line: 2424, col: 14, offset: 74965},
+				pos: position{line: 2611, col: 14, offset: 80236},
 				run: (*parser).callonOR1,
 				expr: &seqExpr{
-					pos: position{line: 2424, col: 14, offset: 74965},
+					pos: position{line: 2611, col: 14, offset: 80236},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 2424, col: 14, offset: 74965},
+							pos:        position{line: 2611, col: 14, offset: 80236},
 							val:        "or",
 							ignoreCase: true,
 							want:       "\"OR\"i",
 						},
 						&notExpr{
-							pos: position{line: 2424, col: 33, offset: 74984},
+							pos: position{line: 2611, col: 33, offset: 80255},
 							expr: &ruleRefExpr{
-								pos:  position{line: 2424, col: 34, offset: 74985},
+								pos:  position{line: 2611, col: 34, offset: 80256},
 								name: "IdentifierRest",
 							},
 						},
@@ -18148,20 +19400,20 @@ var g = &grammar{
 		},
 		{
 			name: "ORDER",
-			pos:  position{line: 2425, col: 1, offset: 75021},
+			pos:  position{line: 2612, col: 1, offset: 80292},
 			expr: &seqExpr{
-				pos: position{line: 2425, col: 14, offset: 75034},
+				pos: position{line: 2612, col: 14, offset: 80305},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2425, col: 14, offset: 75034},
+						pos:        position{line: 2612, col: 14, offset: 80305},
 						val:        "order",
 						ignoreCase: true,
 						want:       "\"ORDER\"i",
 					},
 					&notExpr{
-						pos: position{line: 2425, col: 33, offset: 75053},
+						pos: position{line: 2612, col: 33, offset: 80324},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2425, col: 34, offset: 75054},
+							pos:  position{line: 2612, col: 34, offset: 80325},
 							name: "IdentifierRest",
 						},
 					},
@@ -18172,20 +19424,20 @@ var g = &grammar{
 		},
 		{
 			name: "ORDINALITY",
-			pos:  position{line: 2426, col: 1, offset: 75069},
+			pos:  position{line: 2613, col: 1, offset: 80340},
 			expr: &seqExpr{
-				pos: position{line: 2426, col: 14, offset: 75082},
+				pos: position{line: 2613, col: 14, offset: 80353},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2426, col: 14, offset: 75082},
+						pos:        position{line: 2613, col: 14, offset: 80353},
 						val:        "ordinality",
 						ignoreCase: true,
 						want:       "\"ORDINALITY\"i",
 					},
 					&notExpr{
-						pos: position{line: 2426, col: 33, offset: 75101},
+						pos: position{line: 2613, col: 33, offset: 80372},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2426, col: 34, offset: 75102},
+							pos:  position{line: 2613, col: 34, offset: 80373},
 							name: "IdentifierRest",
 						},
 					},
@@ -18196,20 +19448,20 @@ var g = &grammar{
 		},
 		{
 			name: "OUTER",
-			pos:  position{line: 2427, col: 1, offset: 75117},
+			pos:  position{line: 2614, col: 1, offset: 80388},
 			expr: &seqExpr{
-				pos: position{line: 2427, col: 14, offset: 75130},
+				pos: position{line: 2614, col: 14, offset: 80401},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2427, col: 14, offset: 75130},
+						pos:        position{line: 2614, col: 14, offset: 80401},
 						val:        "outer",
 						ignoreCase: true,
 						want:       "\"OUTER\"i",
 					},
 					&notExpr{
-						pos: position{line: 2427, col: 33, offset: 75149},
+						pos: position{line: 2614, col: 33, offset: 80420},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2427, col: 34, offset: 75150},
+							pos:  position{line: 2614, col: 34, offset: 80421},
 							name: "IdentifierRest",
 						},
 					},
@@ -18220,20 +19472,20 @@ var g = &grammar{
 		},
 		{
 			name: "OUTPUT",
-			pos:  position{line: 2428, col: 1, offset: 75165},
+			pos:  position{line: 2615, col: 1, offset: 80436},
 			expr: &seqExpr{
-				pos: position{line: 2428, col: 14, offset: 75178},
+				pos: position{line: 2615, col: 14, offset: 80449},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2428, col: 14, offset: 75178},
+						pos:        position{line: 2615, col: 14, offset: 80449},
 						val:        "output",
 						ignoreCase: true,
 						want:       "\"OUTPUT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2428, col: 33, offset: 75197},
+						pos: position{line: 2615, col: 33, offset: 80468},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2428, col: 34, offset: 75198},
+							pos:  position{line: 2615, col: 34, offset: 80469},
 							name: "IdentifierRest",
 						},
 					},
@@ -18244,20 +19496,20 @@ var g = &grammar{
 		},
 		{
 			name: "OVER",
-			pos:  position{line: 2429, col: 1, offset: 75213},
+			pos:  position{line: 2616, col: 1, offset: 80484},
 			expr: &seqExpr{
-				pos: position{line: 2429, col: 14, offset: 75226},
+				pos: position{line: 2616, col: 14, offset: 80497},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2429, col: 14, offset: 75226},
+						pos:        position{line: 2616, col: 14, offset: 80497},
 						val:        "over",
 						ignoreCase: true,
 						want:       "\"OVER\"i",
 					},
 					&notExpr{
-						pos: position{line: 2429, col: 33, offset: 75245},
+						pos: position{line: 2616, col: 33, offset: 80516},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2429, col: 34, offset: 75246},
+							pos:  position{line: 2616, col: 34, offset: 80517},
 							name: "IdentifierRest",
 						},
 					},
@@ -18268,20 +19520,20 @@ var g = &grammar{
 		},
 		{
 			name: "PASS",
-			pos:  position{line: 2430, col: 1, offset: 75261},
+			pos:  position{line: 2617, col: 1, offset: 80532},
 			expr: &seqExpr{
-				pos: position{line: 2430, col: 14, offset: 75274},
+				pos: position{line: 2617, col: 14, offset: 80545},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2430, col: 14, offset: 75274},
+						pos:        position{line: 2617, col: 14, offset: 80545},
 						val:        "pass",
 						ignoreCase: true,
 						want:       "\"PASS\"i",
 					},
 					&notExpr{
-						pos: position{line: 2430, col: 33, offset: 75293},
+						pos: position{line: 2617, col: 33, offset: 80564},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2430, col: 34, offset: 75294},
+							pos:  position{line: 2617, col: 34, offset: 80565},
 							name: "IdentifierRest",
 						},
 					},
@@ -18292,20 +19544,44 @@ var g = &grammar{
 		},
 		{
 			name: "PUT",
-			pos:  position{line: 2431, col: 1, offset: 75309},
+			pos:  position{line: 2618, col: 1, offset: 80580},
 			expr: &seqExpr{
-				pos: position{line: 2431, col: 14, offset: 75322},
+				pos: position{line: 2618, col: 14, offset: 80593},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2431, col: 14, offset: 75322},
+						pos:        position{line: 2618, col: 14, offset: 80593},
 						val:        "put",
 						ignoreCase: true,
 						want:       "\"PUT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2431, col: 33, offset: 75341},
+						pos: position{line: 2618, col: 33, offset: 80612},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2618, col: 34, offset: 80613},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "RANGE",
+			pos:  position{line: 2619, col: 1, offset: 80628},
+			expr: &seqExpr{
+				pos: position{line: 2619, col: 14, offset: 80641},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2619, col: 14, offset: 80641},
+						val:        "range",
+						ignoreCase: true,
+						want:       "\"RANGE\"i",
+					},
+					&notExpr{
+						pos: position{line: 2619, col: 33, offset: 80660},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2431, col: 34, offset: 75342},
+							pos:  position{line: 2619, col: 34, offset: 80661},
 							name: "IdentifierRest",
 						},
 					},
@@ -18316,20 +19592,20 @@ var g = &grammar{
 		},
 		{
 			name: "RECURSIVE",
-			pos:  position{line: 2432, col: 1, offset: 75357},
+			pos:  position{line: 2620, col: 1, offset: 80676},
 			expr: &seqExpr{
-				pos: position{line: 2432, col: 14, offset: 75370},
+				pos: position{line: 2620, col: 14, offset: 80689},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2432, col: 14, offset: 75370},
+						pos:        position{line: 2620, col: 14, offset: 80689},
 						val:        "RECURSIVE",
 						ignoreCase: false,
 						want:       "\"RECURSIVE\"",
 					},
 					&notExpr{
-						pos: position{line: 2432, col: 33, offset: 75389},
+						pos: position{line: 2620, col: 33, offset: 80708},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2432, col: 34, offset: 75390},
+							pos:  position{line: 2620, col: 34, offset: 80709},
 							name: "IdentifierRest",
 						},
 					},
@@ -18340,20 +19616,20 @@ var g = &grammar{
 		},
 		{
 			name: "REGEXP",
-			pos:  position{line: 2433, col: 1, offset: 75405},
+			pos:  position{line: 2621, col: 1, offset: 80724},
 			expr: &seqExpr{
-				pos: position{line: 2433, col: 14, offset: 75418},
+				pos: position{line: 2621, col: 14, offset: 80737},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2433, col: 14, offset: 75418},
+						pos:        position{line: 2621, col: 14, offset: 80737},
 						val:        "regexp",
 						ignoreCase: true,
 						want:       "\"REGEXP\"i",
 					},
 					&notExpr{
-						pos: position{line: 2433, col: 33, offset: 75437},
+						pos: position{line: 2621, col: 33, offset: 80756},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2433, col: 34, offset: 75438},
+							pos:  position{line: 2621, col: 34, offset: 80757},
 							name: "IdentifierRest",
 						},
 					},
@@ -18364,20 +19640,20 @@ var g = &grammar{
 		},
 		{
 			name: "REGEXP_REPLACE",
-			pos:  position{line: 2434, col: 1, offset: 75453},
+			pos:  position{line: 2622, col: 1, offset: 80772},
 			expr: &seqExpr{
-				pos: position{line: 2434, col: 18, offset: 75470},
+				pos: position{line: 2622, col: 18, offset: 80789},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2434, col: 18, offset: 75470},
+						pos:        position{line: 2622, col: 18, offset: 80789},
 						val:        "regexp_replace",
 						ignoreCase: true,
 						want:       "\"REGEXP_REPLACE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2434, col: 36, offset: 75488},
+						pos: position{line: 2622, col: 36, offset: 80807},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2434, col: 37, offset: 75489},
+							pos:  position{line: 2622, col: 37, offset: 80808},
 							name: "IdentifierRest",
 						},
 					},
@@ -18388,20 +19664,20 @@ var g = &grammar{
 		},
 		{
 			name: "RENAME",
-			pos:  position{line: 2435, col: 1, offset: 75504},
+			pos:  position{line: 2623, col: 1, offset: 80823},
 			expr: &seqExpr{
-				pos: position{line: 2435, col: 14, offset: 75517},
+				pos: position{line: 2623, col: 14, offset: 80836},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2435, col: 14, offset: 75517},
+						pos:        position{line: 2623, col: 14, offset: 80836},
 						val:        "rename",
 						ignoreCase: true,
 						want:       "\"RENAME\"i",
 					},
 					&notExpr{
-						pos: position{line: 2435, col: 33, offset: 75536},
+						pos: position{line: 2623, col: 33, offset: 80855},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2435, col: 34, offset: 75537},
+							pos:  position{line: 2623, col: 34, offset: 80856},
 							name: "IdentifierRest",
 						},
 					},
@@ -18412,20 +19688,20 @@ var g = &grammar{
 		},
 		{
 			name: "RIGHT",
-			pos:  position{line: 2436, col: 1, offset: 75552},
+			pos:  position{line: 2624, col: 1, offset: 80871},
 			expr: &seqExpr{
-				pos: position{line: 2436, col: 14, offset: 75565},
+				pos: position{line: 2624, col: 14, offset: 80884},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2436, col: 14, offset: 75565},
+						pos:        position{line: 2624, col: 14, offset: 80884},
 						val:        "right",
 						ignoreCase: true,
 						want:       "\"RIGHT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2436, col: 33, offset: 75584},
+						pos: position{line: 2624, col: 33, offset: 80903},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2436, col: 34, offset: 75585},
+							pos:  position{line: 2624, col: 34, offset: 80904},
 							name: "IdentifierRest",
 						},
 					},
@@ -18436,20 +19712,20 @@ var g = &grammar{
 		},
 		{
 			name: "SAMPLE",
-			pos:  position{line: 2437, col: 1, offset: 75600},
+			pos:  position{line: 2625, col: 1, offset: 80919},
 			expr: &seqExpr{
-				pos: position{line: 2437, col: 14, offset: 75613},
+				pos: position{line: 2625, col: 14, offset: 80932},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2437, col: 14, offset: 75613},
+						pos:        position{line: 2625, col: 14, offset: 80932},
 						val:        "sample",
 						ignoreCase: true,
 						want:       "\"SAMPLE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2437, col: 33, offset: 75632},
+						pos: position{line: 2625, col: 33, offset: 80951},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2437, col: 34, offset: 75633},
+							pos:  position{line: 2625, col: 34, offset: 80952},
 							name: "IdentifierRest",
 						},
 					},
@@ -18460,20 +19736,20 @@ var g = &grammar{
 		},
 		{
 			name: "SEARCH",
-			pos:  position{line: 2438, col: 1, offset: 75648},
+			pos:  position{line: 2626, col: 1, offset: 80967},
 			expr: &seqExpr{
-				pos: position{line: 2438, col: 14, offset: 75661},
+				pos: position{line: 2626, col: 14, offset: 80980},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2438, col: 14, offset: 75661},
+						pos:        position{line: 2626, col: 14, offset: 80980},
 						val:        "search",
 						ignoreCase: true,
 						want:       "\"SEARCH\"i",
 					},
 					&notExpr{
-						pos: position{line: 2438, col: 33, offset: 75680},
+						pos: position{line: 2626, col: 33, offset: 80999},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2438, col: 34, offset: 75681},
+							pos:  position{line: 2626, col: 34, offset: 81000},
 							name: "IdentifierRest",
 						},
 					},
@@ -18484,20 +19760,68 @@ var g = &grammar{
 		},
 		{
 			name: "SELECT",
-			pos:  position{line: 2439, col: 1, offset: 75696},
+			pos:  position{line: 2627, col: 1, offset: 81015},
 			expr: &seqExpr{
-				pos: position{line: 2439, col: 14, offset: 75709},
+				pos: position{line: 2627, col: 14, offset: 81028},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2439, col: 14, offset: 75709},
+						pos:        position{line: 2627, col: 14, offset: 81028},
 						val:        "select",
 						ignoreCase: true,
 						want:       "\"SELECT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2439, col: 33, offset: 75728},
+						pos: position{line: 2627, col: 33, offset: 81047},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2627, col: 34, offset: 81048},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "SEMI",
+			pos:  position{line: 2628, col: 1, offset: 81063},
+			expr: &seqExpr{
+				pos: position{line: 2628, col: 14, offset: 81076},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2628, col: 14, offset: 81076},
+						val:        "semi",
+						ignoreCase: true,
+						want:       "\"SEMI\"i",
+					},
+					&notExpr{
+						pos: position{line: 2628, col: 33, offset: 81095},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2628, col: 34, offset: 81096},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "SEQUENCE",
+			pos:  position{line: 2629, col: 1, offset: 81111},
+			expr: &seqExpr{
+				pos: position{line: 2629, col: 14, offset: 81124},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2629, col: 14, offset: 81124},
+						val:        "sequence",
+						ignoreCase: true,
+						want:       "\"SEQUENCE\"i",
+					},
+					&notExpr{
+						pos: position{line: 2629, col: 33, offset: 81143},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2439, col: 34, offset: 75729},
+							pos:  position{line: 2629, col: 34, offset: 81144},
 							name: "IdentifierRest",
 						},
 					},
@@ -18508,20 +19832,20 @@ var g = &grammar{
 		},
 		{
 			name: "SHAPE",
-			pos:  position{line: 2440, col: 1, offset: 75744},
+			pos:  position{line: 2630, col: 1, offset: 81159},
 			expr: &seqExpr{
-				pos: position{line: 2440, col: 14, offset: 75757},
+				pos: position{line: 2630, col: 14, offset: 81172},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2440, col: 14, offset: 75757},
+						pos:        position{line: 2630, col: 14, offset: 81172},
 						val:        "shape",
 						ignoreCase: true,
 						want:       "\"SHAPE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2440, col: 33, offset: 75776},
+						pos: position{line: 2630, col: 33, offset: 81191},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2440, col: 34, offset: 75777},
+							pos:  position{line: 2630, col: 34, offset: 81192},
 							name: "IdentifierRest",
 						},
 					},
@@ -18532,20 +19856,20 @@ var g = &grammar{
 		},
 		{
 			name: "SKIP",
-			pos:  position{line: 2441, col: 1, offset: 75792},
+			pos:  position{line: 2631, col: 1, offset: 81207},
 			expr: &seqExpr{
-				pos: position{line: 2441, col: 14, offset: 75805},
+				pos: position{line: 2631, col: 14, offset: 81220},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2441, col: 14, offset: 75805},
+						pos:        position{line: 2631, col: 14, offset: 81220},
 						val:        "skip",
 						ignoreCase: true,
 						want:       "\"SKIP\"i",
 					},
 					&notExpr{
-						pos: position{line: 2441, col: 33, offset: 75824},
+						pos: position{line: 2631, col: 33, offset: 81239},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2441, col: 34, offset: 75825},
+							pos:  position{line: 2631, col: 34, offset: 81240},
 							name: "IdentifierRest",
 						},
 					},
@@ -18556,20 +19880,20 @@ var g = &grammar{
 		},
 		{
 			name: "SORT",
-			pos:  position{line: 2442, col: 1, offset: 75840},
+			pos:  position{line: 2632, col: 1, offset: 81255},
 			expr: &seqExpr{
-				pos: position{line: 2442, col: 14, offset: 75853},
+				pos: position{line: 2632, col: 14, offset: 81268},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2442, col: 14, offset: 75853},
+						pos:        position{line: 2632, col: 14, offset: 81268},
 						val:        "sort",
 						ignoreCase: true,
 						want:       "\"SORT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2442, col: 33, offset: 75872},
+						pos: position{line: 2632, col: 33, offset: 81287},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2442, col: 34, offset: 75873},
+							pos:  position{line: 2632, col: 34, offset: 81288},
 							name: "IdentifierRest",
 						},
 					},
@@ -18580,20 +19904,20 @@ var g = &grammar{
 		},
 		{
 			name: "SUBSTRING",
-			pos:  position{line: 2443, col: 1, offset: 75888},
+			pos:  position{line: 2633, col: 1, offset: 81303},
 			expr: &seqExpr{
-				pos: position{line: 2443, col: 14, offset: 75901},
+				pos: position{line: 2633, col: 14, offset: 81316},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2443, col: 14, offset: 75901},
+						pos:        position{line: 2633, col: 14, offset: 81316},
 						val:        "substring",
 						ignoreCase: true,
 						want:       "\"SUBSTRING\"i",
 					},
 					&notExpr{
-						pos: position{line: 2443, col: 33, offset: 75920},
+						pos: position{line: 2633, col: 33, offset: 81335},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2443, col: 34, offset: 75921},
+							pos:  position{line: 2633, col: 34, offset: 81336},
 							name: "IdentifierRest",
 						},
 					},
@@ -18604,20 +19928,20 @@ var g = &grammar{
 		},
 		{
 			name: "SUMMARIZE",
-			pos:  position{line: 2444, col: 1, offset: 75936},
+			pos:  position{line: 2634, col: 1, offset: 81351},
 			expr: &seqExpr{
-				pos: position{line: 2444, col: 14, offset: 75949},
+				pos: position{line: 2634, col: 14, offset: 81364},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2444, col: 14, offset: 75949},
+						pos:        position{line: 2634, col: 14, offset: 81364},
 						val:        "summarize",
 						ignoreCase: true,
 						want:       "\"SUMMARIZE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2444, col: 33, offset: 75968},
+						pos: position{line: 2634, col: 33, offset: 81383},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2444, col: 34, offset: 75969},
+							pos:  position{line: 2634, col: 34, offset: 81384},
 							name: "IdentifierRest",
 						},
 					},
@@ -18628,20 +19952,20 @@ var g = &grammar{
 		},
 		{
 			name: "SWITCH",
-			pos:  position{line: 2445, col: 1, offset: 75984},
+			pos:  position{line: 2635, col: 1, offset: 81399},
 			expr: &seqExpr{
-				pos: position{line: 2445, col: 14, offset: 75997},
+				pos: position{line: 2635, col: 14, offset: 81412},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2445, col: 14, offset: 75997},
+						pos:        position{line: 2635, col: 14, offset: 81412},
 						val:        "switch",
 						ignoreCase: true,
 						want:       "\"SWITCH\"i",
 					},
 					&notExpr{
-						pos: position{line: 2445, col: 33, offset: 76016},
+						pos: position{line: 2635, col: 33, offset: 81431},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2445, col: 34, offset: 76017},
+							pos:  position{line: 2635, col: 34, offset: 81432},
 							name: "IdentifierRest",
 						},
 					},
@@ -18652,20 +19976,20 @@ var g = &grammar{
 		},
 		{
 			name: "TAIL",
-			pos:  position{line: 2446, col: 1, offset: 76032},
+			pos:  position{line: 2636, col: 1, offset: 81447},
 			expr: &seqExpr{
-				pos: position{line: 2446, col: 14, offset: 76045},
+				pos: position{line: 2636, col: 14, offset: 81460},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2446, col: 14, offset: 76045},
+						pos:        position{line: 2636, col: 14, offset: 81460},
 						val:        "tail",
 						ignoreCase: true,
 						want:       "\"TAIL\"i",
 					},
 					&notExpr{
-						pos: position{line: 2446, col: 33, offset: 76064},
+						pos: position{line: 2636, col: 33, offset: 81479},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2446, col: 34, offset: 76065},
+							pos:  position{line: 2636, col: 34, offset: 81480},
 							name: "IdentifierRest",
 						},
 					},
@@ -18676,20 +20000,68 @@ var g = &grammar{
 		},
 		{
 			name: "TAP",
-			pos:  position{line: 2447, col: 1, offset: 76081},
+			pos:  position{line: 2637, col: 1, offset: 81496},
 			expr: &seqExpr{
-				pos: position{line: 2447, col: 14, offset: 76094},
+				pos: position{line: 2637, col: 14, offset: 81509},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2447, col: 14, offset: 76094},
+						pos:        position{line: 2637, col: 14, offset: 81509},
 						val:        "tap",
 						ignoreCase: true,
 						want:       "\"TAP\"i",
 					},
 					&notExpr{
-						pos: position{line: 2447, col: 33, offset: 76113},
+						pos: position{line: 2637, col: 33, offset: 81528},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2637, col: 34, offset: 81529},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "TEMP",
+			pos:  position{line: 2638, col: 1, offset: 81544},
+			expr: &seqExpr{
+				pos: position{line: 2638, col: 14, offset: 81557},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2638, col: 14, offset: 81557},
+						val:        "temp",
+						ignoreCase: true,
+						want:       "\"TEMP\"i",
+					},
+					&notExpr{
+						pos: position{line: 2638, col: 33, offset: 81576},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2638, col: 34, offset: 81577},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "TO",
+			pos:  position{line: 2639, col: 1, offset: 81592},
+			expr: &seqExpr{
+				pos: position{line: 2639, col: 14, offset: 81605},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2639, col: 14, offset: 81605},
+						val:        "to",
+						ignoreCase: true,
+						want:       "\"TO\"i",
+					},
+					&notExpr{
+						pos: position{line: 2639, col: 33, offset: 81624},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2447, col: 34, offset: 76114},
+							pos:  position{line: 2639, col: 34, offset: 81625},
 							name: "IdentifierRest",
 						},
 					},
@@ -18700,20 +20072,20 @@ var g = &grammar{
 		},
 		{
 			name: "THEN",
-			pos:  position{line: 2448, col: 1, offset: 76129},
+			pos:  position{line: 2640, col: 1, offset: 81640},
 			expr: &seqExpr{
-				pos: position{line: 2448, col: 14, offset: 76142},
+				pos: position{line: 2640, col: 14, offset: 81653},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2448, col: 14, offset: 76142},
+						pos:        position{line: 2640, col: 14, offset: 81653},
 						val:        "then",
 						ignoreCase: true,
 						want:       "\"THEN\"i",
 					},
 					&notExpr{
-						pos: position{line: 2448, col: 33, offset: 76161},
+						pos: position{line: 2640, col: 33, offset: 81672},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2448, col: 34, offset: 76162},
+							pos:  position{line: 2640, col: 34, offset: 81673},
 							name: "IdentifierRest",
 						},
 					},
@@ -18724,23 +20096,23 @@ var g = &grammar{
 		},
 		{
 			name: "TIMESTAMP",
-			pos:  position{line: 2449, col: 1, offset: 76177},
+			pos:  position{line: 2641, col: 1, offset: 81688},
 			expr: &actionExpr{
-				pos: position{line: 2449, col: 14, offset: 76190},
+				pos: position{line: 2641, col: 14, offset: 81701},
 				run: (*parser).callonTIMESTAMP1,
 				expr: &seqExpr{
-					pos: position{line: 2449, col: 14, offset: 76190},
+					pos: position{line: 2641, col: 14, offset: 81701},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 2449, col: 14, offset: 76190},
+							pos:        position{line: 2641, col: 14, offset: 81701},
 							val:        "timestamp",
 							ignoreCase: true,
 							want:       "\"TIMESTAMP\"i",
 						},
 						&notExpr{
-							pos: position{line: 2449, col: 33, offset: 76209},
+							pos: position{line: 2641, col: 33, offset: 81720},
 							expr: &ruleRefExpr{
-								pos:  position{line: 2449, col: 34, offset: 76210},
+								pos:  position{line: 2641, col: 34, offset: 81721},
 								name: "IdentifierRest",
 							},
 						},
@@ -18752,20 +20124,20 @@ var g = &grammar{
 		},
 		{
 			name: "TOP",
-			pos:  position{line: 2450, col: 1, offset: 76253},
+			pos:  position{line: 2642, col: 1, offset: 81764},
 			expr: &seqExpr{
-				pos: position{line: 2450, col: 14, offset: 76266},
+				pos: position{line: 2642, col: 14, offset: 81777},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2450, col: 14, offset: 76266},
+						pos:        position{line: 2642, col: 14, offset: 81777},
 						val:        "top",
 						ignoreCase: true,
 						want:       "\"TOP\"i",
 					},
 					&notExpr{
-						pos: position{line: 2450, col: 33, offset: 76285},
+						pos: position{line: 2642, col: 33, offset: 81796},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2450, col: 34, offset: 76286},
+							pos:  position{line: 2642, col: 34, offset: 81797},
 							name: "IdentifierRest",
 						},
 					},
@@ -18776,20 +20148,20 @@ var g = &grammar{
 		},
 		{
 			name: "TRUE",
-			pos:  position{line: 2451, col: 1, offset: 76301},
+			pos:  position{line: 2643, col: 1, offset: 81812},
 			expr: &seqExpr{
-				pos: position{line: 2451, col: 14, offset: 76314},
+				pos: position{line: 2643, col: 14, offset: 81825},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2451, col: 14, offset: 76314},
+						pos:        position{line: 2643, col: 14, offset: 81825},
 						val:        "true",
 						ignoreCase: true,
 						want:       "\"TRUE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2451, col: 33, offset: 76333},
+						pos: position{line: 2643, col: 33, offset: 81844},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2451, col: 34, offset: 76334},
+							pos:  position{line: 2643, col: 34, offset: 81845},
 							name: "IdentifierRest",
 						},
 					},
@@ -18800,20 +20172,20 @@ var g = &grammar{
 		},
 		{
 			name: "TYPE",
-			pos:  position{line: 2452, col: 1, offset: 76349},
+			pos:  position{line: 2644, col: 1, offset: 81860},
 			expr: &seqExpr{
-				pos: position{line: 2452, col: 14, offset: 76362},
+				pos: position{line: 2644, col: 14, offset: 81873},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2452, col: 14, offset: 76362},
+						pos:        position{line: 2644, col: 14, offset: 81873},
 						val:        "type",
 						ignoreCase: true,
 						want:       "\"TYPE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2452, col: 33, offset: 76381},
+						pos: position{line: 2644, col: 33, offset: 81892},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2452, col: 34, offset: 76382},
+							pos:  position{line: 2644, col: 34, offset: 81893},
 							name: "IdentifierRest",
 						},
 					},
@@ -18824,20 +20196,20 @@ var g = &grammar{
 		},
 		{
 			name: "UNION",
-			pos:  position{line: 2453, col: 1, offset: 76397},
+			pos:  position{line: 2645, col: 1, offset: 81908},
 			expr: &seqExpr{
-				pos: position{line: 2453, col: 14, offset: 76410},
+				pos: position{line: 2645, col: 14, offset: 81921},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2453, col: 14, offset: 76410},
+						pos:        position{line: 2645, col: 14, offset: 81921},
 						val:        "union",
 						ignoreCase: true,
 						want:       "\"UNION\"i",
 					},
 					&notExpr{
-						pos: position{line: 2453, col: 33, offset: 76429},
+						pos: position{line: 2645, col: 33, offset: 81940},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2453, col: 34, offset: 76430},
+							pos:  position{line: 2645, col: 34, offset: 81941},
 							name: "IdentifierRest",
 						},
 					},
@@ -18848,20 +20220,20 @@ var g = &grammar{
 		},
 		{
 			name: "UNIQ",
-			pos:  position{line: 2454, col: 1, offset: 76445},
+			pos:  position{line: 2646, col: 1, offset: 81956},
 			expr: &seqExpr{
-				pos: position{line: 2454, col: 14, offset: 76458},
+				pos: position{line: 2646, col: 14, offset: 81969},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2454, col: 14, offset: 76458},
+						pos:        position{line: 2646, col: 14, offset: 81969},
 						val:        "uniq",
 						ignoreCase: true,
 						want:       "\"UNIQ\"i",
 					},
 					&notExpr{
-						pos: position{line: 2454, col: 33, offset: 76477},
+						pos: position{line: 2646, col: 33, offset: 81988},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2454, col: 34, offset: 76478},
+							pos:  position{line: 2646, col: 34, offset: 81989},
 							name: "IdentifierRest",
 						},
 					},
@@ -18872,20 +20244,20 @@ var g = &grammar{
 		},
 		{
 			name: "UNNEST",
-			pos:  position{line: 2455, col: 1, offset: 76494},
+			pos:  position{line: 2647, col: 1, offset: 82005},
 			expr: &seqExpr{
-				pos: position{line: 2455, col: 14, offset: 76507},
+				pos: position{line: 2647, col: 14, offset: 82018},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2455, col: 14, offset: 76507},
+						pos:        position{line: 2647, col: 14, offset: 82018},
 						val:        "unnest",
 						ignoreCase: true,
 						want:       "\"UNNEST\"i",
 					},
 					&notExpr{
-						pos: position{line: 2455, col: 33, offset: 76526},
+						pos: position{line: 2647, col: 33, offset: 82037},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2455, col: 34, offset: 76527},
+							pos:  position{line: 2647, col: 34, offset: 82038},
 							name: "IdentifierRest",
 						},
 					},
@@ -18896,20 +20268,20 @@ var g = &grammar{
 		},
 		{
 			name: "USING",
-			pos:  position{line: 2456, col: 1, offset: 76542},
+			pos:  position{line: 2648, col: 1, offset: 82053},
 			expr: &seqExpr{
-				pos: position{line: 2456, col: 14, offset: 76555},
+				pos: position{line: 2648, col: 14, offset: 82066},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2456, col: 14, offset: 76555},
+						pos:        position{line: 2648, col: 14, offset: 82066},
 						val:        "using",
 						ignoreCase: true,
 						want:       "\"USING\"i",
 					},
 					&notExpr{
-						pos: position{line: 2456, col: 33, offset: 76574},
+						pos: position{line: 2648, col: 33, offset: 82085},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2456, col: 34, offset: 76575},
+							pos:  position{line: 2648, col: 34, offset: 82086},
 							name: "IdentifierRest",
 						},
 					},
@@ -18920,20 +20292,20 @@ var g = &grammar{
 		},
 		{
 			name: "VALUE",
-			pos:  position{line: 2457, col: 1, offset: 76590},
+			pos:  position{line: 2649, col: 1, offset: 82101},
 			expr: &seqExpr{
-				pos: position{line: 2457, col: 14, offset: 76603},
+				pos: position{line: 2649, col: 14, offset: 82114},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2457, col: 14, offset: 76603},
+						pos:        position{line: 2649, col: 14, offset: 82114},
 						val:        "value",
 						ignoreCase: true,
 						want:       "\"VALUE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2457, col: 33, offset: 76622},
+						pos: position{line: 2649, col: 33, offset: 82133},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2457, col: 34, offset: 76623},
+							pos:  position{line: 2649, col: 34, offset: 82134},
 							name: "IdentifierRest",
 						},
 					},
@@ -18944,20 +20316,20 @@ var g = &grammar{
 		},
 		{
 			name: "VALUES",
-			pos:  position{line: 2458, col: 1, offset: 76638},
+			pos:  position{line: 2650, col: 1, offset: 82149},
 			expr: &seqExpr{
-				pos: position{line: 2458, col: 14, offset: 76651},
+				pos: position{line: 2650, col: 14, offset: 82162},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2458, col: 14, offset: 76651},
+						pos:        position{line: 2650, col: 14, offset: 82162},
 						val:        "values",
 						ignoreCase: true,
 						want:       "\"VALUES\"i",
 					},
 					&notExpr{
-						pos: position{line: 2458, col: 33, offset: 76670},
+						pos: position{line: 2650, col: 33, offset: 82181},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2458, col: 34, offset: 76671},
+							pos:  position{line: 2650, col: 34, offset: 82182},
 							name: "IdentifierRest",
 						},
 					},
@@ -18968,20 +20340,20 @@ var g = &grammar{
 		},
 		{
 			name: "WHEN",
-			pos:  position{line: 2459, col: 1, offset: 76686},
+			pos:  position{line: 2651, col: 1, offset: 82197},
 			expr: &seqExpr{
-				pos: position{line: 2459, col: 14, offset: 76699},
+				pos: position{line: 2651, col: 14, offset: 82210},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2459, col: 14, offset: 76699},
+						pos:        position{line: 2651, col: 14, offset: 82210},
 						val:        "when",
 						ignoreCase: true,
 						want:       "\"WHEN\"i",
 					},
 					&notExpr{
-						pos: position{line: 2459, col: 33, offset: 76718},
+						pos: position{line: 2651, col: 33, offset: 82229},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2459, col: 34, offset: 76719},
+							pos:  position{line: 2651, col: 34, offset: 82230},
 							name: "IdentifierRest",
 						},
 					},
@@ -18992,20 +20364,20 @@ var g = &grammar{
 		},
 		{
 			name: "WHERE",
-			pos:  position{line: 2460, col: 1, offset: 76734},
+			pos:  position{line: 2652, col: 1, offset: 82245},
 			expr: &seqExpr{
-				pos: position{line: 2460, col: 14, offset: 76747},
+				pos: position{line: 2652, col: 14, offset: 82258},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2460, col: 14, offset: 76747},
+						pos:        position{line: 2652, col: 14, offset: 82258},
 						val:        "where",
 						ignoreCase: true,
 						want:       "\"WHERE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2460, col: 33, offset: 76766},
+						pos: position{line: 2652, col: 33, offset: 82277},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2460, col: 34, offset: 76767},
+							pos:  position{line: 2652, col: 34, offset: 82278},
 							name: "IdentifierRest",
 						},
 					},
@@ -19016,20 +20388,44 @@ var g = &grammar{
 		},
 		{
 			name: "WITH",
-			pos:  position{line: 2461, col: 1, offset: 76782},
+			pos:  position{line: 2653, col: 1, offset: 82293},
 			expr: &seqExpr{
-				pos: position{line: 2461, col: 14, offset: 76795},
+				pos: position{line: 2653, col: 14, offset: 82306},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2461, col: 14, offset: 76795},
+						pos:        position{line: 2653, col: 14, offset: 82306},
 						val:        "with",
 						ignoreCase: true,
 						want:       "\"WITH\"i",
 					},
 					&notExpr{
-						pos: position{line: 2461, col: 33, offset: 76814},
+						pos: position{line: 2653, col: 33, offset: 82325},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2653, col: 34, offset: 82326},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "WITHIN",
+			pos:  position{line: 2654, col: 1, offset: 82341},
+			expr: &seqExpr{
+				pos: position{line: 2654, col: 14, offset: 82354},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2654, col: 14, offset: 82354},
+						val:        "within",
+						ignoreCase: true,
+						want:       "\"WITHIN\"i",
+					},
+					&notExpr{
+						pos: position{line: 2654, col: 33, offset: 82373},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2461, col: 34, offset: 76815},
+							pos:  position{line: 2654, col: 34, offset: 82374},
 							name: "IdentifierRest",
 						},
 					},
@@ -19040,20 +20436,20 @@ var g = &grammar{
 		},
 		{
 			name: "YIELD",
-			pos:  position{line: 2462, col: 1, offset: 76830},
+			pos:  position{line: 2655, col: 1, offset: 82389},
 			expr: &seqExpr{
-				pos: position{line: 2462, col: 14, offset: 76843},
+				pos: position{line: 2655, col: 14, offset: 82402},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2462, col: 14, offset: 76843},
+						pos:        position{line: 2655, col: 14, offset: 82402},
 						val:        "yield",
 						ignoreCase: true,
 						want:       "\"YIELD\"i",
 					},
 					&notExpr{
-						pos: position{line: 2462, col: 33, offset: 76862},
+						pos: position{line: 2655, col: 33, offset: 82421},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2462, col: 34, offset: 76863},
+							pos:  position{line: 2655, col: 34, offset: 82422},
 							name: "IdentifierRest",
 						},
 					},
@@ -19401,103 +20797,174 @@ func (p *parser) callonSearchPredicate12() (any, error) {
 	return p.cur.onSearchPredicate12(stack["f"])
 }
 
-func (c *current) onSearchValue3(v any) (any, error) {
-	return newPrimitive(c, "string", v.(string)), nil
-
+func (c *current) onSearchValue3(v any) (any, error) {
+	return newPrimitive(c, "string", v.(string)), nil
+
+}
+
+func (p *parser) callonSearchValue3() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSearchValue3(stack["v"])
+}
+
+func (c *current) onGlob1(pattern any) (any, error) {
+	return &ast.Glob{Kind: "Glob", Pattern: pattern.(string), Loc: loc(c)}, nil
+
+}
+
+func (p *parser) callonGlob1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGlob1(stack["pattern"])
+}
+
+func (c *current) onRegexp1(pattern any) (any, error) {
+	return &ast.Regexp{Kind: "Regexp", Pattern: pattern.(string), Loc: loc(c)}, nil
+
+}
+
+func (p *parser) callonRegexp1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRegexp1(stack["pattern"])
+}
+
+func (c *current) onAggregation2(keys, args any) (any, error) {
+	s := &ast.Aggregate{
+		Kind: "Aggregate",
+		Keys: sliceOf[ast.Assignment](keys),
+		Loc:  loc(c),
+	}
+	setAggregateArgs(s, args)
+	return s, nil
+
+}
+
+func (p *parser) callonAggregation2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAggregation2(stack["keys"], stack["args"])
+}
+
+func (c *current) onAggregation10(aggs, keys, args any) (any, error) {
+	s := &ast.Aggregate{
+		Kind: "Aggregate",
+		Aggs: sliceOf[ast.Assignment](aggs),
+		Loc:  loc(c),
+	}
+	if keys != nil {
+		s.Keys = sliceOf[ast.Assignment](keys.([]any)[1])
+	}
+	setAggregateArgs(s, args)
+	return s, nil
+
+}
+
+func (p *parser) callonAggregation10() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAggregation10(stack["aggs"], stack["keys"], stack["args"])
+}
+
+func (c *current) onAggregateKeys1(columns any) (any, error) {
+	return columns, nil
+}
+
+func (p *parser) callonAggregateKeys1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAggregateKeys1(stack["columns"])
+}
+
+func (c *current) onGroupKeys7(k any) (any, error) {
+	return k, nil
 }
 
-func (p *parser) callonSearchValue3() (any, error) {
+func (p *parser) callonGroupKeys7() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSearchValue3(stack["v"])
+	return p.cur.onGroupKeys7(stack["k"])
 }
 
-func (c *current) onGlob1(pattern any) (any, error) {
-	return &ast.Glob{Kind: "Glob", Pattern: pattern.(string), Loc: loc(c)}, nil
+func (c *current) onGroupKeys1(first, rest any) (any, error) {
+	return prepend(first, rest), nil
 
 }
 
-func (p *parser) callonGlob1() (any, error) {
+func (p *parser) callonGroupKeys1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onGlob1(stack["pattern"])
+	return p.cur.onGroupKeys1(stack["first"], stack["rest"])
 }
 
-func (c *current) onRegexp1(pattern any) (any, error) {
-	return &ast.Regexp{Kind: "Regexp", Pattern: pattern.(string), Loc: loc(c)}, nil
+func (c *current) onGroupKey1(a, collate any) (any, error) {
+	if collate == nil {
+		return a, nil
+	}
+	assign := a.(ast.Assignment)
+	name := collate.(*ast.ID)
+	arg := &ast.Primitive{Kind: "Primitive", Type: "string", Text: name.Name, Loc: name.Loc}
+	fn := &ast.ID{Kind: "ID", Name: "collate", Loc: name.Loc}
+	assign.RHS = newCall(c, fn, []any{assign.RHS, arg}, nil)
+	return assign, nil
 
 }
 
-func (p *parser) callonRegexp1() (any, error) {
+func (p *parser) callonGroupKey1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onRegexp1(stack["pattern"])
+	return p.cur.onGroupKey1(stack["a"], stack["collate"])
 }
 
-func (c *current) onAggregation2(keys, limit any) (any, error) {
-	return &ast.Aggregate{
-		Kind:  "Aggregate",
-		Keys:  sliceOf[ast.Assignment](keys),
-		Limit: limit.(int),
-		Loc:   loc(c),
-	}, nil
-
+func (c *current) onAggregateArgs8(a any) (any, error) {
+	return a, nil
 }
 
-func (p *parser) callonAggregation2() (any, error) {
+func (p *parser) callonAggregateArgs8() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAggregation2(stack["keys"], stack["limit"])
+	return p.cur.onAggregateArgs8(stack["a"])
 }
 
-func (c *current) onAggregation10(aggs, keys, limit any) (any, error) {
-	s := &ast.Aggregate{
-		Kind:  "Aggregate",
-		Aggs:  sliceOf[ast.Assignment](aggs),
-		Limit: limit.(int),
-		Loc:   loc(c),
-	}
-	if keys != nil {
-		s.Keys = sliceOf[ast.Assignment](keys.([]any)[1])
-	}
-	return s, nil
-
+func (c *current) onAggregateArgs2(args any) (any, error) {
+	return makeArgMap(args)
 }
 
-func (p *parser) callonAggregation10() (any, error) {
+func (p *parser) callonAggregateArgs2() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAggregation10(stack["aggs"], stack["keys"], stack["limit"])
+	return p.cur.onAggregateArgs2(stack["args"])
 }
 
-func (c *current) onAggregateKeys1(columns any) (any, error) {
-	return columns, nil
+func (c *current) onAggregateArgs13() (any, error) {
+	return map[string]any{}, nil
 }
 
-func (p *parser) callonAggregateKeys1() (any, error) {
+func (p *parser) callonAggregateArgs13() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAggregateKeys1(stack["columns"])
+	return p.cur.onAggregateArgs13()
 }
 
-func (c *current) onLimitArg2(limit any) (any, error) {
-	return limit, nil
+func (c *current) onAggregateArg2(limit any) (any, error) {
+	return map[string]any{"name": "limit", "value": limit}, nil
 }
 
-func (p *parser) callonLimitArg2() (any, error) {
+func (p *parser) callonAggregateArg2() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onLimitArg2(stack["limit"])
+	return p.cur.onAggregateArg2(stack["limit"])
 }
 
-func (c *current) onLimitArg11() (any, error) {
-	return 0, nil
+func (c *current) onAggregateArg8(d any) (any, error) {
+	return map[string]any{"name": "lateness", "value": d}, nil
 }
 
-func (p *parser) callonLimitArg11() (any, error) {
+func (p *parser) callonAggregateArg8() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onLimitArg11()
+	return p.cur.onAggregateArg8(stack["d"])
 }
 
 func (c *current) onFlexAssignment3(expr any) (any, error) {
@@ -19542,15 +21009,26 @@ func (p *parser) callonAggAssignment2() (any, error) {
 	return p.cur.onAggAssignment2(stack["lval"], stack["agg"])
 }
 
-func (c *current) onAggAssignment11(agg any) (any, error) {
+func (c *current) onAggAssignment13(lval, expr any) (any, error) {
+	return ast.Assignment{Kind: "Assignment", LHS: lval.(ast.Expr), RHS: expr.(ast.Expr), Loc: loc(c)}, nil
+
+}
+
+func (p *parser) callonAggAssignment13() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAggAssignment13(stack["lval"], stack["expr"])
+}
+
+func (c *current) onAggAssignment22(agg any) (any, error) {
 	return ast.Assignment{Kind: "Assignment", RHS: agg.(ast.Expr), Loc: loc(c)}, nil
 
 }
 
-func (p *parser) callonAggAssignment11() (any, error) {
+func (p *parser) callonAggAssignment22() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAggAssignment11(stack["agg"])
+	return p.cur.onAggAssignment22(stack["agg"])
 }
 
 func (c *current) onAgg2(aggDistinct, where any) (any, error) {
@@ -19569,11 +21047,22 @@ func (p *parser) callonAgg2() (any, error) {
 	return p.cur.onAgg2(stack["aggDistinct"], stack["where"])
 }
 
-func (c *current) onAgg15(name, expr, where any) (any, error) {
+func (c *current) onAgg31(e any) (any, error) {
+	return e, nil
+}
+
+func (p *parser) callonAgg31() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAgg31(stack["e"])
+}
+
+func (c *current) onAgg15(name, expr, params, where any) (any, error) {
 	agg := &ast.Agg{
-		Kind: "Agg",
-		Name: name.(string),
-		Loc:  loc(c),
+		Kind:   "Agg",
+		Name:   name.(string),
+		Params: sliceOf[ast.Expr](params),
+		Loc:    loc(c),
 	}
 	if expr != nil {
 		agg.Expr = expr.(ast.Expr)
@@ -19588,10 +21077,10 @@ func (c *current) onAgg15(name, expr, where any) (any, error) {
 func (p *parser) callonAgg15() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAgg15(stack["name"], stack["expr"], stack["where"])
+	return p.cur.onAgg15(stack["name"], stack["expr"], stack["params"], stack["where"])
 }
 
-func (c *current) onAgg38(cs any) (any, error) {
+func (c *current) onAgg47(cs any) (any, error) {
 	return &ast.Agg{
 		Kind: "Agg",
 		Name: "count",
@@ -19600,10 +21089,10 @@ func (c *current) onAgg38(cs any) (any, error) {
 
 }
 
-func (p *parser) callonAgg38() (any, error) {
+func (p *parser) callonAgg47() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAgg38(stack["cs"])
+	return p.cur.onAgg47(stack["cs"])
 }
 
 func (c *current) onAggDistinct1(name, expr any) (any, error) {
@@ -19982,7 +21471,7 @@ func (p *parser) callonCutOp1() (any, error) {
 	return p.cur.onCutOp1(stack["args"])
 }
 
-func (c *current) onDistinctOp1(e any) (any, error) {
+func (c *current) onDistinctOp2(e any) (any, error) {
 	return &ast.Distinct{
 		Kind: "Distinct",
 		Expr: e.(ast.Expr),
@@ -19991,10 +21480,24 @@ func (c *current) onDistinctOp1(e any) (any, error) {
 
 }
 
-func (p *parser) callonDistinctOp1() (any, error) {
+func (p *parser) callonDistinctOp2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDistinctOp2(stack["e"])
+}
+
+func (c *current) onDistinctOp10() (any, error) {
+	return &ast.Distinct{
+		Kind: "Distinct",
+		Loc:  loc(c),
+	}, nil
+
+}
+
+func (p *parser) callonDistinctOp10() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onDistinctOp1(stack["e"])
+	return p.cur.onDistinctOp10()
 }
 
 func (c *current) onDropOp1(args any) (any, error) {
@@ -20185,7 +21688,39 @@ func (p *parser) callonShapeOp1() (any, error) {
 	return p.cur.onShapeOp1()
 }
 
-func (c *current) onJoinOp1(style, rightInput, e, optArgs any) (any, error) {
+func (c *current) onJoinOp2(rightInput, optArgs any) (any, error) {
+	o := &ast.Join{
+		Kind:  "Join",
+		Style: "cross",
+		Loc:   loc(c),
+	}
+	if rightInput != nil {
+		o.RightInput = sliceOf[ast.Op](rightInput)
+	}
+	if optArgs != nil {
+		o.Args = sliceOf[ast.Assignment](optArgs.([]any)[1])
+	}
+	return o, nil
+
+}
+
+func (p *parser) callonJoinOp2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onJoinOp2(stack["rightInput"], stack["optArgs"])
+}
+
+func (c *current) onJoinOp25(t any) (any, error) {
+	return t, nil
+}
+
+func (p *parser) callonJoinOp25() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onJoinOp25(stack["t"])
+}
+
+func (c *current) onJoinOp14(style, rightInput, e, tolerance, optArgs any) (any, error) {
 	o := &ast.Join{
 		Kind:  "Join",
 		Style: style.(string),
@@ -20195,6 +21730,9 @@ func (c *current) onJoinOp1(style, rightInput, e, optArgs any) (any, error) {
 	if rightInput != nil {
 		o.RightInput = sliceOf[ast.Op](rightInput)
 	}
+	if tolerance != nil {
+		o.Tolerance = tolerance.(ast.Expr)
+	}
 	if optArgs != nil {
 		o.Args = sliceOf[ast.Assignment](optArgs.([]any)[1])
 	}
@@ -20202,10 +21740,10 @@ func (c *current) onJoinOp1(style, rightInput, e, optArgs any) (any, error) {
 
 }
 
-func (p *parser) callonJoinOp1() (any, error) {
+func (p *parser) callonJoinOp14() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onJoinOp1(stack["style"], stack["rightInput"], stack["e"], stack["optArgs"])
+	return p.cur.onJoinOp14(stack["style"], stack["rightInput"], stack["e"], stack["tolerance"], stack["optArgs"])
 }
 
 func (c *current) onJoinStyle2() (any, error) {
@@ -20219,7 +21757,7 @@ func (p *parser) callonJoinStyle2() (any, error) {
 }
 
 func (c *current) onJoinStyle6() (any, error) {
-	return "inner", nil
+	return "asof", nil
 }
 
 func (p *parser) callonJoinStyle6() (any, error) {
@@ -20229,7 +21767,7 @@ func (p *parser) callonJoinStyle6() (any, error) {
 }
 
 func (c *current) onJoinStyle10() (any, error) {
-	return "left", nil
+	return "inner", nil
 }
 
 func (p *parser) callonJoinStyle10() (any, error) {
@@ -20239,7 +21777,7 @@ func (p *parser) callonJoinStyle10() (any, error) {
 }
 
 func (c *current) onJoinStyle14() (any, error) {
-	return "right", nil
+	return "left", nil
 }
 
 func (p *parser) callonJoinStyle14() (any, error) {
@@ -20249,7 +21787,7 @@ func (p *parser) callonJoinStyle14() (any, error) {
 }
 
 func (c *current) onJoinStyle18() (any, error) {
-	return "", nil
+	return "lookup", nil
 }
 
 func (p *parser) callonJoinStyle18() (any, error) {
@@ -20258,6 +21796,36 @@ func (p *parser) callonJoinStyle18() (any, error) {
 	return p.cur.onJoinStyle18()
 }
 
+func (c *current) onJoinStyle22() (any, error) {
+	return "right", nil
+}
+
+func (p *parser) callonJoinStyle22() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onJoinStyle22()
+}
+
+func (c *current) onJoinStyle26() (any, error) {
+	return "semi", nil
+}
+
+func (p *parser) callonJoinStyle26() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onJoinStyle26()
+}
+
+func (c *current) onJoinStyle30() (any, error) {
+	return "", nil
+}
+
+func (p *parser) callonJoinStyle30() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onJoinStyle30()
+}
+
 func (c *current) onJoinRightInput2(s any) (any, error) {
 	return s, nil
 }
@@ -20313,6 +21881,55 @@ func (p *parser) callonSampleOp1() (any, error) {
 	return p.cur.onSampleOp1(stack["expr"])
 }
 
+func (c *current) onGraphOp1(src, dst any) (any, error) {
+	return &ast.Graph{
+		Kind: "Graph",
+		Src:  src.(ast.Expr),
+		Dst:  dst.(ast.Expr),
+		Loc:  loc(c),
+	}, nil
+
+}
+
+func (p *parser) callonGraphOp1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGraphOp1(stack["src"], stack["dst"])
+}
+
+func (c *current) onSequenceOp19(e any) (any, error) {
+	return e, nil
+}
+
+func (p *parser) callonSequenceOp19() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSequenceOp19(stack["e"])
+}
+
+func (c *current) onSequenceOp1(steps, keys, window, time any) (any, error) {
+	op := &ast.Sequence{
+		Kind:   "Sequence",
+		Steps:  sliceOf[ast.Assignment](steps),
+		Window: window.(*ast.Primitive),
+		Loc:    loc(c),
+	}
+	if keys != nil {
+		op.Keys = sliceOf[ast.Assignment](keys.([]any)[1])
+	}
+	if time != nil {
+		op.Time = time.(ast.Expr)
+	}
+	return op, nil
+
+}
+
+func (p *parser) callonSequenceOp1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSequenceOp1(stack["steps"], stack["keys"], stack["window"], stack["time"])
+}
+
 func (c *current) onOpAssignment1(a any) (any, error) {
 	return &ast.OpAssignment{
 		Kind:        "OpAssignment",
@@ -20402,6 +22019,70 @@ func (p *parser) callonOutputOp1() (any, error) {
 	return p.cur.onOutputOp1(stack["name"])
 }
 
+func (c *current) onIntoOp2(temp any) (any, error) {
+	return &ast.Into{
+		Kind: "Into",
+		Temp: temp.(*ast.Temp),
+		Loc:  loc(c),
+	}, nil
+
+}
+
+func (p *parser) callonIntoOp2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onIntoOp2(stack["temp"])
+}
+
+func (c *current) onIntoOp8(pool, branch, author, message, meta any) (any, error) {
+	return &ast.Load{
+		Kind:    "Load",
+		Pool:    nullableName(pool),
+		Branch:  nullableName(branch),
+		Author:  nullableName(author),
+		Message: nullableName(message),
+		Meta:    nullableName(meta),
+		Loc:     loc(c),
+	}, nil
+
+}
+
+func (p *parser) callonIntoOp8() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onIntoOp8(stack["pool"], stack["branch"], stack["author"], stack["message"], stack["meta"])
+}
+
+func (c *current) onTempTable1(name any) (any, error) {
+	return &ast.Temp{
+		Kind: "Temp",
+		Name: name.(string),
+		Loc:  loc(c),
+	}, nil
+
+}
+
+func (p *parser) callonTempTable1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTempTable1(stack["name"])
+}
+
+func (c *current) onGenerateSource1(spec any) (any, error) {
+	return &ast.Generate{
+		Kind: "Generate",
+		Spec: spec.(ast.Expr),
+		Loc:  loc(c),
+	}, nil
+
+}
+
+func (p *parser) callonGenerateSource1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGenerateSource1(stack["spec"])
+}
+
 func (c *current) onDebugOp8(e any) (any, error) {
 	return e, nil
 }
@@ -20577,50 +22258,98 @@ func (p *parser) callonFromEntity39() (any, error) {
 	return p.cur.onFromEntity39(stack["s"])
 }
 
-func (c *current) onFromEntity47(name any) (any, error) {
+func (c *current) onFromEntity49(name any) (any, error) {
 	return name, nil
 }
 
-func (p *parser) callonFromEntity47() (any, error) {
+func (p *parser) callonFromEntity49() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onFromEntity47(stack["name"])
+	return p.cur.onFromEntity49(stack["name"])
 }
 
-func (c *current) onFromArgs2(commit, meta, tap any) (any, error) {
-	return &ast.PoolArgs{
+func (c *current) onFromArgs2(commit, asOf, meta, r, tap any) (any, error) {
+	args := &ast.PoolArgs{
 		Kind:   "PoolArgs",
 		Commit: nullableName(commit),
 		Meta:   nullableName(meta),
 		Tap:    tap.(bool),
 		Loc:    loc(c),
-	}, nil
+	}
+	if asOf != nil {
+		args.AsOf = asOf.(*ast.Primitive)
+	}
+	if r != nil {
+		args.Range = r.(*ast.TimeRange)
+	}
+	return args, nil
 
 }
 
 func (p *parser) callonFromArgs2() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onFromArgs2(stack["commit"], stack["meta"], stack["tap"])
+	return p.cur.onFromArgs2(stack["commit"], stack["asOf"], stack["meta"], stack["r"], stack["tap"])
 }
 
-func (c *current) onFromArgs11(meta, tap any) (any, error) {
-	return &ast.PoolArgs{
+func (c *current) onFromArgs17(asOf, meta, r, tap any) (any, error) {
+	args := &ast.PoolArgs{
+		Kind: "PoolArgs",
+		AsOf: asOf.(*ast.Primitive),
+		Meta: nullableName(meta),
+		Tap:  tap.(bool),
+		Loc:  loc(c),
+	}
+	if r != nil {
+		args.Range = r.(*ast.TimeRange)
+	}
+	return args, nil
+
+}
+
+func (p *parser) callonFromArgs17() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFromArgs17(stack["asOf"], stack["meta"], stack["r"], stack["tap"])
+}
+
+func (c *current) onFromArgs29(meta, r, tap any) (any, error) {
+	args := &ast.PoolArgs{
 		Kind: "PoolArgs",
 		Meta: nullableName(meta),
 		Tap:  tap.(bool),
 		Loc:  loc(c),
+	}
+	if r != nil {
+		args.Range = r.(*ast.TimeRange)
+	}
+	return args, nil
+
+}
+
+func (p *parser) callonFromArgs29() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFromArgs29(stack["meta"], stack["r"], stack["tap"])
+}
+
+func (c *current) onFromArgs38(r, tap any) (any, error) {
+	return &ast.PoolArgs{
+		Kind:  "PoolArgs",
+		Range: r.(*ast.TimeRange),
+		Tap:   tap.(bool),
+		Loc:   loc(c),
 	}, nil
 
 }
 
-func (p *parser) callonFromArgs11() (any, error) {
+func (p *parser) callonFromArgs38() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onFromArgs11(stack["meta"], stack["tap"])
+	return p.cur.onFromArgs38(stack["r"], stack["tap"])
 }
 
-func (c *current) onFromArgs17(format any) (any, error) {
+func (c *current) onFromArgs44(format any) (any, error) {
 	return &ast.FormatArg{
 		Kind:   "FormatArg",
 		Format: nullableName(format),
@@ -20629,13 +22358,13 @@ func (c *current) onFromArgs17(format any) (any, error) {
 
 }
 
-func (p *parser) callonFromArgs17() (any, error) {
+func (p *parser) callonFromArgs44() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onFromArgs17(stack["format"])
+	return p.cur.onFromArgs44(stack["format"])
 }
 
-func (c *current) onFromArgs28(format, method, headers, body any) (any, error) {
+func (c *current) onFromArgs55(format, method, headers, body any) (any, error) {
 	if format == nil && method == nil && headers == nil && body == nil {
 		return nil, nil
 	}
@@ -20653,10 +22382,10 @@ func (c *current) onFromArgs28(format, method, headers, body any) (any, error) {
 
 }
 
-func (p *parser) callonFromArgs28() (any, error) {
+func (p *parser) callonFromArgs55() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onFromArgs28(stack["format"], stack["method"], stack["headers"], stack["body"])
+	return p.cur.onFromArgs55(stack["format"], stack["method"], stack["headers"], stack["body"])
 }
 
 func (c *current) onFormatArg1(n any) (any, error) {
@@ -20739,6 +22468,16 @@ func (p *parser) callonPoolCommit1() (any, error) {
 	return p.cur.onPoolCommit1(stack["n"])
 }
 
+func (c *current) onPoolAsOf1(t any) (any, error) {
+	return t, nil
+}
+
+func (p *parser) callonPoolAsOf1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPoolAsOf1(stack["t"])
+}
+
 func (c *current) onPoolMeta1(n any) (any, error) {
 	return n, nil
 }
@@ -20749,6 +22488,22 @@ func (p *parser) callonPoolMeta1() (any, error) {
 	return p.cur.onPoolMeta1(stack["n"])
 }
 
+func (c *current) onRangeArg1(lower, upper any) (any, error) {
+	return &ast.TimeRange{
+		Kind:  "TimeRange",
+		Lower: lower.(*ast.Primitive),
+		Upper: upper.(*ast.Primitive),
+		Loc:   loc(c),
+	}, nil
+
+}
+
+func (p *parser) callonRangeArg1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRangeArg1(stack["lower"], stack["upper"])
+}
+
 func (c *current) onTapArg2() (any, error) {
 	return true, nil
 }
@@ -23754,8 +25509,11 @@ func (p *parser) callonOrderByList1() (any, error) {
 	return p.cur.onOrderByList1(stack["first"], stack["rest"])
 }
 
-func (c *current) onOrderByItem1(e, order, nulls any) (any, error) {
+func (c *current) onOrderByItem1(e, collate, order, nulls any) (any, error) {
 	s := ast.SortExpr{Kind: "SortExpr", Expr: e.(ast.Expr), Loc: loc(c)}
+	if collate != nil {
+		s.Collate = collate.(*ast.ID)
+	}
 	if order != nil {
 		s.Order = order.(*ast.ID)
 	}
@@ -23769,7 +25527,37 @@ func (c *current) onOrderByItem1(e, order, nulls any) (any, error) {
 func (p *parser) callonOrderByItem1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onOrderByItem1(stack["e"], stack["order"], stack["nulls"])
+	return p.cur.onOrderByItem1(stack["e"], stack["collate"], stack["order"], stack["nulls"])
+}
+
+func (c *current) onOptCollate2(name any) (any, error) {
+	return name, nil
+}
+
+func (p *parser) callonOptCollate2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOptCollate2(stack["name"])
+}
+
+func (c *current) onOptCollate9() (any, error) {
+	return nil, nil
+}
+
+func (p *parser) callonOptCollate9() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOptCollate9()
+}
+
+func (c *current) onCollationName3(s any) (any, error) {
+	return &ast.ID{Kind: "ID", Name: s.(string), Loc: loc(c)}, nil
+}
+
+func (p *parser) callonCollationName3() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCollationName3(stack["s"])
 }
 
 func (c *current) onOptAscDesc2() (any, error) {
@@ -57,6 +57,17 @@ func makeArgMap(args any) (any, error) {
 	return m, nil
 }
 
+func setAggregateArgs(a *ast.Aggregate, args any) {
+	// args is nil if makeArgMap failed.
+	argm, _ := args.(map[string]any)
+	if limit, ok := argm["limit"]; ok {
+		a.Limit = limit.(int)
+	}
+	if lateness, ok := argm["lateness"]; ok {
+		a.Lateness = lateness.(*ast.Primitive)
+	}
+}
+
 func newCall(c *current, name, args, where any) ast.Expr {
 	call := &ast.Call{
 		Kind: "Call",
@@ -3,12 +3,15 @@ package semantic
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/compiler/ast"
 	"github.com/brimdata/super/compiler/dag"
 	"github.com/brimdata/super/compiler/parser"
 	"github.com/brimdata/super/compiler/srcfiles"
+	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/pkg/field"
 	"github.com/brimdata/super/runtime/exec"
 )
 
@@ -24,7 +27,11 @@ func Analyze(ctx context.Context, ast *parser.AST, env *exec.Environment, extInp
 			if len(seq) == 0 {
 				return nil, errors.New("query text is missing")
 			}
-			seq.Prepend(&dag.NullScan{Kind: "NullScan"})
+			if pool, branch := a.env.DefaultPool(); pool != "" {
+				seq = append(a.semDefaultPool(pool, branch), seq...)
+			} else {
+				seq.Prepend(&dag.NullScan{Kind: "NullScan"})
+			}
 		} else if extInput {
 			seq.Prepend(&dag.DefaultScan{Kind: "DefaultScan"})
 		} else {
@@ -33,18 +40,78 @@ func Analyze(ctx context.Context, ast *parser.AST, env *exec.Environment, extInp
 			seq.Prepend(&dag.NullScan{Kind: "NullScan"})
 		}
 	}
+	seq = a.defaultOrder(seq)
 	seq = a.checkOutputs(true, seq)
 	return seq, files.Error()
 }
 
+// semDefaultPool returns a scan of the default pool of the environment.
+func (a *analyzer) semDefaultPool(pool, branch string) dag.Seq {
+	name := &ast.Name{Kind: "Name", Text: pool}
+	var args *ast.PoolArgs
+	if branch != "" {
+		args = &ast.PoolArgs{
+			Kind:   "PoolArgs",
+			Commit: &ast.Name{Kind: "Name", Text: branch},
+		}
+	}
+	return a.semPool(name, pool, args)
+}
+
+// defaultOrder appends a sort by the pool's default order to a query that
+// scans a single pool and only filters its values.
+func (a *analyzer) defaultOrder(seq dag.Seq) dag.Seq {
+	if len(seq) == 0 {
+		return seq
+	}
+	scan, ok := seq[0].(*dag.PoolScan)
+	if !ok {
+		return seq
+	}
+	for _, op := range seq[1:] {
+		if put, ok := op.(*dag.Put); ok && a.masks[put] {
+			continue
+		}
+		if _, ok := op.(*dag.Filter); !ok {
+			return seq
+		}
+	}
+	defaults, err := a.env.PoolDefaults(a.ctx, scan.ID)
+	if err != nil {
+		return seq
+	}
+	sortKeys, err := order.ParseSortKeys(defaults.Order)
+	if err != nil || len(sortKeys) == 0 {
+		return seq
+	}
+	var exprs []dag.SortExpr
+	for _, key := range sortKeys {
+		exprs = append(exprs, dag.SortExpr{
+			Key:   &dag.This{Kind: "This", Path: key.Key},
+			Order: key.Order,
+			Nulls: order.NullsLast,
+		})
+	}
+	return append(seq, &dag.Sort{Kind: "Sort", Exprs: exprs})
+}
+
 type analyzer struct {
 	ctx     context.Context
 	files   *srcfiles.List
 	opStack []*ast.OpDecl
 	outputs map[*dag.Output]ast.Node
-	env     *exec.Environment
-	scope   *Scope
-	sctx    *super.Context
+	// temps maps the names of the temporary tables written by the
+	// query to the operators writing them.
+	temps map[string]*ast.Into
+	env   *exec.Environment
+	scope *Scope
+	sctx  *super.Context
+	// aliases maps the field aliases declared by the pools scanned by the
+	// query to the paths of the fields.
+	aliases map[string]field.Path
+	// masks holds the operators that mask the fields of the pools scanned
+	// by the query.
+	masks map[*dag.Put]bool
 }
 
 func newAnalyzer(ctx context.Context, files *srcfiles.List, env *exec.Environment) *analyzer {
@@ -52,9 +119,12 @@ func newAnalyzer(ctx context.Context, files *srcfiles.List, env *exec.Environmen
 		ctx:     ctx,
 		files:   files,
 		outputs: make(map[*dag.Output]ast.Node),
+		temps:   make(map[string]*ast.Into),
 		env:     env,
 		scope:   NewScope(nil),
 		sctx:    super.NewContext(),
+		aliases: make(map[string]field.Path),
+		masks:   make(map[*dag.Put]bool),
 	}
 }
 
@@ -63,7 +133,7 @@ func HasSource(seq dag.Seq) bool {
 		return false
 	}
 	switch op := seq[0].(type) {
-	case *dag.FileScan, *dag.HTTPScan, *dag.PoolScan, *dag.LakeMetaScan, *dag.PoolMetaScan, *dag.CommitMetaScan, *dag.DeleteScan, *dag.NullScan:
+	case *dag.FileScan, *dag.HTTPScan, *dag.PoolScan, *dag.LakeMetaScan, *dag.PoolMetaScan, *dag.CommitMetaScan, *dag.DeleteScan, *dag.NullScan, *dag.TempScan, *dag.GenerateScan:
 		return true
 	case *dag.Fork:
 		return HasSource(op.Paths[0])
@@ -109,7 +179,7 @@ func badOp() dag.Op {
 }
 
 func (a *analyzer) error(n ast.Node, err error) {
-	a.files.AddError(err.Error(), n.Pos(), n.End())
+	a.files.AddError(srcfiles.CodeSemantic, err.Error(), n.Pos(), n.End())
 }
 
 func (a *analyzer) checkOutputs(isLeaf bool, seq dag.Seq) dag.Seq {
@@ -160,3 +230,51 @@ func (a *analyzer) checkOutputs(isLeaf bool, seq dag.Seq) dag.Seq {
 	}
 	return seq
 }
+
+// AnalyzeTransform analyzes query text that transforms the values loaded
+// into a pool, e.g., "rename host:=hostname | drop debug", and returns the
+// sequence of operators that comprise it.
+func AnalyzeTransform(ctx context.Context, text string) (dag.Seq, error) {
+	ast, err := parser.ParseQuery(text)
+	if err != nil {
+		return nil, err
+	}
+	seq, err := Analyze(ctx, ast, exec.NewEnvironment(nil, nil), true)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := seq[0].(*dag.DefaultScan); !ok {
+		return nil, fmt.Errorf("%q: transform cannot read from a data source", text)
+	}
+	if _, ok := seq[len(seq)-1].(*dag.Output); !ok {
+		return nil, fmt.Errorf("%q: transform must have a single output", text)
+	}
+	return seq[1 : len(seq)-1], nil
+}
+
+// AnalyzeAggregate analyzes query text comprising a single aggregation,
+// e.g., "count() by key", and returns its dag.Aggregate.  The yield
+// implied by an aggregate function call like "sum(x)" is ignored.
+func AnalyzeAggregate(ctx context.Context, text string) (*dag.Aggregate, error) {
+	ast, err := parser.ParseQuery(text)
+	if err != nil {
+		return nil, err
+	}
+	seq, err := Analyze(ctx, ast, exec.NewEnvironment(nil, nil), true)
+	if err != nil {
+		return nil, err
+	}
+	if len(seq) == 4 {
+		if _, ok := seq[2].(*dag.Yield); ok {
+			seq = append(seq[:2], seq[3])
+		}
+	}
+	if len(seq) == 3 {
+		if agg, ok := seq[1].(*dag.Aggregate); ok {
+			if _, ok := seq[0].(*dag.DefaultScan); ok {
+				return agg, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("%q: not a single aggregate operator", text)
+}
@@ -3,6 +3,7 @@ package semantic
 import (
 	"errors"
 	"fmt"
+	"math"
 	"slices"
 	"strings"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/brimdata/super/pkg/reglob"
 	"github.com/brimdata/super/runtime/sam/expr"
 	"github.com/brimdata/super/runtime/sam/expr/agg"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
 	"github.com/brimdata/super/runtime/sam/expr/function"
 	"github.com/brimdata/super/sup"
 	"github.com/shellyln/go-sql-like-expr/likeexpr"
@@ -290,11 +292,13 @@ func (a *analyzer) semExpr(e ast.Expr) dag.Expr {
 			return badExpr()
 		}
 		where := a.semExprNullable(e.Where)
+		expr, params := a.semAggTimeArg(e, nameLower, expr, e.Params)
 		return &dag.Agg{
 			Kind:     "Agg",
 			Name:     nameLower,
 			Distinct: e.Distinct,
 			Expr:     expr,
+			Params:   a.semAggParams(e, nameLower, params),
 			Where:    where,
 		}
 	case *ast.RecordExpr:
@@ -429,6 +433,9 @@ func (a *analyzer) semID(id *ast.ID) dag.Expr {
 	if ref != nil {
 		return ref
 	}
+	if path, ok := a.aliases[id.Name]; ok {
+		return &dag.This{Kind: "This", Path: path}
+	}
 	return pathOf(id.Name)
 }
 
@@ -723,7 +730,7 @@ func (a *analyzer) semCall(call *ast.Call) dag.Expr {
 		fallthrough
 	default:
 		if _, _, err = function.New(a.sctx, nameLower, nargs); err != nil {
-			a.error(call, err)
+			a.funcError(call, err)
 			return badExpr()
 		}
 	}
@@ -828,6 +835,12 @@ func deriveLHSPath(rhs dag.Expr) ([]string, error) {
 					return this.Path, nil
 				}
 			}
+		case "collate":
+			// The key of a group-by key with a collation is named
+			// for the key.
+			if len(rhs.Args) > 0 {
+				return deriveLHSPath(rhs.Args[0])
+			}
 		}
 		return []string{rhs.Name}, nil
 	case *dag.This:
@@ -867,11 +880,15 @@ func (a *analyzer) semField(f ast.Expr) dag.Expr {
 func (a *analyzer) maybeConvertAgg(call *ast.Call) dag.Expr {
 	name := call.Name.Name
 	nameLower := strings.ToLower(name)
-	if _, err := agg.NewPattern(nameLower, false, true); err != nil {
+	nparams := agg.NumParams(nameLower)
+	if _, err := agg.NewPattern(nameLower, false, true, make([]float64, nparams)...); err != nil {
 		return nil
 	}
+	if agg.TimeArg(nameLower) {
+		nparams++
+	}
 	var e dag.Expr
-	if err := function.CheckArgCount(len(call.Args), 0, 1); err != nil {
+	if err := function.CheckArgCount(len(call.Args), 0, 1+nparams); err != nil {
 		if nameLower == "min" || nameLower == "max" {
 			// min and max are special cases as they are also functions. If the
 			// number of args is greater than 1 they're probably a function so do not
@@ -881,15 +898,97 @@ func (a *analyzer) maybeConvertAgg(call *ast.Call) dag.Expr {
 		a.error(call, err)
 		return badExpr()
 	}
-	if len(call.Args) == 1 {
+	var params []ast.Expr
+	if len(call.Args) > 0 {
 		e = a.semExpr(call.Args[0])
+		params = call.Args[1:]
 	}
+	e, params = a.semAggTimeArg(call, nameLower, e, params)
 	return &dag.Agg{
-		Kind:  "Agg",
-		Name:  nameLower,
-		Expr:  e,
-		Where: a.semExprNullable(call.Where),
+		Kind:   "Agg",
+		Name:   nameLower,
+		Expr:   e,
+		Params: a.semAggParams(call, nameLower, params),
+		Where:  a.semExprNullable(call.Where),
+	}
+}
+
+// semAggTimeArg returns the argument of aggregate function name and its
+// constant parameters given its first argument e and the arguments params
+// that follow it.  For a function that takes a time argument, the argument
+// is a record of e and the time, which is the first of params.
+func (a *analyzer) semAggTimeArg(n ast.Node, name string, e dag.Expr, params []ast.Expr) (dag.Expr, []ast.Expr) {
+	if !agg.TimeArg(name) || e == nil {
+		return e, params
 	}
+	if len(params) == 0 {
+		a.error(n, fmt.Errorf("%s: expected value and time arguments", name))
+		return badExpr(), nil
+	}
+	return &dag.RecordExpr{
+		Kind: "RecordExpr",
+		Elems: []dag.RecordElem{
+			&dag.Field{Kind: "Field", Name: "value", Value: e},
+			&dag.Field{Kind: "Field", Name: "ts", Value: a.semExpr(params[0])},
+		},
+	}, params[1:]
+}
+
+// semAggParams analyzes the constant parameters of aggregate function name.
+// These are the percentage of percentile, which must be a number from 0 to
+// 100, the count of approx_top, which must be a positive integer, the
+// number of bins of approx_histogram, which must be a positive integer,
+// and the bounds and number of bins of histogram, whose bounds must be
+// finite numbers with the lower less than the upper.
+func (a *analyzer) semAggParams(n ast.Node, name string, params []ast.Expr) []dag.Expr {
+	if nparams := agg.NumParams(name); len(params) != nparams {
+		nargs := 1
+		if agg.TimeArg(name) {
+			nargs++
+		}
+		a.error(n, fmt.Errorf("%s: expected %d arguments but found %d", name, nparams+nargs, len(params)+nargs))
+		return nil
+	}
+	var out []dag.Expr
+	var floats []float64
+	for k, param := range params {
+		val, err := kernel.EvalAtCompileTime(a.sctx, a.semExpr(param))
+		if err != nil {
+			a.error(param, err)
+			continue
+		}
+		f, ok := coerce.ToFloat(val, super.TypeFloat64)
+		ok = ok && super.IsNumber(val.Type().ID()) && !val.IsNull()
+		switch {
+		case name == "approx_top":
+			if !ok || f < 1 || f != math.Trunc(f) || f > agg.MaxApproxTopCount {
+				a.error(param, fmt.Errorf("%s: count must be an integer from 1 to %d: %s", name, agg.MaxApproxTopCount, sup.FormatValue(val)))
+				continue
+			}
+		case name == "approx_histogram" || name == "histogram" && k == 2:
+			if !ok || f < 1 || f != math.Trunc(f) || f > agg.MaxHistogramBins {
+				a.error(param, fmt.Errorf("%s: number of bins must be an integer from 1 to %d: %s", name, agg.MaxHistogramBins, sup.FormatValue(val)))
+				continue
+			}
+		case name == "histogram":
+			if !ok || math.IsInf(f, 0) || math.IsNaN(f) {
+				a.error(param, fmt.Errorf("%s: bound must be a finite number: %s", name, sup.FormatValue(val)))
+				continue
+			}
+			if k == 1 && len(floats) == 1 && floats[0] >= f {
+				a.error(param, fmt.Errorf("%s: upper bound must be greater than lower bound: %s", name, sup.FormatValue(val)))
+				continue
+			}
+		default:
+			if !ok || f < 0 || f > 100 {
+				a.error(param, fmt.Errorf("%s: percentage must be a number from 0 to 100: %s", name, sup.FormatValue(val)))
+				continue
+			}
+		}
+		floats = append(floats, f)
+		out = append(out, &dag.Literal{Kind: "Literal", Value: sup.FormatValue(super.NewFloat64(f))})
+	}
+	return out
 }
 
 func DotExprToFieldPath(e ast.Expr) *dag.This {
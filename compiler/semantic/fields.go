@@ -0,0 +1,171 @@
+package semantic
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/brimdata/super/compiler/ast"
+	"github.com/brimdata/super/compiler/parser"
+	"github.com/brimdata/super/compiler/srcfiles"
+)
+
+// SourcePool returns the name of the pool read by the query in p if the
+// query begins with a "from" of a single pool and otherwise returns an
+// empty string.
+func SourcePool(p *parser.AST) string {
+	seq := p.Parsed()
+	if len(seq) == 0 {
+		return ""
+	}
+	from, ok := seq[0].(*ast.From)
+	if !ok || len(from.Elems) != 1 {
+		return ""
+	}
+	if name, ok := from.Elems[0].Entity.(*ast.Name); ok {
+		return name.Text
+	}
+	return ""
+}
+
+// CheckFields returns an error for each reference to a top-level field of
+// the values read from the pool named by SourcePool(p) that is not in
+// fields, e.g., the fields of a sample of the pool's values.  Only the
+// expressions of the operators that see the values as read from the pool
+// are checked.  Each error has a hint naming the field in fields spelled
+// most like the missing field, if any.
+func CheckFields(p *parser.AST, fields []string) error {
+	pool := SourcePool(p)
+	if pool == "" {
+		return nil
+	}
+	c := &fieldChecker{files: p.Files(), pool: pool, fields: fields}
+	for _, op := range p.Parsed()[1:] {
+		if !c.op(op) {
+			break
+		}
+	}
+	return c.files.Error()
+}
+
+type fieldChecker struct {
+	files  *srcfiles.List
+	pool   string
+	fields []string
+}
+
+// op checks the expressions of op and returns true if the output of op
+// has the same fields as its input.
+func (c *fieldChecker) op(op ast.Op) bool {
+	switch op := op.(type) {
+	case *ast.Where:
+		c.expr(op.Expr)
+		return true
+	case *ast.Sort:
+		c.sortExprs(op.Exprs)
+		return true
+	case *ast.Top:
+		c.sortExprs(op.Exprs)
+		return true
+	case *ast.Head, *ast.Tail, *ast.Pass, *ast.Uniq:
+		return true
+	case *ast.Aggregate:
+		c.assignments(op.Keys)
+		c.assignments(op.Aggs)
+	case *ast.Cut:
+		c.assignments(op.Args)
+	case *ast.Put:
+		c.assignments(op.Args)
+	case *ast.Rename:
+		for _, a := range op.Args {
+			c.expr(a.RHS)
+		}
+	case *ast.Drop:
+		c.exprs(op.Args)
+	case *ast.Yield:
+		c.exprs(op.Exprs)
+	}
+	return false
+}
+
+func (c *fieldChecker) sortExprs(exprs []ast.SortExpr) {
+	for _, e := range exprs {
+		c.expr(e.Expr)
+	}
+}
+
+func (c *fieldChecker) assignments(assignments ast.Assignments) {
+	for _, a := range assignments {
+		c.expr(a.RHS)
+	}
+}
+
+func (c *fieldChecker) exprs(exprs []ast.Expr) {
+	for _, e := range exprs {
+		c.expr(e)
+	}
+}
+
+// expr checks the top-level field references in e.  Expressions whose
+// references depend on scope (e.g., subqueries and lambdas) are skipped.
+func (c *fieldChecker) expr(e ast.Expr) {
+	switch e := e.(type) {
+	case *ast.ID:
+		c.id(e)
+	case *ast.BinaryExpr:
+		c.expr(e.LHS)
+		if e.Op != "." {
+			c.expr(e.RHS)
+		}
+	case *ast.UnaryExpr:
+		c.expr(e.Operand)
+	case *ast.Between:
+		c.exprs([]ast.Expr{e.Expr, e.Lower, e.Upper})
+	case *ast.Conditional:
+		c.exprs([]ast.Expr{e.Cond, e.Then, e.Else})
+	case *ast.Agg:
+		c.exprs([]ast.Expr{e.Expr, e.Where})
+	case *ast.Call:
+		c.exprs(e.Args)
+		c.expr(e.Where)
+	case *ast.Cast:
+		c.expr(e.Expr)
+	case *ast.IndexExpr:
+		c.exprs([]ast.Expr{e.Expr, e.Index})
+	case *ast.IsNullExpr:
+		c.expr(e.Expr)
+	case *ast.RecordExpr:
+		for _, elem := range e.Elems {
+			switch elem := elem.(type) {
+			case *ast.FieldExpr:
+				c.expr(elem.Value)
+			case *ast.Spread:
+				c.expr(elem.Expr)
+			case *ast.ID:
+				c.id(elem)
+			}
+		}
+	case *ast.ArrayExpr:
+		c.vectorElems(e.Elems)
+	case *ast.SetExpr:
+		c.vectorElems(e.Elems)
+	}
+}
+
+func (c *fieldChecker) vectorElems(elems []ast.VectorElem) {
+	for _, elem := range elems {
+		switch elem := elem.(type) {
+		case *ast.VectorValue:
+			c.expr(elem.Expr)
+		case *ast.Spread:
+			c.expr(elem.Expr)
+		}
+	}
+}
+
+func (c *fieldChecker) id(id *ast.ID) {
+	if id.Name == "this" || slices.Contains(c.fields, id.Name) {
+		return
+	}
+	msg := fmt.Sprintf("field %q not found in values sampled from pool %q", id.Name, c.pool)
+	c.files.AddError(srcfiles.CodeUnknownField, msg, id.Pos(), id.End()).Hint = suggest(id.Name, c.fields)
+}
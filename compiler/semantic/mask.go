@@ -0,0 +1,94 @@
+package semantic
+
+import (
+	"github.com/brimdata/super/compiler/dag"
+	"github.com/brimdata/super/lake/pools"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/service/auth"
+)
+
+// semMasks returns a put operator that applies those of masks that hide
+// their fields from the requester of the query or nil if none do.  The put
+// follows the pool scan directly so that no downstream operator, including
+// a filter, sees the unmasked values.
+func (a *analyzer) semMasks(masks []pools.Mask) *dag.Put {
+	roles := auth.RolesFromContext(a.ctx)
+	var assignments []dag.Assignment
+	for _, m := range masks {
+		if !m.Applies(roles) {
+			continue
+		}
+		path := field.Dotted(m.Field)
+		assignments = append(assignments, dag.Assignment{
+			Kind: "Assignment",
+			LHS:  &dag.This{Kind: "This", Path: path},
+			// Leave a missing field missing rather than creating it.
+			RHS: &dag.Conditional{
+				Kind: "Conditional",
+				Cond: &dag.Call{Kind: "Call", Name: "has", Args: []dag.Expr{&dag.This{Kind: "This", Path: path}}},
+				Then: maskExpr(path, m.Method),
+				Else: &dag.Call{Kind: "Call", Name: "quiet", Args: []dag.Expr{&dag.This{Kind: "This", Path: path}}},
+			},
+		})
+	}
+	if len(assignments) == 0 {
+		return nil
+	}
+	put := &dag.Put{
+		Kind: "Put",
+		Args: assignments,
+	}
+	a.masks[put] = true
+	return put
+}
+
+// masksApply reports whether any of masks hides its field from the
+// requester of the query.  The commit metadata of such a pool may not be
+// queried since the value ranges of its objects would reveal masked values.
+func (a *analyzer) masksApply(masks []pools.Mask) bool {
+	return pools.AnyApplies(masks, auth.RolesFromContext(a.ctx))
+}
+
+// maskExpr returns an expression computing the masked form of the field at
+// path with method.
+func maskExpr(path field.Path, method string) dag.Expr {
+	if method == pools.MaskNull {
+		return &dag.Literal{Kind: "Literal", Value: "null"}
+	}
+	str := func() dag.Expr {
+		return &dag.Call{
+			Kind: "Call",
+			Name: "cast",
+			Args: []dag.Expr{
+				&dag.This{Kind: "This", Path: path},
+				&dag.Literal{Kind: "Literal", Value: "<string>"},
+			},
+		}
+	}
+	if method == pools.MaskHash {
+		return &dag.Call{Kind: "Call", Name: "sha256", Args: []dag.Expr{str()}}
+	}
+	stars := func() dag.Expr {
+		return &dag.Literal{Kind: "Literal", Value: `"****"`}
+	}
+	return &dag.Conditional{
+		Kind: "Conditional",
+		Cond: &dag.BinaryExpr{
+			Kind: "BinaryExpr",
+			Op:   ">",
+			LHS:  &dag.Call{Kind: "Call", Name: "len", Args: []dag.Expr{str()}},
+			RHS:  &dag.Literal{Kind: "Literal", Value: "4"},
+		},
+		Then: &dag.BinaryExpr{
+			Kind: "BinaryExpr",
+			Op:   "+",
+			LHS:  stars(),
+			RHS: &dag.SliceExpr{
+				Kind: "SliceExpr",
+				Expr: str(),
+				From: &dag.Literal{Kind: "Literal", Value: "-4"},
+			},
+		},
+		Else: stars(),
+	}
+}
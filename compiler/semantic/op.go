@@ -13,13 +13,17 @@ import (
 	"github.com/brimdata/super/compiler/ast"
 	"github.com/brimdata/super/compiler/dag"
 	"github.com/brimdata/super/compiler/kernel"
+	"github.com/brimdata/super/lake/externals"
+	"github.com/brimdata/super/lake/pools"
 	"github.com/brimdata/super/lakeparse"
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/pkg/nano"
 	"github.com/brimdata/super/pkg/plural"
 	"github.com/brimdata/super/pkg/reglob"
 	"github.com/brimdata/super/runtime/sam/expr"
 	"github.com/brimdata/super/runtime/sam/expr/function"
+	"github.com/brimdata/super/runtime/sam/op/generate"
 	"github.com/brimdata/super/sup"
 	"github.com/segmentio/ksuid"
 )
@@ -36,11 +40,18 @@ func (a *analyzer) semSeq(seq ast.Seq) dag.Seq {
 }
 
 func (a *analyzer) semFrom(from *ast.From, seq dag.Seq) (dag.Seq, schema) {
-	if len(from.Elems) > 1 {
-		a.error(from, errors.New("cross join implied by multiple elements in from clause is not yet supported"))
-		return dag.Seq{badOp()}, badSchema()
-	}
-	return a.semFromElem(from.Elems[0], seq)
+	// Multiple elements imply a cross join of each with the ones before it.
+	elem := from.Elems[0]
+	for _, right := range from.Elems[1:] {
+		join := &ast.CrossJoin{
+			Kind:  "CrossJoin",
+			Left:  elem,
+			Right: right,
+			Loc:   right.Loc,
+		}
+		elem = &ast.FromElem{Kind: "FromElem", Entity: join, Loc: right.Loc}
+	}
+	return a.semFromElem(elem, seq)
 }
 
 // semFromElem generates a DAG fragment to read from the various sources potentially
@@ -98,8 +109,8 @@ func (a *analyzer) semFromEntity(entity ast.FromEntity, alias *ast.TableAlias, a
 			}
 			return seq, schema
 		}
-		op, def := a.semFromName(entity, entity.Text, args)
-		return dag.Seq{op}, a.fromSchema(alias, def)
+		seq, def := a.semFromName(entity, entity.Text, args)
+		return seq, a.fromSchema(alias, def)
 	case *ast.ExprEntity:
 		seq, def := a.semFromExpr(entity, args, seq)
 		return seq, a.fromSchema(alias, def)
@@ -108,10 +119,22 @@ func (a *analyzer) semFromEntity(entity ast.FromEntity, alias *ast.TableAlias, a
 			return bad, badSchema()
 		}
 		return dag.Seq{a.semLakeMeta(entity)}, &dynamicSchema{}
+	case *ast.Temp:
+		if bad := a.hasFromParent(entity, seq); bad != nil {
+			return bad, badSchema()
+		}
+		return dag.Seq{&dag.TempScan{Kind: "TempScan", Name: entity.Name}}, a.fromSchema(alias, entity.Name)
+	case *ast.Generate:
+		if bad := a.hasFromParent(entity, seq); bad != nil {
+			return bad, badSchema()
+		}
+		return a.semGenerate(entity), a.fromSchema(alias, "")
 	case *ast.SQLPipe:
 		return a.semSQLPipe(entity, seq, alias)
 	case *ast.SQLJoin:
 		return a.semSQLJoin(entity, seq)
+	case *ast.CrossJoin:
+		return a.semCrossJoin(entity, seq)
 	default:
 		panic(fmt.Sprintf("semFromEntity: unknown entity type: %T", entity))
 	}
@@ -135,6 +158,22 @@ func (a *analyzer) semFromExpr(entity *ast.ExprEntity, args ast.FromArgs, seq da
 	}), ""
 }
 
+// semGenerate checks the spec of a generate source, which must be constant,
+// so that errors in it are reported at compile time.
+func (a *analyzer) semGenerate(entity *ast.Generate) dag.Seq {
+	spec := a.semExpr(entity.Spec)
+	val, err := kernel.EvalAtCompileTime(a.sctx, spec)
+	if err != nil {
+		a.error(entity.Spec, fmt.Errorf("generate spec must be a constant: %w", err))
+		return dag.Seq{badOp()}
+	}
+	if _, err := generate.NewReader(a.sctx, val); err != nil {
+		a.error(entity.Spec, err)
+		return dag.Seq{badOp()}
+	}
+	return dag.Seq{&dag.GenerateScan{Kind: "GenerateScan", Spec: spec}}
+}
+
 func hasError(val super.Value) bool {
 	has := function.NewHasError()
 	result := has.Call(nil, []super.Value{val})
@@ -151,8 +190,7 @@ func (a *analyzer) hasFromParent(loc ast.Node, seq dag.Seq) dag.Seq {
 
 func (a *analyzer) semFromConstVal(val super.Value, entity *ast.ExprEntity, args ast.FromArgs) (dag.Seq, string) {
 	if super.TypeUnder(val.Type()) == super.TypeString {
-		op, name := a.semFromName(entity, val.AsString(), args)
-		return dag.Seq{op}, name
+		return a.semFromName(entity, val.AsString(), args)
 	}
 	vals, err := val.Elements()
 	if err != nil {
@@ -168,13 +206,13 @@ func (a *analyzer) semFromConstVal(val super.Value, entity *ast.ExprEntity, args
 		names = append(names, val.AsString())
 	}
 	if len(names) == 1 {
-		op, _ := a.semFromName(entity, names[0], args)
-		return dag.Seq{op}, names[0]
+		seq, _ := a.semFromName(entity, names[0], args)
+		return seq, names[0]
 	}
 	var paths []dag.Seq
 	for _, name := range names {
-		op, _ := a.semFromName(entity, name, args)
-		paths = append(paths, dag.Seq{op})
+		seq, _ := a.semFromName(entity, name, args)
+		paths = append(paths, seq)
 	}
 	return dag.Seq{
 		&dag.Fork{
@@ -184,20 +222,25 @@ func (a *analyzer) semFromConstVal(val super.Value, entity *ast.ExprEntity, args
 	}, ""
 }
 
-func (a *analyzer) semFromName(nameLoc ast.Node, name string, args ast.FromArgs) (dag.Op, string) {
+func (a *analyzer) semFromName(nameLoc ast.Node, name string, args ast.FromArgs) (dag.Seq, string) {
 	if isURL(name) {
-		return a.semFromURL(nameLoc, name, args), ""
+		return dag.Seq{a.semFromURL(nameLoc, name, args)}, ""
 	}
 	prefix := strings.Split(name, ".")[0]
 	if a.env.IsLake() {
+		if _, err := a.env.PoolID(a.ctx, name); errors.Is(err, pools.ErrNotFound) {
+			if config, err := a.env.LookupExternal(a.ctx, name); err == nil {
+				return dag.Seq{a.semExternal(nameLoc, config, args)}, prefix
+			}
+		}
 		poolArgs, err := asPoolArgs(args)
 		if err != nil {
 			a.error(args, err)
-			return badOp(), ""
+			return dag.Seq{badOp()}, ""
 		}
 		return a.semPool(nameLoc, name, poolArgs), prefix
 	}
-	return a.semFile(name, args), prefix
+	return dag.Seq{a.semFile(name, args)}, prefix
 }
 
 func asPoolArgs(args ast.FromArgs) (*ast.PoolArgs, error) {
@@ -244,9 +287,12 @@ func (a *analyzer) formatArg(args ast.FromArgs) string {
 }
 
 func (a *analyzer) semFile(name string, args ast.FromArgs) dag.Op {
-	format := a.formatArg(args)
+	return fileScan(name, a.formatArg(args))
+}
+
+func fileScan(path, format string) *dag.FileScan {
 	if format == "" {
-		switch filepath.Ext(name) {
+		switch filepath.Ext(path) {
 		case ".parquet":
 			format = "parquet"
 		case ".csup":
@@ -255,11 +301,40 @@ func (a *analyzer) semFile(name string, args ast.FromArgs) dag.Op {
 	}
 	return &dag.FileScan{
 		Kind:   "FileScan",
-		Path:   name,
+		Path:   path,
 		Format: format,
 	}
 }
 
+// semExternal returns a scan of the files matching the pattern of an
+// external pool when the query is compiled.
+func (a *analyzer) semExternal(nameLoc ast.Node, config *externals.Config, args ast.FromArgs) dag.Op {
+	format := a.formatArg(args)
+	if format == "" {
+		format = config.Format
+	}
+	files, err := a.env.ExternalFiles(a.ctx, config)
+	if err != nil {
+		a.error(nameLoc, err)
+		return badOp()
+	}
+	if len(files) == 0 {
+		a.error(nameLoc, fmt.Errorf("external pool %q: no files match %s", config.Name, config.Pattern))
+		return badOp()
+	}
+	if len(files) == 1 {
+		return fileScan(files[0].URI, format)
+	}
+	paths := make([]dag.Seq, 0, len(files))
+	for _, f := range files {
+		paths = append(paths, dag.Seq{fileScan(f.URI, format)})
+	}
+	return &dag.Fork{
+		Kind:  "Fork",
+		Paths: paths,
+	}
+}
+
 func (a *analyzer) semFromFileGlob(globLoc ast.Node, pattern string, args ast.FromArgs) dag.Op {
 	names, err := filepath.Glob(pattern)
 	if err != nil {
@@ -367,7 +442,7 @@ func (a *analyzer) semPoolFromRegexp(patternLoc ast.Node, re, orig, which string
 	}
 	var paths []dag.Seq
 	for _, name := range poolNames {
-		paths = append(paths, dag.Seq{a.semPool(patternLoc, name, poolArgs)})
+		paths = append(paths, a.semPool(patternLoc, name, poolArgs))
 	}
 	return dag.Seq{&dag.Fork{
 		Kind:  "Fork",
@@ -399,16 +474,26 @@ func (a *analyzer) semSortExpr(sch schema, s ast.SortExpr, reverse bool) dag.Sor
 	if reverse {
 		o = !o
 	}
-	n := order.NullsLast
+	n := order.DefaultNulls
 	if s.Nulls != nil {
 		if err := n.UnmarshalText([]byte(s.Nulls.Name)); err != nil {
 			a.error(s.Nulls, err)
 		}
 	}
-	return dag.SortExpr{Key: e, Order: o, Nulls: n}
+	var collation string
+	if s.Collate != nil {
+		if _, err := expr.NewCollator(s.Collate.Name); err != nil {
+			a.error(s.Collate, err)
+		}
+		collation = s.Collate.Name
+	}
+	return dag.SortExpr{Key: e, Order: o, Nulls: n, Collation: collation}
 }
 
-func (a *analyzer) semPool(nameLoc ast.Node, poolName string, args *ast.PoolArgs) dag.Op {
+// semPool returns a scan of the named pool along with a filter for the time
+// range in args, if any, and records the pool's field aliases for the
+// expressions that follow.
+func (a *analyzer) semPool(nameLoc ast.Node, poolName string, args *ast.PoolArgs) dag.Seq {
 	var commit, meta string
 	var tap bool
 	if args != nil {
@@ -418,8 +503,8 @@ func (a *analyzer) semPool(nameLoc ast.Node, poolName string, args *ast.PoolArgs
 	}
 	poolID, err := a.env.PoolID(a.ctx, poolName)
 	if err != nil {
-		a.error(nameLoc, err)
-		return badOp()
+		a.poolError(nameLoc, poolName, err)
+		return dag.Seq{badOp()}
 	}
 	var commitID ksuid.KSUID
 	if commit != "" {
@@ -427,36 +512,66 @@ func (a *analyzer) semPool(nameLoc ast.Node, poolName string, args *ast.PoolArgs
 			commitID, err = a.env.CommitObject(a.ctx, poolID, commit)
 			if err != nil {
 				a.error(args.Commit, err)
-				return badOp()
+				return dag.Seq{badOp()}
 			}
 		}
 	}
+	if args != nil && args.AsOf != nil {
+		ts, err := nano.ParseRFC3339Nano([]byte(args.AsOf.Text))
+		if err != nil {
+			a.error(args.AsOf, err)
+			return dag.Seq{badOp()}
+		}
+		if commitID == ksuid.Nil {
+			if commitID, err = a.env.CommitObject(a.ctx, poolID, "main"); err != nil {
+				a.error(args.AsOf, err)
+				return dag.Seq{badOp()}
+			}
+		}
+		if commitID, err = a.env.CommitAsOf(a.ctx, poolID, commitID, ts); err != nil {
+			a.error(args.AsOf, err)
+			return dag.Seq{badOp()}
+		}
+	}
 	if meta != "" {
+		if args.Range != nil {
+			a.error(args.Range, errors.New("cannot apply time range to metadata"))
+			return dag.Seq{badOp()}
+		}
 		if _, ok := dag.CommitMetas[meta]; ok {
+			masks, err := a.env.PoolMasks(a.ctx, poolID)
+			if err != nil {
+				a.error(nameLoc, err)
+				return dag.Seq{badOp()}
+			}
+			if a.masksApply(masks) {
+				a.error(nameLoc, fmt.Errorf("metadata of pool %q is hidden by its masks", poolName))
+				return dag.Seq{badOp()}
+			}
 			if commitID == ksuid.Nil {
 				commitID, err = a.env.CommitObject(a.ctx, poolID, "main")
 				if err != nil {
 					a.error(args.Commit, err)
-					return badOp()
+					return dag.Seq{badOp()}
 				}
 			}
-			return &dag.CommitMetaScan{
+			return dag.Seq{&dag.CommitMetaScan{
 				Kind:   "CommitMetaScan",
 				Meta:   meta,
 				Pool:   poolID,
 				Commit: commitID,
 				Tap:    tap,
-			}
+			}}
 		}
 		if _, ok := dag.PoolMetas[meta]; ok {
-			return &dag.PoolMetaScan{
+			return dag.Seq{&dag.PoolMetaScan{
 				Kind: "PoolMetaScan",
 				Meta: meta,
 				ID:   poolID,
-			}
+			}}
 		}
 		a.error(nameLoc, fmt.Errorf("unknown metadata type %q", meta))
-		return badOp()
+		return dag.Seq{badOp()}
 	}
 	if commitID == ksuid.Nil {
 		// This trick here allows us to default to the main branch when
@@ -464,13 +579,62 @@ func (a *analyzer) semPool(nameLoc ast.Node, poolName string, args *ast.PoolArgs
 		commitID, err = a.env.CommitObject(a.ctx, poolID, "main")
 		if err != nil {
 			a.error(nameLoc, err)
-			return badOp()
+			return dag.Seq{badOp()}
 		}
 	}
-	return &dag.PoolScan{
+	defaults, err := a.env.PoolDefaults(a.ctx, poolID)
+	if err != nil {
+		a.error(nameLoc, err)
+		return dag.Seq{badOp()}
+	}
+	for name, path := range defaults.Aliases {
+		a.aliases[name] = field.Dotted(path)
+	}
+	seq := dag.Seq{&dag.PoolScan{
 		Kind:   "PoolScan",
 		ID:     poolID,
 		Commit: commitID,
+	}}
+	masks, err := a.env.PoolMasks(a.ctx, poolID)
+	if err != nil {
+		a.error(nameLoc, err)
+		return dag.Seq{badOp()}
+	}
+	if put := a.semMasks(masks); put != nil {
+		seq = append(seq, put)
+	}
+	if args != nil && args.Range != nil {
+		if defaults.Time == "" {
+			a.error(args.Range, fmt.Errorf("pool %q has no default time field for time range", poolName))
+			return dag.Seq{badOp()}
+		}
+		seq = append(seq, a.semTimeRange(args.Range, field.Dotted(defaults.Time)))
+	}
+	return seq
+}
+
+// semTimeRange returns a filter selecting values whose field at path falls
+// within the half-open interval r.
+func (a *analyzer) semTimeRange(r *ast.TimeRange, path field.Path) dag.Op {
+	this := &dag.This{Kind: "This", Path: path}
+	return &dag.Filter{
+		Kind: "Filter",
+		Expr: &dag.BinaryExpr{
+			Kind: "BinaryExpr",
+			Op:   "and",
+			LHS: &dag.BinaryExpr{
+				Kind: "BinaryExpr",
+				Op:   ">=",
+				LHS:  this,
+				RHS:  a.semExpr(r.Lower),
+			},
+			RHS: &dag.BinaryExpr{
+				Kind: "BinaryExpr",
+				Op:   "<",
+				LHS:  this,
+				RHS:  a.semExpr(r.Upper),
+			},
+		},
 	}
 }
 
@@ -493,7 +657,7 @@ func (a *analyzer) semDelete(op *ast.Delete) dag.Op {
 	}
 	poolID, err := a.env.PoolID(a.ctx, op.Pool)
 	if err != nil {
-		a.error(op, err)
+		a.poolError(op, op.Pool, err)
 		return badOp()
 	}
 	var commitID ksuid.KSUID
@@ -566,6 +730,138 @@ func (a *analyzer) semDebugOp(o *ast.Debug, mainAst ast.Seq, in dag.Seq) dag.Seq
 	})
 }
 
+// semLateness returns the lateness of an aggregation, which requires a
+// grouping key whose values are times.
+func (a *analyzer) semLateness(o *ast.Aggregate) nano.Duration {
+	if o.Lateness == nil {
+		return 0
+	}
+	if len(o.Keys) == 0 {
+		a.error(o.Lateness, errors.New("lateness requires a grouping key"))
+		return 0
+	}
+	d, err := nano.ParseDuration(o.Lateness.Text)
+	if err != nil {
+		a.error(o.Lateness, err)
+		return 0
+	}
+	if d <= 0 {
+		a.error(o.Lateness, errors.New("lateness must be positive"))
+		return 0
+	}
+	return d
+}
+
+func (a *analyzer) semSequence(o *ast.Sequence) dag.Op {
+	keys := a.semAssignments(o.Keys)
+	a.checkStaticAssignment(o.Keys, keys)
+	var steps []dag.Assignment
+	for k, step := range o.Steps {
+		if step.LHS == nil {
+			// Steps are named by their position unless named explicitly.
+			steps = append(steps, dag.Assignment{
+				Kind: "Assignment",
+				LHS:  pathOf(fmt.Sprintf("step%d", k+1)),
+				RHS:  a.semExpr(step.RHS),
+			})
+			continue
+		}
+		steps = append(steps, a.semAssignment(step))
+	}
+	a.checkStaticAssignment(o.Steps, steps)
+	window, err := nano.ParseDuration(o.Window.Text)
+	if err != nil {
+		a.error(o.Window, err)
+	} else if window <= 0 {
+		a.error(o.Window, errors.New("window must be positive"))
+	}
+	var time dag.Expr = pathOf("ts")
+	if o.Time != nil {
+		time = a.semExpr(o.Time)
+	}
+	return &dag.Sequence{
+		Kind:   "Sequence",
+		Steps:  steps,
+		Keys:   keys,
+		Window: window,
+		Time:   time,
+	}
+}
+
+// semGraph translates the graph operator into aggregations that count the
+// edges between each pair of nodes, yield each edge once from each of its
+// nodes, and summarize the edges of each node.  Since the aggregations
+// spill, so does the graph operator.
+func (a *analyzer) semGraph(o *ast.Graph) dag.Seq {
+	assign := func(name string, rhs dag.Expr) dag.Assignment {
+		return dag.Assignment{Kind: "Assignment", LHS: pathOf(name), RHS: rhs}
+	}
+	agg := func(name string, e dag.Expr) *dag.Agg {
+		return &dag.Agg{Kind: "Agg", Name: name, Expr: e}
+	}
+	field := func(name string, e dag.Expr) dag.RecordElem {
+		return &dag.Field{Kind: "Field", Name: name, Value: e}
+	}
+	zero := &dag.Literal{Kind: "Literal", Value: "0(uint64)"}
+	edge := func(node, neighbor string, out, in dag.Expr) dag.Expr {
+		return &dag.RecordExpr{
+			Kind: "RecordExpr",
+			Elems: []dag.RecordElem{
+				field("node", pathOf(node)),
+				field("neighbor", pathOf(neighbor)),
+				field("outgoing", out),
+				field("incoming", in),
+			},
+		}
+	}
+	positive := func(name string) dag.Expr {
+		return &dag.BinaryExpr{Kind: "BinaryExpr", Op: ">", LHS: pathOf(name), RHS: zero}
+	}
+	countWhere := func(name string) *dag.Agg {
+		return &dag.Agg{Kind: "Agg", Name: "count", Where: positive(name)}
+	}
+	return dag.Seq{
+		&dag.Aggregate{
+			Kind: "Aggregate",
+			Aggs: []dag.Assignment{assign("count", agg("count", nil))},
+			Keys: []dag.Assignment{
+				assign("src", a.semExpr(o.Src)),
+				assign("dst", a.semExpr(o.Dst)),
+			},
+		},
+		&dag.Yield{
+			Kind: "Yield",
+			Exprs: []dag.Expr{
+				edge("src", "dst", pathOf("count"), zero),
+				edge("dst", "src", zero, pathOf("count")),
+			},
+		},
+		&dag.Aggregate{
+			Kind: "Aggregate",
+			Aggs: []dag.Assignment{
+				assign("outgoing", agg("sum", pathOf("outgoing"))),
+				assign("incoming", agg("sum", pathOf("incoming"))),
+			},
+			Keys: []dag.Assignment{
+				assign("node", pathOf("node")),
+				assign("neighbor", pathOf("neighbor")),
+			},
+		},
+		&dag.Aggregate{
+			Kind: "Aggregate",
+			Aggs: []dag.Assignment{
+				assign("degree", agg("count", nil)),
+				assign("out_degree", countWhere("outgoing")),
+				assign("in_degree", countWhere("incoming")),
+				assign("out_edges", agg("sum", pathOf("outgoing"))),
+				assign("in_edges", agg("sum", pathOf("incoming"))),
+				assign("neighbors", agg("union", pathOf("neighbor"))),
+			},
+			Keys: []dag.Assignment{assign("node", pathOf("node"))},
+		},
+	}
+}
+
 // semOp does a semantic analysis on a flowgraph to an
 // intermediate representation that can be compiled into the runtime
 // object.  Currently, it only replaces the aggregate duration with
@@ -588,13 +884,14 @@ func (a *analyzer) semOp(o ast.Op, seq dag.Seq) dag.Seq {
 	case *ast.Aggregate:
 		keys := a.semAssignments(o.Keys)
 		a.checkStaticAssignment(o.Keys, keys)
-		if len(keys) == 0 && len(o.Aggs) == 1 {
+		if len(keys) == 0 && len(o.Aggs) == 1 && o.Lateness == nil {
 			if seq := a.singletonAgg(o.Aggs[0], seq); seq != nil {
 				return seq
 			}
 		}
 		aggs := a.semAssignments(o.Aggs)
 		a.checkStaticAssignment(o.Aggs, aggs)
+		aggs, emit := a.semAggEmit(o.Aggs, aggs)
 		// Note: InputSortDir is copied in here but it's not meaningful
 		// coming from a parser AST, only from a worker using the kernel DSL,
 		// which is another reason why we need separate parser and kernel ASTs.
@@ -605,10 +902,12 @@ func (a *analyzer) semOp(o ast.Op, seq dag.Seq) dag.Seq {
 		// and it will soon do other stuff so we need to put in place the
 		// separation... see issue #2163.
 		return append(seq, &dag.Aggregate{
-			Kind:  "Aggregate",
-			Limit: o.Limit,
-			Keys:  keys,
-			Aggs:  aggs,
+			Kind:     "Aggregate",
+			Limit:    o.Limit,
+			Keys:     keys,
+			Aggs:     aggs,
+			Lateness: a.semLateness(o),
+			Emit:     emit,
 		})
 	case *ast.Parallel:
 		var paths []dag.Seq
@@ -667,9 +966,13 @@ func (a *analyzer) semOp(o ast.Op, seq dag.Seq) dag.Seq {
 			Args: assignments,
 		})
 	case *ast.Distinct:
+		var e dag.Expr = &dag.This{Kind: "This"}
+		if o.Expr != nil {
+			e = a.semExpr(o.Expr)
+		}
 		return append(seq, &dag.Distinct{
 			Kind: "Distinct",
-			Expr: a.semExpr(o.Expr),
+			Expr: e,
 		})
 	case *ast.Drop:
 		args := a.semFields(o.Args)
@@ -800,23 +1103,49 @@ func (a *analyzer) semOp(o ast.Op, seq dag.Seq) dag.Seq {
 		return append(seq, &dag.Fuse{Kind: "Fuse"})
 	case *ast.Join:
 		rightInput := a.semSeq(o.RightInput)
-		leftKey, rightKey, err := a.semJoinCond(o.Cond)
-		if err != nil {
-			a.error(o.Cond, err)
-			return append(seq, badOp())
+		var leftKey, rightKey dag.Expr
+		if o.Style != "cross" {
+			var err error
+			leftKey, rightKey, err = a.semJoinCond(o.Cond)
+			if err != nil {
+				a.error(o.Cond, err)
+				return append(seq, badOp())
+			}
 		}
 		style := o.Style
 		if style == "" {
 			style = "inner"
 		}
+		if style == "lookup" && (len(o.RightInput) == 0 || !isFrom(o.RightInput[0])) {
+			a.error(o, errors.New("lookup join requires a right input that begins with from"))
+			return append(seq, badOp())
+		}
+		if style == "semi" && len(o.Args) != 0 {
+			a.error(o.Args, errors.New("semi join does not take assignments"))
+			return append(seq, badOp())
+		}
+		var tolerance dag.Expr
+		if o.Tolerance != nil {
+			if style != "asof" {
+				a.error(o.Tolerance, errors.New("within clause requires an asof join"))
+				return append(seq, badOp())
+			}
+			tolerance = a.semExpr(o.Tolerance)
+			val, err := kernel.EvalAtCompileTime(a.sctx, tolerance)
+			if err != nil || (val.Type().ID() != super.IDDuration && !super.IsNumber(val.Type().ID())) {
+				a.error(o.Tolerance, errors.New("within clause must be a constant duration or number"))
+				return append(seq, badOp())
+			}
+		}
 		join := &dag.Join{
-			Kind:     "Join",
-			Style:    style,
-			LeftDir:  order.Unknown,
-			LeftKey:  leftKey,
-			RightDir: order.Unknown,
-			RightKey: rightKey,
-			Args:     a.semAssignments(o.Args),
+			Kind:      "Join",
+			Style:     style,
+			LeftDir:   order.Unknown,
+			LeftKey:   leftKey,
+			RightDir:  order.Unknown,
+			RightKey:  rightKey,
+			Tolerance: tolerance,
+			Args:      a.semAssignments(o.Args),
 		}
 		if rightInput != nil {
 			par := &dag.Fork{
@@ -888,6 +1217,10 @@ func (a *analyzer) semOp(o ast.Op, seq dag.Seq) dag.Seq {
 			Exprs: exprs,
 			Body:  body,
 		})
+	case *ast.Sequence:
+		return append(seq, a.semSequence(o))
+	case *ast.Graph:
+		return append(seq, a.semGraph(o)...)
 	case *ast.Sample:
 		e := dag.Expr(&dag.This{Kind: "This"})
 		if o.Expr != nil {
@@ -981,10 +1314,25 @@ func (a *analyzer) semOp(o ast.Op, seq dag.Seq) dag.Seq {
 		if err != nil {
 			poolID, err = a.env.PoolID(a.ctx, o.Pool.Text)
 			if err != nil {
-				a.error(o, err)
+				a.poolError(o.Pool, o.Pool.Text, err)
 				return append(seq, badOp())
 			}
 		}
+		pool, err := a.env.Lake().OpenPool(a.ctx, poolID)
+		if err != nil {
+			a.error(o.Pool, err)
+			return append(seq, badOp())
+		}
+		if pool.Transform != "" {
+			// Apply the pool's transform to the loaded values as
+			// a load request does.
+			transform, err := AnalyzeTransform(a.ctx, pool.Transform)
+			if err != nil {
+				a.error(o.Pool, err)
+				return append(seq, badOp())
+			}
+			seq = append(seq, transform...)
+		}
 		return append(seq, &dag.Load{
 			Kind:    "Load",
 			Pool:    poolID,
@@ -997,6 +1345,13 @@ func (a *analyzer) semOp(o ast.Op, seq dag.Seq) dag.Seq {
 		out := &dag.Output{Kind: "Output", Name: o.Name.Name}
 		a.outputs[out] = o
 		return append(seq, out)
+	case *ast.Into:
+		if _, ok := a.temps[o.Temp.Name]; ok {
+			a.error(o.Temp, fmt.Errorf("temp table %q is written more than once", o.Temp.Name))
+			return append(seq, badOp())
+		}
+		a.temps[o.Temp.Name] = o
+		return append(seq, &dag.Into{Kind: "Into", Temp: o.Temp.Name})
 	}
 	panic(fmt.Errorf("semantic transform: unknown AST operator type: %T", o))
 }
@@ -1144,6 +1499,52 @@ func (a *analyzer) semVars(defs []ast.Def) []dag.Def {
 	return locals
 }
 
+// semAggEmit moves the aggregate functions out of any aggregation output
+// that is an expression over them, e.g., sum(x)/count(), into temporary
+// aggregations and returns those aggregations along with the expressions
+// that compute the outputs from them when each group is emitted.  If every
+// output is an aggregate function, semAggEmit returns aggs unchanged and no
+// expressions.
+func (a *analyzer) semAggEmit(asts []ast.Assignment, aggs []dag.Assignment) ([]dag.Assignment, []dag.Assignment) {
+	if !slices.ContainsFunc(aggs, func(assign dag.Assignment) bool {
+		_, ok := assign.RHS.(*dag.Agg)
+		return !ok
+	}) {
+		return aggs, nil
+	}
+	prefix := field.Path{"$agg"}
+	var funcs aggfuncs
+	var out, emit []dag.Assignment
+	for k, assign := range aggs {
+		if _, ok := assign.RHS.(*dag.Agg); ok {
+			out = append(out, assign)
+			if this, ok := assign.LHS.(*dag.This); ok {
+				emit = append(emit, dag.Assignment{Kind: "Assignment", LHS: assign.LHS, RHS: &dag.This{Kind: "This", Path: this.Path}})
+			}
+			continue
+		}
+		n := len(funcs)
+		rhs, err := funcs.substPath(assign.RHS, prefix)
+		if err != nil {
+			a.error(asts[k].RHS, err)
+			continue
+		}
+		if n == len(funcs) {
+			a.error(asts[k].RHS, errors.New("aggregate output expression must contain an aggregate function"))
+			continue
+		}
+		emit = append(emit, dag.Assignment{Kind: "Assignment", LHS: assign.LHS, RHS: rhs})
+	}
+	for _, f := range funcs {
+		out = append(out, dag.Assignment{
+			Kind: "Assignment",
+			LHS:  &dag.This{Kind: "This", Path: append(slices.Clone(prefix), f.name)},
+			RHS:  f.agg,
+		})
+	}
+	return out, emit
+}
+
 func (a *analyzer) semOpAssignment(p *ast.OpAssignment) dag.Op {
 	var aggs, puts []dag.Assignment
 	for _, astAssign := range p.Assignments {
@@ -1342,3 +1743,10 @@ func (a *analyzer) maybeConvertUserOp(call *ast.Call) dag.Seq {
 func isURL(s string) bool {
 	return strings.HasPrefix(s, "http://") || strings.HasPrefix(s, "https://")
 }
+
+// isFrom returns true if o is a from operator, whose output does not
+// depend on its input.
+func isFrom(o ast.Op) bool {
+	_, ok := o.(*ast.From)
+	return ok
+}
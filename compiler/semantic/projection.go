@@ -3,9 +3,11 @@ package semantic
 import (
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/brimdata/super/compiler/ast"
 	"github.com/brimdata/super/compiler/dag"
+	"github.com/brimdata/super/pkg/field"
 )
 
 // Column of a select statement.  We bookkeep here whether
@@ -78,6 +80,12 @@ func (a aggfuncs) tmp() string {
 }
 
 func (a *aggfuncs) subst(e dag.Expr) (dag.Expr, error) {
+	return a.substPath(e, field.Path{"in"})
+}
+
+// substPath is like subst but refers to each temp column by its name
+// appended to prefix.
+func (a *aggfuncs) substPath(e dag.Expr, prefix field.Path) (dag.Expr, error) {
 	var err error
 	switch e := e.(type) {
 	case nil:
@@ -89,17 +97,17 @@ func (a *aggfuncs) subst(e dag.Expr) (dag.Expr, error) {
 		// the generated aggregate operator.
 		tmp := a.tmp()
 		*a = append(*a, namedAgg{name: tmp, agg: e})
-		return &dag.This{Kind: "This", Path: []string{"in", tmp}}, nil
+		return &dag.This{Kind: "This", Path: append(slices.Clone(prefix), tmp)}, nil
 	case *dag.ArrayExpr:
 		for _, elem := range e.Elems {
 			switch elem := elem.(type) {
 			case *dag.Spread:
-				elem.Expr, err = a.subst(elem.Expr)
+				elem.Expr, err = a.substPath(elem.Expr, prefix)
 				if err != nil {
 					return nil, err
 				}
 			case *dag.VectorValue:
-				elem.Expr, err = a.subst(elem.Expr)
+				elem.Expr, err = a.substPath(elem.Expr, prefix)
 				if err != nil {
 					return nil, err
 				}
@@ -108,66 +116,66 @@ func (a *aggfuncs) subst(e dag.Expr) (dag.Expr, error) {
 			}
 		}
 	case *dag.BinaryExpr:
-		e.LHS, err = a.subst(e.LHS)
+		e.LHS, err = a.substPath(e.LHS, prefix)
 		if err != nil {
 			return nil, err
 		}
-		e.RHS, err = a.subst(e.RHS)
+		e.RHS, err = a.substPath(e.RHS, prefix)
 		if err != nil {
 			return nil, err
 		}
 	case *dag.Call:
 		for k, arg := range e.Args {
-			e.Args[k], err = a.subst(arg)
+			e.Args[k], err = a.substPath(arg, prefix)
 			if err != nil {
 				return nil, err
 			}
 		}
 	case *dag.Conditional:
-		e.Cond, err = a.subst(e.Cond)
+		e.Cond, err = a.substPath(e.Cond, prefix)
 		if err != nil {
 			return nil, err
 		}
-		e.Then, err = a.subst(e.Then)
+		e.Then, err = a.substPath(e.Then, prefix)
 		if err != nil {
 			return nil, err
 		}
-		e.Else, err = a.subst(e.Else)
+		e.Else, err = a.substPath(e.Else, prefix)
 		if err != nil {
 			return nil, err
 		}
 	case *dag.Dot:
-		e.LHS, err = a.subst(e.LHS)
+		e.LHS, err = a.substPath(e.LHS, prefix)
 		if err != nil {
 			return nil, err
 		}
 	case *dag.IndexExpr:
-		e.Expr, err = a.subst(e.Expr)
+		e.Expr, err = a.substPath(e.Expr, prefix)
 		if err != nil {
 			return nil, err
 		}
-		e.Index, err = a.subst(e.Index)
+		e.Index, err = a.substPath(e.Index, prefix)
 		if err != nil {
 			return nil, err
 		}
 	case *dag.IsNullExpr:
-		e.Expr, err = a.subst(e.Expr)
+		e.Expr, err = a.substPath(e.Expr, prefix)
 		if err != nil {
 			return nil, err
 		}
 	case *dag.Literal:
 	case *dag.MapCall:
-		e.Expr, err = a.subst(e.Expr)
+		e.Expr, err = a.substPath(e.Expr, prefix)
 		if err != nil {
 			return nil, err
 		}
 	case *dag.MapExpr:
 		for _, ent := range e.Entries {
-			ent.Key, err = a.subst(ent.Key)
+			ent.Key, err = a.substPath(ent.Key, prefix)
 			if err != nil {
 				return nil, err
 			}
-			ent.Value, err = a.subst(ent.Value)
+			ent.Value, err = a.substPath(ent.Value, prefix)
 			if err != nil {
 				return nil, err
 			}
@@ -178,12 +186,12 @@ func (a *aggfuncs) subst(e dag.Expr) (dag.Expr, error) {
 		for _, elem := range e.Elems {
 			switch elem := elem.(type) {
 			case *dag.Field:
-				elem.Value, err = a.subst(elem.Value)
+				elem.Value, err = a.substPath(elem.Value, prefix)
 				if err != nil {
 					return nil, err
 				}
 			case *dag.Spread:
-				elem.Expr, err = a.subst(elem.Expr)
+				elem.Expr, err = a.substPath(elem.Expr, prefix)
 				if err != nil {
 					return nil, err
 				}
@@ -192,17 +200,17 @@ func (a *aggfuncs) subst(e dag.Expr) (dag.Expr, error) {
 			}
 		}
 	case *dag.RegexpMatch:
-		e.Expr, err = a.subst(e.Expr)
+		e.Expr, err = a.substPath(e.Expr, prefix)
 		if err != nil {
 			return nil, err
 		}
 	case *dag.RegexpSearch:
-		e.Expr, err = a.subst(e.Expr)
+		e.Expr, err = a.substPath(e.Expr, prefix)
 		if err != nil {
 			return nil, err
 		}
 	case *dag.Search:
-		e.Expr, err = a.subst(e.Expr)
+		e.Expr, err = a.substPath(e.Expr, prefix)
 		if err != nil {
 			return nil, err
 		}
@@ -210,12 +218,12 @@ func (a *aggfuncs) subst(e dag.Expr) (dag.Expr, error) {
 		for _, elem := range e.Elems {
 			switch elem := elem.(type) {
 			case *dag.Spread:
-				elem.Expr, err = a.subst(elem.Expr)
+				elem.Expr, err = a.substPath(elem.Expr, prefix)
 				if err != nil {
 					return nil, err
 				}
 			case *dag.VectorValue:
-				elem.Expr, err = a.subst(elem.Expr)
+				elem.Expr, err = a.substPath(elem.Expr, prefix)
 				if err != nil {
 					return nil, err
 				}
@@ -224,21 +232,21 @@ func (a *aggfuncs) subst(e dag.Expr) (dag.Expr, error) {
 			}
 		}
 	case *dag.SliceExpr:
-		e.Expr, err = a.subst(e.Expr)
+		e.Expr, err = a.substPath(e.Expr, prefix)
 		if err != nil {
 			return nil, err
 		}
-		e.From, err = a.subst(e.From)
+		e.From, err = a.substPath(e.From, prefix)
 		if err != nil {
 			return nil, err
 		}
-		e.To, err = a.subst(e.To)
+		e.To, err = a.substPath(e.To, prefix)
 		if err != nil {
 			return nil, err
 		}
 	case *dag.This:
 	case *dag.UnaryExpr:
-		e.Operand, err = a.subst(e.Operand)
+		e.Operand, err = a.substPath(e.Operand, prefix)
 		if err != nil {
 			return nil, err
 		}
@@ -467,7 +467,7 @@ func idsToStrings(ids []*ast.ID) []string {
 
 func isSQLOp(op ast.Op) bool {
 	switch op.(type) {
-	case *ast.Select, *ast.SQLLimitOffset, *ast.OrderBy, *ast.SQLPipe, *ast.SQLJoin, *ast.Values:
+	case *ast.Select, *ast.SQLLimitOffset, *ast.OrderBy, *ast.SQLPipe, *ast.SQLJoin, *ast.CrossJoin, *ast.Values:
 		return true
 	}
 	return false
@@ -483,6 +483,8 @@ func (a *analyzer) semSQLOp(op ast.Op, seq dag.Seq) (dag.Seq, schema) {
 		return a.semValues(op, seq)
 	case *ast.SQLJoin:
 		return a.semSQLJoin(op, seq)
+	case *ast.CrossJoin:
+		return a.semCrossJoin(op, seq)
 	case *ast.OrderBy:
 		out, schema := a.semSQLOp(op.Op, seq)
 		var exprs []dag.SortExpr
@@ -529,11 +531,7 @@ func (a *analyzer) semSQLJoin(join *ast.SQLJoin, seq dag.Seq) (dag.Seq, schema)
 		// but for now we flag an error.
 		a.error(join, errors.New("SQL join cannot inherit data from pipeline parent"))
 	}
-	leftSeq, leftSchema := a.semFromElem(join.Left, nil)
-	leftSeq = yieldExpr(wrapThis("left"), leftSeq)
-	rightSeq, rightSchema := a.semFromElem(join.Right, nil)
-	rightSeq = yieldExpr(wrapThis("right"), rightSeq)
-	sch := &joinSchema{left: leftSchema, right: rightSchema}
+	leftSeq, rightSeq, sch := a.semSQLJoinInputs(join.Left, join.Right)
 	leftKey, rightKey, err := a.semSQLJoinCond(join.Cond, sch)
 	if err != nil {
 		// Join expression errors are already logged so suppress further notice.
@@ -542,6 +540,26 @@ func (a *analyzer) semSQLJoin(join *ast.SQLJoin, seq dag.Seq) (dag.Seq, schema)
 		}
 		return append(seq, badOp()), badSchema()
 	}
+	return sqlJoinSeq(join.Style, leftSeq, rightSeq, leftKey, rightKey), sch
+}
+
+func (a *analyzer) semCrossJoin(join *ast.CrossJoin, seq dag.Seq) (dag.Seq, schema) {
+	if len(seq) > 0 {
+		a.error(join, errors.New("SQL join cannot inherit data from pipeline parent"))
+	}
+	leftSeq, rightSeq, sch := a.semSQLJoinInputs(join.Left, join.Right)
+	return sqlJoinSeq("cross", leftSeq, rightSeq, nil, nil), sch
+}
+
+func (a *analyzer) semSQLJoinInputs(left, right *ast.FromElem) (dag.Seq, dag.Seq, *joinSchema) {
+	leftSeq, leftSchema := a.semFromElem(left, nil)
+	leftSeq = yieldExpr(wrapThis("left"), leftSeq)
+	rightSeq, rightSchema := a.semFromElem(right, nil)
+	rightSeq = yieldExpr(wrapThis("right"), rightSeq)
+	return leftSeq, rightSeq, &joinSchema{left: leftSchema, right: rightSchema}
+}
+
+func sqlJoinSeq(style string, leftSeq, rightSeq dag.Seq, leftKey, rightKey dag.Expr) dag.Seq {
 	assignment := dag.Assignment{
 		Kind: "Assignment",
 		LHS:  pathOf("right"),
@@ -553,14 +571,14 @@ func (a *analyzer) semSQLJoin(join *ast.SQLJoin, seq dag.Seq) (dag.Seq, schema)
 	}
 	dagJoin := &dag.Join{
 		Kind:     "Join",
-		Style:    join.Style,
+		Style:    style,
 		LeftDir:  order.Unknown,
 		LeftKey:  leftKey,
 		RightDir: order.Unknown,
 		RightKey: rightKey,
 		Args:     []dag.Assignment{assignment},
 	}
-	return append(append(leftSeq, par), dagJoin), sch
+	return append(append(leftSeq, par), dagJoin)
 }
 
 var badJoinCond = errors.New("bad join condition")
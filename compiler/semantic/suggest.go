@@ -0,0 +1,80 @@
+package semantic
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/agnivade/levenshtein"
+	"github.com/brimdata/super/compiler/ast"
+	"github.com/brimdata/super/compiler/srcfiles"
+	"github.com/brimdata/super/lake/pools"
+	"github.com/brimdata/super/runtime/sam/expr/function"
+)
+
+// aggNames and shaperNames are the names of the functions handled by the
+// analyzer rather than by function.New.
+var (
+	aggNames    = []string{"and", "any", "approx_count_distinct", "approx_histogram", "approx_top", "avg", "collect", "collect_map", "count", "dcount", "first", "fuse", "histogram", "increase", "last", "mad", "max", "median", "min", "or", "percentile", "rate", "sum", "time_weighted_avg", "union", "unique_rate"}
+	shaperNames = []string{"cast", "crop", "fill", "fit", "order", "shape"}
+)
+
+// suggest returns a hint naming the candidate closest in spelling to name
+// or an empty string if no candidate is close enough to be a likely
+// misspelling of name.
+func suggest(name string, candidates []string) string {
+	lower := strings.ToLower(name)
+	best, bestDist := "", (len(name)+2)/3
+	for _, c := range candidates {
+		if c == name {
+			continue
+		}
+		d := levenshtein.ComputeDistance(lower, strings.ToLower(c))
+		if d > bestDist {
+			continue
+		}
+		// Among equally distant candidates, prefer the one whose
+		// length is closest to that of name (e.g., a transposition).
+		if best == "" || d < bestDist || lengthDiff(c, name) < lengthDiff(best, name) {
+			best, bestDist = c, d
+		}
+	}
+	if best == "" {
+		return ""
+	}
+	return fmt.Sprintf("did you mean %q?", best)
+}
+
+func lengthDiff(a, b string) int {
+	return max(len(a)-len(b), len(b)-len(a))
+}
+
+func (a *analyzer) funcError(call *ast.Call, err error) {
+	if !errors.Is(err, function.ErrNoSuchFunction) {
+		a.error(call, err)
+		return
+	}
+	candidates := append(function.Names(), aggNames...)
+	candidates = append(candidates, shaperNames...)
+	candidates = append(candidates, "map")
+	a.files.AddError(srcfiles.CodeUnknownFunction, err.Error(), call.Pos(), call.End()).Hint = suggest(call.Name.Name, candidates)
+}
+
+func (a *analyzer) poolError(n ast.Node, name string, err error) {
+	if !errors.Is(err, pools.ErrNotFound) || a.env.Lake() == nil {
+		a.error(n, err)
+		return
+	}
+	var candidates []string
+	if configs, err := a.env.Lake().ListPools(a.ctx); err == nil {
+		for _, p := range configs {
+			candidates = append(candidates, p.Name)
+		}
+	}
+	if configs, err := a.env.Lake().ListExternals(a.ctx); err == nil {
+		for _, e := range configs {
+			candidates = append(candidates, e.Name)
+		}
+	}
+	a.files.AddError(srcfiles.CodeUnknownPool, err.Error(), n.Pos(), n.End()).Hint = suggest(name, candidates)
+}
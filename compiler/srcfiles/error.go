@@ -8,9 +8,16 @@ import (
 // ErrList is a list of Errors.
 type ErrorList []*Error
 
-// Append appends an Error to e.
-func (e *ErrorList) Append(list *List, msg string, pos, end int) {
-	*e = append(*e, &Error{msg, pos, end, list})
+// Append appends an Error to e and returns it.
+func (e *ErrorList) Append(list *List, code, msg string, pos, end int) *Error {
+	err := &Error{Msg: msg, Code: code, Pos: pos, End: end, list: list}
+	if pos >= 0 {
+		file := list.FileOf(pos)
+		err.Span = &Span{Start: file.Position(pos), End: file.Position(end)}
+		err.Span.File = file.Name
+	}
+	*e = append(*e, err)
+	return err
 }
 
 // Bind takes errors that were created elsewhere (e.g., the service) using
@@ -33,15 +40,40 @@ func (e ErrorList) Error() string {
 	return b.String()
 }
 
+// Error codes identify the kind of an Error.
+const (
+	CodeParse           = "parse"
+	CodeSemantic        = "semantic"
+	CodeUnknownField    = "unknown-field"
+	CodeUnknownFunction = "unknown-function"
+	CodeUnknownPool     = "unknown-pool"
+)
+
 type Error struct {
 	Msg  string
+	Code string
 	Pos  int
 	End  int
+	// Span locates Pos and End within the file containing them.  It is
+	// nil if Pos is not valid.
+	Span *Span `json:",omitempty"`
+	// Hint is an optional suggestion for fixing the error, e.g., the name
+	// of a function that is spelled like a misspelled one.
+	Hint string `json:",omitempty"`
 	list *List
 }
 
+type Span struct {
+	File  string   `json:"file,omitempty"`
+	Start Position `json:"start"`
+	End   Position `json:"end"`
+}
+
 func (e *Error) Error() string {
 	if e.list == nil {
+		if e.Hint != "" {
+			return e.Msg + " (" + e.Hint + ")"
+		}
 		return e.Msg
 	}
 	file := e.list.FileOf(e.Pos)
@@ -59,6 +91,9 @@ func (e *Error) Error() string {
 	} else {
 		formatPointError(&b, start)
 	}
+	if e.Hint != "" {
+		fmt.Fprintf(&b, "\n%s", e.Hint)
+	}
 	return b.String()
 }
 
@@ -12,8 +12,10 @@ type List struct {
 	errors ErrorList
 }
 
-func (l *List) AddError(msg string, pos, end int) {
-	l.errors.Append(l, msg, pos, end)
+// AddError adds an error with the indicated code to l and returns it so the
+// caller may add a hint.
+func (l *List) AddError(code, msg string, pos, end int) *Error {
+	return l.errors.Append(l, code, msg, pos, end)
 }
 
 func (l *List) Error() error {
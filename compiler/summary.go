@@ -0,0 +1,177 @@
+package compiler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/compiler/dag"
+	"github.com/brimdata/super/compiler/optimizer"
+	"github.com/brimdata/super/compiler/semantic"
+	"github.com/brimdata/super/lake"
+	"github.com/brimdata/super/lake/data"
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/exec"
+	"github.com/brimdata/super/zbuf"
+	"github.com/brimdata/super/zio"
+	"github.com/brimdata/super/zio/bsupio"
+)
+
+// CheckSummaries returns an error if any of the summaries is not a
+// single aggregate operator.
+func CheckSummaries(ctx context.Context, summaries []string) error {
+	for _, s := range summaries {
+		if _, err := semantic.AnalyzeAggregate(ctx, s); err != nil {
+			return fmt.Errorf("invalid summary: %w", err)
+		}
+	}
+	return nil
+}
+
+// Summarize computes the partial results of each of the pool's summary
+// aggregations over each of objects, stores them alongside the objects, and
+// records the summaries in the objects' metadata so the optimizer can
+// answer matching aggregations without scanning the objects' data.
+func Summarize(ctx context.Context, pool *lake.Pool, objects []*data.Object) error {
+	for k, text := range pool.Summaries {
+		agg, err := semantic.AnalyzeAggregate(ctx, text)
+		if err != nil {
+			return err
+		}
+		partials := *agg
+		partials.PartialsOut = true
+		seq := dag.Seq{
+			&dag.DefaultScan{Kind: "DefaultScan"},
+			&partials,
+			&dag.Output{Kind: "Output", Name: "main"},
+		}
+		for _, o := range objects {
+			if err := summarizeObject(ctx, pool, o, k, seq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, o := range objects {
+		o.Summaries = slices.Clone(pool.Summaries)
+	}
+	return nil
+}
+
+func summarizeObject(ctx context.Context, pool *lake.Pool, o *data.Object, k int, seq dag.Seq) error {
+	engine := pool.Storage()
+	in, err := engine.Get(ctx, o.SequenceURI(pool.DataPath))
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	sctx := super.NewContext()
+	r := bsupio.NewReader(sctx, in)
+	defer r.Close()
+	rctx := runtime.NewContext(ctx, sctx)
+	defer rctx.Cancel()
+	outputs, _, err := Build(rctx, seq, exec.NewEnvironment(nil, nil), []zio.Reader{r})
+	if err != nil {
+		return err
+	}
+	out, err := engine.Put(ctx, data.SummaryURI(pool.DataPath, o.ID, k))
+	if err != nil {
+		return err
+	}
+	w := bsupio.NewWriter(out)
+	if err := zbuf.CopyPuller(w, outputs["main"]); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+// useSummaries rewrites each pool scan followed directly by an aggregation
+// matching one of the pool's summaries into a scan of the summary's partial
+// results when the summary has been computed for every object in the scan.
+func useSummaries(ctx context.Context, seq dag.Seq, env *exec.Environment) (dag.Seq, error) {
+	if env == nil || env.Lake() == nil {
+		return seq, nil
+	}
+	lk := env.Lake()
+	var err error
+	seq = optimizer.Walk(seq, func(seq dag.Seq) dag.Seq {
+		if err != nil || len(seq) < 2 {
+			return seq
+		}
+		scan, ok := seq[0].(*dag.PoolScan)
+		if !ok {
+			return seq
+		}
+		agg, ok := seq[1].(*dag.Aggregate)
+		if !ok || agg.PartialsIn || agg.PartialsOut {
+			return seq
+		}
+		var summary *dag.SummaryScan
+		summary, err = summaryScan(ctx, lk, scan, agg)
+		if summary == nil {
+			return seq
+		}
+		partials := *agg
+		partials.PartialsIn = true
+		return append(dag.Seq{summary, &partials}, seq[2:]...)
+	})
+	return seq, err
+}
+
+func summaryScan(ctx context.Context, lk *lake.Root, scan *dag.PoolScan, agg *dag.Aggregate) (*dag.SummaryScan, error) {
+	pool, err := lk.OpenPool(ctx, scan.ID)
+	if err != nil || len(pool.Summaries) == 0 {
+		return nil, err
+	}
+	snap, err := pool.Snapshot(ctx, scan.Commit)
+	if err != nil {
+		return nil, err
+	}
+	objects := snap.SelectAll()
+	if len(objects) == 0 {
+		return nil, nil
+	}
+	for k, text := range pool.Summaries {
+		summary, err := semantic.AnalyzeAggregate(ctx, text)
+		if err != nil {
+			return nil, err
+		}
+		if !sameAggregation(agg, summary) || !hasSummary(objects, k, text) {
+			continue
+		}
+		return &dag.SummaryScan{
+			Kind:    "SummaryScan",
+			Pool:    scan.ID,
+			Commit:  scan.Commit,
+			Summary: k,
+		}, nil
+	}
+	return nil, nil
+}
+
+// hasSummary returns true if the kth summary, whose text is text, has been
+// computed for every one of objects.
+func hasSummary(objects []*data.Object, k int, text string) bool {
+	for _, o := range objects {
+		if !o.HasSummary(k, text) {
+			return false
+		}
+	}
+	return true
+}
+
+func sameAggregation(a, b *dag.Aggregate) bool {
+	return a.Limit == b.Limit && sameJSON(a.Keys, b.Keys) && sameJSON(a.Aggs, b.Aggs)
+}
+
+func sameJSON(a, b any) bool {
+	ja, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	jb, err := json.Marshal(b)
+	return err == nil && bytes.Equal(ja, jb)
+}
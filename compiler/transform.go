@@ -0,0 +1,59 @@
+package compiler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/compiler/dag"
+	"github.com/brimdata/super/compiler/semantic"
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/exec"
+	"github.com/brimdata/super/zbuf"
+	"github.com/brimdata/super/zio"
+)
+
+// CheckTransform returns an error if transform is neither empty nor a query
+// that reads only the values loaded into a pool.
+func CheckTransform(ctx context.Context, transform string) error {
+	if transform == "" {
+		return nil
+	}
+	if _, err := semantic.AnalyzeTransform(ctx, transform); err != nil {
+		return fmt.Errorf("invalid transform: %w", err)
+	}
+	return nil
+}
+
+// Transform returns a reader of the values read from r as transformed by
+// the query text transform.  The caller must close the returned reader.
+func Transform(ctx context.Context, sctx *super.Context, transform string, r zio.Reader) (zio.ReadCloser, error) {
+	body, err := semantic.AnalyzeTransform(ctx, transform)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transform: %w", err)
+	}
+	seq := append(dag.Seq{&dag.DefaultScan{Kind: "DefaultScan"}}, body...)
+	seq = append(seq, &dag.Output{Kind: "Output", Name: "main"})
+	env := exec.NewEnvironment(nil, nil)
+	rctx := runtime.NewContext(ctx, sctx)
+	if seq, err = Optimize(rctx, seq, env, 0); err != nil {
+		rctx.Cancel()
+		return nil, err
+	}
+	outputs, _, err := Build(rctx, seq, env, []zio.Reader{r})
+	if err != nil {
+		rctx.Cancel()
+		return nil, err
+	}
+	return &transformReader{zbuf.PullerReader(outputs["main"]), rctx}, nil
+}
+
+type transformReader struct {
+	zio.Reader
+	rctx *runtime.Context
+}
+
+func (t *transformReader) Close() error {
+	t.rctx.Cancel()
+	return nil
+}
@@ -4,6 +4,8 @@ import (
 	"io"
 
 	"github.com/brimdata/super"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/zcode"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -27,16 +29,44 @@ func NewDynamicEncoder() *DynamicEncoder {
 // We track the types seen first-come, first-served and the
 // CSUP metadata structure follows accordingly.
 func (d *DynamicEncoder) Write(val super.Value) {
-	typ := val.Type()
+	d.write(d.lookup(val.Type()), val.Bytes())
+}
+
+// WriteVector writes each value in vec to the encoder without materializing
+// the values as super.Values.  Type lookups are done once per vector
+// unless vec is a vector.Dynamic.
+func (d *DynamicEncoder) WriteVector(vec vector.Any) {
+	var b zcode.Builder
+	dyn, _ := vec.(*vector.Dynamic)
+	var tag uint32
+	if dyn == nil {
+		tag = d.lookup(vec.Type())
+	}
+	n := vec.Len()
+	for slot := uint32(0); slot < n; slot++ {
+		if dyn != nil {
+			tag = d.lookup(dyn.TypeOf(slot))
+		}
+		b.Truncate()
+		vec.Serialize(&b, slot)
+		d.write(tag, b.Bytes().Body())
+	}
+}
+
+func (d *DynamicEncoder) lookup(typ super.Type) uint32 {
 	tag, ok := d.which[typ]
 	if !ok {
 		tag = uint32(len(d.values))
 		d.values = append(d.values, NewEncoder(typ))
 		d.which[typ] = tag
 	}
+	return tag
+}
+
+func (d *DynamicEncoder) write(tag uint32, body zcode.Bytes) {
 	d.tags.Write(tag)
 	d.len++
-	d.values[tag].Write(val.Bytes())
+	d.values[tag].Write(body)
 }
 
 func (d *DynamicEncoder) Encode() (ID, uint64, error) {
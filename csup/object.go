@@ -73,6 +73,10 @@ func (o *Object) Context() *Context {
 	return o.cctx
 }
 
+func (o *Object) Header() Header {
+	return o.header
+}
+
 func (o *Object) Root() ID {
 	return ID(o.header.Root)
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/sup"
+	"github.com/brimdata/super/vector"
 	"github.com/brimdata/super/zio"
 	"github.com/brimdata/super/zio/bsupio"
 )
@@ -45,6 +46,18 @@ func (w *Writer) Write(val super.Value) error {
 	return nil
 }
 
+// WriteVector encodes the values of vec directly into the current object,
+// which lets the vector runtime produce CSUP output without materializing
+// its results.  An object may exceed the target size by up to the length
+// of vec.
+func (w *Writer) WriteVector(vec vector.Any) error {
+	w.dynamic.WriteVector(vec)
+	if w.dynamic.len >= maxObjectSize {
+		return w.finalizeObject()
+	}
+	return nil
+}
+
 func (w *Writer) finalizeObject() error {
 	root, dataSize, err := w.dynamic.Encode()
 	if err != nil {
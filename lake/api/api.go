@@ -9,7 +9,9 @@ import (
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/api"
 	"github.com/brimdata/super/api/client"
+	"github.com/brimdata/super/compiler"
 	"github.com/brimdata/super/lake"
+	"github.com/brimdata/super/lake/commits"
 	"github.com/brimdata/super/lake/pools"
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/sup"
@@ -24,9 +26,11 @@ type Interface interface {
 	Query(ctx context.Context, src string, srcfiles ...string) (zbuf.Scanner, error)
 	PoolID(ctx context.Context, poolName string) (ksuid.KSUID, error)
 	CommitObject(ctx context.Context, poolID ksuid.KSUID, branchName string) (ksuid.KSUID, error)
-	CreatePool(context.Context, string, order.SortKeys, int, int64) (ksuid.KSUID, error)
+	CreatePool(context.Context, string, order.SortKeys, int, int64, []string, pools.Defaults, string, []pools.Mask, pools.Partition) (ksuid.KSUID, error)
 	RemovePool(context.Context, ksuid.KSUID) error
 	RenamePool(context.Context, ksuid.KSUID, string) error
+	CreateExternal(ctx context.Context, name, pattern, format string) (ksuid.KSUID, error)
+	RemoveExternal(ctx context.Context, name string) error
 	CreateBranch(ctx context.Context, pool ksuid.KSUID, name string, parent ksuid.KSUID) error
 	RemoveBranch(ctx context.Context, pool ksuid.KSUID, branchName string) error
 	MergeBranch(ctx context.Context, pool ksuid.KSUID, childBranch, parentBranch string, message api.CommitMessage) (ksuid.KSUID, error)
@@ -168,6 +172,35 @@ func LookupBranchByID(ctx context.Context, api Interface, id ksuid.KSUID) (*lake
 	}
 }
 
+// Provenance returns the commit provenance described by message or nil if
+// message carries none.
+func Provenance(message api.CommitMessage) *commits.Provenance {
+	prov := &commits.Provenance{
+		Sources: message.Sources,
+		Loader:  message.Loader,
+		Labels:  message.Labels,
+		Key:     message.Key,
+	}
+	if prov.IsZero() {
+		return nil
+	}
+	return prov
+}
+
+// TransformLoad returns a reader of the values of r transformed by the
+// transform of message or, if message has none, the transform of pool.
+// The caller must close the returned reader.
+func TransformLoad(ctx context.Context, sctx *super.Context, pool *lake.Pool, message api.CommitMessage, r zio.Reader) (zio.ReadCloser, error) {
+	transform := message.Transform
+	if transform == "" {
+		transform = pool.Transform
+	}
+	if transform == "" {
+		return zio.NopReadCloser(r), nil
+	}
+	return compiler.Transform(ctx, sctx, transform, r)
+}
+
 func idToHex(id ksuid.KSUID) string {
 	return hex.EncodeToString(id.Bytes())
 }
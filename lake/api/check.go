@@ -0,0 +1,30 @@
+package api
+
+import (
+	"context"
+
+	"github.com/brimdata/super/compiler/parser"
+	"github.com/brimdata/super/compiler/semantic"
+	"github.com/brimdata/super/lakeparse"
+)
+
+// FieldSampleSize is the number of values of a pool sampled by CheckFields.
+var FieldSampleSize = 1000
+
+// CheckFields returns an error for each field referenced by the query in p
+// that is missing from a sample of the values of the pool it reads.  Each
+// error suggests a similarly spelled field from the sample, if any.  Queries
+// not reading a single pool and pools whose sample has no records are not
+// checked.
+func CheckFields(ctx context.Context, api Interface, p *parser.AST) error {
+	pool := semantic.SourcePool(p)
+	if pool == "" {
+		return nil
+	}
+	commitish := &lakeparse.Commitish{Pool: pool, Branch: "main"}
+	fields, err := SampleFields(ctx, api, commitish, FieldSampleSize)
+	if err != nil || len(fields) == 0 {
+		return err
+	}
+	return semantic.CheckFields(p, fields)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/brimdata/super/compiler"
 	"github.com/brimdata/super/compiler/parser"
 	"github.com/brimdata/super/lake"
+	"github.com/brimdata/super/lake/pools"
 	"github.com/brimdata/super/lakeparse"
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/pkg/storage"
@@ -61,11 +62,17 @@ func (l *local) Root() *lake.Root {
 	return l.root
 }
 
-func (l *local) CreatePool(ctx context.Context, name string, sortKeys order.SortKeys, seekStride int, thresh int64) (ksuid.KSUID, error) {
+func (l *local) CreatePool(ctx context.Context, name string, sortKeys order.SortKeys, seekStride int, thresh int64, summaries []string, defaults pools.Defaults, transform string, masks []pools.Mask, partition pools.Partition) (ksuid.KSUID, error) {
 	if name == "" {
 		return ksuid.Nil, errors.New("no pool name provided")
 	}
-	pool, err := l.root.CreatePool(ctx, name, sortKeys, seekStride, thresh)
+	if err := compiler.CheckSummaries(ctx, summaries); err != nil {
+		return ksuid.Nil, err
+	}
+	if err := compiler.CheckTransform(ctx, transform); err != nil {
+		return ksuid.Nil, err
+	}
+	pool, err := l.root.CreatePool(ctx, name, sortKeys, seekStride, thresh, summaries, defaults, transform, masks, partition)
 	if err != nil {
 		return ksuid.Nil, err
 	}
@@ -84,6 +91,18 @@ func (l *local) RenamePool(ctx context.Context, id ksuid.KSUID, name string) err
 	return l.root.RenamePool(ctx, id, name)
 }
 
+func (l *local) CreateExternal(ctx context.Context, name, pattern, format string) (ksuid.KSUID, error) {
+	config, err := l.root.CreateExternal(ctx, name, pattern, format)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	return config.ID, nil
+}
+
+func (l *local) RemoveExternal(ctx context.Context, name string) error {
+	return l.root.RemoveExternal(ctx, name)
+}
+
 func (l *local) CreateBranch(ctx context.Context, poolID ksuid.KSUID, name string, parent ksuid.KSUID) error {
 	_, err := l.root.CreateBranch(ctx, poolID, name, parent)
 	return err
@@ -102,7 +121,7 @@ func (l *local) Compact(ctx context.Context, poolID ksuid.KSUID, branchName stri
 	if err != nil {
 		return ksuid.Nil, err
 	}
-	return exec.Compact(ctx, l.root, pool, branchName, objects, writeVectors, commit.Author, commit.Body, commit.Meta)
+	return exec.Compact(ctx, l.root, pool, branchName, objects, writeVectors, compiler.Summarize, commit.Author, commit.Body, commit.Meta)
 }
 
 func (l *local) Query(ctx context.Context, src string, srcfiles ...string) (zbuf.Scanner, error) {
@@ -146,11 +165,20 @@ func (l *local) lookupBranch(ctx context.Context, poolID ksuid.KSUID, branchName
 }
 
 func (l *local) Load(ctx context.Context, ztcx *super.Context, poolID ksuid.KSUID, branchName string, r zio.Reader, message api.CommitMessage) (ksuid.KSUID, error) {
-	_, branch, err := l.lookupBranch(ctx, poolID, branchName)
+	pool, branch, err := l.lookupBranch(ctx, poolID, branchName)
 	if err != nil {
 		return ksuid.Nil, err
 	}
-	return branch.Load(ctx, ztcx, r, message.Author, message.Body, message.Meta)
+	rc, err := TransformLoad(ctx, ztcx, pool, message, r)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	defer rc.Close()
+	load := branch.Load
+	if message.Dedup {
+		load = branch.LoadDedup
+	}
+	return load(ctx, ztcx, rc, message.Author, message.Body, message.Meta, Provenance(message))
 }
 
 func (l *local) Delete(ctx context.Context, poolID ksuid.KSUID, branchName string, ids []ksuid.KSUID, message api.CommitMessage) (ksuid.KSUID, error) {
@@ -10,6 +10,7 @@ import (
 	"github.com/brimdata/super/api/client"
 	"github.com/brimdata/super/api/queryio"
 	"github.com/brimdata/super/lake"
+	"github.com/brimdata/super/lake/pools"
 	"github.com/brimdata/super/lakeparse"
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/pkg/field"
@@ -51,7 +52,7 @@ func (r *remote) CommitObject(ctx context.Context, poolID ksuid.KSUID, branchNam
 	return res.Commit, err
 }
 
-func (r *remote) CreatePool(ctx context.Context, name string, sortKeys order.SortKeys, seekStride int, thresh int64) (ksuid.KSUID, error) {
+func (r *remote) CreatePool(ctx context.Context, name string, sortKeys order.SortKeys, seekStride int, thresh int64, summaries []string, defaults pools.Defaults, transform string, masks []pools.Mask, partition pools.Partition) (ksuid.KSUID, error) {
 	res, err := r.conn.CreatePool(ctx, api.PoolPostRequest{
 		Name: name,
 		SortKeys: api.SortKeys{
@@ -60,6 +61,11 @@ func (r *remote) CreatePool(ctx context.Context, name string, sortKeys order.Sor
 		},
 		SeekStride: seekStride,
 		Thresh:     thresh,
+		Summaries:  summaries,
+		Defaults:   defaults,
+		Transform:  transform,
+		Masks:      masks,
+		Partition:  partition,
 	})
 	if err != nil {
 		return ksuid.Nil, err
@@ -100,6 +106,22 @@ func (r *remote) RenamePool(ctx context.Context, pool ksuid.KSUID, name string)
 	return r.conn.RenamePool(ctx, pool, api.PoolPutRequest{Name: name})
 }
 
+func (r *remote) CreateExternal(ctx context.Context, name, pattern, format string) (ksuid.KSUID, error) {
+	config, err := r.conn.CreateExternal(ctx, api.ExternalPostRequest{
+		Name:    name,
+		Pattern: pattern,
+		Format:  format,
+	})
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	return config.ID, nil
+}
+
+func (r *remote) RemoveExternal(ctx context.Context, name string) error {
+	return r.conn.RemoveExternal(ctx, name)
+}
+
 func (r *remote) Load(ctx context.Context, _ *super.Context, poolID ksuid.KSUID, branchName string, reader zio.Reader, commit api.CommitMessage) (ksuid.KSUID, error) {
 	pr, pw := io.Pipe()
 	go func() {
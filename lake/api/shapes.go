@@ -0,0 +1,220 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/lakeparse"
+	"github.com/brimdata/super/pkg/field"
+)
+
+// ShapeChange describes how the type of a field differs between the values
+// at two commits.  Change is one of "added", "removed", "widened", or
+// "changed".  When a field has more than one type at a commit, From or To is
+// the union of those types.
+type ShapeChange struct {
+	Field  string     `super:"field"`
+	Change string     `super:"change"`
+	From   super.Type `super:"from"`
+	To     super.Type `super:"to"`
+}
+
+// Shapes returns the distinct types of the values at the commit indicated
+// by commitish.
+func Shapes(ctx context.Context, api Interface, sctx *super.Context, commitish *lakeparse.Commitish) ([]super.Type, error) {
+	from, err := commitish.FromSpec("")
+	if err != nil {
+		return nil, err
+	}
+	return shapes(ctx, api, sctx, from)
+}
+
+// SampleShapes is like Shapes but returns only the distinct types of the
+// first n values at the commit indicated by commitish.
+func SampleShapes(ctx context.Context, api Interface, sctx *super.Context, commitish *lakeparse.Commitish, n int) ([]super.Type, error) {
+	from, err := commitish.FromSpec("")
+	if err != nil {
+		return nil, err
+	}
+	return shapes(ctx, api, sctx, fmt.Sprintf("%s | head %d", from, n))
+}
+
+// SampleFields returns the names of the top-level fields of the records
+// among the first n values at the commit indicated by commitish.
+func SampleFields(ctx context.Context, api Interface, commitish *lakeparse.Commitish, n int) ([]string, error) {
+	types, err := SampleShapes(ctx, api, super.NewContext(), commitish, n)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, typ := range types {
+		if rec := super.TypeRecordOf(typ); rec != nil {
+			for _, f := range rec.Fields {
+				if !slices.Contains(names, f.Name) {
+					names = append(names, f.Name)
+				}
+			}
+		}
+	}
+	return names, nil
+}
+
+func shapes(ctx context.Context, api Interface, sctx *super.Context, src string) ([]super.Type, error) {
+	q, err := api.Query(ctx, src+" | yield typeof(this) | distinct this")
+	if err != nil {
+		return nil, err
+	}
+	defer q.Pull(true)
+	var types []super.Type
+	for {
+		batch, err := q.Pull(false)
+		if err != nil {
+			return nil, err
+		}
+		if batch == nil {
+			return types, nil
+		}
+		for _, val := range batch.Values() {
+			typ, err := sctx.LookupByValue(val.Bytes())
+			if err != nil {
+				batch.Unref()
+				return nil, err
+			}
+			types = append(types, typ)
+		}
+		batch.Unref()
+	}
+}
+
+// DiffShapes compares the fields of the types in from with those of the
+// types in to and returns a change for each field whose types differ,
+// sorted by field.  The fields of nested records are compared individually
+// while any other value, including a non-record value at the top level,
+// is compared as a whole.  All types must be from sctx.
+func DiffShapes(sctx *super.Context, from, to []super.Type) []ShapeChange {
+	fromFields := shapeFields(from)
+	toFields := shapeFields(to)
+	var changes []ShapeChange
+	for name, fromTypes := range fromFields {
+		toTypes, ok := toFields[name]
+		if !ok {
+			changes = append(changes, ShapeChange{Field: name, Change: "removed", From: unionOf(sctx, fromTypes)})
+			continue
+		}
+		if sameTypes(fromTypes, toTypes) {
+			continue
+		}
+		change := "changed"
+		if widens(fromTypes, toTypes) {
+			change = "widened"
+		}
+		changes = append(changes, ShapeChange{
+			Field:  name,
+			Change: change,
+			From:   unionOf(sctx, fromTypes),
+			To:     unionOf(sctx, toTypes),
+		})
+	}
+	for name, toTypes := range toFields {
+		if _, ok := fromFields[name]; !ok {
+			changes = append(changes, ShapeChange{Field: name, Change: "added", To: unionOf(sctx, toTypes)})
+		}
+	}
+	slices.SortFunc(changes, func(a, b ShapeChange) int {
+		return strings.Compare(a.Field, b.Field)
+	})
+	return changes
+}
+
+// shapeFields returns a map from the name of each leaf field in types to
+// the distinct types of that field.
+func shapeFields(types []super.Type) map[string][]super.Type {
+	fields := make(map[string][]super.Type)
+	var walk func(field.Path, super.Type)
+	walk = func(path field.Path, typ super.Type) {
+		if rec := super.TypeRecordOf(typ); rec != nil {
+			for _, f := range rec.Fields {
+				walk(append(path[:len(path):len(path)], f.Name), f.Type)
+			}
+			return
+		}
+		name := path.String()
+		if !slices.Contains(fields[name], typ) {
+			fields[name] = append(fields[name], typ)
+		}
+	}
+	for _, typ := range types {
+		walk(nil, typ)
+	}
+	return fields
+}
+
+func sameTypes(a, b []super.Type) bool {
+	return len(a) == len(b) && !slices.ContainsFunc(a, func(typ super.Type) bool {
+		return !slices.Contains(b, typ)
+	})
+}
+
+func unionOf(sctx *super.Context, types []super.Type) super.Type {
+	if len(types) == 1 {
+		return types[0]
+	}
+	return sctx.LookupTypeUnion(slices.Clone(types))
+}
+
+// widens returns true if each of the from types that is no longer present
+// can be represented by one of the to types and each of the to types that
+// is new can represent one of the from types.
+func widens(from, to []super.Type) bool {
+	for _, f := range from {
+		if !slices.Contains(to, f) && !slices.ContainsFunc(to, func(t super.Type) bool { return widensTo(f, t) }) {
+			return false
+		}
+	}
+	for _, t := range to {
+		if !slices.Contains(from, t) && !slices.ContainsFunc(from, func(f super.Type) bool { return widensTo(f, t) }) {
+			return false
+		}
+	}
+	return true
+}
+
+// widensTo returns true if every value of type from can be represented
+// as a value of type to.
+func widensTo(from, to super.Type) bool {
+	from, to = super.TypeUnder(from), super.TypeUnder(to)
+	if from == to || from == super.TypeNull {
+		return true
+	}
+	if union, ok := to.(*super.TypeUnion); ok {
+		return slices.ContainsFunc(union.Types, func(t super.Type) bool { return widensTo(from, t) })
+	}
+	fromID, toID := from.ID(), to.ID()
+	switch {
+	case super.IsUnsigned(fromID) && super.IsUnsigned(toID):
+		return toID > fromID
+	case super.IsUnsigned(fromID) && isInt(toID):
+		return toID-super.IDInt8 > fromID-super.IDUint8
+	case isInt(fromID) && isInt(toID):
+		return toID > fromID
+	case super.IsInteger(fromID) && super.IsFloat(toID):
+		return toID == super.IDFloat64 || toID == super.IDFloat32 && intBits(fromID) <= 16
+	case super.IsFloat(fromID) && super.IsFloat(toID):
+		return toID > fromID
+	}
+	return false
+}
+
+func isInt(id int) bool {
+	return id >= super.IDInt8 && id <= super.IDInt256
+}
+
+func intBits(id int) int {
+	if isInt(id) {
+		return 8 << (id - super.IDInt8)
+	}
+	return 8 << (id - super.IDUint8)
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/rand/v2"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/compiler/parser"
@@ -25,11 +28,16 @@ import (
 const (
 	maxCommitRetries  = 10
 	maxMessageObjects = 10
+	// commitRetryDelay is the initial bound on the random delay before a
+	// commit is retried.  The bound doubles with each retry.
+	commitRetryDelay = 5 * time.Millisecond
 )
 
 var (
 	ErrCommitFailed      = fmt.Errorf("exceeded max update attempts (%d) to branch tip: commit failed", maxCommitRetries)
 	ErrInvalidCommitMeta = errors.New("cannot parse SUP string")
+
+	errDuplicateKey = errors.New("duplicate idempotency key")
 )
 
 type Branch struct {
@@ -47,11 +55,52 @@ func OpenBranch(ctx context.Context, config *branches.Config, engine storage.Eng
 	}, nil
 }
 
-func (b *Branch) Load(ctx context.Context, sctx *super.Context, r zio.Reader, author, message, meta string) (ksuid.KSUID, error) {
+// Load writes the values read from r to new data objects and commits them to
+// the branch.  prov, which may be nil, is recorded in the commit object.
+// If prov has an idempotency key and a commit on the branch already has
+// that key, then Load returns that commit without committing the values.
+func (b *Branch) Load(ctx context.Context, sctx *super.Context, r zio.Reader, author, message, meta string, prov *commits.Provenance) (ksuid.KSUID, error) {
+	return b.load(ctx, sctx, r, author, message, meta, prov, false, false)
+}
+
+// LoadDedup is like Load but records a content hash of the values in the
+// commit and, if a commit on the branch already has that hash, commits
+// none of the values.  Instead, it records a commit that adds no data so
+// that the skipped load appears in the branch's history.
+func (b *Branch) LoadDedup(ctx context.Context, sctx *super.Context, r zio.Reader, author, message, meta string, prov *commits.Provenance) (ksuid.KSUID, error) {
+	return b.load(ctx, sctx, r, author, message, meta, prov, false, true)
+}
+
+// LoadVectors is like Load but also writes the vector (CSUP) form of each
+// new data object and commits the objects and their vectors together so
+// that vectorized queries can scan them as soon as the commit is visible.
+func (b *Branch) LoadVectors(ctx context.Context, sctx *super.Context, r zio.Reader, author, message, meta string) (ksuid.KSUID, error) {
+	return b.load(ctx, sctx, r, author, message, meta, nil, true, false)
+}
+
+func (b *Branch) load(ctx context.Context, sctx *super.Context, r zio.Reader, author, message, meta string, prov *commits.Provenance, vectors, dedup bool) (ksuid.KSUID, error) {
+	var checked ksuid.KSUID
+	if prov != nil && prov.Key != "" {
+		config, err := b.pool.branches.LookupByName(ctx, b.Name)
+		if err != nil {
+			return ksuid.Nil, err
+		}
+		commit, err := b.pool.commits.LookupKey(ctx, config.Commit, ksuid.Nil, prov.Key)
+		if err != nil || commit != ksuid.Nil {
+			return commit, err
+		}
+		checked = config.Commit
+	}
 	w, err := NewWriter(ctx, sctx, b.pool)
 	if err != nil {
 		return ksuid.Nil, err
 	}
+	w.vectors = vectors
+	var hr *hashReader
+	if dedup {
+		hr = newHashReader(r)
+		r = hr
+	}
 	err = zio.CopyWithContext(ctx, w, r)
 	if closeErr := w.Close(); err == nil {
 		err = closeErr
@@ -66,6 +115,14 @@ func (b *Branch) Load(ctx context.Context, sctx *super.Context, r zio.Reader, au
 	if message == "" {
 		message = loadMessage(objects)
 	}
+	if dedup {
+		p := commits.Provenance{}
+		if prov != nil {
+			p = *prov
+		}
+		p.Hash = hr.Sum()
+		prov = &p
+	}
 	appMeta, err := loadMeta(sctx, meta)
 	if err != nil {
 		return ksuid.Nil, err
@@ -74,9 +131,63 @@ func (b *Branch) Load(ctx context.Context, sctx *super.Context, r zio.Reader, au
 	// safe to merge at the tip and there can be no conflicts
 	// with other concurrent writers (except for updating the branch pointer
 	// which is handled by Branch.commit)
-	return b.commit(ctx, func(parent *branches.Config, retries int) (*commits.Object, error) {
-		return commits.NewAddsObject(parent.Commit, retries, author, message, appMeta, objects), nil
+	var duplicate, original, hashChecked ksuid.KSUID
+	commit, err := b.commit(ctx, func(parent *branches.Config, retries int) (*commits.Object, error) {
+		if prov != nil && prov.Key != "" {
+			// Check the commits made since the key was first checked
+			// in case a concurrent load with the same key won the race.
+			var err error
+			duplicate, err = b.pool.commits.LookupKey(ctx, parent.Commit, checked, prov.Key)
+			if err != nil {
+				return nil, err
+			}
+			if duplicate != ksuid.Nil {
+				return nil, errDuplicateKey
+			}
+		}
+		if dedup {
+			// A commit found to have the hash stays in the branch's
+			// history, so only the commits made since the last
+			// check need to be checked on a retry.
+			if original == ksuid.Nil {
+				var err error
+				original, err = b.pool.commits.LookupHash(ctx, parent.Commit, hashChecked, prov.Hash)
+				if err != nil {
+					return nil, err
+				}
+				hashChecked = parent.Commit
+			}
+			if original != ksuid.Nil {
+				message := fmt.Sprintf("skipped load of values already committed by %s\n", original)
+				return commits.NewAddsObject(parent.Commit, retries, author, message, appMeta, prov, nil), nil
+			}
+		}
+		if vectors {
+			return commits.NewAddsWithVectorsObject(parent.Commit, retries, author, message, appMeta, objects), nil
+		}
+		return commits.NewAddsObject(parent.Commit, retries, author, message, appMeta, prov, objects), nil
 	})
+	if err == errDuplicateKey {
+		if err := b.removeObjects(ctx, objects); err != nil {
+			return ksuid.Nil, err
+		}
+		return duplicate, nil
+	}
+	if err == nil && original != ksuid.Nil {
+		err = b.removeObjects(ctx, objects)
+	}
+	return commit, err
+}
+
+// removeObjects removes data objects written by a load that were not
+// committed.
+func (b *Branch) removeObjects(ctx context.Context, objects []data.Object) error {
+	for _, o := range objects {
+		if err := o.Remove(ctx, b.engine, b.pool.DataPath); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func loadMessage(objects []data.Object) string {
@@ -294,15 +405,10 @@ func (b *Branch) mergeInto(ctx context.Context, parent *Branch, author, message
 }
 
 func (b *Branch) buildMergeObject(ctx context.Context, parent *branches.Config, retries int, author, message string) (*commits.Object, error) {
-	childPath, err := b.pool.commits.Path(ctx, b.Commit)
-	if err != nil {
-		return nil, err
-	}
-	parentPath, err := b.pool.commits.Path(ctx, parent.Commit)
+	baseID, err := b.pool.commits.CommonAncestor(ctx, parent.Commit, b.Commit)
 	if err != nil {
 		return nil, err
 	}
-	baseID := commonAncestor(parentPath, childPath)
 	if baseID == ksuid.Nil {
 		//XXX this shouldn't happen because because all of the branches
 		// should live in a single tree.
@@ -339,17 +445,25 @@ func (b *Branch) buildMergeObject(ctx context.Context, parent *branches.Config,
 	return diff.NewCommitObject(parent.Commit, retries, author, message, super.Null), nil
 }
 
-func commonAncestor(a, b []ksuid.KSUID) ksuid.KSUID {
-	m := make(map[ksuid.KSUID]struct{})
-	for _, id := range a {
-		m[id] = struct{}{}
-	}
-	for _, id := range b {
-		if _, ok := m[id]; ok {
-			return id
-		}
+// A ConflictError reports that a commit could not be rebased onto the tip of
+// a branch because it conflicts with commits made to the branch after the
+// commit was started.
+type ConflictError struct {
+	Branch  string
+	Commits []ksuid.KSUID
+	Err     error
+}
+
+func (c *ConflictError) Error() string {
+	ids := make([]string, 0, len(c.Commits))
+	for _, id := range c.Commits {
+		ids = append(ids, id.String())
 	}
-	return ksuid.Nil
+	return fmt.Sprintf("branch %q: commit conflicts with concurrent commit%s %s: %s", c.Branch, plural.Slice(c.Commits, "s"), strings.Join(ids, ", "), c.Err)
+}
+
+func (c *ConflictError) Unwrap() []error {
+	return []error{commits.ErrWriteConflict, c.Err}
 }
 
 type constructor func(parent *branches.Config, retries int) (*commits.Object, error)
@@ -366,14 +480,29 @@ func (b *Branch) commit(ctx context.Context, create constructor) (ksuid.KSUID, e
 	// then moves the branch pointer to the new commit but, using a constraint,
 	// only succeeds when the presumed parent is atomically consistent
 	// with the branch update.  If the contraint, fails will loop a number
-	// of times till it succeeds, or we give up.
+	// of times till it succeeds, or we give up.  Each retry rebuilds the
+	// commit object against the new tip so commits that do not conflict
+	// with the intervening commits succeed while those that do fail
+	// with a ConflictError.
+	var start ksuid.KSUID
 	for retries := range maxCommitRetries {
+		if retries > 0 {
+			if err := commitBackoff(ctx, retries); err != nil {
+				return ksuid.Nil, err
+			}
+		}
 		config, err := b.pool.branches.LookupByName(ctx, b.Name)
 		if err != nil {
 			return ksuid.Nil, err
 		}
+		if retries == 0 {
+			start = config.Commit
+		}
 		object, err := create(config, retries)
 		if err != nil {
+			if config.Commit != start && isConflict(err) {
+				return ksuid.Nil, b.conflict(ctx, start, config.Commit, err)
+			}
 			return ksuid.Nil, err
 		}
 		if err := b.pool.commits.Put(ctx, object); err != nil {
@@ -402,11 +531,45 @@ func (b *Branch) commit(ctx context.Context, create constructor) (ksuid.KSUID, e
 			}
 			return ksuid.Nil, err
 		}
+		b.pool.snapCache.Invalidate(b.pool.ID, b.Name)
 		return object.Commit, nil
 	}
 	return ksuid.Nil, fmt.Errorf("branch %q: %w", b.Name, ErrCommitFailed)
 }
 
+// commitBackoff waits a random time before the indicated retry of a commit
+// so that concurrent writers contending for the tip of a branch spread out
+// their attempts.
+func commitBackoff(ctx context.Context, retries int) error {
+	t := time.NewTimer(rand.N(commitRetryDelay << retries))
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func isConflict(err error) bool {
+	return errors.Is(err, commits.ErrWriteConflict) || errors.Is(err, commits.ErrNotFound) || errors.Is(err, commits.ErrExists)
+}
+
+// conflict returns a ConflictError for err listing the commits between start
+// and tip, i.e., the commits made to the branch while the failed commit was
+// being built.
+func (b *Branch) conflict(ctx context.Context, start, tip ksuid.KSUID, err error) error {
+	path, pathErr := b.pool.commits.PathRange(ctx, tip, start)
+	if pathErr != nil {
+		return pathErr
+	}
+	if n := len(path); n > 0 && path[n-1] == start {
+		path = path[:n-1]
+	}
+	slices.Reverse(path)
+	return &ConflictError{Branch: b.Name, Commits: path, Err: err}
+}
+
 func (b *Branch) LookupTags(ctx context.Context, tags []ksuid.KSUID) ([]ksuid.KSUID, error) {
 	var ids []ksuid.KSUID
 	for _, tag := range tags {
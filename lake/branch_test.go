@@ -0,0 +1,162 @@
+package lake
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/lake/branches"
+	"github.com/brimdata/super/lake/commits"
+	"github.com/brimdata/super/lake/data"
+	"github.com/brimdata/super/lake/pools"
+	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/brimdata/super/zio/supio"
+	"github.com/segmentio/ksuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestBranch(ctx context.Context, t *testing.T) *Branch {
+	root, err := Create(ctx, storage.NewLocalEngine(), zap.NewNop(), storage.MustParseURI(t.TempDir()))
+	require.NoError(t, err)
+	sortKeys := order.SortKeys{order.NewSortKey(order.Asc, field.Path{"x"})}
+	pool, err := root.CreatePool(ctx, "test", sortKeys, data.DefaultSeekStride, data.DefaultThreshold, nil, pools.Defaults{}, "", nil, pools.Partition{})
+	require.NoError(t, err)
+	branch, err := pool.OpenBranchByName(ctx, "main")
+	require.NoError(t, err)
+	return branch
+}
+
+func load(ctx context.Context, t *testing.T, b *Branch, s string) ksuid.KSUID {
+	sctx := super.NewContext()
+	commit, err := b.Load(ctx, sctx, supio.NewReader(sctx, strings.NewReader(s)), "test", "", "", nil)
+	require.NoError(t, err)
+	return commit
+}
+
+func TestBranchCommitConcurrent(t *testing.T) {
+	ctx := context.Background()
+	b := newTestBranch(ctx, t)
+	const N = 20
+	var wg sync.WaitGroup
+	errs := make([]error, N)
+	for i := range N {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sctx := super.NewContext()
+			_, errs[i] = b.Load(ctx, sctx, supio.NewReader(sctx, strings.NewReader("{x:1}")), "test", "", "", nil)
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	config, err := b.pool.LookupBranchByName(ctx, "main")
+	require.NoError(t, err)
+	snap, err := b.pool.commits.Snapshot(ctx, config.Commit)
+	require.NoError(t, err)
+	require.Len(t, snap.SelectAll(), N)
+}
+
+func TestBranchCommitConflict(t *testing.T) {
+	ctx := context.Background()
+	b := newTestBranch(ctx, t)
+	load(ctx, t, b, "{x:1}")
+	config, err := b.pool.LookupBranchByName(ctx, "main")
+	require.NoError(t, err)
+	snap, err := b.pool.commits.Snapshot(ctx, config.Commit)
+	require.NoError(t, err)
+	id := snap.SelectAll()[0].ID
+	var concurrent ksuid.KSUID
+	_, err = b.commit(ctx, func(parent *branches.Config, retries int) (*commits.Object, error) {
+		if retries == 0 {
+			// Delete the object out from under this commit.
+			concurrent, err = b.Delete(ctx, []ksuid.KSUID{id}, "test", "")
+			require.NoError(t, err)
+		}
+		snap, err := b.pool.commits.Snapshot(ctx, parent.Commit)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := snap.Lookup(id); err != nil {
+			return nil, err
+		}
+		return commits.NewDeletesObject(parent.Commit, retries, "test", "", []ksuid.KSUID{id}), nil
+	})
+	var conflict *ConflictError
+	require.True(t, errors.As(err, &conflict))
+	require.Equal(t, []ksuid.KSUID{concurrent}, conflict.Commits)
+	require.ErrorIs(t, err, commits.ErrWriteConflict)
+	require.ErrorIs(t, err, commits.ErrNotFound)
+	// A commit that does not conflict is rebased onto the concurrent commit.
+	var other ksuid.KSUID
+	commit, err := b.commit(ctx, func(parent *branches.Config, retries int) (*commits.Object, error) {
+		if retries == 0 {
+			other = load(ctx, t, b, "{x:2}")
+		}
+		object := data.NewObject()
+		object.Min, object.Max, object.Stats = super.Null, super.Null, super.Null
+		return commits.NewAddsObject(parent.Commit, retries, "test", "", super.Null, nil, []data.Object{object}), nil
+	})
+	require.NoError(t, err)
+	o, err := b.pool.commits.Get(ctx, commit)
+	require.NoError(t, err)
+	require.Equal(t, other, o.Parent)
+}
+
+func TestBranchLoadVectors(t *testing.T) {
+	ctx := context.Background()
+	b := newTestBranch(ctx, t)
+	load(ctx, t, b, "{x:1}")
+	sctx := super.NewContext()
+	_, err := b.LoadVectors(ctx, sctx, supio.NewReader(sctx, strings.NewReader("{x:2}")), "test", "", "")
+	require.NoError(t, err)
+	config, err := b.pool.LookupBranchByName(ctx, "main")
+	require.NoError(t, err)
+	snap, err := b.pool.commits.Snapshot(ctx, config.Commit)
+	require.NoError(t, err)
+	objects := snap.SelectAll()
+	require.Len(t, objects, 2)
+	var vectors int
+	for _, o := range objects {
+		if snap.HasVector(o.ID) {
+			vectors++
+			exists, err := b.pool.engine.Exists(ctx, o.VectorURI(b.pool.DataPath))
+			require.NoError(t, err)
+			require.True(t, exists)
+		}
+	}
+	require.Equal(t, 1, vectors)
+}
+
+func TestPoolAddedObjects(t *testing.T) {
+	ctx := context.Background()
+	b := newTestBranch(ctx, t)
+	first := load(ctx, t, b, "{x:1}")
+	var ids []ksuid.KSUID
+	for _, s := range []string{"{x:2}", "{x:3}"} {
+		o, err := b.pool.commits.Get(ctx, load(ctx, t, b, s))
+		require.NoError(t, err)
+		ids = append(ids, o.Actions[1].(*commits.Add).Object.ID)
+	}
+	// A commit that deletes objects adds no new data.
+	tip, err := b.Delete(ctx, ids[:1], "test", "")
+	require.NoError(t, err)
+	objects, err := b.pool.AddedObjects(ctx, first, tip)
+	require.NoError(t, err)
+	require.Len(t, objects, 2)
+	require.Equal(t, ids[0], objects[0].ID)
+	require.Equal(t, ids[1], objects[1].ID)
+	objects, err = b.pool.AddedObjects(ctx, ksuid.Nil, tip)
+	require.NoError(t, err)
+	require.Len(t, objects, 3)
+	objects, err = b.pool.AddedObjects(ctx, tip, tip)
+	require.NoError(t, err)
+	require.Empty(t, objects)
+}
@@ -45,13 +45,36 @@ func (a *Add) String() string {
 // pessimistic locking mechanisms alongside the optimistic approach.
 
 type Commit struct {
-	ID      ksuid.KSUID `super:"id"`
-	Parent  ksuid.KSUID `super:"parent"`
-	Retries uint8       `super:"retries"`
-	Author  string      `super:"author"`
-	Date    nano.Ts     `super:"date"`
-	Message string      `super:"message"`
-	Meta    super.Value `super:"meta"`
+	ID         ksuid.KSUID `super:"id"`
+	Parent     ksuid.KSUID `super:"parent"`
+	Retries    uint8       `super:"retries"`
+	Author     string      `super:"author"`
+	Date       nano.Ts     `super:"date"`
+	Message    string      `super:"message"`
+	Meta       super.Value `super:"meta"`
+	Provenance *Provenance `super:"provenance"`
+}
+
+// Provenance describes where the data added by a commit came from.  Unlike
+// Commit.Meta, which is opaque to the lake, Provenance has a fixed structure
+// so that lineage questions can be answered with ordinary queries over the
+// commit log, e.g., "from pool:log | has(provenance.labels['job'])".
+type Provenance struct {
+	// Sources holds the URIs of the inputs that were loaded.
+	Sources []string `super:"sources"`
+	// Loader identifies the program and version that performed the load.
+	Loader string `super:"loader"`
+	// Labels holds user-supplied key/value pairs.
+	Labels map[string]string `super:"labels"`
+	// Key holds the user-supplied idempotency key of the load.
+	Key string `super:"key"`
+	// Hash holds the content hash of the loaded values if the load was
+	// deduplicated.
+	Hash string `super:"hash"`
+}
+
+func (p *Provenance) IsZero() bool {
+	return p == nil || len(p.Sources) == 0 && p.Loader == "" && len(p.Labels) == 0 && p.Key == "" && p.Hash == ""
 }
 
 func (c *Commit) CommitID() ksuid.KSUID {
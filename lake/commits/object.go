@@ -39,14 +39,27 @@ func NewObject(parent ksuid.KSUID, author, message string, meta super.Value, ret
 	return o
 }
 
-func NewAddsObject(parent ksuid.KSUID, retries int, author, message string, meta super.Value, objects []data.Object) *Object {
+func NewAddsObject(parent ksuid.KSUID, retries int, author, message string, meta super.Value, prov *Provenance, objects []data.Object) *Object {
 	o := NewObject(parent, author, message, meta, retries)
+	if !prov.IsZero() {
+		o.Actions[0].(*Commit).Provenance = prov
+	}
 	for _, dataObject := range objects {
 		o.append(&Add{Commit: o.Commit, Object: dataObject})
 	}
 	return o
 }
 
+// NewAddsWithVectorsObject is like NewAddsObject but also adds the vector
+// of each object.
+func NewAddsWithVectorsObject(parent ksuid.KSUID, retries int, author, message string, meta super.Value, objects []data.Object) *Object {
+	o := NewAddsObject(parent, retries, author, message, meta, nil, objects)
+	for _, dataObject := range objects {
+		o.appendAddVector(dataObject.ID)
+	}
+	return o
+}
+
 func NewDeletesObject(parent ksuid.KSUID, retries int, author, message string, ids []ksuid.KSUID) *Object {
 	o := NewObject(parent, author, message, super.Null, retries)
 	for _, id := range ids {
@@ -76,7 +89,9 @@ func (o *Object) append(action Action) {
 }
 
 func (o *Object) appendAdd(dataObject *data.Object) {
-	o.append(&Add{Commit: o.Commit, Object: *dataObject})
+	object := *dataObject
+	object.Commit = o.Commit
+	o.append(&Add{Commit: o.Commit, Object: object})
 }
 
 func (o *Object) appendDelete(id ksuid.KSUID) {
@@ -55,6 +55,21 @@ func (p *Patch) SelectAll() DataObjects {
 	return objects
 }
 
+func (p *Patch) Totals() Totals {
+	totals := p.base.Totals()
+	for _, id := range p.deletedObjects {
+		if o, err := p.base.Lookup(id); err == nil {
+			totals.sub(o)
+		}
+	}
+	diff := p.diff.Totals()
+	totals.Objects += diff.Objects
+	totals.Count += diff.Count
+	totals.Size += diff.Size
+	totals.RawSize += diff.RawSize
+	return totals
+}
+
 func (p *Patch) DataObjects() []ksuid.KSUID {
 	var ids []ksuid.KSUID
 	for _, dataObject := range p.diff.SelectAll() {
@@ -160,7 +175,7 @@ func Diff(parent, child *Patch) (*Patch, error) {
 	for _, o := range child.SelectAll() {
 		if !Exists(parent, o.ID) {
 			if _, ok := deletedObjects[o.ID]; ok {
-				return nil, fmt.Errorf("parent branch deletes object that child branch adds: %d", o.ID)
+				return nil, fmt.Errorf("%s: parent branch deletes object that child branch adds: %w", o.ID, ErrWriteConflict)
 			}
 			if err := p.AddDataObject(o); err != nil {
 				return nil, err
@@ -178,7 +193,7 @@ func Diff(parent, child *Patch) (*Patch, error) {
 			}
 			dirty = true
 		} else {
-			return nil, fmt.Errorf("delete conflict: %s", id)
+			return nil, fmt.Errorf("%s: delete conflict: %w", id, ErrWriteConflict)
 		}
 	}
 	if !dirty {
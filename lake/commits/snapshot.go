@@ -6,6 +6,7 @@ import (
 	"io"
 	"maps"
 
+	"github.com/brimdata/super"
 	"github.com/brimdata/super/bsupbytes"
 	"github.com/brimdata/super/lake/data"
 	"github.com/brimdata/super/order"
@@ -21,6 +22,29 @@ type View interface {
 	HasVector(ksuid.KSUID) bool
 	Select(extent.Span, order.Which) DataObjects
 	SelectAll() DataObjects
+	Totals() Totals
+}
+
+// Totals summarizes the data objects of a view.
+type Totals struct {
+	Objects int
+	Count   uint64
+	Size    int64
+	RawSize int64
+}
+
+func (t *Totals) add(o *data.Object) {
+	t.Objects++
+	t.Count += o.Count
+	t.Size += o.Size
+	t.RawSize += o.RawSize
+}
+
+func (t *Totals) sub(o *data.Object) {
+	t.Objects--
+	t.Count -= o.Count
+	t.Size -= o.Size
+	t.RawSize -= o.RawSize
 }
 
 type Writeable interface {
@@ -37,6 +61,15 @@ type Writeable interface {
 type Snapshot struct {
 	objects map[ksuid.KSUID]*data.Object
 	vectors map[ksuid.KSUID]struct{}
+	// totals is maintained as objects are added and deleted so that
+	// summarizing a snapshot does not require a pass over its objects.
+	totals Totals
+	// keys and hashes map the idempotency keys and content hashes of the
+	// provenance of the commits played into the snapshot to the most
+	// recent such commit so that a load need not walk the history of its
+	// branch to find a duplicate.
+	keys   map[string]ksuid.KSUID
+	hashes map[string]ksuid.KSUID
 }
 
 var _ View = (*Snapshot)(nil)
@@ -46,6 +79,8 @@ func NewSnapshot() *Snapshot {
 	return &Snapshot{
 		objects: make(map[ksuid.KSUID]*data.Object),
 		vectors: make(map[ksuid.KSUID]struct{}),
+		keys:    make(map[string]ksuid.KSUID),
+		hashes:  make(map[string]ksuid.KSUID),
 	}
 }
 
@@ -55,14 +90,17 @@ func (s *Snapshot) AddDataObject(object *data.Object) error {
 		return fmt.Errorf("%s: add of a duplicate data object: %w", id, ErrWriteConflict)
 	}
 	s.objects[id] = object
+	s.totals.add(object)
 	return nil
 }
 
 func (s *Snapshot) DeleteObject(id ksuid.KSUID) error {
-	if _, ok := s.objects[id]; !ok {
+	object, ok := s.objects[id]
+	if !ok {
 		return fmt.Errorf("%s: delete of a non-existent data object: %w", id, ErrWriteConflict)
 	}
 	delete(s.objects, id)
+	s.totals.sub(object)
 	return nil
 }
 
@@ -99,6 +137,19 @@ func (s *Snapshot) Lookup(id ksuid.KSUID) (*data.Object, error) {
 	return o, nil
 }
 
+// addProvenance indexes the idempotency key and content hash of the
+// provenance of commit, if any.
+func (s *Snapshot) addProvenance(commit *Commit) {
+	if p := commit.Provenance; p != nil {
+		if p.Key != "" {
+			s.keys[p.Key] = commit.ID
+		}
+		if p.Hash != "" {
+			s.hashes[p.Hash] = commit.ID
+		}
+	}
+}
+
 func (s *Snapshot) HasVector(id ksuid.KSUID) bool {
 	_, ok := s.vectors[id]
 	return ok
@@ -123,19 +174,27 @@ func (s *Snapshot) SelectAll() DataObjects {
 	return objects
 }
 
+func (s *Snapshot) Totals() Totals {
+	return s.totals
+}
+
 func (s *Snapshot) Copy() *Snapshot {
 	out := NewSnapshot()
 	maps.Copy(out.objects, s.objects)
+	out.totals = s.totals
 	for key := range s.vectors {
 		out.vectors[key] = struct{}{}
 	}
+	maps.Copy(out.keys, s.keys)
+	maps.Copy(out.hashes, s.hashes)
 	return out
 }
 
 // serialize serializes a snapshot as a sequence of actions.  Commit IDs are
 // omitted from actions since they are neither available here nor required
 // during deserialization.  Deleted entities are serialized as an add-delete
-// sequence to meet the requirements of DeleteObject.
+// sequence to meet the requirements of DeleteObject.  The provenance index
+// is serialized as commit actions holding only the indexed fields.
 func (s *Snapshot) serialize() ([]byte, error) {
 	zs := bsupbytes.NewSerializer()
 	zs.Decorate(sup.StylePackage)
@@ -149,6 +208,16 @@ func (s *Snapshot) serialize() ([]byte, error) {
 			return nil, err
 		}
 	}
+	for key, id := range s.keys {
+		if err := zs.Write(&Commit{ID: id, Meta: super.Null, Provenance: &Provenance{Key: key}}); err != nil {
+			return nil, err
+		}
+	}
+	for hash, id := range s.hashes {
+		if err := zs.Write(&Commit{ID: id, Meta: super.Null, Provenance: &Provenance{Hash: hash}}); err != nil {
+			return nil, err
+		}
+	}
 	if err := zs.Close(); err != nil {
 		return nil, err
 	}
@@ -186,6 +255,18 @@ func (d *DataObjects) Append(objects DataObjects) {
 func PlayAction(w Writeable, action Action) error {
 	switch action := action.(type) {
 	case *Add:
+		if action.Object.Commit == ksuid.Nil {
+			// Objects added by older versions of the lake do not
+			// record their commit.
+			action.Object.Commit = action.Commit
+		}
+		if action.Object.Stats.Type() == nil {
+			// Nor do they record field statistics.
+			action.Object.Stats = super.Null
+		}
+		if action.Object.Partition.Type() == nil {
+			action.Object.Partition = super.Null
+		}
 		return w.AddDataObject(&action.Object)
 	case *Delete:
 		return w.DeleteObject(action.ID)
@@ -194,7 +275,9 @@ func PlayAction(w Writeable, action Action) error {
 	case *DeleteVector:
 		return w.DeleteVector(action.ID)
 	case *Commit:
-		// ignore
+		if s, ok := w.(*Snapshot); ok {
+			s.addProvenance(action)
+		}
 		return nil
 	}
 	return fmt.Errorf("lake.commits.PlayAction: unknown action %T", action)
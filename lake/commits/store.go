@@ -12,6 +12,7 @@ import (
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/bsupbytes"
 	"github.com/brimdata/super/lake/data"
+	"github.com/brimdata/super/pkg/nano"
 	"github.com/brimdata/super/pkg/storage"
 	"github.com/brimdata/super/zio"
 	"github.com/brimdata/super/zio/bsupio"
@@ -20,6 +21,12 @@ import (
 	"go.uber.org/zap"
 )
 
+// checkpointInterval is the number of commits replayed between the
+// intermediate snapshots stored by Snapshot so that computing the snapshot
+// of any commit in a long history requires replaying only a bounded number
+// of commit objects.
+const checkpointInterval = 16
+
 var (
 	ErrBadCommitObject = errors.New("first record of object not a commit")
 	ErrExists          = errors.New("commit object already exists")
@@ -152,6 +159,13 @@ func (s *Store) Snapshot(ctx context.Context, leaf ksuid.KSUID) (*Snapshot, erro
 				return nil, err
 			}
 		}
+		if k > 0 && (len(objects)-k)%checkpointInterval == 0 {
+			// Store a checkpoint so that later walks through this
+			// history stop here instead of replaying it again.
+			if err := s.putSnapshot(ctx, objects[k].Commit, snap); err != nil {
+				s.logger.Error("Storing snapshot", zap.Error(err))
+			}
+		}
 	}
 	if err := s.putSnapshot(ctx, leaf, snap); err != nil {
 		s.logger.Error("Storing snapshot", zap.Error(err))
@@ -198,6 +212,140 @@ func (s *Store) Path(ctx context.Context, leaf ksuid.KSUID) ([]ksuid.KSUID, erro
 	return path, nil
 }
 
+// AsOf returns the most recent commit on the path from leaf to the root
+// whose date is at or before ts.
+func (s *Store) AsOf(ctx context.Context, leaf ksuid.KSUID, ts nano.Ts) (ksuid.KSUID, error) {
+	for at := leaf; at != ksuid.Nil; {
+		o, commit, err := s.getCommit(ctx, at)
+		if err != nil {
+			return ksuid.Nil, err
+		}
+		if commit.Date <= ts {
+			return at, nil
+		}
+		at = o.Parent
+	}
+	return ksuid.Nil, fmt.Errorf("no commit found at or before %s", ts)
+}
+
+// Date returns the date of commit.
+func (s *Store) Date(ctx context.Context, commit ksuid.KSUID) (nano.Ts, error) {
+	_, c, err := s.getCommit(ctx, commit)
+	if err != nil {
+		return 0, err
+	}
+	return c.Date, nil
+}
+
+// getCommit returns the commit object for commit along with its leading
+// commit action.
+func (s *Store) getCommit(ctx context.Context, commit ksuid.KSUID) (*Object, *Commit, error) {
+	o, err := s.Get(ctx, commit)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(o.Actions) == 0 {
+		return nil, nil, fmt.Errorf("system error: commit object has no actions: %s", s.pathOf(commit))
+	}
+	c, ok := o.Actions[0].(*Commit)
+	if !ok {
+		return nil, nil, fmt.Errorf("system error: first record of commit object is not a commit action: %s", s.pathOf(commit))
+	}
+	return o, c, nil
+}
+
+// LookupKey returns the most recent commit on the path from leaf toward the
+// root, stopping before stop, whose provenance has the idempotency key key
+// or ksuid.Nil if there is no such commit.  If stop is ksuid.Nil, the key is
+// looked up in the snapshot of leaf rather than by walking the path.
+func (s *Store) LookupKey(ctx context.Context, leaf, stop ksuid.KSUID, key string) (ksuid.KSUID, error) {
+	if stop == ksuid.Nil {
+		snap, err := s.provenanceSnapshot(ctx, leaf)
+		if err != nil {
+			return ksuid.Nil, err
+		}
+		return snap.keys[key], nil
+	}
+	return s.lookupProvenance(ctx, leaf, stop, func(p *Provenance) bool {
+		return p.Key == key
+	})
+}
+
+// LookupHash is like LookupKey but looks for a commit whose provenance has
+// the content hash hash.
+func (s *Store) LookupHash(ctx context.Context, leaf, stop ksuid.KSUID, hash string) (ksuid.KSUID, error) {
+	if stop == ksuid.Nil {
+		snap, err := s.provenanceSnapshot(ctx, leaf)
+		if err != nil {
+			return ksuid.Nil, err
+		}
+		return snap.hashes[hash], nil
+	}
+	return s.lookupProvenance(ctx, leaf, stop, func(p *Provenance) bool {
+		return p.Hash == hash
+	})
+}
+
+// provenanceSnapshot returns the snapshot of leaf, whose provenance index
+// covers the path from leaf to the root, or an empty snapshot if leaf is
+// ksuid.Nil.
+func (s *Store) provenanceSnapshot(ctx context.Context, leaf ksuid.KSUID) (*Snapshot, error) {
+	if leaf == ksuid.Nil {
+		return NewSnapshot(), nil
+	}
+	return s.Snapshot(ctx, leaf)
+}
+
+func (s *Store) lookupProvenance(ctx context.Context, leaf, stop ksuid.KSUID, match func(*Provenance) bool) (ksuid.KSUID, error) {
+	for at := leaf; at != ksuid.Nil && at != stop; {
+		o, err := s.Get(ctx, at)
+		if err != nil {
+			return ksuid.Nil, err
+		}
+		if len(o.Actions) > 0 {
+			if commit, ok := o.Actions[0].(*Commit); ok && commit.Provenance != nil && match(commit.Provenance) {
+				return at, nil
+			}
+		}
+		at = o.Parent
+	}
+	return ksuid.Nil, nil
+}
+
+// CommonAncestor returns the most recent commit on the paths from both a and b
+// to the root or ksuid.Nil if there is no such commit.  The paths are walked
+// in tandem so the cost is proportional to the distance from a and b to
+// their common ancestor rather than to the root.
+func (s *Store) CommonAncestor(ctx context.Context, a, b ksuid.KSUID) (ksuid.KSUID, error) {
+	seenA := map[ksuid.KSUID]struct{}{}
+	seenB := map[ksuid.KSUID]struct{}{}
+	for a != ksuid.Nil || b != ksuid.Nil {
+		if a != ksuid.Nil {
+			if _, ok := seenB[a]; ok {
+				return a, nil
+			}
+			seenA[a] = struct{}{}
+			o, err := s.Get(ctx, a)
+			if err != nil {
+				return ksuid.Nil, err
+			}
+			a = o.Parent
+		}
+		if b != ksuid.Nil {
+			if _, ok := seenA[b]; ok {
+				return b, nil
+			}
+			seenB[b] = struct{}{}
+			o, err := s.Get(ctx, b)
+			if err != nil {
+				return ksuid.Nil, err
+			}
+			b = o.Parent
+		}
+	}
+	return ksuid.Nil, nil
+}
+
 func (s *Store) PathRange(ctx context.Context, from, to ksuid.KSUID) ([]ksuid.KSUID, error) {
 	var path []ksuid.KSUID
 	for at := from; at != ksuid.Nil; {
@@ -284,29 +432,18 @@ func (s *Store) OpenCommitLog(ctx context.Context, sctx *super.Context, commit,
 // then computes the difference between that snapshot and the child commit,
 // returning the difference as a patch.
 func (s *Store) PatchOfCommit(ctx context.Context, commit ksuid.KSUID) (*Patch, error) {
-	path, err := s.Path(ctx, commit)
+	object, err := s.Get(ctx, commit)
 	if err != nil {
 		return nil, err
 	}
-	if len(path) == 0 {
-		return nil, errors.New("system error: no error on pathless commit")
-	}
-	var base *Snapshot
-	if len(path) == 1 {
-		// For first commit in branch, just create an empty base ...
-		base = NewSnapshot()
-	} else {
-		parent := path[1]
-		base, err = s.Snapshot(ctx, parent)
+	base := NewSnapshot()
+	if object.Parent != ksuid.Nil {
+		base, err = s.Snapshot(ctx, object.Parent)
 		if err != nil {
 			return nil, err
 		}
 	}
 	patch := NewPatch(base)
-	object, err := s.Get(ctx, commit)
-	if err != nil {
-		return nil, err
-	}
 	for _, action := range object.Actions {
 		if err := PlayAction(patch, action); err != nil {
 			return nil, err
@@ -0,0 +1,129 @@
+package commits
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/lake/data"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/segmentio/ksuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newStore(t *testing.T) *Store {
+	path := storage.MustParseURI(t.TempDir())
+	s, err := OpenStore(storage.NewLocalEngine(), zap.NewNop(), path)
+	require.NoError(t, err)
+	return s
+}
+
+// putChain stores a chain of n commits starting from parent, each adding one
+// data object, and returns the commit IDs in root to leaf order.
+func putChain(ctx context.Context, t *testing.T, s *Store, parent ksuid.KSUID, n int) []ksuid.KSUID {
+	var ids []ksuid.KSUID
+	for range n {
+		object := data.NewObject()
+		object.Min, object.Max, object.Stats = super.Null, super.Null, super.Null
+		object.Count, object.Size, object.RawSize = 1, 10, 20
+		o := NewAddsObject(parent, 0, "test", "", super.Null, nil, []data.Object{object})
+		require.NoError(t, s.Put(ctx, o))
+		ids = append(ids, o.Commit)
+		parent = o.Commit
+	}
+	return ids
+}
+
+func TestStoreSnapshotCheckpoints(t *testing.T) {
+	ctx := context.Background()
+	s := newStore(t)
+	ids := putChain(ctx, t, s, ksuid.Nil, 3*checkpointInterval)
+	snap, err := s.Snapshot(ctx, ids[len(ids)-1])
+	require.NoError(t, err)
+	require.Len(t, snap.SelectAll(), len(ids))
+	for k, id := range ids {
+		exists, err := s.engine.Exists(ctx, s.snapshotPathOf(id))
+		require.NoError(t, err)
+		require.Equal(t, (k+1)%checkpointInterval == 0, exists, "commit %d", k)
+	}
+	// The snapshot of a commit in the middle of the history starts
+	// from the nearest checkpoint.
+	snap, err = s.Snapshot(ctx, ids[checkpointInterval+2])
+	require.NoError(t, err)
+	require.Len(t, snap.SelectAll(), checkpointInterval+3)
+}
+
+func TestStoreCommonAncestor(t *testing.T) {
+	ctx := context.Background()
+	s := newStore(t)
+	trunk := putChain(ctx, t, s, ksuid.Nil, 5)
+	left := putChain(ctx, t, s, trunk[2], 7)
+	right := putChain(ctx, t, s, trunk[2], 2)
+	for _, c := range []struct {
+		a, b, expected ksuid.KSUID
+	}{
+		{left[6], right[1], trunk[2]},
+		{right[1], left[6], trunk[2]},
+		{trunk[4], left[0], trunk[2]},
+		{trunk[4], trunk[1], trunk[1]},
+		{left[3], left[3], left[3]},
+	} {
+		id, err := s.CommonAncestor(ctx, c.a, c.b)
+		require.NoError(t, err)
+		require.Equal(t, c.expected, id)
+	}
+	other := putChain(ctx, t, s, ksuid.Nil, 1)
+	id, err := s.CommonAncestor(ctx, other[0], trunk[4])
+	require.NoError(t, err)
+	require.Equal(t, ksuid.Nil, id)
+}
+
+func TestStoreSnapshotTotals(t *testing.T) {
+	ctx := context.Background()
+	s := newStore(t)
+	ids := putChain(ctx, t, s, ksuid.Nil, checkpointInterval+1)
+	snap, err := s.Snapshot(ctx, ids[len(ids)-1])
+	require.NoError(t, err)
+	objects := snap.SelectAll()
+	patch := NewPatch(snap)
+	require.NoError(t, patch.DeleteObject(objects[0].ID))
+	o := patch.NewCommitObject(ids[len(ids)-1], 0, "test", "", super.Null)
+	require.NoError(t, s.Put(ctx, o))
+	snap, err = s.Snapshot(ctx, o.Commit)
+	require.NoError(t, err)
+	n := len(objects) - 1
+	require.Equal(t, Totals{Objects: n, Count: uint64(n), Size: int64(10 * n), RawSize: int64(20 * n)}, snap.Totals())
+	require.Equal(t, snap.Totals(), patch.Totals())
+}
+
+func TestStoreLookupProvenance(t *testing.T) {
+	ctx := context.Background()
+	s := newStore(t)
+	trunk := putChain(ctx, t, s, ksuid.Nil, 2)
+	prov := &Provenance{Key: "k", Hash: "h"}
+	keyed := NewAddsObject(trunk[1], 0, "test", "", super.Null, prov, nil)
+	require.NoError(t, s.Put(ctx, keyed))
+	ids := putChain(ctx, t, s, keyed.Commit, checkpointInterval+2)
+	leaf := ids[len(ids)-1]
+	_, err := s.Snapshot(ctx, leaf)
+	require.NoError(t, err)
+	// Once the snapshot of the leaf is stored, the keys and hashes are
+	// found in it rather than by walking the history, so a fresh store
+	// finds them even without the keyed commit object.
+	require.NoError(t, s.Remove(ctx, keyed))
+	s, err = OpenStore(s.engine, zap.NewNop(), s.path)
+	require.NoError(t, err)
+	id, err := s.LookupKey(ctx, leaf, ksuid.Nil, "k")
+	require.NoError(t, err)
+	require.Equal(t, keyed.Commit, id)
+	id, err = s.LookupHash(ctx, leaf, ksuid.Nil, "h")
+	require.NoError(t, err)
+	require.Equal(t, keyed.Commit, id)
+	id, err = s.LookupKey(ctx, leaf, ksuid.Nil, "other")
+	require.NoError(t, err)
+	require.Equal(t, ksuid.Nil, id)
+	id, err = s.LookupKey(ctx, trunk[1], ksuid.Nil, "k")
+	require.NoError(t, err)
+	require.Equal(t, ksuid.Nil, id)
+}
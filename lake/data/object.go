@@ -74,13 +74,28 @@ func FileMatch(s string) (kind FileKind, id ksuid.KSUID, ok bool) {
 // of Zed values sorted according to the pool's data order where From is the
 // the first value in the sequence and To is the last value.  Count is the number
 // of values in the sequence and Size is total size in bytes of the Object as
-// persisted to storage (i.e., its compressed size).
+// persisted to storage (i.e., its compressed size).  RawSize is the size of
+// the Object's frames before compression and Commit is the ID of the commit
+// that added the Object to the pool.  Both are zero for objects created by
+// older versions of the lake.  Stats holds the range of values of each
+// primitive field of the Object as a record of the form {min,max} at the
+// field's path, e.g., {a:{b:{min:1,max:5}}} for field a.b, and is null
+// when no ranges were recorded.  Partition is the value of the partition
+// expression shared by all of the Object's values when the pool is
+// partitioned and is null otherwise.  Summaries holds, at index k, the text
+// of the pool summary whose partial results over the Object are stored at
+// SummaryURI(k).
 type Object struct {
-	ID    ksuid.KSUID `super:"id"`
-	Min   super.Value `super:"min"`
-	Max   super.Value `super:"max"`
-	Count uint64      `super:"count"`
-	Size  int64       `super:"size"`
+	ID        ksuid.KSUID `super:"id"`
+	Min       super.Value `super:"min"`
+	Max       super.Value `super:"max"`
+	Count     uint64      `super:"count"`
+	Size      int64       `super:"size"`
+	RawSize   int64       `super:"raw_size"`
+	Commit    ksuid.KSUID `super:"commit"`
+	Stats     super.Value `super:"stats"`
+	Partition super.Value `super:"partition"`
+	Summaries []string    `super:"summaries"`
 }
 
 func (o Object) IsZero() bool {
@@ -103,7 +118,13 @@ func (o *Object) Equal(to *Object) bool {
 }
 
 func NewObject() Object {
-	return Object{ID: ksuid.New()}
+	return Object{ID: ksuid.New(), Partition: super.Null}
+}
+
+// HasSummary returns true if the partial results of the summary with the
+// given text are stored at SummaryURI(k).
+func (o Object) HasSummary(k int, text string) bool {
+	return k < len(o.Summaries) && o.Summaries[k] == text
 }
 
 func (o Object) Span(order order.Which) *extent.Generic {
@@ -133,6 +154,12 @@ func SeekIndexURI(path *storage.URI, id ksuid.KSUID) *storage.URI {
 	return path.JoinPath(fmt.Sprintf("%s-seek.bsup", id))
 }
 
+// SummaryURI returns the URI of the partial results of the pool's kth
+// summary aggregation over the object with the given ID.
+func SummaryURI(path *storage.URI, id ksuid.KSUID, k int) *storage.URI {
+	return path.JoinPath(fmt.Sprintf("%s-summary-%d.bsup", id, k))
+}
+
 func (o Object) VectorURI(path *storage.URI) *storage.URI {
 	return VectorURI(path, o.ID)
 }
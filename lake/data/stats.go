@@ -0,0 +1,152 @@
+package data
+
+import (
+	"slices"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/zcode"
+)
+
+const (
+	// maxStatsFields limits the number of fields whose value ranges are
+	// recorded for an object.
+	maxStatsFields = 64
+	// maxStatsValueSize limits the size of the values recorded for a field.
+	// A field with a larger value is not recorded.
+	maxStatsValueSize = 64
+)
+
+// statsBuilder computes the range of values for each primitive field of the
+// records written to an object.  A field is recorded only when each of its
+// values in the object has the same primitive type so that the range is
+// meaningful for comparisons against the field.
+type statsBuilder struct {
+	cmp    expr.CompareFn
+	fields map[string]*fieldStats
+}
+
+type fieldStats struct {
+	path    field.Path
+	typ     super.Type
+	min     super.Value
+	max     super.Value
+	invalid bool
+}
+
+func newStatsBuilder() *statsBuilder {
+	return &statsBuilder{
+		cmp:    expr.NewValueCompareFn(order.Asc, order.NullsLast),
+		fields: make(map[string]*fieldStats),
+	}
+}
+
+func (s *statsBuilder) update(val super.Value) {
+	if typ := super.TypeRecordOf(val.Type()); typ != nil {
+		s.updateRecord(nil, typ, val.Bytes())
+	}
+}
+
+func (s *statsBuilder) updateRecord(path field.Path, typ *super.TypeRecord, bytes zcode.Bytes) {
+	if bytes == nil {
+		return
+	}
+	it := bytes.Iter()
+	for _, f := range typ.Fields {
+		fieldPath := append(path[:len(path):len(path)], f.Name)
+		b := it.Next()
+		if recType := super.TypeRecordOf(f.Type); recType != nil {
+			s.invalidate(fieldPath)
+			s.updateRecord(fieldPath, recType, b)
+			continue
+		}
+		s.updateField(fieldPath, super.NewValue(f.Type, b))
+	}
+}
+
+func (s *statsBuilder) lookup(path field.Path) *fieldStats {
+	key := path.String()
+	fs, ok := s.fields[key]
+	if !ok {
+		if len(s.fields) >= maxStatsFields {
+			return nil
+		}
+		fs = &fieldStats{path: path}
+		s.fields[key] = fs
+	}
+	return fs
+}
+
+func (s *statsBuilder) invalidate(path field.Path) {
+	if fs := s.lookup(path); fs != nil {
+		fs.invalid = true
+	}
+}
+
+func (s *statsBuilder) updateField(path field.Path, val super.Value) {
+	fs := s.lookup(path)
+	if fs == nil || fs.invalid {
+		return
+	}
+	typ := val.Type()
+	if typ.ID() >= super.IDTypeComplex || typ == super.TypeNull || len(val.Bytes()) > maxStatsValueSize {
+		fs.invalid = true
+		return
+	}
+	if fs.typ == nil {
+		fs.typ = typ
+		fs.min = val.Copy()
+		fs.max = val.Copy()
+		return
+	}
+	if fs.typ != typ {
+		fs.invalid = true
+		return
+	}
+	if s.cmp(val, fs.min) < 0 {
+		fs.min = val.Copy()
+	}
+	if s.cmp(val, fs.max) > 0 {
+		fs.max = val.Copy()
+	}
+}
+
+// value returns a record with a {min,max} record for each recorded field
+// nested according to the field's path, e.g., {a:{b:{min:1,max:2}}} for
+// field a.b, or a null value if no field was recorded.
+func (s *statsBuilder) value() (super.Value, error) {
+	var fields []*fieldStats
+	for _, fs := range s.fields {
+		if !fs.invalid && fs.typ != nil {
+			fields = append(fields, fs)
+		}
+	}
+	if len(fields) == 0 {
+		return super.Null, nil
+	}
+	slices.SortFunc(fields, func(a, b *fieldStats) int {
+		return slices.Compare(a.path, b.path)
+	})
+	var paths field.List
+	var types []super.Type
+	for _, fs := range fields {
+		paths = append(paths, append(slices.Clone(fs.path), "min"), append(slices.Clone(fs.path), "max"))
+		types = append(types, fs.typ, fs.typ)
+	}
+	sctx := super.NewContext()
+	b, err := super.NewRecordBuilder(sctx, paths)
+	if err != nil {
+		return super.Null, err
+	}
+	for _, fs := range fields {
+		b.Append(fs.min.Bytes())
+		b.Append(fs.max.Bytes())
+	}
+	bytes, err := b.Encode()
+	if err != nil {
+		return super.Null, err
+	}
+	return super.NewValue(b.Type(types), bytes), nil
+}
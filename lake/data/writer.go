@@ -25,6 +25,7 @@ type Writer struct {
 	seekIndexTrigger int
 	first            bool
 	seekMin          *super.Value
+	stats            *statsBuilder
 }
 
 // NewWriter returns a writer for writing the data of a BSUP object as
@@ -44,6 +45,7 @@ func (o *Object) NewWriter(ctx context.Context, engine storage.Engine, path *sto
 		writer:      bsupio.NewWriter(counter),
 		sortKey:     sortKey,
 		first:       true,
+		stats:       newStatsBuilder(),
 	}
 	if seekIndexStride == 0 {
 		seekIndexStride = DefaultSeekStride
@@ -67,6 +69,7 @@ func (w *Writer) WriteWithKey(key, val super.Value) error {
 	if err := w.writer.Write(val); err != nil {
 		return err
 	}
+	w.stats.update(val)
 	w.object.Max.CopyFrom(key)
 	return w.writeIndex(key)
 }
@@ -125,6 +128,12 @@ func (w *Writer) Close(ctx context.Context) error {
 	}
 	w.object.Count = w.count
 	w.object.Size = w.writer.Position()
+	w.object.RawSize = w.writer.RawSize()
+	stats, err := w.stats.value()
+	if err != nil {
+		return err
+	}
+	w.object.Stats = stats
 	if w.sortKey.Order == order.Desc {
 		w.object.Min, w.object.Max = w.object.Max, w.object.Min
 	}
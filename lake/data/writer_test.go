@@ -2,6 +2,7 @@ package data_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/brimdata/super"
@@ -48,3 +49,24 @@ func TestDataReaderWriterVector(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, exists, false)
 }
+
+func TestDataWriterStats(t *testing.T) {
+	engine := storage.NewLocalEngine()
+	tmp := storage.MustParseURI(t.TempDir())
+	object := data.NewObject()
+	ctx := context.Background()
+	w, err := object.NewWriter(ctx, engine, tmp, order.NewSortKey(order.Asc, field.Path{"a"}), 1000)
+	require.NoError(t, err)
+	sctx := super.NewContext()
+	for _, s := range []string{
+		`{a:1,r:{x:5,y:"b"},m:1,n:null(int64)}`,
+		`{a:3,r:{x:2,y:"a"},m:"one"}`,
+		`{a:2,r:null({x:int64,y:string}),m:2,n:7,big:"` + strings.Repeat("x", 100) + `"}`,
+	} {
+		require.NoError(t, w.Write(sup.MustParseValue(sctx, s)))
+	}
+	require.NoError(t, w.Close(ctx))
+	// Field m has values of different types and field big has a value
+	// that is too large, so neither is recorded.
+	assert.Equal(t, `{a:{min:1,max:3},n:{min:7,max:null(int64)},r:{x:{min:2,max:5},y:{min:"a",max:"b"}}}`, sup.String(object.Stats))
+}
@@ -0,0 +1,194 @@
+package lake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/lake/externals"
+	"github.com/brimdata/super/lake/pools"
+	"github.com/brimdata/super/sup"
+)
+
+// ExternalListingTTL is how long the listing of the files of an external
+// pool is cached before the files are listed again.
+var ExternalListingTTL = time.Minute
+
+// ExternalMeta describes an external pool and the files that match its
+// pattern.
+type ExternalMeta struct {
+	External externals.Config `super:"external"`
+	Files    int              `super:"files"`
+	Bytes    int64            `super:"bytes"`
+}
+
+type externalListing struct {
+	files []externals.File
+	time  time.Time
+}
+
+// externalStore returns the store of external pools.  Lakes created before
+// external pools existed have no store until one is created, so if create
+// is false and there is no store, externalStore returns nil.
+func (r *Root) externalStore(ctx context.Context, create bool) (*externals.Store, error) {
+	r.externalsMu.Lock()
+	defer r.externalsMu.Unlock()
+	if r.externals != nil {
+		return r.externals, nil
+	}
+	path := r.path.JoinPath(ExternalsTag)
+	store, err := externals.OpenStore(ctx, r.engine, r.logger, path)
+	if err != nil {
+		if !create {
+			return nil, nil
+		}
+		if store, err = externals.CreateStore(ctx, r.engine, r.logger, path); err != nil {
+			return nil, err
+		}
+	}
+	r.externals = store
+	return store, nil
+}
+
+func (r *Root) ListExternals(ctx context.Context) ([]externals.Config, error) {
+	store, err := r.externalStore(ctx, false)
+	if store == nil || err != nil {
+		return nil, err
+	}
+	return store.All(ctx)
+}
+
+// LookupExternal returns the configuration of the named external pool.
+func (r *Root) LookupExternal(ctx context.Context, name string) (*externals.Config, error) {
+	store, err := r.externalStore(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	if store != nil {
+		if config := store.LookupByName(ctx, name); config != nil {
+			return config, nil
+		}
+	}
+	return nil, fmt.Errorf("%s: %w", name, externals.ErrNotFound)
+}
+
+// CreateExternal creates an external pool over the files matching pattern.
+// The name of an external pool cannot be that of a pool.
+func (r *Root) CreateExternal(ctx context.Context, name, pattern, format string) (*externals.Config, error) {
+	if name == "" || name == "HEAD" || strings.HasPrefix(name, ":") {
+		return nil, fmt.Errorf("external pool cannot be named %q", name)
+	}
+	if r.pools.LookupByName(ctx, name) != nil {
+		return nil, fmt.Errorf("%s: %w", name, pools.ErrExists)
+	}
+	config, err := externals.NewConfig(name, pattern, format)
+	if err != nil {
+		return nil, err
+	}
+	store, err := r.externalStore(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	if err := store.Add(ctx, config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// RemoveExternal deletes an external pool.  Its files are not deleted.
+func (r *Root) RemoveExternal(ctx context.Context, name string) error {
+	config, err := r.LookupExternal(ctx, name)
+	if err != nil {
+		return err
+	}
+	store, err := r.externalStore(ctx, false)
+	if err != nil {
+		return err
+	}
+	if err := store.Remove(ctx, *config); err != nil {
+		return err
+	}
+	r.externalsMu.Lock()
+	delete(r.listings, config.ID)
+	r.externalsMu.Unlock()
+	return nil
+}
+
+// ExternalFiles returns the files matching the pattern of an external pool
+// in order of their URIs.  The listing is cached for ExternalListingTTL so
+// that queries compiled in quick succession do not each list the files.
+func (r *Root) ExternalFiles(ctx context.Context, config *externals.Config) ([]externals.File, error) {
+	r.externalsMu.Lock()
+	listing, ok := r.listings[config.ID]
+	r.externalsMu.Unlock()
+	if ok && time.Since(listing.time) < ExternalListingTTL {
+		return listing.files, nil
+	}
+	dir, err := config.Dir()
+	if err != nil {
+		return nil, err
+	}
+	infos, err := r.engine.List(ctx, dir)
+	if err != nil {
+		return nil, fmt.Errorf("external pool %q: %w", config.Name, err)
+	}
+	var files []externals.File
+	for _, info := range infos {
+		if config.Match(info.Name) {
+			files = append(files, externals.File{
+				URI:  dir.JoinPath(info.Name).String(),
+				Size: info.Size,
+			})
+		}
+	}
+	slices.SortFunc(files, func(a, b externals.File) int {
+		return strings.Compare(a.URI, b.URI)
+	})
+	r.externalsMu.Lock()
+	r.listings[config.ID] = externalListing{files, time.Now()}
+	r.externalsMu.Unlock()
+	return files, nil
+}
+
+// BatchifyExternals returns an ExternalMeta for each external pool.  The
+// statistics of an external pool are collected from the listing of its
+// files when they are first requested rather than when it is created.
+func (r *Root) BatchifyExternals(ctx context.Context, sctx *super.Context) ([]super.Value, error) {
+	m := sup.NewBSUPMarshalerWithContext(sctx)
+	m.Decorate(sup.StylePackage)
+	configs, err := r.ListExternals(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var vals []super.Value
+	for k := range configs {
+		files, err := r.ExternalFiles(ctx, &configs[k])
+		if err != nil {
+			return nil, err
+		}
+		meta := ExternalMeta{External: configs[k], Files: len(files)}
+		for _, f := range files {
+			meta.Bytes += f.Size
+		}
+		val, err := m.Marshal(&meta)
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, val)
+	}
+	return vals, nil
+}
+
+// checkExternalName returns an error if name is that of an external pool.
+func (r *Root) checkExternalName(ctx context.Context, name string) error {
+	if _, err := r.LookupExternal(ctx, name); err == nil {
+		return fmt.Errorf("%s: %w", name, externals.ErrExists)
+	} else if !errors.Is(err, externals.ErrNotFound) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package externals
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/brimdata/super/lake/journal"
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/segmentio/ksuid"
+)
+
+// A Config defines an external pool, i.e., the files in storage whose names
+// match a pattern, e.g., "s3://bucket/logs/*.parquet", which queries read in
+// place as they would the data of a pool that has not been loaded.
+type Config struct {
+	Ts   nano.Ts     `super:"ts"`
+	Name string      `super:"name"`
+	ID   ksuid.KSUID `super:"id"`
+	// Pattern is the URI of the files.  Its last element may contain the
+	// wildcards of path.Match.
+	Pattern string `super:"pattern"`
+	// Format is the format of the files.  If empty, the format of each
+	// file is inferred as it is for a file named in a from operator.
+	Format string `super:"format"`
+}
+
+var _ journal.Entry = (*Config)(nil)
+
+// NewConfig returns the configuration of a new external pool.  Pattern is
+// resolved as is a path in a from operator, so a relative path becomes an
+// absolute file URI.
+func NewConfig(name, pattern, format string) (*Config, error) {
+	dir, base, err := ParsePattern(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return &Config{
+		Ts:      nano.Now(),
+		Name:    name,
+		ID:      ksuid.New(),
+		Pattern: strings.TrimSuffix(dir.String(), "/") + "/" + base,
+		Format:  format,
+	}, nil
+}
+
+func (c *Config) Key() string {
+	return c.Name
+}
+
+// Dir returns the URI of the directory holding the files of c.
+func (c *Config) Dir() (*storage.URI, error) {
+	dir, _, err := ParsePattern(c.Pattern)
+	return dir, err
+}
+
+// Match returns true if the file with the given name in the directory of c
+// matches the pattern of c.
+func (c *Config) Match(name string) bool {
+	_, base, err := ParsePattern(c.Pattern)
+	if err != nil {
+		return false
+	}
+	ok, _ := path.Match(base, name)
+	return ok
+}
+
+// ParsePattern splits pattern into the URI of its directory and the pattern
+// of the names of its files.  Only the last element of pattern may contain
+// wildcards.
+func ParsePattern(pattern string) (*storage.URI, string, error) {
+	i := strings.LastIndexByte(pattern, '/')
+	if i < 0 {
+		pattern = "./" + pattern
+		i = 1
+	}
+	dir, base := pattern[:i], pattern[i+1:]
+	if base == "" {
+		return nil, "", fmt.Errorf("pattern %q: no file name", pattern)
+	}
+	if strings.ContainsAny(dir, "*?[\\") {
+		return nil, "", fmt.Errorf("pattern %q: wildcards allowed only in last element", pattern)
+	}
+	if _, err := path.Match(base, ""); err != nil {
+		return nil, "", fmt.Errorf("pattern %q: %w", pattern, err)
+	}
+	if dir == "" {
+		dir = "/"
+	}
+	u, err := storage.ParseURI(dir)
+	if err != nil {
+		return nil, "", fmt.Errorf("pattern %q: %w", pattern, err)
+	}
+	return u, base, nil
+}
+
+// A File is a file matching the pattern of an external pool.
+type File struct {
+	URI  string `super:"uri"`
+	Size int64  `super:"size"`
+}
@@ -0,0 +1,88 @@
+package externals
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/brimdata/super/lake/journal"
+	"github.com/brimdata/super/pkg/storage"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrExists   = errors.New("external pool already exists")
+	ErrNotFound = errors.New("external pool not found")
+)
+
+type Store struct {
+	store *journal.Store
+}
+
+func CreateStore(ctx context.Context, engine storage.Engine, logger *zap.Logger, path *storage.URI) (*Store, error) {
+	store, err := journal.CreateStore(ctx, engine, logger, path, Config{})
+	if err != nil {
+		return nil, err
+	}
+	return &Store{store}, nil
+}
+
+func OpenStore(ctx context.Context, engine storage.Engine, logger *zap.Logger, path *storage.URI) (*Store, error) {
+	store, err := journal.OpenStore(ctx, engine, logger, path, Config{})
+	if err != nil {
+		return nil, err
+	}
+	return &Store{store}, nil
+}
+
+func (s *Store) All(ctx context.Context) ([]Config, error) {
+	entries, err := s.store.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]Config, 0, len(entries))
+	for _, entry := range entries {
+		config, ok := entry.(*Config)
+		if !ok {
+			return nil, errors.New("corrupt external pool config journal")
+		}
+		list = append(list, *config)
+	}
+	return list, nil
+}
+
+func (s *Store) LookupByName(ctx context.Context, name string) *Config {
+	list, err := s.All(ctx)
+	if err != nil {
+		return nil
+	}
+	for k, config := range list {
+		if config.Name == name {
+			return &list[k]
+		}
+	}
+	return nil
+}
+
+func (s *Store) Add(ctx context.Context, config *Config) error {
+	err := s.store.Insert(ctx, config)
+	if err == journal.ErrKeyExists {
+		return fmt.Errorf("%s: %w", config.Name, ErrExists)
+	}
+	return err
+}
+
+// Remove deletes an external pool from the configuration journal.
+func (s *Store) Remove(ctx context.Context, config Config) error {
+	err := s.store.Delete(ctx, config.Name, func(v journal.Entry) bool {
+		c, ok := v.(*Config)
+		return ok && c.ID == config.ID
+	})
+	switch err {
+	case journal.ErrNoSuchKey:
+		return fmt.Errorf("%s: %w", config.Name, ErrNotFound)
+	case journal.ErrConstraint:
+		return fmt.Errorf("%s: external pool %q replaced during removal", config.Name, config.ID)
+	}
+	return err
+}
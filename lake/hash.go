@@ -0,0 +1,61 @@
+package lake
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"hash"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/sup"
+	"github.com/brimdata/super/zio"
+)
+
+// hashReader computes a content hash of the values read through it.  The
+// hash covers the type and bytes of each value in order, so the same values
+// have the same hash regardless of the format from which they were read.
+type hashReader struct {
+	zio.Reader
+	hash  hash.Hash
+	types map[super.Type][]byte
+}
+
+func newHashReader(r zio.Reader) *hashReader {
+	return &hashReader{
+		Reader: r,
+		hash:   sha256.New(),
+		types:  make(map[super.Type][]byte),
+	}
+}
+
+func (h *hashReader) Read() (*super.Value, error) {
+	val, err := h.Reader.Read()
+	if val == nil || err != nil {
+		return val, err
+	}
+	typ, ok := h.types[val.Type()]
+	if !ok {
+		typ = []byte(sup.FormatType(val.Type()))
+		h.types[val.Type()] = typ
+	}
+	h.write(typ)
+	if val.IsNull() {
+		h.hash.Write([]byte{0})
+	} else {
+		h.write(val.Bytes())
+	}
+	return val, nil
+}
+
+// write writes b to the hash prefixed by its length plus one, which both
+// marks the boundaries between values and distinguishes an empty value
+// from a null one, which is written as a zero length.
+func (h *hashReader) write(b []byte) {
+	h.hash.Write(binary.AppendUvarint(nil, uint64(len(b))+1))
+	h.hash.Write(b)
+}
+
+// Sum returns the hash, in hexadecimal, of the values read so far.
+func (h *hashReader) Sum() string {
+	return hex.EncodeToString(h.hash.Sum(nil))
+}
@@ -46,3 +46,21 @@ func TestJournalConcurrent(t *testing.T) {
 		require.NoError(t, <-ch)
 	}
 }
+
+func TestJournalStaleHead(t *testing.T) {
+	ctx := context.Background()
+	q := newQueue(ctx, t)
+	_, err := q.Commit(ctx, []byte("one"))
+	require.NoError(t, err)
+	_, err = q.Commit(ctx, []byte("two"))
+	require.NoError(t, err)
+	// Simulate a writer whose update of HEAD was overwritten by a
+	// slower writer.
+	require.NoError(t, q.writeHead(ctx, 1))
+	head, err := q.ReadHead(ctx)
+	require.NoError(t, err)
+	require.Equal(t, ID(2), head)
+	id, err := q.Commit(ctx, []byte("three"))
+	require.NoError(t, err)
+	require.Equal(t, ID(3), id)
+}
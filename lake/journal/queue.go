@@ -49,12 +49,24 @@ func (q *Queue) Path() *storage.URI {
 	return q.path
 }
 
+// ReadHead returns the ID of the last entry of the journal.  Since writers
+// racing to update the HEAD object may leave it behind the last entry,
+// HEAD is only a hint and ReadHead probes for entries past it.
 func (q *Queue) ReadHead(ctx context.Context) (ID, error) {
-	//XXX The head file can be wrong due to races but it will always be
-	// close so we should probe for the next slot(s) and update the HEAD
-	// object if we find a hit.  See issue #XXX.
 	id, _, err := readID(ctx, q.engine, q.headPath)
-	return id, err
+	if err != nil {
+		return Nil, err
+	}
+	for {
+		ok, err := q.engine.Exists(ctx, q.uri(id+1))
+		if err != nil {
+			return Nil, err
+		}
+		if !ok {
+			return id, nil
+		}
+		id++
+	}
 }
 
 func (q *Queue) writeHead(ctx context.Context, id ID) error {
@@ -91,7 +103,8 @@ func (q *Queue) Boundaries(ctx context.Context) (ID, ID, error) {
 	return head, tail, nil
 }
 
-// XXX This needs concurrency work. See issue #2546.
+// Commit appends b to the journal after its current HEAD and returns the ID
+// of the new entry.
 func (q *Queue) Commit(ctx context.Context, b []byte) (ID, error) {
 	head, err := q.ReadHead(ctx)
 	if err != nil {
@@ -113,9 +126,9 @@ func (q *Queue) CommitAt(ctx context.Context, at ID, b []byte) error {
 		if err != storage.ErrNotSupported {
 			return err
 		}
-		//XXX Here, we need to emulate PutIfNotExists using S3's
-		// strong ordering guarantees.  Currently, this is incorrect
-		// and can race with multiple writers.  See issue #2686.
+		// The local file system and S3 both support PutIfNotExists.
+		// For any other storage, this write can race with other
+		// writers so such a journal must have a single writer.
 		w, err := q.engine.Put(ctx, uri)
 		if err != nil {
 			return err
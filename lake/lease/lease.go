@@ -0,0 +1,230 @@
+// Package lease provides expiring, exclusive leases stored alongside a lake
+// so that, of the processes sharing a lake root, one at a time may hold a
+// lease to perform a task.
+//
+// A lease is stored as a sequence of records, each an object named by its
+// generation number.  Records are written with storage.Engine.PutIfNotExists,
+// which is atomic on the local file system and on S3, so at most one process
+// can write each generation.  A lease is held by the owner of the record with
+// the highest generation until that record's expiration time.  Acquiring,
+// renewing, and releasing a lease each write the next generation, so a holder
+// that has lost its lease to another process learns so from its next write
+// and the generation of a held lease serves as a fencing token for the work
+// done under it.  Expiration times are compared with the local clock, so
+// holders should renew a lease well before it expires.
+package lease
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/brimdata/super/sup"
+)
+
+const ext = "sup"
+
+var (
+	ErrHeld = errors.New("lease is held by another owner")
+	ErrLost = errors.New("lease was lost to another owner")
+)
+
+type record struct {
+	Owner   string  `super:"owner"`
+	Expires nano.Ts `super:"expires"`
+}
+
+type Lease struct {
+	engine  storage.Engine
+	path    *storage.URI
+	owner   string
+	ttl     time.Duration
+	gen     uint64
+	expires nano.Ts
+}
+
+// Acquire acquires the lease stored at path for owner for a duration of ttl.
+// If another owner holds the lease, Acquire returns an error wrapping
+// ErrHeld.
+func Acquire(ctx context.Context, engine storage.Engine, path *storage.URI, owner string, ttl time.Duration) (*Lease, error) {
+	gen, err := latest(ctx, engine, path)
+	if err != nil {
+		return nil, err
+	}
+	if gen != 0 {
+		rec, err := read(ctx, engine, path, gen)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				// The holder has renewed the lease since the call
+				// to latest and removed the record.
+				err = ErrHeld
+			}
+			return nil, err
+		}
+		if rec.Owner != owner && nano.Now() < rec.Expires {
+			return nil, fmt.Errorf("%s: %w", rec.Owner, ErrHeld)
+		}
+	}
+	l := &Lease{
+		engine: engine,
+		path:   path,
+		owner:  owner,
+		ttl:    ttl,
+		gen:    gen,
+	}
+	if err := l.write(ctx, nano.Now().Add(nano.Duration(ttl))); err != nil {
+		if errors.Is(err, ErrLost) {
+			err = ErrHeld
+		}
+		return nil, err
+	}
+	return l, nil
+}
+
+// Generation returns the generation of the lease's latest record, which
+// increases with each acquisition or renewal of the lease.
+func (l *Lease) Generation() uint64 {
+	return l.gen
+}
+
+// Expires returns the time at which the lease expires unless renewed.
+func (l *Lease) Expires() time.Time {
+	return l.expires.Time()
+}
+
+// Renew extends the lease for its ttl from now.  If another owner has
+// acquired the lease since l was acquired or last renewed, Renew returns
+// ErrLost.
+func (l *Lease) Renew(ctx context.Context) error {
+	return l.write(ctx, nano.Now().Add(nano.Duration(l.ttl)))
+}
+
+// Release releases the lease so that another owner may acquire it before
+// it would otherwise expire.
+func (l *Lease) Release(ctx context.Context) error {
+	return l.write(ctx, 0)
+}
+
+func (l *Lease) write(ctx context.Context, expires nano.Ts) error {
+	s, err := sup.Marshal(record{Owner: l.owner, Expires: expires})
+	if err != nil {
+		return err
+	}
+	gen := l.gen + 1
+	if err := l.engine.PutIfNotExists(ctx, uri(l.path, gen), []byte(s)); err != nil {
+		if os.IsExist(err) {
+			return ErrLost
+		}
+		return err
+	}
+	if l.gen > 1 {
+		// Remove the record before the previous one.  Keeping the
+		// previous record ensures a concurrent call to latest always
+		// finds a record.
+		l.engine.Delete(ctx, uri(l.path, l.gen-1))
+	}
+	l.gen = gen
+	l.expires = expires
+	return nil
+}
+
+// latest returns the highest generation of the records at path or zero
+// if there are none.
+func latest(ctx context.Context, engine storage.Engine, path *storage.URI) (uint64, error) {
+	infos, err := engine.List(ctx, path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	var max uint64
+	for _, info := range infos {
+		name, ok := strings.CutSuffix(info.Name, "."+ext)
+		if !ok {
+			continue
+		}
+		if gen, err := strconv.ParseUint(name, 10, 64); err == nil && gen > max {
+			max = gen
+		}
+	}
+	return max, nil
+}
+
+func read(ctx context.Context, engine storage.Engine, path *storage.URI, gen uint64) (*record, error) {
+	b, err := storage.Get(ctx, engine, uri(path, gen))
+	if err != nil {
+		return nil, err
+	}
+	var rec record
+	if err := sup.Unmarshal(string(b), &rec); err != nil {
+		return nil, err
+	}
+	return &rec, nil
+}
+
+func uri(path *storage.URI, gen uint64) *storage.URI {
+	return path.JoinPath(fmt.Sprintf("%d.%s", gen, ext))
+}
+
+// An Elector elects its owner to perform a task when it holds a lease,
+// acquiring or renewing the lease on each call to Elect.
+type Elector struct {
+	engine storage.Engine
+	path   *storage.URI
+	owner  string
+	ttl    time.Duration
+	lease  *Lease
+}
+
+func NewElector(engine storage.Engine, path *storage.URI, owner string, ttl time.Duration) *Elector {
+	return &Elector{
+		engine: engine,
+		path:   path,
+		owner:  owner,
+		ttl:    ttl,
+	}
+}
+
+// Elect returns true if e's owner holds the lease.
+func (e *Elector) Elect(ctx context.Context) (bool, error) {
+	if e.lease != nil {
+		err := e.lease.Renew(ctx)
+		if err == nil {
+			return true, nil
+		}
+		if !errors.Is(err, ErrLost) {
+			return false, err
+		}
+		e.lease = nil
+	}
+	l, err := Acquire(ctx, e.engine, e.path, e.owner, e.ttl)
+	if err != nil {
+		if errors.Is(err, ErrHeld) {
+			return false, nil
+		}
+		return false, err
+	}
+	e.lease = l
+	return true, nil
+}
+
+// Release releases the lease if e's owner holds it.
+func (e *Elector) Release(ctx context.Context) error {
+	if e.lease == nil {
+		return nil
+	}
+	err := e.lease.Release(ctx)
+	e.lease = nil
+	if errors.Is(err, ErrLost) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,69 @@
+package lease
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLease(t *testing.T) {
+	ctx := context.Background()
+	engine := storage.NewLocalEngine()
+	path := storage.MustParseURI(t.TempDir())
+
+	a, err := Acquire(ctx, engine, path, "a", time.Hour)
+	require.NoError(t, err)
+	_, err = Acquire(ctx, engine, path, "b", time.Hour)
+	require.ErrorIs(t, err, ErrHeld)
+	require.NoError(t, a.Renew(ctx))
+	require.NoError(t, a.Release(ctx))
+
+	b, err := Acquire(ctx, engine, path, "b", time.Hour)
+	require.NoError(t, err)
+	assert.Greater(t, b.Generation(), a.Generation())
+	require.ErrorIs(t, a.Renew(ctx), ErrLost)
+}
+
+func TestLeaseExpired(t *testing.T) {
+	ctx := context.Background()
+	engine := storage.NewLocalEngine()
+	path := storage.MustParseURI(t.TempDir())
+
+	a, err := Acquire(ctx, engine, path, "a", time.Nanosecond)
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond)
+	_, err = Acquire(ctx, engine, path, "b", time.Hour)
+	require.NoError(t, err)
+	require.ErrorIs(t, a.Renew(ctx), ErrLost)
+}
+
+func TestElectorConcurrent(t *testing.T) {
+	ctx := context.Background()
+	engine := storage.NewLocalEngine()
+	path := storage.MustParseURI(t.TempDir())
+
+	const N = 20
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var elected []string
+	for i := range N {
+		wg.Add(1)
+		go func(owner string) {
+			defer wg.Done()
+			ok, err := NewElector(engine, path, owner, time.Hour).Elect(ctx)
+			assert.NoError(t, err)
+			if ok {
+				mu.Lock()
+				elected = append(elected, owner)
+				mu.Unlock()
+			}
+		}(string(rune('a' + i)))
+	}
+	wg.Wait()
+	require.Len(t, elected, 1)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io/fs"
 	"runtime"
+	"slices"
 	"sync"
 
 	"github.com/brimdata/super"
@@ -14,6 +15,7 @@ import (
 	"github.com/brimdata/super/lake/data"
 	"github.com/brimdata/super/lake/pools"
 	"github.com/brimdata/super/lakeparse"
+	"github.com/brimdata/super/pkg/nano"
 	"github.com/brimdata/super/pkg/storage"
 	"github.com/brimdata/super/runtime/sam/expr"
 	"github.com/brimdata/super/sup"
@@ -32,11 +34,12 @@ const (
 
 type Pool struct {
 	pools.Config
-	engine   storage.Engine
-	Path     *storage.URI
-	DataPath *storage.URI
-	branches *branches.Store
-	commits  *commits.Store
+	engine    storage.Engine
+	Path      *storage.URI
+	DataPath  *storage.URI
+	branches  *branches.Store
+	commits   *commits.Store
+	snapCache *SnapshotCache
 }
 
 func CreatePool(ctx context.Context, engine storage.Engine, logger *zap.Logger, root *storage.URI, config *pools.Config) error {
@@ -103,11 +106,68 @@ func (p *Pool) removeBranch(ctx context.Context, name string) error {
 	if err != nil {
 		return err
 	}
-	return p.branches.Remove(ctx, *config)
+	if err := p.branches.Remove(ctx, *config); err != nil {
+		return err
+	}
+	p.snapCache.Invalidate(p.ID, name)
+	return nil
 }
 
 func (p *Pool) Snapshot(ctx context.Context, commit ksuid.KSUID) (commits.View, error) {
-	return p.commits.Snapshot(ctx, commit)
+	if snap, ok := p.snapCache.snapshot(p.ID, commit); ok {
+		return snap, nil
+	}
+	snap, err := p.commits.Snapshot(ctx, commit)
+	if err != nil {
+		return nil, err
+	}
+	p.snapCache.addSnapshot(p.ID, commit, snap)
+	return snap, nil
+}
+
+// CommitAsOf returns the commit that was the tip of the history ending at
+// commit as of time ts.
+func (p *Pool) CommitAsOf(ctx context.Context, commit ksuid.KSUID, ts nano.Ts) (ksuid.KSUID, error) {
+	return p.commits.AsOf(ctx, commit, ts)
+}
+
+// CommitDate returns the date of commit.
+func (p *Pool) CommitDate(ctx context.Context, commit ksuid.KSUID) (nano.Ts, error) {
+	return p.commits.Date(ctx, commit)
+}
+
+// AddedObjects returns the data objects added by the commits in the history
+// ending at commit that follow since, oldest first.  Commits that also delete
+// objects rewrite existing data (e.g., compactions and deletes) rather than
+// add new data and so are skipped.  If since is not in the history, the
+// objects of the entire history are returned.
+func (p *Pool) AddedObjects(ctx context.Context, since, commit ksuid.KSUID) ([]data.Object, error) {
+	var added [][]data.Object
+	for at := commit; at != ksuid.Nil && at != since; {
+		o, err := p.commits.Get(ctx, at)
+		if err != nil {
+			return nil, err
+		}
+		var objects []data.Object
+		var deletes bool
+		for _, action := range o.Actions {
+			switch action := action.(type) {
+			case *commits.Add:
+				objects = append(objects, action.Object)
+			case *commits.Delete:
+				deletes = true
+			}
+		}
+		if !deletes {
+			added = append(added, objects)
+		}
+		at = o.Parent
+	}
+	var objects []data.Object
+	for _, a := range slices.Backward(added) {
+		objects = append(objects, a...)
+	}
+	return objects, nil
 }
 
 func (p *Pool) OpenCommitLog(ctx context.Context, sctx *super.Context, commit ksuid.KSUID) zio.Reader {
@@ -259,6 +319,16 @@ func (p *Pool) Vacuum(ctx context.Context, commit ksuid.KSUID, dryrun bool) ([]k
 			}
 			return err
 		})
+		// And any summaries.
+		for k := range p.Summaries {
+			group.Go(func() error {
+				err := p.engine.Delete(ctx, data.SummaryURI(p.DataPath, o.ID, k))
+				if errors.Is(err, fs.ErrNotExist) {
+					err = nil
+				}
+				return err
+			})
+		}
 	}
 	if err := group.Wait(); err != nil {
 		return nil, err
@@ -1,6 +1,9 @@
 package pools
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/lake/data"
 	"github.com/brimdata/super/lake/journal"
@@ -19,11 +22,57 @@ type Config struct {
 	SortKeys   order.SortKeys `super:"layout"`
 	SeekStride int            `super:"seek_stride"`
 	Threshold  int64          `super:"threshold"`
+	// Summaries holds the text of the aggregations, e.g., "count() by key",
+	// whose partial results are maintained for each data object by compaction.
+	Summaries []string `super:"summaries"`
+	// Defaults holds the query defaults declared for the pool.
+	Defaults Defaults `super:"defaults"`
+	// Transform holds the text of a query, e.g., "rename host:=hostname",
+	// applied to the values loaded into the pool before they are written
+	// to data objects.
+	Transform string `super:"transform"`
+	// Masks holds the policies that hide the values of sensitive fields
+	// from queries of the pool.
+	Masks []Mask `super:"masks"`
+	// Partition, if not zero, places the values of the pool in data
+	// objects by partition.
+	Partition Partition `super:"partition"`
+}
+
+// Defaults holds the defaults that the compiler applies to queries of a pool
+// so that common queries need not spell them out.
+type Defaults struct {
+	// Time is the dotted path of the field to which a time range in a
+	// from operator, e.g., "from logs range 2024-01-01T00:00:00Z to
+	// 2024-01-02T00:00:00Z", applies.
+	Time string `super:"time" json:"time,omitempty"`
+	// Order is the sort key, in the form key[:asc|:desc], by which the values
+	// selected from the pool by a query that only filters them are sorted.
+	Order string `super:"order" json:"order,omitempty"`
+	// Aliases maps alternative names for fields to their dotted paths.
+	Aliases map[string]string `super:"aliases" json:"aliases,omitempty"`
+}
+
+func (d Defaults) IsZero() bool {
+	return d.Time == "" && d.Order == "" && len(d.Aliases) == 0
+}
+
+// Check returns an error if any of the defaults is malformed.
+func (d Defaults) Check() error {
+	if _, err := order.ParseSortKeys(d.Order); err != nil {
+		return fmt.Errorf("default order %q: %w", d.Order, err)
+	}
+	for name, path := range d.Aliases {
+		if name == "" || strings.Contains(name, ".") || path == "" {
+			return fmt.Errorf("malformed alias %q for field %q", name, path)
+		}
+	}
+	return nil
 }
 
 var _ journal.Entry = (*Config)(nil)
 
-func NewConfig(name string, sortKeys order.SortKeys, thresh int64, seekStride int) *Config {
+func NewConfig(name string, sortKeys order.SortKeys, thresh int64, seekStride int, summaries []string, defaults Defaults, transform string, masks []Mask, partition Partition) *Config {
 	if sortKeys.IsNil() {
 		sortKeys = order.SortKeys{order.NewSortKey(order.Desc, field.Dotted("ts"))}
 	}
@@ -40,6 +89,11 @@ func NewConfig(name string, sortKeys order.SortKeys, thresh int64, seekStride in
 		SortKeys:   sortKeys,
 		SeekStride: seekStride,
 		Threshold:  thresh,
+		Summaries:  summaries,
+		Defaults:   defaults,
+		Transform:  transform,
+		Masks:      masks,
+		Partition:  partition,
 	}
 }
 
@@ -63,6 +117,23 @@ type marshalConfig struct {
 	Threshold  int64       `super:"threshold"`
 }
 
+// marshalSummariesConfig extends marshalConfig with summaries, defaults,
+// transform, masks, and partition, which we include only when present so the common
+// case is unchanged.
+type marshalSummariesConfig struct {
+	Ts         nano.Ts     `super:"ts"`
+	Name       string      `super:"name"`
+	ID         ksuid.KSUID `super:"id"`
+	SortKey    oldSortKey  `super:"layout"`
+	SeekStride int         `super:"seek_stride"`
+	Threshold  int64       `super:"threshold"`
+	Summaries  []string    `super:"summaries"`
+	Defaults   *Defaults   `super:"defaults"`
+	Transform  string      `super:"transform"`
+	Masks      []Mask      `super:"masks"`
+	Partition  *Partition  `super:"partition"`
+}
+
 type oldSortKey struct {
 	Order order.Which `json:"order" super:"order"`
 	Keys  field.List  `json:"keys" super:"keys"`
@@ -70,11 +141,13 @@ type oldSortKey struct {
 
 var hackedBindings = []sup.Binding{
 	{Name: "order.SortKey", Template: oldSortKey{}},
-	{Name: "pools.Config", Template: marshalConfig{}},
+	{Name: "pools.Config", Template: marshalSummariesConfig{}},
 }
 
+var hackedMarshalBindings = append(hackedBindings, sup.Binding{Name: "pools.Config", Template: marshalConfig{}})
+
 func (p Config) MarshalBSUP(ctx *sup.MarshalBSUPContext) (super.Type, error) {
-	ctx.NamedBindings(hackedBindings)
+	ctx.NamedBindings(hackedMarshalBindings)
 	m := marshalConfig{
 		Ts:         p.Ts,
 		Name:       p.Name,
@@ -88,13 +161,33 @@ func (p Config) MarshalBSUP(ctx *sup.MarshalBSUPContext) (super.Type, error) {
 			m.SortKey.Keys = append(m.SortKey.Keys, sortKey.Key)
 		}
 	}
+	if len(p.Summaries) > 0 || !p.Defaults.IsZero() || p.Transform != "" || len(p.Masks) > 0 || !p.Partition.IsZero() {
+		ext := &marshalSummariesConfig{
+			Ts:         m.Ts,
+			Name:       m.Name,
+			ID:         m.ID,
+			SortKey:    m.SortKey,
+			SeekStride: m.SeekStride,
+			Threshold:  m.Threshold,
+			Summaries:  p.Summaries,
+			Transform:  p.Transform,
+			Masks:      p.Masks,
+		}
+		if !p.Defaults.IsZero() {
+			ext.Defaults = &p.Defaults
+		}
+		if !p.Partition.IsZero() {
+			ext.Partition = &p.Partition
+		}
+		return ctx.MarshalValue(ext)
+	}
 	typ, err := ctx.MarshalValue(&m)
 	return typ, err
 }
 
 func (p *Config) UnmarshalBSUP(ctx *sup.UnmarshalBSUPContext, val super.Value) error {
 	ctx.NamedBindings(hackedBindings)
-	var m marshalConfig
+	var m marshalSummariesConfig
 	if err := ctx.Unmarshal(val, &m); err != nil {
 		return err
 	}
@@ -103,6 +196,15 @@ func (p *Config) UnmarshalBSUP(ctx *sup.UnmarshalBSUPContext, val super.Value) e
 	p.ID = m.ID
 	p.SeekStride = m.SeekStride
 	p.Threshold = m.Threshold
+	p.Summaries = m.Summaries
+	p.Transform = m.Transform
+	p.Masks = m.Masks
+	if m.Defaults != nil {
+		p.Defaults = *m.Defaults
+	}
+	if m.Partition != nil {
+		p.Partition = *m.Partition
+	}
 	for _, k := range m.SortKey.Keys {
 		p.SortKeys = append(p.SortKeys, order.NewSortKey(m.SortKey.Order, k))
 	}
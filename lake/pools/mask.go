@@ -0,0 +1,82 @@
+package pools
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Methods by which a Mask hides the values of a field.
+const (
+	// MaskHash replaces a value with the SHA-256 digest of its string form.
+	MaskHash = "hash"
+	// MaskPartial replaces all but the last four characters of the string
+	// form of a value with "****".
+	MaskPartial = "partial"
+	// MaskNull replaces a value with null.
+	MaskNull = "null"
+)
+
+// A Mask is a policy that hides the values of a field of a pool from the
+// queries of requesters holding none of its roles.
+type Mask struct {
+	// Field is the dotted path of the masked field.
+	Field string `super:"field" json:"field"`
+	// Method is one of MaskHash, MaskPartial, or MaskNull.
+	Method string `super:"method" json:"method"`
+	// Roles holds the roles of the requesters that see the field unmasked.
+	Roles []string `super:"roles" json:"roles,omitempty"`
+}
+
+// ParseMask parses a mask of the form field:method[:role,role,...].
+func ParseMask(s string) (Mask, error) {
+	parts := strings.SplitN(s, ":", 3)
+	if len(parts) < 2 {
+		return Mask{}, fmt.Errorf("mask %q must be of the form field:method[:role,role,...]", s)
+	}
+	m := Mask{Field: parts[0], Method: parts[1]}
+	if len(parts) == 3 && parts[2] != "" {
+		m.Roles = strings.Split(parts[2], ",")
+	}
+	return m, m.Check()
+}
+
+// Check returns an error if m is malformed.
+func (m Mask) Check() error {
+	if m.Field == "" {
+		return fmt.Errorf("mask with method %q has no field", m.Method)
+	}
+	switch m.Method {
+	case MaskHash, MaskPartial, MaskNull:
+	default:
+		return fmt.Errorf("mask of field %q has unknown method %q (must be hash, partial, or null)", m.Field, m.Method)
+	}
+	for _, role := range m.Roles {
+		if role == "" {
+			return fmt.Errorf("mask of field %q has an empty role", m.Field)
+		}
+	}
+	return nil
+}
+
+// Applies returns true if a requester holding roles sees the field
+// masked.
+func (m Mask) Applies(roles []string) bool {
+	for _, role := range roles {
+		if slices.Contains(m.Roles, role) {
+			return false
+		}
+	}
+	return true
+}
+
+// AnyApplies returns true if a requester holding roles sees the field of
+// any of masks masked.
+func AnyApplies(masks []Mask, roles []string) bool {
+	for _, m := range masks {
+		if m.Applies(roles) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,91 @@
+package pools
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/pkg/nano"
+)
+
+// A Partition places the values of a pool in data objects by the value of
+// an expression derived from each value, e.g., its tenant or the day of its
+// timestamp, so that each data object holds the values of a single partition
+// and queries filtering on the expression skip the objects of the other
+// partitions.
+type Partition struct {
+	// Field is the dotted path of the field whose value, or whose value
+	// truncated to a multiple of Bin, is the partition expression.
+	Field string `super:"field" json:"field"`
+	// Bin, if nonzero, truncates a time or duration value of Field to a
+	// multiple of Bin as does the bucket function, e.g., Bin of 1d
+	// partitions a pool by the day of Field.
+	Bin nano.Duration `super:"bin" json:"bin,omitempty"`
+}
+
+// ParsePartition parses a partition of the form field[:bin], e.g., "tenant"
+// or "ts:1d".  An empty string yields a zero Partition.
+func ParsePartition(s string) (Partition, error) {
+	if s == "" {
+		return Partition{}, nil
+	}
+	fld, bin, ok := strings.Cut(s, ":")
+	p := Partition{Field: fld}
+	if ok {
+		var err error
+		if p.Bin, err = nano.ParseDuration(bin); err != nil {
+			return Partition{}, fmt.Errorf("partition %q: %w", s, err)
+		}
+	}
+	return p, p.Check()
+}
+
+func (p Partition) IsZero() bool {
+	return p.Field == "" && p.Bin == 0
+}
+
+// Check returns an error if p is malformed.
+func (p Partition) Check() error {
+	if p.IsZero() {
+		return nil
+	}
+	if p.Field == "" {
+		return fmt.Errorf("partition with bin %s has no field", p.Bin)
+	}
+	if p.Bin < 0 {
+		return fmt.Errorf("partition of field %q has negative bin %s", p.Field, p.Bin)
+	}
+	return nil
+}
+
+func (p Partition) Path() field.Path {
+	return field.Dotted(p.Field)
+}
+
+func (p Partition) String() string {
+	if p.Bin == 0 {
+		return p.Field
+	}
+	return p.Field + ":" + p.Bin.String()
+}
+
+// Of returns the partition of val.  If Bin is nonzero, values whose field is
+// neither a time nor a duration belong to the null partition.  The result
+// may reference the bytes of val.
+func (p Partition) Of(val super.Value) super.Value {
+	key := val.DerefPath(p.Path()).MissingAsNull()
+	if p.Bin == 0 {
+		return key
+	}
+	key = key.Under()
+	switch {
+	case key.IsNull():
+		return super.Null
+	case key.Type().ID() == super.IDTime:
+		return super.NewTime(nano.Ts(key.Int()).Trunc(p.Bin))
+	case key.Type().ID() == super.IDDuration:
+		return super.NewDuration(nano.Duration(key.Int()).Trunc(p.Bin))
+	}
+	return super.Null
+}
@@ -6,14 +6,19 @@ import (
 	"errors"
 	"fmt"
 	"io/fs"
+	"sync"
+	"time"
 
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/bsupbytes"
 	"github.com/brimdata/super/compiler/dag"
 	"github.com/brimdata/super/lake/branches"
 	"github.com/brimdata/super/lake/data"
+	"github.com/brimdata/super/lake/externals"
+	"github.com/brimdata/super/lake/lease"
 	"github.com/brimdata/super/lake/pools"
 	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/pkg/nano"
 	"github.com/brimdata/super/pkg/storage"
 	"github.com/brimdata/super/runtime/sam/expr"
 	"github.com/brimdata/super/runtime/vcache"
@@ -28,6 +33,8 @@ import (
 const (
 	Version         = 4
 	PoolsTag        = "pools"
+	ExternalsTag    = "externals"
+	LeasesTag       = "leases"
 	LakeMagicFile   = "lake.bsup"
 	LakeMagicString = "ZED LAKE"
 )
@@ -46,7 +53,12 @@ type Root struct {
 
 	poolCache *arc.ARCCache[ksuid.KSUID, *Pool]
 	pools     *pools.Store
+	snapCache *SnapshotCache
 	vCache    *vcache.Cache
+
+	externalsMu sync.Mutex // Protects externals and listings.
+	externals   *externals.Store
+	listings    map[ksuid.KSUID]externalListing
 }
 
 type LakeMagic struct {
@@ -68,6 +80,7 @@ func newRoot(engine storage.Engine, logger *zap.Logger, path *storage.URI) *Root
 		path:      path,
 		poolCache: poolCache,
 		vCache:    vcache.NewCache(engine),
+		listings:  make(map[ksuid.KSUID]externalListing),
 	}
 }
 
@@ -108,6 +121,10 @@ func (r *Root) createConfig(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	r.externals, err = externals.CreateStore(ctx, r.engine, r.logger, r.path.JoinPath(ExternalsTag))
+	if err != nil {
+		return err
+	}
 	return r.writeLakeMagic(ctx)
 }
 
@@ -249,7 +266,18 @@ func (r *Root) PoolID(ctx context.Context, poolName string) (ksuid.KSUID, error)
 	return poolRef.ID, nil
 }
 
+// SetSnapshotCache sets the cache of branch tips and their snapshots used
+// by r and the pools it opens.
+func (r *Root) SetSnapshotCache(cache *SnapshotCache) {
+	r.snapCache = cache
+	r.poolCache.Purge()
+}
+
 func (r *Root) CommitObject(ctx context.Context, poolID ksuid.KSUID, branchName string) (ksuid.KSUID, error) {
+	commit, gen, ok := r.snapCache.tip(poolID, branchName)
+	if ok {
+		return commit, nil
+	}
 	pool, err := r.OpenPool(ctx, poolID)
 	if err != nil {
 		return ksuid.Nil, err
@@ -258,9 +286,36 @@ func (r *Root) CommitObject(ctx context.Context, poolID ksuid.KSUID, branchName
 	if err != nil {
 		return ksuid.Nil, err
 	}
+	r.snapCache.addTip(poolID, branchName, branchRef.Commit, gen)
 	return branchRef.Commit, nil
 }
 
+func (r *Root) CommitAsOf(ctx context.Context, poolID, commit ksuid.KSUID, ts nano.Ts) (ksuid.KSUID, error) {
+	pool, err := r.OpenPool(ctx, poolID)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	return pool.CommitAsOf(ctx, commit, ts)
+}
+
+// PoolDefaults returns the query defaults declared for the pool.
+func (r *Root) PoolDefaults(ctx context.Context, poolID ksuid.KSUID) (pools.Defaults, error) {
+	config, err := r.pools.LookupByID(ctx, poolID)
+	if err != nil {
+		return pools.Defaults{}, err
+	}
+	return config.Defaults, nil
+}
+
+// PoolMasks returns the masks declared for the pool.
+func (r *Root) PoolMasks(ctx context.Context, poolID ksuid.KSUID) ([]pools.Mask, error) {
+	config, err := r.pools.LookupByID(ctx, poolID)
+	if err != nil {
+		return nil, err
+	}
+	return config.Masks, nil
+}
+
 func (r *Root) SortKeys(ctx context.Context, src dag.Op) order.SortKeys {
 	switch src := src.(type) {
 	case *dag.Lister:
@@ -306,28 +361,46 @@ func (r *Root) openPool(ctx context.Context, config *pools.Config) (*Pool, error
 	if err != nil {
 		return nil, err
 	}
+	p.snapCache = r.snapCache
 	r.poolCache.Add(config.ID, p)
 	return p, nil
 }
 
 func (r *Root) RenamePool(ctx context.Context, id ksuid.KSUID, newName string) error {
+	if err := r.checkExternalName(ctx, newName); err != nil {
+		return err
+	}
 	return r.pools.Rename(ctx, id, newName)
 }
 
-func (r *Root) CreatePool(ctx context.Context, name string, sortKeys order.SortKeys, seekStride int, thresh int64) (*Pool, error) {
+func (r *Root) CreatePool(ctx context.Context, name string, sortKeys order.SortKeys, seekStride int, thresh int64, summaries []string, defaults pools.Defaults, transform string, masks []pools.Mask, partition pools.Partition) (*Pool, error) {
 	if name == "HEAD" {
 		return nil, fmt.Errorf("pool cannot be named %q", name)
 	}
 	if r.pools.LookupByName(ctx, name) != nil {
 		return nil, fmt.Errorf("%s: %w", name, pools.ErrExists)
 	}
+	if err := r.checkExternalName(ctx, name); err != nil {
+		return nil, err
+	}
 	if thresh == 0 {
 		thresh = data.DefaultThreshold
 	}
 	if len(sortKeys) > 1 {
 		return nil, errors.New("multiple pool keys not supported")
 	}
-	config := pools.NewConfig(name, sortKeys, thresh, seekStride)
+	if err := defaults.Check(); err != nil {
+		return nil, err
+	}
+	for _, m := range masks {
+		if err := m.Check(); err != nil {
+			return nil, err
+		}
+	}
+	if err := partition.Check(); err != nil {
+		return nil, err
+	}
+	config := pools.NewConfig(name, sortKeys, thresh, seekStride, summaries, defaults, transform, masks, partition)
 	if err := CreatePool(ctx, r.engine, r.logger, r.path, config); err != nil {
 		return nil, err
 	}
@@ -357,6 +430,7 @@ func (r *Root) RemovePool(ctx context.Context, id ksuid.KSUID) error {
 	// With no entry in the pool store, it will be inaccessible and
 	// eventually evicted by the cache's LRU algorithm.
 	r.poolCache.Remove(config.ID)
+	r.snapCache.InvalidatePool(config.ID)
 	return RemovePool(ctx, r.engine, r.path, config)
 }
 
@@ -410,6 +484,17 @@ func (r *Root) Open(context.Context, *super.Context, string, string, zbuf.Pushdo
 	return nil, errors.New("cannot use 'file' or 'http' source in a lake query")
 }
 
+// NewElector returns a lease.Elector for the lease named name stored under
+// the lake root.
+func (r *Root) NewElector(name, owner string, ttl time.Duration) *lease.Elector {
+	return lease.NewElector(r.engine, r.path.JoinPath(LeasesTag, name), owner, ttl)
+}
+
+// Engine returns the storage engine of the lake.
+func (r *Root) Engine() storage.Engine {
+	return r.engine
+}
+
 func (r *Root) VectorCache() *vcache.Cache {
 	return r.vCache
 }
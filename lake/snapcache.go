@@ -0,0 +1,130 @@
+package lake
+
+import (
+	"sync"
+
+	"github.com/brimdata/super/lake/commits"
+	"github.com/segmentio/ksuid"
+)
+
+// A SnapshotCache caches the tip commit of each branch looked up through a
+// Root and the snapshot at that tip so that queries need not reload them.
+// Commits made through the Root invalidate the entries they affect, but
+// commits made by other processes sharing the lake do not, so the owner of
+// the cache must call Invalidate for each such commit it learns of.
+type SnapshotCache struct {
+	mu sync.Mutex
+	// gen counts invalidations so that a tip looked up concurrently with
+	// an invalidation is not cached.
+	gen   uint64
+	tips  map[branchKey]ksuid.KSUID
+	snaps map[commitKey]commits.View
+}
+
+type branchKey struct {
+	pool   ksuid.KSUID
+	branch string
+}
+
+type commitKey struct {
+	pool   ksuid.KSUID
+	commit ksuid.KSUID
+}
+
+func NewSnapshotCache() *SnapshotCache {
+	return &SnapshotCache{
+		tips:  make(map[branchKey]ksuid.KSUID),
+		snaps: make(map[commitKey]commits.View),
+	}
+}
+
+// tip returns the cached tip of the named branch of pool, if any, and the
+// generation to pass to addTip if there is none.
+func (s *SnapshotCache) tip(pool ksuid.KSUID, branch string) (ksuid.KSUID, uint64, bool) {
+	if s == nil {
+		return ksuid.Nil, 0, false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	commit, ok := s.tips[branchKey{pool, branch}]
+	return commit, s.gen, ok
+}
+
+func (s *SnapshotCache) addTip(pool ksuid.KSUID, branch string, commit ksuid.KSUID, gen uint64) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	if s.gen == gen {
+		s.tips[branchKey{pool, branch}] = commit
+	}
+	s.mu.Unlock()
+}
+
+func (s *SnapshotCache) snapshot(pool, commit ksuid.KSUID) (commits.View, bool) {
+	if s == nil {
+		return nil, false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	snap, ok := s.snaps[commitKey{pool, commit}]
+	return snap, ok
+}
+
+// addSnapshot caches snap if commit is the tip of a cached branch of pool.
+func (s *SnapshotCache) addSnapshot(pool, commit ksuid.KSUID, snap commits.View) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, tip := range s.tips {
+		if key.pool == pool && tip == commit {
+			s.snaps[commitKey{pool, commit}] = snap
+			return
+		}
+	}
+}
+
+// Invalidate removes the entry for the named branch of pool.
+func (s *SnapshotCache) Invalidate(pool ksuid.KSUID, branch string) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.gen++
+	key := branchKey{pool, branch}
+	commit, ok := s.tips[key]
+	if !ok {
+		return
+	}
+	delete(s.tips, key)
+	for key, tip := range s.tips {
+		if key.pool == pool && tip == commit {
+			// Another branch shares the snapshot.
+			return
+		}
+	}
+	delete(s.snaps, commitKey{pool, commit})
+}
+
+// InvalidatePool removes the entries for all branches of pool.
+func (s *SnapshotCache) InvalidatePool(pool ksuid.KSUID) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.gen++
+	for key := range s.tips {
+		if key.pool == pool {
+			delete(s.tips, key)
+		}
+	}
+	for key := range s.snaps {
+		if key.pool == pool {
+			delete(s.snaps, key)
+		}
+	}
+}
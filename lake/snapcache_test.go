@@ -0,0 +1,60 @@
+package lake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brimdata/super/lake/data"
+	"github.com/brimdata/super/lake/pools"
+	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestSnapshotCache(t *testing.T) {
+	ctx := context.Background()
+	path := storage.MustParseURI(t.TempDir())
+	root, err := Create(ctx, storage.NewLocalEngine(), zap.NewNop(), path)
+	require.NoError(t, err)
+	cache := NewSnapshotCache()
+	root.SetSnapshotCache(cache)
+	sortKeys := order.SortKeys{order.NewSortKey(order.Asc, field.Path{"x"})}
+	pool, err := root.CreatePool(ctx, "test", sortKeys, data.DefaultSeekStride, data.DefaultThreshold, nil, pools.Defaults{}, "", nil, pools.Partition{})
+	require.NoError(t, err)
+	branch, err := pool.OpenBranchByName(ctx, "main")
+	require.NoError(t, err)
+
+	// A commit through the root invalidates the cached tip.
+	_, err = root.CommitObject(ctx, pool.ID, "main")
+	require.NoError(t, err)
+	commit := load(ctx, t, branch, "{x:1}")
+	tip, err := root.CommitObject(ctx, pool.ID, "main")
+	require.NoError(t, err)
+	require.Equal(t, commit, tip)
+	snap, err := pool.Snapshot(ctx, tip)
+	require.NoError(t, err)
+	cached, ok := cache.snapshot(pool.ID, tip)
+	require.True(t, ok)
+	require.Equal(t, snap, cached)
+
+	// A commit through another root does not until the cache is
+	// invalidated.
+	other, err := Open(ctx, storage.NewLocalEngine(), zap.NewNop(), path)
+	require.NoError(t, err)
+	otherPool, err := other.OpenPool(ctx, pool.ID)
+	require.NoError(t, err)
+	otherBranch, err := otherPool.OpenBranchByName(ctx, "main")
+	require.NoError(t, err)
+	commit = load(ctx, t, otherBranch, "{x:2}")
+	stale, err := root.CommitObject(ctx, pool.ID, "main")
+	require.NoError(t, err)
+	require.Equal(t, tip, stale)
+	cache.Invalidate(pool.ID, "main")
+	_, ok = cache.snapshot(pool.ID, tip)
+	require.False(t, ok)
+	tip, err = root.CommitObject(ctx, pool.ID, "main")
+	require.NoError(t, err)
+	require.Equal(t, commit, tip)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/lake/data"
+	"github.com/brimdata/super/lake/pools"
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/runtime/sam/expr"
 	"github.com/brimdata/super/zbuf"
@@ -18,10 +19,19 @@ import (
 // the pools data object threshold, sorts each resulting buffer, and writes
 // it as an immutable object to the storage system.  The presumption is that
 // each buffer's worth of data fits into memory.
+//
+// Writer detects input that is already in pool-key order.  As long as the
+// input remains sorted, buffers are written without sorting and are split
+// only where the pool key changes so that the resulting objects do not
+// overlap and the Slicer can place each in its own partition.
+//
+// If the pool is partitioned, each buffer is written as one object per
+// partition of its values.
 type Writer struct {
 	pool        *Pool
 	objects     []data.Object
 	inputSorted bool
+	last        super.Value
 	ctx         context.Context
 	sctx        *super.Context
 	errgroup    *errgroup.Group
@@ -33,8 +43,13 @@ type Writer struct {
 	// data efficiently in one big backing store.
 	buffer      chan []super.Value
 	comparator  *expr.Comparator
+	orderCmp    *expr.Comparator
+	keyCmp      *expr.Comparator
 	memBuffered int64
 	stats       ImportStats
+	// vectors is true if each object's vector (CSUP) form is written
+	// along with its sequence form.
+	vectors bool
 }
 
 //XXX NOTE: we removed the flusher logic as the callee should just put
@@ -46,20 +61,25 @@ type Writer struct {
 
 // NewWriter creates a zio.Writer compliant writer for writing data to an
 // a data pool presuming the input is not guaranteed to be sorted.
-// XXX we should make another writer that takes sorted input and is a bit
-// more efficient.  This other writer could have different commit triggers
-// to do useful things like paritioning given the context is a rollup.
+// XXX This writer could have different commit triggers to do useful things
+// like paritioning given the context is a rollup.
 func NewWriter(ctx context.Context, sctx *super.Context, pool *Pool) (*Writer, error) {
 	g, ctx := errgroup.WithContext(ctx)
 	ch := make(chan []super.Value, 1)
 	ch <- nil
 	return &Writer{
-		pool:       pool,
-		ctx:        ctx,
-		sctx:       sctx,
-		errgroup:   g,
-		buffer:     ch,
-		comparator: ImportComparator(sctx, pool),
+		pool:        pool,
+		inputSorted: true,
+		ctx:         ctx,
+		sctx:        sctx,
+		errgroup:    g,
+		buffer:      ch,
+		comparator:  ImportComparator(sctx, pool),
+		// orderCmp and keyCmp are used only by Write and not shared with
+		// the sorting goroutine since a Comparator is not safe for
+		// concurrent use.
+		orderCmp: ImportComparator(sctx, pool),
+		keyCmp:   poolKeyComparator(sctx, pool),
 	}, nil
 }
 
@@ -79,30 +99,50 @@ func (w *Writer) Write(rec super.Value) error {
 		}
 		return w.ctx.Err()
 	}
+	if w.inputSorted && w.last.Type() != nil && w.orderCmp.Compare(w.last, rec) > 0 {
+		w.inputSorted = false
+	}
+	//XXX change name LogSizeThreshold
+	// XXX the previous logic estimated the object size with divide by 2...?!
+	if w.memBuffered >= w.pool.Threshold && !w.extendRun(rec) {
+		w.flipBuffers()
+	}
 	// XXX This call leads to a ton of one-off allocations that burden the GC
 	// and slow down import. We should instead copy the raw record bytes into a
 	// recycled buffer and keep around an array of ts + byte-slice structs for
 	// sorting.
-	w.vals = append(w.vals, rec.Copy())
+	rec = rec.Copy()
+	w.vals = append(w.vals, rec)
+	// The bytes of rec are never modified so it is safe to retain rec
+	// after its buffer has been recycled.
+	w.last = rec
 	w.memBuffered += int64(len(rec.Bytes()))
-	//XXX change name LogSizeThreshold
-	// XXX the previous logic estimated the object size with divide by 2...?!
-	if w.memBuffered >= w.pool.Threshold {
-		w.flipBuffers()
-	}
 	return nil
 }
 
+// extendRun returns true if rec should be added to the current buffer even
+// though the buffer is full.  When the input is sorted, we avoid splitting a
+// run of equal pool keys across objects as this would cause the objects to
+// overlap.  To bound memory use, a run extends a buffer to at most twice the
+// pool threshold.
+func (w *Writer) extendRun(rec super.Value) bool {
+	if !w.inputSorted || w.keyCmp == nil || w.last.Type() == nil {
+		return false
+	}
+	return w.memBuffered < 2*w.pool.Threshold && w.keyCmp.Compare(w.last, rec) == 0
+}
+
 func (w *Writer) flipBuffers() {
 	oldvals, ok := <-w.buffer
 	if !ok {
 		return
 	}
 	recs := w.vals
+	sorted := w.inputSorted
 	w.vals = oldvals[:0]
 	w.memBuffered = 0
 	w.errgroup.Go(func() error {
-		err := w.writeObject(w.newObject(), recs)
+		err := w.writeObjects(recs, sorted)
 		if err != nil {
 			close(w.buffer)
 			return err
@@ -122,9 +162,25 @@ func (w *Writer) Close() error {
 	return w.errgroup.Wait()
 }
 
-func (w *Writer) writeObject(object *data.Object, recs []super.Value) error {
+// writeObjects writes recs to a new object or, if the pool is partitioned,
+// to a new object for each partition of recs.
+func (w *Writer) writeObjects(recs []super.Value, sorted bool) error {
+	if w.pool.Partition.IsZero() {
+		return w.writeObject(w.newObject(), recs, sorted)
+	}
+	for _, part := range partitionValues(w.pool.Partition, recs) {
+		object := w.newObject()
+		object.Partition = part.value
+		if err := w.writeObject(object, part.vals, sorted); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *Writer) writeObject(object *data.Object, recs []super.Value, sorted bool) error {
 	var zr zio.Reader
-	if w.inputSorted {
+	if sorted {
 		zr = zbuf.NewArray(recs)
 	} else {
 		done := make(chan struct{})
@@ -142,13 +198,35 @@ func (w *Writer) writeObject(object *data.Object, recs []super.Value) error {
 	if err != nil {
 		return err
 	}
-	if err := zio.CopyWithContext(w.ctx, writer, zr); err != nil {
+	var out zio.Writer = writer
+	var vectorWriter *data.VectorWriter
+	if w.vectors {
+		vectorWriter, err = object.NewVectorWriter(w.ctx, w.pool.engine, w.pool.DataPath)
+		if err != nil {
+			writer.Abort()
+			return err
+		}
+		out = zio.MultiWriter(writer, vectorWriter)
+	}
+	if err := zio.CopyWithContext(w.ctx, out, zr); err != nil {
 		writer.Abort()
+		if vectorWriter != nil {
+			vectorWriter.Abort()
+		}
 		return err
 	}
 	if err := writer.Close(w.ctx); err != nil {
+		if vectorWriter != nil {
+			vectorWriter.Abort()
+		}
 		return err
 	}
+	if vectorWriter != nil {
+		if err := vectorWriter.Close(); err != nil {
+			vectorWriter.Abort()
+			return err
+		}
+	}
 	w.stats.Accumulate(ImportStats{
 		ObjectsWritten:     1,
 		RecordBytesWritten: writer.BytesWritten(),
@@ -161,6 +239,10 @@ func (w *Writer) Stats() ImportStats {
 	return w.stats.Copy()
 }
 
+// SortedWriter writes values sorted in pool-key order to objects.  If the
+// pool is partitioned, SortedWriter writes the values of each partition to
+// their own objects, which remain sorted as each partition's values are a
+// subsequence of the input.
 type SortedWriter struct {
 	comparator    *expr.Comparator
 	ctx           context.Context
@@ -171,19 +253,33 @@ type SortedWriter struct {
 	vectorEnabled bool
 	vectorWriter  *data.VectorWriter
 	objects       []*data.Object
+	// partition is the partition of the objects written by w.
+	partition super.Value
+	// If the pool is partitioned, partitions holds the writer of each
+	// partition, which are listed in order of creation by children.
+	partitions map[partitionKey]*SortedWriter
+	children   []*SortedWriter
 }
 
 func NewSortedWriter(ctx context.Context, sctx *super.Context, pool *Pool, vectorEnabled bool) *SortedWriter {
-	return &SortedWriter{
+	w := &SortedWriter{
 		comparator:    ImportComparator(sctx, pool),
 		ctx:           ctx,
 		sortKey:       pool.SortKeys.Primary(),
 		pool:          pool,
 		vectorEnabled: vectorEnabled,
+		partition:     super.Null,
 	}
+	if !pool.Partition.IsZero() {
+		w.partitions = make(map[partitionKey]*SortedWriter)
+	}
+	return w
 }
 
 func (w *SortedWriter) Write(val super.Value) error {
+	if w.partitions != nil {
+		return w.partitionWriter(val).Write(val)
+	}
 	key := val.DerefPath(w.sortKey.Key).MissingAsNull()
 again:
 	if w.writer == nil {
@@ -215,7 +311,30 @@ again:
 	return nil
 }
 
+// partitionWriter returns the writer of the partition of val.
+func (w *SortedWriter) partitionWriter(val super.Value) *SortedWriter {
+	part := w.pool.Partition.Of(val)
+	key := newPartitionKey(part)
+	child, ok := w.partitions[key]
+	if !ok {
+		child = &SortedWriter{
+			comparator:    w.comparator,
+			ctx:           w.ctx,
+			sortKey:       w.sortKey,
+			pool:          w.pool,
+			vectorEnabled: w.vectorEnabled,
+			partition:     part.Copy(),
+		}
+		w.partitions[key] = child
+		w.children = append(w.children, child)
+	}
+	return child
+}
+
 func (w *SortedWriter) Abort() {
+	for _, child := range w.children {
+		child.Abort()
+	}
 	if w.writer != nil {
 		w.writer.Abort()
 		w.writer = nil
@@ -232,6 +351,7 @@ func (w *SortedWriter) Abort() {
 
 func (w *SortedWriter) newWriter() error {
 	o := data.NewObject()
+	o.Partition = w.partition
 	var err error
 	w.writer, err = o.NewWriter(w.ctx, w.pool.engine, w.pool.DataPath, w.sortKey, w.pool.SeekStride)
 	if err != nil {
@@ -248,7 +368,11 @@ func (w *SortedWriter) newWriter() error {
 }
 
 func (w *SortedWriter) Objects() []*data.Object {
-	return w.objects
+	objects := w.objects
+	for _, child := range w.children {
+		objects = append(objects, child.Objects()...)
+	}
+	return objects
 }
 
 func (w *SortedWriter) Vectors() []ksuid.KSUID {
@@ -256,13 +380,18 @@ func (w *SortedWriter) Vectors() []ksuid.KSUID {
 		return nil
 	}
 	var ids []ksuid.KSUID
-	for _, o := range w.objects {
+	for _, o := range w.Objects() {
 		ids = append(ids, o.ID)
 	}
 	return ids
 }
 
 func (w *SortedWriter) Close() error {
+	for _, child := range w.children {
+		if err := child.Close(); err != nil {
+			return err
+		}
+	}
 	if w.writer == nil {
 		return nil
 	}
@@ -306,7 +435,18 @@ func ImportComparator(sctx *super.Context, pool *Pool) *expr.Comparator {
 	}
 	// valueAsBytes establishes a total order.
 	exprs = append(exprs, expr.NewSortExpr(&valueAsBytes{}, o, o.NullsMax(true)))
-	return expr.NewComparator(exprs...).WithMissingAsNull()
+	return expr.NewComparator(exprs...)
+}
+
+// poolKeyComparator returns a comparator that compares values by the
+// pool's primary key only or nil if the pool has no key.
+func poolKeyComparator(sctx *super.Context, pool *Pool) *expr.Comparator {
+	if pool.SortKeys.IsNil() {
+		return nil
+	}
+	key := pool.SortKeys.Primary()
+	e := expr.NewSortExpr(expr.NewDottedExpr(sctx, key.Key), key.Order, key.Order.NullsMax(true))
+	return expr.NewComparator(e)
 }
 
 type valueAsBytes struct{}
@@ -314,3 +454,37 @@ type valueAsBytes struct{}
 func (v *valueAsBytes) Eval(ectx expr.Context, val super.Value) super.Value {
 	return super.NewBytes(val.Bytes())
 }
+
+// partitionKey identifies a partition value.
+type partitionKey struct {
+	typ   super.Type
+	bytes string
+}
+
+func newPartitionKey(val super.Value) partitionKey {
+	return partitionKey{val.Type(), string(val.Bytes())}
+}
+
+type partitionVals struct {
+	value super.Value
+	vals  []super.Value
+}
+
+// partitionValues splits vals by partition, preserving their order within
+// each partition.
+func partitionValues(p pools.Partition, vals []super.Value) []*partitionVals {
+	var parts []*partitionVals
+	index := make(map[partitionKey]*partitionVals)
+	for _, val := range vals {
+		part := p.Of(val)
+		key := newPartitionKey(part)
+		pv, ok := index[key]
+		if !ok {
+			pv = &partitionVals{value: part.Copy()}
+			index[key] = pv
+			parts = append(parts, pv)
+		}
+		pv.vals = append(pv.vals, val)
+	}
+	return parts
+}
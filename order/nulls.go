@@ -16,6 +16,10 @@ const (
 	NullsFirst Nulls = true
 )
 
+// DefaultNulls is the position of nulls in a sort when it is not specified
+// by the query.  It is set for a session, e.g., by a command-line flag.
+var DefaultNulls = NullsLast
+
 func (n Nulls) String() string {
 	if n == NullsFirst {
 		return "first"
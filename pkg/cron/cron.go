@@ -0,0 +1,204 @@
+// Package cron parses cron expressions and computes the times they schedule.
+//
+// An expression has five space-separated fields, which give the minute
+// (0-59), hour (0-23), day of month (1-31), month (1-12 or jan-dec), and day
+// of week (0-6 or sun-sat, with 7 also meaning Sunday) at which to run.  A
+// field is a comma-separated list of terms, each of which is "*", a value,
+// or a range "a-b", optionally followed by a step "/n".  As in traditional
+// cron, if both the day of month and the day of week are restricted, a day
+// matching either one is scheduled.
+//
+// An expression may instead be one of the descriptors @yearly (or
+// @annually), @monthly, @weekly, @daily (or @midnight), @hourly, or
+// "@every <duration>", where duration is a Go duration of at least a second.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Schedule struct {
+	every  time.Duration
+	minute uint64
+	hour   uint64
+	dom    uint64
+	month  uint64
+	dow    uint64
+	// domStar and dowStar are true if the day of month and day of week
+	// fields, respectively, are unrestricted.
+	domStar bool
+	dowStar bool
+}
+
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+type bounds struct {
+	name     string
+	min, max int
+	names    []string
+}
+
+var (
+	minutes  = bounds{"minute", 0, 59, nil}
+	hours    = bounds{"hour", 0, 23, nil}
+	days     = bounds{"day of month", 1, 31, nil}
+	months   = bounds{"month", 1, 12, []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}}
+	weekdays = bounds{"day of week", 0, 7, []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}}
+)
+
+// Parse parses a cron expression.
+func Parse(s string) (*Schedule, error) {
+	s = strings.TrimSpace(s)
+	if rest, ok := strings.CutPrefix(s, "@every "); ok {
+		d, err := time.ParseDuration(strings.TrimSpace(rest))
+		if err != nil {
+			return nil, fmt.Errorf("cron expression %q: %w", s, err)
+		}
+		if d < time.Second {
+			return nil, fmt.Errorf("cron expression %q: interval must be at least one second", s)
+		}
+		return &Schedule{every: d}, nil
+	}
+	expr := s
+	if strings.HasPrefix(s, "@") {
+		var ok bool
+		if expr, ok = descriptors[strings.ToLower(s)]; !ok {
+			return nil, fmt.Errorf("cron expression %q: unknown descriptor", s)
+		}
+	}
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression %q: expected 5 fields but found %d", s, len(fields))
+	}
+	var sched Schedule
+	var err error
+	if sched.minute, err = parseField(fields[0], minutes); err != nil {
+		return nil, fmt.Errorf("cron expression %q: %w", s, err)
+	}
+	if sched.hour, err = parseField(fields[1], hours); err != nil {
+		return nil, fmt.Errorf("cron expression %q: %w", s, err)
+	}
+	if sched.dom, err = parseField(fields[2], days); err != nil {
+		return nil, fmt.Errorf("cron expression %q: %w", s, err)
+	}
+	if sched.month, err = parseField(fields[3], months); err != nil {
+		return nil, fmt.Errorf("cron expression %q: %w", s, err)
+	}
+	if sched.dow, err = parseField(fields[4], weekdays); err != nil {
+		return nil, fmt.Errorf("cron expression %q: %w", s, err)
+	}
+	if sched.dow&(1<<7) != 0 {
+		// 7 is an alias for Sunday.
+		sched.dow |= 1
+	}
+	sched.domStar = strings.HasPrefix(fields[2], "*")
+	sched.dowStar = strings.HasPrefix(fields[4], "*")
+	return &sched, nil
+}
+
+// parseField returns a bit set of the values matched by field.
+func parseField(field string, b bounds) (uint64, error) {
+	var bits uint64
+	for _, term := range strings.Split(field, ",") {
+		lo, hi, step := b.min, b.max, 1
+		rng, stepStr, hasStep := strings.Cut(term, "/")
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepStr); err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step %q in %s field", stepStr, b.name)
+			}
+		}
+		if rng != "*" {
+			loStr, hiStr, isRange := strings.Cut(rng, "-")
+			var err error
+			if lo, err = b.value(loStr); err != nil {
+				return 0, err
+			}
+			hi = lo
+			if isRange {
+				if hi, err = b.value(hiStr); err != nil {
+					return 0, err
+				}
+				if hi < lo {
+					return 0, fmt.Errorf("invalid range %q in %s field", rng, b.name)
+				}
+			} else if hasStep {
+				// "a/n" means from a to the maximum by n.
+				hi = b.max
+			}
+		}
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << v
+		}
+	}
+	return bits, nil
+}
+
+func (b bounds) value(s string) (int, error) {
+	for i, name := range b.names {
+		if strings.EqualFold(s, name) {
+			return i + b.min, nil
+		}
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil || v < b.min || v > b.max {
+		return 0, fmt.Errorf("invalid value %q in %s field", s, b.name)
+	}
+	return v, nil
+}
+
+// Next returns the first time scheduled by s that is after t.  If s
+// schedules no time in the five years after t (e.g., for "0 0 30 2 *"),
+// Next returns the zero time.
+func (s *Schedule) Next(t time.Time) time.Time {
+	if s.every > 0 {
+		return t.Truncate(time.Second).Add(s.every)
+	}
+	loc := t.Location()
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		if !has(s.month, int(t.Month())) {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !s.matchDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !has(s.hour, t.Hour()) {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+		if !has(s.minute, t.Minute()) {
+			t = t.Truncate(time.Minute).Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (s *Schedule) matchDay(t time.Time) bool {
+	dom := has(s.dom, t.Day())
+	dow := has(s.dow, int(t.Weekday()))
+	if s.domStar || s.dowStar {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+func has(bits uint64, v int) bool {
+	return bits&(1<<v) != 0
+}
@@ -0,0 +1,59 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNext(t *testing.T) {
+	cases := []struct {
+		expr     string
+		from     string
+		expected string
+	}{
+		{"* * * * *", "2024-06-01T10:15:30Z", "2024-06-01T10:16:00Z"},
+		{"*/15 * * * *", "2024-06-01T10:15:00Z", "2024-06-01T10:30:00Z"},
+		{"5 * * * *", "2024-06-01T10:15:00Z", "2024-06-01T11:05:00Z"},
+		{"0 0 * * *", "2024-12-31T23:59:00Z", "2025-01-01T00:00:00Z"},
+		{"30 9 * * mon-fri", "2024-06-01T10:00:00Z", "2024-06-03T09:30:00Z"},
+		{"0 12 1 jan,jul *", "2024-06-01T00:00:00Z", "2024-07-01T12:00:00Z"},
+		{"0 0 13 * 5", "2024-06-01T00:00:00Z", "2024-06-07T00:00:00Z"},
+		{"0 0 * * 7", "2024-06-01T00:00:00Z", "2024-06-02T00:00:00Z"},
+		{"0 0 29 2 *", "2024-03-01T00:00:00Z", "2028-02-29T00:00:00Z"},
+		{"10-20/5 3 * * *", "2024-06-01T03:16:00Z", "2024-06-01T03:20:00Z"},
+		{"@hourly", "2024-06-01T10:15:00Z", "2024-06-01T11:00:00Z"},
+		{"@weekly", "2024-06-01T10:15:00Z", "2024-06-02T00:00:00Z"},
+		{"@every 90s", "2024-06-01T10:15:00.5Z", "2024-06-01T10:16:30Z"},
+		{"0 0 30 2 *", "2024-06-01T00:00:00Z", "0001-01-01T00:00:00Z"},
+	}
+	for _, c := range cases {
+		t.Run(c.expr, func(t *testing.T) {
+			s, err := Parse(c.expr)
+			require.NoError(t, err)
+			from, err := time.Parse(time.RFC3339Nano, c.from)
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, s.Next(from).Format(time.RFC3339))
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	cases := map[string]string{
+		"* * * *":       `cron expression "* * * *": expected 5 fields but found 4`,
+		"60 * * * *":    `cron expression "60 * * * *": invalid value "60" in minute field`,
+		"* * 0 * *":     `cron expression "* * 0 * *": invalid value "0" in day of month field`,
+		"* 5-2 * * *":   `cron expression "* 5-2 * * *": invalid range "5-2" in hour field`,
+		"*/0 * * * *":   `cron expression "*/0 * * * *": invalid step "0" in minute field`,
+		"* * * foo *":   `cron expression "* * * foo *": invalid value "foo" in month field`,
+		"@sometimes":    `cron expression "@sometimes": unknown descriptor`,
+		"@every 100ms":  `cron expression "@every 100ms": interval must be at least one second`,
+		"@every banana": `cron expression "@every banana": time: invalid duration "banana"`,
+	}
+	for expr, expected := range cases {
+		_, err := Parse(expr)
+		assert.EqualError(t, err, expected, "expression %q", expr)
+	}
+}
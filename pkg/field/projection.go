@@ -2,6 +2,13 @@ package field
 
 import "slices"
 
+// Elem is a path element that refers to the elements of an array or set
+// or to the values of a map.  It lets a projection reach into the values
+// held by a container, e.g., the path a.[].b projects field b of the records
+// in array a.  A record projected with Elem is loaded in its entirety since
+// a record may be indexed by position.
+const Elem = "[]"
+
 type Projection []ProjectionNode
 
 type ProjectionNode struct {
@@ -46,3 +53,21 @@ func (p Projection) Paths() []Path {
 	}
 	return paths
 }
+
+// ElemProjection returns the projection of the elements of a container
+// value, which is nil (i.e., the whole element) if p does not include Elem.
+func (p Projection) ElemProjection() Projection {
+	for _, node := range p {
+		if node.Name == Elem {
+			return node.Proj
+		}
+	}
+	return nil
+}
+
+// HasElem returns true if p includes Elem.
+func (p Projection) HasElem() bool {
+	return slices.ContainsFunc(p, func(n ProjectionNode) bool {
+		return n.Name == Elem
+	})
+}
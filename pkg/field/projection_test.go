@@ -29,3 +29,11 @@ func TestProjection(t *testing.T) {
 		assert.Equal(t, c, proj.Paths(), "projection: %#v", proj)
 	}
 }
+
+func TestProjectionElem(t *testing.T) {
+	proj := NewProjection([]Path{{"a", Elem, "b"}, {"a", Elem, "c"}, {"d"}})
+	assert.Equal(t, NewProjection([]Path{{"b"}, {"c"}}), proj[0].Proj.ElemProjection())
+	assert.True(t, proj[0].Proj.HasElem())
+	assert.False(t, proj.HasElem())
+	assert.Nil(t, proj.ElemProjection())
+}
@@ -39,6 +39,13 @@ func New(r io.Reader) *Lexer {
 	}
 }
 
+// Reset discards any buffered input and error and switches the lexer to
+// read from r.
+func (l *Lexer) Reset(r io.Reader) {
+	l.br.Reset(r)
+	l.err = nil
+}
+
 func (l *Lexer) Buf() []byte {
 	return l.buf
 }
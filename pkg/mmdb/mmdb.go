@@ -0,0 +1,353 @@
+// Package mmdb reads databases in the MaxMind DB format, e.g., the GeoIP2
+// and GeoLite2 databases, which map IP networks to records.
+//
+// A database is a binary search tree over the bits of an address whose
+// leaves point into a data section of self-describing values, followed by a
+// metadata map that locates the tree.  Values are decoded to string,
+// float64, []byte, uint64 (for all unsigned integers up to 64 bits), int64,
+// *big.Int (for 128-bit integers), bool, []any, and map[string]any.
+package mmdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"net/netip"
+	"os"
+)
+
+var metadataMarker = []byte("\xab\xcd\xefMaxMind.com")
+
+// maxDepth bounds the nesting of decoded values.
+const maxDepth = 64
+
+var ErrInvalid = errors.New("invalid MaxMind DB")
+
+type Metadata struct {
+	DatabaseType string
+	IPVersion    int
+	NodeCount    int
+	RecordSize   int
+	BuildEpoch   uint64
+}
+
+type Reader struct {
+	Metadata Metadata
+	buf      []byte
+	tree     []byte
+	data     []byte
+	// ipv4Start is the node reached by following the 96 zero bits that
+	// prefix an IPv4 address in an IPv6 tree.
+	ipv4Start int
+}
+
+// Open reads the database at path.
+func Open(path string) (*Reader, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	r, err := New(buf)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return r, nil
+}
+
+// New returns a Reader of the database in buf.
+func New(buf []byte) (*Reader, error) {
+	i := bytes.LastIndex(buf, metadataMarker)
+	if i < 0 {
+		return nil, fmt.Errorf("%w: metadata not found", ErrInvalid)
+	}
+	meta := buf[i+len(metadataMarker):]
+	v, _, err := (&decoder{buf: meta}).decode(0, 0)
+	if err != nil {
+		return nil, err
+	}
+	m, ok := v.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: metadata is not a map", ErrInvalid)
+	}
+	r := &Reader{buf: buf}
+	r.Metadata.DatabaseType, _ = m["database_type"].(string)
+	r.Metadata.BuildEpoch, _ = m["build_epoch"].(uint64)
+	ipVersion, _ := m["ip_version"].(uint64)
+	nodeCount, _ := m["node_count"].(uint64)
+	recordSize, _ := m["record_size"].(uint64)
+	r.Metadata.IPVersion = int(ipVersion)
+	r.Metadata.NodeCount = int(nodeCount)
+	r.Metadata.RecordSize = int(recordSize)
+	switch recordSize {
+	case 24, 28, 32:
+	default:
+		return nil, fmt.Errorf("%w: unsupported record size %d", ErrInvalid, recordSize)
+	}
+	if ipVersion != 4 && ipVersion != 6 {
+		return nil, fmt.Errorf("%w: unsupported IP version %d", ErrInvalid, ipVersion)
+	}
+	treeSize := nodeCount * recordSize / 4
+	if treeSize+16 > uint64(i) {
+		return nil, fmt.Errorf("%w: search tree exceeds file", ErrInvalid)
+	}
+	r.tree = buf[:treeSize]
+	r.data = buf[treeSize+16 : i]
+	if ipVersion == 6 {
+		node := 0
+		for range 96 {
+			if node >= r.Metadata.NodeCount {
+				break
+			}
+			node = r.record(node, 0)
+		}
+		r.ipv4Start = node
+	}
+	return r, nil
+}
+
+// Lookup returns the value for the network containing addr and the length
+// of the prefix of that network, or a nil value if no network contains it.
+func (r *Reader) Lookup(addr netip.Addr) (any, int, error) {
+	addr = addr.Unmap()
+	node, bits := 0, 0
+	switch {
+	case addr.Is4() && r.Metadata.IPVersion == 6:
+		node, bits = r.ipv4Start, 96
+	case addr.Is6() && r.Metadata.IPVersion == 4:
+		return nil, 0, nil
+	}
+	b := addr.AsSlice()
+	nodeCount := r.Metadata.NodeCount
+	for i := 0; i < len(b)*8 && node < nodeCount; i++ {
+		bit := int(b[i/8]>>(7-i%8)) & 1
+		node = r.record(node, bit)
+		bits++
+	}
+	if node == nodeCount {
+		return nil, 0, nil
+	}
+	if node < nodeCount {
+		return nil, 0, fmt.Errorf("%w: search tree has no leaf", ErrInvalid)
+	}
+	off := node - nodeCount - 16
+	if off < 0 || off >= len(r.data) {
+		return nil, 0, fmt.Errorf("%w: data pointer out of range", ErrInvalid)
+	}
+	v, _, err := (&decoder{buf: r.data}).decode(off, 0)
+	if err != nil {
+		return nil, 0, err
+	}
+	if addr.Is4() && r.Metadata.IPVersion == 6 {
+		bits -= 96
+	}
+	return v, bits, nil
+}
+
+// record returns the left (bit 0) or right (bit 1) record of node.
+func (r *Reader) record(node, bit int) int {
+	switch r.Metadata.RecordSize {
+	case 24:
+		b := r.tree[node*6+bit*3:]
+		return int(b[0])<<16 | int(b[1])<<8 | int(b[2])
+	case 28:
+		b := r.tree[node*7:]
+		if bit == 0 {
+			return int(b[3]&0xf0)<<20 | int(b[0])<<16 | int(b[1])<<8 | int(b[2])
+		}
+		return int(b[3]&0x0f)<<24 | int(b[4])<<16 | int(b[5])<<8 | int(b[6])
+	default:
+		return int(binary.BigEndian.Uint32(r.tree[node*8+bit*4:]))
+	}
+}
+
+const (
+	typeExtended = iota
+	typePointer
+	typeString
+	typeDouble
+	typeBytes
+	typeUint16
+	typeUint32
+	typeMap
+	typeInt32
+	typeUint64
+	typeUint128
+	typeArray
+	typeContainer
+	typeEndMarker
+	typeBool
+	typeFloat
+)
+
+type decoder struct {
+	buf []byte
+}
+
+// decode returns the value at off and the offset following it.
+func (d *decoder) decode(off, depth int) (any, int, error) {
+	if depth > maxDepth {
+		return nil, 0, fmt.Errorf("%w: values nested too deeply", ErrInvalid)
+	}
+	typ, size, off, err := d.control(off)
+	if err != nil {
+		return nil, 0, err
+	}
+	if typ == typePointer {
+		ptr, next, err := d.pointer(size, off)
+		if err != nil {
+			return nil, 0, err
+		}
+		// A pointer to a pointer is invalid.
+		if t, _, _, err := d.control(ptr); err != nil || t == typePointer {
+			return nil, 0, fmt.Errorf("%w: bad pointer", ErrInvalid)
+		}
+		v, _, err := d.decode(ptr, depth+1)
+		return v, next, err
+	}
+	switch typ {
+	case typeMap:
+		m := make(map[string]any, min(size, len(d.buf)))
+		for range size {
+			k, next, err := d.decode(off, depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			key, ok := k.(string)
+			if !ok {
+				return nil, 0, fmt.Errorf("%w: map key is not a string", ErrInvalid)
+			}
+			v, next, err := d.decode(next, depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			m[key] = v
+			off = next
+		}
+		return m, off, nil
+	case typeArray:
+		a := make([]any, 0, min(size, len(d.buf)))
+		for range size {
+			v, next, err := d.decode(off, depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			a = append(a, v)
+			off = next
+		}
+		return a, off, nil
+	case typeBool:
+		if size > 1 {
+			return nil, 0, fmt.Errorf("%w: bad boolean", ErrInvalid)
+		}
+		return size == 1, off, nil
+	}
+	if off+size > len(d.buf) {
+		return nil, 0, fmt.Errorf("%w: value exceeds data section", ErrInvalid)
+	}
+	b, next := d.buf[off:off+size], off+size
+	switch typ {
+	case typeString:
+		return string(b), next, nil
+	case typeDouble:
+		if size != 8 {
+			return nil, 0, fmt.Errorf("%w: bad double", ErrInvalid)
+		}
+		return math.Float64frombits(binary.BigEndian.Uint64(b)), next, nil
+	case typeFloat:
+		if size != 4 {
+			return nil, 0, fmt.Errorf("%w: bad float", ErrInvalid)
+		}
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(b))), next, nil
+	case typeBytes:
+		return bytes.Clone(b), next, nil
+	case typeUint16, typeUint32, typeUint64:
+		if size > 8 || (typ == typeUint16 && size > 2) || (typ == typeUint32 && size > 4) {
+			return nil, 0, fmt.Errorf("%w: bad unsigned integer", ErrInvalid)
+		}
+		return uintOf(b), next, nil
+	case typeInt32:
+		if size > 4 {
+			return nil, 0, fmt.Errorf("%w: bad int32", ErrInvalid)
+		}
+		return int64(int32(uintOf(b))), next, nil
+	case typeUint128:
+		if size > 16 {
+			return nil, 0, fmt.Errorf("%w: bad uint128", ErrInvalid)
+		}
+		return new(big.Int).SetBytes(b), next, nil
+	}
+	return nil, 0, fmt.Errorf("%w: unknown data type %d", ErrInvalid, typ)
+}
+
+// control decodes the control byte at off and returns the type and size of
+// the value it describes and the offset following it.  For a pointer, size
+// holds the bits of the control byte that encode the pointer.
+func (d *decoder) control(off int) (int, int, int, error) {
+	if off >= len(d.buf) {
+		return 0, 0, 0, fmt.Errorf("%w: offset out of range", ErrInvalid)
+	}
+	ctrl := d.buf[off]
+	off++
+	typ := int(ctrl >> 5)
+	if typ == typePointer {
+		return typ, int(ctrl & 0x1f), off, nil
+	}
+	if typ == typeExtended {
+		if off >= len(d.buf) {
+			return 0, 0, 0, fmt.Errorf("%w: offset out of range", ErrInvalid)
+		}
+		typ = 7 + int(d.buf[off])
+		off++
+		if typ < typeInt32 {
+			return 0, 0, 0, fmt.Errorf("%w: bad extended type", ErrInvalid)
+		}
+	}
+	size := int(ctrl & 0x1f)
+	if size >= 29 {
+		n := size - 28
+		if off+n > len(d.buf) {
+			return 0, 0, 0, fmt.Errorf("%w: offset out of range", ErrInvalid)
+		}
+		v := int(uintOf(d.buf[off : off+n]))
+		off += n
+		switch n {
+		case 1:
+			size = 29 + v
+		case 2:
+			size = 285 + v
+		default:
+			size = 65821 + v
+		}
+	}
+	return typ, size, off, nil
+}
+
+// pointer decodes a pointer whose control bits are bits and whose
+// remaining bytes are at off.
+func (d *decoder) pointer(bits, off int) (int, int, error) {
+	n := bits>>3 + 1
+	if off+n > len(d.buf) {
+		return 0, 0, fmt.Errorf("%w: offset out of range", ErrInvalid)
+	}
+	v := int(uintOf(d.buf[off : off+n]))
+	switch n {
+	case 1:
+		v |= (bits & 7) << 8
+	case 2:
+		v = (v | (bits&7)<<16) + 2048
+	case 3:
+		v = (v | (bits&7)<<24) + 526336
+	}
+	return v, off + n, nil
+}
+
+func uintOf(b []byte) uint64 {
+	var v uint64
+	for _, c := range b {
+		v = v<<8 | uint64(c)
+	}
+	return v
+}
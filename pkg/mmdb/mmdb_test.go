@@ -0,0 +1,300 @@
+package mmdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"flag"
+	"math"
+	"net/netip"
+	"os"
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update the GeoIP database in the testdata directory")
+
+// fixturePath is a GeoIP database used by the tests of the geoip function.
+const fixturePath = "../../testdata/geoip.mmdb"
+
+type network struct {
+	prefix string
+	value  any
+}
+
+var fixture = []network{
+	{"1.2.3.0/24", map[string]any{
+		"country":                        map[string]any{"iso_code": "US", "names": map[string]any{"en": "United States"}},
+		"city":                           map[string]any{"names": map[string]any{"en": "Springfield"}},
+		"location":                       map[string]any{"latitude": 39.8, "longitude": -89.6},
+		"autonomous_system_number":       uint32(64500),
+		"autonomous_system_organization": "Example Net",
+	}},
+	{"10.0.0.0/8", map[string]any{
+		"country": map[string]any{"iso_code": "DE", "names": map[string]any{"en": "Germany"}},
+	}},
+	{"2001:db8::/32", map[string]any{
+		"country":                        map[string]any{"iso_code": "JP", "names": map[string]any{"en": "Japan"}},
+		"autonomous_system_number":       uint32(64501),
+		"autonomous_system_organization": "Example IPv6",
+	}},
+}
+
+func TestFixture(t *testing.T) {
+	buf := build(t, fixture, 6, 24)
+	if *update {
+		require.NoError(t, os.WriteFile(fixturePath, buf, 0644))
+	}
+	b, err := os.ReadFile(fixturePath)
+	require.NoError(t, err)
+	require.Equal(t, buf, b, "run go test -update to update %s", fixturePath)
+}
+
+func TestLookup(t *testing.T) {
+	for _, recordSize := range []int{24, 28, 32} {
+		r, err := New(build(t, fixture, 6, recordSize))
+		require.NoError(t, err)
+		require.Equal(t, "Test-GeoIP", r.Metadata.DatabaseType)
+		v, bits, err := r.Lookup(netip.MustParseAddr("1.2.3.4"))
+		require.NoError(t, err)
+		require.Equal(t, 24, bits)
+		m := v.(map[string]any)
+		require.Equal(t, "US", m["country"].(map[string]any)["iso_code"])
+		require.Equal(t, uint64(64500), m["autonomous_system_number"])
+		require.Equal(t, 39.8, m["location"].(map[string]any)["latitude"])
+		v, bits, err = r.Lookup(netip.MustParseAddr("::ffff:10.1.2.3"))
+		require.NoError(t, err)
+		require.Equal(t, 8, bits)
+		require.Equal(t, "Germany", v.(map[string]any)["country"].(map[string]any)["names"].(map[string]any)["en"])
+		v, bits, err = r.Lookup(netip.MustParseAddr("2001:db8::1"))
+		require.NoError(t, err)
+		require.Equal(t, 32, bits)
+		require.Equal(t, "Example IPv6", v.(map[string]any)["autonomous_system_organization"])
+		v, _, err = r.Lookup(netip.MustParseAddr("192.168.0.1"))
+		require.NoError(t, err)
+		require.Nil(t, v)
+	}
+}
+
+func TestLookupIPv4Tree(t *testing.T) {
+	r, err := New(build(t, fixture[:2], 4, 24))
+	require.NoError(t, err)
+	v, bits, err := r.Lookup(netip.MustParseAddr("10.0.0.1"))
+	require.NoError(t, err)
+	require.Equal(t, 8, bits)
+	require.NotNil(t, v)
+	v, _, err = r.Lookup(netip.MustParseAddr("2001:db8::1"))
+	require.NoError(t, err)
+	require.Nil(t, v)
+}
+
+func TestDecodeTypes(t *testing.T) {
+	in := map[string]any{
+		"array":  []any{true, false, int32(-5), float32(1.5)},
+		"bytes":  []byte{1, 2, 3},
+		"long":   string(bytes.Repeat([]byte("x"), 300)),
+		"uint64": uint64(math.MaxUint64),
+	}
+	var w writer
+	off := w.value(in)
+	v, _, err := (&decoder{buf: w.data}).decode(off, 0)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"array":  []any{true, false, int64(-5), 1.5},
+		"bytes":  []byte{1, 2, 3},
+		"long":   in["long"],
+		"uint64": uint64(math.MaxUint64),
+	}, v)
+}
+
+func TestInvalid(t *testing.T) {
+	_, err := New([]byte("not a database"))
+	require.ErrorIs(t, err, ErrInvalid)
+	buf := build(t, fixture, 6, 24)
+	// Truncate the search tree.
+	i := bytes.LastIndex(buf, metadataMarker)
+	_, err = New(slices.Concat(buf[:10], buf[i:]))
+	require.ErrorIs(t, err, ErrInvalid)
+}
+
+// writer encodes values in the data section format, writing each map key
+// once and pointing to it thereafter.
+type writer struct {
+	data []byte
+	keys map[string]int
+}
+
+func (w *writer) control(typ, size int) {
+	var ext []byte
+	if typ > 7 {
+		ext = []byte{byte(typ - 7)}
+		typ = 0
+	}
+	var extra []byte
+	switch {
+	case size < 29:
+	case size < 285:
+		extra = []byte{byte(size - 29)}
+		size = 29
+	default:
+		extra = binary.BigEndian.AppendUint16(nil, uint16(size-285))
+		size = 30
+	}
+	w.data = append(w.data, byte(typ<<5|size))
+	w.data = append(w.data, ext...)
+	w.data = append(w.data, extra...)
+}
+
+// value appends v and returns its offset.
+func (w *writer) value(v any) int {
+	off := len(w.data)
+	switch v := v.(type) {
+	case string:
+		w.control(typeString, len(v))
+		w.data = append(w.data, v...)
+	case float64:
+		w.control(typeDouble, 8)
+		w.data = binary.BigEndian.AppendUint64(w.data, math.Float64bits(v))
+	case float32:
+		w.control(typeFloat, 4)
+		w.data = binary.BigEndian.AppendUint32(w.data, math.Float32bits(v))
+	case []byte:
+		w.control(typeBytes, len(v))
+		w.data = append(w.data, v...)
+	case uint16:
+		w.control(typeUint16, 2)
+		w.data = binary.BigEndian.AppendUint16(w.data, v)
+	case uint32:
+		w.control(typeUint32, 4)
+		w.data = binary.BigEndian.AppendUint32(w.data, v)
+	case uint64:
+		w.control(typeUint64, 8)
+		w.data = binary.BigEndian.AppendUint64(w.data, v)
+	case int32:
+		w.control(typeInt32, 4)
+		w.data = binary.BigEndian.AppendUint32(w.data, uint32(v))
+	case bool:
+		size := 0
+		if v {
+			size = 1
+		}
+		w.control(typeBool, size)
+	case []any:
+		w.control(typeArray, len(v))
+		for _, e := range v {
+			w.value(e)
+		}
+	case map[string]any:
+		w.control(typeMap, len(v))
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			w.key(k)
+			w.value(v[k])
+		}
+	default:
+		panic(v)
+	}
+	return off
+}
+
+func (w *writer) key(k string) {
+	if off, ok := w.keys[k]; ok && off < 2048 {
+		w.data = append(w.data, byte(typePointer<<5|off>>8), byte(off))
+		return
+	}
+	if w.keys == nil {
+		w.keys = make(map[string]int)
+	}
+	w.keys[k] = w.value(k)
+}
+
+type node struct {
+	children [2]*node
+	// leaf is the offset of the value of a network plus one, or zero.
+	leaf int
+}
+
+// build returns a database of the networks with the given IP version and
+// record size.
+func build(t *testing.T, networks []network, ipVersion, recordSize int) []byte {
+	var w writer
+	root := &node{}
+	for _, n := range networks {
+		prefix := netip.MustParsePrefix(n.prefix)
+		b := prefix.Addr().AsSlice()
+		bits := prefix.Bits()
+		if ipVersion == 6 && prefix.Addr().Is4() {
+			b = slices.Concat(make([]byte, 12), b)
+			bits += 96
+		}
+		nd := root
+		for i := range bits {
+			bit := b[i/8] >> (7 - i%8) & 1
+			if nd.children[bit] == nil {
+				nd.children[bit] = &node{}
+			}
+			nd = nd.children[bit]
+		}
+		nd.leaf = w.value(n.value) + 1
+	}
+	var nodes []*node
+	index := map[*node]int{}
+	var walk func(*node)
+	walk = func(nd *node) {
+		index[nd] = len(nodes)
+		nodes = append(nodes, nd)
+		for _, c := range nd.children {
+			if c != nil && c.leaf == 0 {
+				walk(c)
+			}
+		}
+	}
+	walk(root)
+	nodeCount := len(nodes)
+	var tree []byte
+	for _, nd := range nodes {
+		var records [2]int
+		for i, c := range nd.children {
+			switch {
+			case c == nil:
+				records[i] = nodeCount
+			case c.leaf != 0:
+				records[i] = nodeCount + 16 + c.leaf - 1
+			default:
+				records[i] = index[c]
+			}
+		}
+		switch recordSize {
+		case 24:
+			for _, r := range records {
+				tree = append(tree, byte(r>>16), byte(r>>8), byte(r))
+			}
+		case 28:
+			l, r := records[0], records[1]
+			tree = append(tree, byte(l>>16), byte(l>>8), byte(l), byte(l>>20&0xf0|r>>24&0x0f), byte(r>>16), byte(r>>8), byte(r))
+		case 32:
+			for _, r := range records {
+				tree = binary.BigEndian.AppendUint32(tree, uint32(r))
+			}
+		}
+	}
+	var meta writer
+	meta.value(map[string]any{
+		"binary_format_major_version": uint16(2),
+		"binary_format_minor_version": uint16(0),
+		"build_epoch":                 uint64(1700000000),
+		"database_type":               "Test-GeoIP",
+		"ip_version":                  uint16(ipVersion),
+		"languages":                   []any{"en"},
+		"node_count":                  uint32(nodeCount),
+		"record_size":                 uint16(recordSize),
+	})
+	require.Equal(t, nodeCount*recordSize/4, len(tree))
+	return slices.Concat(tree, make([]byte, 16), w.data, metadataMarker, meta.data)
+}
@@ -1,9 +1,11 @@
 package s3io
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"io"
+	"io/fs"
 	"net/http"
 	"net/url"
 	"os"
@@ -14,6 +16,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
@@ -186,6 +189,32 @@ func Remove(ctx context.Context, path string, client s3iface.S3API) error {
 	return err
 }
 
+// PutIfNotExists writes b to path with a conditional write that fails with
+// fs.ErrExist if an object already exists at path.
+func PutIfNotExists(ctx context.Context, path string, client s3iface.S3API, b []byte) error {
+	bucket, key, err := parsePath(path)
+	if err != nil {
+		return err
+	}
+	_, err = client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Body:   bytes.NewReader(b),
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}, func(r *request.Request) {
+		r.HTTPRequest.Header.Set("If-None-Match", "*")
+	})
+	var reqerr awserr.RequestFailure
+	if errors.As(err, &reqerr) {
+		switch reqerr.StatusCode() {
+		case http.StatusPreconditionFailed, http.StatusConflict:
+			// A conflict means a concurrent conditional write to the
+			// same key is in progress, which will create the object.
+			return fs.ErrExist
+		}
+	}
+	return err
+}
+
 type Info struct {
 	Name    string
 	Size    int64
@@ -42,22 +42,36 @@ func (f *FileSystem) Put(_ context.Context, u *URI) (io.WriteCloser, error) {
 	return w, fileErr(err)
 }
 
+// PutIfNotExists writes b to a temporary file and then links it to the path
+// of u so that the file appears atomically with its full contents and the
+// link fails with fs.ErrExist if the file already exists.
 func (f *FileSystem) PutIfNotExists(_ context.Context, u *URI, b []byte) error {
 	path := u.Filepath()
 	if err := f.checkPath(path); err != nil {
 		return fileErr(err)
 	}
-	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC|os.O_EXCL, f.perm)
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-"+filepath.Base(path))
 	if err != nil {
 		return fileErr(err)
 	}
-	_, err = io.Copy(file, bytes.NewReader(b))
+	defer os.Remove(tmp.Name())
+	_, err = io.Copy(tmp, bytes.NewReader(b))
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
 	if err != nil {
-		file.Close()
-		f.Delete(nil, u)
 		return err
 	}
-	return file.Close()
+	if err := os.Chmod(tmp.Name(), f.perm); err != nil {
+		return err
+	}
+	if err := os.Link(tmp.Name(), path); err != nil {
+		if os.IsExist(err) {
+			return fs.ErrExist
+		}
+		return fileErr(err)
+	}
+	return nil
 }
 
 func (f *FileSystem) Delete(_ context.Context, u *URI) error {
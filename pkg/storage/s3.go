@@ -35,8 +35,8 @@ func (s *S3Engine) Put(ctx context.Context, u *URI) (io.WriteCloser, error) {
 	return w, s3Err(err)
 }
 
-func (s *S3Engine) PutIfNotExists(context.Context, *URI, []byte) error {
-	return ErrNotSupported
+func (s *S3Engine) PutIfNotExists(ctx context.Context, u *URI, b []byte) error {
+	return s3Err(s3io.PutIfNotExists(ctx, u.String(), s.client, b))
 }
 
 func (s *S3Engine) Delete(ctx context.Context, u *URI) error {
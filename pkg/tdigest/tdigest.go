@@ -0,0 +1,211 @@
+// Package tdigest implements the merging t-digest of Dunning and Ertl, a
+// compact sketch of a distribution of numbers from which quantiles can be
+// estimated with small relative error, especially near the tails.  Digests
+// of disjoint inputs can be merged, so a quantile may be computed from
+// digests built independently, e.g., by the workers of a parallel scan.
+package tdigest
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"slices"
+)
+
+// DefaultCompression is a compression that bounds a digest to a few hundred
+// centroids while keeping the error of quantile estimates well under 1%.
+const DefaultCompression = 100
+
+type centroid struct {
+	mean   float64
+	weight float64
+}
+
+type TDigest struct {
+	compression float64
+	// centroids are sorted by mean and satisfy the size bound of the
+	// scale function.  buffer holds centroids not yet merged into them.
+	centroids []centroid
+	buffer    []centroid
+	count     float64
+	min       float64
+	max       float64
+}
+
+func New(compression float64) *TDigest {
+	return &TDigest{
+		compression: compression,
+		min:         math.Inf(1),
+		max:         math.Inf(-1),
+	}
+}
+
+// Count returns the number of values added to t.
+func (t *TDigest) Count() float64 {
+	return t.count
+}
+
+// Add adds x to t.  NaNs are ignored.
+func (t *TDigest) Add(x float64) {
+	if math.IsNaN(x) {
+		return
+	}
+	t.add(centroid{x, 1})
+	t.min = min(t.min, x)
+	t.max = max(t.max, x)
+}
+
+func (t *TDigest) add(c centroid) {
+	t.buffer = append(t.buffer, c)
+	t.count += c.weight
+	if len(t.buffer) >= 5*int(t.compression) {
+		t.compress()
+	}
+}
+
+// Merge adds the values summarized by u to t.
+func (t *TDigest) Merge(u *TDigest) {
+	if u.count == 0 {
+		return
+	}
+	for _, c := range u.centroids {
+		t.add(c)
+	}
+	for _, c := range u.buffer {
+		t.add(c)
+	}
+	t.min = min(t.min, u.min)
+	t.max = max(t.max, u.max)
+}
+
+// compress merges the buffer into the centroids, combining adjacent
+// centroids as long as the scale function k1 permits.
+func (t *TDigest) compress() {
+	if len(t.buffer) == 0 {
+		return
+	}
+	all := append(t.centroids, t.buffer...)
+	slices.SortFunc(all, func(a, b centroid) int {
+		switch {
+		case a.mean < b.mean:
+			return -1
+		case a.mean > b.mean:
+			return 1
+		}
+		return 0
+	})
+	merged := all[:1]
+	var sofar float64
+	kLo := t.k(0)
+	for _, c := range all[1:] {
+		cur := &merged[len(merged)-1]
+		if t.k((sofar+cur.weight+c.weight)/t.count)-kLo <= 1 {
+			cur.weight += c.weight
+			cur.mean += (c.mean - cur.mean) * c.weight / cur.weight
+			continue
+		}
+		sofar += cur.weight
+		kLo = t.k(sofar / t.count)
+		merged = append(merged, c)
+	}
+	t.centroids = merged
+	t.buffer = nil
+}
+
+// k is the scale function k1, which makes centroids small near the tails.
+func (t *TDigest) k(q float64) float64 {
+	return t.compression / (2 * math.Pi) * math.Asin(2*min(q, 1)-1)
+}
+
+// Quantile returns an estimate of the q-quantile, 0 <= q <= 1, of the values
+// added to t, interpolating between the means of adjacent centroids.  It
+// returns NaN if t is empty.
+func (t *TDigest) Quantile(q float64) float64 {
+	if t.count == 0 {
+		return math.NaN()
+	}
+	t.compress()
+	if q <= 0 {
+		return t.min
+	}
+	if q >= 1 {
+		return t.max
+	}
+	cs := t.centroids
+	// Place each centroid at the mean rank of the values it summarizes so
+	// that, when all centroids are singletons, interpolating between them
+	// gives the same result as interpolating between the sorted values.
+	// The minimum and maximum are at the first and last ranks.
+	rank := q * (t.count - 1)
+	center := (cs[0].weight - 1) / 2
+	if rank < center {
+		return t.min + rank/center*(cs[0].mean-t.min)
+	}
+	var sofar float64
+	for i := range len(cs) - 1 {
+		next := sofar + cs[i].weight + (cs[i+1].weight-1)/2
+		if rank < next {
+			z := (rank - center) / (next - center)
+			return cs[i].mean + z*(cs[i+1].mean-cs[i].mean)
+		}
+		sofar += cs[i].weight
+		center = next
+	}
+	last := cs[len(cs)-1]
+	if end := t.count - 1; end > center {
+		return last.mean + (rank-center)/(end-center)*(t.max-last.mean)
+	}
+	return last.mean
+}
+
+// Deviations returns a digest of the absolute deviations from x of the
+// values added to t, e.g., for estimating their median absolute deviation.
+// The values summarized by a centroid of t are assumed to be at its mean,
+// so the deviations are exact when each centroid holds a single value.
+func (t *TDigest) Deviations(x float64) *TDigest {
+	d := New(t.compression)
+	if t.count == 0 {
+		return d
+	}
+	t.compress()
+	for _, c := range t.centroids {
+		dev := math.Abs(c.mean - x)
+		d.add(centroid{dev, c.weight})
+		d.min = min(d.min, dev)
+	}
+	d.max = max(math.Abs(t.min-x), math.Abs(t.max-x))
+	return d
+}
+
+// MarshalBinary encodes t as its compression, minimum, maximum, and the mean
+// and weight of each centroid.
+func (t *TDigest) MarshalBinary() ([]byte, error) {
+	t.compress()
+	b := make([]byte, 0, 8*(3+2*len(t.centroids)))
+	b = binary.LittleEndian.AppendUint64(b, math.Float64bits(t.compression))
+	b = binary.LittleEndian.AppendUint64(b, math.Float64bits(t.min))
+	b = binary.LittleEndian.AppendUint64(b, math.Float64bits(t.max))
+	for _, c := range t.centroids {
+		b = binary.LittleEndian.AppendUint64(b, math.Float64bits(c.mean))
+		b = binary.LittleEndian.AppendUint64(b, math.Float64bits(c.weight))
+	}
+	return b, nil
+}
+
+func (t *TDigest) UnmarshalBinary(b []byte) error {
+	if len(b) < 24 || len(b)%16 != 8 {
+		return errors.New("tdigest: invalid encoding")
+	}
+	float := func() float64 {
+		f := math.Float64frombits(binary.LittleEndian.Uint64(b))
+		b = b[8:]
+		return f
+	}
+	*t = TDigest{compression: float(), min: float(), max: float()}
+	for len(b) > 0 {
+		c := centroid{mean: float(), weight: float()}
+		t.centroids = append(t.centroids, c)
+		t.count += c.weight
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package tdigest
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuantileSmall(t *testing.T) {
+	d := New(DefaultCompression)
+	assert.True(t, math.IsNaN(d.Quantile(0.5)))
+	for _, x := range []float64{3, 1, 4, 2, math.NaN()} {
+		d.Add(x)
+	}
+	assert.Equal(t, 4.0, d.Count())
+	assert.Equal(t, 1.0, d.Quantile(0))
+	assert.Equal(t, 2.5, d.Quantile(0.5))
+	assert.InDelta(t, 3.85, d.Quantile(0.95), 1e-9)
+	assert.Equal(t, 4.0, d.Quantile(1))
+}
+
+func TestQuantileLarge(t *testing.T) {
+	const N = 100000
+	rng := rand.New(rand.NewPCG(1, 2))
+	// Build two digests over halves of a shuffled 0..N-1 and merge them
+	// as the workers of a parallel scan would.
+	a, b := New(DefaultCompression), New(DefaultCompression)
+	for i, x := range rng.Perm(N) {
+		if i%2 == 0 {
+			a.Add(float64(x))
+		} else {
+			b.Add(float64(x))
+		}
+	}
+	a.Merge(b)
+	require.Equal(t, float64(N), a.Count())
+	for _, q := range []float64{0.001, 0.01, 0.1, 0.5, 0.9, 0.99, 0.999} {
+		assert.InDelta(t, q*N, a.Quantile(q), 0.005*N, "quantile %g", q)
+	}
+	assert.Less(t, len(a.centroids), 2*DefaultCompression)
+}
+
+func TestDeviations(t *testing.T) {
+	d := New(DefaultCompression)
+	assert.True(t, math.IsNaN(d.Deviations(0).Quantile(0.5)))
+	for _, x := range []float64{1, 2, 3, 4, 100} {
+		d.Add(x)
+	}
+	dev := d.Deviations(d.Quantile(0.5))
+	assert.Equal(t, 5.0, dev.Count())
+	assert.Equal(t, 0.0, dev.Quantile(0))
+	assert.Equal(t, 1.0, dev.Quantile(0.5))
+	assert.Equal(t, 97.0, dev.Quantile(1))
+
+	const N = 100000
+	d = New(DefaultCompression)
+	for x := range N {
+		d.Add(float64(x))
+	}
+	assert.InDelta(t, N/4, d.Deviations(d.Quantile(0.5)).Quantile(0.5), 0.005*N)
+}
+
+func TestMarshal(t *testing.T) {
+	d := New(DefaultCompression)
+	for i := range 1000 {
+		d.Add(float64(i))
+	}
+	b, err := d.MarshalBinary()
+	require.NoError(t, err)
+	var u TDigest
+	require.NoError(t, u.UnmarshalBinary(b))
+	assert.Equal(t, d.Count(), u.Count())
+	for _, q := range []float64{0, 0.25, 0.5, 0.75, 1} {
+		assert.Equal(t, d.Quantile(q), u.Quantile(q))
+	}
+	assert.Error(t, u.UnmarshalBinary(b[:len(b)-1]))
+}
@@ -49,6 +49,8 @@ type RecordBuilder struct {
 	builder  *zcode.Builder
 	sctx     *Context
 	curField int
+	types    TypeVectorTable
+	recTypes []*TypeRecord
 }
 
 // NewRecordBuilder constructs the zcode.Bytes representation for records
@@ -205,3 +207,15 @@ func (r *RecordBuilder) Type(types []Type) *TypeRecord {
 	}
 	return r.sctx.MustLookupTypeRecord(stack[0].fields)
 }
+
+// LookupType is like Type but remembers the record type for each distinct
+// vector of field types so it is constructed only once.
+func (r *RecordBuilder) LookupType(types []Type) *TypeRecord {
+	id := r.types.Lookup(types)
+	if id < len(r.recTypes) {
+		return r.recTypes[id]
+	}
+	typ := r.Type(types)
+	r.recTypes = append(r.recTypes, typ)
+	return typ
+}
@@ -0,0 +1,22 @@
+package super_test
+
+import (
+	"testing"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/sup"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordBuilderLookupType(t *testing.T) {
+	sctx := super.NewContext()
+	b, err := super.NewRecordBuilder(sctx, field.List{{"a"}, {"r", "b"}})
+	require.NoError(t, err)
+	typ1 := b.LookupType([]super.Type{super.TypeInt64, super.TypeString})
+	require.Equal(t, "{a:int64,r:{b:string}}", sup.FormatType(typ1))
+	typ2 := b.LookupType([]super.Type{super.TypeString, super.TypeString})
+	require.Equal(t, "{a:string,r:{b:string}}", sup.FormatType(typ2))
+	require.Same(t, typ1, b.LookupType([]super.Type{super.TypeInt64, super.TypeString}))
+	require.Same(t, typ2, b.LookupType([]super.Type{super.TypeString, super.TypeString}))
+}
@@ -22,6 +22,7 @@ type Query interface {
 	io.Closer
 	Progress() zbuf.Progress
 	Meter() zbuf.Meter
+	zbuf.SpillMeter
 }
 
 type DeleteQuery interface {
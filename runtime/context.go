@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"github.com/brimdata/super"
+	"github.com/brimdata/super/zbuf"
 )
 
 // Context provides states used by all procs to provide the outside context
@@ -15,15 +16,28 @@ type Context struct {
 	// (e.g., removing temporary files) before Cancel returns.
 	WaitGroup sync.WaitGroup
 	Sctx      *super.Context
-	cancel    context.CancelFunc
+	// Memory limits the memory held by the query's operators.
+	Memory *Memory
+	// Spills accumulates the statistics of the values spilled to
+	// temporary storage by the query's operators.
+	Spills zbuf.SpillStats
+	// SpillOptions configures the files to which the query's operators
+	// spill values.
+	SpillOptions zbuf.SpillOptions
+	cancel       context.CancelFunc
 }
 
+// DefaultSpillOptions are the SpillOptions of each new Context.
+var DefaultSpillOptions zbuf.SpillOptions
+
 func NewContext(ctx context.Context, sctx *super.Context) *Context {
 	ctx, cancel := context.WithCancel(ctx)
 	return &Context{
-		Context: ctx,
-		cancel:  cancel,
-		Sctx:    sctx,
+		Context:      ctx,
+		cancel:       cancel,
+		Sctx:         sctx,
+		Memory:       NewMemory(int64(QueryMemMaxBytes), ProcessMemory),
+		SpillOptions: DefaultSpillOptions,
 	}
 }
 
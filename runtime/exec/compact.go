@@ -7,13 +7,17 @@ import (
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/lake"
 	"github.com/brimdata/super/lake/commits"
+	"github.com/brimdata/super/lake/data"
 	"github.com/brimdata/super/runtime"
 	"github.com/brimdata/super/runtime/sam/op/meta"
 	"github.com/brimdata/super/zbuf"
 	"github.com/segmentio/ksuid"
 )
 
-func Compact(ctx context.Context, lk *lake.Root, pool *lake.Pool, branchName string, objectIDs []ksuid.KSUID, writeVectors bool, author, message, info string) (ksuid.KSUID, error) {
+// Summarizer computes the pool's summary objects for newly written data objects.
+type Summarizer func(context.Context, *lake.Pool, []*data.Object) error
+
+func Compact(ctx context.Context, lk *lake.Root, pool *lake.Pool, branchName string, objectIDs []ksuid.KSUID, writeVectors bool, summarize Summarizer, author, message, info string) (ksuid.KSUID, error) {
 	if len(objectIDs) < 2 {
 		return ksuid.Nil, errors.New("compact: two or more source objects required")
 	}
@@ -48,6 +52,12 @@ func Compact(ctx context.Context, lk *lake.Root, pool *lake.Pool, branchName str
 		w.Abort()
 		return ksuid.Nil, err
 	}
+	if summarize != nil && len(pool.Summaries) > 0 {
+		if err := summarize(ctx, pool, w.Objects()); err != nil {
+			w.Abort()
+			return ksuid.Nil, err
+		}
+	}
 	commit, err := branch.CommitCompact(ctx, compact.SelectAll(), w.Objects(), w.Vectors(), author, message, info)
 	if err != nil {
 		w.Abort()
@@ -10,10 +10,16 @@ import (
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/compiler/dag"
 	"github.com/brimdata/super/lake"
+	"github.com/brimdata/super/lake/externals"
+	"github.com/brimdata/super/lake/pools"
 	"github.com/brimdata/super/lakeparse"
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/pkg/nano"
 	"github.com/brimdata/super/pkg/storage"
+	"github.com/brimdata/super/runtime/sam/op/join"
+	"github.com/brimdata/super/runtime/sam/op/meta"
+	"github.com/brimdata/super/runtime/sam/op/temp"
 	"github.com/brimdata/super/runtime/vam"
 	"github.com/brimdata/super/vector"
 	"github.com/brimdata/super/zbuf"
@@ -24,9 +30,20 @@ import (
 )
 
 type Environment struct {
-	engine storage.Engine
-	lake   *lake.Root
-	useVAM bool
+	engine     storage.Engine
+	lake       *lake.Root
+	lookups    *join.LookupCache
+	partitions *meta.PartitionCache
+	temps      *temp.Tables
+	// pool and branch name the pool scanned by lake queries that have
+	// no data source.
+	pool     string
+	branch   string
+	readOnly bool
+	useVAM   bool
+	// externalFiles holds the URIs of the files of the external pools
+	// resolved by e, which are read with the storage engine of the lake.
+	externalFiles map[string]struct{}
 }
 
 func NewEnvironment(engine storage.Engine, lake *lake.Root) *Environment {
@@ -37,6 +54,56 @@ func NewEnvironment(engine storage.Engine, lake *lake.Root) *Environment {
 	}
 }
 
+// SetPartitionCache sets the cache of pool partitions shared by queries
+// compiled with e.
+func (e *Environment) SetPartitionCache(cache *meta.PartitionCache) {
+	e.partitions = cache
+}
+
+func (e *Environment) PartitionCache() *meta.PartitionCache {
+	return e.partitions
+}
+
+// SetLookupCache sets the cache of lookup join indexes shared by queries
+// compiled with e.
+func (e *Environment) SetLookupCache(cache *join.LookupCache) {
+	e.lookups = cache
+}
+
+func (e *Environment) LookupCache() *join.LookupCache {
+	return e.lookups
+}
+
+// SetTempTables sets the temporary tables shared by queries compiled with e.
+// If no tables are set, each query has its own.
+func (e *Environment) SetTempTables(tables *temp.Tables) {
+	e.temps = tables
+}
+
+func (e *Environment) TempTables() *temp.Tables {
+	return e.temps
+}
+
+// SetDefaultPool sets the pool and branch scanned by lake queries compiled
+// with e that have no data source.
+func (e *Environment) SetDefaultPool(pool, branch string) {
+	e.pool = pool
+	e.branch = branch
+}
+
+func (e *Environment) DefaultPool() (string, string) {
+	return e.pool, e.branch
+}
+
+// SetReadOnly sets whether queries are prevented from modifying the lake.
+func (e *Environment) SetReadOnly(readOnly bool) {
+	e.readOnly = readOnly
+}
+
+func (e *Environment) ReadOnly() bool {
+	return e.readOnly
+}
+
 func (e *Environment) UseVAM() bool {
 	return e.useVAM
 }
@@ -65,6 +132,67 @@ func (e *Environment) CommitObject(ctx context.Context, id ksuid.KSUID, name str
 	return ksuid.Nil, nil
 }
 
+// CommitAsOf returns the commit in the history ending at commit that was
+// current at time ts.
+func (e *Environment) CommitAsOf(ctx context.Context, poolID, commit ksuid.KSUID, ts nano.Ts) (ksuid.KSUID, error) {
+	if e.lake != nil {
+		return e.lake.CommitAsOf(ctx, poolID, commit, ts)
+	}
+	return ksuid.Nil, nil
+}
+
+// PoolDefaults returns the query defaults declared for the pool.
+func (e *Environment) PoolDefaults(ctx context.Context, poolID ksuid.KSUID) (pools.Defaults, error) {
+	if e.lake != nil {
+		return e.lake.PoolDefaults(ctx, poolID)
+	}
+	return pools.Defaults{}, nil
+}
+
+// PoolMasks returns the masks declared for the pool.
+func (e *Environment) PoolMasks(ctx context.Context, poolID ksuid.KSUID) ([]pools.Mask, error) {
+	if e.lake != nil {
+		return e.lake.PoolMasks(ctx, poolID)
+	}
+	return nil, nil
+}
+
+// LookupExternal returns the configuration of the named external pool.
+func (e *Environment) LookupExternal(ctx context.Context, name string) (*externals.Config, error) {
+	if e.lake != nil {
+		return e.lake.LookupExternal(ctx, name)
+	}
+	return nil, fmt.Errorf("%s: %w", name, externals.ErrNotFound)
+}
+
+// ExternalFiles returns the files matching the pattern of an external pool.
+// Queries compiled with e may open the files with Open or VectorOpen even if
+// the storage engine of e does not support their URI schemes.
+func (e *Environment) ExternalFiles(ctx context.Context, config *externals.Config) ([]externals.File, error) {
+	if e.lake == nil {
+		return nil, nil
+	}
+	files, err := e.lake.ExternalFiles(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	if e.externalFiles == nil {
+		e.externalFiles = make(map[string]struct{})
+	}
+	for _, f := range files {
+		e.externalFiles[f.URI] = struct{}{}
+	}
+	return files, nil
+}
+
+// engineFor returns the storage engine with which to read path.
+func (e *Environment) engineFor(path string) storage.Engine {
+	if _, ok := e.externalFiles[path]; ok {
+		return e.lake.Engine()
+	}
+	return e.engine
+}
+
 func (e *Environment) SortKeys(ctx context.Context, src dag.Op) order.SortKeys {
 	if e.lake != nil {
 		return e.lake.SortKeys(ctx, src)
@@ -82,7 +210,7 @@ func (e *Environment) Open(ctx context.Context, sctx *super.Context, path, forma
 			fields = proj.Paths()
 		}
 	}
-	file, err := anyio.Open(ctx, sctx, e.engine, path, anyio.ReaderOpts{Fields: fields, Format: format})
+	file, err := anyio.Open(ctx, sctx, e.engineFor(path), path, anyio.ReaderOpts{Fields: fields, Format: format})
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", path, err)
 	}
@@ -139,7 +267,7 @@ func (e *Environment) VectorOpen(ctx context.Context, sctx *super.Context, path,
 	if err != nil {
 		return nil, err
 	}
-	r, err := e.engine.Get(ctx, uri)
+	r, err := e.engineFor(path).Get(ctx, uri)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,79 @@
+package exec
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/brimdata/super/zbuf"
+)
+
+// OpStat is a snapshot of the output of an operator of a flowgraph.
+// Elapsed is the time spent in the operator's Pull method, which includes
+// the time spent pulling from the operators upstream of it.  Spill is the
+// statistics of the values spilled by the operator or nil if it does not
+// spill.
+type OpStat struct {
+	Op      string           `json:"op"`
+	Batches uint64           `json:"batches"`
+	Values  uint64           `json:"values"`
+	Elapsed time.Duration    `json:"elapsed"`
+	Spill   *zbuf.SpillStats `json:"spill,omitempty"`
+}
+
+// OpStats collects an OpStat for each operator of a flowgraph.
+type OpStats struct {
+	mu      sync.Mutex
+	pullers []*statsPuller
+}
+
+// Wrap returns a zbuf.Puller that records the output of parent in o under
+// the name op.
+func (o *OpStats) Wrap(op string, parent zbuf.Puller) zbuf.Puller {
+	p := &statsPuller{parent: parent, op: op}
+	o.mu.Lock()
+	o.pullers = append(o.pullers, p)
+	o.mu.Unlock()
+	return p
+}
+
+// Stats returns the statistics of the operators in the order they were
+// wrapped.
+func (o *OpStats) Stats() []OpStat {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	stats := make([]OpStat, 0, len(o.pullers))
+	for _, p := range o.pullers {
+		stat := OpStat{
+			Op:      p.op,
+			Batches: p.batches.Load(),
+			Values:  p.values.Load(),
+			Elapsed: time.Duration(p.elapsed.Load()),
+		}
+		if m, ok := p.parent.(zbuf.SpillMeter); ok {
+			spill := m.SpillStats()
+			stat.Spill = &spill
+		}
+		stats = append(stats, stat)
+	}
+	return stats
+}
+
+type statsPuller struct {
+	parent  zbuf.Puller
+	op      string
+	batches atomic.Uint64
+	values  atomic.Uint64
+	elapsed atomic.Int64
+}
+
+func (s *statsPuller) Pull(done bool) (zbuf.Batch, error) {
+	start := time.Now()
+	batch, err := s.parent.Pull(done)
+	s.elapsed.Add(int64(time.Since(start)))
+	if batch != nil {
+		s.batches.Add(1)
+		s.values.Add(uint64(len(batch.Values())))
+	}
+	return batch, err
+}
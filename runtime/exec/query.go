@@ -1,6 +1,7 @@
 package exec
 
 import (
+	"github.com/brimdata/super/compiler/dag"
 	"github.com/brimdata/super/runtime"
 	"github.com/brimdata/super/zbuf"
 	"github.com/brimdata/super/zio"
@@ -13,6 +14,8 @@ type Query struct {
 	zbuf.Puller
 	rctx  *runtime.Context
 	meter zbuf.Meter
+	plan  dag.Seq
+	stats *OpStats
 }
 
 var _ runtime.Query = (*Query)(nil)
@@ -25,6 +28,15 @@ func NewQuery(rctx *runtime.Context, puller zbuf.Puller, meter zbuf.Meter) *Quer
 	}
 }
 
+// NewQueryWithStats is like NewQuery but retains the DAG from which the
+// flowgraph was built and the statistics of its operators.
+func NewQueryWithStats(rctx *runtime.Context, puller zbuf.Puller, meter zbuf.Meter, plan dag.Seq, stats *OpStats) *Query {
+	q := NewQuery(rctx, puller, meter)
+	q.plan = plan
+	q.stats = stats
+	return q
+}
+
 func (q *Query) AsReader() zio.Reader {
 	return zbuf.PullerReader(q)
 }
@@ -37,6 +49,27 @@ func (q *Query) Meter() zbuf.Meter {
 	return q.meter
 }
 
+// SpillStats returns the statistics of the values spilled to temporary
+// storage by the operators of the flowgraph.
+func (q *Query) SpillStats() zbuf.SpillStats {
+	return q.rctx.Spills.Copy()
+}
+
+// Plan returns the DAG from which the flowgraph was built or nil if it
+// was not retained.
+func (q *Query) Plan() dag.Seq {
+	return q.plan
+}
+
+// OpStats returns the statistics of the operators of the flowgraph or nil
+// if they were not collected.
+func (q *Query) OpStats() []OpStat {
+	if q.stats == nil {
+		return nil
+	}
+	return q.stats.Stats()
+}
+
 func (q *Query) Close() error {
 	q.rctx.Cancel()
 	return nil
@@ -9,6 +9,7 @@ import (
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/pkg/nano"
 	"github.com/brimdata/super/runtime/sam/expr/extent"
+	"github.com/segmentio/ksuid"
 )
 
 // XXX for backward compat keep this for now, and return branchstats for pool/main
@@ -16,49 +17,80 @@ type PoolStats struct {
 	Size int64 `super:"size"`
 	// XXX (nibs) - This shouldn't be a span because keys don't have to be time.
 	Span *nano.Span `super:"span"`
+	// RawSize is the size of the values before they were encoded into
+	// data objects while Size is the size of the data objects in storage.
+	RawSize int64  `super:"raw_size"`
+	Count   uint64 `super:"count"`
+	Objects int    `super:"objects"`
+	// Min and Max are the smallest and largest pool key values.
+	Min      super.Value    `super:"min"`
+	Max      super.Value    `super:"max"`
+	Branches []BranchCommit `super:"branches"`
 }
 
+// BranchCommit identifies the tip of a branch and the time it was committed.
+type BranchCommit struct {
+	Name   string      `super:"name"`
+	Commit ksuid.KSUID `super:"commit"`
+	Date   nano.Ts     `super:"date"`
+}
+
+// GetPoolStats returns the statistics of the snapshot of the pool's main
+// branch along with the tip of each branch.  The statistics are computed
+// from the metadata of the snapshot's data objects without reading them.
 func GetPoolStats(ctx context.Context, p *lake.Pool, snap commits.View) (info PoolStats, err error) {
-	// XXX this doesn't scale... it should be stored in the snapshot and is
-	// not easy to compute in the face of deletes...
-	var poolSpan *extent.Generic
-	for _, object := range snap.Select(nil, p.SortKeys.Primary().Order) {
-		info.Size += object.Size
-		if poolSpan == nil {
-			poolSpan = extent.NewGenericFromOrder(object.Min, object.Max, order.Asc)
-		} else {
-			poolSpan.Extend(object.Min)
-			poolSpan.Extend(object.Max)
-		}
+	totals := snap.Totals()
+	info.Size = totals.Size
+	info.RawSize = totals.RawSize
+	info.Count = totals.Count
+	info.Objects = totals.Objects
+	info.Min, info.Max, info.Span = keyRange(snap, p.SortKeys.Primary().Order)
+	branches, err := p.ListBranches(ctx)
+	if err != nil {
+		return info, err
 	}
-	//XXX need to change API to take return key range
-	if poolSpan != nil {
-		min := poolSpan.First()
-		if min.Type() == super.TypeTime {
-			firstTs := super.DecodeTime(min.Bytes())
-			lastTs := super.DecodeTime(poolSpan.Last().Bytes())
-			if lastTs < firstTs {
-				firstTs, lastTs = lastTs, firstTs
+	for _, branch := range branches {
+		var date nano.Ts
+		if branch.Commit != ksuid.Nil {
+			if date, err = p.CommitDate(ctx, branch.Commit); err != nil {
+				return info, err
 			}
-			span := nano.NewSpanTs(firstTs, lastTs+1)
-			info.Span = &span
 		}
+		info.Branches = append(info.Branches, BranchCommit{
+			Name:   branch.Name,
+			Commit: branch.Commit,
+			Date:   date,
+		})
 	}
-	return info, err
+	return info, nil
 }
 
 type BranchStats struct {
 	Size int64 `super:"size"`
 	// XXX (nibs) - This shouldn't be a span because keys don't have to be time.
-	Span *nano.Span `super:"span"`
+	Span    *nano.Span  `super:"span"`
+	RawSize int64       `super:"raw_size"`
+	Count   uint64      `super:"count"`
+	Objects int         `super:"objects"`
+	Min     super.Value `super:"min"`
+	Max     super.Value `super:"max"`
 }
 
 func GetBranchStats(ctx context.Context, b *lake.Branch, snap commits.View) (info BranchStats, err error) {
-	// XXX this doesn't scale... it should be stored in the snapshot and is
-	// not easy to compute in the face of deletes...
+	totals := snap.Totals()
+	info.Size = totals.Size
+	info.RawSize = totals.RawSize
+	info.Count = totals.Count
+	info.Objects = totals.Objects
+	info.Min, info.Max, info.Span = keyRange(snap, b.Pool().SortKeys.Primary().Order)
+	return info, nil
+}
+
+// keyRange returns the smallest and largest pool key values of the data
+// objects in snap and, when the pool key is a time, the span they cover.
+func keyRange(snap commits.View, o order.Which) (super.Value, super.Value, *nano.Span) {
 	var poolSpan *extent.Generic
-	for _, object := range snap.Select(nil, b.Pool().SortKeys.Primary().Order) {
-		info.Size += object.Size
+	for _, object := range snap.Select(nil, o) {
 		if poolSpan == nil {
 			poolSpan = extent.NewGenericFromOrder(object.Min, object.Max, order.Asc)
 		} else {
@@ -66,18 +98,21 @@ func GetBranchStats(ctx context.Context, b *lake.Branch, snap commits.View) (inf
 			poolSpan.Extend(object.Max)
 		}
 	}
+	if poolSpan == nil {
+		return super.Null, super.Null, nil
+	}
+	min, max := poolSpan.First(), poolSpan.Last()
 	//XXX need to change API to take return key range
-	if poolSpan != nil {
-		min := poolSpan.First()
-		if min.Type() == super.TypeTime {
-			firstTs := super.DecodeTime(min.Bytes())
-			lastTs := super.DecodeTime(poolSpan.Last().Bytes())
-			if lastTs < firstTs {
-				firstTs, lastTs = lastTs, firstTs
-			}
-			span := nano.NewSpanTs(firstTs, lastTs+1)
-			info.Span = &span
+	var span *nano.Span
+	if min.Type() == super.TypeTime {
+		firstTs := super.DecodeTime(min.Bytes())
+		lastTs := super.DecodeTime(max.Bytes())
+		if lastTs < firstTs {
+			firstTs, lastTs = lastTs, firstTs
+			min, max = max, min
 		}
+		s := nano.NewSpanTs(firstTs, lastTs+1)
+		span = &s
 	}
-	return info, err
+	return min, max, span
 }
@@ -0,0 +1,75 @@
+package runtime
+
+import "sync/atomic"
+
+// QueryMemMaxBytes is the limit of the Memory of each query, i.e., of the
+// Context returned by NewContext.
+var QueryMemMaxBytes = 1024 * 1024 * 1024
+
+// ProcessMemory is the Memory shared by all of the queries in the process.
+// It has no limit unless one is set, e.g., by a command-line flag.
+var ProcessMemory = NewMemory(0, nil)
+
+// Memory is a budget of bytes of memory drawn upon by operators that hold
+// data in memory and can spill it to storage, e.g., aggregations.  An
+// operator reserves bytes as it grows and, when a reservation fails, spills
+// and releases what it holds.  A Memory may draw upon a parent so that,
+// e.g., each query is limited both individually and in aggregate.  A nil
+// Memory has no limit.
+type Memory struct {
+	limit  atomic.Int64
+	used   atomic.Int64
+	parent *Memory
+}
+
+// NewMemory returns a Memory limited to limit bytes, or unlimited if limit
+// is zero, that also draws upon parent if it is not nil.
+func NewMemory(limit int64, parent *Memory) *Memory {
+	m := &Memory{parent: parent}
+	m.limit.Store(limit)
+	return m
+}
+
+// SetLimit sets the limit of m to n bytes, or removes the limit if n is
+// zero.  Bytes already reserved are unaffected.
+func (m *Memory) SetLimit(n int64) {
+	m.limit.Store(n)
+}
+
+// Reserve reserves n bytes of m and its ancestors and returns true, or if
+// that would exceed any of their limits, reserves nothing and returns false.
+func (m *Memory) Reserve(n int64) bool {
+	for at := m; at != nil; at = at.parent {
+		used := at.used.Add(n)
+		if limit := at.limit.Load(); limit > 0 && used > limit {
+			for undo := m; undo != at.parent; undo = undo.parent {
+				undo.used.Add(-n)
+			}
+			return false
+		}
+	}
+	return true
+}
+
+// Grow reserves n bytes of m and its ancestors regardless of their limits.
+// It is used when an operator cannot make progress without the bytes.
+func (m *Memory) Grow(n int64) {
+	for at := m; at != nil; at = at.parent {
+		at.used.Add(n)
+	}
+}
+
+// Release returns n reserved bytes to m and its ancestors.
+func (m *Memory) Release(n int64) {
+	for at := m; at != nil; at = at.parent {
+		at.used.Add(-n)
+	}
+}
+
+// Used returns the number of bytes reserved from m.
+func (m *Memory) Used() int64 {
+	if m == nil {
+		return 0
+	}
+	return m.used.Load()
+}
@@ -0,0 +1,27 @@
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/brimdata/super/runtime"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemory(t *testing.T) {
+	process := runtime.NewMemory(100, nil)
+	query1 := runtime.NewMemory(60, process)
+	query2 := runtime.NewMemory(60, process)
+	assert.True(t, query1.Reserve(50))
+	assert.False(t, query1.Reserve(20), "query limit exceeded")
+	assert.False(t, query2.Reserve(60), "process limit exceeded")
+	assert.EqualValues(t, 50, query1.Used())
+	assert.EqualValues(t, 0, query2.Used())
+	assert.EqualValues(t, 50, process.Used())
+	query2.Grow(60)
+	assert.EqualValues(t, 110, process.Used())
+	query1.Release(50)
+	query2.Release(60)
+	assert.EqualValues(t, 0, process.Used())
+	var unlimited *runtime.Memory
+	assert.EqualValues(t, 0, unlimited.Used())
+}
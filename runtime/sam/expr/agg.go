@@ -6,13 +6,17 @@ import (
 )
 
 type Aggregator struct {
+	name    string
 	pattern agg.Pattern
 	expr    Evaluator
 	where   Evaluator
+	// finisher is true if the functions created by pattern are
+	// agg.Finishers.
+	finisher bool
 }
 
-func NewAggregator(op string, distinct bool, expr Evaluator, where Evaluator) (*Aggregator, error) {
-	pattern, err := agg.NewPattern(op, distinct, expr != nil)
+func NewAggregator(op string, distinct bool, expr Evaluator, where Evaluator, params ...float64) (*Aggregator, error) {
+	pattern, err := agg.NewPattern(op, distinct, expr != nil, params...)
 	if err != nil {
 		return nil, err
 	}
@@ -21,18 +25,29 @@ func NewAggregator(op string, distinct bool, expr Evaluator, where Evaluator) (*
 		// true so it counts each value encountered.
 		expr = &Literal{super.True}
 	}
+	_, finisher := pattern().(agg.Finisher)
 	return &Aggregator{
-		pattern: pattern,
-		expr:    expr,
-		where:   where,
+		name:     op,
+		pattern:  pattern,
+		expr:     expr,
+		where:    where,
+		finisher: finisher,
 	}, nil
 }
 
+// Name returns the name of the aggregate function.
+func (a *Aggregator) Name() string {
+	return a.name
+}
+
 func (a *Aggregator) NewFunction() agg.Function {
 	return a.pattern()
 }
 
 func (a *Aggregator) Apply(sctx *super.Context, ectx Context, f agg.Function, this super.Value) {
+	if a.finisher && f.(agg.Finisher).Finished() {
+		return
+	}
 	if a.where != nil {
 		if val := EvalBool(sctx, ectx, this, a.where); !val.AsBool() {
 			// XXX Issue #3401: do something with "where" errors.
@@ -23,7 +23,23 @@ type Function interface {
 	ResultAsPartial(*super.Context) super.Value
 }
 
-func NewPattern(op string, distinct, hasarg bool) (Pattern, error) {
+// A Finisher is a Function that can finish before it has consumed all of
+// its input, e.g., because its result is known.  Consuming a value has no
+// effect on a finished Function so the value need not be computed.
+type Finisher interface {
+	Finished() bool
+}
+
+// A Sizer is a Function whose state grows with the values it consumes.
+// Size returns the approximate number of bytes held by the state.
+type Sizer interface {
+	Size() int
+}
+
+// NewPattern returns the pattern for aggregate function op.  params are the
+// values of the constant parameters that follow the argument of op, of
+// which there must be NumParams(op).
+func NewPattern(op string, distinct, hasarg bool, params ...float64) (Pattern, error) {
 	needarg := true
 	var pattern Pattern
 	switch op {
@@ -41,14 +57,58 @@ func NewPattern(op string, distinct, hasarg bool) (Pattern, error) {
 		pattern = func() Function {
 			return &Avg{}
 		}
-	case "dcount":
+	case "approx_count_distinct", "dcount":
 		pattern = func() Function {
 			return NewDCount()
 		}
+	case "approx_top":
+		pattern = func() Function {
+			return NewApproxTop(int(params[0]))
+		}
+	case "approx_histogram":
+		pattern = func() Function {
+			return NewApproxHistogram(int(params[0]))
+		}
+	case "histogram":
+		pattern = func() Function {
+			return NewHistogram(params[0], params[1], int(params[2]))
+		}
+	case "increase":
+		pattern = func() Function {
+			return NewIncrease()
+		}
+	case "mad":
+		pattern = func() Function {
+			return NewMAD()
+		}
+	case "median":
+		pattern = func() Function {
+			return NewPercentile(50)
+		}
+	case "percentile":
+		pattern = func() Function {
+			return NewPercentile(params[0])
+		}
+	case "first":
+		pattern = func() Function {
+			return &First{}
+		}
 	case "fuse":
 		pattern = func() Function {
 			return newFuse()
 		}
+	case "last":
+		pattern = func() Function {
+			return &Last{}
+		}
+	case "rate":
+		pattern = func() Function {
+			return NewRate()
+		}
+	case "time_weighted_avg":
+		pattern = func() Function {
+			return NewTimeWeightedAvg()
+		}
 	case "sum":
 		pattern = func() Function {
 			return newMathReducer(anymath.Add)
@@ -69,6 +129,10 @@ func NewPattern(op string, distinct, hasarg bool) (Pattern, error) {
 		pattern = func() Function {
 			return NewUnion()
 		}
+	case "unique_rate":
+		pattern = func() Function {
+			return NewUniqueRate()
+		}
 	case "collect":
 		pattern = func() Function {
 			return &Collect{}
@@ -87,6 +151,9 @@ func NewPattern(op string, distinct, hasarg bool) (Pattern, error) {
 	if needarg && !hasarg {
 		return nil, fmt.Errorf("%s: argument required", op)
 	}
+	if n := NumParams(op); len(params) != n {
+		return nil, fmt.Errorf("%s: expected %d constant parameters but found %d", op, n, len(params))
+	}
 	if distinct {
 		switch op {
 		case "avg", "collect", "count", "sum":
@@ -96,3 +163,15 @@ func NewPattern(op string, distinct, hasarg bool) (Pattern, error) {
 	}
 	return pattern, nil
 }
+
+// NumParams returns the number of constant parameters that follow the
+// argument of aggregate function op, e.g., the percentage of percentile.
+func NumParams(op string) int {
+	switch op {
+	case "approx_histogram", "approx_top", "percentile":
+		return 1
+	case "histogram":
+		return 3
+	}
+	return 0
+}
@@ -0,0 +1,232 @@
+package agg
+
+import (
+	"cmp"
+	"container/heap"
+	"fmt"
+	"slices"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/sup"
+	"github.com/brimdata/super/zcode"
+)
+
+// approxTopFactor is the number of values tracked by ApproxTop for each of
+// the k values in its result.
+const approxTopFactor = 10
+
+// MaxApproxTopCount is the largest k of an ApproxTop.
+const MaxApproxTopCount = 10000
+
+// ApproxTop estimates the k most frequent values of its input and their
+// counts with the space-saving algorithm of Metwally et al.  It tracks at
+// most k*approxTopFactor values, so its counts are exact when the input has
+// no more distinct values than that and otherwise overestimate a value's
+// count by at most the count of the least frequent tracked value.  Its
+// result is an array of records of the form {value,count} in descending
+// order of count.  Its partial result has the same form but holds every
+// tracked value, and partials merge as described by Agarwal et al. in
+// "Mergeable Summaries".
+type ApproxTop struct {
+	k        int
+	capacity int
+	counters topHeap
+	index    map[topKey]*topCounter
+	size     int
+}
+
+var _ Function = (*ApproxTop)(nil)
+
+type topKey struct {
+	typ   super.Type
+	bytes string
+}
+
+type topCounter struct {
+	val   super.Value
+	count uint64
+	// slot is the index of the counter in its topHeap.
+	slot int
+}
+
+func NewApproxTop(k int) *ApproxTop {
+	return &ApproxTop{
+		k:        k,
+		capacity: k * approxTopFactor,
+		index:    make(map[topKey]*topCounter),
+	}
+}
+
+func (a *ApproxTop) Consume(val super.Value) {
+	if val.IsNull() {
+		return
+	}
+	a.add(val.Under(), 1)
+}
+
+// add adds n to the count of val.  If val is not tracked and the counters
+// are full, val replaces the least frequent value, whose count is an upper
+// bound on the count of val before this call.
+func (a *ApproxTop) add(val super.Value, n uint64) {
+	key := topKey{val.Type(), string(val.Bytes())}
+	if c, ok := a.index[key]; ok {
+		c.count += n
+		heap.Fix(&a.counters, c.slot)
+		return
+	}
+	if len(a.counters) < a.capacity {
+		c := &topCounter{val: val.Copy(), count: n}
+		heap.Push(&a.counters, c)
+		a.index[key] = c
+		a.size += len(val.Bytes())
+		return
+	}
+	c := a.counters[0]
+	delete(a.index, topKey{c.val.Type(), string(c.val.Bytes())})
+	a.size += len(val.Bytes()) - len(c.val.Bytes())
+	c.val = val.Copy()
+	c.count += n
+	heap.Fix(&a.counters, 0)
+	a.index[key] = c
+}
+
+func (a *ApproxTop) Result(sctx *super.Context) super.Value {
+	return a.result(sctx, a.k)
+}
+
+func (a *ApproxTop) ConsumeAsPartial(partial super.Value) {
+	if partial.IsNull() {
+		return
+	}
+	arrayType, ok := partial.Type().(*super.TypeArray)
+	if !ok {
+		panic(fmt.Errorf("approx_top: partial not an array type: %s", sup.FormatValue(partial)))
+	}
+	// Values tracked by only one of the summaries might have been evicted
+	// from the other, so the count of the other's least frequent value, if
+	// it is full, bounds the count missing from each.
+	var others []topCounter
+	var otherMin uint64
+	for it := partial.Iter(); !it.Done(); {
+		rec := super.NewValue(arrayType.Type, it.Next())
+		c := topCounter{
+			val:   rec.Deref("value").Under(),
+			count: rec.Deref("count").Uint(),
+		}
+		if otherMin == 0 || c.count < otherMin {
+			otherMin = c.count
+		}
+		others = append(others, c)
+	}
+	if len(others) < a.capacity {
+		otherMin = 0
+	}
+	var ownMin uint64
+	if len(a.counters) == a.capacity {
+		ownMin = a.counters[0].count
+	}
+	seen := make(map[topKey]bool)
+	for _, o := range others {
+		key := topKey{o.val.Type(), string(o.val.Bytes())}
+		seen[key] = true
+		if c, ok := a.index[key]; ok {
+			c.count += o.count
+		} else {
+			c := &topCounter{val: o.val.Copy(), count: o.count + ownMin}
+			a.counters = append(a.counters, c)
+			a.index[key] = c
+			a.size += len(o.val.Bytes())
+		}
+	}
+	for key, c := range a.index {
+		if !seen[key] {
+			c.count += otherMin
+		}
+	}
+	// Keep the most frequent values.
+	slices.SortFunc(a.counters, compareTopCounters)
+	for _, c := range a.counters[min(len(a.counters), a.capacity):] {
+		delete(a.index, topKey{c.val.Type(), string(c.val.Bytes())})
+		a.size -= len(c.val.Bytes())
+	}
+	a.counters = a.counters[:min(len(a.counters), a.capacity)]
+	for i, c := range a.counters {
+		c.slot = i
+	}
+	heap.Init(&a.counters)
+}
+
+func (a *ApproxTop) ResultAsPartial(sctx *super.Context) super.Value {
+	return a.result(sctx, len(a.counters))
+}
+
+// result returns the n most frequent values as an array of {value,count}
+// records.
+func (a *ApproxTop) result(sctx *super.Context, n int) super.Value {
+	if len(a.counters) == 0 {
+		return super.Null
+	}
+	counters := slices.Clone(a.counters)
+	slices.SortFunc(counters, compareTopCounters)
+	counters = counters[:min(n, len(counters))]
+	vals := make([]super.Value, 0, len(counters))
+	for _, c := range counters {
+		vals = append(vals, c.val)
+	}
+	inner := innerType(sctx, vals)
+	union, _ := inner.(*super.TypeUnion)
+	recType := sctx.MustLookupTypeRecord([]super.Field{
+		super.NewField("value", inner),
+		super.NewField("count", super.TypeUint64),
+	})
+	var b zcode.Builder
+	for _, c := range counters {
+		b.BeginContainer()
+		if union != nil {
+			super.BuildUnion(&b, union.TagOf(c.val.Type()), c.val.Bytes())
+		} else {
+			b.Append(c.val.Bytes())
+		}
+		b.Append(super.EncodeUint(c.count))
+		b.EndContainer()
+	}
+	return super.NewValue(sctx.LookupTypeArray(recType), b.Bytes())
+}
+
+func (a *ApproxTop) Size() int {
+	return a.size
+}
+
+// compareTopCounters orders counters by descending count and then by the
+// text of their values so that results are deterministic.
+func compareTopCounters(a, b *topCounter) int {
+	if c := cmp.Compare(b.count, a.count); c != 0 {
+		return c
+	}
+	return cmp.Compare(sup.FormatValue(a.val), sup.FormatValue(b.val))
+}
+
+// topHeap is a min-heap of counters ordered by count.
+type topHeap []*topCounter
+
+func (h topHeap) Len() int           { return len(h) }
+func (h topHeap) Less(i, j int) bool { return h[i].count < h[j].count }
+
+func (h topHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].slot = i
+	h[j].slot = j
+}
+
+func (h *topHeap) Push(x any) {
+	c := x.(*topCounter)
+	c.slot = len(*h)
+	*h = append(*h, c)
+}
+
+func (h *topHeap) Pop() any {
+	old := *h
+	c := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return c
+}
@@ -79,3 +79,7 @@ func (c *Collect) ConsumeAsPartial(val super.Value) {
 func (c *Collect) ResultAsPartial(sctx *super.Context) super.Value {
 	return c.Result(sctx)
 }
+
+func (c *Collect) Size() int {
+	return c.size
+}
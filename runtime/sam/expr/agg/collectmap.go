@@ -10,6 +10,7 @@ import (
 type CollectMap struct {
 	entries map[string]mapEntry
 	scratch []byte
+	size    int
 }
 
 func newCollectMap() *CollectMap {
@@ -40,7 +41,11 @@ func (c *CollectMap) Consume(val super.Value) {
 		c.scratch = super.AppendTypeValue(c.scratch[:0], key.Type())
 		c.scratch = append(c.scratch, keyTagAndBody...)
 		// This will squash existing values which is what we want.
+		if old, ok := c.entries[string(c.scratch)]; ok {
+			c.size -= len(c.scratch) + len(old.val.Bytes())
+		}
 		c.entries[string(c.scratch)] = mapEntry{key, val}
+		c.size += len(c.scratch) + len(val.Bytes())
 	}
 }
 
@@ -76,6 +81,10 @@ func (c *CollectMap) ResultAsPartial(sctx *super.Context) super.Value {
 	return c.Result(sctx)
 }
 
+func (c *CollectMap) Size() int {
+	return c.size
+}
+
 func appendMapVal(b *zcode.Builder, typ super.Type, val super.Value, uniq int) {
 	if uniq > 1 {
 		u := super.TypeUnder(typ).(*super.TypeUnion)
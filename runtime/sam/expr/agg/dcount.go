@@ -10,7 +10,9 @@ import (
 )
 
 // DCount uses hyperloglog to approximate the count of unique values for
-// a field.
+// a field.  It implements both dcount and approx_count_distinct.  Its
+// partial result is the serialized sketch, so partials from spills and
+// from the workers of a parallel lake scan merge without loss.
 type DCount struct {
 	scratch zcode.Bytes
 	sketch  *hyperloglog.Sketch
@@ -8,11 +8,12 @@ import (
 )
 
 type distinct struct {
-	fun      Function
-	buf      []byte
-	seen     map[string]struct{}
-	size     int
-	partials [][]byte
+	fun          Function
+	buf          []byte
+	seen         map[string]struct{}
+	size         int
+	partials     [][]byte
+	partialsSize int
 }
 
 func newDistinct(f Function) Function {
@@ -34,6 +35,7 @@ func (d *distinct) ConsumeAsPartial(val super.Value) {
 		panic("distinct: invalid partial")
 	}
 	d.partials = append(d.partials, val.Bytes())
+	d.partialsSize += len(val.Bytes())
 }
 
 func (d *distinct) Result(sctx *super.Context) super.Value {
@@ -66,6 +68,10 @@ func (d *distinct) Result(sctx *super.Context) super.Value {
 	return d.fun.Result(sctx)
 }
 
+func (d *distinct) Size() int {
+	return d.size + d.partialsSize
+}
+
 func (d *distinct) ResultAsPartial(sctx *super.Context) super.Value {
 	buf := make([]byte, 0, d.size)
 	for key := range d.seen {
@@ -0,0 +1,66 @@
+package agg
+
+import (
+	"github.com/brimdata/super"
+)
+
+// First returns the first non-null value of its input in input order.
+// Once it has a value, it is finished and ignores the rest of its input.
+type First struct {
+	val *super.Value
+}
+
+var _ Finisher = (*First)(nil)
+
+func (f *First) Consume(val super.Value) {
+	if f.val == nil && !val.IsNull() {
+		f.val = val.Copy().Ptr()
+	}
+}
+
+func (f *First) ConsumeAsPartial(val super.Value) {
+	// Partials are consumed in the order of the input from which they
+	// were computed so the first non-null partial is the result.
+	f.Consume(val)
+}
+
+func (f *First) Result(*super.Context) super.Value {
+	if f.val == nil {
+		return super.Null
+	}
+	return *f.val
+}
+
+func (f *First) ResultAsPartial(*super.Context) super.Value {
+	return f.Result(nil)
+}
+
+func (f *First) Finished() bool {
+	return f.val != nil
+}
+
+// Last returns the last non-null value of its input in input order.
+type Last struct {
+	val *super.Value
+}
+
+func (l *Last) Consume(val super.Value) {
+	if !val.IsNull() {
+		l.val = val.Copy().Ptr()
+	}
+}
+
+func (l *Last) ConsumeAsPartial(val super.Value) {
+	l.Consume(val)
+}
+
+func (l *Last) Result(*super.Context) super.Value {
+	if l.val == nil {
+		return super.Null
+	}
+	return *l.val
+}
+
+func (l *Last) ResultAsPartial(*super.Context) super.Value {
+	return l.Result(nil)
+}
@@ -0,0 +1,212 @@
+package agg
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
+	"github.com/brimdata/super/sup"
+	"github.com/brimdata/super/zcode"
+)
+
+// MaxHistogramBins is the largest number of bins of a Histogram or an
+// ApproxHistogram.
+const MaxHistogramBins = 10000
+
+// Histogram counts numeric values in bins of equal width that span the
+// interval from lo to hi.  Each bin includes its lower bound and excludes
+// its upper bound except for the last bin, which includes hi.  Values
+// outside the interval are ignored.  Its result is an array of records of
+// the form {lo,hi,count}, one for each bin in ascending order.  Its partial
+// result is the array of the counts of the bins, so partials merge by
+// adding their counts.
+type Histogram struct {
+	lo     float64
+	hi     float64
+	counts []uint64
+	total  uint64
+}
+
+var _ Function = (*Histogram)(nil)
+
+func NewHistogram(lo, hi float64, nbins int) *Histogram {
+	return &Histogram{
+		lo:     lo,
+		hi:     hi,
+		counts: make([]uint64, nbins),
+	}
+}
+
+func (h *Histogram) Consume(val super.Value) {
+	if val.IsNull() {
+		return
+	}
+	f, ok := coerce.ToFloat(val, super.TypeFloat64)
+	if !ok || !(h.lo <= f && f <= h.hi) {
+		return
+	}
+	n := len(h.counts)
+	bin := min(int((f-h.lo)/(h.hi-h.lo)*float64(n)), n-1)
+	h.counts[bin]++
+	h.total++
+}
+
+func (h *Histogram) Result(sctx *super.Context) super.Value {
+	if h.total == 0 {
+		return super.Null
+	}
+	recType := sctx.MustLookupTypeRecord([]super.Field{
+		super.NewField("lo", super.TypeFloat64),
+		super.NewField("hi", super.TypeFloat64),
+		super.NewField("count", super.TypeUint64),
+	})
+	width := (h.hi - h.lo) / float64(len(h.counts))
+	var b zcode.Builder
+	for i, count := range h.counts {
+		hi := h.lo + float64(i+1)*width
+		if i == len(h.counts)-1 {
+			hi = h.hi
+		}
+		b.BeginContainer()
+		b.Append(super.EncodeFloat64(h.lo + float64(i)*width))
+		b.Append(super.EncodeFloat64(hi))
+		b.Append(super.EncodeUint(count))
+		b.EndContainer()
+	}
+	return super.NewValue(sctx.LookupTypeArray(recType), b.Bytes())
+}
+
+func (h *Histogram) ConsumeAsPartial(partial super.Value) {
+	if partial.IsNull() {
+		return
+	}
+	arrayType, ok := partial.Type().(*super.TypeArray)
+	if !ok || arrayType.Type != super.TypeUint64 {
+		panic(fmt.Errorf("histogram: partial has bad type: %s", sup.FormatValue(partial)))
+	}
+	var i int
+	for it := partial.Iter(); !it.Done(); i++ {
+		if i == len(h.counts) {
+			panic(fmt.Errorf("histogram: partial has too many bins: %s", sup.FormatValue(partial)))
+		}
+		count := super.DecodeUint(it.Next())
+		h.counts[i] += count
+		h.total += count
+	}
+}
+
+func (h *Histogram) ResultAsPartial(sctx *super.Context) super.Value {
+	if h.total == 0 {
+		return super.Null
+	}
+	var b zcode.Builder
+	for _, count := range h.counts {
+		b.Append(super.EncodeUint(count))
+	}
+	return super.NewValue(sctx.LookupTypeArray(super.TypeUint64), b.Bytes())
+}
+
+// ApproxHistogram summarizes the distribution of numeric values in at most
+// nbins bins of varying width with the streaming histogram of Ben-Haim and
+// Tom-Tov.  Each bin is the mean of the values it holds and their count.
+// Once there are more than nbins bins, the two bins whose means are
+// closest are merged, so the bins are narrow where the values are dense
+// and exact when there are no more than nbins distinct values.  Its result
+// is an array of records of the form {value,count} in ascending order of
+// value.  Its partial result has the same form, and partials merge by
+// adding their bins.
+type ApproxHistogram struct {
+	nbins int
+	bins  []histogramBin
+}
+
+var _ Function = (*ApproxHistogram)(nil)
+
+type histogramBin struct {
+	value float64
+	count uint64
+}
+
+func NewApproxHistogram(nbins int) *ApproxHistogram {
+	return &ApproxHistogram{nbins: nbins}
+}
+
+func (a *ApproxHistogram) Consume(val super.Value) {
+	if val.IsNull() {
+		return
+	}
+	if f, ok := coerce.ToFloat(val, super.TypeFloat64); ok && !math.IsNaN(f) {
+		a.add(f, 1)
+	}
+}
+
+// add adds count values at value, merging the closest bins if there are
+// then more than nbins bins.
+func (a *ApproxHistogram) add(value float64, count uint64) {
+	i := sort.Search(len(a.bins), func(i int) bool { return a.bins[i].value >= value })
+	if i < len(a.bins) && a.bins[i].value == value {
+		a.bins[i].count += count
+		return
+	}
+	a.bins = slices.Insert(a.bins, i, histogramBin{value, count})
+	if len(a.bins) <= a.nbins {
+		return
+	}
+	closest := 0
+	for i := 1; i < len(a.bins)-1; i++ {
+		if a.bins[i+1].value-a.bins[i].value < a.bins[closest+1].value-a.bins[closest].value {
+			closest = i
+		}
+	}
+	l, r := a.bins[closest], a.bins[closest+1]
+	total := l.count + r.count
+	mean := l.value + (r.value-l.value)*float64(r.count)/float64(total)
+	a.bins[closest] = histogramBin{mean, total}
+	a.bins = slices.Delete(a.bins, closest+1, closest+2)
+}
+
+func (a *ApproxHistogram) Result(sctx *super.Context) super.Value {
+	if len(a.bins) == 0 {
+		return super.Null
+	}
+	var b zcode.Builder
+	for _, bin := range a.bins {
+		b.BeginContainer()
+		b.Append(super.EncodeFloat64(bin.value))
+		b.Append(super.EncodeUint(bin.count))
+		b.EndContainer()
+	}
+	recType := sctx.MustLookupTypeRecord([]super.Field{
+		super.NewField("value", super.TypeFloat64),
+		super.NewField("count", super.TypeUint64),
+	})
+	return super.NewValue(sctx.LookupTypeArray(recType), b.Bytes())
+}
+
+func (a *ApproxHistogram) ConsumeAsPartial(partial super.Value) {
+	if partial.IsNull() {
+		return
+	}
+	arrayType, ok := partial.Type().(*super.TypeArray)
+	if !ok || !isHistogramBinType(arrayType.Type) {
+		panic(fmt.Errorf("approx_histogram: partial has bad type: %s", sup.FormatValue(partial)))
+	}
+	for it := partial.Iter(); !it.Done(); {
+		rec := super.NewValue(arrayType.Type, it.Next())
+		a.add(rec.Deref("value").Float(), rec.Deref("count").Uint())
+	}
+}
+
+func (a *ApproxHistogram) ResultAsPartial(sctx *super.Context) super.Value {
+	return a.Result(sctx)
+}
+
+func isHistogramBinType(typ super.Type) bool {
+	recType, ok := typ.(*super.TypeRecord)
+	return ok && len(recType.Fields) == 2 &&
+		recType.Fields[0] == super.NewField("value", super.TypeFloat64) &&
+		recType.Fields[1] == super.NewField("count", super.TypeUint64)
+}
@@ -0,0 +1,88 @@
+package agg
+
+import (
+	"fmt"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/sup"
+	"github.com/brimdata/super/zcode"
+)
+
+// PartialVersion is the version of the encoding of the values returned by
+// Function.ResultAsPartial.  It must be incremented whenever the encoding
+// of a partial result changes so that a partial result produced by a
+// different version of a worker (or stored in a lake summary) is not
+// silently consumed as if it were of the current encoding.
+const PartialVersion = 1
+
+// A PartialDecoder converts a partial result of an earlier version to a
+// partial result of the current version.
+type PartialDecoder func(*super.Context, super.Value) (super.Value, error)
+
+type partialKey struct {
+	name    string
+	version int
+}
+
+var partialDecoders = map[partialKey]PartialDecoder{}
+
+// RegisterPartialDecoder registers the decoder that converts a partial
+// result of aggregate function name at version to the current version.
+// It should be called from an init function.
+func RegisterPartialDecoder(name string, version int, d PartialDecoder) {
+	if version >= PartialVersion {
+		panic(fmt.Sprintf("partial decoder for %s: version %d is not earlier than version %d", name, version, PartialVersion))
+	}
+	k := partialKey{name, version}
+	if _, ok := partialDecoders[k]; ok {
+		panic(fmt.Sprintf("partial decoder for %s version %d registered twice", name, version))
+	}
+	partialDecoders[k] = d
+}
+
+// EncodePartial returns partial, a partial result of an aggregate function,
+// wrapped in a record of the form {version:uint8,partial:<partial>}.
+func EncodePartial(sctx *super.Context, partial super.Value) super.Value {
+	typ := sctx.MustLookupTypeRecord([]super.Field{
+		super.NewField("version", super.TypeUint8),
+		super.NewField("partial", partial.Type()),
+	})
+	var b zcode.Builder
+	b.Append(super.EncodeUint(PartialVersion))
+	b.Append(partial.Bytes())
+	return super.NewValue(typ, b.Bytes())
+}
+
+// DecodePartial returns the partial result of aggregate function name
+// wrapped in val by EncodePartial, converting it to the current version
+// with a registered PartialDecoder if needed.  It returns an error if val
+// is not a wrapped partial result or its version cannot be decoded.
+func DecodePartial(sctx *super.Context, name string, val super.Value) (super.Value, error) {
+	version, partial, ok := splitPartial(val)
+	if !ok {
+		return super.Value{}, fmt.Errorf("%s: partial result is not versioned: %s", name, sup.FormatValue(val))
+	}
+	if version == PartialVersion {
+		return partial, nil
+	}
+	d, ok := partialDecoders[partialKey{name, version}]
+	if !ok {
+		return super.Value{}, fmt.Errorf("%s: unsupported version %d of partial result (version %d expected)", name, version, PartialVersion)
+	}
+	return d(sctx, partial)
+}
+
+func splitPartial(val super.Value) (int, super.Value, bool) {
+	typ, ok := val.Type().(*super.TypeRecord)
+	if !ok || val.IsNull() || len(typ.Fields) != 2 ||
+		typ.Fields[0].Name != "version" || typ.Fields[0].Type != super.TypeUint8 ||
+		typ.Fields[1].Name != "partial" {
+		return 0, super.Value{}, false
+	}
+	it := val.Bytes().Iter()
+	version := it.Next()
+	if version == nil {
+		return 0, super.Value{}, false
+	}
+	return int(super.DecodeUint(version)), super.NewValue(typ.Fields[1].Type, it.Next()), true
+}
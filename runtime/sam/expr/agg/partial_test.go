@@ -0,0 +1,42 @@
+package agg
+
+import (
+	"testing"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/sup"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartial(t *testing.T) {
+	sctx := super.NewContext()
+	avg := &Avg{}
+	avg.Consume(super.NewInt64(3))
+	avg.Consume(super.NewInt64(4))
+	partial := avg.ResultAsPartial(sctx)
+	encoded := EncodePartial(sctx, partial)
+	assert.Equal(t, "{version:1(uint8),partial:"+sup.FormatValue(partial)+"}", sup.FormatValue(encoded))
+
+	decoded, err := DecodePartial(sctx, "avg", encoded)
+	require.NoError(t, err)
+	assert.Equal(t, sup.FormatValue(partial), sup.FormatValue(decoded))
+
+	_, err = DecodePartial(sctx, "avg", partial)
+	assert.EqualError(t, err, "avg: partial result is not versioned: "+sup.FormatValue(partial))
+
+	future := sup.MustParseValue(sctx, "{version:2(uint8),partial:1}")
+	_, err = DecodePartial(sctx, "avg", future)
+	assert.EqualError(t, err, "avg: unsupported version 2 of partial result (version 1 expected)")
+
+	// A registered decoder converts a partial result of an earlier version.
+	RegisterPartialDecoder("partial_test", 0, func(sctx *super.Context, val super.Value) (super.Value, error) {
+		return super.NewUint64(val.Uint() * 2), nil
+	})
+	old := sup.MustParseValue(sctx, "{version:0(uint8),partial:21(uint64)}")
+	decoded, err = DecodePartial(sctx, "partial_test", old)
+	require.NoError(t, err)
+	assert.Equal(t, "42(uint64)", sup.FormatValue(decoded))
+	_, err = DecodePartial(sctx, "avg", old)
+	assert.Error(t, err)
+}
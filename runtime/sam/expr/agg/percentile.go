@@ -0,0 +1,85 @@
+package agg
+
+import (
+	"fmt"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/tdigest"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
+	"github.com/brimdata/super/sup"
+)
+
+// Percentile uses a t-digest to estimate a percentile of numeric values.
+// It implements both percentile and median.  Its partial result is the
+// serialized digest, so partials from spills and from the workers of a
+// parallel lake scan merge without materializing the values.
+type Percentile struct {
+	p      float64
+	digest *tdigest.TDigest
+}
+
+var _ Function = (*Percentile)(nil)
+
+// NewPercentile returns a Percentile that estimates the pth percentile,
+// where 0 <= p <= 100.
+func NewPercentile(p float64) *Percentile {
+	return &Percentile{
+		p:      p,
+		digest: tdigest.New(tdigest.DefaultCompression),
+	}
+}
+
+func (p *Percentile) Consume(val super.Value) {
+	if val.IsNull() {
+		return
+	}
+	if f, ok := coerce.ToFloat(val, super.TypeFloat64); ok {
+		p.digest.Add(f)
+	}
+}
+
+func (p *Percentile) Result(*super.Context) super.Value {
+	if p.digest.Count() == 0 {
+		return super.NullFloat64
+	}
+	return super.NewFloat64(p.digest.Quantile(p.p / 100))
+}
+
+func (p *Percentile) ConsumeAsPartial(partial super.Value) {
+	if partial.Type() != super.TypeBytes {
+		panic(fmt.Errorf("percentile: partial has bad type: %s", sup.FormatValue(partial)))
+	}
+	var d tdigest.TDigest
+	if err := d.UnmarshalBinary(partial.Bytes()); err != nil {
+		panic(fmt.Errorf("percentile: unmarshaling partial: %w", err))
+	}
+	p.digest.Merge(&d)
+}
+
+func (p *Percentile) ResultAsPartial(*super.Context) super.Value {
+	b, err := p.digest.MarshalBinary()
+	if err != nil {
+		panic(fmt.Errorf("percentile: marshaling partial: %w", err))
+	}
+	return super.NewBytes(b)
+}
+
+// MAD uses a t-digest to estimate the median absolute deviation of numeric
+// values, i.e., the median of their absolute deviations from their median.
+// Since it is computed from the digest of the values, its partial result
+// is that of Percentile.
+type MAD struct {
+	Percentile
+}
+
+func NewMAD() *MAD {
+	return &MAD{*NewPercentile(50)}
+}
+
+func (m *MAD) Result(*super.Context) super.Value {
+	if m.digest.Count() == 0 {
+		return super.NullFloat64
+	}
+	median := m.digest.Quantile(0.5)
+	return super.NewFloat64(m.digest.Deviations(median).Quantile(0.5))
+}
@@ -0,0 +1,231 @@
+package agg
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
+	"github.com/brimdata/super/sup"
+	"github.com/brimdata/super/zcode"
+)
+
+// TimeArg returns whether aggregate function op takes a time argument
+// following its value argument, in which case it consumes records of the
+// form {value,ts}.
+func TimeArg(op string) bool {
+	switch op {
+	case "increase", "rate", "time_weighted_avg":
+		return true
+	}
+	return false
+}
+
+// sample is a value of a time series at a time.
+type sample struct {
+	ts  nano.Ts
+	val float64
+}
+
+// segment summarizes consecutive samples of a time series from first to
+// last, where acc accumulates the step of the function between each pair
+// of adjacent samples.
+type segment struct {
+	first sample
+	last  sample
+	acc   float64
+}
+
+// TimeSeries computes a function of a time series whose samples are
+// consumed as records of the form {value,ts}.  Each sample is summarized in
+// a segment that is joined to the segments preceding and following it in
+// time, so a TimeSeries whose input is ordered by time holds a single
+// segment.  Partial results are arrays of segments, so partial results over
+// disjoint intervals of time, e.g., those of consecutive spills, combine
+// exactly.  A sample or a segment whose time overlaps that of a segment
+// already held, e.g., a sample that arrives after a later one or the
+// partial results of interleaved parts of a time series, is combined
+// without a step between them, so the result is then approximate.
+type TimeSeries struct {
+	name     string
+	step     func(sample, sample) float64
+	result   func(segment) super.Value
+	segments []segment
+}
+
+var _ Function = (*TimeSeries)(nil)
+
+// NewIncrease returns a TimeSeries that computes the increase of a counter,
+// which is assumed to have been reset to zero whenever its value decreases.
+func NewIncrease() *TimeSeries {
+	return &TimeSeries{
+		name: "increase",
+		step: counterStep,
+		result: func(s segment) super.Value {
+			if s.first.ts == s.last.ts {
+				return super.NullFloat64
+			}
+			return super.NewFloat64(s.acc)
+		},
+	}
+}
+
+// NewRate returns a TimeSeries that computes the per-second rate of
+// increase of a counter as for NewIncrease.
+func NewRate() *TimeSeries {
+	return &TimeSeries{
+		name: "rate",
+		step: counterStep,
+		result: func(s segment) super.Value {
+			if s.first.ts == s.last.ts {
+				return super.NullFloat64
+			}
+			return super.NewFloat64(s.acc / seconds(s.first.ts, s.last.ts))
+		},
+	}
+}
+
+// NewTimeWeightedAvg returns a TimeSeries that computes the average of a
+// gauge weighted by time, which linearly interpolates the gauge between
+// samples.
+func NewTimeWeightedAvg() *TimeSeries {
+	return &TimeSeries{
+		name: "time_weighted_avg",
+		step: func(a, b sample) float64 {
+			return (a.val + b.val) / 2 * seconds(a.ts, b.ts)
+		},
+		result: func(s segment) super.Value {
+			if s.first.ts == s.last.ts {
+				return super.NewFloat64(s.last.val)
+			}
+			return super.NewFloat64(s.acc / seconds(s.first.ts, s.last.ts))
+		},
+	}
+}
+
+func counterStep(a, b sample) float64 {
+	if b.val < a.val {
+		// The counter was reset.
+		return b.val
+	}
+	return b.val - a.val
+}
+
+// seconds returns the number of seconds from a to b.
+func seconds(a, b nano.Ts) float64 {
+	return float64(b-a) / float64(nano.Second)
+}
+
+func (t *TimeSeries) Consume(val super.Value) {
+	if _, ok := val.Type().(*super.TypeRecord); !ok || val.IsNull() {
+		return
+	}
+	v, ts := val.Ptr().Deref("value"), val.Ptr().Deref("ts")
+	if v == nil || ts == nil || v.IsNull() || ts.IsNull() || ts.Type().ID() != super.IDTime {
+		return
+	}
+	f, ok := coerce.ToFloat(*v, super.TypeFloat64)
+	if !ok {
+		return
+	}
+	s := sample{super.DecodeTime(ts.Bytes()), f}
+	t.add(segment{first: s, last: s})
+}
+
+// add inserts seg in order of time and joins it to its neighbors where
+// their times do not overlap.
+func (t *TimeSeries) add(seg segment) {
+	i := sort.Search(len(t.segments), func(i int) bool { return t.segments[i].first.ts > seg.first.ts })
+	if i > 0 && t.segments[i-1].last.ts <= seg.first.ts {
+		i--
+		t.segments[i] = t.join(t.segments[i], seg)
+	} else {
+		t.segments = slices.Insert(t.segments, i, seg)
+	}
+	if i+1 < len(t.segments) && t.segments[i].last.ts <= t.segments[i+1].first.ts {
+		t.segments[i] = t.join(t.segments[i], t.segments[i+1])
+		t.segments = slices.Delete(t.segments, i+1, i+2)
+	}
+}
+
+func (t *TimeSeries) join(a, b segment) segment {
+	return segment{a.first, b.last, a.acc + b.acc + t.step(a.last, b.first)}
+}
+
+func (t *TimeSeries) Result(*super.Context) super.Value {
+	if len(t.segments) == 0 {
+		return super.NullFloat64
+	}
+	s := t.segments[0]
+	for _, next := range t.segments[1:] {
+		if s.last.ts <= next.first.ts {
+			s = t.join(s, next)
+			continue
+		}
+		s.acc += next.acc
+		if next.last.ts > s.last.ts {
+			s.last = next.last
+		}
+	}
+	return t.result(s)
+}
+
+func (t *TimeSeries) ConsumeAsPartial(partial super.Value) {
+	if partial.IsNull() {
+		return
+	}
+	arrayType, ok := partial.Type().(*super.TypeArray)
+	if !ok || !isSegmentType(arrayType.Type) {
+		panic(fmt.Errorf("%s: partial has bad type: %s", t.name, sup.FormatValue(partial)))
+	}
+	for it := partial.Iter(); !it.Done(); {
+		fields := it.Next().Iter()
+		var seg segment
+		seg.first.ts = super.DecodeTime(fields.Next())
+		seg.first.val = super.DecodeFloat64(fields.Next())
+		seg.last.ts = super.DecodeTime(fields.Next())
+		seg.last.val = super.DecodeFloat64(fields.Next())
+		seg.acc = super.DecodeFloat64(fields.Next())
+		t.add(seg)
+	}
+}
+
+func (t *TimeSeries) ResultAsPartial(sctx *super.Context) super.Value {
+	if len(t.segments) == 0 {
+		return super.Null
+	}
+	var b zcode.Builder
+	for _, s := range t.segments {
+		b.BeginContainer()
+		b.Append(super.EncodeTime(s.first.ts))
+		b.Append(super.EncodeFloat64(s.first.val))
+		b.Append(super.EncodeTime(s.last.ts))
+		b.Append(super.EncodeFloat64(s.last.val))
+		b.Append(super.EncodeFloat64(s.acc))
+		b.EndContainer()
+	}
+	return super.NewValue(sctx.LookupTypeArray(segmentType(sctx)), b.Bytes())
+}
+
+func (t *TimeSeries) Size() int {
+	return len(t.segments) * 40
+}
+
+var segmentFields = []super.Field{
+	super.NewField("first_ts", super.TypeTime),
+	super.NewField("first", super.TypeFloat64),
+	super.NewField("last_ts", super.TypeTime),
+	super.NewField("last", super.TypeFloat64),
+	super.NewField("acc", super.TypeFloat64),
+}
+
+func segmentType(sctx *super.Context) *super.TypeRecord {
+	return sctx.MustLookupTypeRecord(segmentFields)
+}
+
+func isSegmentType(typ super.Type) bool {
+	recType, ok := typ.(*super.TypeRecord)
+	return ok && slices.Equal(recType.Fields, segmentFields)
+}
@@ -104,3 +104,7 @@ func (u *Union) ConsumeAsPartial(val super.Value) {
 func (u *Union) ResultAsPartial(sctx *super.Context) super.Value {
 	return u.Result(sctx)
 }
+
+func (u *Union) Size() int {
+	return u.size
+}
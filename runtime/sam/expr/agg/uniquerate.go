@@ -0,0 +1,69 @@
+package agg
+
+import (
+	"fmt"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/sup"
+	"github.com/brimdata/super/zcode"
+)
+
+// UniqueRate approximates the fraction of the non-null values it consumes
+// that are distinct, e.g., the rate at which a host contacts new
+// destinations.  It counts distinct values with a DCount, so its partial
+// result is a record of the form {sketch,count} holding the serialized
+// sketch and the number of values.
+type UniqueRate struct {
+	dcount *DCount
+	count  uint64
+}
+
+var _ Function = (*UniqueRate)(nil)
+
+func NewUniqueRate() *UniqueRate {
+	return &UniqueRate{dcount: NewDCount()}
+}
+
+func (u *UniqueRate) Consume(val super.Value) {
+	if val.IsNull() {
+		return
+	}
+	u.dcount.Consume(val)
+	u.count++
+}
+
+func (u *UniqueRate) Result(*super.Context) super.Value {
+	if u.count == 0 {
+		return super.NullFloat64
+	}
+	// The estimate can exceed the count of a small group.
+	estimate := min(u.dcount.sketch.Estimate(), u.count)
+	return super.NewFloat64(float64(estimate) / float64(u.count))
+}
+
+func (u *UniqueRate) ConsumeAsPartial(partial super.Value) {
+	if partial.IsNull() {
+		return
+	}
+	recType, ok := partial.Type().(*super.TypeRecord)
+	if !ok || len(recType.Fields) != 2 || recType.Fields[0].Type != super.TypeBytes || recType.Fields[1].Type != super.TypeUint64 {
+		panic(fmt.Errorf("unique_rate: partial has bad type: %s", sup.FormatValue(partial)))
+	}
+	it := partial.Iter()
+	u.dcount.ConsumeAsPartial(super.NewBytes(it.Next()))
+	u.count += super.DecodeUint(it.Next())
+}
+
+func (u *UniqueRate) ResultAsPartial(sctx *super.Context) super.Value {
+	if u.count == 0 {
+		return super.Null
+	}
+	recType := sctx.MustLookupTypeRecord([]super.Field{
+		super.NewField("sketch", super.TypeBytes),
+		super.NewField("count", super.TypeUint64),
+	})
+	var b zcode.Builder
+	b.Append(u.dcount.ResultAsPartial(sctx).Bytes())
+	b.Append(super.EncodeUint(u.count))
+	return super.NewValue(recType, b.Bytes())
+}
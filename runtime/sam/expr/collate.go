@@ -0,0 +1,155 @@
+package expr
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+// A Collator orders and matches strings according to a collation other
+// than the default, which compares the bytes of their UTF-8 encodings.
+type Collator interface {
+	// Compare returns an integer comparing a and b.
+	Compare(a, b string) int
+	// Key returns a string that is equal to the key of each string that
+	// compares equal to s so that strings may be grouped by collation.
+	Key(s string) string
+}
+
+// NewCollator returns the Collator for the collation called name, which is
+// "nocase" for case-insensitive comparison, "natural" for comparison in
+// which runs of digits compare as numbers (e.g., "a2" < "a10"), or a BCP 47
+// language tag for the collation of the language (e.g., "de" or
+// "sv-u-ks-level2").  It returns nil for the default collation, which is
+// called "binary".
+func NewCollator(name string) (Collator, error) {
+	switch strings.ToLower(name) {
+	case "", "binary":
+		return nil, nil
+	case "nocase":
+		return nocase{}, nil
+	case "natural":
+		return natural{}, nil
+	}
+	tag, err := language.Parse(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown collation %q: %w", name, err)
+	}
+	return &localeCollator{
+		collator: collate.New(tag),
+		keys:     make(map[string]string),
+	}, nil
+}
+
+type nocase struct{}
+
+func (nocase) Compare(a, b string) int {
+	for a != "" && b != "" {
+		ra, na := utf8.DecodeRuneInString(a)
+		rb, nb := utf8.DecodeRuneInString(b)
+		if v := cmp.Compare(foldRune(ra), foldRune(rb)); v != 0 {
+			return v
+		}
+		a, b = a[na:], b[nb:]
+	}
+	return cmp.Compare(len(a), len(b))
+}
+
+func (nocase) Key(s string) string {
+	return strings.Map(foldRune, s)
+}
+
+func foldRune(r rune) rune {
+	return unicode.ToLower(unicode.ToUpper(r))
+}
+
+type natural struct{}
+
+func (natural) Compare(a, b string) int {
+	for a != "" && b != "" {
+		if isDigit(a[0]) && isDigit(b[0]) {
+			var da, db string
+			da, a = splitDigits(a)
+			db, b = splitDigits(b)
+			if v := cmp.Compare(len(da), len(db)); v != 0 {
+				return v
+			}
+			if v := strings.Compare(da, db); v != 0 {
+				return v
+			}
+			continue
+		}
+		if v := cmp.Compare(a[0], b[0]); v != 0 {
+			return v
+		}
+		a, b = a[1:], b[1:]
+	}
+	return cmp.Compare(len(a), len(b))
+}
+
+func (natural) Key(s string) string {
+	var b strings.Builder
+	for s != "" {
+		if !isDigit(s[0]) {
+			b.WriteByte(s[0])
+			s = s[1:]
+			continue
+		}
+		var digits string
+		digits, s = splitDigits(s)
+		b.WriteString(digits)
+	}
+	return b.String()
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// splitDigits splits the run of digits that begins s from the rest of s
+// and returns the run without leading zeros (but at least one digit).
+func splitDigits(s string) (string, string) {
+	n := 1
+	for n < len(s) && isDigit(s[n]) {
+		n++
+	}
+	digits := strings.TrimLeft(s[:n], "0")
+	if digits == "" {
+		digits = "0"
+	}
+	return digits, s[n:]
+}
+
+// localeCollator is safe for concurrent use unlike collate.Collator.  Its
+// key of a string is the first string seen with the same collation key.
+type localeCollator struct {
+	mu       sync.Mutex
+	collator *collate.Collator
+	buf      collate.Buffer
+	keys     map[string]string
+}
+
+func (l *localeCollator) Compare(a, b string) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.collator.CompareString(a, b)
+}
+
+func (l *localeCollator) Key(s string) string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.buf.Reset()
+	k := l.collator.KeyFromString(&l.buf, s)
+	if key, ok := l.keys[string(k)]; ok {
+		return key
+	}
+	s = strings.Clone(s)
+	l.keys[string(k)] = s
+	return s
+}
@@ -0,0 +1,44 @@
+package expr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollator(t *testing.T) {
+	cases := []struct {
+		name string
+		a, b string
+		cmp  int
+	}{
+		{"nocase", "abc", "ABC", 0},
+		{"nocase", "abc", "ABD", -1},
+		{"nocase", "Straße", "STRASSE", 1},
+		{"nocase", "ab", "A", 1},
+		{"natural", "a2", "a10", -1},
+		{"natural", "a02", "a2", 0},
+		{"natural", "a10b", "a10a", 1},
+		{"natural", "10", "9a", 1},
+		{"natural", "a", "1", 1},
+		{"de", "ä", "b", -1},
+		{"sv", "ä", "z", 1},
+		{"de-u-ks-level2", "A", "a", 0},
+	}
+	for _, c := range cases {
+		t.Run(c.name+"/"+c.a+"/"+c.b, func(t *testing.T) {
+			collator, err := NewCollator(c.name)
+			require.NoError(t, err)
+			assert.Equal(t, c.cmp, collator.Compare(c.a, c.b))
+			assert.Equal(t, -c.cmp, collator.Compare(c.b, c.a))
+			// Strings that compare as equal have the same key.
+			assert.Equal(t, c.cmp == 0, collator.Key(c.a) == collator.Key(c.b))
+		})
+	}
+	collator, err := NewCollator("binary")
+	require.NoError(t, err)
+	assert.Nil(t, collator)
+	_, err = NewCollator("nocse")
+	assert.ErrorContains(t, err, `unknown collation "nocse"`)
+}
@@ -13,10 +13,9 @@ type Cutter struct {
 	fieldRefs  field.List
 	fieldExprs []Evaluator
 	lvals      []*Lval
-	outTypes   *super.TypeVectorTable
 	typeCache  []super.Type
 
-	builders     map[string]*recordBuilderCachedTypes
+	builders     map[string]*super.RecordBuilder
 	droppers     map[string]*Dropper
 	dropperCache []*Dropper
 	dirty        bool
@@ -30,11 +29,10 @@ func NewCutter(sctx *super.Context, fieldRefs []*Lval, fieldExprs []Evaluator) *
 	n := len(fieldRefs)
 	return &Cutter{
 		sctx:         sctx,
-		builders:     make(map[string]*recordBuilderCachedTypes),
+		builders:     make(map[string]*super.RecordBuilder),
 		fieldRefs:    make(field.List, n),
 		fieldExprs:   fieldExprs,
 		lvals:        fieldRefs,
-		outTypes:     super.NewTypeVectorTable(),
 		typeCache:    make([]super.Type, n),
 		droppers:     make(map[string]*Dropper),
 		dropperCache: make([]*Dropper, n),
@@ -76,7 +74,7 @@ func (c *Cutter) Eval(ectx Context, in super.Value) super.Value {
 	if err != nil {
 		panic(err)
 	}
-	rec := super.NewValue(rb.Type(c.outTypes.Lookup(types), types), bytes)
+	rec := super.NewValue(rb.LookupType(types), bytes)
 	for _, d := range droppers {
 		rec = d.Eval(ectx, rec)
 	}
@@ -86,7 +84,7 @@ func (c *Cutter) Eval(ectx Context, in super.Value) super.Value {
 	return rec
 }
 
-func (c *Cutter) lookupBuilder(ectx Context, in super.Value) (*recordBuilderCachedTypes, field.List, error) {
+func (c *Cutter) lookupBuilder(ectx Context, in super.Value) (*super.RecordBuilder, field.List, error) {
 	paths := c.fieldRefs[:0]
 	for _, p := range c.lvals {
 		path, err := p.Eval(ectx, in)
@@ -101,35 +99,10 @@ func (c *Cutter) lookupBuilder(ectx Context, in super.Value) (*recordBuilderCach
 	builder, ok := c.builders[paths.String()]
 	if !ok {
 		var err error
-		if builder, err = newRecordBuilderCachedTypes(c.sctx, paths); err != nil {
+		if builder, err = super.NewRecordBuilder(c.sctx, paths); err != nil {
 			return nil, nil, err
 		}
 		c.builders[paths.String()] = builder
 	}
 	return builder, paths, nil
 }
-
-type recordBuilderCachedTypes struct {
-	*super.RecordBuilder
-	recordTypes map[int]*super.TypeRecord
-}
-
-func newRecordBuilderCachedTypes(sctx *super.Context, paths field.List) (*recordBuilderCachedTypes, error) {
-	b, err := super.NewRecordBuilder(sctx, paths)
-	if err != nil {
-		return nil, err
-	}
-	return &recordBuilderCachedTypes{
-		RecordBuilder: b,
-		recordTypes:   make(map[int]*super.TypeRecord),
-	}, nil
-}
-
-func (r *recordBuilderCachedTypes) Type(id int, types []super.Type) *super.TypeRecord {
-	typ, ok := r.recordTypes[id]
-	if !ok {
-		typ = r.RecordBuilder.Type(types)
-		r.recordTypes[id] = typ
-	}
-	return typ
-}
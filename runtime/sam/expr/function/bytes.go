@@ -1,6 +1,7 @@
 package function
 
 import (
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
 
@@ -60,3 +61,22 @@ func (h *Hex) Call(_ super.Allocator, args []super.Value) super.Value {
 		return h.sctx.WrapError("base64: argument must a bytes or string type", val)
 	}
 }
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#sha256
+type SHA256 struct {
+	sctx *super.Context
+}
+
+func (s *SHA256) Call(_ super.Allocator, args []super.Value) super.Value {
+	val := args[0].Under()
+	switch val.Type().ID() {
+	case super.IDBytes, super.IDString:
+		if val.IsNull() {
+			return super.NullString
+		}
+		sum := sha256.Sum256(val.Bytes())
+		return super.NewString(hex.EncodeToString(sum[:]))
+	default:
+		return s.sctx.WrapError("sha256: argument must a bytes or string type", val)
+	}
+}
@@ -0,0 +1,113 @@
+package function
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/brimdata/super"
+)
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#shannon_entropy
+type ShannonEntropy struct {
+	sctx *super.Context
+}
+
+func (s *ShannonEntropy) Call(_ super.Allocator, args []super.Value) super.Value {
+	val := args[0].Under()
+	if !val.IsString() {
+		return s.sctx.WrapError("shannon_entropy: string arg required", val)
+	}
+	if val.IsNull() {
+		return super.NullFloat64
+	}
+	return super.NewFloat64(Entropy(super.DecodeString(val.Bytes())))
+}
+
+// Entropy returns the Shannon entropy in bits of the frequencies of the
+// characters of s.
+func Entropy(s string) float64 {
+	runes := []rune(s)
+	// Sorting the characters groups them for counting and sums their
+	// terms in a fixed order so the result is deterministic.
+	slices.Sort(runes)
+	n := float64(len(runes))
+	var h float64
+	for i := 0; i < len(runes); {
+		j := i + 1
+		for j < len(runes) && runes[j] == runes[i] {
+			j++
+		}
+		p := float64(j-i) / n
+		h -= p * math.Log2(p)
+		i = j
+	}
+	return h
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#char_ratio
+type CharRatio struct {
+	sctx *super.Context
+}
+
+func (c *CharRatio) Call(_ super.Allocator, args []super.Value) super.Value {
+	args = underAll(args)
+	val, classVal := args[0], args[1]
+	if !val.IsString() {
+		return c.sctx.WrapError("char_ratio: string arg required", val)
+	}
+	if !classVal.IsString() {
+		return c.sctx.WrapError("char_ratio: character class must be a string", classVal)
+	}
+	class := LookupCharClass(super.DecodeString(classVal.Bytes()))
+	if class == nil {
+		return c.sctx.WrapError("char_ratio: unknown character class", classVal)
+	}
+	if val.IsNull() {
+		return super.NullFloat64
+	}
+	return super.NewFloat64(RatioOf(super.DecodeString(val.Bytes()), class))
+}
+
+var charClasses = map[string]func(rune) bool{
+	"consonant": func(r rune) bool {
+		return (r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z') && !isVowel(r)
+	},
+	"digit": unicode.IsDigit,
+	"hex": func(r rune) bool {
+		return r >= '0' && r <= '9' || r >= 'a' && r <= 'f' || r >= 'A' && r <= 'F'
+	},
+	"letter": unicode.IsLetter,
+	"lower":  unicode.IsLower,
+	"punct":  unicode.IsPunct,
+	"space":  unicode.IsSpace,
+	"upper":  unicode.IsUpper,
+	"vowel":  isVowel,
+}
+
+func isVowel(r rune) bool {
+	return strings.ContainsRune("aeiouAEIOU", r)
+}
+
+// LookupCharClass returns the predicate of the character class named name
+// or nil if there is no such class.
+func LookupCharClass(name string) func(rune) bool {
+	return charClasses[name]
+}
+
+// RatioOf returns the fraction of the characters of s that are in class or
+// zero if s is empty.
+func RatioOf(s string, class func(rune) bool) float64 {
+	var n, k int
+	for _, r := range s {
+		n++
+		if class(r) {
+			k++
+		}
+	}
+	if n == 0 {
+		return 0
+	}
+	return float64(k) / float64(n)
+}
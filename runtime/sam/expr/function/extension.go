@@ -0,0 +1,127 @@
+package function
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/sam/expr/agg"
+	"github.com/brimdata/super/sup"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/vector/bitvec"
+	"github.com/brimdata/super/zcode"
+)
+
+// Func describes a vectorized function provided by an application embedding
+// the query engine.  Once registered with Register, queries call the function
+// by name like a built-in function.
+type Func struct {
+	// Name is the name by which queries call the function.  Function names
+	// are case insensitive.
+	Name string
+	// Args are the types of the function's arguments.  A nil type accepts
+	// values of any type.  Since types other than primitive types belong
+	// to a super.Context, any other argument type should be nil and checked
+	// by Kernel.
+	Args []super.Type
+	// Kernel computes the function over one vector per argument.  The
+	// vectors have the same length and each has a single type, i.e.,
+	// none is a vector.Dynamic, and that type matches the corresponding
+	// element of Args.  Null values are marked in the null bits of each
+	// vector.  The result must have the same length as the arguments.
+	Kernel func(sctx *super.Context, args ...vector.Any) vector.Any
+}
+
+var funcs = struct {
+	sync.RWMutex
+	m map[string]*Func
+}{m: make(map[string]*Func)}
+
+// Register makes f available to queries in both the vector and sequential
+// runtimes.  In the sequential runtime, f.Kernel is called once per value with
+// vectors of length one.  Register returns an error if f.Name is the name of a
+// built-in function, an aggregate function, a type, or a function that has
+// already been registered.
+func Register(f Func) error {
+	if f.Name == "" || f.Kernel == nil {
+		return errors.New("function name and kernel required")
+	}
+	name := strings.ToLower(f.Name)
+	if _, _, err := New(super.NewContext(), name, len(f.Args)); !errors.Is(err, ErrNoSuchFunction) {
+		return fmt.Errorf("function %q already exists", f.Name)
+	}
+	if _, err := agg.NewPattern(name, false, true, make([]float64, agg.NumParams(name))...); err == nil || expr.NewShaperTransform(name) != 0 || name == "map" || super.LookupPrimitive(name) != nil {
+		return fmt.Errorf("function name %q is reserved", f.Name)
+	}
+	f.Name = name
+	funcs.Lock()
+	defer funcs.Unlock()
+	if _, ok := funcs.m[name]; ok {
+		return fmt.Errorf("function %q already exists", f.Name)
+	}
+	funcs.m[name] = &f
+	return nil
+}
+
+// LookupFunc returns the registered function named name or nil if there is
+// no such function.
+func LookupFunc(name string) *Func {
+	funcs.RLock()
+	defer funcs.RUnlock()
+	return funcs.m[strings.ToLower(name)]
+}
+
+// Call checks the types of args and applies f.Kernel to them.  An argument
+// that is an error is returned as the result while an argument of the wrong
+// type results in an error.
+func (f *Func) Call(sctx *super.Context, args ...vector.Any) vector.Any {
+	vecs := make([]vector.Any, 0, len(args))
+	for k, arg := range args {
+		typ := super.TypeUnder(arg.Type())
+		if typ.Kind() == super.ErrorKind {
+			return arg
+		}
+		if f.Args[k] != nil && typ != f.Args[k] {
+			msg := fmt.Sprintf("%s: argument %d must be of type %s", f.Name, k+1, sup.FormatType(f.Args[k]))
+			return vector.NewWrappedError(sctx, msg, arg)
+		}
+		if c, ok := arg.(*vector.Const); ok && c.Value().IsNull() {
+			// The vector runtime represents a null constant with a
+			// null value rather than with null bits.
+			arg = vector.NewConst(c.Value(), c.Len(), bitvec.NewTrue(c.Len()))
+		}
+		vecs = append(vecs, arg)
+	}
+	return f.Kernel(sctx, vecs...)
+}
+
+// extFunc calls a registered function one value at a time.
+type extFunc struct {
+	sctx    *super.Context
+	fn      *Func
+	vecs    []vector.Any
+	builder zcode.Builder
+}
+
+func newExtFunc(sctx *super.Context, fn *Func) *extFunc {
+	return &extFunc{sctx: sctx, fn: fn, vecs: make([]vector.Any, len(fn.Args))}
+}
+
+func (e *extFunc) Call(_ super.Allocator, args []super.Value) super.Value {
+	for k, arg := range args {
+		e.vecs[k] = vector.NewConst(arg, 1, bitvec.Zero)
+	}
+	out := e.fn.Call(e.sctx, e.vecs...)
+	var typ super.Type
+	if d, ok := out.(*vector.Dynamic); ok {
+		typ = d.TypeOf(0)
+	} else {
+		typ = out.Type()
+	}
+	e.builder.Truncate()
+	out.Serialize(&e.builder, 0)
+	return super.NewValue(typ, e.builder.Bytes().Body())
+}
@@ -2,6 +2,7 @@ package function
 
 import (
 	"errors"
+	"slices"
 
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/pkg/anymath"
@@ -32,6 +33,9 @@ func New(sctx *super.Context, name string, narg int) (expr.Function, field.Path,
 		f = &Bucket{sctx: sctx, name: name}
 	case "ceil":
 		f = &Ceil{sctx: sctx}
+	case "char_ratio":
+		argmin, argmax = 2, 2
+		f = &CharRatio{sctx: sctx}
 	case "cidr_match":
 		argmin = 2
 		argmax = 2
@@ -39,6 +43,10 @@ func New(sctx *super.Context, name string, narg int) (expr.Function, field.Path,
 	case "coalesce":
 		argmax = -1
 		f = &Coalesce{}
+	case "collate":
+		argmin = 2
+		argmax = 2
+		f = newCollate(sctx)
 	case "compare":
 		argmin = 2
 		argmax = 3
@@ -61,6 +69,8 @@ func New(sctx *super.Context, name string, narg int) (expr.Function, field.Path,
 		f = NewFlatten(sctx)
 	case "floor":
 		f = &Floor{sctx: sctx}
+	case "geoip":
+		f = NewGeoIP(sctx)
 	case "grep":
 		argmax = 2
 		f = &Grep{sctx: sctx}
@@ -121,6 +131,11 @@ func New(sctx *super.Context, name string, narg int) (expr.Function, field.Path,
 		argmax = 0
 		argmin = 0
 		f = &Now{}
+	case "parse_json":
+		f = NewParseJSON(sctx)
+	case "parse_kv":
+		argmax = 3
+		f = &ParseKV{sctx: sctx}
 	case "parse_sup":
 		f = newParseSUP(sctx)
 	case "parse_uri":
@@ -148,6 +163,10 @@ func New(sctx *super.Context, name string, narg int) (expr.Function, field.Path,
 		f = &Round{sctx: sctx}
 	case "rune_len":
 		f = &RuneLen{sctx: sctx}
+	case "sha256":
+		f = &SHA256{sctx: sctx}
+	case "shannon_entropy":
+		f = &ShannonEntropy{sctx: sctx}
 	case "split":
 		argmin = 2
 		argmax = 2
@@ -170,7 +189,12 @@ func New(sctx *super.Context, name string, narg int) (expr.Function, field.Path,
 	case "upper":
 		f = &ToUpper{sctx: sctx}
 	default:
-		return nil, nil, ErrNoSuchFunction
+		fn := LookupFunc(name)
+		if fn == nil {
+			return nil, nil, ErrNoSuchFunction
+		}
+		argmin, argmax = len(fn.Args), len(fn.Args)
+		f = newExtFunc(sctx, fn)
 	}
 	if err := CheckArgCount(narg, argmin, argmax); err != nil {
 		return nil, nil, err
@@ -178,6 +202,32 @@ func New(sctx *super.Context, name string, narg int) (expr.Function, field.Path,
 	return f, path, nil
 }
 
+// builtins are the names of the functions created by New other than
+// registered functions.
+var builtins = []string{
+	"abs", "base64", "bucket", "ceil", "char_ratio", "cidr_match", "coalesce", "compare",
+	"date_part", "error", "every", "fields", "flatten", "floor", "geoip", "grep",
+	"grok", "has", "has_error", "hex", "is", "is_error", "join", "kind",
+	"ksuid", "len", "length", "levenshtein", "log", "lower", "max", "min",
+	"missing", "nameof", "nest_dotted", "network_of", "now", "parse_json",
+	"parse_kv", "parse_sup", "parse_uri", "position", "pow", "quiet",
+	"regexp", "regexp_replace", "replace", "round", "rune_len", "sha256",
+	"shannon_entropy", "split", "sqrt", "strftime", "trim", "typename", "typeof", "under", "unflatten",
+	"upper",
+}
+
+// Names returns the names of the functions created by New, including
+// registered functions.
+func Names() []string {
+	names := slices.Clone(builtins)
+	funcs.RLock()
+	defer funcs.RUnlock()
+	for name := range funcs.m {
+		names = append(names, name)
+	}
+	return names
+}
+
 func CheckArgCount(narg int, argmin int, argmax int) error {
 	if argmin != -1 && narg < argmin {
 		return ErrTooFewArgs
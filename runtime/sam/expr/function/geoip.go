@@ -0,0 +1,172 @@
+package function
+
+import (
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/mmdb"
+	"github.com/brimdata/super/sup"
+)
+
+// GeoIPReloadInterval is the minimum interval at which a GeoIP database is
+// checked for changes to its file, which is then reloaded.
+var GeoIPReloadInterval = time.Second
+
+var geoipDatabases atomic.Pointer[[]*geoipDatabase]
+
+// SetGeoIPDatabases sets the MaxMind DB databases, e.g., GeoLite2-City and
+// GeoLite2-ASN, in which the geoip function looks up addresses.  Each field
+// of its result is taken from the first database providing it.
+func SetGeoIPDatabases(paths []string) error {
+	var dbs []*geoipDatabase
+	for _, path := range paths {
+		db := &geoipDatabase{path: path}
+		if err := db.load(); err != nil {
+			return err
+		}
+		db.checked = time.Now()
+		dbs = append(dbs, db)
+	}
+	geoipDatabases.Store(&dbs)
+	return nil
+}
+
+type geoipDatabase struct {
+	path    string
+	mu      sync.Mutex
+	reader  *mmdb.Reader
+	modTime time.Time
+	size    int64
+	checked time.Time
+}
+
+func (g *geoipDatabase) load() error {
+	info, err := os.Stat(g.path)
+	if err != nil {
+		return err
+	}
+	if g.reader != nil && info.ModTime().Equal(g.modTime) && info.Size() == g.size {
+		return nil
+	}
+	r, err := mmdb.Open(g.path)
+	if err != nil {
+		return err
+	}
+	g.reader, g.modTime, g.size = r, info.ModTime(), info.Size()
+	return nil
+}
+
+// current returns the reader of the database after reloading its file if
+// it has changed.  If the file cannot be loaded, e.g., while it is being
+// replaced, the previous reader is returned.
+func (g *geoipDatabase) current() *mmdb.Reader {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if now := time.Now(); now.Sub(g.checked) >= GeoIPReloadInterval {
+		g.checked = now
+		g.load()
+	}
+	return g.reader
+}
+
+type geoip struct {
+	Country     *string  `super:"country"`
+	CountryName *string  `super:"country_name"`
+	City        *string  `super:"city"`
+	Latitude    *float64 `super:"latitude"`
+	Longitude   *float64 `super:"longitude"`
+	ASN         *uint32  `super:"asn"`
+	ASOrg       *string  `super:"as_org"`
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#geoip
+type GeoIP struct {
+	sctx      *super.Context
+	marshaler *sup.MarshalBSUPContext
+}
+
+func NewGeoIP(sctx *super.Context) *GeoIP {
+	return &GeoIP{sctx, sup.NewBSUPMarshalerWithContext(sctx)}
+}
+
+func (g *GeoIP) Call(_ super.Allocator, args []super.Value) super.Value {
+	val := args[0].Under()
+	if val.Type().ID() != super.IDIP {
+		return g.sctx.WrapError("geoip: ip arg required", val)
+	}
+	dbs := geoipDatabases.Load()
+	if dbs == nil || len(*dbs) == 0 {
+		return g.sctx.NewErrorf("geoip: no database configured")
+	}
+	var out geoip
+	var found bool
+	if !val.IsNull() {
+		addr := super.DecodeIP(val.Bytes())
+		for _, db := range *dbs {
+			v, _, err := db.current().Lookup(addr)
+			if err != nil {
+				return g.sctx.WrapError("geoip: "+err.Error(), val)
+			}
+			if m, ok := v.(map[string]any); ok {
+				found = true
+				fillGeoIP(&out, m)
+			}
+		}
+	}
+	var rec *geoip
+	if found {
+		rec = &out
+	}
+	v, err := g.marshaler.Marshal(rec)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// fillGeoIP sets the fields of g not yet set from the record m of a GeoIP2 or
+// GeoLite2 database.
+func fillGeoIP(g *geoip, m map[string]any) {
+	setGeoIPString(&g.Country, m, "country", "iso_code")
+	setGeoIPString(&g.CountryName, m, "country", "names", "en")
+	setGeoIPString(&g.City, m, "city", "names", "en")
+	setGeoIPString(&g.ASOrg, m, "autonomous_system_organization")
+	if g.Latitude == nil {
+		if f, ok := lookupGeoIP(m, "location", "latitude").(float64); ok {
+			g.Latitude = &f
+		}
+	}
+	if g.Longitude == nil {
+		if f, ok := lookupGeoIP(m, "location", "longitude").(float64); ok {
+			g.Longitude = &f
+		}
+	}
+	if g.ASN == nil {
+		if u, ok := lookupGeoIP(m, "autonomous_system_number").(uint64); ok {
+			asn := uint32(u)
+			g.ASN = &asn
+		}
+	}
+}
+
+func setGeoIPString(p **string, m map[string]any, path ...string) {
+	if *p == nil {
+		if s, ok := lookupGeoIP(m, path...).(string); ok {
+			*p = &s
+		}
+	}
+}
+
+func lookupGeoIP(v any, path ...string) any {
+	for _, name := range path {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+		v = m[name]
+	}
+	return v
+}
@@ -0,0 +1,41 @@
+package function
+
+import (
+	"bytes"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/sup"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeoIPReload(t *testing.T) {
+	buf, err := os.ReadFile("../../../../testdata/geoip.mmdb")
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "geoip.mmdb")
+	require.NoError(t, os.WriteFile(path, buf, 0644))
+	require.NoError(t, SetGeoIPDatabases([]string{path}))
+	defer SetGeoIPDatabases(nil)
+	defer func(d time.Duration) { GeoIPReloadInterval = d }(GeoIPReloadInterval)
+	GeoIPReloadInterval = 0
+
+	sctx := super.NewContext()
+	f := NewGeoIP(sctx)
+	country := func() string {
+		ip := super.NewIP(netip.MustParseAddr("1.2.3.4"))
+		return sup.FormatValue(*f.Call(nil, []super.Value{ip}).Ptr().Deref("country"))
+	}
+	require.Equal(t, `"US"`, country())
+
+	// A file that cannot be loaded leaves the previous database in use.
+	require.NoError(t, os.WriteFile(path, []byte("partial"), 0644))
+	require.Equal(t, `"US"`, country())
+
+	// A changed database is reloaded.
+	require.NoError(t, os.WriteFile(path, bytes.Replace(buf, []byte("US"), []byte("CA"), 1), 0644))
+	require.Equal(t, `"CA"`, country())
+}
@@ -1,13 +1,17 @@
 package function
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/sup"
+	"github.com/brimdata/super/zcode"
+	"github.com/brimdata/super/zio/jsonio"
 	"github.com/brimdata/super/zio/supio"
 )
 
@@ -119,3 +123,171 @@ func (p *ParseSUP) Call(_ super.Allocator, args []super.Value) super.Value {
 	}
 	return *val
 }
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#parse_json
+type ParseJSON struct {
+	sctx *super.Context
+	sr   *strings.Reader
+	jr   *jsonio.Reader
+}
+
+func NewParseJSON(sctx *super.Context) *ParseJSON {
+	var sr strings.Reader
+	return &ParseJSON{sctx, &sr, jsonio.NewReader(sctx, &sr)}
+}
+
+func (p *ParseJSON) Call(_ super.Allocator, args []super.Value) super.Value {
+	in := args[0].Under()
+	if !in.IsString() {
+		return p.sctx.WrapError("parse_json: string arg required", args[0])
+	}
+	if in.IsNull() {
+		return super.Null
+	}
+	val, err := p.Parse(super.DecodeString(in.Bytes()))
+	if err != nil {
+		return p.sctx.WrapError("parse_json: "+err.Error(), args[0])
+	}
+	return val
+}
+
+// Parse parses s, which must contain exactly one JSON value.
+func (p *ParseJSON) Parse(s string) (super.Value, error) {
+	p.sr.Reset(s)
+	p.jr.Reset(p.sr)
+	val, err := p.jr.Read()
+	if err != nil {
+		return super.Value{}, err
+	}
+	if val == nil {
+		return super.Value{}, errors.New("unexpected end of JSON input")
+	}
+	// Copy the value since reading again to check for trailing input
+	// reuses the reader's buffers.
+	out := val.Copy()
+	if extra, err := p.jr.Read(); err != nil || extra != nil {
+		return super.Value{}, errors.New("invalid input after top-level value")
+	}
+	return out, nil
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#parse_kv
+type ParseKV struct {
+	sctx    *super.Context
+	names   []string
+	values  []string
+	fields  []super.Field
+	builder zcode.Builder
+}
+
+func (p *ParseKV) Call(_ super.Allocator, args []super.Value) super.Value {
+	in := args[0].Under()
+	if !in.IsString() {
+		return p.sctx.WrapError("parse_kv: string arg required", args[0])
+	}
+	pairSep, kvSep := " ", "="
+	if len(args) > 1 {
+		var ok bool
+		if pairSep, ok = kvSeparator(args[1]); !ok {
+			return p.sctx.WrapError("parse_kv: separator must be a non-empty string", args[1])
+		}
+	}
+	if len(args) > 2 {
+		var ok bool
+		if kvSep, ok = kvSeparator(args[2]); !ok {
+			return p.sctx.WrapError("parse_kv: separator must be a non-empty string", args[2])
+		}
+	}
+	if in.IsNull() {
+		return super.Null
+	}
+	var err error
+	p.names, p.values, err = ParseKVPairs(super.DecodeString(in.Bytes()), pairSep, kvSep, p.names[:0], p.values[:0])
+	if err != nil {
+		return p.sctx.WrapError("parse_kv: "+err.Error(), args[0])
+	}
+	p.fields = p.fields[:0]
+	p.builder.Truncate()
+	for k, name := range p.names {
+		p.fields = append(p.fields, super.NewField(name, super.TypeString))
+		p.builder.Append(super.EncodeString(p.values[k]))
+	}
+	return super.NewValue(p.sctx.MustLookupTypeRecord(p.fields), p.builder.Bytes())
+}
+
+func kvSeparator(val super.Value) (string, bool) {
+	val = val.Under()
+	if !val.IsString() || val.IsNull() || len(val.Bytes()) == 0 {
+		return "", false
+	}
+	return super.DecodeString(val.Bytes()), true
+}
+
+// ParseKVPairs splits s into key/value pairs separated by pairSep, with
+// each key separated from its value by kvSep, and appends the keys and
+// values to names and values.  A value may be a double-quoted string, in
+// which case it may contain either separator.  When a key appears more than
+// once, its last value is used.
+func ParseKVPairs(s, pairSep, kvSep string, names, values []string) ([]string, []string, error) {
+	for {
+		for strings.HasPrefix(s, pairSep) {
+			s = s[len(pairSep):]
+		}
+		if s == "" {
+			return names, values, nil
+		}
+		i := strings.Index(s, kvSep)
+		if j := strings.Index(s, pairSep); i < 0 || j >= 0 && j < i {
+			if j >= 0 {
+				s = s[:j]
+			}
+			return nil, nil, fmt.Errorf("missing %q in %q", kvSep, s)
+		}
+		name := s[:i]
+		if name == "" {
+			return nil, nil, errors.New("empty key")
+		}
+		s = s[i+len(kvSep):]
+		var value string
+		if strings.HasPrefix(s, `"`) {
+			n := quotedLen(s)
+			if n < 0 {
+				return nil, nil, fmt.Errorf("unterminated quoted value for key %q", name)
+			}
+			var err error
+			if value, err = strconv.Unquote(s[:n]); err != nil {
+				return nil, nil, fmt.Errorf("invalid quoted value for key %q", name)
+			}
+			s = s[n:]
+			if s != "" && !strings.HasPrefix(s, pairSep) {
+				return nil, nil, fmt.Errorf("missing separator after quoted value for key %q", name)
+			}
+		} else {
+			n := strings.Index(s, pairSep)
+			if n < 0 {
+				n = len(s)
+			}
+			value, s = s[:n], s[n:]
+		}
+		if k := slices.Index(names, name); k >= 0 {
+			values[k] = value
+			continue
+		}
+		names = append(names, name)
+		values = append(values, value)
+	}
+}
+
+// quotedLen returns the length of the double-quoted string at the start
+// of s or -1 if the string is unterminated.
+func quotedLen(s string) int {
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1
+		}
+	}
+	return -1
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/agnivade/levenshtein"
 	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/sam/expr"
 	"github.com/brimdata/super/zcode"
 )
 
@@ -207,3 +208,48 @@ func (l *Levenshtein) Call(_ super.Allocator, args []super.Value) super.Value {
 	as, bs := super.DecodeString(a.Bytes()), super.DecodeString(b.Bytes())
 	return super.NewInt64(int64(levenshtein.ComputeDistance(as, bs)))
 }
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#collate
+type Collate struct {
+	sctx      *super.Context
+	collators map[string]expr.Collator
+}
+
+func newCollate(sctx *super.Context) *Collate {
+	return &Collate{sctx: sctx, collators: make(map[string]expr.Collator)}
+}
+
+func (c *Collate) Call(_ super.Allocator, args []super.Value) super.Value {
+	val, nameVal := args[0], args[1].Under()
+	if !nameVal.IsString() || nameVal.IsNull() {
+		return c.sctx.WrapError("collate: collation name must be a string", args[1])
+	}
+	collator, err := c.lookup(super.DecodeString(nameVal.Bytes()))
+	if err != nil {
+		return c.sctx.WrapError("collate: "+err.Error(), args[1])
+	}
+	val = val.Under()
+	if !val.IsString() {
+		return args[0]
+	}
+	if val.IsNull() {
+		return super.NullString
+	}
+	str := super.DecodeString(val.Bytes())
+	if collator != nil {
+		str = collator.Key(str)
+	}
+	return super.NewString(str)
+}
+
+func (c *Collate) lookup(name string) (expr.Collator, error) {
+	collator, ok := c.collators[name]
+	if !ok {
+		var err error
+		if collator, err = expr.NewCollator(name); err != nil {
+			return nil, err
+		}
+		c.collators[name] = collator
+	}
+	return collator, nil
+}
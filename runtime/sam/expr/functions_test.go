@@ -4,6 +4,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/brimdata/super"
 	"github.com/brimdata/super/runtime/sam/expr/function"
 )
 
@@ -11,6 +12,20 @@ func TestBadFunction(t *testing.T) {
 	testCompilationError(t, "notafunction()", function.ErrNoSuchFunction)
 }
 
+func TestFunctionNames(t *testing.T) {
+	for _, name := range function.Names() {
+		var err error
+		for narg := range 4 {
+			if _, _, err = function.New(super.NewContext(), name, narg); !errors.Is(err, function.ErrNoSuchFunction) {
+				break
+			}
+		}
+		if errors.Is(err, function.ErrNoSuchFunction) {
+			t.Errorf("%s: %s", name, err)
+		}
+	}
+}
+
 func TestAbs(t *testing.T) {
 	const record = "{u:50 (uint64)} (=0)"
 
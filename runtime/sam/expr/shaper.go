@@ -184,6 +184,12 @@ func newShaper(sctx *super.Context, tf ShaperTransform, in, out super.Type) (*sh
 	return &shaper{typ, step}, err
 }
 
+// ShaperType returns the type of the result of shaping a value of type in to
+// type out according to tf.
+func ShaperType(sctx *super.Context, tf ShaperTransform, in, out super.Type) (super.Type, error) {
+	return shaperType(sctx, tf, in, out)
+}
+
 func shaperType(sctx *super.Context, tf ShaperTransform, in, out super.Type) (super.Type, error) {
 	inUnder, outUnder := super.TypeUnder(in), super.TypeUnder(out)
 	if tf&Cast != 0 {
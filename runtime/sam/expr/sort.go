@@ -13,14 +13,54 @@ import (
 	"github.com/brimdata/super/zio"
 )
 
+// Missing configures how missing values compare with null.
+type Missing int
+
+const (
+	// MissingDefault sorts missing values as null but keeps them
+	// distinct from null as aggregate and join keys.
+	MissingDefault Missing = iota
+	// MissingNull treats missing values as null.
+	MissingNull
+	// MissingDistinct keeps missing values distinct from null.  They
+	// sort beside null but farther from the other values.
+	MissingDistinct
+)
+
+// Collation configures how missing values and NaN are ordered and matched
+// when values are compared.
+type Collation struct {
+	Missing Missing
+	// NaNsMax causes NaN to sort above all other numbers rather than
+	// below them.
+	NaNsMax bool
+}
+
+// Key returns val as an aggregate or join key, which is null if val is
+// missing and c treats missing as null.
+func (c Collation) Key(val super.Value) super.Value {
+	if c.Missing == MissingNull && val.IsMissing() {
+		return super.Null
+	}
+	return val
+}
+
+// QueryCollation is the Collation honored by the sort, merge, aggregate,
+// and join operators of a query.  It is set for a session, e.g., by
+// command-line flags, and does not affect the order of data in a lake.
+var QueryCollation Collation
+
 type SortExpr struct {
 	Evaluator
 	Order order.Which
 	Nulls order.Nulls
+	// Collator, if not nil, orders strings, including those within
+	// arrays and sets, in place of the default collation.
+	Collator Collator
 }
 
 func NewSortExpr(eval Evaluator, o order.Which, n order.Nulls) SortExpr {
-	return SortExpr{eval, o, n}
+	return SortExpr{Evaluator: eval, Order: o, Nulls: n}
 }
 
 // nullsMax reports whether s treats null as the maximum value.
@@ -84,7 +124,7 @@ func (c *Comparator) sortStableIndices(vals []super.Value) []uint32 {
 				ival = expr.Eval(ectx, vals[iidx])
 				jval = expr.Eval(ectx, vals[jidx])
 			}
-			if v := compareValues(ival, jval, expr.nullsMax()); v != 0 {
+			if v := c.collation.compare(ival, jval, expr.nullsMax(), expr.Collator); v != 0 {
 				return v < 0
 			}
 		}
@@ -96,39 +136,28 @@ func (c *Comparator) sortStableIndices(vals []super.Value) []uint32 {
 type CompareFn func(a, b super.Value) int
 
 func NewValueCompareFn(o order.Which, n order.Nulls) CompareFn {
-	return NewComparator(SortExpr{&This{}, o, n}).Compare
+	return NewComparator(NewSortExpr(&This{}, o, n)).Compare
 }
 
 type Comparator struct {
-	ectx  Context
-	exprs []SortExpr
+	ectx      Context
+	exprs     []SortExpr
+	collation Collation
 }
 
 // NewComparator returns a super.Value comparator for exprs.  To compare values
 // a and b, it iterates over the elements e of exprs, stopping when e(a)!=e(b).
 func NewComparator(exprs ...SortExpr) *Comparator {
-	return &Comparator{NewContext(), slices.Clone(exprs)}
+	return &Comparator{ectx: NewContext(), exprs: slices.Clone(exprs)}
 }
 
-// WithMissingAsNull returns the receiver after modifying it to treat missing
-// values as the null value in comparisons.
-func (c *Comparator) WithMissingAsNull() *Comparator {
-	for i, k := range c.exprs {
-		c.exprs[i].Evaluator = &missingAsNull{k}
-	}
+// WithCollation returns the receiver after modifying it to compare values
+// according to collation.
+func (c *Comparator) WithCollation(collation Collation) *Comparator {
+	c.collation = collation
 	return c
 }
 
-type missingAsNull struct{ Evaluator }
-
-func (m *missingAsNull) Eval(ectx Context, val super.Value) super.Value {
-	val = m.Evaluator.Eval(ectx, val)
-	if val.IsMissing() {
-		return super.Null
-	}
-	return val
-}
-
 // Compare returns an interger comparing two values according to the receiver's
 // configuration.  The result will be 0 if a==b, -1 if a < b, and +1 if a > b.
 func (c *Comparator) Compare(a, b super.Value) int {
@@ -138,36 +167,34 @@ func (c *Comparator) Compare(a, b super.Value) int {
 		if k.Order == order.Desc {
 			aval, bval = bval, aval
 		}
-		if v := compareValues(aval, bval, k.nullsMax()); v != 0 {
+		if v := c.collation.compare(aval, bval, k.nullsMax(), k.Collator); v != 0 {
 			return v
 		}
 	}
 	return 0
 }
 
-func compareValues(a, b super.Value, nullsMax bool) int {
-	// Handle nulls according to nullsMax
-	nullA := a.IsNull()
-	nullB := b.IsNull()
-	if nullA && nullB {
-		return 0
-	}
-	if nullA {
+func (c Collation) compare(a, b super.Value, nullsMax bool, collator Collator) int {
+	// Handle nulls and missing according to nullsMax.
+	if rankA, rankB := c.nullRank(a), c.nullRank(b); rankA != 0 || rankB != 0 {
 		if nullsMax {
-			return 1
-		} else {
-			return -1
-		}
-	}
-	if nullB {
-		if nullsMax {
-			return -1
-		} else {
-			return 1
+			return cmp.Compare(rankA, rankB)
 		}
+		return cmp.Compare(rankB, rankA)
 	}
 	switch aid, bid := a.Type().ID(), b.Type().ID(); {
 	case super.IsNumber(aid) && super.IsNumber(bid):
+		if c.NaNsMax {
+			if nanA, nanB := isNaN(a, aid), isNaN(b, bid); nanA || nanB {
+				switch {
+				case !nanA:
+					return -1
+				case !nanB:
+					return 1
+				}
+				return 0
+			}
+		}
 		return compareNumbers(a, b, aid, bid)
 	case aid != bid:
 		return super.CompareTypes(a.Type(), b.Type())
@@ -181,6 +208,9 @@ func compareValues(a, b super.Value, nullsMax bool) int {
 	case aid == super.IDBytes:
 		return bytes.Compare(super.DecodeBytes(a.Bytes()), super.DecodeBytes(b.Bytes()))
 	case aid == super.IDString:
+		if collator != nil {
+			return collator.Compare(super.DecodeString(a.Bytes()), super.DecodeString(b.Bytes()))
+		}
 		return cmp.Compare(super.DecodeString(a.Bytes()), super.DecodeString(b.Bytes()))
 	case aid == super.IDIP:
 		return super.DecodeIP(a.Bytes()).Compare(super.DecodeIP(b.Bytes()))
@@ -208,7 +238,7 @@ func compareValues(a, b super.Value, nullsMax bool) int {
 			}
 			aa := super.NewValue(innerType, ait.Next())
 			bb := super.NewValue(innerType, bit.Next())
-			if v := compareValues(aa, bb, nullsMax); v != 0 {
+			if v := c.compare(aa, bb, nullsMax, collator); v != 0 {
 				return v
 			}
 		}
@@ -216,6 +246,25 @@ func compareValues(a, b super.Value, nullsMax bool) int {
 	return bytes.Compare(a.Bytes(), b.Bytes())
 }
 
+// nullRank returns 0 for a value that is neither null nor missing, 1 for
+// null, and 2 for missing if c keeps missing distinct from null.
+func (c Collation) nullRank(val super.Value) int {
+	switch {
+	case val.IsNull():
+		return 1
+	case val.IsMissing():
+		if c.Missing == MissingDistinct {
+			return 2
+		}
+		return 1
+	}
+	return 0
+}
+
+func isNaN(val super.Value, id int) bool {
+	return super.IsFloat(id) && math.IsNaN(val.Float())
+}
+
 // SortStable sorts vals according to c, with equal values in their original
 // order.  SortStable allocates more memory than [SortStableReader].
 func (c *Comparator) SortStable(vals []super.Value) {
@@ -1,13 +1,17 @@
 package expr
 
 import (
+	"math"
 	"math/rand"
+	"slices"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/sup"
+	"github.com/stretchr/testify/require"
 )
 
 func BenchmarkSort(b *testing.B) {
@@ -23,7 +27,7 @@ func BenchmarkSort(b *testing.B) {
 	}
 	for _, c := range cases {
 		b.Run(sup.FormatType(c.typ), func(b *testing.B) {
-			cmp := NewComparator(SortExpr{&This{}, order.Asc, order.NullsLast})
+			cmp := NewComparator(NewSortExpr(&This{}, order.Asc, order.NullsLast))
 			vals := make([]super.Value, 1048576)
 			for b.Loop() {
 				b.StopTimer()
@@ -36,3 +40,32 @@ func BenchmarkSort(b *testing.B) {
 		})
 	}
 }
+
+func TestCollation(t *testing.T) {
+	missing := super.NewValue(super.NewContext().LookupTypeError(super.TypeString), []byte("missing"))
+	null := super.Null
+	vals := []super.Value{null, super.NewFloat64(math.NaN()), missing, super.NewFloat64(1), super.NewInt64(-1)}
+	cases := []struct {
+		collation Collation
+		expected  string
+	}{
+		{Collation{}, "NaN -1 1. null error(\"missing\")"},
+		{Collation{Missing: MissingDistinct}, "NaN -1 1. null error(\"missing\")"},
+		{Collation{NaNsMax: true}, "-1 1. NaN null error(\"missing\")"},
+	}
+	for _, c := range cases {
+		sorted := slices.Clone(vals)
+		NewComparator(NewSortExpr(&This{}, order.Asc, order.NullsLast)).WithCollation(c.collation).SortStable(sorted)
+		var out []string
+		for _, val := range sorted {
+			out = append(out, sup.FormatValue(val))
+		}
+		require.Equal(t, c.expected, strings.Join(out, " "))
+	}
+	cmp := NewComparator(NewSortExpr(&This{}, order.Asc, order.NullsFirst))
+	require.Equal(t, 0, cmp.Compare(missing, null))
+	require.Equal(t, -1, cmp.WithCollation(Collation{Missing: MissingDistinct}).Compare(missing, null))
+	require.Equal(t, missing, Collation{}.Key(missing))
+	require.Equal(t, missing, Collation{Missing: MissingDistinct}.Key(missing))
+	require.Equal(t, null, Collation{Missing: MissingNull}.Key(missing))
+}
@@ -1,24 +1,60 @@
 package aggregate
 
 import (
+	"bytes"
+	"container/heap"
 	"context"
 	"encoding/binary"
 	"errors"
+	"hash/maphash"
+	"math"
 	"slices"
 	"sync"
 
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/pkg/nano"
 	"github.com/brimdata/super/runtime"
 	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/sam/expr/agg"
 	"github.com/brimdata/super/runtime/sam/op"
+	"github.com/brimdata/super/runtime/sam/op/sort"
 	"github.com/brimdata/super/runtime/sam/op/spill"
 	"github.com/brimdata/super/zbuf"
 	"github.com/brimdata/super/zcode"
 )
 
-var DefaultLimit = 1000000
+// rowOverhead approximates the bytes of memory held by a row of the
+// aggregation table apart from its key and the state of its aggregate
+// functions, and funcOverhead approximates the bytes held by each
+// aggregate function apart from any state accounted for by agg.Sizer.
+const (
+	rowOverhead  = 96
+	funcOverhead = 32
+)
+
+// noWatermark is the watermark of an Aggregator before it has seen a time.
+const noWatermark = nano.Ts(math.MinInt64)
+
+// Concurrency is the number of goroutines among which the kernel has an Op
+// partition a grouped aggregation over unsorted input.  Values less than
+// two disable partitioning.
+var Concurrency = 1
+
+// Top limits the results of an aggregation to the first Limit values that
+// would be produced by sorting them by Exprs.
+type Top struct {
+	Limit int
+	Exprs []expr.SortExpr
+}
+
+// KeyOrder gives the order of the values of a grouping key in the results
+// of an Op that sorts its results by its grouping keys.
+type KeyOrder struct {
+	Order order.Which
+	Nulls order.Nulls
+}
 
 // Proc computes aggregations using an Aggregator.
 type Op struct {
@@ -26,6 +62,13 @@ type Op struct {
 	parent   zbuf.Puller
 	resetter expr.Resetter
 	agg      *Aggregator
+	// shards holds the Aggregators among which the input is partitioned
+	// by keyExprs.  If the input is not partitioned, shards holds only agg.
+	shards   []*Aggregator
+	keyExprs []expr.Evaluator
+	keyCache []byte
+	parts    [][]super.Value
+	seed     maphash.Seed
 	once     sync.Once
 	resultCh chan op.Result
 	doneCh   chan struct{}
@@ -39,23 +82,32 @@ type Op struct {
 type Aggregator struct {
 	ctx  context.Context
 	sctx *super.Context
-	// The keyTypes and outTypes tables map a vector of types resulting
-	// from evaluating the key and reducer expressions to a small int,
-	// such that the same vector of types maps to the same small int.
-	// The int is used in each row to track the type of the keys and used
-	// at the output to track the combined type of the keys and aggregations.
-	keyTypes       *super.TypeVectorTable
-	outTypes       *super.TypeVectorTable
-	typeCache      []super.Type
-	keyCache       []byte // Reduces memory allocations in Consume.
-	keyRefs        []expr.Evaluator
-	keyExprs       []expr.Evaluator
-	aggRefs        []expr.Evaluator
-	aggs           []*expr.Aggregator
-	builder        *super.RecordBuilder
-	recordTypes    map[int]*super.TypeRecord
-	table          map[string]*Row
-	limit          int
+	// The keyTypes table maps a vector of types resulting from evaluating
+	// the key expressions to a small int, such that the same vector of
+	// types maps to the same small int.  The int is used in each row to
+	// track the type of the keys.
+	keyTypes  *super.TypeVectorTable
+	typeCache []super.Type
+	keyCache  []byte // Reduces memory allocations in Consume.
+	// keyColumns holds the grouping keys of the values passed to
+	// ConsumeValues.
+	keyColumns keyColumns
+	// lastRow is the row of the last value consumed, whose key is
+	// lastKey, or nil if the row may no longer be in the table.
+	lastRow  *Row
+	lastKey  []byte
+	keyRefs  []expr.Evaluator
+	keyExprs []expr.Evaluator
+	aggRefs  []expr.Evaluator
+	aggs     []*expr.Aggregator
+	builder  *super.RecordBuilder
+	table    table
+	limit    int
+	// mem is the budget for the memory held by table, of which bytes
+	// are reserved.  The table is spilled when mem is exhausted.
+	mem            *runtime.Memory
+	bytes          int
+	sizers         bool
 	valueCompare   expr.CompareFn   // to compare primary group keys for early key output
 	keyCompare     expr.CompareFn   // compare the first key (used when input sorted)
 	keysComparator *expr.Comparator // compare all keys
@@ -65,25 +117,57 @@ type Aggregator struct {
 	spiller        *spill.MergeSort
 	partialsIn     bool
 	partialsOut    bool
+	// spills accumulates the statistics of the spills of the table and
+	// querySpills those of the query, if not nil.
+	spills      zbuf.SpillStats
+	querySpills *zbuf.SpillStats
+	spillOpts   zbuf.SpillOptions
+	top         *Top
+	// topRecords holds the results selected by top so far in a heap
+	// ordered by topCompare.
+	topRecords *expr.RecordSlice
+	topCompare expr.CompareFn
+	// sortOut is true if the results are sorted by keysComparator.
+	sortOut bool
+	// lateness, if nonzero, is the duration by which the time of the
+	// first key of the input may lag the latest seen.  The watermark is
+	// the latest time less lateness.  Groups whose time is earlier than
+	// the watermark are complete and later values in them are dropped.
+	lateness  nano.Duration
+	watermark nano.Ts
+	// combine is true if the table is read as partial results into
+	// combined rather than spilled when it is full.  See Combiner.
+	combine  bool
+	combined []zbuf.Batch
+	// emitExprs, if not empty, compute the output of the aggregation from
+	// its results.  See NewAggregator.
+	emitBuilder *super.RecordBuilder
+	emitExprs   []expr.Evaluator
 }
 
 type Row struct {
 	keyType  int
 	groupval super.Value // for sorting when input sorted
 	reducers valRow
+	size     int // bytes reserved for the row
 }
 
-func NewAggregator(ctx context.Context, sctx *super.Context, keyRefs, keyExprs, aggRefs []expr.Evaluator, aggs []*expr.Aggregator, builder *super.RecordBuilder, limit int, inputDir order.Direction, partialsIn, partialsOut bool) (*Aggregator, error) {
-	if limit == 0 {
-		limit = DefaultLimit
-	}
+// NewAggregator returns an Aggregator whose table of groups is spilled
+// when it grows beyond limit rows, if limit is nonzero, or when the memory
+// it holds cannot be reserved from mem.  If emitExprs is not empty, each
+// result other than a partial result is replaced upon output by the record
+// built by emitBuilder from the result's grouping keys followed by the
+// values of emitExprs evaluated over the result, so that expressions over
+// the aggregations are computed without another pass over the results.
+func NewAggregator(ctx context.Context, sctx *super.Context, mem *runtime.Memory, keyRefs, keyExprs, aggRefs []expr.Evaluator, aggs []*expr.Aggregator, builder *super.RecordBuilder, limit int, inputDir order.Direction, partialsIn, partialsOut bool, emitBuilder *super.RecordBuilder, emitExprs []expr.Evaluator) (*Aggregator, error) {
 	var keyCompare, valueCompare expr.CompareFn
 	nkeys := len(keyExprs)
 	o := order.Which(inputDir < 0)
 	if nkeys > 0 && inputDir != 0 {
 		keySortExpr := expr.NewSortExpr(keyRefs[0], o, o.NullsMax(true))
-		keyCompare = expr.NewComparator(keySortExpr).WithMissingAsNull().Compare
-		valueCompare = expr.NewValueCompareFn(o, o.NullsMax(true))
+		keyCompare = expr.NewComparator(keySortExpr).WithCollation(expr.QueryCollation).Compare
+		valueSortExpr := expr.NewSortExpr(&expr.This{}, o, o.NullsMax(true))
+		valueCompare = expr.NewComparator(valueSortExpr).WithCollation(expr.QueryCollation).Compare
 	}
 	var sortExprs []expr.SortExpr
 	for _, e := range keyRefs {
@@ -94,8 +178,9 @@ func NewAggregator(ctx context.Context, sctx *super.Context, keyRefs, keyExprs,
 		sctx:           sctx,
 		inputDir:       inputDir,
 		limit:          limit,
+		mem:            mem,
+		sizers:         hasSizers(aggs),
 		keyTypes:       super.NewTypeVectorTable(),
-		outTypes:       super.NewTypeVectorTable(),
 		keyRefs:        keyRefs,
 		keyExprs:       keyExprs,
 		aggRefs:        aggRefs,
@@ -103,17 +188,119 @@ func NewAggregator(ctx context.Context, sctx *super.Context, keyRefs, keyExprs,
 		builder:        builder,
 		typeCache:      make([]super.Type, nkeys+len(aggs)),
 		keyCache:       make(zcode.Bytes, 0, 128),
-		table:          make(map[string]*Row),
-		recordTypes:    make(map[int]*super.TypeRecord),
+		table:          newTable(nkeys),
 		keyCompare:     keyCompare,
-		keysComparator: expr.NewComparator(sortExprs...).WithMissingAsNull(),
+		keysComparator: expr.NewComparator(sortExprs...).WithCollation(expr.QueryCollation),
 		valueCompare:   valueCompare,
 		partialsIn:     partialsIn,
 		partialsOut:    partialsOut,
+		emitBuilder:    emitBuilder,
+		emitExprs:      emitExprs,
+	}, nil
+}
+
+func hasSizers(aggs []*expr.Aggregator) bool {
+	for _, f := range newValRow(aggs) {
+		if _, ok := f.(agg.Sizer); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// New returns an Op that computes the aggregations aggs grouped by keys.  If
+// emit is not empty, the Op's output holds the keys followed by the values
+// of emit, which are evaluated over the keys and aggregations of each
+// group, rather than the aggregations themselves.  If top is not nil, the Op
+// produces only the results selected by top.  If sortOut is not empty, the
+// Op produces its results sorted by keys, with sortOut[i] giving the order
+// of keys[i].
+func New(rctx *runtime.Context, parent zbuf.Puller, keys []expr.Assignment, aggNames field.List, aggs []*expr.Aggregator, emit []expr.Assignment, limit int, inputSortDir order.Direction, partialsIn, partialsOut bool, top *Top, sortOut []KeyOrder, resetter expr.Resetter) (*Op, error) {
+	return NewWithLateness(rctx, parent, keys, aggNames, aggs, emit, limit, inputSortDir, 0, partialsIn, partialsOut, top, sortOut, resetter)
+}
+
+// NewWithLateness is like New but, if lateness is nonzero, the Op does not
+// require its input to be sorted and instead treats the time of its first
+// grouping key as approximately increasing.  The groups whose time is
+// earlier than the latest time seen less lateness are produced before EOS,
+// so that the memory held by the Op is bounded on an endless input, and
+// later values in those groups are dropped.
+func NewWithLateness(rctx *runtime.Context, parent zbuf.Puller, keys []expr.Assignment, aggNames field.List, aggs []*expr.Aggregator, emit []expr.Assignment, limit int, inputSortDir order.Direction, lateness nano.Duration, partialsIn, partialsOut bool, top *Top, sortOut []KeyOrder, resetter expr.Resetter) (*Op, error) {
+	if lateness != 0 {
+		if len(keys) == 0 {
+			return nil, errors.New("internal error: aggregate lateness requires a grouping key")
+		}
+		inputSortDir = order.Up
+	}
+	agg, err := newAggregator(rctx, keys, aggNames, aggs, emit, limit, inputSortDir, partialsIn, partialsOut, top, sortOut)
+	if err != nil {
+		return nil, err
+	}
+	agg.lateness = lateness
+	return &Op{
+		rctx:     rctx,
+		parent:   parent,
+		resetter: resetter,
+		agg:      agg,
+		shards:   []*Aggregator{agg},
+		resultCh: make(chan op.Result),
+		doneCh:   make(chan struct{}),
 	}, nil
 }
 
-func New(rctx *runtime.Context, parent zbuf.Puller, keys []expr.Assignment, aggNames field.List, aggs []*expr.Aggregator, limit int, inputSortDir order.Direction, partialsIn, partialsOut bool, resetter expr.Resetter) (*Op, error) {
+// A Shard holds the grouping keys and aggregations for one of the
+// Aggregators of an Op created by NewSharded.  Since evaluators are not
+// safe for concurrent use, each Shard must be compiled separately.
+type Shard struct {
+	Keys []expr.Assignment
+	Aggs []*expr.Aggregator
+	Emit []expr.Assignment
+}
+
+// NewSharded is like New for unsorted input but partitions the input by
+// the hash of its grouping keys, as computed by keys, across an Aggregator
+// for each element of shards, and each Aggregator consumes its partition of
+// each batch in its own goroutine.  Since the partitions hold disjoint
+// groups, the Op's results are the concatenation of the Aggregators'
+// results.  If limit is nonzero, each Aggregator is limited to
+// limit/len(shards) groups before it spills.  The Aggregators share the
+// memory budget of rctx.  If top is not nil, each Aggregator produces only
+// the results selected by top from its partition.  If sortOut is not empty,
+// the Aggregators' sorted results are merged rather than concatenated.
+func NewSharded(rctx *runtime.Context, parent zbuf.Puller, keys []expr.Assignment, shards []Shard, aggNames field.List, limit int, partialsIn, partialsOut bool, top *Top, sortOut []KeyOrder, resetter expr.Resetter) (*Op, error) {
+	if limit > 0 {
+		limit = max(limit/len(shards), 1)
+	}
+	aggs := make([]*Aggregator, 0, len(shards))
+	for _, shard := range shards {
+		agg, err := newAggregator(rctx, shard.Keys, aggNames, shard.Aggs, shard.Emit, limit, 0, partialsIn, partialsOut, top, sortOut)
+		if err != nil {
+			return nil, err
+		}
+		aggs = append(aggs, agg)
+	}
+	keyExprs := make([]expr.Evaluator, 0, len(keys))
+	for _, k := range keys {
+		keyExprs = append(keyExprs, k.RHS)
+	}
+	return &Op{
+		rctx:     rctx,
+		parent:   parent,
+		resetter: resetter,
+		agg:      aggs[0],
+		shards:   aggs,
+		keyExprs: keyExprs,
+		parts:    make([][]super.Value, len(aggs)),
+		seed:     maphash.MakeSeed(),
+		resultCh: make(chan op.Result),
+		doneCh:   make(chan struct{}),
+	}, nil
+}
+
+func newAggregator(rctx *runtime.Context, keys []expr.Assignment, aggNames field.List, aggs []*expr.Aggregator, emit []expr.Assignment, limit int, inputSortDir order.Direction, partialsIn, partialsOut bool, top *Top, sortOut []KeyOrder) (*Aggregator, error) {
+	if len(sortOut) != 0 && len(sortOut) != len(keys) {
+		return nil, errors.New("internal error: aggregate output order does not match grouping keys")
+	}
 	names := make(field.List, 0, len(keys)+len(aggNames))
 	for _, e := range keys {
 		p, ok := e.LHS.Path()
@@ -137,18 +324,52 @@ func New(rctx *runtime.Context, parent zbuf.Puller, keys []expr.Assignment, aggN
 		keyRefs = append(keyRefs, expr.NewDottedExpr(rctx.Sctx, names[i]))
 		keyExprs = append(keyExprs, keys[i].RHS)
 	}
-	agg, err := NewAggregator(rctx.Context, rctx.Sctx, keyRefs, keyExprs, valRefs, aggs, builder, limit, inputSortDir, partialsIn, partialsOut)
+	var emitBuilder *super.RecordBuilder
+	var emitExprs []expr.Evaluator
+	if len(emit) != 0 {
+		emitNames := slices.Clone(names[:len(keys)])
+		for _, e := range emit {
+			p, ok := e.LHS.Path()
+			if !ok {
+				return nil, errors.New("invalid lval in aggregate output")
+			}
+			emitNames = append(emitNames, p)
+			emitExprs = append(emitExprs, e.RHS)
+		}
+		emitBuilder, err = super.NewRecordBuilder(rctx.Sctx, emitNames)
+		if err != nil {
+			return nil, err
+		}
+	}
+	a, err := NewAggregator(rctx.Context, rctx.Sctx, rctx.Memory, keyRefs, keyExprs, valRefs, aggs, builder, limit, inputSortDir, partialsIn, partialsOut, emitBuilder, emitExprs)
 	if err != nil {
 		return nil, err
 	}
-	return &Op{
-		rctx:     rctx,
-		parent:   parent,
-		resetter: resetter,
-		agg:      agg,
-		resultCh: make(chan op.Result),
-		doneCh:   make(chan struct{}),
-	}, nil
+	a.querySpills = &rctx.Spills
+	a.spillOpts = rctx.SpillOptions
+	a.top = top
+	a.watermark = noWatermark
+	if len(sortOut) != 0 {
+		// Spills are merged in the order of keysComparator so ordering
+		// it as the results are ordered keeps spilled results sorted.
+		sortExprs := make([]expr.SortExpr, 0, len(sortOut))
+		for i, o := range sortOut {
+			sortExprs = append(sortExprs, expr.NewSortExpr(keyRefs[i], o.Order, o.Nulls))
+		}
+		a.keysComparator = expr.NewComparator(sortExprs...).WithCollation(expr.QueryCollation)
+		a.sortOut = true
+	}
+	return a, nil
+}
+
+// SpillStats returns the statistics of the values spilled by o's
+// Aggregators.
+func (o *Op) SpillStats() zbuf.SpillStats {
+	var stats zbuf.SpillStats
+	for _, agg := range o.shards {
+		stats.Add(agg.SpillStats())
+	}
+	return stats
 }
 
 func (o *Op) Pull(done bool) (zbuf.Batch, error) {
@@ -173,23 +394,33 @@ func (o *Op) Pull(done bool) (zbuf.Batch, error) {
 
 func (o *Op) run() {
 	defer func() {
-		if o.agg.spiller != nil {
-			o.agg.spiller.Cleanup()
+		for _, agg := range o.shards {
+			if agg.spiller != nil {
+				agg.spiller.Cleanup()
+			}
+			agg.release(agg.bytes)
 		}
 		// Tell o.rctx.Cancel that we've finished our cleanup.
 		o.rctx.WaitGroup.Done()
 	}()
 	sendResults := func(o *Op) bool {
-		for {
-			b, err := o.agg.nextResult(true, o.batch)
-			done, ok := o.sendResult(b, err)
-			if !ok {
-				return false
-			}
-			if b == nil || done {
-				return true
+		for _, next := range o.resultSources() {
+			for {
+				b, err := next()
+				if b == nil && err == nil {
+					break
+				}
+				done, ok := o.sendResult(b, err)
+				if !ok {
+					return false
+				}
+				if b == nil || done {
+					return true
+				}
 			}
 		}
+		_, ok := o.sendResult(nil, nil)
+		return ok
 	}
 	defer func() {
 		close(o.resultCh)
@@ -206,6 +437,9 @@ func (o *Op) run() {
 			if ok := sendResults(o); !ok {
 				return
 			}
+			for _, agg := range o.shards {
+				agg.watermark = noWatermark
+			}
 			if o.batch != nil {
 				o.batch.Unref()
 				o.batch = nil
@@ -216,12 +450,9 @@ func (o *Op) run() {
 			batch.Ref()
 			o.batch = batch
 		}
-		vals := batch.Values()
-		for i := range vals {
-			if err := o.agg.Consume(batch, vals[i]); err != nil {
-				o.sendResult(nil, err)
-				return
-			}
+		if err := o.consume(batch); err != nil {
+			o.sendResult(nil, err)
+			return
 		}
 		if o.agg.inputDir == 0 {
 			batch.Unref()
@@ -252,6 +483,24 @@ func (o *Op) run() {
 	}
 }
 
+// resultSources returns functions that each return the next batch of the
+// results of o upon EOS until they return a nil batch.  The results of o
+// are the concatenation of those of the sources.
+func (o *Op) resultSources() []func() (zbuf.Batch, error) {
+	if o.agg.sortOut && len(o.shards) > 1 {
+		// The shards hold disjoint groups so their sorted results
+		// must be merged.
+		return []func() (zbuf.Batch, error){newShardMerger(o.shards, o.batch).next}
+	}
+	sources := make([]func() (zbuf.Batch, error), 0, len(o.shards))
+	for _, agg := range o.shards {
+		sources = append(sources, func() (zbuf.Batch, error) {
+			return agg.nextResult(true, o.batch)
+		})
+	}
+	return sources
+}
+
 func (o *Op) sendResult(b zbuf.Batch, err error) (bool, bool) {
 	if b == nil {
 		// Reset stateful aggregation expressions on EOS.
@@ -286,12 +535,65 @@ func (o *Op) sendResult(b zbuf.Batch, err error) (bool, bool) {
 	}
 }
 
+func (o *Op) consume(batch zbuf.Batch) error {
+	if len(o.shards) == 1 {
+		return o.agg.ConsumeValues(batch, batch.Values())
+	}
+	parts := o.partition(batch)
+	errs := make([]error, len(o.shards))
+	var wg sync.WaitGroup
+	for k, agg := range o.shards {
+		if len(parts[k]) == 0 {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[k] = agg.ConsumeValues(batch, parts[k])
+		}()
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// partition returns the values of batch partitioned among o.shards by the
+// hash of their grouping keys.  Values with a quiet key are dropped, as
+// Consume would drop them.
+func (o *Op) partition(batch zbuf.Batch) [][]super.Value {
+	for k := range o.parts {
+		o.parts[k] = o.parts[k][:0]
+	}
+	n := uint64(len(o.parts))
+	vals := batch.Values()
+next:
+	for i := range vals {
+		key := o.keyCache[:0]
+		for _, e := range o.keyExprs {
+			val := e.Eval(batch, vals[i])
+			if val.IsQuiet() {
+				continue next
+			}
+			key = zcode.Append(key, val.Bytes())
+		}
+		o.keyCache = key
+		k := maphash.Bytes(o.seed, key) % n
+		o.parts[k] = append(o.parts[k], vals[i])
+	}
+	return o.parts
+}
+
 func (o *Op) reset() {
-	if o.agg.spiller != nil {
-		o.agg.spiller.Cleanup()
-		o.agg.spiller = nil
+	for _, agg := range o.shards {
+		if agg.spiller != nil {
+			agg.spiller.Cleanup()
+			agg.spiller = nil
+		}
+		agg.release(agg.bytes)
+		agg.table = newTable(len(agg.keyExprs))
+		agg.lastRow = nil
+		agg.topRecords = nil
+		agg.watermark = noWatermark
 	}
-	o.agg.table = make(map[string]*Row)
 	if o.batch != nil {
 		o.batch.Unref()
 		o.batch = nil
@@ -301,70 +603,145 @@ func (o *Op) reset() {
 
 // Consume adds a value to an aggregation.
 func (a *Aggregator) Consume(batch zbuf.Batch, this super.Value) error {
-	// See if we've encountered this row before.
-	// We compute a key for this row by exploiting the fact that
-	// a row key is uniquely determined by the inbound descriptor
-	// (implying the types of the keys) and the keys values.
-	// We don't know the reducer types ahead of time so we can't compute
-	// the final desciptor yet, but it doesn't matter.  Note that a given
-	// input descriptor may end up with multiple output descriptors
-	// (because the reducer types are different for the same keys), but
-	// because our goal is to distingush rows for different types of keys,
-	// we can rely on just the key types (and input desciptor uniquely
-	// implying those types)
-
-	// XXX The comment above is incorrect and the cause of bug #1701.  Neither
-	// the output type of the keys nor of the values is determinined by the
-	// input record type.  This used to be the case but now that we have
-	// type-varying functions and expressions for the keys, this assumption
-	// no longer holds.
-
-	// XXX Store key flattened then let the builder construct the
-	// structure at output time, which is the new approach that will be
-	// taken by the fix to #1701.
+	return a.ConsumeValues(batch, []super.Value{this})
+}
 
-	types := a.typeCache[:0]
-	keyBytes := a.keyCache[:0]
-	var prim super.Value
-	for i, keyExpr := range a.keyExprs {
-		key := keyExpr.Eval(batch, this)
-		if key.IsQuiet() {
-			return nil
-		}
-		if i == 0 && a.inputDir != 0 {
-			prim = a.updateMaxTableKey(key)
-		}
-		types = append(types, key.Type())
-		// Append each value to the key as a flat value, independent
-		// of whether this is a primitive or container.
-		keyBytes = zcode.Append(keyBytes, key.Bytes())
-	}
-	// We conveniently put the key type code at the end of the key string,
-	// so when we recontruct the key values below, we don't have skip over it.
-	keyType := a.keyTypes.Lookup(types)
-	keyBytes = binary.AppendUvarint(keyBytes, uint64(keyType))
-	a.keyCache = keyBytes
-
-	row, ok := a.table[string(keyBytes)]
-	if !ok {
-		if len(a.table) >= a.limit {
-			if err := a.spillTable(false, batch); err != nil {
+// ConsumeValues adds values of batch to an aggregation.  The grouping keys
+// of the values are evaluated together, and a value whose grouping keys
+// are those of the previous value is added to its row without a lookup in
+// the table.
+func (a *Aggregator) ConsumeValues(batch zbuf.Batch, vals []super.Value) error {
+	// The key expressions may produce values of differing types for values
+	// of the same input type (e.g., type-varying functions or union-typed
+	// fields), so each key is stored flattened in the table and the key
+	// types of a row are recorded in keyTypes as a type vector.  The
+	// structure of the keys is reconstructed by the builder at output time
+	// from the flattened key and its type vector.
+	keys := &a.keyColumns
+	keys.eval(batch, a.keyExprs, vals)
+	prev, keyType := -1, 0
+	for j, this := range vals {
+		if keys.quiet[j] {
+			continue
+		}
+		var prim super.Value
+		if a.lateness != 0 {
+			prim = keys.value(0, j)
+			if a.isLate(prim) {
+				continue
+			}
+		} else if a.inputDir != 0 && len(keys.types) > 0 {
+			prim = a.updateMaxTableKey(keys.value(0, j))
+		}
+		if prev < 0 || !keys.sameTypes(j, prev) {
+			types := a.typeCache[:0]
+			for _, t := range keys.types {
+				types = append(types, t[j])
+			}
+			keyType = a.keyTypes.Lookup(types)
+		}
+		prev = j
+		keyBytes := a.keyCache[:0]
+		for i := range keys.types {
+			keyBytes = append(keyBytes, keys.key(i, j)...)
+		}
+		// We conveniently put the key type code at the end of the key string,
+		// so when we recontruct the key values below, we don't have skip over it.
+		keyBytes = binary.AppendUvarint(keyBytes, uint64(keyType))
+		row, ok := a.lastRow, true
+		if row == nil || !bytes.Equal(keyBytes, a.lastKey) {
+			row, ok = a.table.lookup(keyBytes)
+		}
+		if !ok {
+			if a.limit > 0 && a.table.len() >= a.limit {
+				if err := a.evictTable(batch); err != nil {
+					return err
+				}
+			}
+			row = &Row{
+				keyType:  keyType,
+				groupval: prim,
+				reducers: newValRow(a.aggs),
+			}
+			if a.lateness != 0 {
+				row.groupval = prim.Copy()
+			}
+			a.table.insert(keyBytes, row)
+		}
+		a.lastRow = row
+		a.keyCache, a.lastKey = a.lastKey, keyBytes
+		if a.partialsIn {
+			if err := row.reducers.consumeAsPartial(a.sctx, this, a.aggs, a.aggRefs, batch); err != nil {
 				return err
 			}
+		} else {
+			row.reducers.apply(a.sctx, batch, a.aggs, this)
 		}
-		row = &Row{
-			keyType:  keyType,
-			groupval: prim,
-			reducers: newValRow(a.aggs),
+		if ok && !a.sizers {
+			// Only a new row or a Sizer changes the size of the table.
+			continue
+		}
+		if err := a.reserve(row, len(keyBytes), batch); err != nil {
+			return err
 		}
-		a.table[string(keyBytes)] = row
 	}
+	return nil
+}
 
-	if a.partialsIn {
-		row.reducers.consumeAsPartial(this, a.aggRefs, batch)
-	} else {
-		row.reducers.apply(a.sctx, batch, a.aggs, this)
+// reserve reserves the memory for the growth of row since it was last
+// reserved or spills the table if the memory is not available.
+func (a *Aggregator) reserve(row *Row, keyLen int, batch zbuf.Batch) error {
+	size := rowOverhead + keyLen + funcOverhead*len(row.reducers)
+	if a.sizers {
+		for _, f := range row.reducers {
+			if s, ok := f.(agg.Sizer); ok {
+				size += s.Size()
+			}
+		}
+	}
+	delta := size - row.size
+	if delta <= 0 {
+		row.size = size
+		a.release(-delta)
+		return nil
+	}
+	if !a.mem.Reserve(int64(delta)) {
+		if a.table.len() > 1 {
+			// Evicting releases all of the table's memory,
+			// including that of row.
+			return a.evictTable(batch)
+		}
+		// A table with a single row cannot make progress by
+		// spilling so it exceeds the budget.
+		a.mem.Grow(int64(delta))
 	}
+	row.size = size
+	a.bytes += delta
+	return nil
+}
+
+// SpillStats returns the statistics of the values spilled by a.  It may be
+// called concurrently with the other methods of a.
+func (a *Aggregator) SpillStats() zbuf.SpillStats {
+	return a.spills.Copy()
+}
+
+func (a *Aggregator) release(n int) {
+	a.bytes -= n
+	a.mem.Release(int64(n))
+}
+
+// evictTable empties the full table by spilling it or, if a.combine is
+// true, by reading it into a.combined.
+func (a *Aggregator) evictTable(ref zbuf.Batch) error {
+	if !a.combine {
+		return a.spillTable(false, ref)
+	}
+	batch, err := a.readTable(true, true, ref)
+	if err != nil || batch == nil {
+		return err
+	}
+	a.combined = append(a.combined, batch)
 	return nil
 }
 
@@ -374,7 +751,7 @@ func (a *Aggregator) spillTable(eof bool, ref zbuf.Batch) error {
 		return err
 	}
 	if a.spiller == nil {
-		a.spiller, err = spill.NewMergeSort(a.keysComparator)
+		a.spiller, err = spill.NewMergeSort(a.sctx, a.spillOpts, a.keysComparator, &a.spills, a.querySpills)
 		if err != nil {
 			return err
 		}
@@ -404,29 +781,162 @@ func (a *Aggregator) updateMaxTableKey(val super.Value) super.Value {
 	return *a.maxTableKey
 }
 
+// isLate returns true if key is a time earlier than the watermark, i.e., the
+// group of key is complete.  Otherwise, it advances the watermark to key
+// less the lateness.
+func (a *Aggregator) isLate(key super.Value) bool {
+	if key.Type().ID() != super.IDTime || key.IsNull() {
+		return false
+	}
+	ts := key.AsTime()
+	if ts < a.watermark {
+		return true
+	}
+	a.watermark = max(a.watermark, ts-nano.Ts(a.lateness))
+	return false
+}
+
+// isComplete returns true if the group whose first key is val is complete
+// because the input is sorted and a later key has been seen or because val
+// is a time earlier than the watermark.
+func (a *Aggregator) isComplete(val super.Value, max *super.Value) bool {
+	if a.lateness != 0 {
+		return val.Type().ID() == super.IDTime && !val.IsNull() && val.AsTime() < a.watermark
+	}
+	return a.valueCompare(val, *max) < 0
+}
+
 func (a *Aggregator) updateMaxSpillKey(v super.Value) {
 	if a.maxSpillKey == nil || a.valueCompare(v, *a.maxSpillKey) > 0 {
 		a.maxSpillKey = v.Copy().Ptr()
 	}
 }
 
-// Results returns a batch of aggregation result records. Upon eof,
+// nextResult returns a batch of aggregation result records. Upon eof,
 // this should be called repeatedly until a nil batch is returned. If
-// the input is sorted in the primary key, Results can be called
+// the input is sorted in the primary key, nextResult can be called
 // before eof, and keys that are completed will returned.
 func (a *Aggregator) nextResult(eof bool, batch zbuf.Batch) (zbuf.Batch, error) {
+	var b zbuf.Batch
+	var err error
+	if a.top != nil && !a.partialsOut {
+		b, err = a.topResults(eof, batch)
+	} else {
+		b, err = a.results(eof, batch)
+	}
+	if b == nil || err != nil || len(a.emitExprs) == 0 || a.partialsOut {
+		return b, err
+	}
+	return a.emit(b)
+}
+
+// emit returns the output computed by a.emitExprs from the results in b.
+func (a *Aggregator) emit(b zbuf.Batch) (zbuf.Batch, error) {
+	defer b.Unref()
+	vals := b.Values()
+	out := make([]super.Value, 0, len(vals))
+	for _, val := range vals {
+		types := a.typeCache[:0]
+		a.emitBuilder.Reset()
+		for _, e := range a.keyRefs {
+			v := e.Eval(b, val)
+			types = append(types, v.Type())
+			a.emitBuilder.Append(v.Bytes())
+		}
+		for _, e := range a.emitExprs {
+			v := e.Eval(b, val)
+			types = append(types, v.Type())
+			a.emitBuilder.Append(v.Bytes())
+		}
+		a.typeCache = types
+		bytes, err := a.emitBuilder.Encode()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, super.NewValue(a.emitBuilder.LookupType(types), bytes))
+	}
+	return zbuf.NewBatch(b, out), nil
+}
+
+func (a *Aggregator) results(eof bool, batch zbuf.Batch) (zbuf.Batch, error) {
 	if a.spiller == nil {
-		return a.readTable(eof, a.partialsOut, batch)
+		b, err := a.readTable(eof, a.partialsOut, batch)
+		if b != nil && a.sortOut {
+			slices.SortFunc(b.Values(), a.keysComparator.Compare)
+		} else if b != nil && eof && a.lateness != 0 {
+			// Groups completed before EOS are produced in order of
+			// their times so produce the rest in order too.
+			slices.SortStableFunc(b.Values(), a.keyCompare)
+		}
+		return b, err
 	}
 	if eof {
 		// EOF: spill in-memory table before merging all files for output.
 		if err := a.spillTable(true, batch); err != nil {
 			return nil, err
 		}
+	} else if a.lateness != 0 {
+		// Spill the groups completed by the watermark so they are
+		// merged with their spilled partial results.
+		b, err := a.readTable(false, true, batch)
+		if err != nil {
+			return nil, err
+		}
+		if b != nil {
+			if err := a.spiller.Spill(a.ctx, b.Values()); err != nil {
+				return nil, err
+			}
+		}
 	}
 	return a.readSpills(eof, batch)
 }
 
+// topResults consumes the available results into a bounded heap of those
+// selected by a.top, so that the others are dropped as soon as they are
+// produced, and returns the heap's results upon eof.
+func (a *Aggregator) topResults(eof bool, batch zbuf.Batch) (zbuf.Batch, error) {
+	for {
+		b, err := a.results(eof, batch)
+		if err != nil {
+			return nil, err
+		}
+		if b == nil {
+			break
+		}
+		for _, val := range b.Values() {
+			a.consumeTop(val)
+		}
+		b.Unref()
+	}
+	if !eof || a.topRecords == nil {
+		return nil, nil
+	}
+	out := make([]super.Value, a.topRecords.Len())
+	for i := len(out) - 1; i >= 0; i-- {
+		out[i] = heap.Pop(a.topRecords).(super.Value)
+	}
+	a.topRecords = nil
+	return zbuf.NewArray(out), nil
+}
+
+func (a *Aggregator) consumeTop(val super.Value) {
+	if a.topRecords == nil {
+		if a.topCompare == nil {
+			comparator := sort.NewComparator(a.sctx, a.top.Exprs, val, false)
+			// package heap implements a min-heap.  Invert the
+			// comparison result to get a max-heap.
+			a.topCompare = func(x, y super.Value) int { return comparator.Compare(x, y) * -1 }
+		}
+		a.topRecords = expr.NewRecordSlice(a.topCompare)
+	}
+	if a.topRecords.Len() < a.top.Limit || a.topCompare(a.topRecords.Index(0), val) < 0 {
+		heap.Push(a.topRecords, val.Copy())
+		if a.topRecords.Len() > a.top.Limit {
+			heap.Pop(a.topRecords)
+		}
+	}
+}
+
 func (a *Aggregator) readSpills(eof bool, batch zbuf.Batch) (zbuf.Batch, error) {
 	recs := make([]super.Value, 0, op.BatchLen)
 	if !eof && a.inputDir == 0 {
@@ -441,8 +951,11 @@ func (a *Aggregator) readSpills(eof bool, batch zbuf.Batch) (zbuf.Batch, error)
 			if rec == nil {
 				break
 			}
-			keyVal := a.keyExprs[0].Eval(batch, *rec)
-			if !keyVal.IsError() && a.valueCompare(keyVal, *a.maxSpillKey) >= 0 {
+			if a.lateness != 0 {
+				if !a.isComplete(a.keyRefs[0].Eval(batch, *rec), nil) {
+					break
+				}
+			} else if keyVal := a.keyExprs[0].Eval(batch, *rec); !keyVal.IsError() && a.valueCompare(keyVal, *a.maxSpillKey) >= 0 {
 				break
 			}
 		}
@@ -484,7 +997,9 @@ func (a *Aggregator) nextResultFromSpills(ectx expr.Context) (*super.Value, erro
 		} else if a.keysComparator.Compare(*firstRec, *rec) != 0 {
 			break
 		}
-		row.consumeAsPartial(*rec, a.aggRefs, ectx)
+		if err := row.consumeAsPartial(a.sctx, *rec, a.aggs, a.aggRefs, ectx); err != nil {
+			return nil, err
+		}
 		if _, err := a.spiller.Read(); err != nil {
 			return nil, err
 		}
@@ -503,14 +1018,14 @@ func (a *Aggregator) nextResultFromSpills(ectx expr.Context) (*super.Value, erro
 	for _, f := range row {
 		var v super.Value
 		if a.partialsOut {
-			v = f.ResultAsPartial(a.sctx)
+			v = agg.EncodePartial(a.sctx, f.ResultAsPartial(a.sctx))
 		} else {
 			v = f.Result(a.sctx)
 		}
 		types = append(types, v.Type())
 		a.builder.Append(v.Bytes())
 	}
-	typ := a.lookupRecordType(types)
+	typ := a.builder.LookupType(types)
 	bytes, err := a.builder.Encode()
 	if err != nil {
 		return nil, err
@@ -522,15 +1037,18 @@ func (a *Aggregator) nextResultFromSpills(ectx expr.Context) (*super.Value, erro
 // table. If flush is true, the entire table is returned. If flush is
 // false and input is sorted only completed keys are returned.
 // If partialsOut is true, it returns partial aggregation results as
-// defined by each agg.Function.ResultAsPartial() method.
+// defined by each agg.Function.ResultAsPartial() method and versioned
+// by agg.EncodePartial().
 func (a *Aggregator) readTable(flush, partialsOut bool, batch zbuf.Batch) (zbuf.Batch, error) {
+	// The scan below may delete the last row.
+	a.lastRow = nil
 	var recs []super.Value
-	for key, row := range a.table {
+	err := a.table.scan(func(key []byte, row *Row) (bool, error) {
 		if !flush && a.valueCompare == nil {
 			panic("internal bug: tried to fetch completed tuples on non-sorted input")
 		}
-		if !flush && a.valueCompare(row.groupval, *a.maxTableKey) >= 0 {
-			continue
+		if !flush && !a.isComplete(row.groupval, a.maxTableKey) {
+			return false, nil
 		}
 		// To build the output record, we spin over the key values
 		// and append them with the buidler, then spin over the aggregations
@@ -552,39 +1070,33 @@ func (a *Aggregator) readTable(flush, partialsOut bool, batch zbuf.Batch) (zbuf.
 		for _, f := range row.reducers {
 			var v super.Value
 			if partialsOut {
-				v = f.ResultAsPartial(a.sctx)
+				v = agg.EncodePartial(a.sctx, f.ResultAsPartial(a.sctx))
 			} else {
 				v = f.Result(a.sctx)
 			}
 			types = append(types, v.Type())
 			a.builder.Append(v.Bytes())
 		}
-		typ := a.lookupRecordType(types)
+		typ := a.builder.LookupType(types)
 		zv, err := a.builder.Encode()
 		if err != nil {
-			return nil, err
+			return false, err
 		}
 		recs = append(recs, super.NewValue(typ, zv))
+		a.release(row.size)
 		// Delete entries from the table as we create records, so
 		// the freed enries can be GC'd incrementally as we shift
 		// state from the table to the records.  Otherwise, when
 		// operating near capacity, we would double the memory footprint
 		// unnecessarily by holding back the table entries from GC
 		// until this loop finished.
-		delete(a.table, key)
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 	if len(recs) == 0 {
 		return nil, nil
 	}
 	return zbuf.NewBatch(batch, recs), nil
 }
-
-func (a *Aggregator) lookupRecordType(types []super.Type) *super.TypeRecord {
-	id := a.outTypes.Lookup(types)
-	typ, ok := a.recordTypes[id]
-	if !ok {
-		typ = a.builder.Type(types)
-		a.recordTypes[id] = typ
-	}
-	return typ
-}
@@ -15,7 +15,9 @@ import (
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/pkg/field"
 	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/pkg/storage"
 	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/exec"
 	"github.com/brimdata/super/runtime/sam/op/aggregate"
 	"github.com/brimdata/super/zbuf"
 	"github.com/brimdata/super/zio"
@@ -26,9 +28,29 @@ import (
 )
 
 func TestAggregateZtestsSpill(t *testing.T) {
-	saved := aggregate.DefaultLimit
-	t.Cleanup(func() { aggregate.DefaultLimit = saved })
-	aggregate.DefaultLimit = 1
+	saved := runtime.QueryMemMaxBytes
+	t.Cleanup(func() { runtime.QueryMemMaxBytes = saved })
+	runtime.QueryMemMaxBytes = 1
+	ztest.Run(t, "../../../ztests/op/aggregate")
+}
+
+func TestAggregateZtestsConcurrent(t *testing.T) {
+	saved := aggregate.Concurrency
+	t.Cleanup(func() { aggregate.Concurrency = saved })
+	aggregate.Concurrency = 4
+	ztest.Run(t, "../../../ztests/op/aggregate")
+}
+
+func TestAggregateZtestsConcurrentSpill(t *testing.T) {
+	saved := aggregate.Concurrency
+	t.Cleanup(func() {
+		aggregate.Concurrency = saved
+		runtime.ProcessMemory.SetLimit(0)
+	})
+	aggregate.Concurrency = 4
+	// Limit the process rather than each query so that the process-wide
+	// budget is what makes the shards spill.
+	runtime.ProcessMemory.SetLimit(1)
 	ztest.Run(t, "../../../ztests/op/aggregate")
 }
 
@@ -91,11 +113,11 @@ func TestAggregateStreamingSpill(t *testing.T) {
 	//
 	savedPullerBatchValues := zbuf.PullerBatchValues
 	zbuf.PullerBatchValues = 1
-	savedDefaultLimit := aggregate.DefaultLimit
-	aggregate.DefaultLimit = 2
+	savedQueryMemMaxBytes := runtime.QueryMemMaxBytes
+	runtime.QueryMemMaxBytes = 1
 	defer func() {
 		zbuf.PullerBatchValues = savedPullerBatchValues
-		aggregate.DefaultLimit = savedDefaultLimit
+		runtime.QueryMemMaxBytes = savedQueryMemMaxBytes
 	}()
 
 	const totRecs = 200
@@ -143,6 +165,96 @@ func TestAggregateStreamingSpill(t *testing.T) {
 	require.Equal(t, res, resStreaming)
 }
 
+func TestAggregateLatenessStreaming(t *testing.T) {
+	// This test verifies that with a lateness, aggregate streams results
+	// as unsorted input arrives, both with and without spills, and drops
+	// values later than the lateness.
+	savedPullerBatchValues := zbuf.PullerBatchValues
+	zbuf.PullerBatchValues = 1
+	savedQueryMemMaxBytes := runtime.QueryMemMaxBytes
+	defer func() {
+		zbuf.PullerBatchValues = savedPullerBatchValues
+		runtime.QueryMemMaxBytes = savedQueryMemMaxBytes
+	}()
+
+	const totRecs = 200
+	var data []string
+	for i := range totRecs {
+		// Swap each pair of values so the input is not sorted.
+		ts := nano.Unix(int64(i^1)/10, 0)
+		data = append(data, fmt.Sprintf("{ts:%s,ip:1.1.1.%d}", ts, i%3))
+	}
+	// The value at time 0 is later than the lateness.
+	data = append(data, fmt.Sprintf("{ts:%s,ip:1.1.1.0}", nano.Unix(0, 0)))
+
+	run := func(query string) []string {
+		ast, err := parser.ParseQuery(query)
+		require.NoError(t, err)
+		sctx := super.NewContext()
+		zr := supio.NewReader(sctx, strings.NewReader(strings.Join(data, "\n")))
+		cr := &countReader{r: zr}
+		var outbuf bytes.Buffer
+		zw := supio.NewWriter(zio.NopCloser(&outbuf), supio.WriterOpts{})
+		checker := &testAggregateWriter{
+			writer: zw,
+			cb: func(n int) {
+				if n == 1 && strings.Contains(query, "lateness") {
+					require.Less(t, cr.n.Load(), int64(totRecs))
+				}
+			},
+		}
+		comp := compiler.NewCompiler(storage.NewLocalEngine())
+		q, err := runtime.CompileQuery(context.Background(), sctx, comp, ast, []zio.Reader{cr})
+		require.NoError(t, err)
+		defer q.Pull(true)
+		require.NoError(t, zbuf.CopyPuller(checker, q))
+		outData := strings.Split(outbuf.String(), "\n")
+		sort.Strings(outData)
+		return outData
+	}
+
+	expected := run("head 200 | count() by ts:=bucket(ts,1s), ip")
+	assert.Equal(t, expected, run("count() by ts:=bucket(ts,1s), ip with -lateness 1s"))
+	runtime.QueryMemMaxBytes = 1
+	assert.Equal(t, expected, run("count() by ts:=bucket(ts,1s), ip with -lateness 1s"))
+}
+
+func TestAggregateSpillStats(t *testing.T) {
+	saved := runtime.QueryMemMaxBytes
+	t.Cleanup(func() { runtime.QueryMemMaxBytes = saved })
+	runtime.QueryMemMaxBytes = 1
+
+	ast, err := parser.ParseQuery("count() by k | sort k")
+	require.NoError(t, err)
+	sctx := super.NewContext()
+	zr := supio.NewReader(sctx, strings.NewReader("{k:1} {k:2} {k:1} {k:3}"))
+	comp := compiler.NewCompiler(storage.NewLocalEngine())
+	query, err := runtime.CompileQuery(context.Background(), sctx, comp, ast, []zio.Reader{zr})
+	require.NoError(t, err)
+	defer query.Pull(true)
+	var outbuf bytes.Buffer
+	zw := supio.NewWriter(zio.NopCloser(&outbuf), supio.WriterOpts{})
+	require.NoError(t, zbuf.CopyPuller(zw, query))
+	assert.Equal(t, "{k:1,count:2(uint64)}\n{k:2,count:1(uint64)}\n{k:3,count:1(uint64)}\n", outbuf.String())
+
+	// The table spills when a second group is added to it, and the
+	// spills are merged once.
+	stats := query.SpillStats()
+	assert.EqualValues(t, 4, stats.RowsSpilled)
+	assert.EqualValues(t, 2, stats.SpillFiles)
+	assert.Positive(t, stats.BytesSpilled)
+	assert.EqualValues(t, 1, stats.MergePasses)
+	var found bool
+	for _, s := range query.(*exec.Query).OpStats() {
+		if s.Op == "Aggregate" {
+			require.NotNil(t, s.Spill)
+			assert.Equal(t, stats, *s.Spill)
+			found = true
+		}
+	}
+	assert.True(t, found, "aggregate not found in operator statistics")
+}
+
 func newQueryOnOrderedReader(ctx context.Context, sctx *super.Context, ast *parser.AST, reader zio.Reader, sortKey order.SortKey) (runtime.Query, error) {
 	rctx := runtime.NewContext(ctx, sctx)
 	q, err := compiler.CompileWithSortKey(rctx, ast, reader, sortKey)
@@ -0,0 +1,139 @@
+package aggregate
+
+import (
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/zbuf"
+)
+
+// CombinerMaxGroups is the maximum number of groups held by the table of a
+// Combiner.
+var CombinerMaxGroups = 16 * 1024
+
+// combinerMaxRatio is the ratio of groups output to values consumed above
+// which a Combiner considers its table to be ineffective and outputs it
+// after each batch, so that it holds no more groups than a batch has values
+// while the keys of its input are mostly distinct.
+const combinerMaxRatio = 0.8
+
+// Combiner is a pre-aggregation that reduces the data passed to a
+// downstream aggregation consuming its partial results, e.g., on the
+// other side of a merge of parallel paths.  Unlike an Op, a Combiner holds
+// a small table of groups, which it outputs as partial results whenever
+// it is full rather than spilling it.  A group may thus appear in more
+// than one of its results, which the downstream aggregation combines.
+type Combiner struct {
+	rctx     *runtime.Context
+	parent   zbuf.Puller
+	resetter expr.Resetter
+	agg      *Aggregator
+	// batch is the first batch of the input, whose variables are
+	// those of the results read from the table at EOS.
+	batch zbuf.Batch
+	// nvals is the number of values consumed since the table was last
+	// output, and perBatch is true if the table is output after each
+	// batch.
+	nvals    int
+	perBatch bool
+	eos      bool
+}
+
+// NewCombiner returns a Combiner that computes the partial results of the
+// aggregations aggs grouped by keys.
+func NewCombiner(rctx *runtime.Context, parent zbuf.Puller, keys []expr.Assignment, aggNames field.List, aggs []*expr.Aggregator, resetter expr.Resetter) (*Combiner, error) {
+	agg, err := newAggregator(rctx, keys, aggNames, aggs, nil, CombinerMaxGroups, 0, false, true, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	agg.combine = true
+	return &Combiner{
+		rctx:     rctx,
+		parent:   parent,
+		resetter: resetter,
+		agg:      agg,
+	}, nil
+}
+
+func (c *Combiner) Pull(done bool) (zbuf.Batch, error) {
+	if done {
+		c.reset()
+		return c.parent.Pull(true)
+	}
+	if c.eos {
+		c.eos = false
+		return nil, nil
+	}
+	for {
+		if len(c.agg.combined) > 0 {
+			batch := c.agg.combined[0]
+			c.agg.combined = c.agg.combined[1:]
+			return batch, nil
+		}
+		batch, err := c.parent.Pull(false)
+		if err != nil {
+			c.reset()
+			return nil, err
+		}
+		if batch == nil {
+			if c.batch == nil {
+				c.reset()
+				return nil, nil
+			}
+			out, err := c.agg.readTable(true, true, c.batch)
+			c.reset()
+			if err != nil || out == nil {
+				return nil, err
+			}
+			c.eos = true
+			return out, nil
+		}
+		if c.batch == nil {
+			batch.Ref()
+			c.batch = batch
+		}
+		vals := batch.Values()
+		err = c.agg.ConsumeValues(batch, vals)
+		if err == nil && c.perBatch {
+			err = c.agg.evictTable(batch)
+		}
+		batch.Unref()
+		if err != nil {
+			c.reset()
+			return nil, err
+		}
+		c.nvals += len(vals)
+		if len(c.agg.combined) > 0 {
+			c.adapt()
+		}
+	}
+}
+
+// adapt decides whether the table is output after each batch from the
+// reduction achieved by the table since it was last output.
+func (c *Combiner) adapt() {
+	var ngroups int
+	for _, b := range c.agg.combined {
+		ngroups += len(b.Values())
+	}
+	c.perBatch = float64(ngroups) > combinerMaxRatio*float64(c.nvals)
+	c.nvals = 0
+}
+
+func (c *Combiner) reset() {
+	agg := c.agg
+	agg.release(agg.bytes)
+	agg.table = newTable(len(agg.keyExprs))
+	agg.lastRow = nil
+	for _, b := range agg.combined {
+		b.Unref()
+	}
+	agg.combined = nil
+	if c.batch != nil {
+		c.batch.Unref()
+		c.batch = nil
+	}
+	c.nvals = 0
+	c.perBatch = false
+	c.resetter.Reset()
+}
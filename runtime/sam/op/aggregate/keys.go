@@ -0,0 +1,83 @@
+package aggregate
+
+import (
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/zbuf"
+	"github.com/brimdata/super/zcode"
+)
+
+// keyColumns holds the grouping keys of a slice of values, which are
+// evaluated a key expression at a time across the slice rather than a
+// value at a time so that each expression is applied to the values in a
+// tight loop.  The encoding of key i of value j is in bytes[i] ending at
+// ends[i][j] and its type is types[i][j].  A value with a quiet key has no
+// grouping keys.  A missing key is null if the query collation treats
+// missing as null.
+type keyColumns struct {
+	types [][]super.Type
+	bytes [][]byte
+	ends  [][]int
+	quiet []bool
+}
+
+// eval evaluates exprs for each of vals.  The keys of a value with a
+// quiet key are not evaluated past the quiet key.
+func (k *keyColumns) eval(batch zbuf.Batch, exprs []expr.Evaluator, vals []super.Value) {
+	if len(k.types) != len(exprs) {
+		k.types = make([][]super.Type, len(exprs))
+		k.bytes = make([][]byte, len(exprs))
+		k.ends = make([][]int, len(exprs))
+	}
+	if cap(k.quiet) < len(vals) {
+		k.quiet = make([]bool, len(vals))
+	}
+	k.quiet = k.quiet[:len(vals)]
+	clear(k.quiet)
+	for i, e := range exprs {
+		types, bytes, ends := k.types[i][:0], k.bytes[i][:0], k.ends[i][:0]
+		for j := range vals {
+			var typ super.Type
+			if !k.quiet[j] {
+				key := e.Eval(batch, vals[j])
+				if key.IsQuiet() {
+					k.quiet[j] = true
+				} else {
+					key = expr.QueryCollation.Key(key)
+					typ = key.Type()
+					// Append each value to the key as a flat value,
+					// independent of whether this is a primitive or
+					// container.
+					bytes = zcode.Append(bytes, key.Bytes())
+				}
+			}
+			types = append(types, typ)
+			ends = append(ends, len(bytes))
+		}
+		k.types[i], k.bytes[i], k.ends[i] = types, bytes, ends
+	}
+}
+
+// key returns the encoding of key i of value j.
+func (k *keyColumns) key(i, j int) zcode.Bytes {
+	var start int
+	if j > 0 {
+		start = k.ends[i][j-1]
+	}
+	return k.bytes[i][start:k.ends[i][j]]
+}
+
+// value returns key i of value j.
+func (k *keyColumns) value(i, j int) super.Value {
+	return super.NewValue(k.types[i][j], k.key(i, j).Body())
+}
+
+// sameTypes returns true if the keys of values j and l have the same types.
+func (k *keyColumns) sameTypes(j, l int) bool {
+	for _, types := range k.types {
+		if types[j] != types[l] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,36 @@
+package aggregate
+
+import (
+	"testing"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/sup"
+	"github.com/brimdata/super/zbuf"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyColumns(t *testing.T) {
+	sctx := super.NewContext()
+	var vals []super.Value
+	for _, s := range []string{`{a:1,b:"x"}`, `{a:error("quiet"),b:"y"}`, `{a:null,b:[1,2]}`, `{a:"s",b:error("quiet")}`} {
+		vals = append(vals, sup.MustParseValue(sctx, s))
+	}
+	exprs := []expr.Evaluator{
+		expr.NewDottedExpr(sctx, field.Path{"a"}),
+		expr.NewDottedExpr(sctx, field.Path{"b"}),
+	}
+	var k keyColumns
+	// Evaluate twice to check that the columns are reset.
+	for range 2 {
+		k.eval(zbuf.NewArray(vals), exprs, vals)
+		require.Equal(t, []bool{false, true, false, true}, k.quiet)
+		require.Equal(t, "1", sup.FormatValue(k.value(0, 0)))
+		require.Equal(t, `"x"`, sup.FormatValue(k.value(1, 0)))
+		require.Equal(t, "null", sup.FormatValue(k.value(0, 2)))
+		require.Equal(t, "[1,2]", sup.FormatValue(k.value(1, 2)))
+		require.True(t, k.sameTypes(0, 0))
+		require.False(t, k.sameTypes(0, 2))
+	}
+}
@@ -0,0 +1,106 @@
+package aggregate
+
+import (
+	"container/heap"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/sam/op"
+	"github.com/brimdata/super/zbuf"
+)
+
+// shardMerger merges the sorted results of the Aggregators of a sharded Op
+// into a single sorted sequence.  It implements heap.Interface over the
+// Aggregators that have results remaining, ordered by their next result.
+type shardMerger struct {
+	shards  []*Aggregator
+	ref     zbuf.Batch
+	compare expr.CompareFn
+	results []*shardResults
+	started bool
+}
+
+type shardResults struct {
+	agg   *Aggregator
+	batch zbuf.Batch
+	vals  []super.Value
+}
+
+func newShardMerger(shards []*Aggregator, ref zbuf.Batch) *shardMerger {
+	return &shardMerger{
+		shards:  shards,
+		ref:     ref,
+		compare: shards[0].keysComparator.Compare,
+	}
+}
+
+// next returns the next batch of merged results or nil when they are
+// exhausted.
+func (m *shardMerger) next() (zbuf.Batch, error) {
+	if !m.started {
+		m.started = true
+		for _, agg := range m.shards {
+			r := &shardResults{agg: agg}
+			ok, err := r.fill(m.ref)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				m.results = append(m.results, r)
+			}
+		}
+		heap.Init(m)
+	}
+	vals := make([]super.Value, 0, op.BatchLen)
+	for len(vals) < op.BatchLen && m.Len() > 0 {
+		r := m.results[0]
+		vals = append(vals, r.vals[0].Copy())
+		r.vals = r.vals[1:]
+		ok, err := r.fill(m.ref)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			heap.Fix(m, 0)
+		} else {
+			heap.Pop(m)
+		}
+	}
+	if len(vals) == 0 {
+		return nil, nil
+	}
+	return zbuf.NewBatch(m.ref, vals), nil
+}
+
+// fill reads the next batch of results of r.agg if those of the current
+// batch are consumed and returns false if there are no more results.
+func (r *shardResults) fill(ref zbuf.Batch) (bool, error) {
+	for len(r.vals) == 0 {
+		if r.batch != nil {
+			r.batch.Unref()
+			r.batch = nil
+		}
+		b, err := r.agg.nextResult(true, ref)
+		if b == nil || err != nil {
+			return false, err
+		}
+		r.batch, r.vals = b, b.Values()
+	}
+	return true, nil
+}
+
+func (m *shardMerger) Len() int { return len(m.results) }
+
+func (m *shardMerger) Less(i, j int) bool {
+	return m.compare(m.results[i].vals[0], m.results[j].vals[0]) < 0
+}
+
+func (m *shardMerger) Swap(i, j int) { m.results[i], m.results[j] = m.results[j], m.results[i] }
+
+func (m *shardMerger) Push(x any) { m.results = append(m.results, x.(*shardResults)) }
+
+func (m *shardMerger) Pop() any {
+	r := m.results[len(m.results)-1]
+	m.results = m.results[:len(m.results)-1]
+	return r
+}
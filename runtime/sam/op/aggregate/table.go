@@ -0,0 +1,174 @@
+package aggregate
+
+import (
+	"hash/maphash"
+)
+
+// A table maps the encoded grouping key of each row of an aggregation to
+// the row.
+type table interface {
+	lookup(key []byte) (*Row, bool)
+	// insert adds row under a copy of key, which must not be present.
+	insert(key []byte, row *Row)
+	len() int
+	// scan calls f for each key and row, deleting the entry if f returns
+	// true.  The key passed to f is valid only until f returns.
+	scan(f func(key []byte, row *Row) (bool, error)) error
+}
+
+// newTable returns a table for an aggregation with nkeys grouping keys.  With
+// keys, there may be many groups, so it returns a hashTable, which allocates
+// far less per group than a mapTable.
+func newTable(nkeys int) table {
+	if nkeys > 0 {
+		return newHashTable()
+	}
+	return mapTable{}
+}
+
+type mapTable map[string]*Row
+
+func (m mapTable) lookup(key []byte) (*Row, bool) {
+	row, ok := m[string(key)]
+	return row, ok
+}
+
+func (m mapTable) insert(key []byte, row *Row) {
+	m[string(key)] = row
+}
+
+func (m mapTable) len() int {
+	return len(m)
+}
+
+func (m mapTable) scan(f func([]byte, *Row) (bool, error)) error {
+	for key, row := range m {
+		del, err := f([]byte(key), row)
+		if err != nil {
+			return err
+		}
+		if del {
+			// Delete entries as they are scanned so the freed
+			// entries can be GC'd incrementally.
+			delete(m, key)
+		}
+	}
+	return nil
+}
+
+// hashTable is an open-addressing hash table with linear probing whose keys
+// are stored back to back in a single byte arena.  Compared to mapTable, it
+// allocates neither a string per key nor a map entry per row, which matters
+// for aggregations with many groups.
+type hashTable struct {
+	seed  maphash.Seed
+	arena []byte
+	slots []slot
+	n     int // number of live entries
+	used  int // number of live and deleted entries
+}
+
+type slot struct {
+	hash uint64
+	off  int
+	len  int
+	// row is nil for an empty slot and tombstone for a deleted one.
+	row *Row
+}
+
+// tombstone marks a deleted slot so that probes continue past it.
+var tombstone = &Row{}
+
+const minHashTableSlots = 64
+
+func newHashTable() *hashTable {
+	return &hashTable{
+		seed:  maphash.MakeSeed(),
+		slots: make([]slot, minHashTableSlots),
+	}
+}
+
+func (h *hashTable) lookup(key []byte) (*Row, bool) {
+	hash := maphash.Bytes(h.seed, key)
+	mask := len(h.slots) - 1
+	for i := int(hash) & mask; ; i = (i + 1) & mask {
+		s := &h.slots[i]
+		if s.row == nil {
+			return nil, false
+		}
+		if s.row != tombstone && s.hash == hash && string(h.arena[s.off:s.off+s.len]) == string(key) {
+			return s.row, true
+		}
+	}
+}
+
+func (h *hashTable) insert(key []byte, row *Row) {
+	// Keep the load factor, which includes deleted slots, below 3/4.
+	if 4*(h.used+1) > 3*len(h.slots) {
+		h.rehash()
+	}
+	off := len(h.arena)
+	h.arena = append(h.arena, key...)
+	h.put(slot{maphash.Bytes(h.seed, key), off, len(key), row})
+	h.n++
+	h.used++
+}
+
+func (h *hashTable) put(s slot) {
+	mask := len(h.slots) - 1
+	i := int(s.hash) & mask
+	for h.slots[i].row != nil {
+		i = (i + 1) & mask
+	}
+	h.slots[i] = s
+}
+
+// rehash rebuilds the table without its deleted entries, doubling the
+// number of slots if needed to hold the live entries, and compacts the
+// arena.
+func (h *hashTable) rehash() {
+	size := len(h.slots)
+	if 4*(h.n+1) > 3*size/2 {
+		size *= 2
+	}
+	old, oldArena := h.slots, h.arena
+	h.slots = make([]slot, size)
+	h.arena = make([]byte, 0, len(oldArena))
+	for _, s := range old {
+		if s.row == nil || s.row == tombstone {
+			continue
+		}
+		off := len(h.arena)
+		h.arena = append(h.arena, oldArena[s.off:s.off+s.len]...)
+		h.put(slot{s.hash, off, s.len, s.row})
+	}
+	h.used = h.n
+}
+
+func (h *hashTable) len() int {
+	return h.n
+}
+
+func (h *hashTable) scan(f func([]byte, *Row) (bool, error)) error {
+	for i := range h.slots {
+		s := &h.slots[i]
+		if s.row == nil || s.row == tombstone {
+			continue
+		}
+		del, err := f(h.arena[s.off:s.off+s.len], s.row)
+		if err != nil {
+			return err
+		}
+		if del {
+			// Drop the row so it can be GC'd incrementally.
+			s.row = tombstone
+			h.n--
+		}
+	}
+	if h.n == 0 {
+		// Release the arena and slots rather than holding onto memory
+		// sized for the largest table seen.
+		*h = *newHashTable()
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package aggregate
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashTable(t *testing.T) {
+	const N = 10000
+	h := newHashTable()
+	rows := make(map[string]*Row)
+	for i := range N {
+		key := []byte(strconv.Itoa(i))
+		_, ok := h.lookup(key)
+		require.False(t, ok)
+		row := &Row{keyType: i}
+		h.insert(key, row)
+		rows[string(key)] = row
+	}
+	require.Equal(t, N, h.len())
+	for key, row := range rows {
+		got, ok := h.lookup([]byte(key))
+		require.True(t, ok)
+		require.Same(t, row, got)
+	}
+
+	// Delete the even rows, then insert them again so the table must
+	// probe past and rehash away the deleted slots.
+	err := h.scan(func(key []byte, row *Row) (bool, error) {
+		require.Same(t, rows[string(key)], row)
+		return row.keyType%2 == 0, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, N/2, h.len())
+	for key, row := range rows {
+		got, ok := h.lookup([]byte(key))
+		if row.keyType%2 == 0 {
+			require.False(t, ok)
+			h.insert([]byte(key), row)
+		} else {
+			require.Same(t, row, got)
+		}
+	}
+	require.Equal(t, N, h.len())
+
+	var n int
+	err = h.scan(func(key []byte, row *Row) (bool, error) {
+		require.Same(t, rows[string(key)], row)
+		n++
+		return true, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, N, n)
+	require.Zero(t, h.len())
+	_, ok := h.lookup([]byte("0"))
+	require.False(t, ok)
+}
@@ -25,16 +25,20 @@ func (v valRow) apply(sctx *super.Context, ectx expr.Context, aggs []*expr.Aggre
 	}
 }
 
-func (v valRow) consumeAsPartial(rec super.Value, exprs []expr.Evaluator, ectx expr.Context) {
+// consumeAsPartial consumes the partial results in rec, which are decoded
+// with agg.DecodePartial so that a partial result of another version (e.g.,
+// from a worker running an older release) is not silently misinterpreted.
+func (v valRow) consumeAsPartial(sctx *super.Context, rec super.Value, aggs []*expr.Aggregator, exprs []expr.Evaluator, ectx expr.Context) error {
 	for k, r := range v {
 		val := exprs[k].Eval(ectx, rec)
 		if val.IsError() {
-			panic(fmt.Errorf("consumeAsPartial: read a Zed error: %s", sup.FormatValue(val)))
+			return fmt.Errorf("consumeAsPartial: read a Zed error: %s", sup.FormatValue(val))
 		}
-		//XXX should do soemthing with errors... they could come from
-		// a worker over the network?
-		if !val.IsError() {
-			r.ConsumeAsPartial(val)
+		partial, err := agg.DecodePartial(sctx, aggs[k].Name(), val)
+		if err != nil {
+			return err
 		}
+		r.ConsumeAsPartial(partial)
 	}
+	return nil
 }
@@ -1,4 +1,10 @@
 // A combine proc merges multiple upstream inputs into one output.
+//
+// Combine does not preserve order across its inputs (the planner uses a
+// merge proc when order matters).  Each input runs in its own goroutine
+// and may read ahead up to MaxBufferedBatches batches, so a bursty input
+// is not throttled by a slow one, and ready inputs are served round robin,
+// so a fast input cannot starve the others.
 package combine
 
 import (
@@ -11,18 +17,26 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
+// MaxBufferedBatches is the maximum number of batches buffered by each
+// input of a combine proc.
+var MaxBufferedBatches = 4
+
 type Op struct {
 	ctx      context.Context
 	once     sync.Once
 	parents  []*puller
-	queue    <-chan *puller
+	queue    <-chan struct{}
 	waitCh   <-chan struct{}
 	nblocked int
+	// next is the index in parents at which the round-robin search
+	// for a ready parent begins.
+	next int
 }
 
 func New(rctx *runtime.Context, parents []zbuf.Puller) *Op {
 	ctx := rctx.Context
-	queue := make(chan *puller, len(parents))
+	// The queue holds one token for each result buffered by a parent.
+	queue := make(chan struct{}, len(parents)*MaxBufferedBatches)
 	pullers := make([]*puller, 0, len(parents))
 	waitCh := make(chan struct{})
 	for _, parent := range parents {
@@ -46,7 +60,7 @@ func (o *Op) Pull(done bool) (zbuf.Batch, error) {
 		return nil, o.propagateDone()
 	}
 	for {
-		next, err := o.next()
+		next, err := o.nextParent()
 		if err != nil {
 			return nil, err
 		}
@@ -58,32 +72,40 @@ func (o *Op) Pull(done bool) (zbuf.Batch, error) {
 			// legs hit their EOS.
 			return nil, o.unwait()
 		}
-		select {
-		case result := <-next.resultCh:
-			if result.Err != nil {
-				return nil, result.Err
-			}
-			if result.Batch == nil {
-				o.block(next)
-				continue
-			}
-			return result.Batch, nil
-		case <-o.ctx.Done():
-			return nil, o.ctx.Err()
+		result := <-next.resultCh
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		if result.Batch == nil {
+			o.block(next)
+			continue
 		}
+		return result.Batch, nil
 	}
 }
 
-func (o *Op) next() (*puller, error) {
+// nextParent waits for a parent to buffer a result and returns the first
+// parent with a buffered result in round-robin order.  It returns nil if
+// all parents are blocked.
+func (o *Op) nextParent() (*puller, error) {
 	if o.nblocked >= len(o.parents) {
 		return nil, nil
 	}
 	select {
-	case parent := <-o.queue:
-		return parent, nil
+	case <-o.queue:
 	case <-o.ctx.Done():
 		return nil, o.ctx.Err()
 	}
+	// A parent sends its result before its token, so the token we just
+	// received guarantees some parent has a result ready.
+	for i := range o.parents {
+		k := (o.next + i) % len(o.parents)
+		if parent := o.parents[k]; !parent.blocked && len(parent.resultCh) > 0 {
+			o.next = k + 1
+			return parent, nil
+		}
+	}
+	panic("combine: token received without a buffered result")
 }
 
 func (o *Op) unwait() error {
@@ -125,10 +147,9 @@ func (o *Op) propagateDone() error {
 			select {
 			case <-o.queue:
 				// If a parent is waiting on the queue, we need to
-				// read the queue to avoid deadlock.  Since we
-				// are going to throw away the batch anyway, we can
-				// simply ignore which parent it is as we will hit all
-				// of them eventually as we loop over each unblocked parent.
+				// read the queue to avoid deadlock.  Each parent
+				// discards its buffered results when it receives
+				// its done, so we can simply ignore the token.
 				goto again
 			case parent.doneCh <- struct{}{}:
 				mu.Lock()
@@ -164,16 +185,16 @@ type puller struct {
 	resultCh chan op.Result
 	doneCh   chan struct{}
 	waitCh   chan<- struct{}
-	queue    chan<- *puller
+	queue    chan<- struct{}
 	// used only by Proc
 	blocked bool
 }
 
-func newPuller(ctx context.Context, waitCh chan<- struct{}, parent zbuf.Puller, q chan<- *puller) *puller {
+func newPuller(ctx context.Context, waitCh chan<- struct{}, parent zbuf.Puller, q chan<- struct{}) *puller {
 	return &puller{
 		Puller:   op.NewCatcher(parent),
 		ctx:      ctx,
-		resultCh: make(chan op.Result),
+		resultCh: make(chan op.Result, MaxBufferedBatches),
 		doneCh:   make(chan struct{}),
 		waitCh:   waitCh,
 		queue:    q,
@@ -183,21 +204,25 @@ func newPuller(ctx context.Context, waitCh chan<- struct{}, parent zbuf.Puller,
 func (p *puller) run() {
 	for {
 		batch, err := p.Pull(false)
-		p.queue <- p
 		select {
 		case p.resultCh <- op.Result{Batch: batch, Err: err}:
+			// The queue has room for a token for every result
+			// buffered by every puller, so this never blocks.
+			p.queue <- struct{}{}
 			if err != nil {
 				return
 			}
 			if batch == nil {
 				// We just sent an EOS, so we'll wait until
 				// all the other paths are done before pulling
-				// again.  We also are guaranteed here that the
-				// combiner has our EOS and knows we're done and
-				// will mark us blocked and not raise our doneCh.
-				p.wait()
+				// again.  The combiner may not have received our
+				// EOS yet, so it might still raise our doneCh.
+				if !p.waitOrDone() {
+					return
+				}
 			}
 		case <-p.doneCh:
+			p.discard()
 			if batch == nil {
 				// Combiner tells us we're done but we just
 				// received an EOS from upstream, so we don't want
@@ -230,6 +255,22 @@ func (p *puller) run() {
 	}
 }
 
+// discard drops the results buffered in p.resultCh.  It must be called
+// only while the combiner is propagating a done and thus not receiving
+// from p.resultCh.
+func (p *puller) discard() {
+	for {
+		select {
+		case result := <-p.resultCh:
+			if result.Batch != nil {
+				result.Batch.Unref()
+			}
+		default:
+			return
+		}
+	}
+}
+
 func (p *puller) wait() bool {
 	select {
 	case p.waitCh <- struct{}{}:
@@ -238,3 +279,17 @@ func (p *puller) wait() bool {
 		return false
 	}
 }
+
+// waitOrDone is like wait but also handles a done that collides with an
+// EOS still buffered in p.resultCh.
+func (p *puller) waitOrDone() bool {
+	select {
+	case p.waitCh <- struct{}{}:
+		return true
+	case <-p.doneCh:
+		p.discard()
+		return p.wait()
+	case <-p.ctx.Done():
+		return false
+	}
+}
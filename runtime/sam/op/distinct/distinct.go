@@ -4,51 +4,194 @@ import (
 	"encoding/binary"
 
 	"github.com/brimdata/super"
+	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/runtime"
 	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/sam/op/spill"
 	"github.com/brimdata/super/zbuf"
 )
 
+// MemMaxBytes specifies the maximum amount of memory that each distinct
+// operator uses for the keys it has seen and the values it has buffered.
+// Once its set of keys reaches this size, values whose keys are not in the
+// set are spilled and deduplicated with an external merge sort at the end
+// of the input.
+var MemMaxBytes = 128 * 1024 * 1024
+
+// keyOverhead approximates the memory used by each key in the set beyond
+// the bytes of the key.
+const keyOverhead = 48
+
 type Op struct {
+	rctx   *runtime.Context
 	parent zbuf.Puller
 	expr   expr.Evaluator
 	cache  []byte
 	block  map[string]struct{}
+	// nbytes is the size of block.  When it reaches MemMaxBytes, block is
+	// frozen and values with new keys are appended to pending, whose size
+	// is npending, and spilled when it too reaches MemMaxBytes.
+	nbytes     int
+	pending    []super.Value
+	npending   int
+	comparator *expr.Comparator
+	spiller    *spill.MergeSort
+	spills     zbuf.SpillStats
+	merger     zbuf.Puller
 }
 
-func New(parent zbuf.Puller, expr expr.Evaluator) *Op {
+func New(rctx *runtime.Context, parent zbuf.Puller, expr expr.Evaluator) *Op {
 	return &Op{
+		rctx:   rctx,
 		parent: parent,
 		expr:   expr,
 		cache:  make([]byte, 0, 1024),
 	}
 }
 
+// SpillStats returns the statistics of the values spilled by o.
+func (o *Op) SpillStats() zbuf.SpillStats {
+	return o.spills.Copy()
+}
+
 func (o *Op) Pull(done bool) (zbuf.Batch, error) {
 	if o.block == nil {
 		o.block = make(map[string]struct{})
 	}
+	if o.merger != nil {
+		return o.pullSpills(done)
+	}
 	for {
 		batch, err := o.parent.Pull(done)
-		if batch == nil || err != nil {
-			clear(o.block)
+		if err != nil {
+			o.reset()
 			return nil, err
 		}
+		if batch == nil {
+			if o.spiller == nil || done {
+				o.reset()
+				return nil, nil
+			}
+			if err := o.spill(); err != nil {
+				o.reset()
+				return nil, err
+			}
+			o.merger = zbuf.NewPuller(&reader{
+				spiller:    o.spiller,
+				expr:       o.expr,
+				comparator: o.comparator,
+				seen:       make(map[string]struct{}),
+			})
+			return o.pullSpills(false)
+		}
 		vals := batch.Values()
 		out := make([]super.Value, 0, len(vals))
-		bytes := o.cache[:0]
 		for i := range vals {
-			val := o.expr.Eval(batch, vals[i])
-			binary.LittleEndian.PutUint32(bytes[:4], uint32(val.Type().ID()))
-			bytes = append(bytes[:4], val.Bytes()...)
-			if _, ok := o.block[string(bytes)]; !ok {
-				o.block[string(bytes)] = struct{}{}
+			key := appendKey(o.cache[:0], o.expr.Eval(batch, vals[i]))
+			o.cache = key
+			if _, ok := o.block[string(key)]; ok {
+				continue
+			}
+			if o.nbytes < MemMaxBytes {
+				o.block[string(key)] = struct{}{}
+				o.nbytes += len(key) + keyOverhead
 				out = append(out, vals[i])
+				continue
+			}
+			o.pending = append(o.pending, vals[i].Copy())
+			o.npending += len(vals[i].Bytes())
+			if o.npending >= MemMaxBytes {
+				if err := o.spill(); err != nil {
+					batch.Unref()
+					o.reset()
+					return nil, err
+				}
 			}
 		}
 		if len(out) > 0 {
-			o.cache = bytes
 			return zbuf.NewBatch(batch, out), nil
 		}
 		batch.Unref()
 	}
 }
+
+// spill spills the pending values sorted by key.
+func (o *Op) spill() error {
+	if o.spiller == nil {
+		o.comparator = expr.NewComparator(expr.NewSortExpr(o.expr, order.Asc, order.NullsLast))
+		var err error
+		o.spiller, err = spill.NewMergeSort(o.rctx.Sctx, o.rctx.SpillOptions, o.comparator, &o.spills, &o.rctx.Spills)
+		if err != nil {
+			return err
+		}
+	}
+	if len(o.pending) == 0 {
+		return nil
+	}
+	err := o.spiller.Spill(o.rctx.Context, o.pending)
+	o.pending = nil
+	o.npending = 0
+	return err
+}
+
+// pullSpills returns the first value of each key of the spilled values.
+func (o *Op) pullSpills(done bool) (zbuf.Batch, error) {
+	batch, err := o.merger.Pull(done)
+	if batch == nil || err != nil {
+		o.reset()
+	}
+	return batch, err
+}
+
+func (o *Op) reset() {
+	clear(o.block)
+	o.nbytes = 0
+	o.pending = nil
+	o.npending = 0
+	if o.spiller != nil {
+		o.spiller.Cleanup()
+		o.spiller = nil
+	}
+	o.merger = nil
+}
+
+func appendKey(b []byte, val super.Value) []byte {
+	b = binary.LittleEndian.AppendUint32(b, uint32(val.Type().ID()))
+	return append(b, val.Bytes()...)
+}
+
+// reader reads the spilled values in key order and drops each value whose
+// key was already read.  Keys that differ but compare as equal (e.g., 1 and
+// 1.0) may be interleaved, so the keys are tracked until the comparison
+// changes.
+type reader struct {
+	spiller    *spill.MergeSort
+	expr       expr.Evaluator
+	comparator *expr.Comparator
+	ectx       expr.Context
+	prev       *super.Value
+	seen       map[string]struct{}
+	key        []byte
+}
+
+func (r *reader) Read() (*super.Value, error) {
+	if r.ectx == nil {
+		r.ectx = expr.NewContext()
+	}
+	for {
+		val, err := r.spiller.Read()
+		if val == nil || err != nil {
+			return nil, err
+		}
+		if r.prev == nil || r.comparator.Compare(*val, *r.prev) != 0 {
+			clear(r.seen)
+			r.prev = val.Copy().Ptr()
+		}
+		r.key = appendKey(r.key[:0], r.expr.Eval(r.ectx, *val))
+		if _, ok := r.seen[string(r.key)]; ok {
+			continue
+		}
+		r.seen[string(r.key)] = struct{}{}
+		return val, nil
+	}
+}
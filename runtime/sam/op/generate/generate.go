@@ -0,0 +1,544 @@
+// Package generate implements the "from generate(...)" source, which produces
+// synthetic values for benchmarking and testing queries.
+package generate
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
+	"github.com/brimdata/super/sup"
+	"github.com/brimdata/super/zcode"
+	"github.com/brimdata/super/zio"
+)
+
+// A Reader is a zio.Reader of synthetic values specified by a record of the
+// form
+//
+//	{count:<int>,seed:<int>,fields:{<name>:<generator>,...}}
+//
+// where seed is optional and each generator is a record naming a
+// distribution, e.g., {uniform:{min:0,max:100}}, optionally with a field
+// "nulls" giving the fraction of values that are null, a record of
+// generators for a nested record, or any other value, which is a constant.
+type Reader struct {
+	count   int64
+	n       int64
+	rand    *rand.Rand
+	fields  *recordGen
+	builder zcode.Builder
+}
+
+var _ zio.Reader = (*Reader)(nil)
+
+// Kinds are the names of the generators.
+var Kinds = []string{"choice", "exponential", "normal", "seq", "string", "uniform", "zipf"}
+
+const stringChars = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+// NewReader returns a Reader of the values specified by spec, whose types
+// are allocated in sctx.  If spec has no seed, the values differ from Reader
+// to Reader.
+func NewReader(sctx *super.Context, spec super.Value) (*Reader, error) {
+	if !super.IsRecordType(spec.Type()) || spec.IsNull() {
+		return nil, fmt.Errorf("generate: spec must be a record: %s", sup.FormatValue(spec))
+	}
+	for _, f := range super.TypeRecordOf(spec.Type()).Fields {
+		if f.Name != "count" && f.Name != "seed" && f.Name != "fields" {
+			return nil, fmt.Errorf("generate: unknown spec field %q", f.Name)
+		}
+	}
+	count := spec.Deref("count")
+	if count == nil || !super.IsInteger(count.Type().ID()) || count.IsNull() {
+		return nil, errors.New("generate: spec field \"count\" must be an integer")
+	}
+	r := &Reader{count: coerce.ToNumeric[int64](*count)}
+	if r.count < 0 {
+		return nil, fmt.Errorf("generate: count must be nonnegative: %d", r.count)
+	}
+	seed := rand.Uint64()
+	if v := spec.Deref("seed"); v != nil {
+		if !super.IsInteger(v.Type().ID()) || v.IsNull() {
+			return nil, errors.New("generate: spec field \"seed\" must be an integer")
+		}
+		seed = coerce.ToNumeric[uint64](*v)
+	}
+	r.rand = rand.New(rand.NewPCG(seed, seed))
+	fields := spec.Deref("fields")
+	if fields == nil || !super.IsRecordType(fields.Type()) || fields.IsNull() {
+		return nil, errors.New("generate: spec field \"fields\" must be a record")
+	}
+	var err error
+	if r.fields, err = newRecordGen(sctx, r.rand, *fields, ""); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *Reader) Read() (*super.Value, error) {
+	if r.n >= r.count {
+		return nil, nil
+	}
+	r.builder.Truncate()
+	r.fields.gen(r.rand, r.n, &r.builder)
+	r.n++
+	val := super.NewValue(r.fields.typ, r.builder.Bytes().Body())
+	return &val, nil
+}
+
+type generator interface {
+	Type() super.Type
+	// gen appends the value of row n to b.
+	gen(r *rand.Rand, n int64, b *zcode.Builder)
+}
+
+func newGenerator(sctx *super.Context, r *rand.Rand, val super.Value, path string) (generator, error) {
+	if !super.IsRecordType(val.Type()) || val.IsNull() {
+		return &constGen{val}, nil
+	}
+	kind, ok := generatorKind(super.TypeRecordOf(val.Type()))
+	if !ok {
+		return newRecordGen(sctx, r, val, path)
+	}
+	g, err := newKindGen(sctx, r, kind, *val.Deref(kind))
+	if err != nil {
+		return nil, fmt.Errorf("generate: field %q: %w", path, err)
+	}
+	if nulls := val.Deref("nulls"); nulls != nil {
+		frac, ok := toFloat(*nulls)
+		if !ok || frac < 0 || frac > 1 {
+			return nil, fmt.Errorf("generate: field %q: nulls must be a number from 0 to 1", path)
+		}
+		g = &nullsGen{g, frac}
+	}
+	return g, nil
+}
+
+// generatorKind returns the kind of the generator of type typ, which has a
+// field named by one of Kinds and may have a field named "nulls".  A record
+// of any other type is a record of generators.
+func generatorKind(typ *super.TypeRecord) (string, bool) {
+	var kind string
+	for _, f := range typ.Fields {
+		switch {
+		case f.Name == "nulls":
+		case kind == "" && slices.Contains(Kinds, f.Name):
+			kind = f.Name
+		default:
+			return "", false
+		}
+	}
+	return kind, kind != ""
+}
+
+func newKindGen(sctx *super.Context, r *rand.Rand, kind string, arg super.Value) (generator, error) {
+	switch kind {
+	case "choice":
+		return newChoiceGen(sctx, arg)
+	case "string":
+		length, err := argInt(arg, "length", 8)
+		if err != nil {
+			return nil, err
+		}
+		if length < 0 {
+			return nil, fmt.Errorf("string length must be nonnegative: %d", length)
+		}
+		return &stringGen{int(length)}, nil
+	}
+	if !super.IsRecordType(arg.Type()) {
+		return nil, fmt.Errorf("%s argument must be a record: %s", kind, sup.FormatValue(arg))
+	}
+	switch kind {
+	case "seq":
+		start, step := super.NewInt64(0), super.NewInt64(1)
+		if v := arg.Deref("start"); v != nil {
+			start = *v
+		}
+		if v := arg.Deref("step"); v != nil {
+			step = *v
+		}
+		typ, err := numberType(start, step)
+		if err != nil {
+			return nil, fmt.Errorf("seq: %w", err)
+		}
+		return &seqGen{typ, start, step}, nil
+	case "uniform":
+		lo, hi := arg.Deref("min"), arg.Deref("max")
+		if lo == nil || hi == nil {
+			return nil, errors.New("uniform requires min and max")
+		}
+		typ, err := numberType(*lo, *hi)
+		if err != nil {
+			return nil, fmt.Errorf("uniform: %w", err)
+		}
+		if compareNumbers(typ, *lo, *hi) > 0 {
+			return nil, errors.New("uniform: min exceeds max")
+		}
+		return &uniformGen{typ, *lo, *hi}, nil
+	case "normal":
+		mean, err := argFloat(arg, "mean", 0)
+		if err != nil {
+			return nil, err
+		}
+		stddev, err := argFloat(arg, "stddev", 1)
+		if err != nil {
+			return nil, err
+		}
+		if stddev < 0 {
+			return nil, fmt.Errorf("normal stddev must be nonnegative: %g", stddev)
+		}
+		return &normalGen{mean, stddev}, nil
+	case "exponential":
+		rate, err := argFloat(arg, "rate", 1)
+		if err != nil {
+			return nil, err
+		}
+		if rate <= 0 {
+			return nil, fmt.Errorf("exponential rate must be positive: %g", rate)
+		}
+		return &exponentialGen{rate}, nil
+	case "zipf":
+		s, err := argFloat(arg, "s", 1.1)
+		if err != nil {
+			return nil, err
+		}
+		v, err := argFloat(arg, "v", 1)
+		if err != nil {
+			return nil, err
+		}
+		imax, err := argInt(arg, "max", -1)
+		if err != nil {
+			return nil, err
+		}
+		if s <= 1 || v < 1 || imax < 0 {
+			return nil, errors.New("zipf requires s > 1, v >= 1, and max >= 0")
+		}
+		return &zipfGen{rand.NewZipf(r, s, v, uint64(imax))}, nil
+	}
+	panic(kind)
+}
+
+func argFloat(arg super.Value, name string, def float64) (float64, error) {
+	v := arg.Deref(name)
+	if v == nil {
+		return def, nil
+	}
+	f, ok := toFloat(*v)
+	if !ok {
+		return 0, fmt.Errorf("%s must be a number: %s", name, sup.FormatValue(*v))
+	}
+	return f, nil
+}
+
+func argInt(arg super.Value, name string, def int64) (int64, error) {
+	if !super.IsRecordType(arg.Type()) {
+		return 0, fmt.Errorf("argument must be a record: %s", sup.FormatValue(arg))
+	}
+	v := arg.Deref(name)
+	if v == nil {
+		return def, nil
+	}
+	if !super.IsInteger(v.Type().ID()) || v.IsNull() {
+		return 0, fmt.Errorf("%s must be an integer: %s", name, sup.FormatValue(*v))
+	}
+	return coerce.ToNumeric[int64](*v), nil
+}
+
+func toFloat(val super.Value) (float64, bool) {
+	if !super.IsNumber(val.Type().ID()) || val.IsNull() {
+		return 0, false
+	}
+	return coerce.ToNumeric[float64](val), true
+}
+
+// numberType returns the type of the values generated from a and b, which
+// is time or duration if a is, float64 if either is a float, and otherwise
+// int64.
+func numberType(a, b super.Value) (super.Type, error) {
+	if a.IsNull() || b.IsNull() {
+		return nil, errors.New("arguments cannot be null")
+	}
+	aid, bid := a.Type().ID(), b.Type().ID()
+	switch {
+	case aid == super.IDTime || aid == super.IDDuration:
+		if bid != super.IDDuration && bid != aid && !super.IsInteger(bid) {
+			return nil, fmt.Errorf("%s incompatible with %s", sup.FormatValue(a), sup.FormatValue(b))
+		}
+		return a.Type(), nil
+	case !super.IsNumber(aid) || !super.IsNumber(bid):
+		return nil, fmt.Errorf("arguments must be numbers, times, or durations: %s, %s", sup.FormatValue(a), sup.FormatValue(b))
+	case super.IsFloat(aid) || super.IsFloat(bid):
+		return super.TypeFloat64, nil
+	}
+	return super.TypeInt64, nil
+}
+
+func asInt(val super.Value) int64 {
+	switch val.Type().ID() {
+	case super.IDTime, super.IDDuration:
+		return val.Int()
+	}
+	return coerce.ToNumeric[int64](val)
+}
+
+func compareNumbers(typ super.Type, a, b super.Value) int {
+	if typ == super.TypeFloat64 {
+		x, y := coerce.ToNumeric[float64](a), coerce.ToNumeric[float64](b)
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+		return 0
+	}
+	x, y := asInt(a), asInt(b)
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+func appendNumber(b *zcode.Builder, typ super.Type, i int64, f float64) {
+	switch typ.ID() {
+	case super.IDFloat64:
+		b.Append(super.EncodeFloat64(f))
+	case super.IDTime:
+		b.Append(super.EncodeTime(nano.Ts(i)))
+	case super.IDDuration:
+		b.Append(super.EncodeDuration(nano.Duration(i)))
+	default:
+		b.Append(super.EncodeInt(i))
+	}
+}
+
+type recordGen struct {
+	typ    *super.TypeRecord
+	fields []generator
+}
+
+func newRecordGen(sctx *super.Context, r *rand.Rand, val super.Value, path string) (*recordGen, error) {
+	var fields []super.Field
+	var gens []generator
+	for i, f := range super.TypeRecordOf(val.Type()).Fields {
+		name := f.Name
+		if path != "" {
+			name = path + "." + f.Name
+		}
+		g, err := newGenerator(sctx, r, *val.DerefByColumn(i), name)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, super.NewField(f.Name, g.Type()))
+		gens = append(gens, g)
+	}
+	typ, err := sctx.LookupTypeRecord(fields)
+	if err != nil {
+		return nil, err
+	}
+	return &recordGen{typ, gens}, nil
+}
+
+func (g *recordGen) Type() super.Type { return g.typ }
+
+func (g *recordGen) gen(r *rand.Rand, n int64, b *zcode.Builder) {
+	b.BeginContainer()
+	for _, f := range g.fields {
+		f.gen(r, n, b)
+	}
+	b.EndContainer()
+}
+
+type constGen struct {
+	val super.Value
+}
+
+func (g *constGen) Type() super.Type { return g.val.Type() }
+
+func (g *constGen) gen(_ *rand.Rand, _ int64, b *zcode.Builder) {
+	b.Append(g.val.Bytes())
+}
+
+type nullsGen struct {
+	generator
+	frac float64
+}
+
+func (g *nullsGen) gen(r *rand.Rand, n int64, b *zcode.Builder) {
+	// Draw from r for every row so that the values of a field do not
+	// depend on the nulls of another.
+	if r.Float64() < g.frac {
+		b.Append(nil)
+		return
+	}
+	g.generator.gen(r, n, b)
+}
+
+type seqGen struct {
+	typ         super.Type
+	start, step super.Value
+}
+
+func (g *seqGen) Type() super.Type { return g.typ }
+
+func (g *seqGen) gen(_ *rand.Rand, n int64, b *zcode.Builder) {
+	if g.typ == super.TypeFloat64 {
+		f := coerce.ToNumeric[float64](g.start) + float64(n)*coerce.ToNumeric[float64](g.step)
+		appendNumber(b, g.typ, 0, f)
+		return
+	}
+	appendNumber(b, g.typ, asInt(g.start)+n*asInt(g.step), 0)
+}
+
+type uniformGen struct {
+	typ    super.Type
+	lo, hi super.Value
+}
+
+func (g *uniformGen) Type() super.Type { return g.typ }
+
+func (g *uniformGen) gen(r *rand.Rand, _ int64, b *zcode.Builder) {
+	if g.typ == super.TypeFloat64 {
+		lo, hi := coerce.ToNumeric[float64](g.lo), coerce.ToNumeric[float64](g.hi)
+		appendNumber(b, g.typ, 0, lo+r.Float64()*(hi-lo))
+		return
+	}
+	lo, hi := asInt(g.lo), asInt(g.hi)
+	offset := r.Uint64()
+	if n := uint64(hi-lo) + 1; n != 0 {
+		offset = r.Uint64N(n)
+	}
+	appendNumber(b, g.typ, lo+int64(offset), 0)
+}
+
+type normalGen struct {
+	mean, stddev float64
+}
+
+func (*normalGen) Type() super.Type { return super.TypeFloat64 }
+
+func (g *normalGen) gen(r *rand.Rand, _ int64, b *zcode.Builder) {
+	b.Append(super.EncodeFloat64(g.mean + r.NormFloat64()*g.stddev))
+}
+
+type exponentialGen struct {
+	rate float64
+}
+
+func (*exponentialGen) Type() super.Type { return super.TypeFloat64 }
+
+func (g *exponentialGen) gen(r *rand.Rand, _ int64, b *zcode.Builder) {
+	b.Append(super.EncodeFloat64(r.ExpFloat64() / g.rate))
+}
+
+type zipfGen struct {
+	zipf *rand.Zipf
+}
+
+func (*zipfGen) Type() super.Type { return super.TypeInt64 }
+
+func (g *zipfGen) gen(_ *rand.Rand, _ int64, b *zcode.Builder) {
+	b.Append(super.EncodeInt(int64(g.zipf.Uint64())))
+}
+
+type choiceGen struct {
+	typ   super.Type
+	union *super.TypeUnion
+	vals  []super.Value
+	// cumulative holds the cumulative weights of vals if they are weighted.
+	cumulative []float64
+}
+
+func newChoiceGen(sctx *super.Context, arg super.Value) (*choiceGen, error) {
+	valsArg, weightsArg := arg, super.Null
+	if super.IsRecordType(arg.Type()) {
+		v := arg.Deref("values")
+		if v == nil {
+			return nil, errors.New("choice requires values")
+		}
+		valsArg = *v
+		if w := arg.Deref("weights"); w != nil {
+			weightsArg = *w
+		}
+	}
+	vals, err := valsArg.Elements()
+	if err != nil || len(vals) == 0 {
+		return nil, fmt.Errorf("choice values must be a nonempty array or set: %s", sup.FormatValue(valsArg))
+	}
+	g := &choiceGen{}
+	var types []super.Type
+	for _, v := range vals {
+		v = v.Under()
+		g.vals = append(g.vals, v)
+		if !slices.Contains(types, v.Type()) {
+			types = append(types, v.Type())
+		}
+	}
+	g.typ = types[0]
+	if len(types) > 1 {
+		g.union = sctx.LookupTypeUnion(types)
+		g.typ = g.union
+	}
+	if !weightsArg.IsNull() {
+		weights, err := weightsArg.Elements()
+		if err != nil || len(weights) != len(vals) {
+			return nil, errors.New("choice weights must be an array with a weight for each value")
+		}
+		var total float64
+		for _, w := range weights {
+			f, ok := toFloat(w)
+			if !ok || f < 0 {
+				return nil, fmt.Errorf("choice weight must be a nonnegative number: %s", sup.FormatValue(w))
+			}
+			total += f
+			g.cumulative = append(g.cumulative, total)
+		}
+		if total == 0 {
+			return nil, errors.New("choice weights cannot all be zero")
+		}
+	}
+	return g, nil
+}
+
+func (g *choiceGen) Type() super.Type { return g.typ }
+
+func (g *choiceGen) gen(r *rand.Rand, _ int64, b *zcode.Builder) {
+	var i int
+	if g.cumulative != nil {
+		x := r.Float64() * g.cumulative[len(g.cumulative)-1]
+		i, _ = slices.BinarySearch(g.cumulative, x)
+		// Skip values of zero weight.
+		for g.cumulative[i] <= x && i < len(g.cumulative)-1 {
+			i++
+		}
+	} else {
+		i = r.IntN(len(g.vals))
+	}
+	val := g.vals[i]
+	if g.union != nil {
+		super.BuildUnion(b, g.union.TagOf(val.Type()), val.Bytes())
+		return
+	}
+	b.Append(val.Bytes())
+}
+
+type stringGen struct {
+	length int
+}
+
+func (*stringGen) Type() super.Type { return super.TypeString }
+
+func (g *stringGen) gen(r *rand.Rand, _ int64, b *zcode.Builder) {
+	s := make([]byte, g.length)
+	for i := range s {
+		s[i] = stringChars[r.IntN(len(stringChars))]
+	}
+	b.Append(s)
+}
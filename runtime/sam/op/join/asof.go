@@ -0,0 +1,271 @@
+package join
+
+import (
+	"sort"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
+	"github.com/brimdata/super/zbuf"
+)
+
+// AsOfOp is a join that matches each left value with the most recent right
+// value, i.e., the last one in input order with the greatest key not greater
+// than the left key and, if there is a tolerance, no further below it than
+// the tolerance.  Unmatched left values are output as is, and the output
+// follows the order of the left input.
+//
+// Both inputs are pulled concurrently, so that a join of the branches of a
+// fork does not deadlock.  The right input is held in memory and sorted by
+// key while the left input is buffered, spilling past MemMaxBytes, until the
+// right input reaches EOS.
+//
+// As for the merge join, a done from downstream, an error, or the end of the
+// left input ends the platoon, passing a done to each input that has not
+// reached its EOS and releasing the batches and buffers the join holds.
+type AsOfOp struct {
+	rctx *runtime.Context
+	inputs
+	getLeftKey  expr.Evaluator
+	getRightKey expr.Evaluator
+	tolerance   *super.Value
+	resetter    expr.Resetter
+	compare     expr.CompareFn
+	cutter      *expr.Cutter
+	splicer     *RecordSplicer
+
+	built bool
+	// keys and vals hold the right values sorted by key.
+	keys []super.Value
+	vals []super.Value
+	// partial holds the left values pulled before the right input
+	// reached EOS.
+	partial       *spillBuffer
+	partialPuller zbuf.Puller
+	partialEOS    bool
+	spills        zbuf.SpillStats
+}
+
+func NewAsOf(rctx *runtime.Context, left, right zbuf.Puller, leftKey, rightKey expr.Evaluator, tolerance *super.Value, lhs []*expr.Lval, rhs []expr.Evaluator, resetter expr.Resetter) *AsOfOp {
+	o := &AsOfOp{
+		rctx:        rctx,
+		getLeftKey:  leftKey,
+		getRightKey: rightKey,
+		tolerance:   tolerance,
+		resetter:    resetter,
+		compare:     expr.NewComparator(expr.NewSortExpr(&expr.This{}, order.Asc, order.NullsLast)).WithCollation(expr.QueryCollation).Compare,
+		cutter:      expr.NewCutter(rctx.Sctx, lhs, rhs),
+		splicer:     NewRecordSplicer(rctx.Sctx),
+	}
+	o.inputs = newInputs(rctx.Context, left, right, o.reset)
+	return o
+}
+
+// SpillStats returns the statistics of the values spilled by o.
+func (o *AsOfOp) SpillStats() zbuf.SpillStats {
+	return o.spills.Copy()
+}
+
+func (o *AsOfOp) Pull(done bool) (zbuf.Batch, error) {
+	if done {
+		return nil, o.done()
+	}
+	o.start()
+	if !o.built {
+		if err := o.build(); err != nil {
+			return nil, o.fail(err)
+		}
+		o.built = true
+	}
+	batch, err := o.probe()
+	if err != nil {
+		return nil, o.fail(err)
+	}
+	if batch == nil {
+		return nil, o.done()
+	}
+	return batch, nil
+}
+
+// build pulls the right input until EOS while buffering the left input and
+// then sorts the right values by key.
+func (o *AsOfOp) build() error {
+	o.partial = newSpillBuffer(o.rctx, &o.spills)
+	leftCh := o.left.ch
+	ectx := expr.NewContext()
+	var vals []super.Value
+	for {
+		var batch zbuf.Batch
+		var err error
+		select {
+		case res := <-leftCh:
+			if batch, err = o.left.result(res); err != nil {
+				return err
+			}
+			if batch == nil {
+				// Stop pulling the left input, which is now
+				// entirely in o.partial.
+				leftCh = nil
+				continue
+			}
+			for _, val := range batch.Values() {
+				if err := o.partial.write(val); err != nil {
+					batch.Unref()
+					return err
+				}
+			}
+			batch.Unref()
+			continue
+		case res := <-o.right.ch:
+			batch, err = o.right.result(res)
+		case <-o.rctx.Context.Done():
+			return o.rctx.Context.Err()
+		}
+		if err != nil {
+			return err
+		}
+		if batch == nil {
+			break
+		}
+		for _, val := range batch.Values() {
+			key := o.getRightKey.Eval(ectx, val)
+			if key.IsMissing() || key.IsNull() {
+				continue
+			}
+			vals = append(vals, val.Copy())
+			o.keys = append(o.keys, key.Copy())
+		}
+		batch.Unref()
+	}
+	// Sort stably so the last of the values with equal keys is the most
+	// recent one.
+	indices := make([]int, len(vals))
+	for i := range indices {
+		indices[i] = i
+	}
+	sort.SliceStable(indices, func(i, j int) bool {
+		return o.compare(o.keys[indices[i]], o.keys[indices[j]]) < 0
+	})
+	keys := make([]super.Value, len(vals))
+	o.vals = make([]super.Value, len(vals))
+	for i, k := range indices {
+		keys[i], o.vals[i] = o.keys[k], vals[k]
+	}
+	o.keys = keys
+	return nil
+}
+
+// next returns the next batch of the left input.
+func (o *AsOfOp) next() (zbuf.Batch, error) {
+	if o.partialPuller == nil && !o.partialEOS {
+		r, err := o.partial.reader()
+		if err != nil {
+			return nil, err
+		}
+		o.partialPuller = zbuf.NewPuller(r)
+	}
+	if o.partialPuller != nil {
+		batch, err := o.partialPuller.Pull(false)
+		if batch != nil || err != nil {
+			return batch, err
+		}
+		o.partialPuller, o.partialEOS = nil, true
+		o.partial.close()
+	}
+	// After EOS, the closed channel of o.left yields EOS again.
+	return o.left.Pull(false)
+}
+
+func (o *AsOfOp) probe() (zbuf.Batch, error) {
+	// See #3366
+	ectx := expr.NewContext()
+	for {
+		batch, err := o.next()
+		if batch == nil || err != nil {
+			return nil, err
+		}
+		out := zbuf.NewArenaBatch(nil)
+		for _, leftVal := range batch.Values() {
+			key := o.getLeftKey.Eval(ectx, leftVal)
+			if key.IsMissing() {
+				continue
+			}
+			rightVal := o.lookup(key)
+			if rightVal == nil {
+				out.Append(leftVal)
+				continue
+			}
+			val, err := o.splicer.Splice(leftVal, o.cutter.Eval(ectx, *rightVal))
+			if err != nil {
+				batch.Unref()
+				out.Unref()
+				return nil, err
+			}
+			out.Append(val)
+		}
+		batch.Unref()
+		if len(out.Values()) > 0 {
+			return out, nil
+		}
+		out.Unref()
+	}
+}
+
+// lookup returns the right value matching the left key or nil if there is
+// none.
+func (o *AsOfOp) lookup(key super.Value) *super.Value {
+	if key.IsNull() {
+		return nil
+	}
+	i := sort.Search(len(o.keys), func(i int) bool {
+		return o.compare(o.keys[i], key) > 0
+	}) - 1
+	if i < 0 || !ComparableKeys(key, o.keys[i]) {
+		return nil
+	}
+	if o.tolerance != nil && !Within(key, o.keys[i], *o.tolerance) {
+		return nil
+	}
+	return &o.vals[i]
+}
+
+func (o *AsOfOp) reset() {
+	o.built = false
+	if o.partial != nil {
+		o.partial.close()
+	}
+	o.partial, o.partialPuller, o.partialEOS = nil, nil, false
+	o.keys, o.vals = nil, nil
+	o.resetter.Reset()
+}
+
+// ComparableKeys returns whether a and b are keys of an asof join that may
+// match, i.e., of the same type or both numbers.
+func ComparableKeys(a, b super.Value) bool {
+	a, b = a.Under(), b.Under()
+	return a.Type() == b.Type() || super.IsNumber(a.Type().ID()) && super.IsNumber(b.Type().ID())
+}
+
+// Within returns whether left, a key of an asof join, is no further above
+// right, the key it matches, than tolerance, a duration for keys of type
+// time or a number for numeric keys.
+func Within(left, right, tolerance super.Value) bool {
+	left, right, tolerance = left.Under(), right.Under(), tolerance.Under()
+	switch {
+	case left.Type().ID() == super.IDTime && right.Type().ID() == super.IDTime:
+		if tolerance.Type().ID() != super.IDDuration {
+			return false
+		}
+		d := nano.Duration(super.DecodeTime(left.Bytes()) - super.DecodeTime(right.Bytes()))
+		return d <= super.DecodeDuration(tolerance.Bytes())
+	case super.IsNumber(left.Type().ID()) && super.IsNumber(right.Type().ID()):
+		l, ok1 := coerce.ToFloat(left, super.TypeFloat64)
+		r, ok2 := coerce.ToFloat(right, super.TypeFloat64)
+		t, ok3 := coerce.ToFloat(tolerance, super.TypeFloat64)
+		return ok1 && ok2 && ok3 && l-r <= t
+	}
+	return false
+}
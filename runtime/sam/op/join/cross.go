@@ -0,0 +1,318 @@
+package join
+
+import (
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/sam/op/spill"
+	"github.com/brimdata/super/zbuf"
+	"github.com/brimdata/super/zio"
+)
+
+// CrossOp is a join that outputs the cartesian product of its inputs, i.e.,
+// a value for each pair of a left value and a right value.
+//
+// Both inputs are pulled concurrently, so that a join of the branches of a
+// fork does not deadlock, and buffered until one of them reaches EOS.  That
+// input, e.g., a small generated lookup table, is then held in full while
+// the other is streamed, and for each batch of the streamed input, the held
+// input is read once.  A buffer whose values exceed MemMaxBytes is spilled
+// to a temporary file.
+//
+// As for the merge join, a done from downstream, an error, or the end of the
+// streamed input ends the platoon, passing a done to each input that has not
+// reached its EOS and releasing the batches and buffers the join holds.
+type CrossOp struct {
+	rctx *runtime.Context
+	inputs
+	resetter expr.Resetter
+	cutter   *expr.Cutter
+	splicer  *RecordSplicer
+
+	built bool
+	// held is the input held in full and streamed is the other one,
+	// whose values buffered while the held input was pulled are read
+	// first from partial.
+	held          *spillBuffer
+	heldLeft      bool
+	streamed      *puller
+	partial       *spillBuffer
+	partialPuller zbuf.Puller
+	partialEOS    bool
+	// chunk holds the values of the batch of the streamed input being
+	// joined with heldVal, the value of the held input last read from
+	// heldReader.
+	batch      zbuf.Batch
+	chunk      []super.Value
+	next       int
+	heldReader zio.Reader
+	heldVal    *super.Value
+	spills     zbuf.SpillStats
+}
+
+func NewCross(rctx *runtime.Context, left, right zbuf.Puller, lhs []*expr.Lval, rhs []expr.Evaluator, resetter expr.Resetter) *CrossOp {
+	o := &CrossOp{
+		rctx:     rctx,
+		resetter: resetter,
+		cutter:   expr.NewCutter(rctx.Sctx, lhs, rhs),
+		splicer:  NewRecordSplicer(rctx.Sctx),
+	}
+	o.inputs = newInputs(rctx.Context, left, right, o.reset)
+	return o
+}
+
+// SpillStats returns the statistics of the values spilled by o.
+func (o *CrossOp) SpillStats() zbuf.SpillStats {
+	return o.spills.Copy()
+}
+
+func (o *CrossOp) Pull(done bool) (zbuf.Batch, error) {
+	if done {
+		return nil, o.done()
+	}
+	o.start()
+	if !o.built {
+		if err := o.build(); err != nil {
+			return nil, o.fail(err)
+		}
+		o.built = true
+	}
+	batch, err := o.product()
+	if err != nil {
+		return nil, o.fail(err)
+	}
+	if batch == nil {
+		return nil, o.done()
+	}
+	return batch, nil
+}
+
+// build pulls both inputs until one of them reaches EOS.
+func (o *CrossOp) build() error {
+	left, right := newSpillBuffer(o.rctx, &o.spills), newSpillBuffer(o.rctx, &o.spills)
+	o.held, o.partial = left, right
+	for {
+		var batch zbuf.Batch
+		var err error
+		var buf *spillBuffer
+		select {
+		case res := <-o.left.ch:
+			batch, err = o.left.result(res)
+			buf = left
+		case res := <-o.right.ch:
+			batch, err = o.right.result(res)
+			buf = right
+		case <-o.rctx.Context.Done():
+			return o.rctx.Context.Err()
+		}
+		if err != nil {
+			return err
+		}
+		if batch == nil {
+			o.heldLeft = buf == left
+			if o.heldLeft {
+				o.partial, o.streamed = right, o.right
+			} else {
+				o.held, o.partial, o.streamed = right, left, o.left
+			}
+			return nil
+		}
+		for _, val := range batch.Values() {
+			if err := buf.write(val); err != nil {
+				batch.Unref()
+				return err
+			}
+		}
+		batch.Unref()
+	}
+}
+
+// nextChunk reads the next batch of the streamed input into o.chunk and
+// rereads the held input.
+func (o *CrossOp) nextChunk() (bool, error) {
+	if o.batch != nil {
+		o.batch.Unref()
+		o.batch = nil
+	}
+	if o.partialPuller == nil && !o.partialEOS {
+		r, err := o.partial.reader()
+		if err != nil {
+			return false, err
+		}
+		o.partialPuller = zbuf.NewPuller(r)
+	}
+	var batch zbuf.Batch
+	if o.partialPuller != nil {
+		var err error
+		if batch, err = o.partialPuller.Pull(false); err != nil {
+			return false, err
+		}
+		if batch == nil {
+			o.partialPuller, o.partialEOS = nil, true
+			o.partial.close()
+		}
+	}
+	if batch == nil {
+		var err error
+		if batch, err = o.streamed.Pull(false); batch == nil || err != nil {
+			return false, err
+		}
+	}
+	o.batch, o.chunk, o.next = batch, batch.Values(), 0
+	r, err := o.held.reader()
+	if err != nil {
+		return false, err
+	}
+	o.heldReader, o.heldVal = r, nil
+	return true, nil
+}
+
+func (o *CrossOp) product() (zbuf.Batch, error) {
+	var out *zbuf.ArenaBatch
+	// See #3366
+	ectx := expr.NewContext()
+	for {
+		if o.heldVal == nil || o.next == len(o.chunk) {
+			var val *super.Value
+			if o.heldReader != nil {
+				var err error
+				if val, err = o.heldReader.Read(); err != nil {
+					return nil, err
+				}
+			}
+			if val == nil {
+				ok, err := o.nextChunk()
+				if err != nil {
+					if out != nil {
+						out.Unref()
+					}
+					return nil, err
+				}
+				if !ok {
+					if out == nil {
+						return nil, nil
+					}
+					return out, nil
+				}
+				continue
+			}
+			o.heldVal, o.next = val, 0
+		}
+		left, right := o.chunk[o.next], *o.heldVal
+		if o.heldLeft {
+			left, right = right, left
+		}
+		o.next++
+		val, err := o.splicer.SpliceCross(left, o.cutter.Eval(ectx, right))
+		if err != nil {
+			if out != nil {
+				out.Unref()
+			}
+			return nil, err
+		}
+		if out == nil {
+			out = zbuf.NewArenaBatch(nil)
+		}
+		if out.Append(val) {
+			return out, nil
+		}
+	}
+}
+
+// SpliceCross is like Splice but returns an error value for a pair of values
+// that are not both records, as the inputs of a cross join need not be.
+func (o *RecordSplicer) SpliceCross(left, right super.Value) (super.Value, error) {
+	if super.TypeRecordOf(left.Type()) == nil {
+		return o.sctx.WrapError("cross join: left value not a record", left), nil
+	}
+	if super.TypeRecordOf(right.Type()) == nil {
+		return o.sctx.WrapError("cross join: right value not a record", right), nil
+	}
+	return o.Splice(left, right)
+}
+
+func (o *CrossOp) reset() {
+	o.built = false
+	if o.held != nil {
+		o.held.close()
+	}
+	if o.partial != nil {
+		o.partial.close()
+	}
+	if o.batch != nil {
+		o.batch.Unref()
+		o.batch = nil
+	}
+	o.held, o.partial, o.streamed = nil, nil, nil
+	o.chunk, o.heldReader, o.heldVal, o.partialPuller = nil, nil, nil, nil
+	o.partialEOS = false
+	o.resetter.Reset()
+}
+
+// spillBuffer holds the values of an input of a join in memory until they
+// exceed MemMaxBytes and in a temporary file thereafter.
+type spillBuffer struct {
+	rctx   *runtime.Context
+	spills *zbuf.SpillStats
+	vals   []super.Value
+	nbytes int
+	file   *spill.File
+}
+
+func newSpillBuffer(rctx *runtime.Context, spills *zbuf.SpillStats) *spillBuffer {
+	return &spillBuffer{rctx: rctx, spills: spills}
+}
+
+func (b *spillBuffer) addStats(stats zbuf.SpillStats) {
+	b.spills.Add(stats)
+	b.rctx.Spills.Add(stats)
+}
+
+func (b *spillBuffer) write(val super.Value) error {
+	if b.file == nil {
+		b.vals = append(b.vals, val.Copy())
+		b.nbytes += len(val.Bytes())
+		if b.nbytes <= MemMaxBytes {
+			return nil
+		}
+		var err error
+		if b.file, err = spill.NewTempFileWithOptions(b.rctx.SpillOptions); err != nil {
+			return err
+		}
+		b.addStats(zbuf.SpillStats{SpillFiles: 1})
+		for _, val := range b.vals {
+			if err := b.file.Write(val); err != nil {
+				return err
+			}
+		}
+		b.addStats(zbuf.SpillStats{RowsSpilled: int64(len(b.vals))})
+		b.vals, b.nbytes = nil, 0
+		return nil
+	}
+	b.addStats(zbuf.SpillStats{RowsSpilled: 1})
+	return b.file.Write(val)
+}
+
+// reader returns a reader of the values of b from the start.
+func (b *spillBuffer) reader() (zio.Reader, error) {
+	if b.file == nil {
+		return zbuf.NewArray(b.vals), nil
+	}
+	if err := b.file.Rewind(b.rctx.Sctx); err != nil {
+		return nil, err
+	}
+	size, err := b.file.Size()
+	if err != nil {
+		return nil, err
+	}
+	b.addStats(zbuf.SpillStats{BytesSpilled: size, MergePasses: 1})
+	return b.file, nil
+}
+
+func (b *spillBuffer) close() {
+	if b.file != nil {
+		b.file.CloseAndRemove()
+		b.file = nil
+	}
+	b.vals, b.nbytes = nil, 0
+}
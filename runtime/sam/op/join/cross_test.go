@@ -0,0 +1,60 @@
+package join_test
+
+import (
+	"testing"
+
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/sam/op/head"
+	"github.com/brimdata/super/runtime/sam/op/join"
+	"github.com/brimdata/super/zbuf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCross(rctx *runtime.Context, left, right zbuf.Puller) *join.CrossOp {
+	lhs := []*expr.Lval{expr.NewLval([]expr.LvalElem{&expr.StaticLvalElem{Name: "r"}})}
+	rhs := []expr.Evaluator{&expr.This{}}
+	return join.NewCross(rctx, left, right, lhs, rhs, expr.Resetters{})
+}
+
+func TestCrossDoneUnderHead(t *testing.T) {
+	rctx := runtime.DefaultContext()
+	defer rctx.Cancel()
+	// The right input ends first and is held while the left is streamed.
+	left := newTestPuller(rctx.Sctx, 1<<30, 10)
+	right := newTestPuller(rctx.Sctx, 1, 3)
+	op := head.New(newCross(rctx, left, right), 15)
+	assert.Equal(t, 15, pullAll(t, op))
+	// The head passed a done through the join to the streamed input, and
+	// the join released the batch it was joining.
+	assert.Equal(t, 1, left.dones)
+	assert.Zero(t, right.dones)
+	assert.Zero(t, left.refs.Load())
+	assert.Zero(t, right.refs.Load())
+	// The next platoon starts over.
+	assert.Equal(t, 15, pullAll(t, op))
+	assert.Equal(t, 2, left.dones)
+	assert.Zero(t, right.dones)
+	assert.Zero(t, left.refs.Load())
+	assert.Zero(t, right.refs.Load())
+}
+
+func TestCrossDoneBeforePull(t *testing.T) {
+	rctx := runtime.DefaultContext()
+	defer rctx.Cancel()
+	left := newTestPuller(rctx.Sctx, 10, 10)
+	right := newTestPuller(rctx.Sctx, 1, 3)
+	op := newCross(rctx, left, right)
+	batch, err := op.Pull(true)
+	require.NoError(t, err)
+	assert.Nil(t, batch)
+	assert.Equal(t, 1, left.dones)
+	assert.Equal(t, 1, right.dones)
+	assert.Equal(t, 300, pullAll(t, op))
+	assert.Equal(t, 300, pullAll(t, op))
+	assert.Equal(t, 1, left.dones)
+	assert.Equal(t, 1, right.dones)
+	assert.Zero(t, left.refs.Load())
+	assert.Zero(t, right.refs.Load())
+}
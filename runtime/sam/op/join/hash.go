@@ -0,0 +1,619 @@
+package join
+
+import (
+	"encoding/binary"
+	"hash/maphash"
+	"math"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/sam/op/spill"
+	"github.com/brimdata/super/zbuf"
+)
+
+// MemMaxBytes specifies the maximum amount of memory that the table of each
+// hash join will consume.  The values of the left input buffered while the
+// table is built are subject to the same limit.
+var MemMaxBytes = 128 * 1024 * 1024
+
+// hashPartitions is the number of partitions into which a HashOp whose
+// table exceeds MemMaxBytes spills its inputs.
+const hashPartitions = 16
+
+// HashOp is a join that builds a table of the right input keyed by the
+// join key and probes it with each value of the left input, so neither
+// input needs to be sorted.  The output follows the order of the left input.
+//
+// The left input is pulled while the table is built, so that a join of the
+// branches of a fork, whose router waits for each branch to pull, does not
+// deadlock, and its values are buffered until the table is complete.  If
+// the table exceeds MemMaxBytes, both inputs are spilled to partitions by
+// the hash of the join key and each pair of partitions is joined in turn,
+// in which case the output follows the order of the left input only within
+// each partition.
+//
+// A semi join outputs each left value with a match once and holds only one
+// right value per key.  If it has a KeySet, the keys of the left input
+// are added to the set, which is published once the left input ends.
+//
+// As for the merge join, a done from downstream, an error, or the end of the
+// left input ends the platoon, passing a done to each input that has not
+// reached its EOS and releasing the batches and spills the join holds.
+type HashOp struct {
+	rctx  *runtime.Context
+	anti  bool
+	inner bool
+	semi  bool
+	keys  *KeySet
+	inputs
+	getLeftKey  expr.Evaluator
+	getRightKey expr.Evaluator
+	resetter    expr.Resetter
+	cutter      *expr.Cutter
+	splicer     *RecordSplicer
+	seed        maphash.Seed
+	keyBuf      []byte
+
+	built  bool
+	table  map[string][]super.Value
+	nbytes int
+	// pending holds the batches of the left input pulled while the
+	// table is built.  Once they exceed MemMaxBytes, they are spilled to
+	// leftSpill.
+	pending      []zbuf.Batch
+	pendingBytes int
+	leftSpill    *spill.File
+	// leftParts and rightParts hold the inputs partitioned by the hash
+	// of the join key once the table exceeds MemMaxBytes.
+	leftParts  []*spill.File
+	rightParts []*spill.File
+	part       int
+	// source yields the spilled left values being probed.
+	source zbuf.Puller
+	batch  zbuf.Batch
+	vals   []super.Value
+	// leftVal and matches are the left value being joined and the right
+	// values it has yet to be joined with when a Pull returns a full
+	// batch.  leftVal belongs to batch.
+	leftVal super.Value
+	matches []super.Value
+	spills  zbuf.SpillStats
+}
+
+func NewHash(rctx *runtime.Context, anti, inner, semi bool, keys *KeySet, left, right zbuf.Puller, leftKey, rightKey expr.Evaluator,
+	lhs []*expr.Lval, rhs []expr.Evaluator, resetter expr.Resetter) *HashOp {
+	o := &HashOp{
+		rctx:        rctx,
+		anti:        anti,
+		inner:       inner,
+		semi:        semi,
+		keys:        keys,
+		getLeftKey:  leftKey,
+		getRightKey: rightKey,
+		resetter:    resetter,
+		cutter:      expr.NewCutter(rctx.Sctx, lhs, rhs),
+		splicer:     NewRecordSplicer(rctx.Sctx),
+		seed:        maphash.MakeSeed(),
+		table:       make(map[string][]super.Value),
+	}
+	o.inputs = newInputs(rctx.Context, left, right, o.reset)
+	return o
+}
+
+// SpillStats returns the statistics of the values spilled by o.
+func (o *HashOp) SpillStats() zbuf.SpillStats {
+	return o.spills.Copy()
+}
+
+func (o *HashOp) Pull(done bool) (zbuf.Batch, error) {
+	if done {
+		return nil, o.done()
+	}
+	o.start()
+	if !o.built {
+		err := o.build()
+		if err == nil && o.rightParts != nil {
+			err = o.partitionLeft()
+		}
+		if err != nil {
+			return nil, o.fail(err)
+		}
+		o.built = true
+	}
+	batch, err := o.probe()
+	if err != nil {
+		return nil, o.fail(err)
+	}
+	if batch == nil {
+		return nil, o.done()
+	}
+	return batch, nil
+}
+
+// done is like inputs.done but first releases a right input waiting for
+// the keys of a platoon that ends before the left input does.
+func (o *HashOp) done() error {
+	o.publishKeys()
+	return o.inputs.done()
+}
+
+func (o *HashOp) fail(err error) error {
+	o.publishKeys()
+	return o.inputs.fail(err)
+}
+
+func (o *HashOp) publishKeys() {
+	if o.keys != nil {
+		o.keys.Publish()
+	}
+}
+
+// build reads the right input into the table while buffering the left
+// input.
+func (o *HashOp) build() error {
+	leftCh := o.left.ch
+	for {
+		select {
+		case res := <-o.right.ch:
+			batch, err := o.right.result(res)
+			if batch == nil || err != nil {
+				return err
+			}
+			err = o.insert(batch)
+			batch.Unref()
+			if err != nil {
+				return err
+			}
+		case res := <-leftCh:
+			batch, err := o.left.result(res)
+			if err != nil {
+				return err
+			}
+			if batch == nil {
+				// The puller closes its channel after EOS, which
+				// later pulls see as EOS again.
+				leftCh = nil
+				if o.keys != nil {
+					o.keys.Publish()
+				}
+				continue
+			}
+			if o.keys != nil {
+				o.addKeys(batch)
+			}
+			if err := o.buffer(batch); err != nil {
+				return err
+			}
+		case <-o.rctx.Context.Done():
+			return o.rctx.Context.Err()
+		}
+	}
+}
+
+func (o *HashOp) addKeys(batch zbuf.Batch) {
+	// See #3366
+	ectx := expr.NewContext()
+	for _, val := range batch.Values() {
+		o.keys.Add(expr.QueryCollation.Key(o.getLeftKey.Eval(ectx, val)))
+	}
+}
+
+func (o *HashOp) insert(batch zbuf.Batch) error {
+	// See #3366
+	ectx := expr.NewContext()
+	for _, val := range batch.Values() {
+		key, ok := o.key(ectx, o.getRightKey, val)
+		if !ok {
+			continue
+		}
+		if o.rightParts != nil {
+			if err := o.writePart(o.rightParts, key, val); err != nil {
+				return err
+			}
+			continue
+		}
+		if o.semi && len(o.table[key]) != 0 {
+			// A semi join needs only one right value per key.
+			continue
+		}
+		o.table[key] = append(o.table[key], val.Copy())
+		o.nbytes += len(key) + len(val.Bytes())
+		if o.nbytes > MemMaxBytes {
+			if err := o.spillTable(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// spillTable moves the table to the right partitions.
+func (o *HashOp) spillTable() error {
+	var err error
+	if o.rightParts, err = o.newParts(); err != nil {
+		return err
+	}
+	for key, vals := range o.table {
+		for _, val := range vals {
+			if err := o.writePart(o.rightParts, key, val); err != nil {
+				return err
+			}
+		}
+	}
+	clear(o.table)
+	o.nbytes = 0
+	return nil
+}
+
+func (o *HashOp) buffer(batch zbuf.Batch) error {
+	if o.leftSpill != nil {
+		err := o.writeSpill(batch)
+		batch.Unref()
+		return err
+	}
+	o.pending = append(o.pending, batch)
+	for _, val := range batch.Values() {
+		o.pendingBytes += len(val.Bytes())
+	}
+	if o.pendingBytes <= MemMaxBytes {
+		return nil
+	}
+	var err error
+	if o.leftSpill, err = o.newFile(); err != nil {
+		return err
+	}
+	for _, b := range o.pending {
+		if err := o.writeSpill(b); err != nil {
+			return err
+		}
+	}
+	o.releasePending()
+	return nil
+}
+
+func (o *HashOp) writeSpill(batch zbuf.Batch) error {
+	vals := batch.Values()
+	for _, val := range vals {
+		if err := o.leftSpill.Write(val); err != nil {
+			return err
+		}
+	}
+	o.addStats(zbuf.SpillStats{RowsSpilled: int64(len(vals))})
+	return nil
+}
+
+// partitionLeft moves the whole left input to the left partitions.
+func (o *HashOp) partitionLeft() error {
+	var err error
+	if o.leftParts, err = o.newParts(); err != nil {
+		return err
+	}
+	// See #3366
+	ectx := expr.NewContext()
+	for {
+		batch, err := o.nextLeft()
+		if batch == nil || err != nil {
+			return err
+		}
+		for _, val := range batch.Values() {
+			key, ok := o.key(ectx, o.getLeftKey, val)
+			if !ok {
+				continue
+			}
+			if err := o.writePart(o.leftParts, key, val); err != nil {
+				batch.Unref()
+				return err
+			}
+		}
+		batch.Unref()
+	}
+}
+
+// nextLeft returns the next batch of the left input, reading first the
+// batches buffered while the table was built.
+func (o *HashOp) nextLeft() (zbuf.Batch, error) {
+	if len(o.pending) > 0 {
+		batch := o.pending[0]
+		o.pending = o.pending[1:]
+		return batch, nil
+	}
+	if o.leftSpill != nil {
+		if o.source == nil {
+			if err := o.rewind(o.leftSpill); err != nil {
+				return nil, err
+			}
+			o.source = zbuf.NewPuller(o.leftSpill)
+		}
+		batch, err := o.source.Pull(false)
+		if batch != nil || err != nil {
+			return batch, err
+		}
+		o.source = nil
+		err = o.leftSpill.CloseAndRemove()
+		o.leftSpill = nil
+		if err != nil {
+			return nil, err
+		}
+	}
+	return o.left.Pull(false)
+}
+
+// nextPart returns the next batch of the left partitions, loading the table
+// from the matching right partition at the start of each.
+func (o *HashOp) nextPart() (zbuf.Batch, error) {
+	for o.part < len(o.leftParts) {
+		if o.source == nil {
+			if err := o.loadPart(); err != nil {
+				return nil, err
+			}
+		}
+		batch, err := o.source.Pull(false)
+		if batch != nil || err != nil {
+			return batch, err
+		}
+		o.source = nil
+		o.part++
+	}
+	return nil, nil
+}
+
+// loadPart reads the right partition o.part into the table.  A partition
+// is loaded whole even if it exceeds MemMaxBytes.
+func (o *HashOp) loadPart() error {
+	clear(o.table)
+	o.nbytes = 0
+	right, left := o.rightParts[o.part], o.leftParts[o.part]
+	if err := o.rewind(right); err != nil {
+		return err
+	}
+	// See #3366
+	ectx := expr.NewContext()
+	for {
+		val, err := right.Read()
+		if err != nil {
+			return err
+		}
+		if val == nil {
+			break
+		}
+		// The key was present when the value was spilled.
+		key, _ := o.key(ectx, o.getRightKey, *val)
+		o.table[key] = append(o.table[key], val.Copy())
+	}
+	if err := o.rewind(left); err != nil {
+		return err
+	}
+	o.source = zbuf.NewPuller(left)
+	o.addStats(zbuf.SpillStats{MergePasses: 1})
+	return nil
+}
+
+func (o *HashOp) probe() (zbuf.Batch, error) {
+	var out *zbuf.ArenaBatch
+	// See #3366
+	ectx := expr.NewContext()
+	for {
+		if len(o.matches) > 0 {
+			if out == nil {
+				out = zbuf.NewArenaBatch(nil)
+			}
+			full, err := o.splice(ectx, out)
+			if err != nil {
+				out.Unref()
+				return nil, err
+			}
+			if full {
+				return out, nil
+			}
+			continue
+		}
+		if len(o.vals) == 0 {
+			if o.batch != nil {
+				o.batch.Unref()
+				o.batch = nil
+			}
+			var batch zbuf.Batch
+			var err error
+			if o.leftParts != nil {
+				batch, err = o.nextPart()
+			} else {
+				batch, err = o.nextLeft()
+			}
+			if err != nil {
+				if out != nil {
+					out.Unref()
+				}
+				return nil, err
+			}
+			if batch == nil {
+				if out == nil {
+					return nil, nil
+				}
+				return out, nil
+			}
+			o.batch, o.vals = batch, batch.Values()
+		}
+		leftVal := o.vals[0]
+		o.vals = o.vals[1:]
+		key, ok := o.key(ectx, o.getLeftKey, leftVal)
+		if !ok {
+			// As for the merge join, drop left values whose
+			// key is missing.
+			continue
+		}
+		rightVals, ok := o.table[key]
+		if !ok {
+			if !o.inner {
+				if out == nil {
+					out = zbuf.NewArenaBatch(nil)
+				}
+				if out.Append(leftVal) {
+					return out, nil
+				}
+			}
+			continue
+		}
+		if o.anti {
+			continue
+		}
+		if o.semi {
+			if out == nil {
+				out = zbuf.NewArenaBatch(nil)
+			}
+			if out.Append(leftVal) {
+				return out, nil
+			}
+			continue
+		}
+		o.leftVal, o.matches = leftVal, rightVals
+	}
+}
+
+// splice appends the joins of o.leftVal with o.matches to out until out is
+// full and returns whether it is.
+func (o *HashOp) splice(ectx expr.Context, out *zbuf.ArenaBatch) (bool, error) {
+	for len(o.matches) > 0 {
+		cutVal := o.cutter.Eval(ectx, o.matches[0])
+		val, err := o.splicer.Splice(o.leftVal, cutVal)
+		if err != nil {
+			return false, err
+		}
+		o.matches = o.matches[1:]
+		if out.Append(val) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// key returns the hash key of the value of e for val and false if that
+// value is missing.  The key of a null is empty.
+func (o *HashOp) key(ectx expr.Context, e expr.Evaluator, val super.Value) (string, bool) {
+	keyVal := expr.QueryCollation.Key(e.Eval(ectx, val))
+	if keyVal.IsMissing() {
+		return "", false
+	}
+	if keyVal.IsNull() {
+		// As for the merge join, nulls of any type match.
+		return "", true
+	}
+	keyVal = NumericKey(keyVal)
+	o.keyBuf = binary.LittleEndian.AppendUint32(o.keyBuf[:0], uint32(keyVal.Type().ID()))
+	o.keyBuf = append(o.keyBuf, keyVal.Bytes()...)
+	return string(o.keyBuf), true
+}
+
+// NumericKey returns val or, if val is a number, the same number as an int64
+// if it has an exact int64 value, as a uint64 if it has an exact uint64
+// value, and as a float64 otherwise, so that equal numbers of different
+// types, which the merge join matches, have the same hash key.
+func NumericKey(val super.Value) super.Value {
+	under := val.Under()
+	id := under.Type().ID()
+	switch {
+	case super.IsSigned(id):
+		return super.NewInt64(under.Int())
+	case super.IsUnsigned(id):
+		if u := under.Uint(); u > math.MaxInt64 {
+			return super.NewUint64(u)
+		}
+		return super.NewInt64(int64(under.Uint()))
+	case super.IsFloat(id):
+		f := under.Float()
+		switch {
+		case f != math.Trunc(f):
+		case f >= -(1<<63) && f < 1<<63:
+			return super.NewInt64(int64(f))
+		case f >= 0 && f < 1<<64:
+			return super.NewUint64(uint64(f))
+		}
+		return super.NewFloat64(f)
+	}
+	return val
+}
+
+func (o *HashOp) newFile() (*spill.File, error) {
+	f, err := spill.NewTempFileWithOptions(o.rctx.SpillOptions)
+	if err != nil {
+		return nil, err
+	}
+	o.addStats(zbuf.SpillStats{SpillFiles: 1})
+	return f, nil
+}
+
+func (o *HashOp) newParts() ([]*spill.File, error) {
+	parts := make([]*spill.File, 0, hashPartitions)
+	for range hashPartitions {
+		f, err := o.newFile()
+		if err != nil {
+			closeFiles(parts)
+			return nil, err
+		}
+		parts = append(parts, f)
+	}
+	return parts, nil
+}
+
+func (o *HashOp) writePart(parts []*spill.File, key string, val super.Value) error {
+	o.addStats(zbuf.SpillStats{RowsSpilled: 1})
+	return parts[maphash.String(o.seed, key)%uint64(len(parts))].Write(val)
+}
+
+// rewind rewinds f for reading and accounts for its size.
+func (o *HashOp) rewind(f *spill.File) error {
+	if err := f.Rewind(o.rctx.Sctx); err != nil {
+		return err
+	}
+	size, err := f.Size()
+	if err != nil {
+		return err
+	}
+	o.addStats(zbuf.SpillStats{BytesSpilled: size})
+	return nil
+}
+
+func (o *HashOp) addStats(stats zbuf.SpillStats) {
+	o.spills.Add(stats)
+	o.rctx.Spills.Add(stats)
+}
+
+func (o *HashOp) releasePending() {
+	for _, b := range o.pending {
+		b.Unref()
+	}
+	o.pending = nil
+	o.pendingBytes = 0
+}
+
+func (o *HashOp) reset() {
+	o.built = false
+	clear(o.table)
+	o.nbytes = 0
+	o.releasePending()
+	if o.leftSpill != nil {
+		o.leftSpill.CloseAndRemove()
+		o.leftSpill = nil
+	}
+	closeFiles(o.leftParts)
+	closeFiles(o.rightParts)
+	o.leftParts, o.rightParts = nil, nil
+	o.part = 0
+	o.source = nil
+	if o.batch != nil {
+		o.batch.Unref()
+		o.batch = nil
+	}
+	o.vals = nil
+	o.matches = nil
+	if o.keys != nil {
+		o.keys.Reset()
+	}
+	o.resetter.Reset()
+}
+
+func closeFiles(files []*spill.File) {
+	for _, f := range files {
+		f.CloseAndRemove()
+	}
+}
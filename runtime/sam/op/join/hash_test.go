@@ -0,0 +1,63 @@
+package join_test
+
+import (
+	"testing"
+
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/sam/op/head"
+	"github.com/brimdata/super/runtime/sam/op/join"
+	"github.com/brimdata/super/zbuf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newHash(rctx *runtime.Context, left, right zbuf.Puller) *join.HashOp {
+	sctx := rctx.Sctx
+	key := expr.NewDottedExpr(sctx, field.Path{"k"})
+	lhs := []*expr.Lval{expr.NewLval([]expr.LvalElem{&expr.StaticLvalElem{Name: "r"}})}
+	rhs := []expr.Evaluator{key}
+	return join.NewHash(rctx, false, true, false, nil, left, right, key, key, lhs, rhs, expr.Resetters{})
+}
+
+func TestHashDoneUnderHead(t *testing.T) {
+	rctx := runtime.DefaultContext()
+	defer rctx.Cancel()
+	// The left input does not end while the table is built.
+	left := newTestPuller(rctx.Sctx, 1<<30, 10)
+	right := newTestPuller(rctx.Sctx, 10, 10)
+	op := head.New(newHash(rctx, left, right), 15)
+	assert.Equal(t, 15, pullAll(t, op))
+	// The head passed a done through the join to the left input, and the
+	// join released the batches it buffered.
+	assert.Equal(t, 1, left.dones)
+	assert.Zero(t, right.dones)
+	assert.Zero(t, left.refs.Load())
+	assert.Zero(t, right.refs.Load())
+	// The next platoon starts over.
+	assert.Equal(t, 15, pullAll(t, op))
+	assert.Equal(t, 2, left.dones)
+	assert.Zero(t, right.dones)
+	assert.Zero(t, left.refs.Load())
+	assert.Zero(t, right.refs.Load())
+}
+
+func TestHashDoneBeforePull(t *testing.T) {
+	rctx := runtime.DefaultContext()
+	defer rctx.Cancel()
+	left := newTestPuller(rctx.Sctx, 100, 10)
+	right := newTestPuller(rctx.Sctx, 100, 10)
+	op := newHash(rctx, left, right)
+	batch, err := op.Pull(true)
+	require.NoError(t, err)
+	assert.Nil(t, batch)
+	assert.Equal(t, 1, left.dones)
+	assert.Equal(t, 1, right.dones)
+	assert.Equal(t, 1000, pullAll(t, op))
+	assert.Equal(t, 1000, pullAll(t, op))
+	assert.Equal(t, 1, left.dones)
+	assert.Equal(t, 1, right.dones)
+	assert.Zero(t, left.refs.Load())
+	assert.Zero(t, right.refs.Load())
+}
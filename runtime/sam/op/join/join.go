@@ -1,9 +1,7 @@
 package join
 
 import (
-	"context"
 	"fmt"
-	"sync"
 
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/order"
@@ -14,15 +12,15 @@ import (
 	"github.com/brimdata/super/zio"
 )
 
+// Op is a merge join.  The end of the left input ends the platoon of its
+// inputs.
 type Op struct {
-	rctx        *runtime.Context
-	anti        bool
-	inner       bool
-	ctx         context.Context
-	cancel      context.CancelFunc
-	once        sync.Once
-	left        *puller
-	right       *zio.Peeker
+	rctx  *runtime.Context
+	anti  bool
+	inner bool
+	semi  bool
+	inputs
+	peeker      *zio.Peeker
 	getLeftKey  expr.Evaluator
 	getRightKey expr.Evaluator
 	resetter    expr.Resetter
@@ -31,9 +29,14 @@ type Op struct {
 	joinKey     *super.Value
 	joinSet     []super.Value
 	splicer     *RecordSplicer
+	// leftRec and matches are the left record being joined and the right
+	// records it has yet to be joined with when a Pull returns a full
+	// batch, so that a key with many matches spans many batches.
+	leftRec *super.Value
+	matches []super.Value
 }
 
-func New(rctx *runtime.Context, anti, inner bool, left, right zbuf.Puller, leftKey, rightKey expr.Evaluator,
+func New(rctx *runtime.Context, anti, inner, semi bool, left, right zbuf.Puller, leftKey, rightKey expr.Evaluator,
 	leftDir, rightDir order.Direction, lhs []*expr.Lval, rhs []expr.Evaluator, resetter expr.Resetter) *Op {
 	var o order.Which
 	switch {
@@ -51,48 +54,66 @@ func New(rctx *runtime.Context, anti, inner bool, left, right zbuf.Puller, leftK
 		s := expr.NewSortExpr(rightKey, o, order.NullsLast)
 		right = sort.New(rctx, right, []expr.SortExpr{s}, false, resetter)
 	}
-	ctx, cancel := context.WithCancel(rctx.Context)
-	return &Op{
+	op := &Op{
 		rctx:        rctx,
 		anti:        anti,
 		inner:       inner,
-		ctx:         ctx,
-		cancel:      cancel,
+		semi:        semi,
 		getLeftKey:  leftKey,
 		getRightKey: rightKey,
-		left:        newPuller(left, ctx),
-		right:       zio.NewPeeker(newPuller(right, ctx)),
 		resetter:    resetter,
-		compare:     expr.NewValueCompareFn(o, o.NullsMax(true)),
+		compare:     expr.NewComparator(expr.NewSortExpr(&expr.This{}, o, o.NullsMax(true))).WithCollation(expr.QueryCollation).Compare,
 		cutter:      expr.NewCutter(rctx.Sctx, lhs, rhs),
 		splicer:     NewRecordSplicer(rctx.Sctx),
 	}
+	op.inputs = newInputs(rctx.Context, left, right, op.reset)
+	op.peeker = zio.NewPeeker(op.inputs.right)
+	return op
 }
 
 // Pull implements the merge logic for returning data from the upstreams.
 func (o *Op) Pull(done bool) (zbuf.Batch, error) {
-	// XXX see issue #3437 regarding done protocol.
-	o.once.Do(func() {
-		go o.left.run()
-		go o.right.Reader.(*puller).run()
-	})
-	var out []super.Value
+	if done {
+		return nil, o.done()
+	}
+	o.start()
+	var out *zbuf.ArenaBatch
 	// See #3366
 	ectx := expr.NewContext()
 	for {
+		if len(o.matches) > 0 {
+			if out == nil {
+				out = zbuf.NewArenaBatch(nil)
+			}
+			full, err := o.splice(ectx, out)
+			if err != nil {
+				out.Unref()
+				return nil, o.fail(err)
+			}
+			if full {
+				return out, nil
+			}
+			continue
+		}
 		leftRec, err := o.left.Read()
 		if err != nil {
-			return nil, err
+			if out != nil {
+				out.Unref()
+			}
+			return nil, o.fail(err)
 		}
 		if leftRec == nil {
-			if len(out) == 0 {
-				o.resetter.Reset()
-				return nil, nil
+			if out != nil {
+				// The left input returns EOS again on the
+				// next Pull, which returns the join's EOS.
+				//XXX See issue #3427.
+				return out, nil
 			}
-			//XXX See issue #3427.
-			return zbuf.NewArray(out), nil
+			// The right input is not needed past the end of the
+			// left.
+			return nil, o.done()
 		}
-		key := o.getLeftKey.Eval(ectx, *leftRec)
+		key := expr.QueryCollation.Key(o.getLeftKey.Eval(ectx, *leftRec))
 		if key.IsMissing() {
 			// If the left key isn't present (which is not a thing
 			// in a sql join), then drop the record and return only
@@ -101,37 +122,69 @@ func (o *Op) Pull(done bool) (zbuf.Batch, error) {
 		}
 		rightRecs, err := o.getJoinSet(key)
 		if err != nil {
-			return nil, err
+			if out != nil {
+				out.Unref()
+			}
+			return nil, o.fail(err)
 		}
 		if rightRecs == nil {
 			// Nothing to add to the left join.
 			// Accumulate this record for an outer join.
 			if !o.inner {
-				out = append(out, leftRec.Copy())
+				if out == nil {
+					out = zbuf.NewArenaBatch(nil)
+				}
+				if out.Append(*leftRec) {
+					return out, nil
+				}
 			}
 			continue
 		}
 		if o.anti {
 			continue
 		}
-		// For every record on the right with a key matching
-		// this left record, generate a joined record.
-		// XXX This loop could be more efficient if we had CutAppend
-		// and built the record in a re-usable buffer, then allocated
-		// a right-sized output buffer for the record body and copied
-		// the two inputs into the output buffer.  Even better, these
-		// output buffers could come from a large buffer that implements
-		// Batch and lives in a pool so the downstream user can
-		// release the batch with and bypass GC.
-		for _, rightRec := range rightRecs {
-			cutRec := o.cutter.Eval(ectx, rightRec)
-			rec, err := o.splicer.Splice(*leftRec, cutRec)
-			if err != nil {
-				return nil, err
+		if o.semi {
+			if out == nil {
+				out = zbuf.NewArenaBatch(nil)
+			}
+			if out.Append(*leftRec) {
+				return out, nil
 			}
-			out = append(out, rec)
+			continue
+		}
+		// For every record on the right with a key matching
+		// this left record, generate a joined record at the top of
+		// the loop.
+		o.leftRec, o.matches = leftRec, rightRecs
+	}
+}
+
+func (o *Op) reset() {
+	// The peeked value, if any, belongs to a dropped batch.
+	o.peeker = zio.NewPeeker(o.inputs.right)
+	o.joinKey = nil
+	o.joinSet = nil
+	o.leftRec = nil
+	o.matches = nil
+	o.resetter.Reset()
+}
+
+// splice appends the joins of o.leftRec with o.matches to out, whose memory
+// is reused once the downstream user releases it, until out is full and
+// returns whether it is.
+func (o *Op) splice(ectx expr.Context, out *zbuf.ArenaBatch) (bool, error) {
+	for len(o.matches) > 0 {
+		cutRec := o.cutter.Eval(ectx, o.matches[0])
+		rec, err := o.splicer.Splice(*o.leftRec, cutRec)
+		if err != nil {
+			return false, err
+		}
+		o.matches = o.matches[1:]
+		if out.Append(rec) {
+			return true, nil
 		}
 	}
+	return false, nil
 }
 
 func (o *Op) getJoinSet(leftKey super.Value) ([]super.Value, error) {
@@ -141,13 +194,13 @@ func (o *Op) getJoinSet(leftKey super.Value) ([]super.Value, error) {
 	// See #3366
 	ectx := expr.NewContext()
 	for {
-		rec, err := o.right.Peek()
+		rec, err := o.peeker.Peek()
 		if err != nil || rec == nil {
 			return nil, err
 		}
-		rightKey := o.getRightKey.Eval(ectx, *rec)
+		rightKey := expr.QueryCollation.Key(o.getRightKey.Eval(ectx, *rec))
 		if rightKey.IsMissing() {
-			o.right.Read()
+			o.peeker.Read()
 			continue
 		}
 		cmp := o.compare(leftKey, rightKey)
@@ -170,7 +223,7 @@ func (o *Op) getJoinSet(leftKey super.Value) ([]super.Value, error) {
 		// Discard the peeked-at record and keep looking for
 		// a righthand key that either matches or exceeds the
 		// lefthand key.
-		o.right.Read()
+		o.peeker.Read()
 	}
 }
 
@@ -182,33 +235,34 @@ func (o *Op) readJoinSet(joinKey *super.Value) ([]super.Value, error) {
 	// See #3366
 	ectx := expr.NewContext()
 	for {
-		rec, err := o.right.Peek()
+		rec, err := o.peeker.Peek()
 		if err != nil {
 			return nil, err
 		}
 		if rec == nil {
 			return recs, nil
 		}
-		key := o.getRightKey.Eval(ectx, *rec)
+		key := expr.QueryCollation.Key(o.getRightKey.Eval(ectx, *rec))
 		if key.IsMissing() {
-			o.right.Read()
+			o.peeker.Read()
 			continue
 		}
 		if o.compare(key, *joinKey) != 0 {
 			return recs, nil
 		}
 		recs = append(recs, rec.Copy())
-		o.right.Read()
+		o.peeker.Read()
 	}
 }
 
 type RecordSplicer struct {
 	sctx  *super.Context
 	types map[int]map[int]*super.TypeRecord
+	buf   []byte
 }
 
 func NewRecordSplicer(sctx *super.Context) *RecordSplicer {
-	return &RecordSplicer{sctx, map[int]map[int]*super.TypeRecord{}}
+	return &RecordSplicer{sctx: sctx, types: map[int]map[int]*super.TypeRecord{}}
 }
 
 func (o *RecordSplicer) lookupType(left, right *super.TypeRecord) *super.TypeRecord {
@@ -253,6 +307,8 @@ func (o *RecordSplicer) combinedType(left, right *super.TypeRecord) (*super.Type
 	return typ, nil
 }
 
+// Splice returns a record with the fields of left followed by those of right.
+// The returned value is valid only until the next call to Splice.
 func (o *RecordSplicer) Splice(left, right super.Value) (super.Value, error) {
 	left = left.Under()
 	right = right.Under()
@@ -260,9 +316,6 @@ func (o *RecordSplicer) Splice(left, right super.Value) (super.Value, error) {
 	if err != nil {
 		return super.Null, err
 	}
-	n := len(left.Bytes())
-	bytes := make([]byte, n+len(right.Bytes()))
-	copy(bytes, left.Bytes())
-	copy(bytes[n:], right.Bytes())
-	return super.NewValue(typ, bytes), nil
+	o.buf = append(append(o.buf[:0], left.Bytes()...), right.Bytes()...)
+	return super.NewValue(typ, o.buf), nil
 }
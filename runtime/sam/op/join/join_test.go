@@ -0,0 +1,136 @@
+package join_test
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/sam/op/head"
+	"github.com/brimdata/super/runtime/sam/op/join"
+	"github.com/brimdata/super/sup"
+	"github.com/brimdata/super/zbuf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testPuller returns nbatches batches of size records {k:0}, {k:1}, and so
+// on.  It counts the dones it receives and the references to its batches
+// that have not been released.
+type testPuller struct {
+	sctx     *super.Context
+	nbatches int
+	size     int
+	next     int
+	dones    int
+	refs     *atomic.Int64
+}
+
+func newTestPuller(sctx *super.Context, nbatches, size int) *testPuller {
+	return &testPuller{sctx: sctx, nbatches: nbatches, size: size, refs: &atomic.Int64{}}
+}
+
+func (p *testPuller) Pull(done bool) (zbuf.Batch, error) {
+	if done {
+		p.dones++
+		p.next = 0
+		return nil, nil
+	}
+	if p.next == p.nbatches {
+		p.next = 0
+		return nil, nil
+	}
+	var vals []super.Value
+	for k := range p.size {
+		vals = append(vals, sup.MustParseValue(p.sctx, fmt.Sprintf("{k:%d}", p.next*p.size+k)))
+	}
+	p.next++
+	p.refs.Add(1)
+	return &testBatch{zbuf.NewArray(vals), p.refs}, nil
+}
+
+type testBatch struct {
+	*zbuf.Array
+	refs *atomic.Int64
+}
+
+func (b *testBatch) Ref()   { b.refs.Add(1) }
+func (b *testBatch) Unref() { b.refs.Add(-1) }
+
+func newJoin(rctx *runtime.Context, left, right zbuf.Puller) *join.Op {
+	sctx := rctx.Sctx
+	key := expr.NewDottedExpr(sctx, field.Path{"k"})
+	lhs := []*expr.Lval{expr.NewLval([]expr.LvalElem{&expr.StaticLvalElem{Name: "r"}})}
+	rhs := []expr.Evaluator{key}
+	return join.New(rctx, false, true, false, left, right, key, key, order.Up, order.Up, lhs, rhs, expr.Resetters{})
+}
+
+// pullAll returns the number of values pulled from p before its EOS.
+func pullAll(t *testing.T, p zbuf.Puller) int {
+	var n int
+	for {
+		batch, err := p.Pull(false)
+		require.NoError(t, err)
+		if batch == nil {
+			return n
+		}
+		n += len(batch.Values())
+		batch.Unref()
+	}
+}
+
+func TestJoinDoneUnderHead(t *testing.T) {
+	rctx := runtime.DefaultContext()
+	defer rctx.Cancel()
+	left := newTestPuller(rctx.Sctx, 100, 10)
+	right := newTestPuller(rctx.Sctx, 100, 10)
+	op := head.New(newJoin(rctx, left, right), 15)
+	assert.Equal(t, 15, pullAll(t, op))
+	// The head passed a done through the join to both inputs, which
+	// were not at their EOS, and the join released their batches.
+	assert.Equal(t, 1, left.dones)
+	assert.Equal(t, 1, right.dones)
+	assert.Zero(t, left.refs.Load())
+	assert.Zero(t, right.refs.Load())
+	// The next platoon starts over.
+	assert.Equal(t, 15, pullAll(t, op))
+	assert.Equal(t, 2, left.dones)
+	assert.Equal(t, 2, right.dones)
+	assert.Zero(t, left.refs.Load())
+	assert.Zero(t, right.refs.Load())
+}
+
+func TestJoinDoneAtLeftEOS(t *testing.T) {
+	rctx := runtime.DefaultContext()
+	defer rctx.Cancel()
+	left := newTestPuller(rctx.Sctx, 1, 10)
+	right := newTestPuller(rctx.Sctx, 100, 10)
+	assert.Equal(t, 10, pullAll(t, newJoin(rctx, left, right)))
+	// The right input is not read past the end of the left.
+	assert.Zero(t, left.dones)
+	assert.Equal(t, 1, right.dones)
+	assert.Zero(t, left.refs.Load())
+	assert.Zero(t, right.refs.Load())
+}
+
+func TestJoinDoneBeforePull(t *testing.T) {
+	rctx := runtime.DefaultContext()
+	defer rctx.Cancel()
+	left := newTestPuller(rctx.Sctx, 100, 10)
+	right := newTestPuller(rctx.Sctx, 100, 10)
+	op := newJoin(rctx, left, right)
+	batch, err := op.Pull(true)
+	require.NoError(t, err)
+	assert.Nil(t, batch)
+	assert.Equal(t, 1, left.dones)
+	assert.Equal(t, 1, right.dones)
+	assert.Equal(t, 1000, pullAll(t, op))
+	assert.Equal(t, 1, left.dones)
+	assert.Equal(t, 1, right.dones)
+	assert.Zero(t, left.refs.Load())
+	assert.Zero(t, right.refs.Load())
+}
@@ -0,0 +1,132 @@
+package join
+
+import (
+	"context"
+	"encoding/binary"
+	"slices"
+	"sync"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/runtime/sam/expr"
+)
+
+// KeySet is the set of join keys of the left input of a semi join.  The
+// join adds each left key and publishes the set once the left input ends so
+// that the Lister of a lake scan of the right input, which waits for the
+// set, can prune the data objects whose key ranges contain none of the keys.
+// If the keys exceed MemMaxBytes, the set is published as unbounded and
+// nothing is pruned.
+type KeySet struct {
+	mu        sync.Mutex
+	ready     chan struct{}
+	published bool
+	seen      map[string]struct{}
+	keys      []super.Value
+	nbytes    int
+	unbounded bool
+	keyBuf    []byte
+}
+
+func NewKeySet() *KeySet {
+	return &KeySet{
+		ready: make(chan struct{}),
+		seen:  make(map[string]struct{}),
+	}
+}
+
+// Add adds key to the set.  Null and missing keys, which cannot rule out
+// an object, are ignored.
+func (k *KeySet) Add(key super.Value) {
+	if k.unbounded || key.IsMissing() || key.IsNull() {
+		return
+	}
+	k.keyBuf = binary.LittleEndian.AppendUint32(k.keyBuf[:0], uint32(key.Type().ID()))
+	k.keyBuf = append(k.keyBuf, key.Bytes()...)
+	if _, ok := k.seen[string(k.keyBuf)]; ok {
+		return
+	}
+	k.seen[string(k.keyBuf)] = struct{}{}
+	k.keys = append(k.keys, key.Copy())
+	k.nbytes += 2 * len(k.keyBuf)
+	if k.nbytes > MemMaxBytes {
+		k.unbounded = true
+		k.seen = nil
+		k.keys = nil
+	}
+}
+
+// Publish sorts the set and releases the Listers waiting for it.
+func (k *KeySet) Publish() {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if k.published {
+		return
+	}
+	slices.SortFunc(k.keys, expr.NewValueCompareFn(order.Asc, order.NullsLast))
+	k.published = true
+	close(k.ready)
+}
+
+// Reset empties the set so that it may be built again when the join is
+// reused.  It must not be called until the right input has ended.
+func (k *KeySet) Reset() {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.ready = make(chan struct{})
+	k.published = false
+	k.seen = make(map[string]struct{})
+	k.keys = nil
+	k.nbytes = 0
+	k.unbounded = false
+}
+
+// wait waits for the set to be published and returns its sorted keys and
+// whether they bound the keys of the left input.
+func (k *KeySet) wait(ctx context.Context) ([]super.Value, bool) {
+	k.mu.Lock()
+	ready := k.ready
+	k.mu.Unlock()
+	select {
+	case <-ready:
+	case <-ctx.Done():
+		return nil, false
+	}
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return k.keys, !k.unbounded
+}
+
+// Pruner returns an evaluator that, given a data object's metadata, waits
+// for the set to be published and returns true if the range from the
+// object's min to its max contains none of the keys.
+func (k *KeySet) Pruner(ctx context.Context) expr.Evaluator {
+	return &keySetPruner{
+		ctx:     ctx,
+		set:     k,
+		compare: expr.NewValueCompareFn(order.Asc, order.NullsLast),
+	}
+}
+
+type keySetPruner struct {
+	ctx     context.Context
+	set     *KeySet
+	compare expr.CompareFn
+}
+
+func (p *keySetPruner) Eval(_ expr.Context, this super.Value) super.Value {
+	keys, ok := p.set.wait(p.ctx)
+	if !ok {
+		return super.False
+	}
+	min, max := this.Deref("min"), this.Deref("max")
+	if min == nil || max == nil || min.IsNull() || max.IsNull() {
+		// An object with null keys may match null left keys.
+		return super.False
+	}
+	if p.compare(*min, *max) > 0 {
+		min, max = max, min
+	}
+	i, _ := slices.BinarySearchFunc(keys, *min, p.compare)
+	return super.NewBool(i == len(keys) || p.compare(keys[i], *max) > 0)
+}
@@ -0,0 +1,333 @@
+package join
+
+import (
+	"encoding/binary"
+	"fmt"
+	"slices"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/sup"
+	"github.com/brimdata/super/zbuf"
+	arc "github.com/hashicorp/golang-lru/arc/v2"
+	"github.com/segmentio/ksuid"
+)
+
+// DefaultLookupCacheSize is the default number of indexes held in a
+// LookupCache.
+const DefaultLookupCacheSize = 8
+
+// LookupCache holds the indexes built by lookup joins keyed by their right
+// inputs so that repeated queries joining with the same pool or file need
+// not read it again.  Since a key identifies the commit of each pool read by
+// the right input and the modification time and size of each file, a new
+// commit or a changed file causes the index to be rebuilt on its next use.
+type LookupCache struct {
+	lru *arc.ARCCache[string, *lookupIndex]
+}
+
+func NewLookupCache(size int) (*LookupCache, error) {
+	lru, err := arc.NewARC[string, *lookupIndex](size)
+	if err != nil {
+		return nil, err
+	}
+	return &LookupCache{lru: lru}, nil
+}
+
+// Len returns the number of indexes in the cache.
+func (c *LookupCache) Len() int {
+	return c.lru.Len()
+}
+
+// InvalidatePool removes the indexes built from the pool with the given ID
+// so that their memory is released once the pool's branch moves on.
+func (c *LookupCache) InvalidatePool(id ksuid.KSUID) {
+	for _, key := range c.lru.Keys() {
+		if index, ok := c.lru.Peek(key); ok && slices.Contains(index.pools, id) {
+			c.lru.Remove(key)
+		}
+	}
+}
+
+// lookupIndex is an immutable table of the values of a right input keyed by
+// the join key.  Its values have the types of its own type context so that
+// queries with other contexts may share it.
+type lookupIndex struct {
+	sctx  *super.Context
+	table map[string][]super.Value
+	pools []ksuid.KSUID
+}
+
+// LookupOp is a left join whose right input is read into an in-memory index
+// before the left input is pulled.  The right input must not depend on the
+// input of the join.  If the join has a LookupCache and a cache key, the
+// index is shared with later joins having the same key, which skip reading
+// the right input.  An index whose values exceed MemMaxBytes is an error.
+type LookupOp struct {
+	rctx        *runtime.Context
+	cache       *LookupCache
+	cacheKey    string
+	pools       []ksuid.KSUID
+	left        zbuf.Puller
+	right       zbuf.Puller
+	getLeftKey  expr.Evaluator
+	getRightKey expr.Evaluator
+	resetter    expr.Resetter
+	cutter      *expr.Cutter
+	splicer     *RecordSplicer
+	keyBuf      []byte
+
+	index *lookupIndex
+	// types maps the types of index to those of the query.
+	types map[super.Type]super.Type
+	batch zbuf.Batch
+	vals  []super.Value
+	// leftVal and matches are the left value being joined and the right
+	// values it has yet to be joined with when a Pull returns a full
+	// batch.  leftVal belongs to batch.
+	leftVal super.Value
+	matches []super.Value
+}
+
+// NewLookup returns a LookupOp.  The index is cached in cache under cacheKey
+// unless either is empty, and pools gives the IDs of the pools read by the
+// right input.
+func NewLookup(rctx *runtime.Context, cache *LookupCache, cacheKey string, pools []ksuid.KSUID, left, right zbuf.Puller,
+	leftKey, rightKey expr.Evaluator, lhs []*expr.Lval, rhs []expr.Evaluator, resetter expr.Resetter) *LookupOp {
+	if cache == nil {
+		cacheKey = ""
+	}
+	return &LookupOp{
+		rctx:        rctx,
+		cache:       cache,
+		cacheKey:    cacheKey,
+		pools:       pools,
+		left:        left,
+		right:       right,
+		getLeftKey:  leftKey,
+		getRightKey: rightKey,
+		resetter:    resetter,
+		cutter:      expr.NewCutter(rctx.Sctx, lhs, rhs),
+		splicer:     NewRecordSplicer(rctx.Sctx),
+	}
+}
+
+func (o *LookupOp) Pull(done bool) (zbuf.Batch, error) {
+	if done {
+		err := o.done()
+		o.reset()
+		return nil, err
+	}
+	if o.index == nil {
+		index, err := o.load()
+		if err != nil {
+			o.reset()
+			return nil, err
+		}
+		o.index = index
+		o.types = make(map[super.Type]super.Type)
+	}
+	batch, err := o.probe()
+	if batch == nil || err != nil {
+		o.reset()
+	}
+	return batch, err
+}
+
+// done sends done to the left input and, if the index has not been loaded,
+// to the right input.
+func (o *LookupOp) done() error {
+	if o.index == nil {
+		if _, err := o.right.Pull(true); err != nil {
+			return err
+		}
+	}
+	_, err := o.left.Pull(true)
+	return err
+}
+
+// load returns the index from the cache or, if it is not there, builds it
+// from the right input and adds it to the cache.
+func (o *LookupOp) load() (*lookupIndex, error) {
+	if o.cacheKey != "" {
+		if index, ok := o.cache.lru.Get(o.cacheKey); ok {
+			// The right input need not be read.
+			if _, err := o.right.Pull(true); err != nil {
+				return nil, err
+			}
+			return index, nil
+		}
+	}
+	index := &lookupIndex{
+		sctx:  super.NewContext(),
+		table: make(map[string][]super.Value),
+		pools: o.pools,
+	}
+	types := make(map[super.Type]super.Type)
+	var nbytes int
+	// See #3366
+	ectx := expr.NewContext()
+	for {
+		batch, err := o.right.Pull(false)
+		if batch == nil || err != nil {
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+		for _, val := range batch.Values() {
+			key, ok := o.key(ectx, o.getRightKey, val)
+			if !ok {
+				continue
+			}
+			typ, err := translateType(index.sctx, types, val.Type())
+			if err != nil {
+				batch.Unref()
+				return nil, err
+			}
+			index.table[key] = append(index.table[key], super.NewValue(typ, slices.Clone(val.Bytes())))
+			nbytes += len(key) + len(val.Bytes())
+			if nbytes > MemMaxBytes {
+				batch.Unref()
+				return nil, fmt.Errorf("lookup join: right input exceeds %d bytes", MemMaxBytes)
+			}
+		}
+		batch.Unref()
+	}
+	if o.cacheKey != "" {
+		o.cache.lru.Add(o.cacheKey, index)
+	}
+	return index, nil
+}
+
+func (o *LookupOp) probe() (zbuf.Batch, error) {
+	var out *zbuf.ArenaBatch
+	// See #3366
+	ectx := expr.NewContext()
+	for {
+		if len(o.matches) > 0 {
+			if out == nil {
+				out = zbuf.NewArenaBatch(nil)
+			}
+			full, err := o.splice(ectx, out)
+			if err != nil {
+				out.Unref()
+				return nil, err
+			}
+			if full {
+				return out, nil
+			}
+			continue
+		}
+		if len(o.vals) == 0 {
+			if o.batch != nil {
+				o.batch.Unref()
+				o.batch = nil
+			}
+			batch, err := o.left.Pull(false)
+			if err != nil {
+				if out != nil {
+					out.Unref()
+				}
+				return nil, err
+			}
+			if batch == nil {
+				if out == nil {
+					return nil, nil
+				}
+				return out, nil
+			}
+			o.batch, o.vals = batch, batch.Values()
+		}
+		leftVal := o.vals[0]
+		o.vals = o.vals[1:]
+		key, ok := o.key(ectx, o.getLeftKey, leftVal)
+		if !ok {
+			// As for the merge join, drop left values whose
+			// key is missing.
+			continue
+		}
+		rightVals, ok := o.index.table[key]
+		if !ok {
+			if out == nil {
+				out = zbuf.NewArenaBatch(nil)
+			}
+			if out.Append(leftVal) {
+				return out, nil
+			}
+			continue
+		}
+		o.leftVal, o.matches = leftVal, rightVals
+	}
+}
+
+// splice appends the joins of o.leftVal with o.matches to out until out is
+// full and returns whether it is.
+func (o *LookupOp) splice(ectx expr.Context, out *zbuf.ArenaBatch) (bool, error) {
+	for len(o.matches) > 0 {
+		typ, err := translateType(o.rctx.Sctx, o.types, o.matches[0].Type())
+		if err != nil {
+			return false, err
+		}
+		cutVal := o.cutter.Eval(ectx, super.NewValue(typ, o.matches[0].Bytes()))
+		val, err := o.splicer.Splice(o.leftVal, cutVal)
+		if err != nil {
+			return false, err
+		}
+		o.matches = o.matches[1:]
+		if out.Append(val) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// key returns the index key of the value of e for val and false if that
+// value is missing.  The key of a null is empty.  Unlike the key of a
+// HashOp, it does not depend on the type context of val.
+func (o *LookupOp) key(ectx expr.Context, e expr.Evaluator, val super.Value) (string, bool) {
+	keyVal := expr.QueryCollation.Key(e.Eval(ectx, val))
+	if keyVal.IsMissing() {
+		return "", false
+	}
+	if keyVal.IsNull() {
+		// As for the merge join, nulls of any type match.
+		return "", true
+	}
+	keyVal = NumericKey(keyVal)
+	if id := keyVal.Type().ID(); id < super.IDTypeComplex {
+		o.keyBuf = binary.LittleEndian.AppendUint32(o.keyBuf[:0], uint32(id))
+	} else {
+		o.keyBuf = binary.LittleEndian.AppendUint32(o.keyBuf[:0], uint32(super.IDTypeComplex))
+		o.keyBuf = append(o.keyBuf, sup.FormatType(keyVal.Type())...)
+		o.keyBuf = append(o.keyBuf, 0)
+	}
+	o.keyBuf = append(o.keyBuf, keyVal.Bytes()...)
+	return string(o.keyBuf), true
+}
+
+func (o *LookupOp) reset() {
+	o.index = nil
+	o.types = nil
+	if o.batch != nil {
+		o.batch.Unref()
+		o.batch = nil
+	}
+	o.vals = nil
+	o.matches = nil
+	o.resetter.Reset()
+}
+
+// translateType returns the type in sctx of typ, memoizing it in types.
+func translateType(sctx *super.Context, types map[super.Type]super.Type, typ super.Type) (super.Type, error) {
+	if t, ok := types[typ]; ok {
+		return t, nil
+	}
+	t, err := sctx.TranslateType(typ)
+	if err != nil {
+		return nil, err
+	}
+	types[typ] = t
+	return t, nil
+}
@@ -3,38 +3,71 @@ package join
 import (
 	"context"
 
+	"github.com/brimdata/super"
 	"github.com/brimdata/super/runtime/sam/op"
 	"github.com/brimdata/super/zbuf"
-	"github.com/brimdata/super/zio"
 )
 
+// puller pulls from a parent of a join in its own goroutine so that the
+// parent's next batch is pulled while the join works on the last one.
 type puller struct {
-	zio.Reader
-	op  zbuf.Puller
-	ctx context.Context
-	ch  chan op.Result
+	op     zbuf.Puller
+	ctx    context.Context
+	ch     chan op.Result
+	doneCh chan struct{}
+	// running is true from start until Pull returns an EOS or error or
+	// done returns.
+	running bool
+	// batch and vals are the batch being read by Read and its values yet
+	// to be read.
+	batch zbuf.Batch
+	vals  []super.Value
 }
 
 func newPuller(p zbuf.Puller, ctx context.Context) *puller {
-	puller := &puller{
-		op:  p,
-		ctx: ctx,
-		ch:  make(chan op.Result),
+	return &puller{
+		op:     p,
+		ctx:    ctx,
+		doneCh: make(chan struct{}),
 	}
-	puller.Reader = zbuf.PullerReader(puller)
-	return puller
 }
 
-func (p *puller) run() {
+// start starts the goroutine pulling the parent's current platoon.
+func (p *puller) start() {
+	ch := make(chan op.Result)
+	p.ch = ch
+	p.running = true
+	go p.run(ch)
+}
+
+func (p *puller) run(ch chan op.Result) {
 	for {
 		batch, err := p.op.Pull(false)
 		select {
-		case p.ch <- op.Result{Batch: batch, Err: err}:
+		case ch <- op.Result{Batch: batch, Err: err}:
 			if batch == nil || err != nil {
-				close(p.ch)
+				close(ch)
 				return
 			}
+		case <-p.doneCh:
+			// The join is done with the platoon.  If the parent
+			// has not reached its EOS, drop the batch and pass the
+			// done upstream.
+			err = nil
+			if batch != nil {
+				batch.Unref()
+				_, err = p.op.Pull(true)
+			}
+			select {
+			case ch <- op.Result{Err: err}:
+				close(ch)
+			case <-p.ctx.Done():
+			}
+			return
 		case <-p.ctx.Done():
+			if batch != nil {
+				batch.Unref()
+			}
 			return
 		}
 	}
@@ -43,8 +76,124 @@ func (p *puller) run() {
 func (p *puller) Pull(done bool) (zbuf.Batch, error) {
 	select {
 	case res := <-p.ch:
-		return res.Batch, res.Err
+		return p.result(res)
 	case <-p.ctx.Done():
 		return nil, p.ctx.Err()
 	}
 }
+
+// result returns the batch and error of res, which was received from p.ch,
+// noting whether the goroutine of p has ended.
+func (p *puller) result(res op.Result) (zbuf.Batch, error) {
+	if res.Batch == nil || res.Err != nil {
+		p.running = false
+	}
+	return res.Batch, res.Err
+}
+
+// Read returns the next value of the parent, which belongs to a batch that
+// is held until the values of that batch have been read or done is called.
+func (p *puller) Read() (*super.Value, error) {
+	// Loop handles zero-length batches.
+	for len(p.vals) == 0 {
+		p.drop()
+		batch, err := p.Pull(false)
+		if batch == nil || err != nil {
+			return nil, err
+		}
+		p.batch, p.vals = batch, batch.Values()
+	}
+	val := &p.vals[0]
+	p.vals = p.vals[1:]
+	return val, nil
+}
+
+// done drops the batch being read and, if the goroutine of p is running,
+// tells it that the join is done with the platoon and waits for it to pass
+// the done to the parent, returning the parent's error.
+func (p *puller) done() error {
+	p.drop()
+	if !p.running {
+		return nil
+	}
+	p.running = false
+	select {
+	case p.doneCh <- struct{}{}:
+	case <-p.ctx.Done():
+		return p.ctx.Err()
+	}
+	select {
+	case res := <-p.ch:
+		return res.Err
+	case <-p.ctx.Done():
+		return p.ctx.Err()
+	}
+}
+
+func (p *puller) drop() {
+	if p.batch != nil {
+		p.batch.Unref()
+		p.batch = nil
+	}
+	p.vals = nil
+}
+
+// inputs are the left and right inputs of a join, whose goroutines are
+// started by the first Pull of each platoon.  A done from downstream, an
+// error, or the end of the input driving the join ends the platoon: the
+// join passes a done to each input that has not reached its EOS, waits for
+// the done to reach the input's parent, and calls reset to release what it
+// holds, so that the next Pull starts the next platoon.
+type inputs struct {
+	left    *puller
+	right   *puller
+	running bool
+	reset   func()
+}
+
+func newInputs(ctx context.Context, left, right zbuf.Puller, reset func()) inputs {
+	return inputs{
+		left:  newPuller(left, ctx),
+		right: newPuller(right, ctx),
+		reset: reset,
+	}
+}
+
+// start starts the goroutines of the inputs if the platoon has not started.
+func (i *inputs) start() {
+	if !i.running {
+		i.left.start()
+		i.right.start()
+		i.running = true
+	}
+}
+
+// done ends the platoon, passing a done to each input that has not reached
+// its EOS.  If the platoon has not started, the done is passed to the
+// parents of the inputs directly.
+func (i *inputs) done() error {
+	var err error
+	if i.running {
+		err = i.left.done()
+		if rightErr := i.right.done(); err == nil {
+			err = rightErr
+		}
+	} else {
+		_, err = i.left.op.Pull(true)
+		if _, rightErr := i.right.op.Pull(true); err == nil {
+			err = rightErr
+		}
+	}
+	i.running = false
+	i.reset()
+	return err
+}
+
+// fail ends the platoon after err and returns err.
+func (i *inputs) fail(err error) error {
+	i.left.done()
+	i.right.done()
+	i.running = false
+	i.reset()
+	return err
+}
@@ -17,10 +17,14 @@ type Op struct {
 	author  string
 	message string
 	meta    string
+	vectors bool
 	done    bool
 }
 
-func New(rctx *runtime.Context, lk *lake.Root, parent zbuf.Puller, pool ksuid.KSUID, branch, author, message, meta string) *Op {
+// New returns an Op that loads the values pulled from parent into the
+// branch of pool in a single commit.  If vectors is true, the vector form
+// of each new data object is written and committed as well.
+func New(rctx *runtime.Context, lk *lake.Root, parent zbuf.Puller, pool ksuid.KSUID, branch, author, message, meta string, vectors bool) *Op {
 	return &Op{
 		rctx:    rctx,
 		lk:      lk,
@@ -30,6 +34,7 @@ func New(rctx *runtime.Context, lk *lake.Root, parent zbuf.Puller, pool ksuid.KS
 		author:  author,
 		message: message,
 		meta:    meta,
+		vectors: vectors,
 	}
 }
 
@@ -62,7 +67,12 @@ func (o *Op) Pull(done bool) (zbuf.Batch, error) {
 	if err != nil {
 		return nil, err
 	}
-	commitID, err := branch.Load(o.rctx.Context, o.rctx.Sctx, reader, o.author, o.message, o.meta)
+	var commitID ksuid.KSUID
+	if o.vectors {
+		commitID, err = branch.LoadVectors(o.rctx.Context, o.rctx.Sctx, reader, o.author, o.message, o.meta)
+	} else {
+		commitID, err = branch.Load(o.rctx.Context, o.rctx.Sctx, reader, o.author, o.message, o.meta, nil)
+	}
 	if err != nil {
 		return nil, err
 	}
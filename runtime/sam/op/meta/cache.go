@@ -0,0 +1,37 @@
+package meta
+
+import (
+	arc "github.com/hashicorp/golang-lru/arc/v2"
+	"github.com/segmentio/ksuid"
+)
+
+// DefaultPartitionCacheSize is the default number of snapshots whose
+// partitions are held in a PartitionCache.
+const DefaultPartitionCacheSize = 256
+
+// PartitionCache holds the partitions computed by a Slicer keyed by pool,
+// commit, and sort key so that repeated queries over the same snapshot can
+// skip sorting and slicing its data objects.  Since a commit's snapshot is
+// immutable, entries never need to be invalidated.
+type PartitionCache struct {
+	lru *arc.ARCCache[partitionKey, []Partition]
+}
+
+type partitionKey struct {
+	pool    ksuid.KSUID
+	commit  ksuid.KSUID
+	sortKey string
+}
+
+func NewPartitionCache(size int) (*PartitionCache, error) {
+	lru, err := arc.NewARC[partitionKey, []Partition](size)
+	if err != nil {
+		return nil, err
+	}
+	return &PartitionCache{lru: lru}, nil
+}
+
+// Len returns the number of snapshots in the cache.
+func (p *PartitionCache) Len() int {
+	return p.lru.Len()
+}
@@ -25,8 +25,11 @@ import (
 type Lister struct {
 	ctx       context.Context
 	pool      *lake.Pool
+	commit    ksuid.KSUID
 	snap      commits.View
 	pruner    *pruner
+	unordered bool
+	reverse   bool
 	group     *errgroup.Group
 	marshaler *sup.MarshalBSUPContext
 	mu        sync.Mutex
@@ -41,7 +44,32 @@ func NewSortedLister(ctx context.Context, sctx *super.Context, pool *lake.Pool,
 	if err != nil {
 		return nil, err
 	}
-	return NewSortedListerFromSnap(ctx, sctx, pool, snap, pruner), nil
+	l := NewSortedListerFromSnap(ctx, sctx, pool, snap, pruner)
+	l.commit = commit
+	return l, nil
+}
+
+// NewUnorderedLister returns a Lister that enumerates the data objects of
+// the snapshot at commit in no particular order.  It is used when the
+// downstream flowgraph is insensitive to order and no Slicer is needed.
+func NewUnorderedLister(ctx context.Context, sctx *super.Context, pool *lake.Pool, commit ksuid.KSUID, pruner expr.Evaluator) (*Lister, error) {
+	l, err := NewSortedLister(ctx, sctx, pool, commit, pruner)
+	if err != nil {
+		return nil, err
+	}
+	l.unordered = true
+	return l, nil
+}
+
+// NewReverseLister returns a Lister that enumerates the data objects of the
+// snapshot at commit in the reverse of the pool's sort order.
+func NewReverseLister(ctx context.Context, sctx *super.Context, pool *lake.Pool, commit ksuid.KSUID, pruner expr.Evaluator) (*Lister, error) {
+	l, err := NewSortedLister(ctx, sctx, pool, commit, pruner)
+	if err != nil {
+		return nil, err
+	}
+	l.reverse = true
+	return l, nil
 }
 
 func NewSortedListerByID(ctx context.Context, sctx *super.Context, r *lake.Root, poolID, commit ksuid.KSUID, pruner expr.Evaluator) (*Lister, error) {
@@ -72,6 +100,19 @@ func (l *Lister) Snapshot() commits.View {
 	return l.snap
 }
 
+// partitionKey returns the key of the Lister's partitions in a PartitionCache
+// and false if the Lister's output cannot be cached.
+func (l *Lister) partitionKey() (partitionKey, bool) {
+	if l.commit == ksuid.Nil || l.pruner != nil || l.reverse {
+		return partitionKey{}, false
+	}
+	return partitionKey{
+		pool:    l.pool.ID,
+		commit:  l.commit,
+		sortKey: l.pool.SortKeys.Primary().String(),
+	}, true
+}
+
 func (l *Lister) Pull(done bool) (zbuf.Batch, error) {
 	l.mu.Lock()
 	defer l.mu.Unlock()
@@ -79,7 +120,15 @@ func (l *Lister) Pull(done bool) (zbuf.Batch, error) {
 		return nil, l.err
 	}
 	if l.objects == nil {
-		l.objects = initObjectScan(l.snap, l.pool.SortKeys.Primary())
+		sortKey := l.pool.SortKeys.Primary()
+		switch {
+		case l.unordered:
+			l.objects = l.snap.SelectAll()
+		case l.reverse:
+			l.objects = initObjectScan(l.snap, order.NewSortKey(!sortKey.Order, sortKey.Key))
+		default:
+			l.objects = initObjectScan(l.snap, sortKey)
+		}
 	}
 	for len(l.objects) != 0 {
 		o := l.objects[0]
@@ -0,0 +1,85 @@
+package meta
+
+import (
+	"slices"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/lake/commits"
+	"github.com/brimdata/super/lake/data"
+	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/sup"
+	"github.com/brimdata/super/zio"
+	"github.com/segmentio/ksuid"
+)
+
+// An Overlap describes how the data objects of a Partition overlap, which
+// determines the merge-on-read work needed to scan the partition in order.
+// Objects is the number of objects in the partition, Depth is the largest
+// number of objects whose key ranges include a common key, and Size is the
+// total size of the objects.  A partition with more than one object is a
+// candidate for compaction.
+type Overlap struct {
+	Min     super.Value   `super:"min"`
+	Max     super.Value   `super:"max"`
+	Objects int           `super:"objects"`
+	Depth   int           `super:"depth"`
+	Size    int64         `super:"size"`
+	IDs     []ksuid.KSUID `super:"ids"`
+}
+
+func NewOverlap(p Partition) Overlap {
+	o := Overlap{
+		Min:     p.Min,
+		Max:     p.Max,
+		Objects: len(p.Objects),
+		Depth:   overlapDepth(p.Objects),
+	}
+	for _, object := range p.Objects {
+		o.Size += object.Size
+		o.IDs = append(o.IDs, object.ID)
+	}
+	return o
+}
+
+// overlapDepth returns the largest number of objects whose key ranges
+// include a common key.
+func overlapDepth(objects []*data.Object) int {
+	type event struct {
+		key   super.Value
+		delta int
+	}
+	events := make([]event, 0, 2*len(objects))
+	for _, o := range objects {
+		events = append(events, event{o.Min, 1}, event{o.Max, -1})
+	}
+	cmp := expr.NewValueCompareFn(order.Asc, order.NullsLast)
+	slices.SortStableFunc(events, func(a, b event) int {
+		if c := cmp(a.key, b.key); c != 0 {
+			return c
+		}
+		// Key ranges are inclusive so an object starting at a key
+		// overlaps an object ending at the same key.
+		return b.delta - a.delta
+	})
+	var depth, n int
+	for _, e := range events {
+		n += e.delta
+		depth = max(depth, n)
+	}
+	return depth
+}
+
+func overlapReader(sctx *super.Context, snap commits.View, sortKey order.SortKey) (zio.Reader, error) {
+	partitions := NewSlicer(nil, sctx).partitionsOf(initObjectScan(snap, sortKey))
+	m := sup.NewBSUPMarshalerWithContext(sctx)
+	m.Decorate(sup.StylePackage)
+	return readerFunc(func() (*super.Value, error) {
+		if len(partitions) == 0 {
+			return nil, nil
+		}
+		val, err := m.Marshal(NewOverlap(partitions[0]))
+		partitions = partitions[1:]
+		return &val, err
+	}), nil
+}
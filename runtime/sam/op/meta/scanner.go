@@ -23,6 +23,8 @@ func NewLakeMetaScanner(ctx context.Context, sctx *super.Context, r *lake.Root,
 		vals, err = r.BatchifyPools(ctx, sctx, nil)
 	case "branches":
 		vals, err = r.BatchifyBranches(ctx, sctx, nil)
+	case "externals":
+		vals, err = r.BatchifyExternals(ctx, sctx)
 	default:
 		return nil, fmt.Errorf("unknown lake metadata type: %q", meta)
 	}
@@ -74,6 +76,16 @@ func NewCommitMetaScanner(ctx context.Context, sctx *super.Context, r *lake.Root
 			return nil, err
 		}
 		return zbuf.NewScanner(ctx, zbuf.PullerReader(slicer), nil)
+	case "overlaps":
+		snap, err := p.Snapshot(ctx, commit)
+		if err != nil {
+			return nil, err
+		}
+		reader, err := overlapReader(sctx, snap, p.SortKeys.Primary())
+		if err != nil {
+			return nil, err
+		}
+		return zbuf.NewScanner(ctx, reader, nil)
 	case "log":
 		tips, err := p.BatchifyBranchTips(ctx, sctx, nil)
 		if err != nil {
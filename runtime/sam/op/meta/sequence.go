@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"io"
+	"slices"
 
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/lake"
@@ -29,6 +30,7 @@ type SequenceScanner struct {
 	pool        *lake.Pool
 	progress    *zbuf.Progress
 	unmarshaler *sup.UnmarshalBSUPContext
+	reverse     bool
 	done        bool
 	err         error
 }
@@ -45,6 +47,15 @@ func NewSequenceScanner(rctx *runtime.Context, parent zbuf.Puller, pool *lake.Po
 	}
 }
 
+// NewReverseSequenceScanner returns a SequenceScanner that scans the
+// partitions pulled from parent, which are ordered by a reverse Lister, and
+// returns the values of each partition in the reverse of the pool's sort order.
+func NewReverseSequenceScanner(rctx *runtime.Context, parent zbuf.Puller, pool *lake.Pool, pushdown zbuf.Pushdown, pruner expr.Evaluator, progress *zbuf.Progress) *SequenceScanner {
+	s := NewSequenceScanner(rctx, parent, pool, pushdown, pruner, progress)
+	s.reverse = true
+	return s
+}
+
 func (s *SequenceScanner) Pull(done bool) (zbuf.Batch, error) {
 	if s.done {
 		return nil, s.err
@@ -76,6 +87,9 @@ func (s *SequenceScanner) Pull(done bool) (zbuf.Batch, error) {
 				s.close(err)
 				return nil, err
 			}
+			if s.reverse {
+				s.scanner = &reverseScanner{parent: s.scanner}
+			}
 		}
 		batch, err := s.scanner.Pull(false)
 		if err != nil {
@@ -94,6 +108,42 @@ func (s *SequenceScanner) close(err error) {
 	s.done = true
 }
 
+// reverseScanner pulls all of the values of a partition from its parent
+// and returns them in a single batch in reverse order.
+type reverseScanner struct {
+	parent zbuf.Puller
+	done   bool
+}
+
+func (r *reverseScanner) Pull(done bool) (zbuf.Batch, error) {
+	if r.done {
+		return nil, nil
+	}
+	r.done = true
+	if done {
+		return r.parent.Pull(true)
+	}
+	var vals []super.Value
+	for {
+		batch, err := r.parent.Pull(false)
+		if err != nil {
+			return nil, err
+		}
+		if batch == nil {
+			break
+		}
+		for _, val := range batch.Values() {
+			vals = append(vals, val.Copy())
+		}
+		batch.Unref()
+	}
+	if len(vals) == 0 {
+		return nil, nil
+	}
+	slices.Reverse(vals)
+	return zbuf.NewArray(vals), nil
+}
+
 type SearchScanner struct {
 	pushdown zbuf.Pushdown
 	parent   Searcher
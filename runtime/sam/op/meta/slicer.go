@@ -3,7 +3,7 @@ package meta
 import (
 	"errors"
 	"fmt"
-	"sync"
+	"slices"
 
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/lake/commits"
@@ -16,7 +16,8 @@ import (
 
 // Slicer implements an op that pulls data objects and organizes
 // them into overlapping object Slices forming a sequence of
-// non-overlapping Partitions.
+// non-overlapping Partitions.  A Slicer is not safe for concurrent use.
+// Parallel scans of its partitions pull from it via a scatter operator.
 type Slicer struct {
 	parent      zbuf.Puller
 	marshaler   *sup.MarshalBSUPContext
@@ -25,7 +26,9 @@ type Slicer struct {
 	cmp         expr.CompareFn
 	min         *super.Value
 	max         *super.Value
-	mu          sync.Mutex
+	cache       *PartitionCache
+	partitions  []Partition
+	off         int
 }
 
 func NewSlicer(parent zbuf.Puller, sctx *super.Context) *Slicer {
@@ -40,25 +43,36 @@ func NewSlicer(parent zbuf.Puller, sctx *super.Context) *Slicer {
 	}
 }
 
+// NewCachedSlicer returns a Slicer that looks up the partitions of parent's
+// snapshot in cache and computes and adds them to cache on a miss.  If cache
+// is nil or parent cannot be cached (e.g., because it prunes objects), the
+// Slicer computes the partitions as NewSlicer does.
+func NewCachedSlicer(parent zbuf.Puller, sctx *super.Context, cache *PartitionCache) *Slicer {
+	s := NewSlicer(parent, sctx)
+	if lister, ok := parent.(*Lister); ok && cache != nil {
+		if _, ok := lister.partitionKey(); ok {
+			s.cache = cache
+		}
+	}
+	return s
+}
+
 func (s *Slicer) Snapshot() commits.View {
 	//XXX
 	return s.parent.(*Lister).Snapshot()
 }
 
 func (s *Slicer) Pull(done bool) (zbuf.Batch, error) {
-	//XXX for now we use a mutex because multiple downstream trunks can call
-	// Pull concurrently here.  We should change this to use a fork.  But for now,
-	// this does not seem like a performance critical issue because the bottleneck
-	// will be each trunk and the lister parent should run fast in comparison.
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	if s.cache != nil {
+		return s.pullCached(done)
+	}
 	for {
 		batch, err := s.parent.Pull(done)
 		if err != nil {
 			return nil, err
 		}
 		if batch == nil {
-			return s.nextPartition()
+			return s.marshal(s.nextPartition())
 		}
 		vals := batch.Values()
 		if len(vals) != 1 {
@@ -69,18 +83,65 @@ func (s *Slicer) Pull(done bool) (zbuf.Batch, error) {
 		if err := s.unmarshaler.Unmarshal(vals[0], &object); err != nil {
 			return nil, err
 		}
-		if batch, err := s.stash(&object); batch != nil || err != nil {
-			return batch, err
+		if p := s.stash(&object); p != nil {
+			return s.marshal(p)
 		}
 	}
 }
 
-// nextPartition takes collected up slices and forms a partition returning
-// a batch containing a single value comprising the serialized partition.
-func (s *Slicer) nextPartition() (zbuf.Batch, error) {
-	if len(s.objects) == 0 {
+func (s *Slicer) pullCached(done bool) (zbuf.Batch, error) {
+	if s.partitions == nil {
+		lister := s.parent.(*Lister)
+		key, _ := lister.partitionKey()
+		partitions, ok := s.cache.lru.Get(key)
+		if !ok {
+			partitions = s.partitionsOf(initObjectScan(lister.snap, lister.pool.SortKeys.Primary()))
+			s.cache.lru.Add(key, partitions)
+		}
+		s.partitions = partitions
+	}
+	if done {
+		s.off = len(s.partitions)
+	}
+	if s.off >= len(s.partitions) {
+		return nil, nil
+	}
+	p := &s.partitions[s.off]
+	s.off++
+	return s.marshal(p)
+}
+
+func (s *Slicer) marshal(p *Partition) (zbuf.Batch, error) {
+	if p == nil {
 		return nil, nil
 	}
+	val, err := s.marshaler.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return zbuf.NewArray([]super.Value{val}), nil
+}
+
+// partitionsOf returns the partitions formed by objects, which must be
+// sorted as by a Lister.
+func (s *Slicer) partitionsOf(objects []*data.Object) []Partition {
+	partitions := []Partition{}
+	for _, o := range objects {
+		if p := s.stash(o); p != nil {
+			partitions = append(partitions, *p)
+		}
+	}
+	if p := s.nextPartition(); p != nil {
+		partitions = append(partitions, *p)
+	}
+	return partitions
+}
+
+// nextPartition takes collected up slices and forms a partition.
+func (s *Slicer) nextPartition() *Partition {
+	if len(s.objects) == 0 {
+		return nil
+	}
 	//XXX let's keep this as we go!... need to reorder stuff in stash() to make this work
 	min := s.objects[0].Min
 	max := s.objects[0].Max
@@ -92,30 +153,23 @@ func (s *Slicer) nextPartition() (zbuf.Batch, error) {
 			max = o.Max
 		}
 	}
-	val, err := s.marshaler.Marshal(&Partition{
+	p := &Partition{
 		Min:     min,
 		Max:     max,
-		Objects: s.objects,
-	})
-	s.objects = s.objects[:0]
-	if err != nil {
-		return nil, err
+		Objects: slices.Clone(s.objects),
 	}
-	return zbuf.NewArray([]super.Value{val}), nil
+	s.objects = s.objects[:0]
+	return p
 }
 
-func (s *Slicer) stash(o *data.Object) (zbuf.Batch, error) {
-	var batch zbuf.Batch
+func (s *Slicer) stash(o *data.Object) *Partition {
+	var p *Partition
 	if len(s.objects) > 0 {
 		// We collect all the subsequent objects that overlap with any object in the
 		// accumulated set so far.  Since first times are non-decreasing this is
 		// guaranteed to generate partitions that are non-decreasing and non-overlapping.
 		if s.cmp(o.Max, *s.min) < 0 || s.cmp(o.Min, *s.max) > 0 {
-			var err error
-			batch, err = s.nextPartition()
-			if err != nil {
-				return nil, err
-			}
+			p = s.nextPartition()
 			s.min = nil
 			s.max = nil
 		}
@@ -127,7 +181,7 @@ func (s *Slicer) stash(o *data.Object) (zbuf.Batch, error) {
 	if s.max == nil || s.cmp(*s.max, o.Max) < 0 {
 		s.max = o.Max.Copy().Ptr()
 	}
-	return batch, nil
+	return p
 }
 
 // A Partition is a logical view of the records within a pool-key span, stored
@@ -0,0 +1,79 @@
+package meta
+
+import (
+	"context"
+	"io"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/lake"
+	"github.com/brimdata/super/lake/data"
+	"github.com/brimdata/super/zbuf"
+	"github.com/brimdata/super/zio/bsupio"
+	"github.com/segmentio/ksuid"
+)
+
+// NewSummaryScanner returns a scanner of the partial results of the pool's
+// kth summary aggregation over the data objects in commit.  Each object
+// in the commit must have its summary computed.
+func NewSummaryScanner(ctx context.Context, sctx *super.Context, r *lake.Root, poolID, commit ksuid.KSUID, k int) (zbuf.Scanner, error) {
+	p, err := r.OpenPool(ctx, poolID)
+	if err != nil {
+		return nil, err
+	}
+	snap, err := p.Snapshot(ctx, commit)
+	if err != nil {
+		return nil, err
+	}
+	reader := &summaryReader{
+		ctx:     ctx,
+		sctx:    sctx,
+		pool:    p,
+		objects: snap.SelectAll(),
+		k:       k,
+	}
+	return zbuf.NewScanner(ctx, reader, nil)
+}
+
+type summaryReader struct {
+	ctx     context.Context
+	sctx    *super.Context
+	pool    *lake.Pool
+	objects []*data.Object
+	k       int
+	closer  io.Closer
+	reader  *bsupio.Reader
+}
+
+func (s *summaryReader) Read() (*super.Value, error) {
+	for {
+		if s.reader == nil {
+			if len(s.objects) == 0 {
+				return nil, nil
+			}
+			o := s.objects[0]
+			s.objects = s.objects[1:]
+			get, err := s.pool.Storage().Get(s.ctx, data.SummaryURI(s.pool.DataPath, o.ID, s.k))
+			if err != nil {
+				return nil, err
+			}
+			s.closer = get
+			s.reader = bsupio.NewReader(s.sctx, get)
+		}
+		val, err := s.reader.Read()
+		if val != nil || err != nil {
+			return val, err
+		}
+		if err := s.close(); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (s *summaryReader) close() error {
+	err := s.reader.Close()
+	if err2 := s.closer.Close(); err == nil {
+		err = err2
+	}
+	s.reader, s.closer = nil, nil
+	return err
+}
@@ -2,6 +2,7 @@ package op
 
 import (
 	"context"
+	"reflect"
 	"slices"
 	"sync"
 
@@ -156,6 +157,43 @@ func (r *Router) Send(p zbuf.Puller, b zbuf.Batch, err error) bool {
 	}
 }
 
+// SendAny sends b to the first unblocked route that is ready to receive it.
+// If every route is blocked, the batch is dropped.
+func (r *Router) SendAny(b zbuf.Batch) bool {
+	if b == nil {
+		panic("EOS sent through router send API")
+	}
+	for {
+		var routes []*route
+		var cases []reflect.SelectCase
+		for _, p := range r.routes {
+			if p.blocked {
+				continue
+			}
+			routes = append(routes, p)
+			cases = append(cases,
+				reflect.SelectCase{Dir: reflect.SelectSend, Chan: reflect.ValueOf(p.resultCh), Send: reflect.ValueOf(Result{Batch: b})},
+				reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(p.doneCh)})
+		}
+		if len(routes) == 0 {
+			b.Unref()
+			return true
+		}
+		cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(r.ctx.Done())})
+		chosen, _, _ := reflect.Select(cases)
+		switch {
+		case chosen == len(cases)-1:
+			return false
+		case chosen%2 == 0:
+			return true
+		default:
+			// As in Send, a route that signals done while we're trying
+			// to write is marked blocked and we try the other routes.
+			routes[chosen/2].blocked = true
+		}
+	}
+}
+
 type route struct {
 	router   *Router
 	resultCh chan Result
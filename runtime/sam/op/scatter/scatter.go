@@ -0,0 +1,39 @@
+package scatter
+
+import (
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/sam/op"
+	"github.com/brimdata/super/zbuf"
+)
+
+// Op distributes the batches of its parent across its exits such that
+// each batch is sent to just one exit, namely, the first exit ready to
+// receive it.  This lets the parallel trunks of a scatter pull distinct
+// input (e.g., the partitions of a pool scan) concurrently while the parent
+// is pulled from a single goroutine.
+type Op struct {
+	router *op.Router
+}
+
+func New(rctx *runtime.Context, parent zbuf.Puller) *Op {
+	o := &Op{router: op.NewRouter(rctx, parent)}
+	o.router.Link(distributor{})
+	return o
+}
+
+func (o *Op) AddExit() zbuf.Puller {
+	return o.router.AddRoute()
+}
+
+// A distributor implements op.Selector by sending each batch to any one of
+// the downstream legs of the flowgraph.
+type distributor struct{}
+
+var _ op.Selector = (*distributor)(nil)
+
+func (distributor) Forward(r *op.Router, b zbuf.Batch) bool {
+	b.Ref()
+	return r.SendAny(b)
+}
+
+func (distributor) Reset() {}
@@ -0,0 +1,204 @@
+package sequence
+
+import (
+	"encoding/binary"
+	"errors"
+	"slices"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/zbuf"
+)
+
+// Op matches steps, which are Boolean expressions, in order among the
+// values with the same grouping keys whose times fall within a window, as
+// in a funnel of the events of a product or the stages of an attack.  Its
+// input is expected to be ordered by time within each group.  For each
+// match, Op outputs a record holding the keys followed by the time of the
+// value matched by each step.
+//
+// Each group holds the latest partial match of each step, i.e., the one
+// whose first value is latest, so a match spans the shortest window
+// possible.  A value advances the partial match of the previous step that
+// it matches, and once the last step is matched, the partial matches that
+// share values with the match are discarded so that no value belongs to
+// more than one match.
+type Op struct {
+	rctx     *runtime.Context
+	parent   zbuf.Puller
+	resetter expr.Resetter
+	keys     []expr.Evaluator
+	steps    []expr.Evaluator
+	time     expr.Evaluator
+	window   nano.Duration
+	builder  *super.RecordBuilder
+	types    []super.Type
+	groups   map[string]*group
+	cache    []byte
+	// seq numbers the values consumed so that a value matched by more
+	// than one partial match can be identified.
+	seq uint64
+}
+
+type group struct {
+	keys []super.Value
+	// partials[i] holds the values matched by the latest partial match of
+	// steps 0 through i or is nil.
+	partials [][]event
+}
+
+type event struct {
+	seq uint64
+	ts  nano.Ts
+}
+
+func New(rctx *runtime.Context, parent zbuf.Puller, keys, steps []expr.Assignment, time expr.Evaluator, window nano.Duration, resetter expr.Resetter) (*Op, error) {
+	var names field.List
+	var keyExprs, stepExprs []expr.Evaluator
+	for _, k := range keys {
+		p, ok := k.LHS.Path()
+		if !ok {
+			return nil, errors.New("invalid lval in grouping key")
+		}
+		names = append(names, p)
+		keyExprs = append(keyExprs, k.RHS)
+	}
+	types := make([]super.Type, len(keys), len(keys)+len(steps))
+	for _, s := range steps {
+		p, ok := s.LHS.Path()
+		if !ok {
+			return nil, errors.New("invalid lval in sequence step")
+		}
+		names = append(names, p)
+		stepExprs = append(stepExprs, s.RHS)
+		types = append(types, super.TypeTime)
+	}
+	builder, err := super.NewRecordBuilder(rctx.Sctx, names)
+	if err != nil {
+		return nil, err
+	}
+	return &Op{
+		rctx:     rctx,
+		parent:   parent,
+		resetter: resetter,
+		keys:     keyExprs,
+		steps:    stepExprs,
+		time:     time,
+		window:   window,
+		builder:  builder,
+		types:    types,
+		groups:   make(map[string]*group),
+	}, nil
+}
+
+func (o *Op) Pull(done bool) (zbuf.Batch, error) {
+	if done {
+		o.reset()
+		return o.parent.Pull(true)
+	}
+	for {
+		batch, err := o.parent.Pull(false)
+		if batch == nil || err != nil {
+			o.reset()
+			return nil, err
+		}
+		var out []super.Value
+		for _, val := range batch.Values() {
+			var err error
+			out, err = o.consume(out, batch, val)
+			if err != nil {
+				batch.Unref()
+				o.reset()
+				return nil, err
+			}
+		}
+		batch.Unref()
+		if len(out) > 0 {
+			return zbuf.NewArray(out), nil
+		}
+	}
+}
+
+func (o *Op) consume(out []super.Value, ectx expr.Context, val super.Value) ([]super.Value, error) {
+	tval := o.time.Eval(ectx, val)
+	if super.TypeUnder(tval.Type()) != super.TypeTime || tval.IsNull() {
+		return out, nil
+	}
+	ts := super.DecodeTime(tval.Bytes())
+	key := o.cache[:0]
+	for _, e := range o.keys {
+		kval := e.Eval(ectx, val)
+		key = binary.LittleEndian.AppendUint32(key, uint32(kval.Type().ID()))
+		key = binary.LittleEndian.AppendUint32(key, uint32(len(kval.Bytes())))
+		key = append(key, kval.Bytes()...)
+	}
+	o.cache = key
+	g, ok := o.groups[string(key)]
+	if !ok {
+		g = &group{partials: make([][]event, len(o.steps))}
+		for _, e := range o.keys {
+			g.keys = append(g.keys, e.Eval(ectx, val).Copy())
+		}
+		o.groups[string(key)] = g
+	}
+	for i, p := range g.partials {
+		if p != nil && ts-p[0].ts > nano.Ts(o.window) {
+			g.partials[i] = nil
+		}
+	}
+	o.seq++
+	e := event{o.seq, ts}
+	// Steps are visited last to first so that a value matching more than
+	// one step advances a partial match by at most one step.
+	for i := len(o.steps) - 1; i >= 0; i-- {
+		if !expr.EvalBool(o.rctx.Sctx, ectx, val, o.steps[i]).Ptr().AsBool() {
+			continue
+		}
+		if i == 0 {
+			g.partials[0] = []event{e}
+		} else if p := g.partials[i-1]; p != nil && ts >= p[len(p)-1].ts {
+			g.partials[i] = append(slices.Clip(p), e)
+		}
+	}
+	if match := g.partials[len(o.steps)-1]; match != nil {
+		for i, p := range g.partials {
+			if p != nil && slices.ContainsFunc(p, func(e event) bool { return slices.Contains(match, e) }) {
+				g.partials[i] = nil
+			}
+		}
+		rec, err := o.output(g, match)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, rec)
+	}
+	if !slices.ContainsFunc(g.partials, func(p []event) bool { return p != nil }) {
+		delete(o.groups, string(key))
+	}
+	return out, nil
+}
+
+func (o *Op) output(g *group, match []event) (super.Value, error) {
+	o.builder.Reset()
+	for i, k := range g.keys {
+		o.types[i] = k.Type()
+		o.builder.Append(k.Bytes())
+	}
+	for _, e := range match {
+		o.builder.Append(super.EncodeTime(e.ts))
+	}
+	bytes, err := o.builder.Encode()
+	if err != nil {
+		return super.Value{}, err
+	}
+	return super.NewValue(o.builder.LookupType(o.types), bytes), nil
+}
+
+func (o *Op) reset() {
+	clear(o.groups)
+	o.seq = 0
+	o.resetter.Reset()
+}
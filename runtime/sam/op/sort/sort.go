@@ -28,6 +28,7 @@ type Op struct {
 	once           sync.Once
 	resultCh       chan op.Result
 	comparator     *expr.Comparator
+	spills         zbuf.SpillStats
 }
 
 func New(rctx *runtime.Context, parent zbuf.Puller, fields []expr.SortExpr, guessReverse bool, resetter expr.Resetter) *Op {
@@ -59,6 +60,11 @@ func (o *Op) Pull(done bool) (zbuf.Batch, error) {
 	}
 }
 
+// SpillStats returns the statistics of the values spilled by o.
+func (o *Op) SpillStats() zbuf.SpillStats {
+	return o.spills.Copy()
+}
+
 func (o *Op) run() {
 	defer close(o.resultCh)
 	var spiller *spill.MergeSort
@@ -125,7 +131,7 @@ func (o *Op) run() {
 			continue
 		}
 		if spiller == nil {
-			spiller, err = spill.NewMergeSort(o.comparator)
+			spiller, err = spill.NewMergeSort(o.rctx.Sctx, o.rctx.SpillOptions, o.comparator, &o.spills, &o.rctx.Spills)
 			if err != nil {
 				if ok := o.sendResult(nil, err); !ok {
 					return
@@ -201,9 +207,9 @@ func NewComparator(sctx *super.Context, exprs []expr.SortExpr, guessVal super.Va
 		if guessReverse {
 			o = order.Desc
 		}
-		exprs = []expr.SortExpr{expr.NewSortExpr(e, o, order.NullsLast)}
+		exprs = []expr.SortExpr{expr.NewSortExpr(e, o, order.DefaultNulls)}
 	}
-	return expr.NewComparator(exprs...).WithMissingAsNull()
+	return expr.NewComparator(exprs...).WithCollation(expr.QueryCollation)
 }
 
 func GuessSortKey(val super.Value) field.Path {
@@ -0,0 +1,184 @@
+package spill
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/brimdata/super/zio"
+)
+
+// Encrypt causes spill files to be encrypted with AES-GCM so that values
+// spilled by a query are not left in plaintext in the temporary directory.
+// Each spill file is encrypted with its own key, which is held only in
+// memory and discarded with the file.
+var Encrypt = false
+
+// chunkSize is the maximum size of the plaintext of each sealed chunk.
+const chunkSize = 64 * 1024
+
+var errTruncated = errors.New("spill file truncated")
+
+// Cipher encrypts and decrypts a spill file as a sequence of chunks, each
+// sealed with AES-GCM and preceded by the length of its ciphertext.  The
+// nonce of a chunk is its sequence number and the last chunk is marked as
+// such in its additional data so that a truncated file is detected.  A nil
+// Cipher leaves the file in plaintext.
+type Cipher struct {
+	aead cipher.AEAD
+}
+
+// NewCipher returns a Cipher with a new random key if Encrypt is true and
+// returns nil otherwise.
+func NewCipher() (*Cipher, error) {
+	if !Encrypt {
+		return nil, nil
+	}
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Cipher{aead}, nil
+}
+
+// Writer returns a writer that encrypts to w.  Closing the writer flushes
+// the last chunk but does not close w.
+func (c *Cipher) Writer(w io.Writer) io.WriteCloser {
+	if c == nil {
+		return zio.NopCloser(w)
+	}
+	return &encrypter{cipher: c, w: w, buf: make([]byte, 0, chunkSize)}
+}
+
+// Reader returns a reader that decrypts from r, which must be positioned at
+// the beginning of a file written by a Writer of c.
+func (c *Cipher) Reader(r io.Reader) io.Reader {
+	if c == nil {
+		return r
+	}
+	return &decrypter{cipher: c, r: r}
+}
+
+func (c *Cipher) nonce(seq uint64) []byte {
+	nonce := make([]byte, c.aead.NonceSize())
+	binary.BigEndian.PutUint64(nonce[len(nonce)-8:], seq)
+	return nonce
+}
+
+func additionalData(last bool) []byte {
+	if last {
+		return []byte{1}
+	}
+	return []byte{0}
+}
+
+type encrypter struct {
+	cipher *Cipher
+	w      io.Writer
+	buf    []byte
+	seq    uint64
+	sealed []byte
+}
+
+func (e *encrypter) Write(b []byte) (int, error) {
+	n := len(b)
+	for len(b) > 0 {
+		if len(e.buf) == chunkSize {
+			if err := e.flush(false); err != nil {
+				return 0, err
+			}
+		}
+		k := min(len(b), chunkSize-len(e.buf))
+		e.buf = append(e.buf, b[:k]...)
+		b = b[k:]
+	}
+	return n, nil
+}
+
+func (e *encrypter) flush(last bool) error {
+	aead := e.cipher.aead
+	e.sealed = binary.BigEndian.AppendUint32(e.sealed[:0], uint32(len(e.buf)+aead.Overhead()))
+	e.sealed = aead.Seal(e.sealed, e.cipher.nonce(e.seq), e.buf, additionalData(last))
+	e.seq++
+	e.buf = e.buf[:0]
+	_, err := e.w.Write(e.sealed)
+	return err
+}
+
+func (e *encrypter) Close() error {
+	return e.flush(true)
+}
+
+type decrypter struct {
+	cipher *Cipher
+	r      io.Reader
+	sealed []byte
+	buf    []byte
+	off    int
+	seq    uint64
+	last   bool
+}
+
+func (d *decrypter) Read(b []byte) (int, error) {
+	for d.off == len(d.buf) {
+		if d.last {
+			return 0, io.EOF
+		}
+		if err := d.next(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(b, d.buf[d.off:])
+	d.off += n
+	return n, nil
+}
+
+func (d *decrypter) next() error {
+	var hdr [4]byte
+	if _, err := io.ReadFull(d.r, hdr[:]); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return errTruncated
+		}
+		return err
+	}
+	size := int(binary.BigEndian.Uint32(hdr[:]))
+	if size < d.cipher.aead.Overhead() || size > chunkSize+d.cipher.aead.Overhead() {
+		return fmt.Errorf("spill file corrupted: bad chunk size %d", size)
+	}
+	if cap(d.sealed) < size {
+		d.sealed = make([]byte, size)
+	}
+	sealed := d.sealed[:size]
+	if _, err := io.ReadFull(d.r, sealed); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return errTruncated
+		}
+		return err
+	}
+	nonce := d.cipher.nonce(d.seq)
+	// The ciphertext is not opened in place since a failed Open may
+	// overwrite its output and the chunk may be the last one.
+	plain, err := d.cipher.aead.Open(d.buf[:0], nonce, sealed, additionalData(false))
+	if err != nil {
+		plain, err = d.cipher.aead.Open(d.buf[:0], nonce, sealed, additionalData(true))
+		if err != nil {
+			return errors.New("spill file corrupted: authentication failed")
+		}
+		d.last = true
+	}
+	d.seq++
+	d.buf, d.off = plain, 0
+	return nil
+}
@@ -0,0 +1,72 @@
+package spill
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/brimdata/super"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCipher(t *testing.T) *Cipher {
+	saved := Encrypt
+	Encrypt = true
+	t.Cleanup(func() { Encrypt = saved })
+	c, err := NewCipher()
+	require.NoError(t, err)
+	require.NotNil(t, c)
+	return c
+}
+
+func TestCipher(t *testing.T) {
+	c := newTestCipher(t)
+	for _, n := range []int{0, 1, chunkSize - 1, chunkSize, 3*chunkSize + 7} {
+		plain := make([]byte, n)
+		for i := range plain {
+			plain[i] = byte(i)
+		}
+		var buf bytes.Buffer
+		w := c.Writer(&buf)
+		_, err := w.Write(plain)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		if n > 1 {
+			require.False(t, bytes.Contains(buf.Bytes(), plain))
+		}
+		sealed := buf.Bytes()
+		out, err := io.ReadAll(c.Reader(bytes.NewReader(sealed)))
+		require.NoError(t, err)
+		require.Equal(t, plain, out)
+		// A truncated file is detected.
+		if n > chunkSize {
+			_, err = io.ReadAll(c.Reader(bytes.NewReader(sealed[:len(sealed)-20])))
+			require.Error(t, err)
+			_, err = io.ReadAll(c.Reader(bytes.NewReader(sealed[:chunkSize+4+c.aead.Overhead()])))
+			require.ErrorIs(t, err, errTruncated)
+		}
+		// A file cannot be read with another key.
+		_, err = io.ReadAll(newTestCipher(t).Reader(bytes.NewReader(sealed)))
+		require.Error(t, err)
+	}
+}
+
+func TestEncryptedFile(t *testing.T) {
+	newTestCipher(t)
+	f, err := NewTempFile()
+	require.NoError(t, err)
+	defer f.CloseAndRemove()
+	val := super.NewString("sensitive")
+	require.NoError(t, f.Write(val))
+	require.NoError(t, f.Rewind(super.NewContext()))
+	b, err := os.ReadFile(f.file.Name())
+	require.NoError(t, err)
+	require.False(t, bytes.Contains(b, []byte("sensitive")))
+	out, err := f.Read()
+	require.NoError(t, err)
+	require.Equal(t, val.Bytes(), out.Bytes())
+	out, err = f.Read()
+	require.NoError(t, err)
+	require.Nil(t, out)
+}
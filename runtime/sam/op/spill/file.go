@@ -2,13 +2,19 @@ package spill
 
 import (
 	"bufio"
+	"errors"
+	"io"
+	"math"
 	"os"
 
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/pkg/bufwriter"
 	"github.com/brimdata/super/pkg/fs"
+	"github.com/brimdata/super/zbuf"
 	"github.com/brimdata/super/zio"
 	"github.com/brimdata/super/zio/bsupio"
+	"github.com/brimdata/super/zio/csupio"
+	"github.com/klauspost/compress/zstd"
 )
 
 // File provides a means to write a sequence of Super values to temporary
@@ -17,62 +23,157 @@ import (
 // but can be processed in multiple passes.  File implements zio.Reader and
 // zio.Writer.
 type File struct {
-	*bsupio.Reader
-	*bsupio.Writer
-	file *os.File
+	zio.Reader
+	zio.WriteCloser
+	file   *os.File
+	cipher *Cipher
+	opts   zbuf.SpillOptions
+	// closers are closed with the reader.
+	closers []io.Closer
 }
 
-// NewFile returns a File.  Records should be written to File via the zio.Writer
-// interface, followed by a call to the Rewind method, followed by reading
-// records via the zio.Reader interface.
-func NewFile(f *os.File) *File {
-	return &File{
-		Writer: bsupio.NewWriterWithOpts(bufwriter.New(zio.NopCloser(f)), bsupio.WriterOpts{
-			Compress:    false, // Compression reduces write throughput; see #3973.
-			FrameThresh: bsupio.DefaultFrameThresh,
-		}),
-		file: f,
+// NewFile returns a File whose format and compression are given by opts.
+// Records should be written to File via the zio.Writer interface, followed by
+// a call to the Rewind method, followed by reading records via the zio.Reader
+// interface.  If Encrypt is true, the records are encrypted in f.
+func NewFile(f *os.File, opts zbuf.SpillOptions) (*File, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	c, err := NewCipher()
+	if err != nil {
+		return nil, err
+	}
+	file := &File{
+		file:   f,
+		cipher: c,
+		opts:   opts,
+	}
+	if opts.Format == "csup" {
+		if c != nil {
+			return nil, errors.New("spill files in the csup format cannot be encrypted")
+		}
+		// CSUP is read back with random access so it is written directly
+		// to f.
+		file.WriteCloser = csupio.NewWriter(zio.NopCloser(f))
+		return file, nil
+	}
+	w := c.Writer(f)
+	if opts.Compression == "zstd" {
+		zw, err := zstd.NewWriter(w, zstd.WithEncoderLevel(zstd.SpeedFastest))
+		if err != nil {
+			return nil, err
+		}
+		w = &zstdWriter{zw, w}
 	}
+	file.WriteCloser = bsupio.NewWriterWithOpts(bufwriter.New(w), bsupio.WriterOpts{
+		// Compression reduces write throughput (see #3973) so it is
+		// off unless requested.
+		Compress:    opts.Compression == "lz4",
+		FrameThresh: bsupio.DefaultFrameThresh,
+	})
+	return file, nil
+}
+
+// zstdWriter closes the zstd.Encoder that writes to next then closes next.
+type zstdWriter struct {
+	*zstd.Encoder
+	next io.Closer
+}
+
+func (z *zstdWriter) Close() error {
+	err := z.Encoder.Close()
+	if closeErr := z.next.Close(); err == nil {
+		err = closeErr
+	}
+	return err
 }
 
+// NewTempFile returns a File in the bsup format without compression.
 func NewTempFile() (*File, error) {
+	return NewTempFileWithOptions(zbuf.SpillOptions{})
+}
+
+// NewTempFileWithOptions returns a File whose format and compression are
+// given by opts.
+func NewTempFileWithOptions(opts zbuf.SpillOptions) (*File, error) {
 	f, err := TempFile()
 	if err != nil {
 		return nil, err
 	}
-	return NewFile(f), nil
+	return newFileOrRemove(f, opts)
 }
 
-func NewFileWithPath(path string) (*File, error) {
+func NewFileWithPath(path string, opts zbuf.SpillOptions) (*File, error) {
 	f, err := fs.Create(path)
 	if err != nil {
 		return nil, err
 	}
-	return NewFile(f), nil
+	return newFileOrRemove(f, opts)
+}
+
+func newFileOrRemove(f *os.File, opts zbuf.SpillOptions) (*File, error) {
+	file, err := NewFile(f, opts)
+	if err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return nil, err
+	}
+	return file, nil
 }
 
+// Rewind prepares f to read the records written to it from the start.  It
+// may be called again to reread them.
 func (f *File) Rewind(sctx *super.Context) error {
-	// Close the writer to flush any pending output but since we
-	// wrapped the file in a zio.NopCloser, the file will stay open.
-	if err := f.Writer.Close(); err != nil {
-		return err
+	// Close the writer to flush any pending output but since the
+	// file is wrapped by a writer that does not close it, the file
+	// will stay open.
+	if f.WriteCloser != nil {
+		if err := f.WriteCloser.Close(); err != nil {
+			return err
+		}
+		f.WriteCloser = nil
 	}
-	f.Writer = nil
 	if _, err := f.file.Seek(0, 0); err != nil {
 		return err
 	}
-	if f.Reader != nil {
-		f.Reader.Close()
+	f.closeReader()
+	if f.opts.Format == "csup" {
+		// The section reader keeps the reader from closing the file.
+		r, err := csupio.NewReader(sctx, io.NewSectionReader(f.file, 0, math.MaxInt64), nil)
+		if err != nil {
+			return err
+		}
+		f.Reader = r
+		f.closers = append(f.closers, r.(io.Closer))
+		return nil
+	}
+	r := f.cipher.Reader(f.file)
+	if f.opts.Compression == "zstd" {
+		zr, err := zstd.NewReader(r)
+		if err != nil {
+			return err
+		}
+		r = zr
+		f.closers = append(f.closers, zr.IOReadCloser())
 	}
-	f.Reader = bsupio.NewReader(sctx, bufio.NewReader(f.file))
+	br := bsupio.NewReader(sctx, bufio.NewReader(r))
+	f.Reader = br
+	f.closers = append(f.closers, br)
 	return nil
 }
 
+func (f *File) closeReader() {
+	// Close the readers wrapping the others first.
+	for i := len(f.closers) - 1; i >= 0; i-- {
+		f.closers[i].Close()
+	}
+	f.closers = nil
+}
+
 // CloseAndRemove closes and removes the underlying file.
 func (r *File) CloseAndRemove() error {
-	if r.Reader != nil {
-		r.Reader.Close()
-	}
+	r.closeReader()
 	err := r.file.Close()
 	if rmErr := os.Remove(r.file.Name()); err == nil {
 		err = rmErr
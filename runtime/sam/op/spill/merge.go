@@ -9,12 +9,21 @@ import (
 
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/zbuf"
 	"github.com/brimdata/super/zio"
 )
 
-// MergeSort manages "runs" (files of sorted BSUP values) that are spilled to
-// disk a chunk at a time, then read back and merged in sorted order, effectively
-// implementing an external merge sort.
+// MaxFanIn is the largest number of runs of a MergeSort at a level before
+// they are merged into a run at the next level.
+var MaxFanIn = 64
+
+// MergeSort manages "runs" (files of sorted values) that are spilled to disk
+// a chunk at a time, then read back and merged in sorted order, effectively
+// implementing an external merge sort.  Each spilled run is at level zero,
+// and whenever MaxFanIn runs are at a level, they are merged into a single
+// run at the next level.  Cascading merges in this way bounds the number of
+// open files and runs read at once to about MaxFanIn times the number of
+// levels while each value is rewritten only once per level.
 type MergeSort struct {
 	comparator *expr.Comparator
 	nspill     int
@@ -22,6 +31,10 @@ type MergeSort struct {
 	tempDir    string
 	spillSize  int64
 	sctx       *super.Context
+	opts       zbuf.SpillOptions
+	meters     []*zbuf.SpillStats
+	// merging is true if the runs have been read since the last spill.
+	merging bool
 }
 
 const TempPrefix = "zed-spill-"
@@ -35,9 +48,11 @@ func TempFile() (*os.File, error) {
 }
 
 // NewMergeSort returns a MergeSort to implement external merge sorts of a large
-// BSUP stream.  It creates a temporary directory to hold the collection
-// of spilled chunks.  Call Cleanup to remove it.
-func NewMergeSort(comparator *expr.Comparator) (*MergeSort, error) {
+// stream of values that are spilled to files configured by opts and read back
+// in sctx.  It creates a temporary directory to hold the collection of spilled
+// chunks.  Call Cleanup to remove it.  The statistics of the spills and merges
+// are added to each of meters.
+func NewMergeSort(sctx *super.Context, opts zbuf.SpillOptions, comparator *expr.Comparator, meters ...*zbuf.SpillStats) (*MergeSort, error) {
 	tempDir, err := TempDir()
 	if err != nil {
 		return nil, err
@@ -45,7 +60,9 @@ func NewMergeSort(comparator *expr.Comparator) (*MergeSort, error) {
 	return &MergeSort{
 		comparator: comparator,
 		tempDir:    tempDir,
-		sctx:       super.NewContext(),
+		sctx:       sctx,
+		opts:       opts,
+		meters:     meters,
 	}, nil
 }
 
@@ -68,19 +85,87 @@ func (r *MergeSort) Spill(ctx context.Context, vals []super.Value) error {
 	}); err != nil {
 		return err
 	}
-	filename := filepath.Join(r.tempDir, strconv.Itoa(r.nspill))
-	runFile, err := newPeeker(ctx, r.sctx, filename, r.nspill, zr)
+	run, err := r.newRun(ctx, zr, r.nspill, 0)
 	if err != nil {
 		return err
 	}
-	size, err := runFile.Size()
+	r.merging = false
+	r.addStats(zbuf.SpillStats{RowsSpilled: int64(len(vals))})
+	heap.Push(r, run)
+	return r.cascade(ctx, 0)
+}
+
+// newRun writes the values of zr to a new run with ordinal at level.
+func (r *MergeSort) newRun(ctx context.Context, zr zio.Reader, ordinal, level int) (*peeker, error) {
+	filename := filepath.Join(r.tempDir, strconv.Itoa(r.nspill))
+	run, err := newPeeker(ctx, r.sctx, filename, r.opts, ordinal, zr)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	size, err := run.Size()
+	if err != nil {
+		run.CloseAndRemove()
+		return nil, err
+	}
+	run.level = level
 	r.nspill++
 	r.spillSize += size
-	heap.Push(r, runFile)
-	return nil
+	r.addStats(zbuf.SpillStats{
+		SpillFiles:   1,
+		BytesSpilled: size,
+	})
+	return run, nil
+}
+
+// cascade merges the runs at level into a run at the next level if there
+// are MaxFanIn of them and continues with the next level.
+func (r *MergeSort) cascade(ctx context.Context, level int) error {
+	for ; ; level++ {
+		var runs, others []*peeker
+		for _, run := range r.runs {
+			if run.level == level {
+				runs = append(runs, run)
+			} else {
+				others = append(others, run)
+			}
+		}
+		if len(runs) < MaxFanIn {
+			return nil
+		}
+		// The runs at a level hold consecutive spills, so the merged run
+		// takes the place of the first of them to maintain stability.
+		ordinal := runs[0].ordinal
+		for _, run := range runs[1:] {
+			ordinal = min(ordinal, run.ordinal)
+		}
+		merger := &MergeSort{comparator: r.comparator, runs: runs}
+		heap.Init(merger)
+		run, err := r.newRun(ctx, merger, ordinal, level+1)
+		if err != nil {
+			// Keep the runs so Cleanup removes them.
+			r.runs = append(others, merger.runs...)
+			heap.Init(r)
+			return err
+		}
+		r.addStats(zbuf.SpillStats{MergePasses: 1})
+		r.runs = append(others, run)
+		heap.Init(r)
+	}
+}
+
+func (r *MergeSort) addStats(stats zbuf.SpillStats) {
+	for _, m := range r.meters {
+		m.Add(stats)
+	}
+}
+
+// merge counts a merge pass if the runs have not been read since the last
+// spill.
+func (r *MergeSort) merge() {
+	if !r.merging && r.Len() > 0 {
+		r.merging = true
+		r.addStats(zbuf.SpillStats{MergePasses: 1})
+	}
 }
 
 func goWithContext(ctx context.Context, f func()) error {
@@ -100,6 +185,7 @@ func goWithContext(ctx context.Context, f func()) error {
 // Peek returns the next record without advancing the reader.  The record stops
 // being valid at the next read call.
 func (r *MergeSort) Peek() (*super.Value, error) {
+	r.merge()
 	if r.Len() == 0 {
 		return nil, nil
 	}
@@ -110,6 +196,7 @@ func (r *MergeSort) Peek() (*super.Value, error) {
 // from among the next records in the spilled chunks.  It implements the merge operation
 // for an external merge sort.
 func (r *MergeSort) Read() (*super.Value, error) {
+	r.merge()
 	for {
 		if r.Len() == 0 {
 			return nil, nil
@@ -0,0 +1,114 @@
+package spill
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/sup"
+	"github.com/brimdata/super/zbuf"
+	"github.com/stretchr/testify/require"
+)
+
+var spillOptions = []zbuf.SpillOptions{
+	{Format: "bsup", Compression: "none"},
+	{Format: "bsup", Compression: "lz4"},
+	{Format: "bsup", Compression: "zstd"},
+	{Format: "csup", Compression: "none"},
+}
+
+// spillValues returns n records that compress about as well as typical logs.
+func spillValues(t testing.TB, sctx *super.Context, n int) []super.Value {
+	var vals []super.Value
+	for i := range n {
+		s := fmt.Sprintf(`{id:%d,host:"host-%d",status:%d,msg:"request %d completed"}`, (i*7919)%n, i%16, 200+i%5, i%100)
+		val, err := sup.ParseValue(sctx, s)
+		require.NoError(t, err)
+		vals = append(vals, val)
+	}
+	return vals
+}
+
+func mergeSort(t testing.TB, sctx *super.Context, opts zbuf.SpillOptions, vals []super.Value, nruns int) (*MergeSort, *zbuf.SpillStats) {
+	cmp := expr.NewComparator(expr.NewSortExpr(expr.NewDottedExpr(sctx, []string{"id"}), order.Asc, order.NullsLast))
+	var stats zbuf.SpillStats
+	ms, err := NewMergeSort(sctx, opts, cmp, &stats)
+	require.NoError(t, err)
+	n := len(vals) / nruns
+	for i := 0; i < len(vals); i += n {
+		require.NoError(t, ms.Spill(context.Background(), vals[i:min(i+n, len(vals))]))
+	}
+	return ms, &stats
+}
+
+func TestMergeSortOptions(t *testing.T) {
+	for _, opts := range spillOptions {
+		t.Run(opts.Format+"-"+opts.Compression, func(t *testing.T) {
+			sctx := super.NewContext()
+			ms, _ := mergeSort(t, sctx, opts, spillValues(t, sctx, 1000), 4)
+			defer ms.Cleanup()
+			for i := range 1000 {
+				val, err := ms.Read()
+				require.NoError(t, err)
+				require.NotNil(t, val)
+				require.Equal(t, int64(i), val.Deref("id").AsInt())
+			}
+			val, err := ms.Read()
+			require.NoError(t, err)
+			require.Nil(t, val)
+		})
+	}
+}
+
+func BenchmarkMergeSort(b *testing.B) {
+	for _, opts := range spillOptions {
+		b.Run(opts.Format+"-"+opts.Compression, func(b *testing.B) {
+			sctx := super.NewContext()
+			vals := spillValues(b, sctx, 100000)
+			var bytes int64
+			b.ResetTimer()
+			for range b.N {
+				ms, stats := mergeSort(b, sctx, opts, vals, 8)
+				for {
+					val, err := ms.Read()
+					require.NoError(b, err)
+					if val == nil {
+						break
+					}
+				}
+				ms.Cleanup()
+				bytes = stats.BytesSpilled
+			}
+			b.ReportMetric(float64(bytes), "spillbytes/op")
+		})
+	}
+}
+
+func TestMergeSortCascade(t *testing.T) {
+	saved := MaxFanIn
+	MaxFanIn = 3
+	t.Cleanup(func() { MaxFanIn = saved })
+	sctx := super.NewContext()
+	var vals []super.Value
+	for i := range 100 {
+		// Equal ids across runs check that the merges are stable.
+		val, err := sup.ParseValue(sctx, fmt.Sprintf("{id:%d,seq:%d}", i%10, i))
+		require.NoError(t, err)
+		vals = append(vals, val)
+	}
+	ms, stats := mergeSort(t, sctx, zbuf.SpillOptions{}, vals, 20)
+	defer ms.Cleanup()
+	// 20 runs of 5 values cascade in 6 merges into level 1 and 2 merges
+	// into level 2, which leave 2 runs at level 2 and 2 at level 0.
+	require.Equal(t, 4, ms.Len())
+	require.Equal(t, int64(8), stats.MergePasses)
+	for i := range 100 {
+		val, err := ms.Read()
+		require.NoError(t, err)
+		require.Equal(t, int64(i/10), val.Deref("id").AsInt())
+		require.Equal(t, int64(i%10*10+i/10), val.Deref("seq").AsInt())
+	}
+}
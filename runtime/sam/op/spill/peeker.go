@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/brimdata/super"
+	"github.com/brimdata/super/zbuf"
 	"github.com/brimdata/super/zio"
 )
 
@@ -11,10 +12,11 @@ type peeker struct {
 	*File
 	nextRecord *super.Value
 	ordinal    int
+	level      int
 }
 
-func newPeeker(ctx context.Context, sctx *super.Context, filename string, ordinal int, zr zio.Reader) (*peeker, error) {
-	f, err := NewFileWithPath(filename)
+func newPeeker(ctx context.Context, sctx *super.Context, filename string, opts zbuf.SpillOptions, ordinal int, zr zio.Reader) (*peeker, error) {
+	f, err := NewFileWithPath(filename, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -31,7 +33,7 @@ func newPeeker(ctx context.Context, sctx *super.Context, filename string, ordina
 		f.CloseAndRemove()
 		return nil, err
 	}
-	return &peeker{f, first, ordinal}, nil
+	return &peeker{File: f, nextRecord: first, ordinal: ordinal}, nil
 }
 
 // read is like Read but returns eof at the last record so a MergeSort can
@@ -0,0 +1,117 @@
+package temp
+
+import (
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/zbuf"
+	"github.com/brimdata/super/zio"
+)
+
+// Writer is the "into temp" operator.  It writes all values from its parent
+// into a table and produces no output.
+type Writer struct {
+	parent zbuf.Puller
+	table  *Table
+	done   bool
+}
+
+func NewWriter(parent zbuf.Puller, table *Table) *Writer {
+	return &Writer{parent: parent, table: table}
+}
+
+func (w *Writer) Pull(done bool) (zbuf.Batch, error) {
+	if w.done {
+		// The table is written once.  Subsequent platoons are
+		// drained and discarded.
+		return w.drain(done)
+	}
+	w.done = true
+	if done {
+		w.table.Finish(nil)
+		return w.parent.Pull(true)
+	}
+	for {
+		batch, err := w.parent.Pull(false)
+		if err != nil {
+			w.table.Finish(err)
+			return nil, err
+		}
+		if batch == nil {
+			w.table.Finish(nil)
+			return nil, nil
+		}
+		err = w.table.Write(batch.Values())
+		batch.Unref()
+		if err != nil {
+			w.table.Finish(err)
+			return nil, err
+		}
+	}
+}
+
+func (w *Writer) drain(done bool) (zbuf.Batch, error) {
+	for {
+		batch, err := w.parent.Pull(done)
+		if batch == nil || err != nil {
+			return nil, err
+		}
+		batch.Unref()
+	}
+}
+
+// Scanner is the "from temp" source.  It waits for a table to be finished
+// and then reads its values.
+type Scanner struct {
+	rctx   *runtime.Context
+	tables *Tables
+	name   string
+	reader zio.ReadCloser
+	puller zbuf.Puller
+	eof    bool
+}
+
+func NewScanner(rctx *runtime.Context, tables *Tables, name string) *Scanner {
+	return &Scanner{rctx: rctx, tables: tables, name: name}
+}
+
+func (s *Scanner) Pull(done bool) (zbuf.Batch, error) {
+	if s.eof {
+		return nil, nil
+	}
+	if done {
+		return nil, s.close()
+	}
+	if s.puller == nil {
+		// Look up the table here rather than in NewScanner since the
+		// operator writing it may be built after this one.
+		table, err := s.tables.Lookup(s.name)
+		if err != nil {
+			return nil, err
+		}
+		select {
+		case <-table.Done():
+		case <-s.rctx.Done():
+			return nil, s.rctx.Err()
+		}
+		r, err := table.NewReader(s.rctx.Sctx)
+		if err != nil {
+			return nil, err
+		}
+		s.reader = r
+		s.puller = zbuf.NewPuller(r)
+	}
+	batch, err := s.puller.Pull(false)
+	if batch == nil || err != nil {
+		if closeErr := s.close(); err == nil {
+			err = closeErr
+		}
+	}
+	return batch, err
+}
+
+func (s *Scanner) close() error {
+	s.eof = true
+	if s.reader == nil {
+		return nil
+	}
+	return s.reader.Close()
+}
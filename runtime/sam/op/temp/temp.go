@@ -0,0 +1,202 @@
+// Package temp implements temporary tables, which hold the values written
+// by an "into temp" operator so they can be read by "from temp" sources
+// elsewhere in the same query or, when the tables are shared via
+// exec.Environment, in later queries of the same session.
+package temp
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/bufwriter"
+	"github.com/brimdata/super/runtime/sam/op/spill"
+	"github.com/brimdata/super/zio"
+	"github.com/brimdata/super/zio/bsupio"
+)
+
+// MemMaxBytes specifies the maximum amount of memory that each temporary
+// table will consume before it spills to disk.
+var MemMaxBytes = 128 * 1024 * 1024
+
+// Tables is a set of named temporary tables.  It is safe for concurrent use.
+type Tables struct {
+	mu     sync.Mutex
+	tables map[string]*Table
+}
+
+func NewTables() *Tables {
+	return &Tables{tables: make(map[string]*Table)}
+}
+
+// Declare creates an empty table called name, replacing any existing table
+// of the same name.  Readers of the new table block until it is finished.
+func (t *Tables) Declare(name string) *Table {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if old, ok := t.tables[name]; ok {
+		old.remove()
+	}
+	table := &Table{name: name, done: make(chan struct{})}
+	t.tables[name] = table
+	return table
+}
+
+// Lookup returns the table called name.
+func (t *Tables) Lookup(name string) (*Table, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	table, ok := t.tables[name]
+	if !ok {
+		return nil, fmt.Errorf("temp table %q does not exist", name)
+	}
+	return table, nil
+}
+
+// Drop removes the table called name.
+func (t *Tables) Drop(name string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	table, ok := t.tables[name]
+	if !ok {
+		return fmt.Errorf("temp table %q does not exist", name)
+	}
+	delete(t.tables, name)
+	return table.remove()
+}
+
+// Close removes all tables in t.
+func (t *Tables) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var errs []error
+	for name, table := range t.tables {
+		errs = append(errs, table.remove())
+		delete(t.tables, name)
+	}
+	return errors.Join(errs...)
+}
+
+// Table is a temporary table.  A single writer appends values to a table
+// and then calls Finish, after which any number of readers may read it.
+// Values are kept in memory until they exceed MemMaxBytes, after which
+// the remaining values are written to a spill file.
+type Table struct {
+	name   string
+	done   chan struct{}
+	err    error
+	vals   []super.Value
+	bytes  int
+	file   *os.File
+	cipher *spill.Cipher
+	w      *bsupio.Writer
+}
+
+// Write appends a copy of each value in vals to t.
+func (t *Table) Write(vals []super.Value) error {
+	for _, val := range vals {
+		if t.w == nil && t.bytes < MemMaxBytes {
+			t.vals = append(t.vals, val.Copy())
+			t.bytes += len(val.Bytes())
+			continue
+		}
+		if t.w == nil {
+			c, err := spill.NewCipher()
+			if err != nil {
+				return err
+			}
+			f, err := spill.TempFile()
+			if err != nil {
+				return err
+			}
+			t.file, t.cipher = f, c
+			t.w = bsupio.NewWriterWithOpts(bufwriter.New(c.Writer(f)), bsupio.WriterOpts{
+				FrameThresh: bsupio.DefaultFrameThresh,
+			})
+		}
+		if err := t.w.Write(val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Finish marks t as complete, or as failed if err is not nil, and unblocks
+// its readers.
+func (t *Table) Finish(err error) {
+	if t.w != nil {
+		if closeErr := t.w.Close(); err == nil {
+			err = closeErr
+		}
+		t.w = nil
+	}
+	t.err = err
+	close(t.done)
+}
+
+// Done returns a channel that is closed when t is finished.
+func (t *Table) Done() <-chan struct{} {
+	return t.done
+}
+
+// NewReader returns a reader of the values in t.  It must not be called
+// until t is finished.
+func (t *Table) NewReader(sctx *super.Context) (zio.ReadCloser, error) {
+	if t.err != nil {
+		return nil, fmt.Errorf("temp table %q: %w", t.name, t.err)
+	}
+	r := &reader{vals: t.vals}
+	if t.file != nil {
+		f, err := os.Open(t.file.Name())
+		if err != nil {
+			return nil, err
+		}
+		r.file = f
+		r.spill = bsupio.NewReader(sctx, bufio.NewReader(t.cipher.Reader(f)))
+	}
+	return r, nil
+}
+
+func (t *Table) remove() error {
+	if t.file == nil {
+		return nil
+	}
+	err := t.file.Close()
+	if rmErr := os.Remove(t.file.Name()); err == nil {
+		err = rmErr
+	}
+	return err
+}
+
+type reader struct {
+	vals  []super.Value
+	off   int
+	file  *os.File
+	spill *bsupio.Reader
+}
+
+func (r *reader) Read() (*super.Value, error) {
+	if r.off < len(r.vals) {
+		val := &r.vals[r.off]
+		r.off++
+		return val, nil
+	}
+	if r.spill == nil {
+		return nil, nil
+	}
+	return r.spill.Read()
+}
+
+func (r *reader) Close() error {
+	if r.spill == nil {
+		return nil
+	}
+	err := r.spill.Close()
+	if closeErr := r.file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
@@ -0,0 +1,39 @@
+package temp_test
+
+import (
+	"testing"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/sam/op/temp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableSpill(t *testing.T) {
+	saved := temp.MemMaxBytes
+	temp.MemMaxBytes = 16
+	t.Cleanup(func() { temp.MemMaxBytes = saved })
+	var vals []super.Value
+	for i := range 100 {
+		vals = append(vals, super.NewInt64(int64(i)))
+	}
+	tables := temp.NewTables()
+	defer tables.Close()
+	table := tables.Declare("t")
+	require.NoError(t, table.Write(vals))
+	table.Finish(nil)
+	// Read twice to check that readers are independent.
+	for range 2 {
+		r, err := table.NewReader(super.NewContext())
+		require.NoError(t, err)
+		for _, expected := range vals {
+			val, err := r.Read()
+			require.NoError(t, err)
+			require.NotNil(t, val)
+			require.Equal(t, expected.Bytes(), val.Bytes())
+		}
+		val, err := r.Read()
+		require.NoError(t, err)
+		require.Nil(t, val)
+		require.NoError(t, r.Close())
+	}
+}
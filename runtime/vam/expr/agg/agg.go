@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/brimdata/super"
+	samagg "github.com/brimdata/super/runtime/sam/expr/agg"
 	"github.com/brimdata/super/vector"
 )
 
@@ -16,7 +17,7 @@ type Func interface {
 
 type Pattern func() Func
 
-func NewPattern(op string, distinct, hasarg bool) (Pattern, error) {
+func NewPattern(op string, distinct, hasarg bool, params ...float64) (Pattern, error) {
 	needarg := true
 	var pattern Pattern
 	switch op {
@@ -33,14 +34,62 @@ func NewPattern(op string, distinct, hasarg bool) (Pattern, error) {
 		pattern = func() Func {
 			return &avg{}
 		}
-	case "dcount":
+	case "approx_count_distinct", "dcount":
 		pattern = func() Func {
 			return newDCount()
 		}
+	case "approx_top":
+		pattern = func() Func {
+			return &approxTop{samagg.NewApproxTop(int(params[0]))}
+		}
+	case "approx_histogram":
+		pattern = func() Func {
+			return &samFunc{samagg.NewApproxHistogram(int(params[0]))}
+		}
+	case "histogram":
+		pattern = func() Func {
+			return &samFunc{samagg.NewHistogram(params[0], params[1], int(params[2]))}
+		}
+	case "increase":
+		pattern = func() Func {
+			return &samFunc{samagg.NewIncrease()}
+		}
+	case "mad":
+		pattern = func() Func {
+			return mad{newPercentile(50)}
+		}
+	case "median":
+		pattern = func() Func {
+			return newPercentile(50)
+		}
+	case "percentile":
+		pattern = func() Func {
+			return newPercentile(params[0])
+		}
+	case "first":
+		pattern = func() Func {
+			return &first{}
+		}
 	// case "fuse":
 	// 	pattern = func() AggFunc {
 	// 		return newFuse()
 	// 	}
+	case "last":
+		pattern = func() Func {
+			return &last{}
+		}
+	case "rate":
+		pattern = func() Func {
+			return &samFunc{samagg.NewRate()}
+		}
+	case "time_weighted_avg":
+		pattern = func() Func {
+			return &samFunc{samagg.NewTimeWeightedAvg()}
+		}
+	case "unique_rate":
+		pattern = func() Func {
+			return &samFunc{samagg.NewUniqueRate()}
+		}
 	case "sum":
 		pattern = func() Func {
 			return newMathReducer(mathSum)
@@ -75,6 +124,9 @@ func NewPattern(op string, distinct, hasarg bool) (Pattern, error) {
 	if needarg && !hasarg {
 		return nil, fmt.Errorf("%s: argument required", op)
 	}
+	if n := samagg.NumParams(op); len(params) != n {
+		return nil, fmt.Errorf("%s: expected %d constant parameters but found %d", op, n, len(params))
+	}
 	if distinct {
 		switch op {
 		case "avg", "collect", "count", "sum":
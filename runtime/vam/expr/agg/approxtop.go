@@ -0,0 +1,37 @@
+package agg
+
+import (
+	"github.com/brimdata/super"
+	samagg "github.com/brimdata/super/runtime/sam/expr/agg"
+	"github.com/brimdata/super/vector"
+)
+
+type approxTop struct {
+	samtop *samagg.ApproxTop
+}
+
+func (a *approxTop) Consume(vec vector.Any) {
+	if isError(vec) {
+		return
+	}
+	nulls := vector.NullsOf(vec)
+	for i := range vec.Len() {
+		if !nulls.IsSet(i) {
+			a.samtop.Consume(valueOf(vec, i))
+		}
+	}
+}
+
+func (a *approxTop) ConsumeAsPartial(partial vector.Any) {
+	for i := range partial.Len() {
+		a.samtop.ConsumeAsPartial(valueOf(partial, i))
+	}
+}
+
+func (a *approxTop) Result(sctx *super.Context) super.Value {
+	return a.samtop.Result(sctx)
+}
+
+func (a *approxTop) ResultAsPartial(sctx *super.Context) super.Value {
+	return a.samtop.ResultAsPartial(sctx)
+}
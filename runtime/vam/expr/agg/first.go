@@ -0,0 +1,85 @@
+package agg
+
+import (
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/zcode"
+)
+
+// first returns the first non-null value of its input in input order.
+type first struct {
+	val *super.Value
+}
+
+func (f *first) Consume(vec vector.Any) {
+	if f.val != nil || isError(vec) {
+		return
+	}
+	nulls := vector.NullsOf(vec)
+	for i := range vec.Len() {
+		if !nulls.IsSet(i) {
+			f.val = valueOf(vec, i).Ptr()
+			return
+		}
+	}
+}
+
+func (f *first) ConsumeAsPartial(vec vector.Any) {
+	f.Consume(vec)
+}
+
+func (f *first) Result(*super.Context) super.Value {
+	if f.val == nil {
+		return super.Null
+	}
+	return *f.val
+}
+
+func (f *first) ResultAsPartial(*super.Context) super.Value {
+	return f.Result(nil)
+}
+
+// last returns the last non-null value of its input in input order.
+type last struct {
+	val *super.Value
+}
+
+func (l *last) Consume(vec vector.Any) {
+	if isError(vec) {
+		return
+	}
+	nulls := vector.NullsOf(vec)
+	for i := vec.Len(); i > 0; i-- {
+		if !nulls.IsSet(i - 1) {
+			l.val = valueOf(vec, i-1).Ptr()
+			return
+		}
+	}
+}
+
+func (l *last) ConsumeAsPartial(vec vector.Any) {
+	l.Consume(vec)
+}
+
+func (l *last) Result(*super.Context) super.Value {
+	if l.val == nil {
+		return super.Null
+	}
+	return *l.val
+}
+
+func (l *last) ResultAsPartial(*super.Context) super.Value {
+	return l.Result(nil)
+}
+
+func valueOf(vec vector.Any, slot uint32) super.Value {
+	var b zcode.Builder
+	vec.Serialize(&b, slot)
+	return super.NewValue(vec.Type(), b.Bytes().Body())
+}
+
+// isError returns true if vec holds errors, e.g., for a missing argument.
+func isError(vec vector.Any) bool {
+	_, ok := vector.Under(vec).Type().(*super.TypeError)
+	return ok
+}
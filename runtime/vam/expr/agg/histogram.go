@@ -0,0 +1,31 @@
+package agg
+
+import (
+	samagg "github.com/brimdata/super/runtime/sam/expr/agg"
+	"github.com/brimdata/super/vector"
+)
+
+// samFunc adapts a samagg.Function, whose state is updated one value at a
+// time, e.g., a histogram, to a Func.  Its results are those of the
+// samagg.Function.
+type samFunc struct {
+	samagg.Function
+}
+
+func (s *samFunc) Consume(vec vector.Any) {
+	if isError(vec) {
+		return
+	}
+	nulls := vector.NullsOf(vec)
+	for i := range vec.Len() {
+		if !nulls.IsSet(i) {
+			s.Function.Consume(valueOf(vec, i))
+		}
+	}
+}
+
+func (s *samFunc) ConsumeAsPartial(partial vector.Any) {
+	for i := range partial.Len() {
+		s.Function.ConsumeAsPartial(valueOf(partial, i))
+	}
+}
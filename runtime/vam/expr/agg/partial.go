@@ -0,0 +1,63 @@
+package agg
+
+import (
+	"github.com/brimdata/super"
+	samagg "github.com/brimdata/super/runtime/sam/expr/agg"
+	"github.com/brimdata/super/vector"
+)
+
+// DecodePartial returns the partial results of aggregate function name
+// wrapped in vec by samagg.EncodePartial.  See samagg.DecodePartial.
+func DecodePartial(sctx *super.Context, name string, vec vector.Any) (vector.Any, error) {
+	var err error
+	out := vector.Apply(false, func(vecs ...vector.Any) vector.Any {
+		if err != nil {
+			return vecs[0]
+		}
+		var out vector.Any
+		out, err = decodePartial(sctx, name, vecs[0])
+		return out
+	}, vec)
+	return out, err
+}
+
+func decodePartial(sctx *super.Context, name string, vec vector.Any) (vector.Any, error) {
+	if rec, ok := vec.(*vector.Record); ok && isCurrentPartial(rec) {
+		return rec.Fields[1], nil
+	}
+	// Slow path for partial results that must be converted or are invalid.
+	b := vector.NewDynamicBuilder()
+	for slot := range vec.Len() {
+		val, err := samagg.DecodePartial(sctx, name, valueOf(vec, slot))
+		if err != nil {
+			return nil, err
+		}
+		b.Write(val)
+	}
+	return b.Build(), nil
+}
+
+// isCurrentPartial returns true if rec holds partial results wrapped by
+// samagg.EncodePartial that are all of the current version.
+func isCurrentPartial(rec *vector.Record) bool {
+	fields := rec.Typ.Fields
+	if !rec.Nulls.IsZero() || len(fields) != 2 || fields[0].Name != "version" ||
+		fields[0].Type != super.TypeUint8 || fields[1].Name != "partial" {
+		return false
+	}
+	switch version := rec.Fields[0].(type) {
+	case *vector.Const:
+		return version.Nulls.IsZero() && version.Value().Uint() == samagg.PartialVersion
+	case *vector.Uint:
+		if !version.Nulls.IsZero() {
+			return false
+		}
+		for _, v := range version.Values {
+			if v != samagg.PartialVersion {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
@@ -0,0 +1,89 @@
+package agg
+
+import (
+	"fmt"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/tdigest"
+	"github.com/brimdata/super/vector"
+)
+
+// percentile uses a t-digest to estimate a percentile of numeric values.
+type percentile struct {
+	p      float64
+	digest *tdigest.TDigest
+}
+
+func newPercentile(p float64) *percentile {
+	return &percentile{
+		p:      p,
+		digest: tdigest.New(tdigest.DefaultCompression),
+	}
+}
+
+func (p *percentile) Consume(vec vector.Any) {
+	id := vector.Under(vec).Type().ID()
+	if !super.IsNumber(id) {
+		return
+	}
+	for i := range vec.Len() {
+		var f float64
+		var null bool
+		switch {
+		case super.IsFloat(id):
+			f, null = vector.FloatValue(vec, i)
+		case super.IsSigned(id):
+			var v int64
+			v, null = vector.IntValue(vec, i)
+			f = float64(v)
+		default:
+			var v uint64
+			v, null = vector.UintValue(vec, i)
+			f = float64(v)
+		}
+		if !null {
+			p.digest.Add(f)
+		}
+	}
+}
+
+func (p *percentile) Result(*super.Context) super.Value {
+	if p.digest.Count() == 0 {
+		return super.NullFloat64
+	}
+	return super.NewFloat64(p.digest.Quantile(p.p / 100))
+}
+
+func (p *percentile) ConsumeAsPartial(partial vector.Any) {
+	if partial.Type() != super.TypeBytes || partial.Len() != 1 {
+		panic("percentile: invalid partial")
+	}
+	b, _ := vector.BytesValue(partial, 0)
+	var d tdigest.TDigest
+	if err := d.UnmarshalBinary(b); err != nil {
+		panic(fmt.Errorf("percentile: unmarshaling partial: %w", err))
+	}
+	p.digest.Merge(&d)
+}
+
+func (p *percentile) ResultAsPartial(*super.Context) super.Value {
+	b, err := p.digest.MarshalBinary()
+	if err != nil {
+		panic(fmt.Errorf("percentile: marshaling partial: %w", err))
+	}
+	return super.NewBytes(b)
+}
+
+// mad uses a t-digest to estimate the median absolute deviation of numeric
+// values.  See samagg.MAD.
+type mad struct {
+	*percentile
+}
+
+func (m mad) Result(*super.Context) super.Value {
+	if m.digest.Count() == 0 {
+		return super.NullFloat64
+	}
+	median := m.digest.Quantile(0.5)
+	return super.NewFloat64(m.digest.Deviations(median).Quantile(0.5))
+}
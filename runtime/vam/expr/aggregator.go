@@ -15,8 +15,8 @@ type Aggregator struct {
 	Where    Evaluator
 }
 
-func NewAggregator(name string, distinct bool, expr Evaluator, where Evaluator) (*Aggregator, error) {
-	pattern, err := agg.NewPattern(name, distinct, expr != nil)
+func NewAggregator(name string, distinct bool, expr Evaluator, where Evaluator, params ...float64) (*Aggregator, error) {
+	pattern, err := agg.NewPattern(name, distinct, expr != nil, params...)
 	if err != nil {
 		return nil, err
 	}
@@ -61,6 +61,10 @@ func (a *Arith) eval(vecs ...vector.Any) (out vector.Any) {
 		s := fmt.Sprintf("type %s incompatible with '%s' operator", sup.FormatType(lhs.Type()), vector.ArithOpToString(a.opCode))
 		return vector.NewStringError(a.sctx, s, lhs.Len())
 	}
+	if vector.AllNulls(lhs) || vector.AllNulls(rhs) {
+		// Every result is null so skip the kernel.
+		return vector.NewConst(super.NewValue(lhs.Type(), nil), lhs.Len(), bitvec.Zero)
+	}
 	if a.opCode == vector.ArithDiv || a.opCode == vector.ArithMod {
 		defer func() {
 			if v := recover(); v != nil {
@@ -73,6 +77,9 @@ func (a *Arith) eval(vecs ...vector.Any) (out vector.Any) {
 		}()
 	}
 	out = f(lhs, rhs)
+	if vector.NoNulls(lhs) && vector.NoNulls(rhs) {
+		return out
+	}
 	return vector.CopyAndSetNulls(out, bitvec.Or(vector.NullsOf(lhs), vector.NullsOf(rhs)))
 }
 
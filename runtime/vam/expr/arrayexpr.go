@@ -4,7 +4,6 @@ import (
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/vector"
 	"github.com/brimdata/super/vector/bitvec"
-	"github.com/brimdata/super/zcode"
 )
 
 type ListElem struct {
@@ -82,11 +81,12 @@ func buildList(sctx *super.Context, elems []ListElem, in []vector.Any) ([]uint32
 				tags = append(tags, tag)
 				size++
 			} else {
+				j := i
 				if index := viewIndexes[k]; index != nil {
-					i = index[i]
+					j = index[i]
 				}
-				off := spreadOff[i]
-				for end := spreadOff[i+1]; off < end; off++ {
+				off := spreadOff[j]
+				for end := spreadOff[j+1]; off < end; off++ {
 					if utags != nil {
 						tags = append(tags, tag+utags[off])
 					} else {
@@ -107,7 +107,7 @@ func buildList(sctx *super.Context, elems []ListElem, in []vector.Any) ([]uint32
 	}
 	types := super.UniqueTypes(all)
 	if len(types) == 1 {
-		return offsets, mergeSameTypeVecs(types[0], tags, vecs)
+		return offsets, vector.Normalize(vector.NewDynamic(tags, vecs))
 	}
 	return offsets, vector.NewUnion(sctx.LookupTypeUnion(types), tags, vecs, bitvec.Zero)
 }
@@ -124,18 +124,3 @@ func unwrapSpread(vec vector.Any) (vector.Any, []uint32, []uint32) {
 	}
 	return nil, nil, nil
 }
-
-func mergeSameTypeVecs(typ super.Type, tags []uint32, vecs []vector.Any) vector.Any {
-	// XXX This is going to be slow. At some point would nice to write a native
-	// merge of same type vectors.
-	counts := make([]uint32, len(vecs))
-	vb := vector.NewBuilder(typ)
-	var b zcode.Builder
-	for _, tag := range tags {
-		b.Truncate()
-		vecs[tag].Serialize(&b, counts[tag])
-		vb.Write(b.Bytes().Body())
-		counts[tag]++
-	}
-	return vb.Build(bitvec.Zero)
-}
@@ -36,7 +36,14 @@ func (c *Compare) eval(vecs ...vector.Any) vector.Any {
 	if _, ok := rhs.(*vector.Error); ok {
 		return vecs[1]
 	}
-	nulls := bitvec.Or(vector.NullsOf(lhs), vector.NullsOf(rhs))
+	if vector.AllNulls(lhs) || vector.AllNulls(rhs) {
+		// Every result is null so skip the kernel.
+		return vector.NewConst(super.NullBool, lhs.Len(), bitvec.Zero)
+	}
+	var nulls bitvec.Bits
+	if !vector.NoNulls(lhs) || !vector.NoNulls(rhs) {
+		nulls = bitvec.Or(vector.NullsOf(lhs), vector.NullsOf(rhs))
+	}
 	lhs, rhs, errVal := coerceVals(c.sctx, lhs, rhs)
 	if errVal != nil {
 		// if incompatible types return false
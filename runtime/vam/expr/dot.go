@@ -80,6 +80,13 @@ func (d *DotExpr) eval(vecs ...vector.Any) vector.Any {
 	case *vector.Map:
 		panic("vam.DotExpr Map TBD")
 	case *vector.View:
+		if rec, ok := val.Any.(*vector.Record); ok {
+			i, ok := rec.Typ.IndexOfField(d.field)
+			if !ok {
+				return vector.NewMissing(d.sctx, val.Len())
+			}
+			return val.Field(i)
+		}
 		return vector.Pick(d.eval(val.Any), val.Index)
 	default:
 		return vector.NewMissing(d.sctx, val.Len())
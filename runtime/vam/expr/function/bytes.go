@@ -1,6 +1,7 @@
 package function
 
 import (
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
 
@@ -102,3 +103,37 @@ func (h *Hex) Call(args ...vector.Any) vector.Any {
 		return vector.NewWrappedError(h.sctx, "hex: argument must a bytes or string type", val)
 	}
 }
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#sha256
+type SHA256 struct {
+	sctx *super.Context
+}
+
+func (s *SHA256) Call(args ...vector.Any) vector.Any {
+	val := vector.Under(args[0])
+	switch id := val.Type().ID(); id {
+	case super.IDBytes, super.IDString:
+		out := vector.NewStringEmpty(val.Len(), bitvec.NewFalse(val.Len()))
+		for i := uint32(0); i < val.Len(); i++ {
+			var bytes []byte
+			var null bool
+			if id == super.IDBytes {
+				bytes, null = vector.BytesValue(val, i)
+			} else {
+				var str string
+				str, null = vector.StringValue(val, i)
+				bytes = []byte(str)
+			}
+			if null {
+				out.Nulls.Set(i)
+				out.Append("")
+				continue
+			}
+			sum := sha256.Sum256(bytes)
+			out.Append(hex.EncodeToString(sum[:]))
+		}
+		return out
+	default:
+		return vector.NewWrappedError(s.sctx, "sha256: argument must a bytes or string type", val)
+	}
+}
@@ -0,0 +1,76 @@
+package function
+
+import (
+	"github.com/brimdata/super"
+	samfunc "github.com/brimdata/super/runtime/sam/expr/function"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/vector/bitvec"
+)
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#shannon_entropy
+type ShannonEntropy struct {
+	sctx *super.Context
+}
+
+func (s *ShannonEntropy) Call(args ...vector.Any) vector.Any {
+	val := vector.Under(args[0])
+	if val.Type() != super.TypeString {
+		return vector.NewWrappedError(s.sctx, "shannon_entropy: string arg required", val)
+	}
+	out := vector.NewFloatEmpty(super.TypeFloat64, val.Len(), bitvec.NewFalse(val.Len()))
+	for i := range val.Len() {
+		str, null := vector.StringValue(val, i)
+		if null {
+			out.Nulls.Set(i)
+		}
+		out.Append(samfunc.Entropy(str))
+	}
+	return out
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#char_ratio
+type CharRatio struct {
+	sctx *super.Context
+}
+
+func (c *CharRatio) Call(args ...vector.Any) vector.Any {
+	args = underAll(args)
+	val, classVec := args[0], args[1]
+	if val.Type() != super.TypeString {
+		return vector.NewWrappedError(c.sctx, "char_ratio: string arg required", val)
+	}
+	if classVec.Type() != super.TypeString {
+		return vector.NewWrappedError(c.sctx, "char_ratio: character class must be a string", classVec)
+	}
+	var errs []uint32
+	var floats []float64
+	var nulls bitvec.Bits
+	var name string
+	var class func(rune) bool
+	for i := range val.Len() {
+		if s, _ := vector.StringValue(classVec, i); class == nil || s != name {
+			name, class = s, samfunc.LookupCharClass(s)
+		}
+		if class == nil {
+			errs = append(errs, i)
+			continue
+		}
+		s, null := vector.StringValue(val, i)
+		if null {
+			if nulls.IsZero() {
+				nulls = bitvec.NewFalse(val.Len())
+			}
+			nulls.Set(uint32(len(floats)))
+		}
+		floats = append(floats, samfunc.RatioOf(s, class))
+	}
+	out := vector.NewFloat(super.TypeFloat64, floats, nulls)
+	if !nulls.IsZero() {
+		nulls.Shorten(out.Len())
+	}
+	if len(errs) > 0 {
+		err := vector.NewWrappedError(c.sctx, "char_ratio: unknown character class", vector.Pick(classVec, errs))
+		return vector.Combine(out, errs, err)
+	}
+	return out
+}
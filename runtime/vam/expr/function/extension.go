@@ -0,0 +1,17 @@
+package function
+
+import (
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/sam/expr/function"
+	"github.com/brimdata/super/vector"
+)
+
+// extFunc calls a function registered with function.Register.
+type extFunc struct {
+	sctx *super.Context
+	fn   *function.Func
+}
+
+func (e *extFunc) Call(args ...vector.Any) vector.Any {
+	return e.fn.Call(e.sctx, args...)
+}
@@ -0,0 +1,43 @@
+package function_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/sam/expr/function"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/vector/bitvec"
+	"github.com/brimdata/super/ztest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegister(t *testing.T) {
+	err := function.Register(function.Func{
+		Name: "Shout",
+		Args: []super.Type{super.TypeString},
+		Kernel: func(sctx *super.Context, args ...vector.Any) vector.Any {
+			n := args[0].Len()
+			out := vector.NewStringEmpty(n, bitvec.NewFalse(n))
+			for i := range n {
+				s, null := vector.StringValue(args[0], i)
+				if null {
+					out.Nulls.Set(i)
+				}
+				out.Append(strings.ToUpper(s) + "!")
+			}
+			return out
+		},
+	})
+	require.NoError(t, err)
+	zt := ztest.ZTest{
+		Zed:    "yield shout(this)",
+		Input:  `"hi" null(string) 1 error("x")`,
+		Output: "\"HI!\"\nnull(string)\nerror({message:\"shout: argument 1 must be of type string\",on:1})\nerror(\"x\")\n",
+		Vector: true,
+	}
+	require.NoError(t, zt.RunInternal())
+	require.Error(t, function.Register(function.Func{Name: "shout", Kernel: func(*super.Context, ...vector.Any) vector.Any { return nil }}))
+	require.Error(t, function.Register(function.Func{Name: "upper", Kernel: func(*super.Context, ...vector.Any) vector.Any { return nil }}))
+	require.Error(t, function.Register(function.Func{Name: "count", Kernel: func(*super.Context, ...vector.Any) vector.Any { return nil }}))
+}
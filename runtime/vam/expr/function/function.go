@@ -26,6 +26,9 @@ func New(sctx *super.Context, name string, narg int) (expr.Function, field.Path,
 		f = &Bucket{sctx: sctx, name: name}
 	case "ceil":
 		f = &Ceil{sctx}
+	case "char_ratio":
+		argmin, argmax = 2, 2
+		f = &CharRatio{sctx}
 	case "cidr_match":
 		argmin = 2
 		argmax = 2
@@ -33,6 +36,9 @@ func New(sctx *super.Context, name string, narg int) (expr.Function, field.Path,
 	case "coalesce":
 		argmax = -1
 		f = &Coalesce{}
+	case "collate":
+		argmin, argmax = 2, 2
+		f = newCollate(sctx)
 	case "date_part":
 		argmin = 2
 		argmax = 2
@@ -48,6 +54,8 @@ func New(sctx *super.Context, name string, narg int) (expr.Function, field.Path,
 		f = newFlatten(sctx)
 	case "floor":
 		f = &Floor{sctx}
+	case "geoip":
+		f = newGeoIP(sctx)
 	case "grep":
 		argmax = 2
 		f = &Grep{sctx: sctx}
@@ -95,6 +103,11 @@ func New(sctx *super.Context, name string, narg int) (expr.Function, field.Path,
 	case "network_of":
 		argmax = 2
 		f = &NetworkOf{sctx}
+	case "parse_json":
+		f = newParseJSON(sctx)
+	case "parse_kv":
+		argmax = 3
+		f = &ParseKV{sctx}
 	case "parse_sup":
 		f = newParseSUP(sctx)
 	case "parse_uri":
@@ -121,6 +134,10 @@ func New(sctx *super.Context, name string, narg int) (expr.Function, field.Path,
 		f = &Round{sctx}
 	case "rune_len":
 		f = &RuneLen{sctx}
+	case "sha256":
+		f = &SHA256{sctx}
+	case "shannon_entropy":
+		f = &ShannonEntropy{sctx}
 	case "split":
 		argmin, argmax = 2, 2
 		f = &Split{sctx}
@@ -142,7 +159,12 @@ func New(sctx *super.Context, name string, narg int) (expr.Function, field.Path,
 	case "upper":
 		f = &ToUpper{sctx}
 	default:
-		return nil, nil, function.ErrNoSuchFunction
+		fn := function.LookupFunc(name)
+		if fn == nil {
+			return nil, nil, function.ErrNoSuchFunction
+		}
+		argmin, argmax = len(fn.Args), len(fn.Args)
+		f = &extFunc{sctx, fn}
 	}
 	if err := function.CheckArgCount(narg, argmin, argmax); err != nil {
 		return nil, nil, err
@@ -0,0 +1,34 @@
+package function
+
+import (
+	"github.com/brimdata/super"
+	samfunc "github.com/brimdata/super/runtime/sam/expr/function"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/zcode"
+)
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#geoip
+type GeoIP struct {
+	sctx  *super.Context
+	samfn *samfunc.GeoIP
+}
+
+func newGeoIP(sctx *super.Context) *GeoIP {
+	return &GeoIP{sctx, samfunc.NewGeoIP(sctx)}
+}
+
+func (g *GeoIP) Call(args ...vector.Any) vector.Any {
+	vec := vector.Under(args[0])
+	if vec.Type().ID() != super.IDIP {
+		return vector.NewWrappedError(g.sctx, "geoip: ip arg required", args[0])
+	}
+	var b zcode.Builder
+	db := vector.NewDynamicBuilder()
+	for i := range vec.Len() {
+		b.Truncate()
+		vec.Serialize(&b, i)
+		val := super.NewValue(super.TypeIP, b.Bytes().Body())
+		db.Write(g.samfn.Call(nil, []super.Value{val}))
+	}
+	return db.Build()
+}
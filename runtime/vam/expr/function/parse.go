@@ -1,6 +1,7 @@
 package function
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/brimdata/super"
@@ -81,3 +82,171 @@ func (p *ParseSUP) Call(args ...vector.Any) vector.Any {
 	}
 	return out
 }
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#parse_json
+type ParseJSON struct {
+	sctx  *super.Context
+	samfn *samfunc.ParseJSON
+}
+
+func newParseJSON(sctx *super.Context) *ParseJSON {
+	return &ParseJSON{sctx, samfunc.NewParseJSON(sctx)}
+}
+
+func (p *ParseJSON) Call(args ...vector.Any) vector.Any {
+	vec := vector.Under(args[0])
+	if vec.Type().ID() != super.IDString {
+		return vector.NewWrappedError(p.sctx, "parse_json: string arg required", args[0])
+	}
+	var errs []uint32
+	errMsgs := vector.NewStringEmpty(0, bitvec.Zero)
+	builder := vector.NewDynamicBuilder()
+	for i := range vec.Len() {
+		s, null := vector.StringValue(vec, i)
+		if null {
+			builder.Write(super.Null)
+			continue
+		}
+		val, err := p.samfn.Parse(s)
+		if err != nil {
+			errs = append(errs, i)
+			errMsgs.Append("parse_json: " + err.Error())
+			continue
+		}
+		builder.Write(val)
+	}
+	out := builder.Build()
+	if len(errs) > 0 {
+		return vector.Combine(out, errs, vector.NewVecWrappedError(p.sctx, errMsgs, vector.Pick(args[0], errs)))
+	}
+	return out
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#parse_kv
+type ParseKV struct {
+	sctx *super.Context
+}
+
+// kvGroup holds the string fields of the rows whose pairs have the same
+// keys in the same order.
+type kvGroup struct {
+	tag    uint32
+	names  []string
+	fields []*vector.String
+	len    uint32
+}
+
+func (p *ParseKV) Call(args ...vector.Any) vector.Any {
+	vec := vector.Under(args[0])
+	if vec.Type().ID() != super.IDString {
+		return vector.NewWrappedError(p.sctx, "parse_kv: string arg required", args[0])
+	}
+	for _, arg := range args[1:] {
+		if vector.Under(arg).Type().ID() != super.IDString {
+			return vector.NewWrappedError(p.sctx, "parse_kv: separator must be a non-empty string", arg)
+		}
+	}
+	var groups []*kvGroup
+	var last *kvGroup
+	var tags, nulls, errs []uint32
+	sepErrs := make([][]uint32, len(args))
+	var names, values []string
+	errMsgs := vector.NewStringEmpty(0, bitvec.Zero)
+	for i := range vec.Len() {
+		pairSep, kvSep, bad := kvSeparators(args, i)
+		if bad > 0 {
+			sepErrs[bad] = append(sepErrs[bad], i)
+			continue
+		}
+		s, null := vector.StringValue(vec, i)
+		if null {
+			nulls = append(nulls, i)
+			tags = append(tags, 0)
+			continue
+		}
+		var err error
+		names, values, err = samfunc.ParseKVPairs(s, pairSep, kvSep, names[:0], values[:0])
+		if err != nil {
+			errs = append(errs, i)
+			errMsgs.Append("parse_kv: " + err.Error())
+			continue
+		}
+		// Consecutive values usually have the same keys so check the
+		// most recently used group first.
+		if last == nil || !slices.Equal(last.names, names) {
+			k := slices.IndexFunc(groups, func(g *kvGroup) bool {
+				return slices.Equal(g.names, names)
+			})
+			if k < 0 {
+				k = len(groups)
+				g := &kvGroup{tag: uint32(k + 1), names: slices.Clone(names)}
+				for range names {
+					g.fields = append(g.fields, vector.NewStringEmpty(0, bitvec.Zero))
+				}
+				groups = append(groups, g)
+			}
+			last = groups[k]
+		}
+		for k, value := range values {
+			last.fields[k].Append(value)
+		}
+		last.len++
+		tags = append(tags, last.tag)
+	}
+	var vecs []vector.Any
+	if len(nulls) > 0 {
+		vecs = append(vecs, vector.NewConst(super.Null, uint32(len(nulls)), bitvec.Zero))
+	}
+	for _, g := range groups {
+		vecs = append(vecs, g.build(p.sctx))
+	}
+	var out vector.Any
+	switch len(vecs) {
+	case 0:
+		// Every value is an error.
+		out = vector.NewConst(super.Null, 0, bitvec.Zero)
+	case 1:
+		out = vecs[0]
+	default:
+		if len(nulls) == 0 {
+			// Tag 0 is reserved for nulls.
+			for k := range tags {
+				tags[k]--
+			}
+		}
+		out = vector.NewDynamic(tags, vecs)
+	}
+	c := vector.NewCombiner(out)
+	if len(errs) > 0 {
+		c.Add(errs, vector.NewVecWrappedError(p.sctx, errMsgs, vector.Pick(args[0], errs)))
+	}
+	for k, index := range sepErrs[1:] {
+		c.WrappedError(p.sctx, index, "parse_kv: separator must be a non-empty string", args[k+1])
+	}
+	return c.Result()
+}
+
+func (g *kvGroup) build(sctx *super.Context) vector.Any {
+	fields := make([]super.Field, 0, len(g.names))
+	vecs := make([]vector.Any, 0, len(g.names))
+	for k, name := range g.names {
+		fields = append(fields, super.NewField(name, super.TypeString))
+		vecs = append(vecs, g.fields[k])
+	}
+	return vector.NewRecord(sctx.MustLookupTypeRecord(fields), vecs, g.len, bitvec.Zero)
+}
+
+// kvSeparators returns the pair and key/value separators for slot along
+// with the index of the first invalid separator in args or 0 if both are
+// valid.
+func kvSeparators(args []vector.Any, slot uint32) (string, string, int) {
+	seps := []string{" ", "="}
+	for k, arg := range args[1:] {
+		s, null := vector.StringValue(vector.Under(arg), slot)
+		if null || s == "" {
+			return "", "", k + 1
+		}
+		seps[k] = s
+	}
+	return seps[0], seps[1], 0
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/agnivade/levenshtein"
 	"github.com/brimdata/super"
+	samexpr "github.com/brimdata/super/runtime/sam/expr"
 	"github.com/brimdata/super/vector"
 	"github.com/brimdata/super/vector/bitvec"
 )
@@ -250,3 +251,54 @@ func (t *Trim) Call(args ...vector.Any) vector.Any {
 	}
 	return out
 }
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#collate
+type Collate struct {
+	sctx      *super.Context
+	collators map[string]samexpr.Collator
+}
+
+func newCollate(sctx *super.Context) *Collate {
+	return &Collate{sctx: sctx, collators: make(map[string]samexpr.Collator)}
+}
+
+func (c *Collate) Call(args ...vector.Any) vector.Any {
+	vec, nameVec := vector.Under(args[0]), vector.Under(args[1])
+	if nameVec.Type() != super.TypeString {
+		return vector.NewWrappedError(c.sctx, "collate: collation name must be a string", args[1])
+	}
+	if vec.Type() != super.TypeString {
+		return args[0]
+	}
+	out := vector.NewStringEmpty(vec.Len(), bitvec.NewFalse(vec.Len()))
+	for i := uint32(0); i < vec.Len(); i++ {
+		name, null := vector.StringValue(nameVec, i)
+		if null {
+			return vector.NewWrappedError(c.sctx, "collate: collation name must be a string", args[1])
+		}
+		collator, err := c.lookup(name)
+		if err != nil {
+			return vector.NewWrappedError(c.sctx, "collate: "+err.Error(), args[1])
+		}
+		s, null := vector.StringValue(vec, i)
+		if null {
+			out.Nulls.Set(i)
+		} else if collator != nil {
+			s = collator.Key(s)
+		}
+		out.Append(s)
+	}
+	return out
+}
+
+func (c *Collate) lookup(name string) (samexpr.Collator, error) {
+	collator, ok := c.collators[name]
+	if !ok {
+		var err error
+		if collator, err = samexpr.NewCollator(name); err != nil {
+			return nil, err
+		}
+		c.collators[name] = collator
+	}
+	return collator, nil
+}
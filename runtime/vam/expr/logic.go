@@ -57,7 +57,18 @@ func NewLogicalOr(sctx *super.Context, lhs, rhs Evaluator) *Or {
 }
 
 func (a *And) Eval(val vector.Any) vector.Any {
-	return evalBool(a.sctx, a.eval, a.lhs.Eval(val), a.rhs.Eval(val))
+	// Since anything and false is false, the right operand is evaluated
+	// only for the slots where the left operand is not false.
+	lhs := a.lhs.Eval(val)
+	index := undetermined(lhs, false)
+	switch len(index) {
+	case 0:
+		return vector.NewConst(super.False, val.Len(), bitvec.Zero)
+	case int(val.Len()):
+		return evalBool(a.sctx, a.eval, lhs, a.rhs.Eval(val))
+	}
+	rhs := a.rhs.Eval(vector.Pick(val, index))
+	return scatterBool(val.Len(), false, index, evalBool(a.sctx, a.eval, vector.Pick(lhs, index), rhs))
 }
 
 func (a *And) eval(vecs ...vector.Any) vector.Any {
@@ -104,7 +115,18 @@ func (a *And) andError(err vector.Any, vec vector.Any) vector.Any {
 }
 
 func (o *Or) Eval(val vector.Any) vector.Any {
-	return evalBool(o.sctx, o.eval, o.lhs.Eval(val), o.rhs.Eval(val))
+	// Since anything or true is true, the right operand is evaluated only
+	// for the slots where the left operand is not true.
+	lhs := o.lhs.Eval(val)
+	index := undetermined(lhs, true)
+	switch len(index) {
+	case 0:
+		return vector.NewConst(super.True, val.Len(), bitvec.Zero)
+	case int(val.Len()):
+		return evalBool(o.sctx, o.eval, lhs, o.rhs.Eval(val))
+	}
+	rhs := o.rhs.Eval(vector.Pick(val, index))
+	return scatterBool(val.Len(), true, index, evalBool(o.sctx, o.eval, vector.Pick(lhs, index), rhs))
 }
 
 func (o *Or) eval(vecs ...vector.Any) vector.Any {
@@ -149,6 +171,72 @@ func (o *Or) orError(err, vec vector.Any) vector.Any {
 	return vec
 }
 
+// undetermined returns the slots of vec that are not the bool value, i.e.,
+// the slots for which the result of a logical operator whose left operand is
+// vec depends on its right operand.
+func undetermined(vec vector.Any, value bool) []uint32 {
+	n := vec.Len()
+	index := make([]uint32, 0, n)
+	switch under := vector.Under(vec).(type) {
+	case *vector.Bool:
+		for i := range n {
+			if under.Nulls.IsSet(i) || under.Bits.IsSetDirect(i) != value {
+				index = append(index, i)
+			}
+		}
+	case *vector.Dynamic:
+		for i := range n {
+			if super.TypeUnder(under.TypeOf(i)) != super.TypeBool {
+				index = append(index, i)
+			} else if b, null := vector.BoolValue(under, i); null || b != value {
+				index = append(index, i)
+			}
+		}
+	default:
+		if under.Type() != super.TypeBool {
+			for i := range n {
+				index = append(index, i)
+			}
+			break
+		}
+		for i := range n {
+			if b, null := vector.BoolValue(under, i); null || b != value {
+				index = append(index, i)
+			}
+		}
+	}
+	return index
+}
+
+// scatterBool returns a vector of length n whose slots in index hold the
+// values of partial and whose other slots are the bool value.
+func scatterBool(n uint32, value bool, index []uint32, partial vector.Any) vector.Any {
+	b, ok := partial.(*vector.Bool)
+	if !ok {
+		base := vector.NewConst(super.NewBool(value), n-uint32(len(index)), bitvec.Zero)
+		return vector.Normalize(vector.Combine(base, index, partial))
+	}
+	bits := bitvec.NewFalse(n)
+	var nulls bitvec.Bits
+	var k uint32
+	for i := range n {
+		if int(k) < len(index) && index[k] == i {
+			if b.Nulls.IsSet(k) {
+				if nulls.IsZero() {
+					nulls = bitvec.NewFalse(n)
+				}
+				nulls.Set(i)
+			} else if b.Bits.IsSetDirect(k) {
+				bits.Set(i)
+			}
+			k++
+		} else if value {
+			bits.Set(i)
+		}
+	}
+	return vector.NewBool(bits, nulls)
+}
+
 // evalBool evaluates e using val to computs a boolean result.  For elements
 // of the result that are not boolean, an error is calculated for each non-bool
 // slot and they are returned as an error.  If all of the value slots are errors,
@@ -0,0 +1,160 @@
+package expr
+
+import (
+	"fmt"
+
+	"github.com/brimdata/super"
+	samexpr "github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/vam/expr/cast"
+	"github.com/brimdata/super/sup"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/vector/bitvec"
+)
+
+// ConstShaper shapes vectors to a fixed type according to a set of shaper
+// transforms (e.g., those of the crop, fill, order, and shape functions).
+// The output type for each input type is computed once from the type
+// metadata and each vector is shaped as a whole by rearranging its fields,
+// filling in null fields, and casting its primitive fields.  Vectors that
+// cannot be shaped this way, e.g., those needing a cast to or from a union,
+// are shaped value by value with the sam shaper.
+type ConstShaper struct {
+	sctx     *super.Context
+	expr     Evaluator
+	shapeTo  super.Type
+	tf       samexpr.ShaperTransform
+	fallback Evaluator
+}
+
+// NewConstShaper returns a shaper that shapes the result of expr to shapeTo
+// according to tf.
+func NewConstShaper(sctx *super.Context, expr Evaluator, shapeTo super.Type, tf samexpr.ShaperTransform) *ConstShaper {
+	return &ConstShaper{
+		sctx:     sctx,
+		expr:     expr,
+		shapeTo:  shapeTo,
+		tf:       tf,
+		fallback: NewSamExpr(samexpr.NewConstShaper(sctx, &samexpr.This{}, shapeTo, tf)),
+	}
+}
+
+// NewLiteralShaper returns a ConstShaper for the type in literal or an error
+// if literal is not a type value.
+func NewLiteralShaper(sctx *super.Context, expr Evaluator, literal *Literal, tf samexpr.ShaperTransform) (*ConstShaper, error) {
+	if literal.val.Type().ID() != super.IDType {
+		return nil, fmt.Errorf("shaper type argument is not a type: %s", sup.FormatValue(literal.val))
+	}
+	typ, err := sctx.LookupByValue(literal.val.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return NewConstShaper(sctx, expr, typ, tf), nil
+}
+
+func (c *ConstShaper) Eval(this vector.Any) vector.Any {
+	return vector.Apply(true, c.eval, c.expr.Eval(this))
+}
+
+func (c *ConstShaper) eval(vecs ...vector.Any) vector.Any {
+	vec := vecs[0]
+	typ := vec.Type()
+	if typ.Kind() == super.ErrorKind {
+		return vec
+	}
+	if typ == super.TypeNull {
+		// Null values can be shaped to any type.
+		return vector.NewConst(super.NewValue(c.shapeTo, nil), vec.Len(), bitvec.Zero)
+	}
+	out, err := samexpr.ShaperType(c.sctx, c.tf, typ, c.shapeTo)
+	if err != nil {
+		return vector.NewStringError(c.sctx, err.Error(), vec.Len())
+	}
+	if out != c.shapeTo && !vector.NullsOf(vec).IsZero() {
+		// A null value is shaped to c.shapeTo rather than to out so
+		// the result would have more than one type.
+		return c.fallback.Eval(vec)
+	}
+	if shaped, ok := c.shape(vec, out); ok {
+		return shaped
+	}
+	return c.fallback.Eval(vec)
+}
+
+// shape returns vec shaped to type out along with true or nil and false if
+// vec cannot be shaped to out as a whole.
+func (c *ConstShaper) shape(vec vector.Any, out super.Type) (vector.Any, bool) {
+	in := vec.Type()
+	switch {
+	case in == out:
+		return vec, true
+	case in.ID() == super.IDNull:
+		return vector.NewConst(super.NewValue(out, nil), vec.Len(), bitvec.Zero), true
+	case in.ID() == out.ID():
+		// Same underlying types but one or both are named.
+		return withType(vector.Under(vec), out), true
+	case super.IsPrimitiveType(in) && super.IsPrimitiveType(out):
+		under := super.TypeUnder(out)
+		vec = cast.To(c.sctx, vec, under)
+		if !hasType(vec, under) {
+			// Some or all of the values could not be cast.
+			return nil, false
+		}
+		return withType(vec, out), true
+	}
+	switch vec := vector.Under(vec).(type) {
+	case *vector.Record:
+		outRec, ok := super.TypeUnder(out).(*super.TypeRecord)
+		if !ok {
+			return nil, false
+		}
+		inRec := super.TypeRecordOf(vec.Type())
+		fields := make([]vector.Any, 0, len(outRec.Fields))
+		for _, f := range outRec.Fields {
+			k, ok := inRec.IndexOfField(f.Name)
+			if !ok {
+				fields = append(fields, vector.NewConst(super.NewValue(f.Type, nil), vec.Len(), bitvec.Zero))
+				continue
+			}
+			field, ok := c.shape(vec.Fields[k], f.Type)
+			if !ok || !hasType(field, f.Type) {
+				return nil, false
+			}
+			fields = append(fields, field)
+		}
+		return withType(vector.NewRecord(outRec, fields, vec.Len(), vec.Nulls), out), true
+	case *vector.Array:
+		outArray, ok := super.TypeUnder(out).(*super.TypeArray)
+		if !ok {
+			return nil, false
+		}
+		values, ok := c.shape(vec.Values, outArray.Type)
+		if !ok || !hasType(values, outArray.Type) {
+			return nil, false
+		}
+		return withType(vector.NewArray(outArray, vec.Offsets, values, vec.Nulls), out), true
+	case *vector.View:
+		if shaped, ok := c.shape(vec.Any, out); ok {
+			return vector.Pick(shaped, vec.Index), true
+		}
+	case *vector.Dict:
+		if shaped, ok := c.shape(vec.Any, out); ok {
+			return vector.NewDict(shaped, vec.Index, vec.Counts, vec.Nulls), true
+		}
+	}
+	return nil, false
+}
+
+// withType returns vec, whose type has the same underlying type as typ,
+// with type typ.
+func withType(vec vector.Any, typ super.Type) vector.Any {
+	if named, ok := typ.(*super.TypeNamed); ok {
+		return vector.NewNamed(named, withType(vec, named.Type))
+	}
+	return vector.Under(vec)
+}
+
+// hasType returns true if all values of vec have type typ.
+func hasType(vec vector.Any, typ super.Type) bool {
+	_, ok := vec.(*vector.Dynamic)
+	return !ok && vec.Type() == typ
+}
@@ -27,6 +27,34 @@ func (m *Materializer) Pull(done bool) (zbuf.Batch, error) {
 	if vec == nil || err != nil {
 		return nil, err
 	}
+	return &vectorBatch{vec: vec}, nil
+}
+
+// vectorBatch is a zbuf.VectorBatch that materializes the values of its
+// vector on the first call to Values.  This lets writers that implement
+// zbuf.VectorWriter encode the vector directly while sequential operators
+// continue to see ordinary values.
+type vectorBatch struct {
+	vec  vector.Any
+	once sync.Once
+	vals []super.Value
+}
+
+var _ zbuf.VectorBatch = (*vectorBatch)(nil)
+
+func (*vectorBatch) Ref()                 {}
+func (*vectorBatch) Unref()               {}
+func (*vectorBatch) Vars() []super.Value  { return nil }
+func (b *vectorBatch) Vector() vector.Any { return b.vec }
+
+func (b *vectorBatch) Values() []super.Value {
+	b.once.Do(func() {
+		b.vals = materialize(b.vec)
+	})
+	return b.vals
+}
+
+func materialize(vec vector.Any) []super.Value {
 	d, _ := vec.(*vector.Dynamic)
 	var typ super.Type
 	if d == nil {
@@ -44,7 +72,7 @@ func (m *Materializer) Pull(done bool) (zbuf.Batch, error) {
 		vals = append(vals, val)
 		builder.Reset()
 	}
-	return zbuf.NewArray(vals), nil
+	return vals
 }
 
 type dematerializer struct {
@@ -63,6 +91,9 @@ func (d *dematerializer) Pull(done bool) (vector.Any, error) {
 	if batch == nil || err != nil {
 		return nil, err
 	}
+	if vb, ok := batch.(zbuf.VectorBatch); ok {
+		return vb.Vector(), nil
+	}
 	defer batch.Unref()
 	builder := vector.NewDynamicBuilder()
 	for _, val := range batch.Values() {
@@ -1,11 +1,16 @@
 package aggregate
 
 import (
+	"slices"
+
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/pkg/field"
+	samexpr "github.com/brimdata/super/runtime/sam/expr"
 	"github.com/brimdata/super/runtime/vam/expr"
+	"github.com/brimdata/super/runtime/vam/expr/agg"
 	"github.com/brimdata/super/vector"
 	"github.com/brimdata/super/vector/bitvec"
+	"github.com/brimdata/super/zcode"
 )
 
 type Aggregate struct {
@@ -16,17 +21,31 @@ type Aggregate struct {
 	aggs        []*expr.Aggregator
 	aggExprs    []expr.Evaluator
 	keyExprs    []expr.Evaluator
+	emit        expr.Evaluator
 	typeTable   *super.TypeVectorTable
 	builder     *vector.RecordBuilder
 	partialsIn  bool
 	partialsOut bool
+	// maxGroups, if nonzero, is the number of groups at which the
+	// tables are output as partial results before EOS.  See NewCombiner.
+	maxGroups int
+	// nvals is the number of values consumed since the tables were last
+	// output, and perBatch is true if the tables are output after each
+	// vector.
+	nvals    int
+	perBatch bool
 
 	types   []super.Type
 	tables  map[int]aggTable
 	results []aggTable
+	eos     bool
 }
 
-func New(parent vector.Puller, sctx *super.Context, aggNames []field.Path, aggExprs []expr.Evaluator, aggs []*expr.Aggregator, keyNames []field.Path, keyExprs []expr.Evaluator, partialsIn, partialsOut bool) (*Aggregate, error) {
+// New returns an Aggregate that computes aggs grouped by the keys computed
+// by keyExprs.  If emit is not nil and partialsOut is false, each result,
+// which holds the keys followed by the aggregations, is replaced upon output
+// by the value of emit evaluated over it.
+func New(parent vector.Puller, sctx *super.Context, aggNames []field.Path, aggExprs []expr.Evaluator, aggs []*expr.Aggregator, keyNames []field.Path, keyExprs []expr.Evaluator, emit expr.Evaluator, partialsIn, partialsOut bool) (*Aggregate, error) {
 	builder, err := vector.NewRecordBuilder(sctx, append(keyNames, aggNames...))
 	if err != nil {
 		return nil, err
@@ -37,6 +56,7 @@ func New(parent vector.Puller, sctx *super.Context, aggNames []field.Path, aggEx
 		aggs:        aggs,
 		aggExprs:    aggExprs,
 		keyExprs:    keyExprs,
+		emit:        emit,
 		tables:      make(map[int]aggTable),
 		typeTable:   super.NewTypeVectorTable(),
 		types:       make([]super.Type, len(keyExprs)),
@@ -46,13 +66,33 @@ func New(parent vector.Puller, sctx *super.Context, aggNames []field.Path, aggEx
 	}, nil
 }
 
+// NewCombiner returns an Aggregate that, like the sam Combiner, computes
+// partial results whose tables are output whenever they hold maxGroups
+// groups so that a group may appear in more than one of its results.
+func NewCombiner(parent vector.Puller, sctx *super.Context, aggNames []field.Path, aggExprs []expr.Evaluator, aggs []*expr.Aggregator, keyNames []field.Path, keyExprs []expr.Evaluator, maxGroups int) (*Aggregate, error) {
+	a, err := New(parent, sctx, aggNames, aggExprs, aggs, keyNames, keyExprs, nil, false, true)
+	if err != nil {
+		return nil, err
+	}
+	a.maxGroups = maxGroups
+	return a, nil
+}
+
 func (a *Aggregate) Pull(done bool) (vector.Any, error) {
 	if done {
+		clear(a.tables)
+		a.results, a.eos = nil, false
+		a.nvals, a.perBatch = 0, false
 		_, err := a.parent.Pull(done)
 		return nil, err
 	}
 	if a.results != nil {
-		return a.next(), nil
+		// Before EOS, results are those of a combiner's full tables
+		// and consumption resumes once they are output.
+		if vec := a.next(); vec != nil || a.eos {
+			a.eos = vec != nil
+			return vec, nil
+		}
 	}
 	for {
 		//XXX check context Done
@@ -61,19 +101,23 @@ func (a *Aggregate) Pull(done bool) (vector.Any, error) {
 			return nil, err
 		}
 		if vec == nil {
-			for _, t := range a.tables {
-				a.results = append(a.results, t)
-			}
-			clear(a.tables)
-			return a.next(), nil
+			a.flush()
+			a.nvals, a.perBatch = 0, false
+			vec := a.next()
+			a.eos = vec != nil
+			return vec, nil
 		}
 		var keys, vals []vector.Any
 		for _, e := range a.keyExprs {
 			keys = append(keys, e.Eval(vec))
 		}
 		if a.partialsIn {
-			for _, e := range a.aggExprs {
-				vals = append(vals, e.Eval(vec))
+			for i, e := range a.aggExprs {
+				val, err := agg.DecodePartial(a.sctx, a.aggs[i].Name, e.Eval(vec))
+				if err != nil {
+					return nil, err
+				}
+				vals = append(vals, val)
 			}
 		} else {
 			for _, e := range a.aggs {
@@ -86,10 +130,62 @@ func (a *Aggregate) Pull(done bool) (vector.Any, error) {
 			// no return value is expected.
 			return vector.NewConst(super.Null, args[0].Len(), bitvec.Zero)
 		}, append(keys, vals...)...)
+		if a.maxGroups > 0 {
+			a.nvals += int(vec.Len())
+			if ngroups := a.groups(); a.perBatch || ngroups >= a.maxGroups {
+				// As for the sam Combiner, the tables are output after
+				// each vector while they reduce the input poorly.
+				a.perBatch = float64(ngroups) > combinerMaxRatio*float64(a.nvals)
+				a.nvals = 0
+				a.flush()
+				if vec := a.next(); vec != nil {
+					return vec, nil
+				}
+			}
+		}
+	}
+}
+
+// combinerMaxRatio is the ratio of groups output to values consumed above
+// which a combiner outputs its tables after each vector.
+const combinerMaxRatio = 0.8
+
+func (a *Aggregate) groups() int {
+	var n int
+	for _, t := range a.tables {
+		n += t.len()
+	}
+	return n
+}
+
+// flush moves the nonempty tables to the results.
+func (a *Aggregate) flush() {
+	for _, t := range a.tables {
+		if t.len() > 0 {
+			a.results = append(a.results, t)
+		}
 	}
+	clear(a.tables)
 }
 
 func (a *Aggregate) consume(keys []vector.Any, vals []vector.Any) {
+	if samexpr.QueryCollation.Missing == samexpr.MissingNull {
+		for i, key := range keys {
+			missing, present := missingSlots(key)
+			if len(missing) == 0 {
+				continue
+			}
+			if len(present) > 0 {
+				// Consume the missing and present keys separately
+				// since their types differ once missing is null.
+				a.consume(pickAll(keys, missing), pickAll(vals, missing))
+				a.consume(pickAll(keys, present), pickAll(vals, present))
+				return
+			}
+			keys = slices.Clone(keys)
+			keys[i] = vector.NewConst(super.Null, key.Len(), bitvec.Zero)
+		}
+	}
 	var keyTypes []super.Type
 	for _, k := range keys {
 		keyTypes = append(keyTypes, k.Type())
@@ -103,9 +199,38 @@ func (a *Aggregate) consume(keys []vector.Any, vals []vector.Any) {
 	table.update(keys, vals)
 }
 
+// missingSlots returns the slots of vec that are missing and those that are
+// not if vec is of an error type.
+func missingSlots(vec vector.Any) ([]uint32, []uint32) {
+	typ, ok := vec.Type().(*super.TypeError)
+	if !ok {
+		return nil, nil
+	}
+	var missing, present []uint32
+	var b zcode.Builder
+	for slot := range vec.Len() {
+		b.Truncate()
+		vec.Serialize(&b, slot)
+		if typ.IsMissing(b.Bytes().Body()) {
+			missing = append(missing, slot)
+		} else {
+			present = append(present, slot)
+		}
+	}
+	return missing, present
+}
+
+func pickAll(vecs []vector.Any, index []uint32) []vector.Any {
+	out := make([]vector.Any, 0, len(vecs))
+	for _, vec := range vecs {
+		out = append(out, vector.Pick(vec, index))
+	}
+	return out
+}
+
 func (a *Aggregate) newAggTable(keyTypes []super.Type) aggTable {
 	// Check if we can us an optimized table, else go slow path.
-	if a.isCountByString(keyTypes) && len(a.aggs) == 1 && a.aggs[0].Where == nil {
+	if a.isCountByString(keyTypes) && len(a.aggs) == 1 && a.aggs[0].Where == nil && !a.partialsOut {
 		// countByString.update does not handle nulls in its vals param.
 		return newCountByString(a.builder, a.partialsIn)
 	}
@@ -131,5 +256,9 @@ func (a *Aggregate) next() vector.Any {
 	}
 	t := a.results[0]
 	a.results = a.results[1:]
-	return t.materialize()
+	vec := t.materialize()
+	if a.emit != nil && !a.partialsOut {
+		vec = a.emit.Eval(vec)
+	}
+	return vec
 }
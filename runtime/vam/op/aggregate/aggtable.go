@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/brimdata/super"
+	samagg "github.com/brimdata/super/runtime/sam/expr/agg"
 	"github.com/brimdata/super/runtime/vam/expr"
 	"github.com/brimdata/super/runtime/vam/expr/agg"
 	"github.com/brimdata/super/vector"
@@ -18,6 +19,8 @@ import (
 type aggTable interface {
 	update([]vector.Any, []vector.Any)
 	materialize() vector.Any
+	// len returns the number of groups in the table.
+	len() int
 }
 
 type superTable struct {
@@ -87,6 +90,10 @@ func (s *superTable) newRow(keys []vector.Any, index []uint32) aggRow {
 	return row
 }
 
+func (s *superTable) len() int {
+	return len(s.rows)
+}
+
 func (s *superTable) materialize() vector.Any {
 	if len(s.rows) == 0 {
 		return vector.NewConst(super.Null, 0, bitvec.Zero)
@@ -116,7 +123,7 @@ func (s *superTable) materializeAgg(i int) vector.Any {
 	b := vector.NewDynamicBuilder()
 	for _, row := range s.rows {
 		if s.partialsOut {
-			b.Write(row.funcs[i].ResultAsPartial(s.sctx))
+			b.Write(samagg.EncodePartial(s.sctx, row.funcs[i].ResultAsPartial(s.sctx)))
 		} else {
 			b.Write(row.funcs[i].Result(s.sctx))
 		}
@@ -139,6 +146,13 @@ func newCountByString(b *vector.RecordBuilder, partialsIn bool) aggTable {
 	}
 }
 
+func (c *countByString) len() int {
+	if c.nulls > 0 {
+		return len(c.table) + 1
+	}
+	return len(c.table)
+}
+
 func (c *countByString) update(keys, vals []vector.Any) {
 	if c.partialsIn {
 		c.updatePartial(keys[0], vals[0])
@@ -0,0 +1,185 @@
+package op
+
+import (
+	"context"
+	"sort"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/runtime"
+	samexpr "github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/sam/op/join"
+	"github.com/brimdata/super/runtime/vam/expr"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/zcode"
+)
+
+// AsOf is a join that matches each left value with the most recent right
+// value whose key is not greater than the left key.  See join.AsOfOp.
+type AsOf struct {
+	ctx context.Context
+	inputs
+	leftKey   expr.Evaluator
+	rightKey  expr.Evaluator
+	tolerance *super.Value
+	compare   samexpr.CompareFn
+	cutter    *samexpr.Cutter
+	splicer   *join.RecordSplicer
+
+	built bool
+	keys  []super.Value
+	vals  []super.Value
+	// partial holds the left vectors pulled before the right input
+	// reached EOS.
+	partial []vector.Any
+}
+
+func NewAsOf(rctx *runtime.Context, left, right vector.Puller, leftKey, rightKey expr.Evaluator, tolerance *super.Value, lhs []*samexpr.Lval, rhs []samexpr.Evaluator) *AsOf {
+	a := &AsOf{
+		ctx:       rctx.Context,
+		leftKey:   leftKey,
+		rightKey:  rightKey,
+		tolerance: tolerance,
+		compare:   samexpr.NewComparator(samexpr.NewSortExpr(&samexpr.This{}, order.Asc, order.NullsLast)).WithCollation(samexpr.QueryCollation).Compare,
+		cutter:    samexpr.NewCutter(rctx.Sctx, lhs, rhs),
+		splicer:   join.NewRecordSplicer(rctx.Sctx),
+	}
+	a.inputs = newInputs(rctx.Context, left, right, a.reset)
+	return a
+}
+
+func (a *AsOf) Pull(done bool) (vector.Any, error) {
+	if done {
+		return nil, a.done()
+	}
+	a.start()
+	if !a.built {
+		if err := a.build(); err != nil {
+			return nil, a.fail(err)
+		}
+		a.built = true
+	}
+	for {
+		var vec vector.Any
+		if len(a.partial) > 0 {
+			vec, a.partial = a.partial[0], a.partial[1:]
+		} else {
+			var err error
+			if vec, err = a.left.pull(); err != nil {
+				return nil, a.fail(err)
+			}
+			if vec == nil {
+				return nil, a.done()
+			}
+		}
+		out, err := a.probe(vec)
+		if err != nil {
+			return nil, a.fail(err)
+		}
+		if out.Len() > 0 {
+			return out, nil
+		}
+	}
+}
+
+// build pulls the right input until EOS while buffering the left input and
+// then sorts the right values by key.
+func (a *AsOf) build() error {
+	leftCh := a.left.resultCh
+	var keys, vals []super.Value
+	for {
+		var r result
+		select {
+		case r = <-leftCh:
+			if r = a.left.result(r); r.err != nil {
+				return r.err
+			}
+			if r.vector == nil {
+				leftCh = nil
+			} else {
+				a.partial = append(a.partial, r.vector)
+			}
+			continue
+		case r = <-a.right.resultCh:
+			r = a.right.result(r)
+		case <-a.ctx.Done():
+			return a.ctx.Err()
+		}
+		if r.err != nil {
+			return r.err
+		}
+		if r.vector == nil {
+			break
+		}
+		keyVec := a.rightKey.Eval(r.vector)
+		for i := range r.vector.Len() {
+			var keyBuilder, valBuilder zcode.Builder
+			key := vectorValue(&keyBuilder, keyVec, i)
+			if key.IsMissing() || key.IsNull() {
+				continue
+			}
+			keys = append(keys, key)
+			vals = append(vals, vectorValue(&valBuilder, r.vector, i))
+		}
+	}
+	indices := make([]int, len(keys))
+	for i := range indices {
+		indices[i] = i
+	}
+	sort.SliceStable(indices, func(i, j int) bool {
+		return a.compare(keys[indices[i]], keys[indices[j]]) < 0
+	})
+	a.keys = make([]super.Value, len(keys))
+	a.vals = make([]super.Value, len(keys))
+	for i, k := range indices {
+		a.keys[i], a.vals[i] = keys[k], vals[k]
+	}
+	return nil
+}
+
+func (a *AsOf) reset() {
+	a.built = false
+	a.keys, a.vals, a.partial = nil, nil, nil
+}
+
+func (a *AsOf) probe(vec vector.Any) (vector.Any, error) {
+	keyVec := a.leftKey.Eval(vec)
+	var keyBuilder, valBuilder zcode.Builder
+	b := vector.NewDynamicBuilder()
+	for i := range vec.Len() {
+		keyBuilder.Truncate()
+		key := vectorValue(&keyBuilder, keyVec, i)
+		if key.IsMissing() {
+			continue
+		}
+		valBuilder.Truncate()
+		leftVal := vectorValue(&valBuilder, vec, i)
+		rightVal := a.lookup(key)
+		if rightVal == nil {
+			b.Write(leftVal)
+			continue
+		}
+		val, err := a.splicer.Splice(leftVal, a.cutter.Eval(nil, *rightVal))
+		if err != nil {
+			return nil, err
+		}
+		b.Write(val)
+	}
+	return b.Build(), nil
+}
+
+func (a *AsOf) lookup(key super.Value) *super.Value {
+	if key.IsNull() {
+		return nil
+	}
+	i := sort.Search(len(a.keys), func(i int) bool {
+		return a.compare(a.keys[i], key) > 0
+	}) - 1
+	if i < 0 || !join.ComparableKeys(key, a.keys[i]) {
+		return nil
+	}
+	if a.tolerance != nil && !join.Within(key, a.keys[i], *a.tolerance) {
+		return nil
+	}
+	return &a.vals[i]
+}
@@ -0,0 +1,303 @@
+package op
+
+import (
+	"context"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime"
+	samexpr "github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/sam/op/join"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/zcode"
+)
+
+// Cross is a join that outputs the cartesian product of its inputs.  Both
+// inputs are pulled concurrently, so that a join of the branches of a fork
+// does not deadlock, until one of them reaches EOS.  That input is then held
+// in memory while the other is streamed.  A done from downstream, an error,
+// or the end of the streamed input ends the platoon, passing a done to each
+// input that has not reached its EOS.
+type Cross struct {
+	ctx context.Context
+	inputs
+
+	cutter  *samexpr.Cutter
+	splicer *join.RecordSplicer
+
+	built    bool
+	held     []super.Value
+	heldLeft bool
+	streamed *crossParent
+	// partial holds the vectors of the streamed input pulled before the
+	// held input reached EOS.
+	partial []vector.Any
+}
+
+func NewCross(rctx *runtime.Context, left, right vector.Puller, lhs []*samexpr.Lval, rhs []samexpr.Evaluator) *Cross {
+	c := &Cross{
+		ctx:     rctx.Context,
+		cutter:  samexpr.NewCutter(rctx.Sctx, lhs, rhs),
+		splicer: join.NewRecordSplicer(rctx.Sctx),
+	}
+	c.inputs = newInputs(rctx.Context, left, right, c.reset)
+	return c
+}
+
+func (c *Cross) Pull(done bool) (vector.Any, error) {
+	if done {
+		return nil, c.done()
+	}
+	c.start()
+	if !c.built {
+		if err := c.build(); err != nil {
+			return nil, c.fail(err)
+		}
+		c.built = true
+	}
+	for {
+		var vec vector.Any
+		if len(c.partial) > 0 {
+			vec, c.partial = c.partial[0], c.partial[1:]
+		} else {
+			var err error
+			if vec, err = c.streamed.pull(); err != nil {
+				return nil, c.fail(err)
+			}
+			if vec == nil {
+				return nil, c.done()
+			}
+		}
+		out, err := c.product(vec)
+		if err != nil {
+			return nil, c.fail(err)
+		}
+		if out.Len() > 0 {
+			return out, nil
+		}
+	}
+}
+
+// build pulls both inputs until one of them reaches EOS.
+func (c *Cross) build() error {
+	var left, right []vector.Any
+	for {
+		var r result
+		var isLeft bool
+		select {
+		case r = <-c.left.resultCh:
+			r = c.left.result(r)
+			isLeft = true
+		case r = <-c.right.resultCh:
+			r = c.right.result(r)
+		case <-c.ctx.Done():
+			return c.ctx.Err()
+		}
+		if r.err != nil {
+			return r.err
+		}
+		if r.vector != nil {
+			if isLeft {
+				left = append(left, r.vector)
+			} else {
+				right = append(right, r.vector)
+			}
+			continue
+		}
+		c.heldLeft = isLeft
+		held := left
+		c.partial, c.streamed = right, c.right
+		if !isLeft {
+			held = right
+			c.partial, c.streamed = left, c.left
+		}
+		for _, vec := range held {
+			for i := range vec.Len() {
+				var b zcode.Builder
+				c.held = append(c.held, vectorValue(&b, vec, i))
+			}
+		}
+		return nil
+	}
+}
+
+func (c *Cross) product(vec vector.Any) (vector.Any, error) {
+	var valBuilder zcode.Builder
+	b := vector.NewDynamicBuilder()
+	for i := range vec.Len() {
+		valBuilder.Truncate()
+		val := vectorValue(&valBuilder, vec, i)
+		for _, heldVal := range c.held {
+			left, right := val, heldVal
+			if c.heldLeft {
+				left, right = right, left
+			}
+			out, err := c.splicer.SpliceCross(left, c.cutter.Eval(nil, right))
+			if err != nil {
+				return nil, err
+			}
+			b.Write(out)
+		}
+	}
+	return b.Build(), nil
+}
+
+func (c *Cross) reset() {
+	c.built = false
+	c.held, c.partial, c.streamed = nil, nil, nil
+}
+
+// crossParent pulls from a parent of a join in its own goroutine so that
+// the parents of a join fed by one fork are pulled concurrently.
+type crossParent struct {
+	ctx      context.Context
+	parent   vector.Puller
+	resultCh chan result
+	doneCh   chan struct{}
+	// running is true from start until the parent's EOS or error is
+	// received or done returns.
+	running bool
+}
+
+func newCrossParent(ctx context.Context, parent vector.Puller) *crossParent {
+	return &crossParent{
+		ctx:    ctx,
+		parent: parent,
+		doneCh: make(chan struct{}),
+	}
+}
+
+// start starts the goroutine pulling the parent's current platoon.
+func (c *crossParent) start() {
+	ch := make(chan result)
+	c.resultCh = ch
+	c.running = true
+	go c.run(ch)
+}
+
+func (c *crossParent) run(ch chan result) {
+	for {
+		vec, err := c.parent.Pull(false)
+		select {
+		case ch <- result{vec, err}:
+			if vec == nil || err != nil {
+				close(ch)
+				return
+			}
+		case <-c.doneCh:
+			// The join is done with the platoon.  If the parent has
+			// not reached its EOS, pass the done upstream.
+			err = nil
+			if vec != nil {
+				_, err = c.parent.Pull(true)
+			}
+			select {
+			case ch <- result{nil, err}:
+				close(ch)
+			case <-c.ctx.Done():
+			}
+			return
+		case <-c.ctx.Done():
+			return
+		}
+	}
+}
+
+func (c *crossParent) pull() (vector.Any, error) {
+	select {
+	case r := <-c.resultCh:
+		r = c.result(r)
+		return r.vector, r.err
+	case <-c.ctx.Done():
+		return nil, c.ctx.Err()
+	}
+}
+
+// result returns r, which was received from c.resultCh, noting whether the
+// goroutine of c has ended.
+func (c *crossParent) result(r result) result {
+	if r.vector == nil || r.err != nil {
+		c.running = false
+	}
+	return r
+}
+
+// done tells the goroutine of c, if it is running, that the join is done
+// with the platoon and waits for it to pass the done to the parent,
+// returning the parent's error.
+func (c *crossParent) done() error {
+	if !c.running {
+		return nil
+	}
+	c.running = false
+	select {
+	case c.doneCh <- struct{}{}:
+	case <-c.ctx.Done():
+		return c.ctx.Err()
+	}
+	select {
+	case r := <-c.resultCh:
+		return r.err
+	case <-c.ctx.Done():
+		return c.ctx.Err()
+	}
+}
+
+// inputs are the left and right inputs of a join, whose goroutines are
+// started by the first Pull of each platoon.  A done from downstream, an
+// error, or the end of the input driving the join ends the platoon: the
+// join passes a done to each input that has not reached its EOS and calls
+// reset to release what it holds, so that the next Pull starts the next
+// platoon.
+type inputs struct {
+	left    *crossParent
+	right   *crossParent
+	running bool
+	reset   func()
+}
+
+func newInputs(ctx context.Context, left, right vector.Puller, reset func()) inputs {
+	return inputs{
+		left:  newCrossParent(ctx, left),
+		right: newCrossParent(ctx, right),
+		reset: reset,
+	}
+}
+
+// start starts the goroutines of the inputs if the platoon has not started.
+func (i *inputs) start() {
+	if !i.running {
+		i.left.start()
+		i.right.start()
+		i.running = true
+	}
+}
+
+// done ends the platoon, passing a done to each input that has not reached
+// its EOS.  If the platoon has not started, the done is passed to the
+// parents of the inputs directly.
+func (i *inputs) done() error {
+	var err error
+	if i.running {
+		err = i.left.done()
+		if rightErr := i.right.done(); err == nil {
+			err = rightErr
+		}
+	} else {
+		_, err = i.left.parent.Pull(true)
+		if _, rightErr := i.right.parent.Pull(true); err == nil {
+			err = rightErr
+		}
+	}
+	i.running = false
+	i.reset()
+	return err
+}
+
+// fail ends the platoon after err and returns err.
+func (i *inputs) fail(err error) error {
+	i.left.done()
+	i.right.done()
+	i.running = false
+	i.reset()
+	return err
+}
@@ -0,0 +1,91 @@
+package op
+
+import (
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime"
+	samexpr "github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/sam/expr/agg"
+	"github.com/brimdata/super/runtime/vam/expr"
+	"github.com/brimdata/super/vector"
+)
+
+// Fuse buffers the vectors from its parent while assembling a unified schema
+// from their types and then shapes each vector to the unified schema.  Unlike
+// the sam fuse operator, Fuse does not spill to disk.
+type Fuse struct {
+	rctx   *runtime.Context
+	parent vector.Puller
+
+	types  map[super.Type]struct{}
+	schema *agg.Schema
+	vecs   []vector.Any
+	shaper *expr.ConstShaper
+}
+
+func NewFuse(rctx *runtime.Context, parent vector.Puller) *Fuse {
+	return &Fuse{
+		rctx:   rctx,
+		parent: parent,
+		types:  make(map[super.Type]struct{}),
+		schema: agg.NewSchema(rctx.Sctx),
+	}
+}
+
+func (f *Fuse) Pull(done bool) (vector.Any, error) {
+	if done {
+		f.reset()
+		return f.parent.Pull(true)
+	}
+	if f.shaper == nil {
+		if err := f.fill(); err != nil || len(f.vecs) == 0 {
+			f.reset()
+			return nil, err
+		}
+		f.shaper = expr.NewConstShaper(f.rctx.Sctx, &expr.This{}, f.schema.Type(), samexpr.Cast|samexpr.Fill|samexpr.Order)
+	}
+	if len(f.vecs) == 0 {
+		f.reset()
+		return nil, nil
+	}
+	vec := f.vecs[0]
+	f.vecs = f.vecs[1:]
+	return f.shaper.Eval(vec), nil
+}
+
+// fill pulls from f.parent until EOS, mixing the type of each vector, or
+// of each of the values of a Dynamic, into f.schema.
+func (f *Fuse) fill() error {
+	for {
+		if err := f.rctx.Err(); err != nil {
+			return err
+		}
+		vec, err := f.parent.Pull(false)
+		if vec == nil || err != nil {
+			return err
+		}
+		if d, ok := vec.(*vector.Dynamic); ok {
+			for _, v := range d.Values {
+				if v != nil && v.Len() > 0 {
+					f.mixin(v.Type())
+				}
+			}
+		} else {
+			f.mixin(vec.Type())
+		}
+		f.vecs = append(f.vecs, vec)
+	}
+}
+
+func (f *Fuse) mixin(typ super.Type) {
+	if _, ok := f.types[typ]; !ok {
+		f.types[typ] = struct{}{}
+		f.schema.Mixin(typ)
+	}
+}
+
+func (f *Fuse) reset() {
+	f.types = make(map[super.Type]struct{})
+	f.schema = agg.NewSchema(f.rctx.Sctx)
+	f.vecs = nil
+	f.shaper = nil
+}
@@ -15,6 +15,8 @@ type Join struct {
 	sctx     *super.Context
 	anti     bool
 	inner    bool
+	semi     bool
+	keys     *join.KeySet
 	left     vector.Puller
 	right    vector.Puller
 	leftKey  expr.Evaluator
@@ -23,13 +25,19 @@ type Join struct {
 	cutter  *samexpr.Cutter
 	splicer *join.RecordSplicer
 	table   map[string][]super.Value
+	// pending holds the left input read while the right input is read,
+	// and leftEOS is true if that reached the end of the left input.
+	pending []vector.Any
+	leftEOS bool
 }
 
-func NewJoin(sctx *super.Context, anti, inner bool, left, right vector.Puller, leftKey, rightKey expr.Evaluator, lhs []*samexpr.Lval, rhs []samexpr.Evaluator) *Join {
+func NewJoin(sctx *super.Context, anti, inner, semi bool, keys *join.KeySet, left, right vector.Puller, leftKey, rightKey expr.Evaluator, lhs []*samexpr.Lval, rhs []samexpr.Evaluator) *Join {
 	return &Join{
 		sctx:     sctx,
 		anti:     anti,
 		inner:    inner,
+		semi:     semi,
+		keys:     keys,
 		left:     left,
 		right:    right,
 		leftKey:  leftKey,
@@ -41,46 +49,31 @@ func NewJoin(sctx *super.Context, anti, inner bool, left, right vector.Puller, l
 
 func (j *Join) Pull(done bool) (vector.Any, error) {
 	if done {
-		_, err := j.left.Pull(true)
+		var err error
+		if !j.leftEOS {
+			_, err = j.left.Pull(true)
+		}
 		if err == nil {
 			_, err = j.right.Pull(true)
 		}
+		j.reset()
 		return nil, err
 	}
 
-	// Build
 	if j.table == nil {
-		j.table = map[string][]super.Value{}
-		var keyBuilder, valBuilder zcode.Builder
-		for {
-			vec, err := j.right.Pull(false)
-			if err != nil {
-				return nil, err
-			}
-			if vec == nil {
-				break
-			}
-			rightKeyVec := j.rightKey.Eval(vec)
-			for i := range vec.Len() {
-				keyBuilder.Truncate()
-				keyVal := vectorValue(&keyBuilder, rightKeyVec, i)
-				if keyVal.IsMissing() {
-					continue
-				}
-				key := hashKey(keyVal)
-				valBuilder.Reset()
-				j.table[key] = append(j.table[key], vectorValue(&valBuilder, vec, i))
-			}
+		if err := j.build(); err != nil {
+			return nil, err
 		}
 	}
 
 	// Probe
 	for {
-		leftVec, err := j.left.Pull(false)
+		leftVec, err := j.nextLeft()
 		if err != nil {
 			return nil, err
 		}
 		if leftVec == nil {
+			j.reset()
 			return nil, nil
 		}
 		leftKeyVec := j.leftKey.Eval(leftVec)
@@ -88,7 +81,7 @@ func (j *Join) Pull(done bool) (vector.Any, error) {
 		b := vector.NewDynamicBuilder()
 		for i := range leftVec.Len() {
 			keyBuilder.Truncate()
-			keyVal := vectorValue(&keyBuilder, leftKeyVec, i)
+			keyVal := samexpr.QueryCollation.Key(vectorValue(&keyBuilder, leftKeyVec, i))
 			if keyVal.IsMissing() {
 				continue
 			}
@@ -105,6 +98,10 @@ func (j *Join) Pull(done bool) (vector.Any, error) {
 			if j.anti {
 				continue
 			}
+			if j.semi {
+				b.Write(leftVal)
+				continue
+			}
 			for _, rightVal := range rightVals {
 				cutVal := j.cutter.Eval(nil, rightVal)
 				val, err := j.splicer.Splice(leftVal, cutVal)
@@ -121,7 +118,102 @@ func (j *Join) Pull(done bool) (vector.Any, error) {
 	}
 }
 
+// build reads the right input into j.table.  The left input is read into
+// j.pending at the same time so that inputs fed by the same fork both make
+// progress.  If the join publishes the keys of the left input to j.keys, the
+// right input waits for them, so the whole left input is read.
+func (j *Join) build() error {
+	table := map[string][]super.Value{}
+	rightDone := make(chan error, 1)
+	go func() {
+		rightDone <- j.readRight(table)
+	}()
+	for !j.leftEOS && (j.keys != nil || len(rightDone) == 0) {
+		if err := j.readLeft(); err != nil {
+			return err
+		}
+	}
+	if err := <-rightDone; err != nil {
+		return err
+	}
+	j.table = table
+	return nil
+}
+
+func (j *Join) readRight(table map[string][]super.Value) error {
+	var keyBuilder, valBuilder zcode.Builder
+	for {
+		vec, err := j.right.Pull(false)
+		if vec == nil || err != nil {
+			return err
+		}
+		rightKeyVec := j.rightKey.Eval(vec)
+		for i := range vec.Len() {
+			keyBuilder.Truncate()
+			keyVal := samexpr.QueryCollation.Key(vectorValue(&keyBuilder, rightKeyVec, i))
+			if keyVal.IsMissing() {
+				continue
+			}
+			key := hashKey(keyVal)
+			if j.semi && len(table[key]) != 0 {
+				continue
+			}
+			valBuilder.Reset()
+			table[key] = append(table[key], vectorValue(&valBuilder, vec, i))
+		}
+	}
+}
+
+// readLeft reads the next vector of the left input into j.pending, adding
+// its keys to j.keys, and publishes the keys at the end of the input.
+func (j *Join) readLeft() error {
+	vec, err := j.left.Pull(false)
+	if err != nil {
+		return err
+	}
+	if vec == nil {
+		j.leftEOS = true
+		if j.keys != nil {
+			j.keys.Publish()
+		}
+		return nil
+	}
+	if j.keys != nil {
+		var keyBuilder zcode.Builder
+		keyVec := j.leftKey.Eval(vec)
+		for i := range vec.Len() {
+			keyBuilder.Truncate()
+			j.keys.Add(samexpr.QueryCollation.Key(vectorValue(&keyBuilder, keyVec, i)))
+		}
+	}
+	j.pending = append(j.pending, vec)
+	return nil
+}
+
+func (j *Join) nextLeft() (vector.Any, error) {
+	if len(j.pending) > 0 {
+		vec := j.pending[0]
+		j.pending = j.pending[1:]
+		return vec, nil
+	}
+	if j.leftEOS {
+		return nil, nil
+	}
+	return j.left.Pull(false)
+}
+
+// reset readies j for the next platoon of its inputs.
+func (j *Join) reset() {
+	j.table = nil
+	j.pending = nil
+	j.leftEOS = false
+	if j.keys != nil {
+		j.keys.Reset()
+	}
+}
+
 func hashKey(val super.Value) string {
+	val = join.NumericKey(val)
 	return string(binary.LittleEndian.AppendUint32(val.Bytes(), uint32(val.Type().ID())))
 }
 
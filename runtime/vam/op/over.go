@@ -86,6 +86,7 @@ func (o *Over) flatten(vec vector.Any, slot uint32) vector.Any {
 		keyOffsets := []uint32{0, 1}
 		var tags []uint32
 		var vecs []vector.Any
+		index := []uint32{slot}
 		for i, f := range super.TypeRecordOf(vec.Type()).Fields {
 			tags = append(tags, uint32(i))
 			typ := o.sctx.MustLookupTypeRecord([]super.Field{
@@ -93,7 +94,7 @@ func (o *Over) flatten(vec vector.Any, slot uint32) vector.Any {
 				{Name: "value", Type: f.Type},
 			})
 			keyVec := vector.NewArray(keyType, keyOffsets, vector.NewConst(super.NewString(f.Name), 1, bitvec.Zero), bitvec.Zero)
-			valVec := vector.Pick(vec.Fields[i], []uint32{slot})
+			valVec := vector.Pick(vec.Fields[i], index)
 			vecs = append(vecs, vector.NewRecord(typ, []vector.Any{keyVec, valVec}, keyVec.Len(), bitvec.Zero))
 		}
 		return vector.NewDynamic(tags, vecs)
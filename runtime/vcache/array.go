@@ -32,11 +32,11 @@ func (a *array) unmarshal(cctx *csup.Context, projection field.Projection) {
 	if a.values == nil {
 		a.values = newShadow(cctx, a.meta.Values, nil)
 	}
-	a.values.unmarshal(cctx, projection)
+	a.values.unmarshal(cctx, projection.ElemProjection())
 }
 
 func (a *array) project(loader *loader, projection field.Projection) vector.Any {
-	vec := a.values.project(loader, nil)
+	vec := a.values.project(loader, projection.ElemProjection())
 	typ := loader.sctx.LookupTypeArray(vec.Type())
 	offs, nulls := a.load(loader)
 	return vector.NewArray(typ, offs, vec, nulls)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"sync"
 
+	"github.com/brimdata/super/csup"
 	"github.com/brimdata/super/pkg/storage"
 	"github.com/segmentio/ksuid"
 )
@@ -11,25 +12,28 @@ import (
 type Cache struct {
 	mu     sync.Mutex
 	engine storage.Engine
-	// objects is currently a simple map but we will turn this into an
-	// LRU cache sometime soon.  First step is object-level granularity, though
-	// we might want LRU inside of objects based on vectors.  We can do that
-	// later if measurements warrant it.  XXX note that we keep the storage
-	// reader open for every object and never close it.  We should timeout
-	// files and close them and then reopen them when needed to access
-	// vectors that haven't yet been loaded.
-	objects map[ksuid.KSUID]*Object
-	locks   map[ksuid.KSUID]*sync.Mutex
+	// meta holds the metadata of each object.  Vectors are not yet cached
+	// but will be held apart from meta so that the two can be bounded
+	// independently.  XXX note that each object in meta keeps its storage
+	// reader open.  We should timeout files and close them and then reopen
+	// them when needed to access vectors that haven't yet been loaded.
+	meta  *MetaCache
+	locks map[ksuid.KSUID]*sync.Mutex
 }
 
 func NewCache(engine storage.Engine) *Cache {
 	return &Cache{
-		engine:  engine,
-		objects: make(map[ksuid.KSUID]*Object),
-		locks:   make(map[ksuid.KSUID]*sync.Mutex),
+		engine: engine,
+		meta:   NewMetaCache(DefaultMetaCacheSize),
+		locks:  make(map[ksuid.KSUID]*sync.Mutex),
 	}
 }
 
+// MetaCache returns the cache of object metadata underlying c.
+func (c *Cache) MetaCache() *MetaCache {
+	return c.meta
+}
+
 func (c *Cache) lock(id ksuid.KSUID) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -48,26 +52,31 @@ func (c *Cache) unlock(id ksuid.KSUID) {
 }
 
 func (c *Cache) Fetch(ctx context.Context, uri *storage.URI, id ksuid.KSUID) (*Object, error) {
-	c.mu.Lock()
-	object, ok := c.objects[id]
-	c.mu.Unlock()
-	if ok {
-		return object, nil
+	if object, ok := c.meta.get(id); ok {
+		return NewObjectFromCSUP(object), nil
 	}
 	c.lock(id)
 	defer c.unlock(id)
-	c.mu.Lock()
-	object, ok = c.objects[id]
-	c.mu.Unlock()
-	if ok {
-		return object, nil
+	if object, ok := c.meta.get(id); ok {
+		return NewObjectFromCSUP(object), nil
 	}
-	object, err := NewObject(ctx, c.engine, uri)
+	object, err := openObject(ctx, c.engine, uri)
 	if err != nil {
 		return nil, err
 	}
-	c.mu.Lock()
-	c.objects[id] = object
-	c.mu.Unlock()
-	return object, nil
+	c.meta.add(id, object)
+	return NewObjectFromCSUP(object), nil
+}
+
+func openObject(ctx context.Context, engine storage.Engine, uri *storage.URI) (*csup.Object, error) {
+	// XXX currently we open a storage.Reader for every object and never close it.
+	// We should either close after a timeout and reopen when needed or change the
+	// storage API to have a more reasonable semantics around the Put/Get not leaving
+	// a file descriptor open for every long Get.  Perhaps there should be another
+	// method for intermittent random access.
+	reader, err := engine.Get(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	return csup.NewObject(reader)
 }
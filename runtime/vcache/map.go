@@ -34,13 +34,13 @@ func (m *map_) unmarshal(cctx *csup.Context, projection field.Projection) {
 		m.keys = newShadow(cctx, m.meta.Keys, nil)
 		m.values = newShadow(cctx, m.meta.Values, nil)
 	}
-	m.keys.unmarshal(cctx, projection)
-	m.values.unmarshal(cctx, projection)
+	m.keys.unmarshal(cctx, nil)
+	m.values.unmarshal(cctx, projection.ElemProjection())
 }
 
 func (m *map_) project(loader *loader, projection field.Projection) vector.Any {
 	keys := m.keys.project(loader, nil)
-	vals := m.values.project(loader, nil)
+	vals := m.values.project(loader, projection.ElemProjection())
 	typ := loader.sctx.LookupTypeMap(keys.Type(), vals.Type())
 	offs, nulls := m.load(loader)
 	return vector.NewMap(typ, offs, keys, vals, nulls)
@@ -0,0 +1,98 @@
+package vcache
+
+import (
+	"math"
+	"sync"
+
+	"github.com/brimdata/super/csup"
+	"github.com/hashicorp/golang-lru/v2/simplelru"
+	"github.com/segmentio/ksuid"
+)
+
+// DefaultMetaCacheSize is the default limit in bytes on the CSUP metadata
+// held in a MetaCache.
+const DefaultMetaCacheSize = 512 * 1024 * 1024
+
+// A MetaCache holds the deserialized metadata of CSUP objects keyed by
+// object ID.  Metadata is needed to plan and prune a scan of an object even
+// when none of its vectors are loaded, so it is cached apart from vector
+// data and bounded by its own budget: when the total size of the metadata
+// sections of the cached objects exceeds the limit, the least recently used
+// objects are evicted.
+type MetaCache struct {
+	mu    sync.Mutex
+	limit uint64
+	size  uint64
+	lru   *simplelru.LRU[ksuid.KSUID, *csup.Object]
+}
+
+func NewMetaCache(limit uint64) *MetaCache {
+	m := &MetaCache{limit: limit}
+	lru, err := simplelru.NewLRU(math.MaxInt, func(_ ksuid.KSUID, o *csup.Object) {
+		m.size -= metaSize(o)
+	})
+	if err != nil {
+		panic(err)
+	}
+	m.lru = lru
+	return m
+}
+
+func metaSize(o *csup.Object) uint64 {
+	return o.Header().MetaSize
+}
+
+func (m *MetaCache) get(id ksuid.KSUID) (*csup.Object, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.lru.Get(id)
+}
+
+// add caches o unless its metadata alone exceeds the limit.  Evicted
+// objects are dropped rather than closed since callers of Cache.Fetch may
+// still be reading them.
+func (m *MetaCache) add(id ksuid.KSUID, o *csup.Object) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	size := metaSize(o)
+	if size > m.limit {
+		return
+	}
+	if old, ok := m.lru.Peek(id); ok {
+		m.size -= metaSize(old)
+	}
+	m.lru.Add(id, o)
+	m.size += size
+	m.evict()
+}
+
+func (m *MetaCache) evict() {
+	for m.size > m.limit {
+		if _, _, ok := m.lru.RemoveOldest(); !ok {
+			break
+		}
+	}
+}
+
+// SetLimit sets the limit in bytes on the metadata held in m, evicting
+// objects as needed to meet it.
+func (m *MetaCache) SetLimit(limit uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.limit = limit
+	m.evict()
+}
+
+// Len returns the number of objects whose metadata is held in m.
+func (m *MetaCache) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.lru.Len()
+}
+
+// Size returns the total size in bytes of the metadata held in m.
+func (m *MetaCache) Size() uint64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.size
+}
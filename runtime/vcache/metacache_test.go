@@ -0,0 +1,57 @@
+package vcache_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/fuzz"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/brimdata/super/runtime/vcache"
+	"github.com/segmentio/ksuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetaCache(t *testing.T) {
+	ctx := context.Background()
+	engine := storage.NewLocalEngine()
+	dir := storage.MustParseURI(t.TempDir())
+	var buf bytes.Buffer
+	fuzz.WriteCSUP(t, []super.Value{super.NewInt64(1)}, &buf)
+	var ids []ksuid.KSUID
+	for range 3 {
+		id := ksuid.New()
+		require.NoError(t, storage.Put(ctx, engine, dir.JoinPath(id.String()), bytes.NewReader(buf.Bytes())))
+		ids = append(ids, id)
+	}
+	cache := vcache.NewCache(engine)
+	fetch := func(id ksuid.KSUID) {
+		_, err := cache.Fetch(ctx, dir.JoinPath(id.String()), id)
+		require.NoError(t, err)
+	}
+	for _, id := range ids {
+		fetch(id)
+	}
+	meta := cache.MetaCache()
+	require.Equal(t, 3, meta.Len())
+	size := meta.Size() / 3
+
+	// Shrinking the limit evicts the least recently used objects.
+	fetch(ids[0])
+	meta.SetLimit(2 * size)
+	require.Equal(t, 2, meta.Len())
+	require.Equal(t, 2*size, meta.Size())
+
+	// Fetching an evicted object reloads its metadata.
+	fetch(ids[1])
+	require.Equal(t, 2, meta.Len())
+	require.Equal(t, 2*size, meta.Size())
+
+	// An object whose metadata exceeds the limit is not cached.
+	meta.SetLimit(size - 1)
+	require.Zero(t, meta.Len())
+	fetch(ids[2])
+	require.Zero(t, meta.Len())
+	require.Zero(t, meta.Size())
+}
@@ -26,17 +26,7 @@ type Object struct {
 // the metadata is deserialized so that vectors can be loaded into the cache
 // on demand only as needed and retained in memory for future use.
 func NewObject(ctx context.Context, engine storage.Engine, uri *storage.URI) (*Object, error) {
-	// XXX currently we open a storage.Reader for every object and never close it.
-	// We should either close after a timeout and reopen when needed or change the
-	// storage API to have a more reasonable semantics around the Put/Get not leaving
-	// a file descriptor open for every long Get.  Perhaps there should be another
-	// method for intermittent random access.
-	// XXX maybe open the reader inside Fetch if needed?
-	reader, err := engine.Get(ctx, uri)
-	if err != nil {
-		return nil, err
-	}
-	object, err := csup.NewObject(reader)
+	object, err := openObject(ctx, engine, uri)
 	if err != nil {
 		return nil, err
 	}
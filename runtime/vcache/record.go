@@ -31,9 +31,10 @@ func newRecord(cctx *csup.Context, meta *csup.Record, nulls *nulls) *record {
 func (r *record) unmarshal(cctx *csup.Context, projection field.Projection) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
-	if len(projection) == 0 {
-		// Unmarshal all the fields of this record.  We're either loading all on demand (nil paths)
-		// or loading this record because it's referenced at the end of a projected path.
+	if len(projection) == 0 || projection.HasElem() {
+		// Unmarshal all the fields of this record.  We're either loading all on demand (nil paths),
+		// loading this record because it's referenced at the end of a projected path, or
+		// loading this record because it may be indexed by position.
 		for k := range r.fields {
 			if r.fields[k] == nil {
 				r.fields[k] = newShadow(cctx, r.meta.Fields[k].Values, r.nulls)
@@ -56,9 +57,10 @@ func (r *record) project(loader *loader, projection field.Projection) vector.Any
 	nulls := r.load(loader)
 	vecs := make([]vector.Any, 0, len(r.fields))
 	types := make([]super.Field, 0, len(r.fields))
-	if len(projection) == 0 {
-		// Build the whole record.  We're either loading all on demand (nil paths)
-		// or loading this record because it's referenced at the end of a projected path.
+	if len(projection) == 0 || projection.HasElem() {
+		// Build the whole record.  We're either loading all on demand (nil paths),
+		// loading this record because it's referenced at the end of a projected path, or
+		// loading this record because it may be indexed by position.
 		for k, f := range r.fields {
 			if f != nil {
 				vec := f.project(loader, nil)
@@ -32,11 +32,11 @@ func (s *set) unmarshal(cctx *csup.Context, projection field.Projection) {
 	if s.values == nil {
 		s.values = newShadow(cctx, s.meta.Values, nil)
 	}
-	s.values.unmarshal(cctx, projection)
+	s.values.unmarshal(cctx, projection.ElemProjection())
 }
 
 func (s *set) project(loader *loader, projection field.Projection) vector.Any {
-	vec := s.values.project(loader, nil)
+	vec := s.values.project(loader, projection.ElemProjection())
 	typ := loader.sctx.LookupTypeSet(vec.Type())
 	offs, nulls := s.load(loader)
 	return vector.NewSet(typ, offs, vec, nulls)
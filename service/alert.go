@@ -0,0 +1,325 @@
+package service
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/api"
+	"github.com/brimdata/super/compiler"
+	"github.com/brimdata/super/compiler/ast"
+	"github.com/brimdata/super/compiler/parser"
+	"github.com/brimdata/super/lake/pools"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/service/srverr"
+	"github.com/brimdata/super/sup"
+	"github.com/brimdata/super/zbuf"
+	"github.com/brimdata/super/zio"
+	"github.com/brimdata/super/zio/bsupio"
+	"github.com/brimdata/super/zio/jsonio"
+	"github.com/segmentio/ksuid"
+	"go.uber.org/zap"
+)
+
+// MaxAlertValues is the maximum number of matching values carried by an
+// alert.
+const MaxAlertValues = 100
+
+// WebhookTimeout bounds the time taken to post an alert to a webhook.
+const WebhookTimeout = 10 * time.Second
+
+// An alertRule runs a query over the data committed to a branch since the
+// rule last ran and fires an alert, i.e., publishes an event and posts to
+// its webhook, if any results satisfy the rule's condition.  Rules are
+// evaluated whenever a commit to their branch is published.
+type alertRule struct {
+	poolID ksuid.KSUID
+
+	// evalMu serializes evaluations so that each commit is evaluated
+	// exactly once and in order.
+	evalMu sync.Mutex
+
+	mu   sync.Mutex
+	meta api.AlertRule
+}
+
+func (c *Core) newAlertRule(ctx context.Context, req api.AlertPostRequest) (*alertRule, error) {
+	if req.Name == "" {
+		return nil, srverr.ErrInvalid("alert rule name must be specified")
+	}
+	if req.Pool == "" {
+		return nil, srverr.ErrInvalid("alert rule pool must be specified")
+	}
+	if req.Branch == "" {
+		req.Branch = "main"
+	}
+	if _, err := parseAlertQuery(req.Query, req.Condition); err != nil {
+		return nil, srverr.ErrInvalid(err)
+	}
+	if req.Webhook != "" {
+		u, err := url.Parse(req.Webhook)
+		if err != nil {
+			return nil, srverr.ErrInvalid(err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return nil, srverr.ErrInvalid("alert rule webhook must be an http or https URL")
+		}
+	}
+	branch, err := c.openBranch(ctx, req.Pool, req.Branch)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkAlertMasks(branch.Pool().Config); err != nil {
+		return nil, err
+	}
+	// Data committed before the rule was created is not evaluated.
+	r := &alertRule{
+		poolID: branch.Pool().ID,
+		meta: api.AlertRule{
+			Name:      req.Name,
+			Query:     req.Query,
+			Condition: req.Condition,
+			Pool:      req.Pool,
+			Branch:    req.Branch,
+			Webhook:   req.Webhook,
+			Commit:    branch.Commit,
+		},
+	}
+	c.alertsMu.Lock()
+	defer c.alertsMu.Unlock()
+	if _, ok := c.alerts[req.Name]; ok {
+		return nil, srverr.ErrConflict("alert rule %q already exists", req.Name)
+	}
+	c.alerts[req.Name] = r
+	return r, nil
+}
+
+// checkAlertMasks returns an error if pool has masks.  A rule reads the
+// objects added to its branch directly rather than scanning the pool, so its
+// results would reveal the values that the masks hide.
+func checkAlertMasks(pool pools.Config) error {
+	if len(pool.Masks) > 0 {
+		return srverr.ErrForbidden("alert rules are not supported on pool %q since it has masks", pool.Name)
+	}
+	return nil
+}
+
+// parseAlertQuery parses query followed by a filter on condition.
+func parseAlertQuery(query, condition string) (*parser.AST, error) {
+	if condition == "" {
+		return parser.ParseQuery(query)
+	}
+	// Make sure condition is an expression and not, e.g., "x | count()",
+	// before appending it to query.
+	where, err := parser.ParseQuery("where " + condition)
+	if err != nil {
+		return nil, err
+	}
+	if seq := where.Parsed(); len(seq) != 1 {
+		return nil, fmt.Errorf("alert rule condition must be an expression: %s", condition)
+	} else if _, ok := seq[0].(*ast.Where); !ok {
+		return nil, fmt.Errorf("alert rule condition must be an expression: %s", condition)
+	}
+	return parser.ParseQuery(query + "\n| where " + condition)
+}
+
+func (c *Core) lookupAlertRule(name string) (*alertRule, error) {
+	c.alertsMu.Lock()
+	defer c.alertsMu.Unlock()
+	r, ok := c.alerts[name]
+	if !ok {
+		return nil, srverr.ErrNotFound("alert rule %q not found", name)
+	}
+	return r, nil
+}
+
+func (c *Core) listAlertRules() []api.AlertRule {
+	c.alertsMu.Lock()
+	defer c.alertsMu.Unlock()
+	list := make([]api.AlertRule, 0, len(c.alerts))
+	for _, r := range c.alerts {
+		list = append(list, r.Meta())
+	}
+	slices.SortFunc(list, func(a, b api.AlertRule) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return list
+}
+
+func (c *Core) deleteAlertRule(name string) error {
+	c.alertsMu.Lock()
+	defer c.alertsMu.Unlock()
+	if _, ok := c.alerts[name]; !ok {
+		return srverr.ErrNotFound("alert rule %q not found", name)
+	}
+	delete(c.alerts, name)
+	return nil
+}
+
+// Meta returns a copy of the rule's description and status.
+func (r *alertRule) Meta() api.AlertRule {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.meta
+}
+
+// evaluateAlertRules evaluates, in the background, the rules for a branch
+// to which a commit has been published.
+func (c *Core) evaluateAlertRules(poolID ksuid.KSUID, branch string) {
+	c.alertsMu.Lock()
+	defer c.alertsMu.Unlock()
+	for _, r := range c.alerts {
+		if r.poolID == poolID && r.meta.Branch == branch {
+			go c.evaluateAlertRule(c.ctx, r)
+		}
+	}
+}
+
+// evaluateAlertRule runs the query of r over the data added to its branch
+// since the commit last evaluated and publishes an alert event if any
+// results satisfy its condition or an alert-failure event if evaluation
+// fails.
+func (c *Core) evaluateAlertRule(ctx context.Context, r *alertRule) {
+	r.evalMu.Lock()
+	defer r.evalMu.Unlock()
+	meta := r.Meta()
+	logger := c.logger.With(zap.String("alert", meta.Name))
+	event := api.EventAlert{
+		Name:   meta.Name,
+		PoolID: r.poolID,
+		Branch: meta.Branch,
+	}
+	commit, values, err := c.execAlertRule(ctx, meta)
+	r.mu.Lock()
+	if commit != ksuid.Nil {
+		r.meta.Commit = commit
+	}
+	r.meta.Error = ""
+	if err != nil {
+		r.meta.Error = err.Error()
+	} else if len(values) > 0 {
+		r.meta.Alerts++
+	}
+	r.mu.Unlock()
+	event.Commit = commit
+	if err != nil {
+		logger.Error("Alert rule evaluation failed", zap.Error(err))
+		event.Error = err.Error()
+		c.publish(logger, "alert-failure", event)
+		return
+	}
+	if len(values) == 0 {
+		return
+	}
+	event.Values = values
+	logger.Info("Alert fired", zap.Stringer("commit", commit), zap.Int("values", len(values)))
+	c.publish(logger, "alert", event)
+	if meta.Webhook != "" {
+		if err := postWebhook(ctx, meta.Webhook, event); err != nil {
+			logger.Error("Error posting alert to webhook", zap.String("webhook", meta.Webhook), zap.Error(err))
+		}
+	}
+}
+
+// execAlertRule runs the query of meta over the data objects added to its
+// branch after meta.Commit.  It returns the tip of the branch, which is nil
+// if nothing was evaluated, and the matching values.
+func (c *Core) execAlertRule(ctx context.Context, meta api.AlertRule) (ksuid.KSUID, []super.Value, error) {
+	branch, err := c.openBranch(ctx, meta.Pool, meta.Branch)
+	if err != nil {
+		return ksuid.Nil, nil, err
+	}
+	tip := branch.Commit
+	if tip == meta.Commit {
+		return ksuid.Nil, nil, nil
+	}
+	pool := branch.Pool()
+	// Masks may have been added to the pool since the rule was created.
+	if err := checkAlertMasks(pool.Config); err != nil {
+		return ksuid.Nil, nil, err
+	}
+	objects, err := pool.AddedObjects(ctx, meta.Commit, tip)
+	if err != nil || len(objects) == 0 {
+		return tip, nil, err
+	}
+	ast, err := parseAlertQuery(meta.Query, meta.Condition)
+	if err != nil {
+		return ksuid.Nil, nil, err
+	}
+	sctx := super.NewContext()
+	var readers []zio.Reader
+	for _, o := range objects {
+		rc, err := o.NewReader(ctx, pool.Storage(), pool.DataPath, nil)
+		if err != nil {
+			return ksuid.Nil, nil, err
+		}
+		defer rc.Close()
+		r := bsupio.NewReader(sctx, rc)
+		defer r.Close()
+		readers = append(readers, r)
+	}
+	// The query reads the new data rather than the lake, and the remote
+	// engine keeps it from reading the local file system.
+	comp := compiler.NewCompiler(storage.NewRemoteEngine())
+	query, err := runtime.CompileQuery(ctx, sctx, comp, ast, []zio.Reader{zio.ConcatReader(readers...)})
+	if err != nil {
+		return ksuid.Nil, nil, err
+	}
+	defer query.Close()
+	var values []super.Value
+	r := zbuf.PullerReader(query)
+	for len(values) < MaxAlertValues {
+		val, err := r.Read()
+		if err != nil {
+			return ksuid.Nil, nil, err
+		}
+		if val == nil {
+			break
+		}
+		values = append(values, val.Copy())
+	}
+	return tip, values, nil
+}
+
+// postWebhook posts event to url as JSON.
+func postWebhook(ctx context.Context, url string, event api.EventAlert) error {
+	marshaler := sup.NewBSUPMarshaler()
+	marshaler.Decorate(sup.StyleSimple)
+	val, err := marshaler.Marshal(event)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	w := jsonio.NewWriter(zio.NopCloser(&buf), jsonio.WriterOpts{})
+	if err := w.Write(val); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, WebhookTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, &buf)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+	if res.StatusCode/100 != 2 {
+		return errors.New(res.Status)
+	}
+	return nil
+}
@@ -22,6 +22,10 @@ type AuthConfig struct {
 	Audience string
 	ClientID string
 	Domain   string
+
+	// AdminRole is the role that permits administrative requests, e.g.,
+	// canceling the queries of other users.
+	AdminRole string
 }
 
 func (c *AuthConfig) SetFlags(fs *flag.FlagSet) {
@@ -30,6 +34,7 @@ func (c *AuthConfig) SetFlags(fs *flag.FlagSet) {
 	fs.StringVar(&c.ClientID, "auth.clientid", "", "Auth0 client ID for API clients (will be publicly accessible)")
 	fs.StringVar(&c.Domain, "auth.domain", "", "Auth0 domain (as a URL) for API clients (will be publicly accessible)")
 	fs.StringVar(&c.JWKSPath, "auth.jwkspath", "", "path to JSON Web Key Set file")
+	fs.StringVar(&c.AdminRole, "auth.adminrole", "admin", "role that permits administrative requests such as canceling any query")
 }
 
 type Auth0Authenticator struct {
@@ -15,6 +15,9 @@ const (
 type Identity struct {
 	TenantID TenantID
 	UserID   UserID
+	// Roles holds the roles granted to the user, which exempt the user's
+	// queries from the pool masks naming them.
+	Roles []string
 }
 
 type identityKey struct{}
@@ -31,6 +34,11 @@ func ContextWithIdentity(ctx context.Context, ident Identity) context.Context {
 	return context.WithValue(ctx, identityKey{}, ident)
 }
 
+// RolesFromContext returns the roles of the identity in ctx.
+func RolesFromContext(ctx context.Context) []string {
+	return IdentityFromContext(ctx).Roles
+}
+
 type authTokenKey struct{}
 
 func AuthTokenFromContext(ctx context.Context) (string, bool) {
@@ -19,6 +19,7 @@ const (
 	// access token.
 	TenantIDClaim = "https://lake.brimdata.io/tenant_id"
 	UserIDClaim   = "https://lake.brimdata.io/user_id"
+	RolesClaim    = "https://lake.brimdata.io/roles"
 )
 
 type TokenValidator struct {
@@ -90,7 +91,7 @@ func (v *TokenValidator) Validate(token string) (Identity, error) {
 	if !claims.VerifyIssuer(v.expectedIssuer, true) {
 		return Identity{}, srverr.ErrNoCredentials("invalid issuer")
 	}
-	ident := Identity{AnonymousTenantID, AnonymousUserID}
+	ident := Identity{TenantID: AnonymousTenantID, UserID: AnonymousUserID}
 	if v, ok := claims[TenantIDClaim]; ok {
 		s, _ := v.(string)
 		if s == "" || TenantID(s) == AnonymousTenantID {
@@ -105,6 +106,19 @@ func (v *TokenValidator) Validate(token string) (Identity, error) {
 		}
 		ident.UserID = UserID(s)
 	}
+	if v, ok := claims[RolesClaim]; ok {
+		roles, _ := v.([]any)
+		if roles == nil {
+			return Identity{}, srverr.ErrNoCredentials("invalid roles")
+		}
+		for _, r := range roles {
+			s, _ := r.(string)
+			if s == "" {
+				return Identity{}, srverr.ErrNoCredentials("invalid roles")
+			}
+			ident.Roles = append(ident.Roles, s)
+		}
+	}
 	return ident, nil
 }
 
@@ -4,13 +4,22 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/brimdata/super"
 	"github.com/brimdata/super/api"
 	"github.com/brimdata/super/api/client"
+	"github.com/brimdata/super/lake/pools"
+	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/pkg/field"
 	"github.com/brimdata/super/service"
 	"github.com/brimdata/super/service/auth"
+	"github.com/brimdata/super/sup"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -21,13 +30,15 @@ func testAuthConfig() service.AuthConfig {
 		Audience: "testaudience",
 		Domain:   "https://testdomain",
 		ClientID: "testclientid",
+		// AdminRole is ordinarily defaulted by AuthConfig.SetFlags.
+		AdminRole: "admin",
 	}
 }
 
-func genToken(t *testing.T, tenantID auth.TenantID, userID auth.UserID) string {
+func genToken(t *testing.T, tenantID auth.TenantID, userID auth.UserID, roles ...string) string {
 	ac := testAuthConfig()
 	token, err := auth.GenerateAccessToken("testkey", "testdata/auth-private-key",
-		1*time.Hour, ac.Audience, ac.Domain, tenantID, userID)
+		1*time.Hour, ac.Audience, ac.Domain, tenantID, userID, roles)
 	require.NoError(t, err)
 	return token
 }
@@ -63,6 +74,147 @@ func TestAuthIdentity(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestAuthRolesUnmask(t *testing.T) {
+	_, conn := newCoreWithConfig(t, service.Config{
+		Auth: testAuthConfig(),
+	})
+	conn.SetAuthToken(genToken(t, "test_tenant_id", "test_user_id", "admin"))
+	poolID := conn.TestPoolPost(api.PoolPostRequest{
+		Name: "test",
+		Masks: []pools.Mask{
+			{Field: "ssn", Method: pools.MaskPartial, Roles: []string{"admin"}},
+			{Field: "email", Method: pools.MaskNull},
+		},
+	})
+	conn.TestLoad(poolID, "main", strings.NewReader(`{ssn:"123-45-6789",email:"a@b.com"}`))
+	require.Equal(t, "{ssn:\"123-45-6789\",email:null}\n", conn.TestQuery("from test"))
+
+	conn.SetAuthToken(genToken(t, "test_tenant_id", "test_user_id", "analyst"))
+	require.Equal(t, "{ssn:\"****6789\",email:null}\n", conn.TestQuery("from test"))
+	require.Equal(t, "", conn.TestQuery("from test | ssn==\"123-45-6789\""))
+}
+
+func TestAuthPoolStatsMasks(t *testing.T) {
+	_, conn := newCoreWithConfig(t, service.Config{
+		Auth: testAuthConfig(),
+	})
+	conn.SetAuthToken(genToken(t, "test_tenant_id", "test_user_id", "admin"))
+	poolID := conn.TestPoolPost(api.PoolPostRequest{
+		Name:     "test",
+		SortKeys: api.SortKeys{Order: order.Asc, Keys: field.List{{"ssn"}}},
+		Masks:    []pools.Mask{{Field: "ssn", Method: pools.MaskNull, Roles: []string{"admin"}}},
+	})
+	conn.TestLoad(poolID, "main", strings.NewReader(`{ssn:"123-45-6789"}`))
+	info := conn.TestPoolStats(poolID)
+	require.Equal(t, `"123-45-6789"`, sup.String(info.Min))
+	require.Equal(t, `"123-45-6789"`, sup.String(info.Max))
+
+	conn.SetAuthToken(genToken(t, "test_tenant_id", "test_user_id", "analyst"))
+	info = conn.TestPoolStats(poolID)
+	require.Equal(t, uint64(1), info.Count)
+	require.Equal(t, super.Null, info.Min)
+	require.Equal(t, super.Null, info.Max)
+	require.Nil(t, info.Span)
+}
+
+func TestAuthQueryCancel(t *testing.T) {
+	_, conn := newCoreWithConfig(t, service.Config{
+		Auth: testAuthConfig(),
+	})
+	ctx := context.Background()
+	conn.SetAuthToken(genToken(t, "test_tenant_id", "test_user_id", "analyst"))
+	r, err := conn.Query(ctx, "values 1")
+	require.NoError(t, err)
+	id := r.Header.Get(api.RequestIDHeader)
+	conn.readResponse(r)
+	list, err := conn.QueryStatusList(ctx)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Equal(t, "test_user_id", list[0].UserID)
+	err = conn.CancelQuery(ctx, id)
+	require.ErrorContains(t, err, "canceling a query requires the admin role")
+
+	conn.SetAuthToken(genToken(t, "test_tenant_id", "admin_user_id", "admin"))
+	require.NoError(t, conn.CancelQuery(ctx, id))
+}
+
+func TestAuthQueryStatusList(t *testing.T) {
+	_, conn := newCoreWithConfig(t, service.Config{
+		Auth: testAuthConfig(),
+	})
+	ctx := context.Background()
+	for _, user := range []auth.UserID{"user1", "user2"} {
+		conn.SetAuthToken(genToken(t, "test_tenant_id", user, "analyst"))
+		r, err := conn.Query(ctx, "values 1")
+		require.NoError(t, err)
+		conn.readResponse(r)
+	}
+	for _, user := range []string{"user1", "user2"} {
+		conn.SetAuthToken(genToken(t, "test_tenant_id", auth.UserID(user), "analyst"))
+		list, err := conn.QueryStatusList(ctx)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		require.Equal(t, user, list[0].UserID)
+	}
+
+	conn.SetAuthToken(genToken(t, "test_tenant_id", "admin_user_id", "admin"))
+	list, err := conn.QueryStatusList(ctx)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+}
+
+func TestAuthExternal(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.sup"), []byte("{x:1}"), 0666))
+	_, conn := newCoreWithConfig(t, service.Config{
+		Auth:          testAuthConfig(),
+		ExternalRoots: []string{dir},
+	})
+	ctx := context.Background()
+	conn.SetAuthToken(genToken(t, "test_tenant_id", "test_user_id", "analyst"))
+	_, err := conn.CreateExternal(ctx, api.ExternalPostRequest{Name: "logs", Pattern: dir + "/*.sup"})
+	require.ErrorContains(t, err, "creating an external pool requires the admin role")
+
+	conn.SetAuthToken(genToken(t, "test_tenant_id", "admin_user_id", "admin"))
+	for _, pattern := range []string{"/etc/*", dir + "/../*", "s3://bucket/*"} {
+		_, err = conn.CreateExternal(ctx, api.ExternalPostRequest{Name: "logs", Pattern: pattern})
+		require.Equal(t, http.StatusForbidden, err.(*client.ErrorResponse).StatusCode, pattern)
+	}
+	_, err = conn.CreateExternal(ctx, api.ExternalPostRequest{Name: "logs", Pattern: dir + "/*.sup"})
+	require.NoError(t, err)
+	require.Equal(t, "{x:1}\n", conn.TestQuery("from logs"))
+
+	conn.SetAuthToken(genToken(t, "test_tenant_id", "test_user_id", "analyst"))
+	err = conn.RemoveExternal(ctx, "logs")
+	require.ErrorContains(t, err, "deleting an external pool requires the admin role")
+}
+
+func TestAuthExternalNotEnabled(t *testing.T) {
+	_, conn := newCore(t)
+	_, err := conn.CreateExternal(context.Background(), api.ExternalPostRequest{Name: "logs", Pattern: "/etc/*"})
+	require.ErrorContains(t, err, "external pools not enabled")
+}
+
+func TestAuthSession(t *testing.T) {
+	_, conn := newCoreWithConfig(t, service.Config{
+		Auth: testAuthConfig(),
+	})
+	ctx := context.Background()
+	conn.SetAuthToken(genToken(t, "test_tenant_id", "user1", "analyst"))
+	session, err := conn.CreateSession(ctx, api.SessionPostRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "", conn.TestQueryInSession(session.ID, "values 1 | into temp(\"t\")"))
+
+	conn.SetAuthToken(genToken(t, "test_tenant_id", "user2", "admin"))
+	_, err = conn.QueryInSession(ctx, session.ID, "from temp(\"t\")")
+	assert.ErrorContains(t, err, "not found")
+	assert.ErrorContains(t, conn.DeleteSession(ctx, session.ID), "not found")
+
+	conn.SetAuthToken(genToken(t, "test_tenant_id", "user1", "analyst"))
+	assert.Equal(t, "{c0:1}\n", conn.TestQueryInSession(session.ID, "from temp(\"t\")"))
+	require.NoError(t, conn.DeleteSession(ctx, session.ID))
+}
+
 func TestAuthMethodGet(t *testing.T) {
 	t.Run("none", func(t *testing.T) {
 		_, connNoAuth := newCoreWithConfig(t, service.Config{})
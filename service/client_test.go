@@ -82,6 +82,16 @@ func (c *testClient) TestBranchPost(poolID ksuid.KSUID, payload api.BranchPostRe
 func (c *testClient) TestQuery(query string) string {
 	r, err := c.Connection.Query(context.Background(), query)
 	require.NoError(c, err)
+	return c.readResponse(r)
+}
+
+func (c *testClient) TestQueryInSession(session ksuid.KSUID, query string) string {
+	r, err := c.Connection.QueryInSession(context.Background(), session, query)
+	require.NoError(c, err)
+	return c.readResponse(r)
+}
+
+func (c *testClient) readResponse(r *client.Response) string {
 	defer r.Body.Close()
 	zr := bsupio.NewReader(super.NewContext(), r.Body)
 	defer zr.Close()
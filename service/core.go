@@ -1,6 +1,7 @@
 package service
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"errors"
@@ -8,6 +9,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/pprof"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -15,13 +17,20 @@ import (
 	"github.com/brimdata/super/api"
 	"github.com/brimdata/super/compiler"
 	"github.com/brimdata/super/lake"
+	"github.com/brimdata/super/pkg/nano"
 	"github.com/brimdata/super/pkg/storage"
 	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/exec"
+	"github.com/brimdata/super/runtime/sam/op/join"
+	"github.com/brimdata/super/runtime/sam/op/meta"
+	"github.com/brimdata/super/service/auth"
+	"github.com/brimdata/super/service/srverr"
 	"github.com/brimdata/super/sup"
 	"github.com/gorilla/mux"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/collectors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/segmentio/ksuid"
 	"go.uber.org/zap"
 )
 
@@ -46,24 +55,54 @@ type Config struct {
 	Auth                  AuthConfig
 	CORSAllowedOrigins    []string
 	DefaultResponseFormat string
+	ESBulkIndexes         []string
+	ExportRoots           []string
+	ExternalRoots         []string
+	MetaCacheSize         uint64
+	OTLPLogs              string
+	OTLPTraces            string
+	PartitionCacheSize    int
+	QueryMetricLabels     []string
+	ReadOnly              bool
+	RefreshInterval       time.Duration
 	Root                  *storage.URI
 	RootContent           io.ReadSeeker
+	SessionIdleTTL        time.Duration
+	SnapshotCache         bool
+	SlowQueryThreshold    time.Duration
 	Version               string
+	LookupCacheSize       int
 	Logger                *zap.Logger
 }
 
 type Core struct {
+	alerts           map[string]*alertRule
+	alertsMu         sync.Mutex
+	auditLogger      *zap.Logger
 	auth             *Auth0Authenticator
 	compiler         runtime.Compiler
 	conf             Config
+	ctx              context.Context // bounds background work, e.g., schedules
 	engine           storage.Engine
+	esBulkIndexes    []esBulkIndex
+	exportRoots      []*storage.URI
+	externalRoots    []*storage.URI
 	logger           *zap.Logger
+	lookups          *join.LookupCache
+	partitions       *meta.PartitionCache
+	queryMetrics     *queryMetrics
 	registry         *prometheus.Registry
 	root             *lake.Root
 	routerAPI        *mux.Router
 	routerAux        *mux.Router
 	runningQueries   map[string]*queryStatus
 	runningQueriesMu sync.Mutex
+	schedules        map[string]*schedule
+	schedulesMu      sync.Mutex
+	sessions         map[ksuid.KSUID]*session
+	sessionsMu       sync.Mutex
+	slowQueryLogger  *zap.Logger
+	snapshots        *lake.SnapshotCache
 	subscriptions    map[chan event]struct{}
 	subscriptionsMu  sync.RWMutex
 }
@@ -84,6 +123,30 @@ func NewCore(ctx context.Context, conf Config) (*Core, error) {
 	if conf.Version == "" {
 		conf.Version = "unknown"
 	}
+	if conf.PartitionCacheSize == 0 {
+		conf.PartitionCacheSize = meta.DefaultPartitionCacheSize
+	}
+	if conf.LookupCacheSize == 0 {
+		conf.LookupCacheSize = join.DefaultLookupCacheSize
+	}
+	for _, name := range conf.QueryMetricLabels {
+		if err := api.CheckQueryLabels(map[string]string{name: ""}); err != nil {
+			return nil, fmt.Errorf("invalid query metric label: %w", err)
+		}
+	}
+
+	esBulkIndexes, err := parseESBulkIndexes(conf.ESBulkIndexes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Elasticsearch bulk index mapping: %w", err)
+	}
+	exportRoots, err := parseRoots("export", conf.ExportRoots)
+	if err != nil {
+		return nil, fmt.Errorf("invalid export root: %w", err)
+	}
+	externalRoots, err := parseRoots("external", conf.ExternalRoots)
+	if err != nil {
+		return nil, fmt.Errorf("invalid external root: %w", err)
+	}
 
 	registry := prometheus.NewRegistry()
 	registry.MustRegister(collectors.NewGoCollector())
@@ -108,7 +171,32 @@ func NewCore(ctx context.Context, conf Config) (*Core, error) {
 	default:
 		return nil, fmt.Errorf("root path cannot have scheme %q", path.Scheme)
 	}
-	root, err := lake.CreateOrOpen(ctx, engine, conf.Logger.Named("lake"), path)
+	var root *lake.Root
+	if conf.ReadOnly {
+		// A read-only service never creates the lake.
+		root, err = lake.Open(ctx, engine, conf.Logger.Named("lake"), path)
+	} else {
+		root, err = lake.CreateOrOpen(ctx, engine, conf.Logger.Named("lake"), path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if conf.MetaCacheSize != 0 {
+		root.VectorCache().MetaCache().SetLimit(conf.MetaCacheSize)
+	}
+
+	var snapshots *lake.SnapshotCache
+	if conf.SnapshotCache {
+		snapshots = lake.NewSnapshotCache()
+		root.SetSnapshotCache(snapshots)
+	}
+
+	partitions, err := meta.NewPartitionCache(conf.PartitionCacheSize)
+	if err != nil {
+		return nil, err
+	}
+	lookups, err := join.NewLookupCache(conf.LookupCacheSize)
 	if err != nil {
 		return nil, err
 	}
@@ -143,22 +231,52 @@ func NewCore(ctx context.Context, conf Config) (*Core, error) {
 	routerAPI.Use(corsMiddleware(conf.CORSAllowedOrigins))
 
 	c := &Core{
-		auth:           authenticator,
-		compiler:       compiler.NewLakeCompiler(root),
-		conf:           conf,
-		engine:         engine,
-		logger:         conf.Logger.Named("core"),
-		root:           root,
-		registry:       registry,
-		routerAPI:      routerAPI,
-		routerAux:      routerAux,
-		runningQueries: make(map[string]*queryStatus),
-		subscriptions:  make(map[chan event]struct{}),
+		alerts:          make(map[string]*alertRule),
+		auditLogger:     conf.Logger.Named("audit"),
+		auth:            authenticator,
+		conf:            conf,
+		ctx:             ctx,
+		engine:          engine,
+		esBulkIndexes:   esBulkIndexes,
+		exportRoots:     exportRoots,
+		externalRoots:   externalRoots,
+		logger:          conf.Logger.Named("core"),
+		lookups:         lookups,
+		partitions:      partitions,
+		queryMetrics:    newQueryMetrics(registry, conf.QueryMetricLabels),
+		root:            root,
+		registry:        registry,
+		routerAPI:       routerAPI,
+		routerAux:       routerAux,
+		runningQueries:  make(map[string]*queryStatus),
+		schedules:       make(map[string]*schedule),
+		sessions:        make(map[ksuid.KSUID]*session),
+		slowQueryLogger: conf.Logger.Named("slowquery"),
+		snapshots:       snapshots,
+		subscriptions:   make(map[chan event]struct{}),
 	}
+	c.compiler = compiler.NewCompilerWithEnvironment(c.newEnvironment())
 
 	c.addAPIServerRoutes()
+	sessionTTL := conf.SessionIdleTTL
+	if sessionTTL <= 0 {
+		sessionTTL = DefaultSessionIdleTTL
+	}
+	go c.expireSessions(ctx, sessionTTL)
+	if conf.ReadOnly {
+		interval := conf.RefreshInterval
+		if interval <= 0 {
+			interval = DefaultRefreshInterval
+		}
+		state, err := c.loadPoolStates(ctx)
+		if err != nil {
+			return nil, err
+		}
+		go c.refresh(ctx, interval, state)
+	}
 	c.logger.Info("Started",
 		zap.Bool("auth_enabled", conf.Auth.Enabled),
+		zap.Bool("read_only", conf.ReadOnly),
 		zap.Stringer("root", path),
 		zap.String("version", conf.Version),
 	)
@@ -166,30 +284,50 @@ func NewCore(ctx context.Context, conf Config) (*Core, error) {
 }
 
 func (c *Core) addAPIServerRoutes() {
+	c.authhandle("/alert", handleAlertList).Methods("GET")
+	c.authhandle("/alert", c.mutating(handleAlertPost)).Methods("POST")
+	c.authhandle("/alert/{alert}", handleAlertGet).Methods("GET")
+	c.authhandle("/alert/{alert}", c.mutating(handleAlertDelete)).Methods("DELETE")
 	c.authhandle("/auth/identity", handleAuthIdentityGet).Methods("GET")
 	// /auth/method intentionally requires no authentication
 	c.routerAPI.Handle("/auth/method", c.handler(handleAuthMethodGet)).Methods("GET")
 	c.authhandle("/compile", handleCompile).Methods("POST")
 	c.authhandle("/events", handleEvents).Methods("GET")
-	c.authhandle("/pool", handlePoolPost).Methods("POST")
-	c.authhandle("/pool/{pool}", handlePoolDelete).Methods("DELETE")
-	c.authhandle("/pool/{pool}", handleBranchPost).Methods("POST")
-	c.authhandle("/pool/{pool}", handlePoolPut).Methods("PUT")
+	c.authhandle("/pool", c.mutating(handlePoolPost)).Methods("POST")
+	c.authhandle("/pool/{pool}", c.mutating(handlePoolDelete)).Methods("DELETE")
+	c.authhandle("/pool/{pool}", c.mutating(handleBranchPost)).Methods("POST")
+	c.authhandle("/pool/{pool}", c.mutating(handlePoolPut)).Methods("PUT")
+	c.authhandle("/external", c.mutating(handleExternalPost)).Methods("POST")
+	c.authhandle("/external/{external}", c.mutating(handleExternalDelete)).Methods("DELETE")
 	c.authhandle("/pool/{pool}/branch/{branch}", handleBranchGet).Methods("GET")
-	c.authhandle("/pool/{pool}/branch/{branch}", handleBranchDelete).Methods("DELETE")
-	c.authhandle("/pool/{pool}/branch/{branch}", handleBranchLoad).Methods("POST")
-	c.authhandle("/pool/{pool}/branch/{branch}/compact", handleCompact).Methods("POST")
-	c.authhandle("/pool/{pool}/branch/{branch}/compact/new", handleCompactNew).Methods("POST")
-	c.authhandle("/pool/{pool}/branch/{branch}/delete", handleDelete).Methods("POST")
-	c.authhandle("/pool/{pool}/branch/{branch}/merge/{child}", handleBranchMerge).Methods("POST")
-	c.authhandle("/pool/{pool}/branch/{branch}/revert/{commit}", handleRevertPost).Methods("POST")
-	c.authhandle("/pool/{pool}/revision/{revision}/vacuum", handleVacuum).Methods("POST")
-	c.authhandle("/pool/{pool}/revision/{revision}/vector", handleVectorPost).Methods("POST")
-	c.authhandle("/pool/{pool}/revision/{revision}/vector", handleVectorDelete).Methods("DELETE")
+	c.authhandle("/pool/{pool}/branch/{branch}", c.mutating(handleBranchDelete)).Methods("DELETE")
+	c.authhandle("/pool/{pool}/branch/{branch}", c.mutating(handleBranchLoad)).Methods("POST")
+	c.authhandle("/pool/{pool}/branch/{branch}/compact", c.mutating(handleCompact)).Methods("POST")
+	c.authhandle("/pool/{pool}/branch/{branch}/compact/new", c.mutating(handleCompactNew)).Methods("POST")
+	c.authhandle("/pool/{pool}/branch/{branch}/delete", c.mutating(handleDelete)).Methods("POST")
+	c.authhandle("/pool/{pool}/branch/{branch}/merge/{child}", c.mutating(handleBranchMerge)).Methods("POST")
+	c.authhandle("/pool/{pool}/branch/{branch}/revert/{commit}", c.mutating(handleRevertPost)).Methods("POST")
+	c.authhandle("/pool/{pool}/revision/{revision}/vacuum", c.mutating(handleVacuum)).Methods("POST")
+	c.authhandle("/pool/{pool}/revision/{revision}/vector", c.mutating(handleVectorPost)).Methods("POST")
+	c.authhandle("/pool/{pool}/revision/{revision}/vector", c.mutating(handleVectorDelete)).Methods("DELETE")
 	c.authhandle("/pool/{pool}/stats", handlePoolStats).Methods("GET")
 	c.authhandle("/query", handleQuery).Methods("OPTIONS", "POST")
 	c.authhandle("/query/describe", handleQueryDescribe).Methods("OPTIONS", "POST")
+	c.authhandle("/query/status", handleQueryStatusList).Methods("GET")
 	c.authhandle("/query/status/{requestID}", handleQueryStatus).Methods("GET")
+	c.authhandle("/query/status/{requestID}", handleQueryCancel).Methods("DELETE")
+	c.authhandle("/schedule", handleScheduleList).Methods("GET")
+	c.authhandle("/schedule", c.mutating(handleSchedulePost)).Methods("POST")
+	c.authhandle("/schedule/{schedule}", handleScheduleGet).Methods("GET")
+	c.authhandle("/schedule/{schedule}", c.mutating(handleScheduleDelete)).Methods("DELETE")
+	c.authhandle("/schedule/{schedule}/run", c.mutating(handleScheduleRun)).Methods("POST")
+	c.authhandle("/session", handleSessionPost).Methods("POST")
+	c.authhandle("/session/{session}", handleSessionGet).Methods("GET")
+	c.authhandle("/session/{session}", handleSessionDelete).Methods("DELETE")
+	c.authhandle("/v1/logs", c.mutating(handleOTLPLogs)).Methods("POST")
+	c.authhandle("/v1/traces", c.mutating(handleOTLPTraces)).Methods("POST")
+	c.authhandle("/_bulk", c.mutating(handleESBulk)).Methods("POST", "PUT")
+	c.authhandle("/{index}/_bulk", c.mutating(handleESBulk)).Methods("POST", "PUT")
 }
 
 func (c *Core) handler(f func(*Core, *ResponseWriter, *Request)) http.Handler {
@@ -200,6 +338,17 @@ func (c *Core) handler(f func(*Core, *ResponseWriter, *Request)) http.Handler {
 	})
 }
 
+// mutating returns f, which modifies the lake, or, if the service is
+// read-only, a handler that rejects the request.
+func (c *Core) mutating(f func(*Core, *ResponseWriter, *Request)) func(*Core, *ResponseWriter, *Request) {
+	if !c.conf.ReadOnly {
+		return f
+	}
+	return func(c *Core, w *ResponseWriter, r *Request) {
+		w.Error(srverr.ErrForbidden("service is read-only"))
+	}
+}
+
 func (c *Core) authhandle(path string, f func(*Core, *ResponseWriter, *Request)) *mux.Route {
 	if c.auth != nil {
 		f = c.auth.Middleware(f)
@@ -211,6 +360,10 @@ func (c *Core) Registry() *prometheus.Registry {
 	return c.registry
 }
 
+func (c *Core) Root() *lake.Root {
+	return c.root
+}
+
 func (c *Core) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	var rm mux.RouteMatch
 	if c.routerAux.Match(r, &rm) {
@@ -221,11 +374,26 @@ func (c *Core) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 }
 
 func (c *Core) publishEvent(w *ResponseWriter, name string, data any) {
+	c.publish(w.Logger, name, data)
+}
+
+func (c *Core) publish(logger *zap.Logger, name string, data any) {
+	switch data := data.(type) {
+	case api.EventBranchCommit:
+		c.snapshots.Invalidate(data.PoolID, data.Branch)
+		c.lookups.InvalidatePool(data.PoolID)
+		c.evaluateAlertRules(data.PoolID, data.Branch)
+	case api.EventBranch:
+		c.snapshots.Invalidate(data.PoolID, data.Branch)
+	case api.EventPool:
+		c.snapshots.InvalidatePool(data.PoolID)
+		c.lookups.InvalidatePool(data.PoolID)
+	}
 	marshaler := sup.NewBSUPMarshaler()
 	marshaler.Decorate(sup.StyleSimple)
 	zv, err := marshaler.Marshal(data)
 	if err != nil {
-		w.Logger.Error("Error marshaling published event", zap.Error(err))
+		logger.Error("Error marshaling published event", zap.Error(err))
 		return
 	}
 	go func() {
@@ -238,7 +406,27 @@ func (c *Core) publishEvent(w *ResponseWriter, name string, data any) {
 	}()
 }
 
-func (c *Core) newQueryStatus(r *Request) *queryStatus {
+// newEnvironment returns the environment in which the queries of c are
+// compiled and run, which shares the caches of c.
+func (c *Core) newEnvironment() *exec.Environment {
+	env := exec.NewEnvironment(storage.NewRemoteEngine(), c.root)
+	env.SetPartitionCache(c.partitions)
+	env.SetLookupCache(c.lookups)
+	env.SetReadOnly(c.conf.ReadOnly)
+	return env
+}
+
+// isAdmin returns true if r may make administrative requests, i.e., if
+// authentication is disabled or r's identity has the admin role.
+func (c *Core) isAdmin(r *Request) bool {
+	if c.auth == nil {
+		return true
+	}
+	role := c.conf.Auth.AdminRole
+	return role != "" && slices.Contains(auth.RolesFromContext(r.Context()), role)
+}
+
+func (c *Core) newQueryStatus(r *Request, req api.QueryRequest, start time.Time, flowgraph runtime.Query, cancel context.CancelFunc) *queryStatus {
 	id := r.ID()
 	remove := func() {
 		// Have query status wait around for a few seconds after done is signaled
@@ -248,7 +436,16 @@ func (c *Core) newQueryStatus(r *Request) *queryStatus {
 		delete(c.runningQueries, id)
 		c.runningQueriesMu.Unlock()
 	}
-	q := &queryStatus{remove: remove}
+	q := &queryStatus{
+		remove:    remove,
+		id:        id,
+		ident:     auth.IdentityFromContext(r.Context()),
+		query:     req.Query,
+		labels:    req.Labels,
+		start:     start,
+		flowgraph: flowgraph,
+		cancel:    cancel,
+	}
 	q.wg.Add(1)
 	c.runningQueriesMu.Lock()
 	c.runningQueries[id] = q
@@ -256,19 +453,99 @@ func (c *Core) newQueryStatus(r *Request) *queryStatus {
 	return q
 }
 
+func (c *Core) lookupQueryStatus(id string) (*queryStatus, error) {
+	c.runningQueriesMu.Lock()
+	defer c.runningQueriesMu.Unlock()
+	q, ok := c.runningQueries[id]
+	if !ok {
+		return nil, srverr.ErrNotFound("query not found")
+	}
+	return q, nil
+}
+
+// listQueryStatus returns the status of the running queries and of those
+// that finished in the last few seconds, oldest first.  Unless r may make
+// administrative requests, only the queries of r's identity are listed.
+func (c *Core) listQueryStatus(r *Request) []api.QueryStatus {
+	admin := c.isAdmin(r)
+	ident := auth.IdentityFromContext(r.Context())
+	c.runningQueriesMu.Lock()
+	list := make([]api.QueryStatus, 0, len(c.runningQueries))
+	for _, q := range c.runningQueries {
+		if !admin && (q.ident.TenantID != ident.TenantID || q.ident.UserID != ident.UserID) {
+			continue
+		}
+		list = append(list, q.Meta())
+	}
+	c.runningQueriesMu.Unlock()
+	slices.SortFunc(list, func(a, b api.QueryStatus) int {
+		return cmp.Or(cmp.Compare(a.Start, b.Start), cmp.Compare(a.RequestID, b.RequestID))
+	})
+	return list
+}
+
 type queryStatus struct {
-	wg     sync.WaitGroup
-	remove func()
-	error  string
+	wg        sync.WaitGroup
+	remove    func()
+	id        string
+	ident     auth.Identity
+	query     string
+	labels    map[string]string
+	start     time.Time
+	flowgraph runtime.Query
+	cancel    context.CancelFunc
+
+	mu    sync.Mutex
+	end   time.Time
+	error string
 }
 
 func (q *queryStatus) setError(err error) {
 	if err != nil {
+		q.mu.Lock()
 		q.error = err.Error()
+		q.mu.Unlock()
+	}
+}
+
+func (q *queryStatus) Error() string {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.error
+}
+
+// Meta returns a description of the query and its progress.
+func (q *queryStatus) Meta() api.QueryStatus {
+	q.mu.Lock()
+	end, errMsg := q.end, q.error
+	q.mu.Unlock()
+	var elapsed time.Duration
+	var endTs nano.Ts
+	if end.IsZero() {
+		elapsed = time.Since(q.start)
+	} else {
+		elapsed = end.Sub(q.start)
+		endTs = nano.TimeToTs(end)
+	}
+	return api.QueryStatus{
+		RequestID: q.id,
+		TenantID:  string(q.ident.TenantID),
+		UserID:    string(q.ident.UserID),
+		Query:     q.query,
+		Labels:    q.labels,
+		Start:     nano.TimeToTs(q.start),
+		End:       endTs,
+		Elapsed:   nano.Duration(elapsed),
+		Progress:  q.flowgraph.Progress(),
+		Spill:     q.flowgraph.SpillStats(),
+		Error:     errMsg,
 	}
 }
 
 func (q *queryStatus) Done() {
+	q.mu.Lock()
+	q.end = time.Now()
+	q.mu.Unlock()
 	q.wg.Done()
 	go q.remove()
 }
@@ -0,0 +1,255 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/api"
+	lakeapi "github.com/brimdata/super/lake/api"
+	"github.com/brimdata/super/lake/commits"
+	"github.com/brimdata/super/lake/pools"
+	"github.com/brimdata/super/service/srverr"
+	"github.com/brimdata/super/zbuf"
+	"github.com/brimdata/super/zio/anyio"
+	"github.com/brimdata/super/zio/jsonio"
+	"github.com/gorilla/mux"
+	"github.com/segmentio/ksuid"
+	"go.uber.org/zap"
+)
+
+// ESBulkLoader is the loader recorded in the provenance of the commits of
+// the Elasticsearch bulk endpoint.
+const ESBulkLoader = "esbulk"
+
+// esBulkIndex maps the Elasticsearch index names matching pattern to a
+// branch.
+type esBulkIndex struct {
+	pattern string
+	pool    string
+	branch  string
+}
+
+// parseESBulkIndexes parses mappings of the form pattern=pool[@branch],
+// where pattern is a path.Match pattern.
+func parseESBulkIndexes(mappings []string) ([]esBulkIndex, error) {
+	var indexes []esBulkIndex
+	for _, s := range mappings {
+		pattern, target, ok := strings.Cut(s, "=")
+		if !ok || pattern == "" || target == "" {
+			return nil, fmt.Errorf("%q: mapping must be pattern=pool[@branch]", s)
+		}
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("%q: %w", s, err)
+		}
+		pool, branch, ok := strings.Cut(target, "@")
+		if !ok {
+			branch = "main"
+		}
+		indexes = append(indexes, esBulkIndex{pattern, pool, branch})
+	}
+	return indexes, nil
+}
+
+func (c *Core) lookupESBulkIndex(index string) (esBulkIndex, bool) {
+	for _, i := range c.esBulkIndexes {
+		if ok, _ := path.Match(i.pattern, index); ok {
+			return i, true
+		}
+	}
+	return esBulkIndex{}, false
+}
+
+type esBulkItem struct {
+	Index   string       `json:"_index"`
+	ID      string       `json:"_id"`
+	Version int          `json:"_version,omitempty"`
+	Result  string       `json:"result,omitempty"`
+	Status  int          `json:"status"`
+	Error   *esBulkError `json:"error,omitempty"`
+	action  string
+}
+
+type esBulkError struct {
+	Type   string `json:"type"`
+	Reason string `json:"reason"`
+}
+
+type esBulkTarget struct {
+	index esBulkIndex
+	vals  []super.Value
+	items []*esBulkItem
+}
+
+// handleESBulk implements a subset of the Elasticsearch bulk API so that
+// log shippers like Filebeat and Vector may load into the lake without
+// reconfiguration.  The documents of the index and create actions of a
+// request are loaded in one commit to each branch mapped from their index
+// names, and the response lists the outcome of each action as Elasticsearch
+// does.  Other actions fail.
+func handleESBulk(c *Core, w *ResponseWriter, r *Request) {
+	if len(c.esBulkIndexes) == 0 {
+		w.Error(srverr.ErrNotFound("Elasticsearch bulk endpoint not enabled"))
+		return
+	}
+	start := time.Now()
+	defaultIndex := mux.Vars(r.Request)["index"]
+	// Shippers may compress requests with gzip.
+	body, err := anyio.GzipReader(r.Body)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	sctx := super.NewContext()
+	var items []*esBulkItem
+	var targets []*esBulkTarget
+	targetOf := map[esBulkIndex]*esBulkTarget{}
+	docReader := jsonio.NewReader(sctx, nil)
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(nil, 100*1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var action map[string]struct {
+			Index string `json:"_index"`
+			ID    string `json:"_id"`
+		}
+		if err := json.Unmarshal(line, &action); err != nil || len(action) != 1 {
+			w.Error(srverr.ErrInvalid("malformed action/metadata line %d", len(items)+1))
+			return
+		}
+		var item esBulkItem
+		for name, meta := range action {
+			item.action, item.Index, item.ID = name, meta.Index, meta.ID
+		}
+		if item.Index == "" {
+			item.Index = defaultIndex
+		}
+		if item.ID == "" {
+			item.ID = ksuid.New().String()
+		}
+		items = append(items, &item)
+		switch item.action {
+		case "index", "create":
+		case "delete":
+			// A delete action has no document line.
+			item.fail(http.StatusBadRequest, "action_request_validation_exception", "delete is not supported")
+			continue
+		case "update":
+			scanner.Scan()
+			item.fail(http.StatusBadRequest, "action_request_validation_exception", "update is not supported")
+			continue
+		default:
+			w.Error(srverr.ErrInvalid("unknown bulk action %q", item.action))
+			return
+		}
+		if !scanner.Scan() {
+			w.Error(srverr.ErrInvalid("%s action is missing its document", item.action))
+			return
+		}
+		index, ok := c.lookupESBulkIndex(item.Index)
+		if !ok {
+			item.fail(http.StatusNotFound, "index_not_found_exception", "no such index ["+item.Index+"]")
+			continue
+		}
+		docReader.Reset(bytes.NewReader(scanner.Bytes()))
+		val, err := docReader.Read()
+		if err == nil && (val == nil || !super.IsRecordType(val.Type())) {
+			err = errors.New("document must be an object")
+		}
+		if err != nil {
+			item.fail(http.StatusBadRequest, "mapper_parsing_exception", err.Error())
+			continue
+		}
+		target := targetOf[index]
+		if target == nil {
+			target = &esBulkTarget{index: index}
+			targetOf[index] = target
+			targets = append(targets, target)
+		}
+		target.vals = append(target.vals, val.Copy())
+		target.items = append(target.items, &item)
+	}
+	if err := scanner.Err(); err != nil {
+		w.Error(srverr.ErrInvalid(err))
+		return
+	}
+	for _, target := range targets {
+		status, typ := http.StatusCreated, ""
+		if err := c.loadESBulk(w, r, sctx, target); err != nil {
+			w.Logger.Warn("Elasticsearch bulk load failed", zap.Error(err))
+			status, typ = http.StatusInternalServerError, "exception"
+			if errors.Is(err, pools.ErrNotFound) {
+				status, typ = http.StatusNotFound, "index_not_found_exception"
+			}
+			for _, item := range target.items {
+				item.fail(status, typ, err.Error())
+			}
+			continue
+		}
+		for _, item := range target.items {
+			item.Version, item.Result, item.Status = 1, "created", status
+		}
+	}
+	res := struct {
+		Took   int64            `json:"took"`
+		Errors bool             `json:"errors"`
+		Items  []map[string]any `json:"items"`
+	}{
+		Took:  time.Since(start).Milliseconds(),
+		Items: []map[string]any{},
+	}
+	for _, item := range items {
+		res.Errors = res.Errors || item.Error != nil
+		res.Items = append(res.Items, map[string]any{item.action: item})
+	}
+	w.Header().Set("Content-Type", api.MediaTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w.ResponseWriter).Encode(res); err != nil {
+		w.Logger.Warn("Error writing response", zap.Error(err))
+	}
+}
+
+func (i *esBulkItem) fail(status int, typ, reason string) {
+	i.Status = status
+	i.Error = &esBulkError{Type: typ, Reason: reason}
+}
+
+func (c *Core) loadESBulk(w *ResponseWriter, r *Request, sctx *super.Context, target *esBulkTarget) error {
+	branch, err := c.openBranch(r.Context(), target.index.pool, target.index.branch)
+	if err != nil {
+		return err
+	}
+	message := api.CommitMessage{
+		Author: ESBulkLoader,
+		Body:   fmt.Sprintf("esbulk %d documents", len(target.vals)),
+		Loader: ESBulkLoader,
+	}
+	tr, err := lakeapi.TransformLoad(r.Context(), sctx, branch.Pool(), message, zbuf.NewArray(target.vals))
+	if err != nil {
+		return err
+	}
+	defer tr.Close()
+	kommit, err := branch.Load(r.Context(), sctx, tr, message.Author, message.Body, message.Meta, lakeapi.Provenance(message))
+	if err != nil {
+		if errors.Is(err, commits.ErrEmptyTransaction) {
+			return nil
+		}
+		return err
+	}
+	c.publishEvent(w, "branch-commit", api.EventBranchCommit{
+		CommitID: kommit,
+		PoolID:   branch.Pool().ID,
+		Branch:   branch.Name,
+	})
+	return nil
+}
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/brimdata/super/api"
+	"github.com/brimdata/super/lake/journal"
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/service/srverr"
+	"github.com/brimdata/super/sup"
+	"github.com/brimdata/super/zio"
+	"github.com/brimdata/super/zio/anyio"
+	"github.com/brimdata/super/zio/jsonio"
+	"go.uber.org/zap"
+)
+
+// parseRoots parses the storage URIs of the roots given by the -<kind>.root
+// option, e.g., the roots under which queries may export their results.
+func parseRoots(kind string, roots []string) ([]*storage.URI, error) {
+	var uris []*storage.URI
+	for _, s := range roots {
+		u, err := storage.ParseURI(s)
+		if err != nil {
+			return nil, err
+		}
+		if !u.HasScheme(storage.FileScheme) && !u.HasScheme(storage.S3Scheme) {
+			return nil, fmt.Errorf("%s: %s root must be a file or S3 URI", s, kind)
+		}
+		uris = append(uris, u)
+	}
+	return uris, nil
+}
+
+// underRoot returns true if u is one of roots or under one of them.
+// Cleaning the path of u removes any ".." that would climb out of a root.
+func underRoot(u *storage.URI, roots []*storage.URI) bool {
+	u.Path = path.Clean(u.Path)
+	for _, root := range roots {
+		rootPath := path.Clean(root.Path)
+		if u.Scheme == root.Scheme && u.Host == root.Host &&
+			(u.Path == rootPath || strings.HasPrefix(u.Path, strings.TrimSuffix(rootPath, "/")+"/")) {
+			return true
+		}
+	}
+	return false
+}
+
+// exportURI parses s and checks that it is under an export root.
+func (c *Core) exportURI(s string) (*storage.URI, error) {
+	if len(c.exportRoots) == 0 {
+		return nil, srverr.ErrInvalid("query export not enabled")
+	}
+	u, err := storage.ParseURI(s)
+	if err != nil {
+		return nil, srverr.ErrInvalid(err)
+	}
+	if !underRoot(u, c.exportRoots) {
+		return nil, srverr.ErrForbidden("%s: export URI is not under an export root", s)
+	}
+	return u, nil
+}
+
+// handleQueryExport runs a query whose results are written to the storage
+// URI given by the request's export instead of the response, so that a
+// large result need not stream through the client's connection.  Writes to
+// S3 are multipart uploads.  Once the results are complete, a manifest
+// describing them is written next to them and returned in the response.
+// If the query fails, any partial results are removed.
+func (c *Core) handleQueryExport(w *ResponseWriter, r *Request, req api.QueryRequest, start time.Time, flowgraph runtime.Query, cancel context.CancelFunc) {
+	status := c.newQueryStatus(r, req, start, flowgraph, cancel)
+	defer func() {
+		status.Done()
+		c.recordQuery(r, req, start, flowgraph, status.Error())
+	}()
+	manifest, err := c.exportQuery(r, req, start, flowgraph)
+	if err != nil {
+		status.setError(err)
+		w.Error(err)
+		return
+	}
+	w.Respond(http.StatusOK, manifest)
+}
+
+func (c *Core) exportQuery(r *Request, req api.QueryRequest, start time.Time, flowgraph runtime.Query) (*api.QueryExportManifest, error) {
+	format := req.Export.Format
+	if format == "" {
+		format = "bsup"
+	}
+	u, err := c.exportURI(req.Export.URI)
+	if err != nil {
+		return nil, err
+	}
+	engine := storage.NewLocalEngine()
+	out, err := engine.Put(r.Context(), u)
+	if err != nil {
+		return nil, err
+	}
+	counter := &countingWriter{WriteCloser: out}
+	writer, err := anyio.NewWriter(counter, anyio.WriterOpts{Format: format})
+	if err != nil {
+		out.Close()
+		engine.Delete(context.Background(), u)
+		return nil, srverr.ErrInvalid(err)
+	}
+	records, err := writeExport(writer, flowgraph)
+	if closeErr := writer.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		// Remove the partial results, which have no manifest.
+		if err := engine.Delete(context.Background(), u); err != nil {
+			r.Logger.Warn("Error removing partial query export", zap.Error(err))
+		}
+		return nil, err
+	}
+	manifest := &api.QueryExportManifest{
+		URI:       u.String(),
+		Format:    format,
+		Query:     req.Query,
+		Records:   records,
+		Bytes:     counter.n,
+		Start:     nano.TimeToTs(start),
+		End:       nano.Now(),
+		RequestID: r.ID(),
+	}
+	// The manifest is encoded as the JSON response is.
+	rec, err := sup.NewBSUPMarshaler().Marshal(manifest)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	jw := jsonio.NewWriter(zio.NopCloser(&buf), jsonio.WriterOpts{})
+	if err := jw.Write(rec); err != nil {
+		return nil, err
+	}
+	if err := jw.Close(); err != nil {
+		return nil, err
+	}
+	manifestURI := *u
+	manifestURI.Path += api.ExportManifestSuffix
+	if err := storage.Put(r.Context(), engine, &manifestURI, &buf); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+func writeExport(writer zio.Writer, flowgraph runtime.Query) (int64, error) {
+	var records int64
+	for {
+		batch, err := flowgraph.Pull(false)
+		if err != nil {
+			if errors.Is(err, journal.ErrEmpty) {
+				return records, nil
+			}
+			return records, err
+		}
+		if batch == nil {
+			return records, nil
+		}
+		vals := batch.Values()
+		for i := range vals {
+			if err := writer.Write(vals[i]); err != nil {
+				batch.Unref()
+				return records, err
+			}
+		}
+		records += int64(len(vals))
+		batch.Unref()
+	}
+}
+
+type countingWriter struct {
+	io.WriteCloser
+	n int64
+}
+
+func (c *countingWriter) Write(b []byte) (int, error) {
+	n, err := c.WriteCloser.Write(b)
+	c.n += int64(n)
+	return n, err
+}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -17,10 +18,11 @@ import (
 	"github.com/brimdata/super/lake"
 	lakeapi "github.com/brimdata/super/lake/api"
 	"github.com/brimdata/super/lake/commits"
+	"github.com/brimdata/super/lake/externals"
 	"github.com/brimdata/super/lake/journal"
+	"github.com/brimdata/super/lake/pools"
 	"github.com/brimdata/super/lakeparse"
 	"github.com/brimdata/super/order"
-	"github.com/brimdata/super/pkg/storage"
 	"github.com/brimdata/super/runtime"
 	"github.com/brimdata/super/runtime/exec"
 	"github.com/brimdata/super/runtime/sam/op"
@@ -31,16 +33,27 @@ import (
 	"github.com/brimdata/super/zio/anyio"
 	"github.com/brimdata/super/zio/bsupio"
 	"github.com/brimdata/super/zio/csvio"
+	"github.com/brimdata/super/zio/pcapio"
 	"github.com/segmentio/ksuid"
 	"go.uber.org/zap"
 )
 
 func handleQuery(c *Core, w *ResponseWriter, r *Request) {
 	const queryStatsInterval = time.Second
+	start := time.Now()
 	var req api.QueryRequest
 	if !r.Unmarshal(w, &req) {
 		return
 	}
+	if err := api.CheckQueryLabels(req.Labels); err != nil {
+		w.Error(srverr.ErrInvalid(err))
+		return
+	}
+	if len(req.Labels) > 0 {
+		r.Logger = r.Logger.With(zap.Any("query_labels", req.Labels))
+		w.Logger = r.Logger
+		addAccessLogFields(r.Context(), zap.Any("query_labels", req.Labels))
+	}
 	r.Logger.Debug("Running Query", zap.String("query", req.Query))
 	ctrl, ok := r.BoolFromQuery(w, "ctrl")
 	if !ok {
@@ -55,16 +68,44 @@ func handleQuery(c *Core, w *ResponseWriter, r *Request) {
 	// The client must look at the return code and interpret the result
 	// accordingly and when it sees a BSUP error after underway,
 	// the error should be relay that to the caller/user.
+	comp := c.compiler
+	sessionID, ok := r.KSUIDFromQuery(w, "session")
+	if !ok {
+		return
+	}
+	var session *session
+	if sessionID != ksuid.Nil {
+		var err error
+		if session, err = c.acquireSession(r.Context(), sessionID); err != nil {
+			w.Error(err)
+			return
+		}
+		defer session.release()
+		comp = session.compiler
+	}
 	ast, err := parser.ParseQuery(req.Query)
 	if err != nil {
+		c.recordQuery(r, req, start, nil, err.Error())
 		w.Error(srverr.ErrInvalid(err))
 		return
 	}
-	flowgraph, err := runtime.CompileLakeQuery(r.Context(), super.NewContext(), c.compiler, ast)
+	if session != nil {
+		ast.Declare(session.decls)
+	}
+	// The query's context may be canceled by an administrator via
+	// handleQueryCancel.
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	flowgraph, err := runtime.CompileLakeQuery(ctx, super.NewContext(), comp, ast)
 	if err != nil {
+		c.recordQuery(r, req, start, nil, err.Error())
 		w.Error(srverr.ErrInvalid(err))
 		return
 	}
+	if req.Export != nil {
+		c.handleQueryExport(w, r, req, start, flowgraph, cancel)
+		return
+	}
 	flusher, _ := w.ResponseWriter.(http.Flusher)
 	writer, err := queryio.NewWriter(zio.NopCloser(w), w.Format, flusher, ctrl)
 	if err != nil {
@@ -78,8 +119,11 @@ func handleQuery(c *Core, w *ResponseWriter, r *Request) {
 	// Launch query status which will report and runtime errors (i.e., system
 	// errors that occur after the OK header has been sent) to the query status
 	// endpoint.
-	status := c.newQueryStatus(r)
-	defer status.Done()
+	status := c.newQueryStatus(r, req, start, flowgraph, cancel)
+	defer func() {
+		status.Done()
+		c.recordQuery(r, req, start, flowgraph, status.Error())
+	}()
 	handleError := func(err error) {
 		writer.WriteError(err)
 		status.setError(err)
@@ -124,7 +168,7 @@ func handleQuery(c *Core, w *ResponseWriter, r *Request) {
 					return
 				}
 			}
-			if len(batch.Values()) == 0 {
+			if zbuf.Len(batch) == 0 {
 				if eoc, ok := batch.(*zbuf.EndOfChannel); ok {
 					if err := writer.WhiteChannelEnd(string(*eoc)); err != nil {
 						w.Logger.Warn("Error writing channel end", zap.Error(err))
@@ -158,7 +202,34 @@ func handleQueryStatus(c *Core, w *ResponseWriter, r *Request) {
 		return
 	}
 	q.wg.Wait()
-	w.Respond(http.StatusOK, api.QueryError{Error: q.error})
+	w.Respond(http.StatusOK, api.QueryError{Error: q.Error(), Labels: q.labels})
+}
+
+// handleQueryStatusList lists the queries of the requester or, if the
+// requester has the admin role, of every user.
+func handleQueryStatusList(c *Core, w *ResponseWriter, r *Request) {
+	w.Respond(http.StatusOK, c.listQueryStatus(r))
+}
+
+// handleQueryCancel cancels a running query of any user.  It requires the
+// admin role.
+func handleQueryCancel(c *Core, w *ResponseWriter, r *Request) {
+	if !c.isAdmin(r) {
+		w.Error(srverr.ErrForbidden("canceling a query requires the admin role"))
+		return
+	}
+	id, ok := r.StringFromPath(w, "requestID")
+	if !ok {
+		return
+	}
+	q, err := c.lookupQueryStatus(id)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.Logger.Info("Canceling query", zap.String("query_request_id", id))
+	q.cancel()
+	w.WriteHeader(http.StatusNoContent)
 }
 
 func handleCompile(c *Core, w *ResponseWriter, r *Request) {
@@ -171,6 +242,14 @@ func handleCompile(c *Core, w *ResponseWriter, r *Request) {
 		w.Error(srverr.ErrInvalid(err))
 		return
 	}
+	if _, err := compiler.Analyze(r.Context(), ast, c.newEnvironment(), false); err != nil {
+		w.Error(srverr.ErrInvalid(err))
+		return
+	}
+	if err := lakeapi.CheckFields(r.Context(), lakeapi.FromRoot(c.root), ast); err != nil {
+		w.Error(srverr.ErrInvalid(err))
+		return
+	}
 	w.Respond(http.StatusOK, ast.Parsed())
 }
 
@@ -179,8 +258,7 @@ func handleQueryDescribe(c *Core, w *ResponseWriter, r *Request) {
 	if !r.Unmarshal(w, &req) {
 		return
 	}
-	env := exec.NewEnvironment(storage.NewRemoteEngine(), c.root)
-	info, err := describe.Analyze(r.Context(), req.Query, env)
+	info, err := describe.Analyze(r.Context(), req.Query, c.newEnvironment())
 	if err != nil {
 		w.Error(srverr.ErrInvalid(err))
 		return
@@ -188,6 +266,143 @@ func handleQueryDescribe(c *Core, w *ResponseWriter, r *Request) {
 	w.Respond(http.StatusOK, info)
 }
 
+func handleSessionPost(c *Core, w *ResponseWriter, r *Request) {
+	var req api.SessionPostRequest
+	if !r.Unmarshal(w, &req) {
+		return
+	}
+	s, err := c.newSession(r.Context(), req)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.Respond(http.StatusOK, s.Session)
+}
+
+func handleSessionGet(c *Core, w *ResponseWriter, r *Request) {
+	id, ok := r.TagFromPath(w, "session")
+	if !ok {
+		return
+	}
+	s, err := c.lookupSession(r.Context(), id)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.Respond(http.StatusOK, s.Session)
+}
+
+func handleSessionDelete(c *Core, w *ResponseWriter, r *Request) {
+	id, ok := r.TagFromPath(w, "session")
+	if !ok {
+		return
+	}
+	if err := c.deleteSession(r.Context(), id); err != nil {
+		w.Error(err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func handleSchedulePost(c *Core, w *ResponseWriter, r *Request) {
+	var req api.SchedulePostRequest
+	if !r.Unmarshal(w, &req) {
+		return
+	}
+	s, err := c.newSchedule(r.Context(), req)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.Respond(http.StatusOK, s.Meta())
+}
+
+func handleScheduleList(c *Core, w *ResponseWriter, r *Request) {
+	w.Respond(http.StatusOK, c.listSchedules())
+}
+
+func handleScheduleGet(c *Core, w *ResponseWriter, r *Request) {
+	name, ok := r.StringFromPath(w, "schedule")
+	if !ok {
+		return
+	}
+	s, err := c.lookupSchedule(name)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.Respond(http.StatusOK, s.Meta())
+}
+
+func handleScheduleDelete(c *Core, w *ResponseWriter, r *Request) {
+	name, ok := r.StringFromPath(w, "schedule")
+	if !ok {
+		return
+	}
+	if err := c.deleteSchedule(name); err != nil {
+		w.Error(err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleScheduleRun runs a schedule's query now rather than waiting for its
+// next scheduled time.
+func handleScheduleRun(c *Core, w *ResponseWriter, r *Request) {
+	name, ok := r.StringFromPath(w, "schedule")
+	if !ok {
+		return
+	}
+	s, err := c.lookupSchedule(name)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.Respond(http.StatusOK, c.runSchedule(r.Context(), s))
+}
+
+func handleAlertPost(c *Core, w *ResponseWriter, r *Request) {
+	var req api.AlertPostRequest
+	if !r.Unmarshal(w, &req) {
+		return
+	}
+	rule, err := c.newAlertRule(r.Context(), req)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.Respond(http.StatusOK, rule.Meta())
+}
+
+func handleAlertList(c *Core, w *ResponseWriter, r *Request) {
+	w.Respond(http.StatusOK, c.listAlertRules())
+}
+
+func handleAlertGet(c *Core, w *ResponseWriter, r *Request) {
+	name, ok := r.StringFromPath(w, "alert")
+	if !ok {
+		return
+	}
+	rule, err := c.lookupAlertRule(name)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.Respond(http.StatusOK, rule.Meta())
+}
+
+func handleAlertDelete(c *Core, w *ResponseWriter, r *Request) {
+	name, ok := r.StringFromPath(w, "alert")
+	if !ok {
+		return
+	}
+	if err := c.deleteAlertRule(name); err != nil {
+		w.Error(err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
 func handleBranchGet(c *Core, w *ResponseWriter, r *Request) {
 	branchName, ok := r.StringFromPath(w, "branch")
 	if !ok {
@@ -236,6 +451,10 @@ func handlePoolStats(c *Core, w *ResponseWriter, r *Request) {
 		w.Error(err)
 		return
 	}
+	// The range of the pool key would reveal masked values.
+	if pools.AnyApplies(pool.Masks, auth.RolesFromContext(r.Context())) {
+		info.Min, info.Max, info.Span = super.Null, super.Null, nil
+	}
 	w.Respond(http.StatusOK, info)
 }
 
@@ -249,7 +468,25 @@ func handlePoolPost(c *Core, w *ResponseWriter, r *Request) {
 	if len(req.SortKeys.Keys) > 0 {
 		sortKeys = append(sortKeys, order.NewSortKey(req.SortKeys.Order, req.SortKeys.Keys[0]))
 	}
-	pool, err := c.root.CreatePool(r.Context(), req.Name, sortKeys, req.SeekStride, req.Thresh)
+	if err := compiler.CheckSummaries(r.Context(), req.Summaries); err != nil {
+		w.Error(srverr.ErrInvalid(err))
+		return
+	}
+	if err := compiler.CheckTransform(r.Context(), req.Transform); err != nil {
+		w.Error(srverr.ErrInvalid(err))
+		return
+	}
+	for _, m := range req.Masks {
+		if err := m.Check(); err != nil {
+			w.Error(srverr.ErrInvalid(err))
+			return
+		}
+	}
+	if err := req.Partition.Check(); err != nil {
+		w.Error(srverr.ErrInvalid(err))
+		return
+	}
+	pool, err := c.root.CreatePool(r.Context(), req.Name, sortKeys, req.SeekStride, req.Thresh, req.Summaries, req.Defaults, req.Transform, req.Masks, req.Partition)
 	if err != nil {
 		w.Error(err)
 		return
@@ -377,6 +614,60 @@ func handlePoolDelete(c *Core, w *ResponseWriter, r *Request) {
 	c.publishEvent(w, "pool-delete", api.EventPool{PoolID: id})
 }
 
+// handleExternalPost creates an external pool.  Since the files of an
+// external pool are read with the storage engine of the lake, it requires
+// the admin role and a pattern under an external root.
+func handleExternalPost(c *Core, w *ResponseWriter, r *Request) {
+	if !c.isAdmin(r) {
+		w.Error(srverr.ErrForbidden("creating an external pool requires the admin role"))
+		return
+	}
+	var req api.ExternalPostRequest
+	if !r.Unmarshal(w, &req) {
+		return
+	}
+	if req.Name == "" {
+		w.Error(srverr.ErrInvalid("no external pool name provided"))
+		return
+	}
+	if len(c.externalRoots) == 0 {
+		w.Error(srverr.ErrInvalid("external pools not enabled"))
+		return
+	}
+	dir, _, err := externals.ParsePattern(req.Pattern)
+	if err != nil {
+		w.Error(srverr.ErrInvalid(err))
+		return
+	}
+	if !underRoot(dir, c.externalRoots) {
+		w.Error(srverr.ErrForbidden("%s: external pattern is not under an external root", req.Pattern))
+		return
+	}
+	config, err := c.root.CreateExternal(r.Context(), req.Name, req.Pattern, req.Format)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.Respond(http.StatusOK, config)
+}
+
+// handleExternalDelete deletes an external pool.  It requires the admin role.
+func handleExternalDelete(c *Core, w *ResponseWriter, r *Request) {
+	if !c.isAdmin(r) {
+		w.Error(srverr.ErrForbidden("deleting an external pool requires the admin role"))
+		return
+	}
+	name, ok := r.StringFromPath(w, "external")
+	if !ok {
+		return
+	}
+	if err := c.root.RemoveExternal(r.Context(), name); err != nil {
+		w.Error(err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
 func handleBranchDelete(c *Core, w *ResponseWriter, r *Request) {
 	poolID, ok := r.PoolID(w, c.root)
 	if !ok {
@@ -453,7 +744,9 @@ func handleBranchLoad(c *Core, w *ResponseWriter, r *Request) {
 	}
 	opts := anyio.ReaderOpts{
 		Format: format,
+		Preset: r.URL.Query().Get("preset"),
 		CSV:    csvio.ReaderOpts{Delim: csvDelim},
+		PCAP:   pcapio.ReaderOpts{Filter: r.URL.Query().Get("pcap.filter")},
 		// Force validation of BSUP when loading into the lake.
 		BSUP: bsupio.ReaderOpts{Validate: true},
 	}
@@ -465,7 +758,17 @@ func handleBranchLoad(c *Core, w *ResponseWriter, r *Request) {
 	}
 	defer zrc.Close()
 	wr := &warningsReader{zrc, []string{}}
-	kommit, err := branch.Load(r.Context(), sctx, wr, message.Author, message.Body, message.Meta)
+	tr, err := lakeapi.TransformLoad(r.Context(), sctx, pool, message, wr)
+	if err != nil {
+		w.Error(srverr.ErrInvalid(err))
+		return
+	}
+	defer tr.Close()
+	load := branch.Load
+	if message.Dedup {
+		load = branch.LoadDedup
+	}
+	kommit, err := load(r.Context(), sctx, tr, message.Author, message.Body, message.Meta, lakeapi.Provenance(message))
 	if err != nil {
 		if errors.Is(err, commits.ErrEmptyTransaction) {
 			err = srverr.ErrInvalid("no records in request")
@@ -522,7 +825,7 @@ func handleCompact(c *Core, w *ResponseWriter, r *Request) {
 	if !ok {
 		return
 	}
-	commit, err := exec.Compact(r.Context(), c.root, pool, branch, req.ObjectIDs, writeVectors, message.Author, message.Body, message.Meta)
+	commit, err := exec.Compact(r.Context(), c.root, pool, branch, req.ObjectIDs, writeVectors, compiler.Summarize, message.Author, message.Body, message.Meta)
 	if err != nil {
 		w.Error(err)
 		return
@@ -560,7 +863,7 @@ func handleCompactNew(c *Core, w *ResponseWriter, r *Request) {
 	if !ok {
 		return
 	}
-	commit, err := exec.Compact(r.Context(), c.root, pool, branch, ids, writeVectors, message.Author, message.Body, message.Meta)
+	commit, err := exec.Compact(r.Context(), c.root, pool, branch, ids, writeVectors, compiler.Summarize, message.Author, message.Body, message.Meta)
 	if err != nil {
 		w.Error(err)
 		return
@@ -715,6 +1018,7 @@ func handleAuthIdentityGet(c *Core, w *ResponseWriter, r *Request) {
 	w.Respond(http.StatusOK, api.AuthIdentityResponse{
 		TenantID: string(ident.TenantID),
 		UserID:   string(ident.UserID),
+		Roles:    ident.Roles,
 	})
 }
 
@@ -3,21 +3,30 @@ package service_test
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"net/http"
 	"net/http/httptest"
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/brimdata/super/api"
 	"github.com/brimdata/super/api/client"
+	"github.com/brimdata/super/lake/pools"
 	"github.com/brimdata/super/pkg/nano"
 	"github.com/brimdata/super/pkg/storage"
 	"github.com/brimdata/super/runtime/exec"
 	"github.com/brimdata/super/service"
+	"github.com/brimdata/super/service/auth"
+	"github.com/brimdata/super/sup"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/segmentio/ksuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
 )
 
 func TestQuery(t *testing.T) {
@@ -55,6 +64,330 @@ func TestQueryAggregateReverse(t *testing.T) {
 	require.Equal(t, counts, "\n"+conn.TestQuery("from test | count() by every(1s)"))
 }
 
+func TestQueryPartitionCache(t *testing.T) {
+	_, conn := newCore(t)
+	poolID := conn.TestPoolPost(api.PoolPostRequest{Name: "test"})
+	conn.TestLoad(poolID, "main", strings.NewReader("{ts:1970-01-01T00:00:01Z}\n{ts:1970-01-01T00:00:03Z}"))
+	conn.TestLoad(poolID, "main", strings.NewReader("{ts:1970-01-01T00:00:02Z}"))
+	expected := `{ts:1970-01-01T00:00:03Z}
+{ts:1970-01-01T00:00:02Z}
+{ts:1970-01-01T00:00:01Z}
+`
+	// The second query reuses the partitions cached by the first.
+	require.Equal(t, expected, conn.TestQuery("from test"))
+	require.Equal(t, expected, conn.TestQuery("from test"))
+	// A new commit must not see the cached partitions of the old one.
+	conn.TestLoad(poolID, "main", strings.NewReader("{ts:1970-01-01T00:00:04Z}"))
+	require.Equal(t, "{ts:1970-01-01T00:00:04Z}\n"+expected, conn.TestQuery("from test"))
+}
+
+func TestQuerySession(t *testing.T) {
+	src := `
+{a:1}
+{a:2}
+{a:3}
+`
+	_, conn := newCore(t)
+	poolID := conn.TestPoolPost(api.PoolPostRequest{Name: "test"})
+	conn.TestLoad(poolID, "main", strings.NewReader(src))
+	ctx := context.Background()
+	session, err := conn.CreateSession(ctx, api.SessionPostRequest{
+		Pool:  "test",
+		Decls: "const threshold = 1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "", conn.TestQueryInSession(session.ID, "a > threshold | sum(a) | into temp(\"total\")"))
+	assert.Equal(t, "5\n", conn.TestQueryInSession(session.ID, "from temp(\"total\")"))
+	require.NoError(t, conn.DeleteSession(ctx, session.ID))
+	_, err = conn.QueryInSession(ctx, session.ID, "pass")
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestQuerySessionIdleTTL(t *testing.T) {
+	_, conn := newCoreWithConfig(t, service.Config{SessionIdleTTL: 50 * time.Millisecond})
+	ctx := context.Background()
+	session, err := conn.CreateSession(ctx, api.SessionPostRequest{})
+	require.NoError(t, err)
+	// Each use of the session would keep it alive, so wait rather than poll.
+	time.Sleep(500 * time.Millisecond)
+	_, err = conn.QueryInSession(ctx, session.ID, "pass")
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestSchedule(t *testing.T) {
+	_, conn := newCore(t)
+	ctx := context.Background()
+	srcID := conn.TestPoolPost(api.PoolPostRequest{Name: "src"})
+	dstID := conn.TestPoolPost(api.PoolPostRequest{Name: "dst"})
+	conn.TestLoad(srcID, "main", strings.NewReader("{a:1} {a:2} {a:3}"))
+	_, err := conn.CreateSchedule(ctx, api.SchedulePostRequest{
+		Name:  "bad",
+		Query: "from src",
+		Cron:  "* * *",
+		Pool:  "dst",
+	})
+	assert.ErrorContains(t, err, "expected 5 fields")
+	schedule, err := conn.CreateSchedule(ctx, api.SchedulePostRequest{
+		Name:  "copy",
+		Query: "from src | a > 1 | sum(a)",
+		Cron:  "@yearly",
+		Pool:  "dst",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "main", schedule.Branch)
+	assert.Equal(t, "scheduler", schedule.Author)
+	_, err = conn.CreateSchedule(ctx, api.SchedulePostRequest{Name: "copy", Cron: "@daily", Pool: "dst"})
+	assert.ErrorContains(t, err, `schedule "copy" already exists`)
+
+	ev, err := conn.SubscribeEvents(ctx)
+	require.NoError(t, err)
+	run, err := conn.RunSchedule(ctx, "copy")
+	require.NoError(t, err)
+	require.Empty(t, run.Error)
+	require.NotEqual(t, ksuid.Nil, run.Commit)
+	assert.Equal(t, "5\n", conn.TestQuery("from dst"))
+	kind, v, err := ev.Recv()
+	require.NoError(t, err)
+	if kind == "branch-commit" {
+		kind, v, err = ev.Recv()
+		require.NoError(t, err)
+	}
+	assert.Equal(t, "schedule-run", kind)
+	assert.Equal(t, &api.EventSchedule{Name: "copy", PoolID: dstID, Branch: "main", Commit: run.Commit}, v)
+
+	// A failed run is recorded in the history and publishes an alert.
+	require.NoError(t, conn.RemovePool(ctx, srcID))
+	run, err = conn.RunSchedule(ctx, "copy")
+	require.NoError(t, err)
+	assert.Contains(t, run.Error, "src: pool not found")
+	for kind != "schedule-failure" {
+		kind, v, err = ev.Recv()
+		require.NoError(t, err)
+	}
+	assert.Equal(t, run.Error, v.(*api.EventSchedule).Error)
+	require.NoError(t, ev.Close())
+	schedule, err = conn.ScheduleGet(ctx, "copy")
+	require.NoError(t, err)
+	require.Len(t, schedule.Runs, 2)
+	assert.NotZero(t, schedule.Next)
+
+	list, err := conn.ScheduleList(ctx)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.NoError(t, conn.DeleteSchedule(ctx, "copy"))
+	_, err = conn.ScheduleGet(ctx, "copy")
+	assert.ErrorContains(t, err, `schedule "copy" not found`)
+}
+
+func TestAlertRule(t *testing.T) {
+	_, conn := newCore(t)
+	ctx := context.Background()
+	poolID := conn.TestPoolPost(api.PoolPostRequest{Name: "logs"})
+	// Data committed before the rule is created is not evaluated.
+	conn.TestLoad(poolID, "main", strings.NewReader(`{s:"x"} {s:"x"}`))
+	webhook := make(chan string, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		webhook <- string(b)
+	}))
+	defer srv.Close()
+	_, err := conn.CreateAlertRule(ctx, api.AlertPostRequest{
+		Name:      "bad",
+		Query:     "count() by s",
+		Condition: "count > 1 | head",
+		Pool:      "logs",
+	})
+	assert.ErrorContains(t, err, "alert rule condition must be an expression")
+	rule, err := conn.CreateAlertRule(ctx, api.AlertPostRequest{
+		Name:      "dup",
+		Query:     "count() by s",
+		Condition: "count > 1",
+		Pool:      "logs",
+		Webhook:   srv.URL,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "main", rule.Branch)
+	_, err = conn.CreateAlertRule(ctx, api.AlertPostRequest{Name: "dup", Pool: "logs"})
+	assert.ErrorContains(t, err, `alert rule "dup" already exists`)
+
+	ev, err := conn.SubscribeEvents(ctx)
+	require.NoError(t, err)
+	defer ev.Close()
+	commit := conn.TestLoad(poolID, "main", strings.NewReader(`{s:"x"} {s:"y"} {s:"x"}`))
+	var kind string
+	var v any
+	for kind != "alert" {
+		kind, v, err = ev.Recv()
+		require.NoError(t, err)
+	}
+	alert := v.(*api.EventAlert)
+	assert.Equal(t, "dup", alert.Name)
+	assert.Equal(t, poolID, alert.PoolID)
+	assert.Equal(t, commit, alert.Commit)
+	require.Len(t, alert.Values, 1)
+	assert.Equal(t, `{s:"x",count:2(uint64)}`, sup.FormatValue(alert.Values[0]))
+	assert.Contains(t, <-webhook, `"values":[{"s":"x","count":2}]`)
+	rule, err = conn.AlertRuleGet(ctx, "dup")
+	require.NoError(t, err)
+	assert.Equal(t, commit, rule.Commit)
+	assert.EqualValues(t, 1, rule.Alerts)
+
+	list, err := conn.AlertRuleList(ctx)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.NoError(t, conn.DeleteAlertRule(ctx, "dup"))
+	_, err = conn.AlertRuleGet(ctx, "dup")
+	assert.ErrorContains(t, err, `alert rule "dup" not found`)
+}
+
+func TestAlertRuleMasks(t *testing.T) {
+	_, conn := newCore(t)
+	conn.TestPoolPost(api.PoolPostRequest{
+		Name:  "logs",
+		Masks: []pools.Mask{{Field: "ssn", Method: pools.MaskNull}},
+	})
+	_, err := conn.CreateAlertRule(context.Background(), api.AlertPostRequest{
+		Name:  "ssn",
+		Query: "ssn != null",
+		Pool:  "logs",
+	})
+	require.Equal(t, http.StatusForbidden, err.(*client.ErrorResponse).StatusCode)
+	assert.ErrorContains(t, err, `alert rules are not supported on pool "logs" since it has masks`)
+}
+
+func TestQueryLabels(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	c, conn := newCoreWithConfig(t, service.Config{
+		Logger:            zap.New(core),
+		QueryMetricLabels: []string{"team"},
+	})
+	conn.TestPoolPost(api.PoolPostRequest{Name: "test"})
+	labels := map[string]string{"team": "search", "job": "nightly"}
+	ctx := api.ContextWithQueryLabels(context.Background(), labels)
+	r, err := conn.Query(ctx, "from test")
+	require.NoError(t, err)
+	conn.readResponse(r)
+	require.Equal(t, 1.0, promCounterValue(c.Registry(), "queries_total"))
+	audit := logs.FilterMessage("Query completed").All()
+	require.Len(t, audit, 1)
+	assert.Equal(t, "from test", audit[0].ContextMap()["query"])
+	assert.Equal(t, labels, audit[0].ContextMap()["labels"])
+	access := logs.FilterMessage("Request completed").FilterField(zap.Any("query_labels", labels)).All()
+	assert.Len(t, access, 1)
+
+	_, err = conn.Query(api.ContextWithQueryLabels(ctx, map[string]string{"bad-name": ""}), "from test")
+	assert.ErrorContains(t, err, `query label name "bad-name" is not an identifier`)
+}
+
+func TestQueryStatusList(t *testing.T) {
+	_, conn := newCore(t)
+	ctx := context.Background()
+	poolID := conn.TestPoolPost(api.PoolPostRequest{Name: "test"})
+	conn.TestLoad(poolID, "main", strings.NewReader("{a:1} {a:2}"))
+	r, err := conn.Query(api.ContextWithQueryLabels(ctx, map[string]string{"team": "search"}), "from test")
+	require.NoError(t, err)
+	done := r.Header.Get(api.RequestIDHeader)
+	conn.readResponse(r)
+	// A query reading from a source that never finishes runs until it is
+	// canceled.
+	blocked := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, "{a:1}\n")
+		w.(http.Flusher).Flush()
+		close(blocked)
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+	r, err = conn.Query(ctx, fmt.Sprintf("from '%s/data.sup' format sup", srv.URL))
+	require.NoError(t, err)
+	defer r.Body.Close()
+	running := r.Header.Get(api.RequestIDHeader)
+	<-blocked
+	list, err := conn.QueryStatusList(ctx)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, done, list[0].RequestID)
+	assert.Equal(t, "from test", list[0].Query)
+	assert.Equal(t, map[string]string{"team": "search"}, list[0].Labels)
+	assert.EqualValues(t, 2, list[0].Progress.RecordsRead)
+	assert.NotZero(t, list[0].End)
+	assert.Equal(t, running, list[1].RequestID)
+	assert.Equal(t, string(auth.AnonymousUserID), list[1].UserID)
+	assert.Zero(t, list[1].End)
+	require.NoError(t, conn.CancelQuery(ctx, running))
+	_, err = io.ReadAll(r.Body)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		list, err := conn.QueryStatusList(ctx)
+		return err == nil && len(list) == 2 && list[1].End != 0
+	}, 10*time.Second, 10*time.Millisecond)
+	list, err = conn.QueryStatusList(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, list[1].Error, "context canceled")
+	err = conn.CancelQuery(ctx, "unknown")
+	assert.ErrorContains(t, err, "query not found")
+}
+
+func TestSlowQueryLog(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	_, conn := newCoreWithConfig(t, service.Config{
+		Logger:             zap.New(core),
+		SlowQueryThreshold: time.Nanosecond,
+	})
+	poolID := conn.TestPoolPost(api.PoolPostRequest{Name: "test"})
+	conn.TestLoad(poolID, "main", strings.NewReader("{x:1} {x:2} {x:3}"))
+	r, err := conn.Query(context.Background(), "from test | x > 1")
+	require.NoError(t, err)
+	conn.readResponse(r)
+	slow := logs.FilterMessage("Slow query").All()
+	require.Len(t, slow, 1)
+	fields := slow[0].ContextMap()
+	assert.Equal(t, "from test | x > 1", fields["query"])
+	assert.Contains(t, fields["plan"], "pool")
+	var values []uint64
+	for _, s := range fields["op_stats"].([]exec.OpStat) {
+		values = append(values, s.Values)
+	}
+	assert.Contains(t, values, uint64(2))
+}
+
+func TestReadOnly(t *testing.T) {
+	root := storage.MustParseURI(t.TempDir())
+	_, writer := newCoreWithConfig(t, service.Config{Root: root})
+	poolID := writer.TestPoolPost(api.PoolPostRequest{Name: "test"})
+	writer.TestLoad(poolID, "main", strings.NewReader("{x:1}"))
+
+	_, replica := newCoreWithConfig(t, service.Config{
+		Root:            root,
+		ReadOnly:        true,
+		RefreshInterval: time.Millisecond,
+	})
+	ev, err := replica.SubscribeEvents(context.Background())
+	require.NoError(t, err)
+	defer ev.Close()
+	assert.Equal(t, "{x:1}\n", replica.TestQuery("from test"))
+
+	_, err = replica.CreatePool(context.Background(), api.PoolPostRequest{Name: "other"})
+	require.Equal(t, 403, err.(*client.ErrorResponse).StatusCode)
+	_, err = replica.Load(context.Background(), poolID, "main", "", strings.NewReader("{x:2}"), api.CommitMessage{})
+	require.Equal(t, 403, err.(*client.ErrorResponse).StatusCode)
+	_, err = replica.Query(context.Background(), "values {x:2} | load test")
+	require.ErrorContains(t, err, "load: lake is read-only")
+	_, err = replica.CreateAlertRule(context.Background(), api.AlertPostRequest{Name: "alert"})
+	require.Equal(t, 403, err.(*client.ErrorResponse).StatusCode)
+	err = replica.DeleteAlertRule(context.Background(), "alert")
+	require.Equal(t, 403, err.(*client.ErrorResponse).StatusCode)
+	err = replica.DeleteSchedule(context.Background(), "schedule")
+	require.Equal(t, 403, err.(*client.ErrorResponse).StatusCode)
+
+	commit := writer.TestLoad(poolID, "main", strings.NewReader("{x:2}"))
+	kind, v, err := ev.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, "branch-commit", kind)
+	assert.Equal(t, &api.EventBranchCommit{PoolID: poolID, Branch: "main", CommitID: commit}, v)
+	assert.Equal(t, "{x:1}\n{x:2}\n", replica.TestQuery("from test | sort x"))
+}
+
 func TestPoolStats(t *testing.T) {
 	src := `
 {_path:"conn",ts:1970-01-01T00:00:01Z,uid:"CBrzd94qfowOqJwCHa"}
@@ -62,24 +395,31 @@ func TestPoolStats(t *testing.T) {
 `
 	_, conn := newCore(t)
 	poolID := conn.TestPoolPost(api.PoolPostRequest{Name: "test"})
-	conn.TestLoad(poolID, "main", strings.NewReader(src))
+	commit := conn.TestLoad(poolID, "main", strings.NewReader(src))
 
+	info := conn.TestPoolStats(poolID)
 	span := nano.Span{Ts: 1e9, Dur: 1e9 + 1}
-	expected := exec.PoolStats{
-		Span: &span,
-		Size: 84,
-	}
-	require.Equal(t, expected, conn.TestPoolStats(poolID))
+	require.Equal(t, &span, info.Span)
+	require.Equal(t, int64(84), info.Size)
+	require.Equal(t, int64(80), info.RawSize)
+	require.Equal(t, uint64(2), info.Count)
+	require.Equal(t, 1, info.Objects)
+	require.Equal(t, "1970-01-01T00:00:01Z", sup.String(info.Min))
+	require.Equal(t, "1970-01-01T00:00:02Z", sup.String(info.Max))
+	require.Len(t, info.Branches, 1)
+	require.Equal(t, "main", info.Branches[0].Name)
+	require.Equal(t, commit, info.Branches[0].Commit)
+	require.NotZero(t, info.Branches[0].Date)
 }
 
 func TestPoolStatsNoData(t *testing.T) {
 	_, conn := newCore(t)
 	poolID := conn.TestPoolPost(api.PoolPostRequest{Name: "test"})
 	info := conn.TestPoolStats(poolID)
-	expected := exec.PoolStats{
-		Size: 0,
-	}
-	require.Equal(t, expected, info)
+	require.Zero(t, info.Size)
+	require.Zero(t, info.Objects)
+	require.Nil(t, info.Span)
+	require.Equal(t, []exec.BranchCommit{{Name: "main"}}, info.Branches)
 }
 
 func TestPoolPostNameOnly(t *testing.T) {
@@ -130,6 +470,20 @@ func TestPoolPut(t *testing.T) {
 	assert.Equal(t, "new_name", info.Name)
 }
 
+func TestErrorCodes(t *testing.T) {
+	ctx := context.Background()
+	_, conn := newCore(t)
+	conn.TestPoolPost(api.PoolPostRequest{Name: "test"})
+	_, err := conn.Query(ctx, "from test | count(")
+	assert.Equal(t, api.ErrorCodeCompile, client.ErrorCode(err))
+	_, err = conn.CreatePool(ctx, api.PoolPostRequest{Name: "test"})
+	assert.Equal(t, api.ErrorCodeConflict, client.ErrorCode(err))
+	assert.ErrorIs(t, err, client.ErrPoolExists)
+	_, err = conn.PoolStats(ctx, ksuid.New())
+	assert.Equal(t, api.ErrorCodeNotFound, client.ErrorCode(err))
+	assert.ErrorIs(t, err, client.ErrPoolNotFound)
+}
+
 func TestPoolRemote(t *testing.T) {
 	ctx := context.Background()
 	_, conn := newCore(t)
@@ -3,6 +3,8 @@ package service
 import (
 	"context"
 	"net/http"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/brimdata/super/api"
@@ -62,18 +64,45 @@ func accessLogMiddleware(logger *zap.Logger) mux.MiddlewareFunc {
 			recorder := newRecordingResponseWriter(w)
 			w = recorder
 			detailedLogger.Debug("Request started")
+			fields := &accessLogFields{}
 			defer func(start time.Time) {
-				detailedLogger.Info("Request completed",
+				detailedLogger.Info("Request completed", append(fields.get(),
 					zap.Duration("elapsed", time.Since(start)),
 					zap.Int("response_content_length", recorder.contentLength),
 					zap.Int("status_code", recorder.statusCode),
-				)
+				)...)
 			}(time.Now())
-			next.ServeHTTP(w, r)
+			ctx := context.WithValue(r.Context(), accessLogFieldsKey{}, fields)
+			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
 }
 
+// accessLogFields holds the fields added by a handler to the access log entry
+// of its request.
+type accessLogFields struct {
+	mu     sync.Mutex
+	fields []zap.Field
+}
+
+type accessLogFieldsKey struct{}
+
+func (a *accessLogFields) get() []zap.Field {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return slices.Clone(a.fields)
+}
+
+// addAccessLogFields adds fields to the access log entry of the request
+// with context ctx.
+func addAccessLogFields(ctx context.Context, fields ...zap.Field) {
+	if a, ok := ctx.Value(accessLogFieldsKey{}).(*accessLogFields); ok {
+		a.mu.Lock()
+		a.fields = append(a.fields, fields...)
+		a.mu.Unlock()
+	}
+}
+
 func panicCatchMiddleware(logger *zap.Logger) mux.MiddlewareFunc {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
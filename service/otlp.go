@@ -0,0 +1,109 @@
+package service
+
+import (
+	"errors"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/api"
+	lakeapi "github.com/brimdata/super/lake/api"
+	"github.com/brimdata/super/lake/commits"
+	"github.com/brimdata/super/service/srverr"
+	"github.com/brimdata/super/zio/anyio"
+	"github.com/brimdata/super/zio/otlpio"
+)
+
+// OTLPLoader is the loader recorded in the provenance of the commits of
+// the OTLP receiver.
+const OTLPLoader = "otlp"
+
+// handleOTLPLogs and handleOTLPTraces implement the OTLP/HTTP receiver,
+// which OpenTelemetry collectors and SDKs export to at the default paths
+// /v1/logs and /v1/traces, by loading each export request in a commit to
+// the branch configured for its signal.
+
+func handleOTLPLogs(c *Core, w *ResponseWriter, r *Request) {
+	c.loadOTLP(w, r, otlpio.Logs, c.conf.OTLPLogs)
+}
+
+func handleOTLPTraces(c *Core, w *ResponseWriter, r *Request) {
+	c.loadOTLP(w, r, otlpio.Traces, c.conf.OTLPTraces)
+}
+
+func (c *Core) loadOTLP(w *ResponseWriter, r *Request, signal otlpio.Signal, target string) {
+	if target == "" {
+		w.Error(srverr.ErrNotFound("OTLP %s receiver not enabled", signal))
+		return
+	}
+	typ, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil && !errors.Is(err, mime.ErrInvalidMediaParameter) {
+		w.Error(srverr.ErrInvalid(err))
+		return
+	}
+	var encoding otlpio.Encoding
+	switch typ {
+	case "application/x-protobuf", "application/protobuf":
+		encoding = otlpio.Protobuf
+	case api.MediaTypeJSON:
+		encoding = otlpio.JSON
+	default:
+		w.Error(srverr.ErrInvalid("unsupported OTLP content type %q", typ))
+		return
+	}
+	poolName, branchName, ok := strings.Cut(target, "@")
+	if !ok {
+		branchName = "main"
+	}
+	branch, err := c.openBranch(r.Context(), poolName, branchName)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	// Exporters may compress requests with gzip.
+	body, err := anyio.GzipReader(r.Body)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	sctx := super.NewContext()
+	zr, err := otlpio.NewReader(sctx, body, signal, encoding)
+	if err != nil {
+		w.Error(srverr.ErrInvalid(err))
+		return
+	}
+	message := api.CommitMessage{
+		Author: OTLPLoader,
+		Body:   "otlp " + string(signal),
+		Loader: OTLPLoader,
+	}
+	tr, err := lakeapi.TransformLoad(r.Context(), sctx, branch.Pool(), message, zr)
+	if err != nil {
+		w.Error(srverr.ErrInvalid(err))
+		return
+	}
+	defer tr.Close()
+	kommit, err := branch.Load(r.Context(), sctx, tr, message.Author, message.Body, message.Meta, lakeapi.Provenance(message))
+	if err != nil && !errors.Is(err, commits.ErrEmptyTransaction) {
+		w.Error(err)
+		return
+	}
+	// Respond with an empty export response, which means that every log
+	// record or span was accepted.
+	if encoding == otlpio.JSON {
+		w.Header().Set("Content-Type", api.MediaTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		w.ResponseWriter.Write([]byte("{}"))
+	} else {
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		w.WriteHeader(http.StatusOK)
+	}
+	if err == nil {
+		c.publishEvent(w, "branch-commit", api.EventBranchCommit{
+			CommitID: kommit,
+			PoolID:   branch.Pool().ID,
+			Branch:   branch.Name,
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"time"
+
+	"github.com/brimdata/super/api"
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/exec"
+	"github.com/brimdata/super/service/auth"
+	"github.com/brimdata/super/zfmt"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+// queryMetrics attributes the queries run by the service to workloads by
+// the values of the query labels named by labelNames.
+type queryMetrics struct {
+	labelNames []string
+	queries    *prometheus.CounterVec
+	errors     *prometheus.CounterVec
+	duration   *prometheus.HistogramVec
+}
+
+func newQueryMetrics(registerer prometheus.Registerer, labelNames []string) *queryMetrics {
+	factory := promauto.With(registerer)
+	return &queryMetrics{
+		labelNames: labelNames,
+		queries: factory.NewCounterVec(prometheus.CounterOpts{
+			Name: "queries_total",
+			Help: "Number of queries run.",
+		}, labelNames),
+		errors: factory.NewCounterVec(prometheus.CounterOpts{
+			Name: "query_errors_total",
+			Help: "Number of queries that failed to compile or run.",
+		}, labelNames),
+		duration: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Name: "query_duration_seconds",
+			Help: "Time from the receipt of a query until its completion.",
+		}, labelNames),
+	}
+}
+
+func (q *queryMetrics) observe(labels map[string]string, elapsed time.Duration, failed bool) {
+	values := make([]string, 0, len(q.labelNames))
+	for _, name := range q.labelNames {
+		values = append(values, labels[name])
+	}
+	q.queries.WithLabelValues(values...).Inc()
+	if failed {
+		q.errors.WithLabelValues(values...).Inc()
+	}
+	q.duration.WithLabelValues(values...).Observe(elapsed.Seconds())
+}
+
+// recordQuery updates the query metrics and writes the audit log entry for
+// the completed query req, whose error, if any, is errMsg.  If the query ran
+// for at least the slow query threshold, recordQuery also writes its plan and
+// the statistics of flowgraph, which is nil if the query did not compile, to
+// the slow query log.
+func (c *Core) recordQuery(r *Request, req api.QueryRequest, start time.Time, flowgraph runtime.Query, errMsg string) {
+	elapsed := time.Since(start)
+	c.queryMetrics.observe(req.Labels, elapsed, errMsg != "")
+	ident := auth.IdentityFromContext(r.Context())
+	fields := []zap.Field{
+		zap.String("request_id", r.ID()),
+		zap.String("tenant_id", string(ident.TenantID)),
+		zap.String("user_id", string(ident.UserID)),
+		zap.String("query", req.Query),
+		zap.Any("labels", req.Labels),
+		zap.Duration("elapsed", elapsed),
+		zap.String("error", errMsg),
+	}
+	c.auditLogger.Info("Query completed", fields...)
+	if threshold := c.conf.SlowQueryThreshold; threshold <= 0 || elapsed < threshold {
+		return
+	}
+	if flowgraph != nil {
+		fields = append(fields, zap.Any("progress", flowgraph.Progress()))
+	}
+	if q, ok := flowgraph.(*exec.Query); ok {
+		fields = append(fields,
+			zap.String("plan", zfmt.DAG(q.Plan())),
+			zap.Any("op_stats", q.OpStats()),
+		)
+	}
+	c.slowQueryLogger.Warn("Slow query", fields...)
+}
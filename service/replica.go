@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/brimdata/super/api"
+	"github.com/segmentio/ksuid"
+	"go.uber.org/zap"
+)
+
+// DefaultRefreshInterval is the interval at which a read-only service
+// refreshes its view of the lake when Config.RefreshInterval is not set.
+const DefaultRefreshInterval = 10 * time.Second
+
+// poolState is the state of a pool as last observed by a read-only service.
+type poolState struct {
+	name     string
+	branches map[string]ksuid.KSUID
+}
+
+// refresh periodically reloads the pools and branches of the lake, which
+// other services may modify, so that a read-only service's cached snapshots
+// of them are current before queries need them.  refresh also publishes
+// an event for each change it observes relative to state.
+func (c *Core) refresh(ctx context.Context, interval time.Duration, state map[ksuid.KSUID]poolState) {
+	logger := c.logger.Named("refresh")
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+		next, err := c.loadPoolStates(ctx)
+		if err != nil {
+			logger.Warn("Error refreshing lake", zap.Error(err))
+			continue
+		}
+		c.publishChanges(logger, state, next)
+		state = next
+	}
+}
+
+func (c *Core) loadPoolStates(ctx context.Context) (map[ksuid.KSUID]poolState, error) {
+	configs, err := c.root.ListPools(ctx)
+	if err != nil {
+		return nil, err
+	}
+	states := make(map[ksuid.KSUID]poolState)
+	for _, config := range configs {
+		pool, err := c.root.OpenPool(ctx, config.ID)
+		if err != nil {
+			return nil, err
+		}
+		branches, err := pool.ListBranches(ctx)
+		if err != nil {
+			return nil, err
+		}
+		state := poolState{name: config.Name, branches: make(map[string]ksuid.KSUID)}
+		for _, branch := range branches {
+			// Load the snapshot of the branch's tip into the pool's
+			// cache.
+			if branch.Commit != ksuid.Nil {
+				if _, err := pool.Snapshot(ctx, branch.Commit); err != nil {
+					return nil, err
+				}
+			}
+			state.branches[branch.Name] = branch.Commit
+		}
+		states[config.ID] = state
+	}
+	return states, nil
+}
+
+func (c *Core) publishChanges(logger *zap.Logger, old, new map[ksuid.KSUID]poolState) {
+	for id, pool := range new {
+		oldPool, ok := old[id]
+		if !ok {
+			c.publish(logger, "pool-new", api.EventPool{PoolID: id})
+		} else if oldPool.name != pool.name {
+			c.publish(logger, "pool-update", api.EventPool{PoolID: id})
+		}
+		for name, commit := range pool.branches {
+			oldCommit, ok := oldPool.branches[name]
+			if !ok {
+				c.publish(logger, "branch-update", api.EventBranch{PoolID: id, Branch: name})
+			} else if oldCommit != commit {
+				c.publish(logger, "branch-commit", api.EventBranchCommit{
+					CommitID: commit,
+					PoolID:   id,
+					Branch:   name,
+				})
+			}
+		}
+		for name := range oldPool.branches {
+			if _, ok := pool.branches[name]; !ok {
+				c.publish(logger, "branch-delete", api.EventBranch{PoolID: id, Branch: name})
+			}
+		}
+	}
+	for id := range old {
+		if _, ok := new[id]; !ok {
+			c.publish(logger, "pool-delete", api.EventPool{PoolID: id})
+		}
+	}
+}
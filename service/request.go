@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"io/fs"
+	"net"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -17,6 +18,7 @@ import (
 	"github.com/brimdata/super/lake"
 	"github.com/brimdata/super/lake/branches"
 	"github.com/brimdata/super/lake/commits"
+	"github.com/brimdata/super/lake/externals"
 	"github.com/brimdata/super/lake/journal"
 	"github.com/brimdata/super/lake/pools"
 	"github.com/brimdata/super/lakeparse"
@@ -155,6 +157,19 @@ func (r *Request) JournalIDFromQuery(w *ResponseWriter, param string) (journal.I
 	return journal.ID(id), true
 }
 
+func (r *Request) KSUIDFromQuery(w *ResponseWriter, param string) (ksuid.KSUID, bool) {
+	s := r.URL.Query().Get(param)
+	if s == "" {
+		return ksuid.Nil, true
+	}
+	id, err := lakeparse.ParseID(s)
+	if err != nil {
+		w.Error(srverr.ErrInvalid("invalid query param %q: %w", param, err))
+		return ksuid.Nil, false
+	}
+	return id, true
+}
+
 func (r *Request) BoolFromQuery(w *ResponseWriter, param string) (bool, bool) {
 	s := r.URL.Query().Get(param)
 	if s == "" {
@@ -298,6 +313,10 @@ func (w *ResponseWriter) Marshal(body any) bool {
 	return true
 }
 
+// statusClientClosedRequest is the nonstandard HTTP status, originated by
+// nginx, for a request canceled before the service could respond.
+const statusClientClosedRequest = 499
+
 func errorResponse(e error) (status int, ae *api.Error) {
 	status = http.StatusInternalServerError
 	ae = &api.Error{Type: "Error"}
@@ -312,29 +331,61 @@ func errorResponse(e error) (status int, ae *api.Error) {
 	}
 
 	switch {
-	case errors.Is(e, branches.ErrExists) || errors.Is(e, pools.ErrExists):
+	case errors.Is(e, context.Canceled) || errors.Is(e, context.DeadlineExceeded):
+		ze.Kind = srverr.Canceled
+	case errors.Is(e, branches.ErrExists) || errors.Is(e, pools.ErrExists) ||
+		errors.Is(e, externals.ErrExists) || errors.Is(e, commits.ErrWriteConflict):
 		ze.Kind = srverr.Conflict
 	case errors.Is(e, branches.ErrNotFound) || errors.Is(e, commits.ErrNotFound) ||
-		errors.Is(e, pools.ErrNotFound) || errors.Is(e, fs.ErrNotExist):
+		errors.Is(e, pools.ErrNotFound) || errors.Is(e, externals.ErrNotFound) ||
+		errors.Is(e, fs.ErrNotExist):
 		ze.Kind = srverr.NotFound
+	case isNetError(e):
+		ze.Kind = srverr.Unavailable
 	}
 
+	ae.Code = api.ErrorCodeInternal
 	switch ze.Kind {
+	case srverr.Canceled:
+		status = statusClientClosedRequest
+		ae.Code = api.ErrorCodeCanceled
 	case srverr.Invalid:
 		status = http.StatusBadRequest
+		ae.Code = api.ErrorCodeInvalid
 	case srverr.NotFound:
 		status = http.StatusNotFound
+		ae.Code = api.ErrorCodeNotFound
 	case srverr.Exists:
 		status = http.StatusBadRequest
+		ae.Code = api.ErrorCodeConflict
 	case srverr.Conflict:
 		status = http.StatusConflict
+		ae.Code = api.ErrorCodeConflict
 	case srverr.NoCredentials:
 		status = http.StatusUnauthorized
+		ae.Code = api.ErrorCodeUnauthenticated
 	case srverr.Forbidden:
 		status = http.StatusForbidden
+		ae.Code = api.ErrorCodeForbidden
+	case srverr.Quota:
+		status = http.StatusTooManyRequests
+		ae.Code = api.ErrorCodeQuota
+	case srverr.Unavailable:
+		status = http.StatusServiceUnavailable
+		ae.Code = api.ErrorCodeStorageUnavailable
+	}
+	if len(ae.CompilationErrors) > 0 {
+		ae.Code = api.ErrorCodeCompile
 	}
 
 	ae.Kind = ze.Kind.String()
 	ae.Message = ze.Message()
 	return
 }
+
+// isNetError returns true if err is a network error, e.g., a failure to
+// reach cloud storage.
+func isNetError(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
@@ -0,0 +1,251 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/api"
+	"github.com/brimdata/super/compiler/parser"
+	"github.com/brimdata/super/lake"
+	"github.com/brimdata/super/lake/commits"
+	"github.com/brimdata/super/pkg/cron"
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/service/srverr"
+	"github.com/brimdata/super/zbuf"
+	"github.com/segmentio/ksuid"
+	"go.uber.org/zap"
+)
+
+// MaxScheduleRuns is the number of most recent runs kept in the history of
+// a schedule.
+const MaxScheduleRuns = 20
+
+// A schedule runs a query at the times given by a cron expression and
+// commits the query's results to a pool.  A run that comes due while the
+// previous run of the schedule is still in progress is skipped so that
+// runs never overlap.
+type schedule struct {
+	cron   *cron.Schedule
+	cancel context.CancelFunc
+
+	mu      sync.Mutex
+	meta    api.Schedule
+	running bool
+}
+
+func (c *Core) newSchedule(ctx context.Context, req api.SchedulePostRequest) (*schedule, error) {
+	if req.Name == "" {
+		return nil, srverr.ErrInvalid("schedule name must be specified")
+	}
+	if req.Pool == "" {
+		return nil, srverr.ErrInvalid("schedule pool must be specified")
+	}
+	if req.Branch == "" {
+		req.Branch = "main"
+	}
+	if req.Author == "" {
+		req.Author = "scheduler"
+	}
+	cronSchedule, err := cron.Parse(req.Cron)
+	if err != nil {
+		return nil, srverr.ErrInvalid(err)
+	}
+	if _, err := parser.ParseQuery(req.Query); err != nil {
+		return nil, srverr.ErrInvalid(err)
+	}
+	if _, err := c.openBranch(ctx, req.Pool, req.Branch); err != nil {
+		return nil, err
+	}
+	s := &schedule{
+		cron: cronSchedule,
+		meta: api.Schedule{
+			Name:   req.Name,
+			Query:  req.Query,
+			Cron:   req.Cron,
+			Pool:   req.Pool,
+			Branch: req.Branch,
+			Author: req.Author,
+		},
+	}
+	c.schedulesMu.Lock()
+	defer c.schedulesMu.Unlock()
+	if _, ok := c.schedules[req.Name]; ok {
+		return nil, srverr.ErrConflict("schedule %q already exists", req.Name)
+	}
+	var loopCtx context.Context
+	loopCtx, s.cancel = context.WithCancel(c.ctx)
+	c.schedules[req.Name] = s
+	go c.scheduleLoop(loopCtx, s)
+	return s, nil
+}
+
+func (c *Core) lookupSchedule(name string) (*schedule, error) {
+	c.schedulesMu.Lock()
+	defer c.schedulesMu.Unlock()
+	s, ok := c.schedules[name]
+	if !ok {
+		return nil, srverr.ErrNotFound("schedule %q not found", name)
+	}
+	return s, nil
+}
+
+func (c *Core) listSchedules() []api.Schedule {
+	c.schedulesMu.Lock()
+	defer c.schedulesMu.Unlock()
+	list := make([]api.Schedule, 0, len(c.schedules))
+	for _, s := range c.schedules {
+		list = append(list, s.Meta())
+	}
+	slices.SortFunc(list, func(a, b api.Schedule) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return list
+}
+
+// deleteSchedule removes a schedule so it is not run again.  A run in
+// progress is canceled.
+func (c *Core) deleteSchedule(name string) error {
+	c.schedulesMu.Lock()
+	s, ok := c.schedules[name]
+	delete(c.schedules, name)
+	c.schedulesMu.Unlock()
+	if !ok {
+		return srverr.ErrNotFound("schedule %q not found", name)
+	}
+	s.cancel()
+	return nil
+}
+
+// Meta returns a copy of the schedule's description and run history.
+func (s *schedule) Meta() api.Schedule {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	meta := s.meta
+	meta.Runs = slices.Clone(s.meta.Runs)
+	return meta
+}
+
+func (c *Core) scheduleLoop(ctx context.Context, s *schedule) {
+	for {
+		next := s.cron.Next(time.Now())
+		if next.IsZero() {
+			return
+		}
+		s.mu.Lock()
+		s.meta.Next = nano.TimeToTs(next)
+		s.mu.Unlock()
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		}
+		// Run in the background so a run that outlasts the interval
+		// is seen, and skipped, by the next tick.
+		go c.runSchedule(ctx, s)
+	}
+}
+
+// runSchedule runs the query of s and commits its results, records the run
+// in the history of s, and publishes an event describing the outcome.
+func (c *Core) runSchedule(ctx context.Context, s *schedule) api.ScheduleRun {
+	logger := c.logger.With(zap.String("schedule", s.meta.Name))
+	run := api.ScheduleRun{Start: nano.Now()}
+	s.mu.Lock()
+	if s.running {
+		run.End = run.Start
+		run.Skipped = true
+		s.record(run)
+		s.mu.Unlock()
+		logger.Warn("Scheduled query skipped because the previous run has not finished")
+		return run
+	}
+	s.running = true
+	meta := s.meta
+	s.mu.Unlock()
+	poolID, err := c.execSchedule(ctx, meta, &run)
+	run.End = nano.Now()
+	if err != nil {
+		run.Error = err.Error()
+	}
+	s.mu.Lock()
+	s.running = false
+	s.record(run)
+	s.mu.Unlock()
+	event := api.EventSchedule{
+		Name:   meta.Name,
+		PoolID: poolID,
+		Branch: meta.Branch,
+		Commit: run.Commit,
+		Error:  run.Error,
+	}
+	if err != nil {
+		logger.Error("Scheduled query failed", zap.Error(err))
+		c.publish(logger, "schedule-failure", event)
+		return run
+	}
+	logger.Info("Scheduled query completed", zap.Stringer("commit", run.Commit))
+	if run.Commit != ksuid.Nil {
+		c.publish(logger, "branch-commit", api.EventBranchCommit{
+			CommitID: run.Commit,
+			PoolID:   poolID,
+			Branch:   meta.Branch,
+		})
+	}
+	c.publish(logger, "schedule-run", event)
+	return run
+}
+
+func (c *Core) openBranch(ctx context.Context, poolName, branchName string) (*lake.Branch, error) {
+	id, err := c.root.PoolID(ctx, poolName)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := c.root.OpenPool(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return pool.OpenBranchByName(ctx, branchName)
+}
+
+func (s *schedule) record(run api.ScheduleRun) {
+	s.meta.Runs = append(s.meta.Runs, run)
+	if n := len(s.meta.Runs); n > MaxScheduleRuns {
+		s.meta.Runs = slices.Delete(s.meta.Runs, 0, n-MaxScheduleRuns)
+	}
+}
+
+// execSchedule runs the query of meta and commits all of its results to
+// the schedule's branch in a single commit, which it stores in run.
+func (c *Core) execSchedule(ctx context.Context, meta api.Schedule, run *api.ScheduleRun) (ksuid.KSUID, error) {
+	branch, err := c.openBranch(ctx, meta.Pool, meta.Branch)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	poolID := branch.Pool().ID
+	ast, err := parser.ParseQuery(meta.Query)
+	if err != nil {
+		return poolID, err
+	}
+	sctx := super.NewContext()
+	query, err := runtime.CompileLakeQuery(ctx, sctx, c.compiler, ast)
+	if err != nil {
+		return poolID, err
+	}
+	defer query.Close()
+	message := "scheduled query " + meta.Name
+	commit, err := branch.Load(ctx, sctx, zbuf.PullerReader(query), meta.Author, message, "", nil)
+	if errors.Is(err, commits.ErrEmptyTransaction) {
+		// A run with no results commits nothing.
+		return poolID, nil
+	}
+	run.Commit = commit
+	return poolID, err
+}
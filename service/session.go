@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/brimdata/super/api"
+	"github.com/brimdata/super/compiler"
+	"github.com/brimdata/super/compiler/ast"
+	"github.com/brimdata/super/compiler/parser"
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/sam/op/temp"
+	"github.com/brimdata/super/service/auth"
+	"github.com/brimdata/super/service/srverr"
+	"github.com/segmentio/ksuid"
+	"go.uber.org/zap"
+)
+
+// DefaultSessionIdleTTL is the time after which a session in which no query
+// has run is deleted when Config.SessionIdleTTL is not set.
+const DefaultSessionIdleTTL = time.Hour
+
+// A session holds state shared by the queries a client runs in it: the
+// declarations placed in scope of each query, the pool scanned by queries
+// without a data source, and the temporary tables written by the queries.
+// A session may be used only by the identity that created it.
+type session struct {
+	api.Session
+	compiler runtime.Compiler
+	decls    []ast.Decl
+	ident    auth.Identity
+	temps    *temp.Tables
+
+	mu       sync.Mutex
+	running  int // number of queries running in the session
+	lastUsed time.Time
+}
+
+func (c *Core) newSession(ctx context.Context, req api.SessionPostRequest) (*session, error) {
+	var decls []ast.Decl
+	if req.Decls != "" {
+		var err error
+		if decls, err = parser.ParseDecls(req.Decls); err != nil {
+			return nil, srverr.ErrInvalid(err)
+		}
+	}
+	if req.Pool != "" {
+		if req.Branch == "" {
+			req.Branch = "main"
+		}
+		id, err := c.root.PoolID(ctx, req.Pool)
+		if err != nil {
+			return nil, err
+		}
+		pool, err := c.root.OpenPool(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := pool.LookupBranchByName(ctx, req.Branch); err != nil {
+			return nil, err
+		}
+	} else if req.Branch != "" {
+		return nil, srverr.ErrInvalid("session branch requires a pool")
+	}
+	temps := temp.NewTables()
+	env := c.newEnvironment()
+	env.SetTempTables(temps)
+	env.SetDefaultPool(req.Pool, req.Branch)
+	s := &session{
+		Session: api.Session{
+			ID:     ksuid.New(),
+			Pool:   req.Pool,
+			Branch: req.Branch,
+			Decls:  req.Decls,
+		},
+		compiler: compiler.NewCompilerWithEnvironment(env),
+		decls:    decls,
+		ident:    auth.IdentityFromContext(ctx),
+		temps:    temps,
+		lastUsed: time.Now(),
+	}
+	c.sessionsMu.Lock()
+	c.sessions[s.ID] = s
+	c.sessionsMu.Unlock()
+	return s, nil
+}
+
+// sessionOf returns the session with the given ID if it belongs to the
+// identity of ctx.  The session of another identity is not found so that
+// its existence is not revealed.  c.sessionsMu must be held.
+func (c *Core) sessionOf(ctx context.Context, id ksuid.KSUID) (*session, error) {
+	ident := auth.IdentityFromContext(ctx)
+	s, ok := c.sessions[id]
+	if !ok || s.ident.TenantID != ident.TenantID || s.ident.UserID != ident.UserID {
+		return nil, srverr.ErrNotFound("session %s not found", id)
+	}
+	return s, nil
+}
+
+func (c *Core) lookupSession(ctx context.Context, id ksuid.KSUID) (*session, error) {
+	c.sessionsMu.Lock()
+	defer c.sessionsMu.Unlock()
+	s, err := c.sessionOf(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	s.lastUsed = time.Now()
+	s.mu.Unlock()
+	return s, nil
+}
+
+// acquireSession is like lookupSession but also marks the session as in use
+// by a query, which keeps it from expiring, until release is called.
+func (c *Core) acquireSession(ctx context.Context, id ksuid.KSUID) (*session, error) {
+	c.sessionsMu.Lock()
+	defer c.sessionsMu.Unlock()
+	s, err := c.sessionOf(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	s.running++
+	s.mu.Unlock()
+	return s, nil
+}
+
+func (s *session) release() {
+	s.mu.Lock()
+	s.running--
+	s.lastUsed = time.Now()
+	s.mu.Unlock()
+}
+
+func (c *Core) deleteSession(ctx context.Context, id ksuid.KSUID) error {
+	c.sessionsMu.Lock()
+	s, err := c.sessionOf(ctx, id)
+	if err == nil {
+		delete(c.sessions, id)
+	}
+	c.sessionsMu.Unlock()
+	if err != nil {
+		return err
+	}
+	return s.temps.Close()
+}
+
+// expireSessions deletes, at intervals of ttl, the sessions in which no
+// query has run for ttl.
+func (c *Core) expireSessions(ctx context.Context, ttl time.Duration) {
+	logger := c.logger.Named("session")
+	ticker := time.NewTicker(ttl)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+		var expired []*session
+		c.sessionsMu.Lock()
+		for id, s := range c.sessions {
+			s.mu.Lock()
+			if s.running == 0 && time.Since(s.lastUsed) >= ttl {
+				expired = append(expired, s)
+				delete(c.sessions, id)
+			}
+			s.mu.Unlock()
+		}
+		c.sessionsMu.Unlock()
+		for _, s := range expired {
+			if err := s.temps.Close(); err != nil {
+				logger.Warn("Error closing expired session", zap.Stringer("session", s.ID), zap.Error(err))
+			}
+		}
+	}
+}
@@ -17,16 +17,21 @@ type Kind int
 
 const (
 	Other Kind = iota
+	Canceled
 	Conflict
 	Exists
 	Forbidden
 	Invalid
 	NoCredentials
 	NotFound
+	Quota
+	Unavailable
 )
 
 func (k Kind) String() string {
 	switch k {
+	case Canceled:
+		return "operation canceled"
 	case Conflict:
 		return "conflict with pending operation"
 	case Exists:
@@ -39,6 +44,10 @@ func (k Kind) String() string {
 		return "missing authentication credentials"
 	case NotFound:
 		return "item does not exist"
+	case Quota:
+		return "quota exceeded"
+	case Unavailable:
+		return "storage unavailable"
 	case Other:
 		return "other error"
 	}
@@ -138,6 +147,7 @@ func IsKind(err error, k Kind) bool {
 	return errors.As(err, &zerr) && zerr.Kind == k
 }
 
+func IsCanceled(err error) bool      { return IsKind(err, Canceled) }
 func IsConflict(err error) bool      { return IsKind(err, Conflict) }
 func IsExists(err error) bool        { return IsKind(err, Exists) }
 func IsForbidden(err error) bool     { return IsKind(err, Forbidden) }
@@ -145,7 +155,10 @@ func IsInvalid(err error) bool       { return IsKind(err, Invalid) }
 func IsNoCredentials(err error) bool { return IsKind(err, NoCredentials) }
 func IsNotFound(err error) bool      { return IsKind(err, NotFound) }
 func IsOther(err error) bool         { return IsKind(err, Other) }
+func IsQuota(err error) bool         { return IsKind(err, Quota) }
+func IsUnavailable(err error) bool   { return IsKind(err, Unavailable) }
 
+func ErrCanceled(args ...any) error      { return errKind(Canceled, args) }
 func ErrConflict(args ...any) error      { return errKind(Conflict, args) }
 func ErrExists(args ...any) error        { return errKind(Exists, args) }
 func ErrForbidden(args ...any) error     { return errKind(Forbidden, args) }
@@ -153,6 +166,8 @@ func ErrInvalid(args ...any) error       { return errKind(Invalid, args) }
 func ErrNoCredentials(args ...any) error { return errKind(NoCredentials, args) }
 func ErrNotFound(args ...any) error      { return errKind(NotFound, args) }
 func ErrOther(args ...any) error         { return errKind(Other, args) }
+func ErrQuota(args ...any) error         { return errKind(Quota, args) }
+func ErrUnavailable(args ...any) error   { return errKind(Unavailable, args) }
 
 func errKind(k Kind, args []any) error {
 	args = append([]any{k}, args...)
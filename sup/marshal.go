@@ -101,6 +101,13 @@ type BSUPMarshaler interface {
 	MarshalBSUP(*MarshalBSUPContext) (super.Type, error)
 }
 
+// ValueMarshaler is implemented by types that marshal as some other Go value,
+// in the manner of json.Marshaler.  The marshaler encodes the value returned
+// by MarshalSuper, which may be a super.Value, in place of the receiver.
+type ValueMarshaler interface {
+	MarshalSuper() (any, error)
+}
+
 func MarshalBSUP(v any) (super.Value, error) {
 	return NewBSUPMarshaler().Marshal(v)
 }
@@ -129,8 +136,12 @@ func (m *MarshalBSUPContext) MarshalValue(v any) (super.Type, error) {
 }
 
 func (m *MarshalBSUPContext) Marshal(v any) (super.Value, error) {
+	return m.marshal(reflect.ValueOf(v))
+}
+
+func (m *MarshalBSUPContext) marshal(v reflect.Value) (super.Value, error) {
 	m.Builder.Reset()
-	typ, err := m.encodeValue(reflect.ValueOf(v))
+	typ, err := m.encodeValue(v)
 	if err != nil {
 		return super.Null, err
 	}
@@ -168,6 +179,53 @@ func (m *MarshalBSUPContext) MarshalCustom(names []string, vals []any) (super.Va
 	return super.NewValue(recType, m.Builder.Bytes()), nil
 }
 
+// MarshalStream marshals each element of v as a separate value and passes it
+// to emit, e.g., the Write method of a zio.Writer, so a large collection is
+// never built as a single value in memory.  The value passed to emit is valid
+// only until emit returns.
+// v must be a slice, an array, a map, or a function that is an iter.Seq or an
+// iter.Seq2.  An element of a map or an iter.Seq2 is a record with fields
+// "key" and "value".
+func (m *MarshalBSUPContext) MarshalStream(emit func(super.Value) error, v any) error {
+	rv := reflect.ValueOf(v)
+	switch kind := rv.Kind(); {
+	case kind == reflect.Array || kind == reflect.Slice:
+		for _, elem := range rv.Seq2() {
+			if err := m.marshalTo(emit, elem); err != nil {
+				return err
+			}
+		}
+	case kind == reflect.Func && rv.Type().CanSeq():
+		for elem := range rv.Seq() {
+			if err := m.marshalTo(emit, elem); err != nil {
+				return err
+			}
+		}
+	case kind == reflect.Map || kind == reflect.Func && rv.Type().CanSeq2():
+		names := []string{"key", "value"}
+		for key, val := range rv.Seq2() {
+			rec, err := m.MarshalCustom(names, []any{key.Interface(), val.Interface()})
+			if err != nil {
+				return err
+			}
+			if err := emit(rec); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("cannot stream value of type %T", v)
+	}
+	return nil
+}
+
+func (m *MarshalBSUPContext) marshalTo(emit func(super.Value) error, v reflect.Value) error {
+	val, err := m.marshal(v)
+	if err != nil {
+		return err
+	}
+	return emit(val)
+}
+
 const (
 	tagName = "super"
 	tagSep  = ","
@@ -254,6 +312,8 @@ func (m *MarshalBSUPContext) NamedBindings(bindings []Binding) error {
 
 var nanoTsType = reflect.TypeOf(nano.Ts(0))
 var superValueType = reflect.TypeOf(super.Value{})
+var bsupMarshalerType = reflect.TypeFor[BSUPMarshaler]()
+var valueMarshalerType = reflect.TypeFor[ValueMarshaler]()
 
 func (m *MarshalBSUPContext) encodeValue(v reflect.Value) (super.Type, error) {
 	typ, err := m.encodeAny(v)
@@ -276,9 +336,21 @@ func (m *MarshalBSUPContext) encodeAny(v reflect.Value) (super.Type, error) {
 		m.Builder.Append(nil)
 		return super.TypeNull, nil
 	}
+	if v.Kind() != reflect.Pointer && v.CanAddr() {
+		// Find marshaler methods with pointer receivers.
+		if p := v.Addr(); p.Type().Implements(bsupMarshalerType) || p.Type().Implements(valueMarshalerType) {
+			v = p
+		}
+	}
 	switch v := v.Interface().(type) {
 	case BSUPMarshaler:
 		return v.MarshalBSUP(m)
+	case ValueMarshaler:
+		x, err := v.MarshalSuper()
+		if err != nil {
+			return nil, err
+		}
+		return m.encodeValue(reflect.ValueOf(x))
 	case float16.Float16:
 		m.Builder.Append(super.EncodeFloat16(v.Float32()))
 		return super.TypeFloat16, nil
@@ -904,6 +976,11 @@ func (u *UnmarshalBSUPContext) decodeNetIP(val super.Value, v reflect.Value) err
 }
 
 func (u *UnmarshalBSUPContext) decodeMap(val super.Value, mapVal reflect.Value) error {
+	if recType, ok := super.TypeUnder(val.Type()).(*super.TypeRecord); ok && !val.IsNull() && mapVal.Type().Key().Kind() == reflect.String {
+		// A record, e.g., one read from a JSON object, decodes into a
+		// map keyed by field name.
+		return u.decodeRecordMap(recType, val, mapVal)
+	}
 	typ, ok := super.TypeUnder(val.Type()).(*super.TypeMap)
 	if !ok {
 		return errors.New("not a map")
@@ -932,6 +1009,25 @@ func (u *UnmarshalBSUPContext) decodeMap(val super.Value, mapVal reflect.Value)
 	return nil
 }
 
+func (u *UnmarshalBSUPContext) decodeRecordMap(recType *super.TypeRecord, val super.Value, mapVal reflect.Value) error {
+	if mapVal.IsNil() {
+		mapVal.Set(reflect.MakeMap(mapVal.Type()))
+	}
+	valType := mapVal.Type().Elem()
+	for i, it := 0, val.Iter(); !it.Done(); i++ {
+		if i >= len(recType.Fields) {
+			return errors.New("malformed Zed value")
+		}
+		field := recType.Fields[i]
+		elem := reflect.New(valType).Elem()
+		if err := u.decodeAny(super.NewValue(field.Type, it.Next()), elem); err != nil {
+			return err
+		}
+		mapVal.SetMapIndex(reflect.ValueOf(field.Name).Convert(mapVal.Type().Key()), elem)
+	}
+	return nil
+}
+
 func (u *UnmarshalBSUPContext) decodeRecord(val super.Value, sval reflect.Value) error {
 	if union, ok := val.Type().(*super.TypeUnion); ok {
 		typ, bytes := union.Untag(val.Bytes())
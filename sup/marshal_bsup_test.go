@@ -5,6 +5,7 @@ import (
 	"errors"
 	"math"
 	"net/netip"
+	"slices"
 	"strings"
 	"testing"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/brimdata/super/sup"
 	"github.com/brimdata/super/zio"
 	"github.com/brimdata/super/zio/bsupio"
+	"github.com/brimdata/super/zio/supio"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/x448/float16"
@@ -332,6 +334,51 @@ func TestMarshalInterface(t *testing.T) {
 	assert.Equal(t, "m2", string(r2.M2))
 }
 
+type testValueMarshaler struct {
+	s string
+}
+
+func (m *testValueMarshaler) MarshalSuper() (any, error) {
+	if m.s == "" {
+		return nil, errors.New("empty")
+	}
+	return strings.ToUpper(m.s), nil
+}
+
+func TestMarshalValueMarshaler(t *testing.T) {
+	type rectype struct {
+		M1 *testValueMarshaler
+		M2 testValueMarshaler
+	}
+	r := &rectype{M1: &testValueMarshaler{"m1"}, M2: testValueMarshaler{"m2"}}
+	rec, err := sup.NewBSUPMarshaler().Marshal(r)
+	require.NoError(t, err)
+	assert.Equal(t, `{M1:"M1",M2:"M2"}`, sup.FormatValue(rec))
+
+	_, err = sup.NewBSUPMarshaler().Marshal(&testValueMarshaler{})
+	require.EqualError(t, err, "empty")
+}
+
+func TestMarshalStream(t *testing.T) {
+	test := func(t *testing.T, v any, expected string) {
+		var buf bytes.Buffer
+		w := supio.NewWriter(zio.NopCloser(&buf), supio.WriterOpts{})
+		require.NoError(t, sup.NewBSUPMarshaler().MarshalStream(w.Write, v))
+		require.NoError(t, w.Close())
+		assert.Equal(t, expected, buf.String())
+	}
+	type S struct {
+		A int
+	}
+	test(t, []S{{1}, {2}}, "{A:1}\n{A:2}\n")
+	test(t, [2]any{"a", 1}, "\"a\"\n1\n")
+	test(t, map[string]int{"a": 1}, "{key:\"a\",value:1}\n")
+	test(t, slices.Values([]int{1, 2}), "1\n2\n")
+	test(t, slices.All([]string{"a"}), "{key:0,value:\"a\"}\n")
+	err := sup.NewBSUPMarshaler().MarshalStream(nil, 1)
+	require.EqualError(t, err, "cannot stream value of type int")
+}
+
 func TestMarshalArray(t *testing.T) {
 	type rectype struct {
 		A1 [2]int8
@@ -457,6 +457,13 @@ func TestZedType(t *testing.T) {
 	assert.Equal(t, super.TypeInt64, typ)
 }
 
+func TestRecordIntoMap(t *testing.T) {
+	var m map[string]string
+	err := sup.Unmarshal(`{team:"search",job:"nightly"}`, &m)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "search", "job": "nightly"}, m)
+}
+
 func TestSimpleUnionUnmarshal(t *testing.T) {
 	t.Skip("see issue #4012")
 	var i int64
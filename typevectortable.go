@@ -2,8 +2,11 @@ package super
 
 import "slices"
 
+// A TypeVectorTable maps each distinct vector of types to a small integer,
+// assigned in order of first appearance.
 type TypeVectorTable struct {
 	types []typeVector
+	last  int
 }
 
 func NewTypeVectorTable() *TypeVectorTable {
@@ -11,23 +14,35 @@ func NewTypeVectorTable() *TypeVectorTable {
 }
 
 func (t *TypeVectorTable) Lookup(types []Type) int {
+	// Check the most recent result first since consecutive lookups
+	// usually have the same types.
+	if t.last < len(t.types) && t.types[t.last].equal(types) {
+		return t.last
+	}
 	for k, typ := range t.types {
 		if typ.equal(types) {
+			t.last = k
 			return k
 		}
 	}
 	k := len(t.types)
+	t.last = k
 	t.types = append(t.types, newTypeVector(types))
 	return k
 }
 
 func (t *TypeVectorTable) LookupByValues(vals []Value) int {
+	if t.last < len(t.types) && t.types[t.last].equalToValues(vals) {
+		return t.last
+	}
 	for k, typ := range t.types {
 		if typ.equalToValues(vals) {
+			t.last = k
 			return k
 		}
 	}
 	k := len(t.types)
+	t.last = k
 	t.types = append(t.types, newTypeVectorFromValues(vals))
 	return k
 }
@@ -4,8 +4,8 @@ import "iter"
 
 // Apply applies eval to vecs. If any element of vecs is a Dynamic, Apply rips
 // vecs accordingly, applies eval to the ripped vectors, and stitches the
-// results together into a Dynamic. If ripUnions is true, Apply also rips
-// Unions.
+// results together into a normalized Dynamic (see Normalize). If ripUnions is
+// true, Apply also rips Unions.
 func Apply(ripUnions bool, eval func(...Any) Any, vecs ...Any) Any {
 	if ripUnions {
 		for k, vec := range vecs {
@@ -24,7 +24,7 @@ func Apply(ripUnions bool, eval func(...Any) Any, vecs ...Any) Any {
 			results[i] = Apply(ripUnions, eval, ripped...)
 		}
 	}
-	return stitch(d.Tags, results)
+	return Normalize(stitch(d.Tags, results))
 }
 
 func findDynamic(vecs []Any) (*Dynamic, bool) {
@@ -3,21 +3,26 @@ package bitvec
 import (
 	"math/bits"
 	"strings"
+	"sync/atomic"
 )
 
 type Bits struct {
 	bits   []uint64
 	length uint32
+	// count caches the number of set bits, or is negative if the count
+	// is unknown, and is shared by copies of Bits with the same storage
+	// so a count computed for one copy is available to all of them.
+	count *atomic.Int64
 }
 
 var Zero Bits
 
 func New(bits []uint64, length uint32) Bits {
-	return Bits{length: length, bits: bits}
+	return Bits{length: length, bits: bits, count: newCount(-1)}
 }
 
 func NewFalse(length uint32) Bits {
-	return Bits{length: length, bits: make([]uint64, (length+63)/64)}
+	return New(make([]uint64, (length+63)/64), length)
 }
 
 func NewTrue(n uint32) Bits {
@@ -25,9 +30,23 @@ func NewTrue(n uint32) Bits {
 	for i := range b.bits {
 		b.bits[i] = ^uint64(0)
 	}
+	b.count.Store(int64(n))
 	return b
 }
 
+func newCount(n int64) *atomic.Int64 {
+	var count atomic.Int64
+	count.Store(n)
+	return &count
+}
+
+// invalidate forgets the cached count of set bits.
+func (b Bits) invalidate() {
+	if b.count != nil && b.count.Load() >= 0 {
+		b.count.Store(-1)
+	}
+}
+
 func (b Bits) IsZero() bool {
 	return b.length == 0
 }
@@ -35,6 +54,7 @@ func (b Bits) IsZero() bool {
 // GetBits returns b's underlying storage with used bits cleared.
 // GetBits may modify the underlying storage.
 func (b Bits) GetBits() []uint64 {
+	b.invalidate()
 	if unusedBits := 64 - (b.length % 64); unusedBits < 64 {
 		// Clear unused bits.
 		mask := ^uint64(0) >> unusedBits
@@ -57,6 +77,7 @@ func (b Bits) IsSetDirect(slot uint32) bool {
 // bitvector, where slot must be smaller than the length of the bit vector.
 func (b Bits) Set(slot uint32) {
 	b.bits[slot>>6] |= (1 << (slot & 0x3f))
+	b.invalidate()
 }
 
 // Shorten may be called to shorten the length of an allocated vector.
@@ -65,6 +86,7 @@ func (b Bits) Set(slot uint32) {
 // to it with Set, then shorten it to the actual length.
 func (b *Bits) Shorten(length uint32) {
 	b.length = length
+	b.count = newCount(-1)
 }
 
 func (b Bits) Len() uint32 {
@@ -88,22 +110,45 @@ func (b Bits) ReversePick(index []uint32) Bits {
 	return b.Pick(ReverseIndex(index, b.length))
 }
 
+// TrueCount returns the number of set bits in b.  The count is cached so
+// subsequent calls on b or its copies are cheap until b is modified.
 func (b Bits) TrueCount() uint32 {
 	if b.IsZero() {
 		return 0
 	}
+	if b.count != nil {
+		if n := b.count.Load(); n >= 0 {
+			return uint32(n)
+		}
+	}
+	words := b.bits[:(b.length+63)/64]
 	var n uint32
-	for _, bs := range b.bits {
+	for _, bs := range words {
 		n += uint32(bits.OnesCount64(bs))
 	}
 	if numTailBits := b.Len() % 64; numTailBits > 0 {
 		mask := ^uint64(0) << numTailBits
-		unusedBits := b.bits[len(b.bits)-1] & mask
+		unusedBits := words[len(words)-1] & mask
 		n -= uint32(bits.OnesCount64(unusedBits))
 	}
+	if b.count != nil {
+		b.count.Store(int64(n))
+	}
 	return n
 }
 
+// NoneSet returns true if no bits of b are set, as is always the case for
+// Zero.  When b represents nulls, this means there are no nulls.
+func (b Bits) NoneSet() bool {
+	return b.TrueCount() == 0
+}
+
+// AllSet returns true if b is not Zero and all of its bits are set.  When
+// b represents nulls, this means every value is null.
+func (b Bits) AllSet() bool {
+	return !b.IsZero() && b.TrueCount() == b.length
+}
+
 // helpful to have around for debugging
 func (b Bits) String() string {
 	if b.IsZero() {
@@ -138,6 +183,14 @@ func Or(a, b Bits) Bits {
 	if a.Len() != b.Len() {
 		panic("or'ing two different length bool vectors")
 	}
+	// Avoid materializing a new vector when the result is one of the
+	// operands.
+	if b.NoneSet() || a.AllSet() {
+		return a
+	}
+	if a.NoneSet() || b.AllSet() {
+		return b
+	}
 	out := NewFalse(a.Len())
 	for i := range len(a.bits) {
 		out.bits[i] = a.bits[i] | b.bits[i]
@@ -152,6 +205,14 @@ func And(a, b Bits) Bits {
 	if a.Len() != b.Len() {
 		panic("and'ing two different length bool vectors")
 	}
+	// Avoid materializing a new vector when the result is one of the
+	// operands.
+	if a.NoneSet() || b.AllSet() {
+		return a
+	}
+	if b.NoneSet() || a.AllSet() {
+		return b
+	}
 	out := NewFalse(a.Len())
 	for i := range len(a.bits) {
 		out.bits[i] = a.bits[i] & b.bits[i]
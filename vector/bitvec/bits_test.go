@@ -20,3 +20,29 @@ func TestBitsTrueCount(t *testing.T) {
 	assert.EqualValues(t, 15, New(bits, 127).TrueCount())
 	assert.EqualValues(t, 16, New(bits, 128).TrueCount())
 }
+
+func TestBitsNoneSetAllSet(t *testing.T) {
+	assert.True(t, Zero.NoneSet())
+	assert.False(t, Zero.AllSet())
+	b := NewFalse(70)
+	assert.True(t, b.NoneSet())
+	assert.False(t, b.AllSet())
+	// Set invalidates the cached count.
+	b.Set(69)
+	assert.False(t, b.NoneSet())
+	assert.EqualValues(t, 1, b.TrueCount())
+	assert.True(t, NewTrue(70).AllSet())
+	shortened := New([]uint64{0x0f, 0xff}, 128)
+	shortened.Shorten(4)
+	assert.True(t, shortened.AllSet())
+}
+
+func TestBitsOrAndShortCircuit(t *testing.T) {
+	none, all := NewFalse(8), NewTrue(8)
+	some := New([]uint64{0x0f}, 8)
+	assert.Equal(t, some.GetBits(), Or(none, some).GetBits())
+	assert.Equal(t, all.GetBits(), Or(some, all).GetBits())
+	assert.Equal(t, some.GetBits(), And(all, some).GetBits())
+	assert.Equal(t, none.GetBits(), And(some, none).GetBits())
+	assert.EqualValues(t, 4, Or(some, New([]uint64{0x03}, 8)).TrueCount())
+}
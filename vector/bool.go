@@ -129,6 +129,34 @@ func NullsOf(v Any) bitvec.Bits {
 	panic(v)
 }
 
+// NoNulls returns true if v has no null values.  Unlike NullsOf, it does not
+// materialize the nulls of a Const or View.
+func NoNulls(v Any) bool {
+	switch v := v.(type) {
+	case *Const:
+		return !v.Value().IsNull() && v.Nulls.NoneSet()
+	case *Named:
+		return NoNulls(v.Any)
+	case *View:
+		if NoNulls(v.Any) {
+			return true
+		}
+	}
+	return NullsOf(v).NoneSet()
+}
+
+// AllNulls returns true if every value of v is null.  Unlike NullsOf, it
+// does not materialize the nulls of a Const.
+func AllNulls(v Any) bool {
+	switch v := v.(type) {
+	case *Const:
+		return v.Value().IsNull() || v.Nulls.AllSet()
+	case *Named:
+		return AllNulls(v.Any)
+	}
+	return v.Len() > 0 && NullsOf(v).AllSet()
+}
+
 func CopyAndSetNulls(v Any, nulls bitvec.Bits) Any {
 	switch v := v.(type) {
 	case *Array:
@@ -0,0 +1,163 @@
+package vector
+
+import (
+	"bytes"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/vector/bitvec"
+	"github.com/brimdata/super/zcode"
+)
+
+// Normalize returns vec with the structure of a Dynamic or Union simplified
+// so kernels are dispatched once per type: nested Dynamics are flattened into
+// their parent, empty values are dropped, values of the same type are merged
+// into one vector, and a Dynamic left with a single value is replaced by that
+// value.  Normalize does not change the type or order of the values in vec.
+func Normalize(vec Any) Any {
+	switch vec := vec.(type) {
+	case *Dynamic:
+		d := normalizeDynamic(vec)
+		if len(d.Values) == 1 {
+			return d.Values[0]
+		}
+		return d
+	case *Union:
+		if d := normalizeDynamic(vec.Dynamic); d != vec.Dynamic {
+			return &Union{d, vec.Typ, vec.Nulls}
+		}
+	}
+	return vec
+}
+
+func normalizeDynamic(d *Dynamic) *Dynamic {
+	if isNormal(d) {
+		return d
+	}
+	tags, leaves := flattenDynamic(d)
+	// Assign each distinct type a new tag in order of first appearance.
+	which := make(map[super.Type]uint32)
+	retag := make([]uint32, len(leaves))
+	var groups [][]Any
+	for k, leaf := range leaves {
+		if leaf == nil || leaf.Len() == 0 {
+			continue
+		}
+		typ := leaf.Type()
+		tag, ok := which[typ]
+		if !ok {
+			tag = uint32(len(groups))
+			which[typ] = tag
+			groups = append(groups, nil)
+		}
+		retag[k] = tag
+		groups[tag] = append(groups[tag], leaf)
+	}
+	if len(groups) == 0 {
+		// Keep an empty leaf so the type of an empty Dynamic is known.
+		for _, leaf := range leaves {
+			if leaf != nil {
+				return NewDynamic(tags, []Any{leaf})
+			}
+		}
+	}
+	vals := make([]Any, 0, len(groups))
+	for tag, group := range groups {
+		if len(group) == 1 {
+			vals = append(vals, group[0])
+		} else {
+			vals = append(vals, merge(group, uint32(tag), tags, leaves, retag))
+		}
+	}
+	for k, tag := range tags {
+		tags[k] = retag[tag]
+	}
+	return NewDynamic(tags, vals)
+}
+
+// isNormal returns true if the values of d are non-empty vectors other than
+// Dynamics, each with a different type.
+func isNormal(d *Dynamic) bool {
+	types := make(map[super.Type]struct{}, len(d.Values))
+	for _, vec := range d.Values {
+		if vec == nil || vec.Len() == 0 {
+			return false
+		}
+		if _, ok := vec.(*Dynamic); ok {
+			return false
+		}
+		typ := vec.Type()
+		if _, ok := types[typ]; ok {
+			return false
+		}
+		types[typ] = struct{}{}
+	}
+	return true
+}
+
+// flattenDynamic returns the tags and values of d with the values of any
+// nested Dynamics, at any depth, moved into d.
+func flattenDynamic(d *Dynamic) ([]uint32, []Any) {
+	var leaves []Any
+	nestedTags := make([][]uint32, len(d.Values))
+	shifts := make([]uint32, len(d.Values))
+	for k, vec := range d.Values {
+		shifts[k] = uint32(len(leaves))
+		if nested, ok := vec.(*Dynamic); ok {
+			tags, vals := flattenDynamic(nested)
+			nestedTags[k] = tags
+			leaves = append(leaves, vals...)
+		} else {
+			leaves = append(leaves, vec)
+		}
+	}
+	tags := make([]uint32, len(d.Tags))
+	for slot, tag := range d.Tags {
+		newTag := shifts[tag]
+		if nested := nestedTags[tag]; nested != nil {
+			newTag += nested[0]
+			nestedTags[tag] = nested[1:]
+		}
+		tags[slot] = newTag
+	}
+	return tags, leaves
+}
+
+// merge returns a single vector holding the values of group, whose vectors
+// all have the same type and are the leaves retagged to target, in the order
+// given by tags.
+func merge(group []Any, target uint32, tags []uint32, leaves []Any, retag []uint32) Any {
+	if c, ok := mergeConsts(group); ok {
+		return c
+	}
+	builder := NewBuilder(group[0].Type())
+	forward := make([]uint32, len(leaves))
+	var b zcode.Builder
+	for _, tag := range tags {
+		if retag[tag] != target {
+			continue
+		}
+		b.Truncate()
+		leaves[tag].Serialize(&b, forward[tag])
+		forward[tag]++
+		builder.Write(b.Bytes().Body())
+	}
+	return builder.Build(bitvec.Zero)
+}
+
+// mergeConsts returns a Const for group if group consists of Consts with
+// the same value and no nulls bits.
+func mergeConsts(group []Any) (Any, bool) {
+	first, ok := group[0].(*Const)
+	if !ok || !first.Nulls.IsZero() {
+		return nil, false
+	}
+	n := first.Len()
+	for _, vec := range group[1:] {
+		c, ok := vec.(*Const)
+		if !ok || !c.Nulls.IsZero() || c.val.IsNull() != first.val.IsNull() || !bytes.Equal(c.val.Bytes(), first.val.Bytes()) {
+			return nil, false
+		}
+		n += c.Len()
+	}
+	return NewConst(first.val, n, bitvec.Zero), true
+}
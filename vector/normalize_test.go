@@ -0,0 +1,55 @@
+package vector_test
+
+import (
+	"testing"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/sup"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/vector/bitvec"
+	"github.com/brimdata/super/zcode"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeDynamic(t *testing.T) {
+	strs := vector.NewStringEmpty(0, bitvec.Zero)
+	strs.Append("a")
+	strs.Append("b")
+	ints1 := vector.NewInt(super.TypeInt64, []int64{1, 2}, bitvec.Zero)
+	ints2 := vector.NewInt(super.TypeInt64, []int64{3}, bitvec.Zero)
+	empty := vector.NewInt(super.TypeInt64, nil, bitvec.Zero)
+	nested := vector.NewDynamic([]uint32{1, 0, 1}, []vector.Any{ints2, strs})
+	d := vector.NewDynamic([]uint32{0, 2, 2, 0, 2}, []vector.Any{ints1, empty, nested})
+	out := vector.Normalize(d)
+	normal, ok := out.(*vector.Dynamic)
+	require.True(t, ok)
+	require.Len(t, normal.Values, 2)
+	require.Equal(t, []uint32{0, 1, 0, 0, 1}, normal.Tags)
+	require.Equal(t, []string{"1", "\"a\"", "3", "2", "\"b\""}, serialize(out))
+}
+
+func TestNormalizeSingleType(t *testing.T) {
+	ints1 := vector.NewInt(super.TypeInt64, []int64{1, 2}, bitvec.Zero)
+	ints2 := vector.NewInt(super.TypeInt64, []int64{3}, bitvec.Zero)
+	d := vector.NewDynamic([]uint32{1, 0, 0}, []vector.Any{ints1, ints2})
+	out := vector.Normalize(d)
+	require.IsType(t, (*vector.Int)(nil), out)
+	require.Equal(t, []string{"3", "1", "2"}, serialize(out))
+}
+
+func serialize(vec vector.Any) []string {
+	var out []string
+	var b zcode.Builder
+	for i := range vec.Len() {
+		b.Truncate()
+		vec.Serialize(&b, i)
+		var typ super.Type
+		if d, ok := vec.(*vector.Dynamic); ok {
+			typ = d.TypeOf(i)
+		} else {
+			typ = vec.Type()
+		}
+		out = append(out, sup.FormatValue(super.NewValue(typ, b.Bytes().Body())))
+	}
+	return out
+}
@@ -1,19 +1,54 @@
 package vector
 
 import (
+	"sync"
+	"sync/atomic"
+
 	"github.com/brimdata/super/vector/bitvec"
 	"github.com/brimdata/super/zcode"
 )
 
+// View is a selection of the slots of a vector given by Index.  A View does
+// not copy the underlying vector so picking from a record is cheap and its
+// fields are picked only when requested (see Field).
 type View struct {
 	Any
 	Index []uint32
+
+	fields atomic.Pointer[viewFields]
+}
+
+// viewFields caches the picked fields of a View of a Record.
+type viewFields struct {
+	mu   sync.Mutex
+	vecs []Any
 }
 
 var _ Any = (*View)(nil)
 
 func NewView(vec Any, index []uint32) *View {
-	return &View{vec, index}
+	return &View{Any: vec, Index: index}
+}
+
+// Field returns field i of the Record under v picked by v's index.  Each
+// field is picked when first requested and at most once so repeated
+// references to a field of a selection, e.g., in the branches of nested
+// conditionals, do not materialize it again.
+func (v *View) Field(i int) Any {
+	rec := v.Any.(*Record)
+	fields := v.fields.Load()
+	if fields == nil {
+		fields = &viewFields{vecs: make([]Any, len(rec.Fields))}
+		if !v.fields.CompareAndSwap(nil, fields) {
+			fields = v.fields.Load()
+		}
+	}
+	fields.mu.Lock()
+	defer fields.mu.Unlock()
+	if fields.vecs[i] == nil {
+		fields.vecs[i] = Pick(rec.Fields[i], v.Index)
+	}
+	return fields.vecs[i]
 }
 
 // Pick takes any vector vec and an index and returns a new vector consisting of the
@@ -63,7 +98,7 @@ func Pick(val Any, index []uint32) Any {
 		// Wrapped View under Named so vector.Under still works.
 		return &Named{val.Typ, Pick(val.Any, index)}
 	}
-	return &View{val, index}
+	return NewView(val, index)
 }
 
 // ReversePick is like Pick but it builds the vector from the elements
@@ -0,0 +1,31 @@
+package vector_test
+
+import (
+	"testing"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/vector/bitvec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestViewField(t *testing.T) {
+	sctx := super.NewContext()
+	typ := sctx.MustLookupTypeRecord([]super.Field{
+		super.NewField("a", super.TypeInt64),
+		super.NewField("b", super.TypeInt64),
+	})
+	a := vector.NewInt(super.TypeInt64, []int64{1, 2, 3}, bitvec.Zero)
+	b := vector.NewInt(super.TypeInt64, []int64{4, 5, 6}, bitvec.Zero)
+	rec := vector.NewRecord(typ, []vector.Any{a, b}, 3, bitvec.Zero)
+	view := vector.Pick(rec, []uint32{2, 0}).(*vector.View)
+	field := view.Field(1)
+	require.Same(t, field, view.Field(1))
+	require.Equal(t, []string{"6", "4"}, serialize(field))
+	// Picking from a view composes the indexes without materializing
+	// the fields.
+	view2 := vector.Pick(view, []uint32{1}).(*vector.View)
+	require.Same(t, rec, view2.Any)
+	require.Equal(t, []uint32{0}, view2.Index)
+	require.Equal(t, []string{"1"}, serialize(view2.Field(0)))
+}
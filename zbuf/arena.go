@@ -0,0 +1,89 @@
+package zbuf
+
+import (
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	"github.com/brimdata/super"
+)
+
+// ArenaBatch is a Batch that owns the memory of its values.  Values appended
+// to an ArenaBatch are copied into a buffer that is returned to a pool for
+// reuse by another ArenaBatch when the reference count falls to zero, so
+// operators that produce new values can avoid an allocation per value.
+type ArenaBatch struct {
+	buf  []byte
+	refs atomic.Int32
+	vals []super.Value
+	vars []super.Value
+}
+
+var _ Batch = (*ArenaBatch)(nil)
+
+var arenaBatchPool sync.Pool
+
+// NewArenaBatch returns an empty ArenaBatch with a reference count of one.
+// If parent is not nil, the batch has a copy of the variables of parent.
+func NewArenaBatch(parent Batch) *ArenaBatch {
+	b, ok := arenaBatchPool.Get().(*ArenaBatch)
+	if !ok {
+		b = &ArenaBatch{
+			buf:  make([]byte, PullerBatchBytes),
+			vals: make([]super.Value, PullerBatchValues),
+		}
+	}
+	b.buf = b.buf[:0]
+	b.refs.Store(1)
+	b.vals = b.vals[:0]
+	b.vars = nil
+	if parent != nil {
+		b.vars = CopyVars(parent)
+	}
+	return b
+}
+
+// Append appends a copy of val to b.  Append returns true if b is full
+// (i.e., b's buffer is full, b's buffer had insufficient space for val.Bytes,
+// or b has [PullerBatchValues] values).  A full batch accepts more values
+// but copies them into newly allocated memory.  Append never reallocates
+// b's buffer so values previously appended remain valid.
+func (b *ArenaBatch) Append(val super.Value) bool {
+	var bytes []byte
+	var bufFull bool
+	if !val.IsNull() {
+		if avail := cap(b.buf) - len(b.buf); avail >= len(val.Bytes()) {
+			// Append to b.buf since that won't reallocate.
+			start := len(b.buf)
+			b.buf = append(b.buf, val.Bytes()...)
+			bytes = b.buf[start:]
+			bufFull = avail == len(val.Bytes())
+		} else {
+			// Copy since appending to b.buf would reallocate.
+			bytes = slices.Clone(val.Bytes())
+			bufFull = true
+		}
+	}
+	b.vals = append(b.vals, super.NewValue(val.Type(), bytes))
+	return bufFull || len(b.vals) >= PullerBatchValues
+}
+
+// Len returns the number of values in b.
+func (b *ArenaBatch) Len() int {
+	return len(b.vals)
+}
+
+func (b *ArenaBatch) Ref() { b.refs.Add(1) }
+
+func (b *ArenaBatch) Unref() {
+	if refs := b.refs.Add(-1); refs == 0 {
+		if cap(b.buf) == PullerBatchBytes && cap(b.vals) == PullerBatchValues {
+			arenaBatchPool.Put(b)
+		}
+	} else if refs < 0 {
+		panic("zbuf: negative batch reference count")
+	}
+}
+
+func (b *ArenaBatch) Values() []super.Value { return b.vals }
+func (b *ArenaBatch) Vars() []super.Value   { return b.vars }
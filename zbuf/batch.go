@@ -1,10 +1,6 @@
 package zbuf
 
 import (
-	"slices"
-	"sync"
-	"sync/atomic"
-
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/zio"
 )
@@ -33,9 +29,15 @@ type Batch interface {
 	Vars() []super.Value
 }
 
-// WriteBatch writes the values in batch to zw.  If an error occurs, WriteBatch
-// stops and returns the error.
+// WriteBatch writes the values in batch to zw.  If batch is a VectorBatch and
+// zw is a VectorWriter, the vector is written directly.  If an error occurs,
+// WriteBatch stops and returns the error.
 func WriteBatch(zw zio.Writer, batch Batch) error {
+	if vb, ok := batch.(VectorBatch); ok {
+		if vw, ok := zw.(VectorWriter); ok {
+			return vw.WriteVector(vb.Vector())
+		}
+	}
 	vals := batch.Values()
 	for i := range vals {
 		if err := zw.Write(vals[i]); err != nil {
@@ -83,7 +85,7 @@ func (p *puller) Pull(done bool) (Batch, error) {
 	if p.zr == nil {
 		return nil, nil
 	}
-	batch := newPullerBatch()
+	batch := NewArenaBatch(nil)
 	for {
 		val, err := p.zr.Read()
 		if err != nil {
@@ -91,75 +93,17 @@ func (p *puller) Pull(done bool) (Batch, error) {
 		}
 		if val == nil {
 			p.zr = nil
-			if len(batch.vals) == 0 {
+			if batch.Len() == 0 {
 				return nil, nil
 			}
 			return batch, nil
 		}
-		if batch.appendVal(*val) {
+		if batch.Append(*val) {
 			return batch, nil
 		}
 	}
 }
 
-type pullerBatch struct {
-	buf  []byte
-	refs atomic.Int32
-	vals []super.Value
-}
-
-var pullerBatchPool sync.Pool
-
-func newPullerBatch() *pullerBatch {
-	b, ok := pullerBatchPool.Get().(*pullerBatch)
-	if !ok {
-		b = &pullerBatch{
-			buf:  make([]byte, PullerBatchBytes),
-			vals: make([]super.Value, PullerBatchValues),
-		}
-	}
-	b.buf = b.buf[:0]
-	b.refs.Store(1)
-	b.vals = b.vals[:0]
-	return b
-}
-
-// appendVal appends a copy of val to b.  appendVal returns true if b is full
-// (i.e., b.buf is full, b.buf had insufficient space for val.Bytes, or b.val is
-// full).  appendVal never reallocates b.buf or b.vals.
-func (b *pullerBatch) appendVal(val super.Value) bool {
-	var bytes []byte
-	var bufFull bool
-	if !val.IsNull() {
-		if avail := cap(b.buf) - len(b.buf); avail >= len(val.Bytes()) {
-			// Append to b.buf since that won't reallocate.
-			start := len(b.buf)
-			b.buf = append(b.buf, val.Bytes()...)
-			bytes = b.buf[start:]
-			bufFull = avail == len(val.Bytes())
-		} else {
-			// Copy since appending to b.buf would reallocate.
-			bytes = slices.Clone(val.Bytes())
-			bufFull = true
-		}
-	}
-	b.vals = append(b.vals, super.NewValue(val.Type(), bytes))
-	return bufFull || len(b.vals) == cap(b.vals)
-}
-
-func (b *pullerBatch) Ref() { b.refs.Add(1) }
-
-func (b *pullerBatch) Unref() {
-	if refs := b.refs.Add(-1); refs == 0 {
-		pullerBatchPool.Put(b)
-	} else if refs < 0 {
-		panic("zbuf: negative batch reference count")
-	}
-}
-
-func (p *pullerBatch) Values() []super.Value { return p.vals }
-func (*pullerBatch) Vars() []super.Value     { return nil }
-
 func CopyPuller(w zio.Writer, p Puller) error {
 	for {
 		b, err := p.Pull(false)
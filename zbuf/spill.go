@@ -0,0 +1,76 @@
+package zbuf
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// A SpillMeter provides SpillStats.
+type SpillMeter interface {
+	SpillStats() SpillStats
+}
+
+// SpillStats represents statistics of the values spilled to temporary
+// storage by operators, e.g., aggregations, whose state exceeds their
+// memory budget.  MergePasses is the number of times the spilled values
+// were merged and read back.
+type SpillStats struct {
+	RowsSpilled  int64 `super:"rows_spilled" json:"rows_spilled"`
+	SpillFiles   int64 `super:"spill_files" json:"spill_files"`
+	BytesSpilled int64 `super:"bytes_spilled" json:"bytes_spilled"`
+	MergePasses  int64 `super:"merge_passes" json:"merge_passes"`
+}
+
+var _ SpillMeter = (*SpillStats)(nil)
+
+// Add updates its receiver by adding to it the values in in.
+func (s *SpillStats) Add(in SpillStats) {
+	if s != nil {
+		atomic.AddInt64(&s.RowsSpilled, in.RowsSpilled)
+		atomic.AddInt64(&s.SpillFiles, in.SpillFiles)
+		atomic.AddInt64(&s.BytesSpilled, in.BytesSpilled)
+		atomic.AddInt64(&s.MergePasses, in.MergePasses)
+	}
+}
+
+func (s *SpillStats) Copy() SpillStats {
+	if s == nil {
+		return SpillStats{}
+	}
+	return SpillStats{
+		RowsSpilled:  atomic.LoadInt64(&s.RowsSpilled),
+		SpillFiles:   atomic.LoadInt64(&s.SpillFiles),
+		BytesSpilled: atomic.LoadInt64(&s.BytesSpilled),
+		MergePasses:  atomic.LoadInt64(&s.MergePasses),
+	}
+}
+
+func (s *SpillStats) SpillStats() SpillStats {
+	return s.Copy()
+}
+
+// SpillOptions configures the temporary files to which operators spill
+// values.  Format is "bsup" or "csup" and Compression is "none", "lz4", or
+// "zstd", where an empty string selects the first of each.  Compression
+// applies only to the bsup format since csup compresses its own data.
+type SpillOptions struct {
+	Format      string
+	Compression string
+}
+
+func (s SpillOptions) Validate() error {
+	switch s.Format {
+	case "", "bsup":
+	case "csup":
+		if s.Compression != "" && s.Compression != "none" {
+			return fmt.Errorf("spill compression %q is not supported by the csup format", s.Compression)
+		}
+	default:
+		return fmt.Errorf("unknown spill format: %q", s.Format)
+	}
+	switch s.Compression {
+	case "", "none", "lz4", "zstd":
+		return nil
+	}
+	return fmt.Errorf("unknown spill compression: %q", s.Compression)
+}
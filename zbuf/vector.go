@@ -0,0 +1,31 @@
+package zbuf
+
+import (
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/zio"
+)
+
+// VectorBatch is a Batch whose values are held in a vector.  A VectorBatch
+// materializes its values only when Values is called so writers that
+// implement VectorWriter can serialize the vector directly.
+type VectorBatch interface {
+	Batch
+	Vector() vector.Any
+}
+
+// VectorWriter is implemented by a zio.Writer that can encode the values
+// of a vector without first materializing them as super.Values.
+type VectorWriter interface {
+	zio.Writer
+	WriteVector(vector.Any) error
+}
+
+// Len returns the number of values in batch.  Unlike len(batch.Values()),
+// it does not materialize the values of a VectorBatch.
+func Len(batch Batch) int {
+	batch, _ = Unlabel(batch)
+	if vb, ok := batch.(VectorBatch); ok {
+		return int(vb.Vector().Len())
+	}
+	return len(batch.Values())
+}
@@ -92,6 +92,10 @@ func (c *canon) expr(e ast.Expr, parent string) {
 		if e.Expr != nil {
 			c.expr(e.Expr, "")
 		}
+		for _, param := range e.Params {
+			c.write(", ")
+			c.expr(param, "")
+		}
 		c.write(")")
 		if e.Where != nil {
 			c.write(" where ")
@@ -498,8 +502,11 @@ func (c *canon) op(p ast.Op) {
 		c.assignments(p.Args)
 	case *ast.Distinct:
 		c.next()
-		c.write("distinct ")
-		c.expr(p.Expr, "")
+		c.write("distinct")
+		if p.Expr != nil {
+			c.write(" ")
+			c.expr(p.Expr, "")
+		}
 	case *ast.Drop:
 		c.next()
 		c.write("drop ")
@@ -542,6 +549,25 @@ func (c *canon) op(p ast.Op) {
 			c.expr(p.Count, "")
 		}
 		c.close()
+	case *ast.Graph:
+		c.next()
+		c.write("graph ")
+		c.expr(p.Src, "")
+		c.write(", ")
+		c.expr(p.Dst, "")
+	case *ast.Sequence:
+		c.next()
+		c.write("sequence ")
+		c.assignments(p.Steps)
+		if len(p.Keys) != 0 {
+			c.write(" by ")
+			c.assignments(p.Keys)
+		}
+		c.write(" within %s", p.Window.Text)
+		if p.Time != nil {
+			c.write(" on ")
+			c.expr(p.Time, "")
+		}
 	case *ast.Uniq:
 		c.next()
 		c.write("uniq")
@@ -613,27 +639,33 @@ func (c *canon) op(p ast.Op) {
 		if p.Style != "" {
 			c.write("%s ", p.Style)
 		}
-		c.write("join ")
+		c.write("join")
 		if p.RightInput != nil {
+			c.write(" ")
 			c.open("(")
 			c.head = true
 			c.seq(p.RightInput)
 			c.close()
 			c.ret()
 			c.flush()
-			c.write(") ")
+			c.write(")")
 		}
 		switch cond := p.Cond.(type) {
+		case nil:
 		case *ast.JoinOnExpr:
-			c.write("on ")
+			c.write(" on ")
 			c.expr(cond.Expr, "")
 		case *ast.JoinUsingExpr:
-			c.write("using (")
+			c.write(" using (")
 			c.exprs(cond.Fields)
 			c.write(")")
 		default:
 			panic(cond)
 		}
+		if p.Tolerance != nil {
+			c.write(" within ")
+			c.expr(p.Tolerance, "")
+		}
 		if p.Args != nil {
 			c.write(" ")
 			c.assignments(p.Args)
@@ -770,6 +802,9 @@ func (c *canon) sortExprs(sortExprs []ast.SortExpr) {
 		}
 		c.space()
 		c.expr(s.Expr, "")
+		if s.Collate != nil {
+			c.write(" collate %q", s.Collate.Name)
+		}
 		if s.Order != nil {
 			c.write(" %s", s.Order.Name)
 		}
@@ -784,6 +819,9 @@ func (c *canon) poolArgs(args *ast.PoolArgs) {
 	if args.Commit != nil {
 		s += "@" + args.Commit.Text
 	}
+	if args.AsOf != nil {
+		s += ":" + args.AsOf.Text
+	}
 	if args.Meta != nil {
 		s += ":" + args.Meta.Text
 	}
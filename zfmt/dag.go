@@ -72,6 +72,10 @@ func (c *canonDAG) expr(e dag.Expr, parent string) {
 		if e.Expr != nil {
 			c.expr(e.Expr, "")
 		}
+		for _, param := range e.Params {
+			c.write(", ")
+			c.expr(param, "")
+		}
 		c.write(")")
 		if e.Where != nil {
 			c.write(" where ")
@@ -372,9 +376,20 @@ func (c *canonDAG) op(p dag.Op) {
 		if p.PartialsOut {
 			c.write(" partials-out")
 		}
+		if p.Combiner {
+			c.write(" combiner")
+		}
 		if p.InputSortDir != 0 {
 			c.write(" sort-dir %d", p.InputSortDir)
 		}
+		if p.TopLimit != 0 {
+			c.write(" top %d", p.TopLimit)
+			c.sortExprs(p.TopExprs)
+		}
+		if len(p.OutputSort) != 0 {
+			c.write(" sort-out")
+			c.sortExprs(p.OutputSort)
+		}
 		c.ret()
 		c.open()
 		c.assignments(p.Aggs)
@@ -385,6 +400,10 @@ func (c *canonDAG) op(p dag.Op) {
 		if p.Limit != 0 {
 			c.write(" -with limit %d", p.Limit)
 		}
+		if len(p.Emit) != 0 {
+			c.write(" emit ")
+			c.assignments(p.Emit)
+		}
 		c.close()
 		c.close()
 	case *dag.Combine:
@@ -433,6 +452,16 @@ func (c *canonDAG) op(p dag.Op) {
 	case *dag.Skip:
 		c.next()
 		c.write("skip %d", p.Count)
+	case *dag.Sequence:
+		c.next()
+		c.write("sequence ")
+		c.assignments(p.Steps)
+		if len(p.Keys) != 0 {
+			c.write(" by ")
+			c.assignments(p.Keys)
+		}
+		c.write(" within %s on ", p.Window)
+		c.expr(p.Time, "")
 	case *dag.Uniq:
 		c.next()
 		c.write("uniq")
@@ -469,29 +498,51 @@ func (c *canonDAG) op(p dag.Op) {
 		if p.Style != "" {
 			c.write("%s ", p.Style)
 		}
-		c.write("join on ")
-		c.expr(p.LeftKey, "")
-		c.write("=")
-		c.expr(p.RightKey, "")
+		c.write("join")
+		if p.LeftKey != nil {
+			c.write(" on ")
+			c.expr(p.LeftKey, "")
+			c.write("=")
+			c.expr(p.RightKey, "")
+		}
+		if p.Tolerance != nil {
+			c.write(" within ")
+			c.expr(p.Tolerance, "")
+		}
 		if len(p.Args) != 0 {
 			c.write(" ")
 			c.assignments(p.Args)
 		}
+		if p.KeySet != "" {
+			c.write(" keyset %s", p.KeySet)
+		}
 		c.close()
 	case *dag.Lister:
 		c.next()
 		c.open("lister")
 		c.write(" pool %s commit %s", p.Pool, p.Commit)
+		if p.Unordered {
+			c.write(" unordered")
+		}
+		if p.Reverse {
+			c.write(" reverse")
+		}
 		if p.KeyPruner != nil {
 			c.write(" pruner (")
 			c.expr(p.KeyPruner, "")
 			c.write(")")
 		}
+		if p.KeySet != "" {
+			c.write(" keyset %s", p.KeySet)
+		}
 		c.close()
 	case *dag.SeqScan:
 		c.next()
 		c.open("seqscan")
 		c.write(" pool %s", p.Pool)
+		if p.Reverse {
+			c.write(" reverse")
+		}
 		if p.KeyPruner != nil {
 			c.write(" pruner (")
 			c.expr(p.KeyPruner, "")
@@ -582,6 +633,9 @@ func (c *canonDAG) op(p dag.Op) {
 		if p.Tap {
 			c.write(" tap")
 		}
+	case *dag.SummaryScan:
+		c.next()
+		c.write("summary %d pool %s@%s", p.Summary, p.Pool, p.Commit)
 	case *dag.LakeMetaScan:
 		c.next()
 		c.write(":%s", p.Meta)
@@ -687,6 +741,9 @@ func (c *canonDAG) sortExprs(sortExprs []dag.SortExpr) {
 		}
 		c.space()
 		c.expr(s.Key, "")
+		if s.Collation != "" {
+			c.write(" collate %q", s.Collation)
+		}
 		c.write(" %s nulls %s", s.Order, s.Nulls)
 	}
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/brimdata/super/zio/jsonio"
 	"github.com/brimdata/super/zio/lineio"
 	"github.com/brimdata/super/zio/parquetio"
+	"github.com/brimdata/super/zio/pcapio"
 	"github.com/brimdata/super/zio/supio"
 	"github.com/brimdata/super/zio/zeekio"
 	"github.com/brimdata/super/zio/zjsonio"
@@ -42,6 +43,12 @@ func lookupReader(sctx *super.Context, r io.Reader, opts ReaderOpts) (zio.ReadCl
 			return nil, err
 		}
 		return zio.NopReadCloser(zr), nil
+	case "pcap":
+		zr, err := pcapio.NewReader(sctx, r, opts.PCAP)
+		if err != nil {
+			return nil, err
+		}
+		return zio.NopReadCloser(zr), nil
 	case "sup":
 		return zio.NopReadCloser(supio.NewReader(sctx, r)), nil
 	case "tsv":
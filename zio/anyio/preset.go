@@ -0,0 +1,218 @@
+package anyio
+
+import (
+	"fmt"
+	"math"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/zcode"
+	"github.com/brimdata/super/zio"
+)
+
+// A preset shapes the values of a well-known log format, converting the
+// fields it knows by name to their proper types, e.g., the strings holding
+// the addresses of a JSON log to IP addresses.  A field that cannot be
+// converted is left as is.
+type preset func(path string) shape
+
+type shape int
+
+const (
+	shapeNone shape = iota
+	shapeDuration
+	shapeIP
+	shapePort
+	shapeTime
+)
+
+var presets = map[string]preset{
+	"suricata": suricataShape,
+	"zeek":     zeekShape,
+}
+
+// zeekShape shapes Zeek logs in the JSON format after the types of the
+// Zeek TSV format, which the Zeek reader already yields.  Zeek JSON
+// flattens nested records to dotted names, e.g., "id.orig_h", so fields are
+// matched by the last element of their name.
+func zeekShape(path string) shape {
+	name := path[strings.LastIndexByte(path, '.')+1:]
+	switch name {
+	case "ts":
+		return shapeTime
+	case "duration", "lease_time", "rtt":
+		return shapeDuration
+	case "assigned_addr", "client_addr", "dst", "requested_addr", "rx_hosts", "server_addr", "src", "tx_hosts":
+		return shapeIP
+	}
+	switch {
+	case strings.HasSuffix(name, "_ts"):
+		return shapeTime
+	case strings.HasSuffix(name, "_h"):
+		return shapeIP
+	case strings.HasSuffix(name, "_p"):
+		return shapePort
+	}
+	return shapeNone
+}
+
+// suricataShape shapes Suricata EVE JSON logs.
+func suricataShape(path string) shape {
+	switch path {
+	case "timestamp", "flow.start", "flow.end":
+		return shapeTime
+	case "src_ip", "dest_ip":
+		return shapeIP
+	case "src_port", "dest_port":
+		return shapePort
+	}
+	return shapeNone
+}
+
+type presetReader struct {
+	zio.ReadCloser
+	sctx    *super.Context
+	preset  preset
+	builder zcode.Builder
+}
+
+func newPresetReader(sctx *super.Context, r zio.ReadCloser, name string) (zio.ReadCloser, error) {
+	p, ok := presets[name]
+	if !ok {
+		return nil, fmt.Errorf("no such preset: %q", name)
+	}
+	return &presetReader{ReadCloser: r, sctx: sctx, preset: p}, nil
+}
+
+func (p *presetReader) Read() (*super.Value, error) {
+	val, err := p.ReadCloser.Read()
+	if val == nil || err != nil {
+		return val, err
+	}
+	if _, ok := val.Type().(*super.TypeRecord); !ok {
+		return val, nil
+	}
+	p.builder.Truncate()
+	typ := p.shapeValue("", val.Type(), val.Bytes())
+	out := super.NewValue(typ, p.builder.Bytes().Body())
+	return &out, nil
+}
+
+// shapeValue appends the value of type typ in bytes, shaped for the field
+// at path, to p.builder and returns its type.
+func (p *presetReader) shapeValue(path string, typ super.Type, bytes zcode.Bytes) super.Type {
+	if path != "" {
+		if s := p.preset(path); s != shapeNone {
+			if typ, out, ok := p.convert(s, typ, bytes); ok {
+				p.builder.Append(out)
+				return typ
+			}
+		}
+	}
+	recType, ok := typ.(*super.TypeRecord)
+	if !ok || bytes == nil {
+		p.builder.Append(bytes)
+		return typ
+	}
+	fields := make([]super.Field, 0, len(recType.Fields))
+	p.builder.BeginContainer()
+	it := bytes.Iter()
+	for _, f := range recType.Fields {
+		name := f.Name
+		if path != "" {
+			name = path + "." + name
+		}
+		fields = append(fields, super.NewField(f.Name, p.shapeValue(name, f.Type, it.Next())))
+	}
+	p.builder.EndContainer()
+	out, err := p.sctx.LookupTypeRecord(fields)
+	if err != nil {
+		// The field names of recType are unique, so this cannot happen.
+		panic(err)
+	}
+	return out
+}
+
+// convert returns the type and bytes of the value of type typ in bytes
+// converted to s.  An array of values is converted element by element.
+func (p *presetReader) convert(s shape, typ super.Type, bytes zcode.Bytes) (super.Type, zcode.Bytes, bool) {
+	if arrayType, ok := typ.(*super.TypeArray); ok {
+		if bytes == nil {
+			return nil, nil, false
+		}
+		var elemType super.Type
+		var b zcode.Builder
+		for it := bytes.Iter(); !it.Done(); {
+			typ, elem, ok := p.convert(s, arrayType.Type, it.Next())
+			if !ok {
+				return nil, nil, false
+			}
+			elemType = typ
+			b.Append(elem)
+		}
+		if elemType == nil {
+			return nil, nil, false
+		}
+		return p.sctx.LookupTypeArray(elemType), b.Bytes(), true
+	}
+	if bytes == nil {
+		return nil, nil, false
+	}
+	id := typ.ID()
+	switch s {
+	case shapeDuration:
+		if secs, ok := number(id, bytes); ok {
+			return super.TypeDuration, super.EncodeDuration(nano.Duration(math.Round(secs * 1e9))), true
+		}
+	case shapeIP:
+		if id == super.IDString {
+			if a, err := netip.ParseAddr(super.DecodeString(bytes)); err == nil {
+				return super.TypeIP, super.EncodeIP(a), true
+			}
+		}
+	case shapePort:
+		if n, ok := number(id, bytes); ok && n >= 0 && n <= math.MaxUint16 && n == math.Trunc(n) {
+			if typ, err := p.sctx.LookupTypeNamed("port", super.TypeUint16); err == nil {
+				return typ, super.EncodeUint(uint64(n)), true
+			}
+		}
+	case shapeTime:
+		if id == super.IDString {
+			if ts, ok := parseTime(super.DecodeString(bytes)); ok {
+				return super.TypeTime, super.EncodeTime(ts), true
+			}
+		} else if secs, ok := number(id, bytes); ok {
+			// Round to microseconds, the precision of Zeek, as a float64
+			// holds epoch nanoseconds inexactly.
+			return super.TypeTime, super.EncodeTime(nano.Ts(math.Round(secs*1e6)) * 1000), true
+		}
+	}
+	return nil, nil, false
+}
+
+// number returns the number in bytes of type ID id as a float64.
+func number(id int, bytes zcode.Bytes) (float64, bool) {
+	switch {
+	case super.IsFloat(id):
+		return super.DecodeFloat(bytes), true
+	case super.IsSigned(id):
+		return float64(super.DecodeInt(bytes)), true
+	case super.IsUnsigned(id):
+		return float64(super.DecodeUint(bytes)), true
+	}
+	return 0, false
+}
+
+// parseTime parses RFC 3339 timestamps and those of Suricata, whose zone
+// offset lacks a colon, e.g., "2025-01-02T03:04:05.123456+0000".
+func parseTime(s string) (nano.Ts, bool) {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999Z0700"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return nano.TimeToTs(t), true
+		}
+	}
+	return 0, false
+}
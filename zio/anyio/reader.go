@@ -17,6 +17,7 @@ import (
 	"github.com/brimdata/super/zio/csvio"
 	"github.com/brimdata/super/zio/jsonio"
 	"github.com/brimdata/super/zio/parquetio"
+	"github.com/brimdata/super/zio/pcapio"
 	"github.com/brimdata/super/zio/supio"
 	"github.com/brimdata/super/zio/zeekio"
 	"github.com/brimdata/super/zio/zjsonio"
@@ -25,8 +26,12 @@ import (
 type ReaderOpts struct {
 	Fields []field.Path
 	Format string
+	// Preset names the shaping applied to the values read, i.e., "zeek"
+	// or "suricata", or is empty for none.
+	Preset string
 	BSUP   bsupio.ReaderOpts
 	CSV    csvio.ReaderOpts
+	PCAP   pcapio.ReaderOpts
 }
 
 func NewReader(sctx *super.Context, r io.Reader) (zio.ReadCloser, error) {
@@ -34,6 +39,14 @@ func NewReader(sctx *super.Context, r io.Reader) (zio.ReadCloser, error) {
 }
 
 func NewReaderWithOpts(sctx *super.Context, r io.Reader, opts ReaderOpts) (zio.ReadCloser, error) {
+	zr, err := newReader(sctx, r, opts)
+	if err != nil || opts.Preset == "" {
+		return zr, err
+	}
+	return newPresetReader(sctx, zr, opts.Preset)
+}
+
+func newReader(sctx *super.Context, r io.Reader, opts ReaderOpts) (zio.ReadCloser, error) {
 	if opts.Format != "" && opts.Format != "auto" {
 		return lookupReader(sctx, r, opts)
 	}
@@ -69,6 +82,18 @@ func NewReaderWithOpts(sctx *super.Context, r io.Reader, opts ReaderOpts) (zio.R
 
 	track := NewTrack(r)
 
+	_, pcapErr := pcapio.NewReader(super.NewContext(), track, pcapio.ReaderOpts{})
+	if pcapErr == nil {
+		track.Reset()
+		zr, err := pcapio.NewReader(sctx, track.Reader(), opts.PCAP)
+		if err != nil {
+			return nil, err
+		}
+		return zio.NopReadCloser(zr), nil
+	}
+	pcapErr = fmt.Errorf("pcap: %w", pcapErr)
+	track.Reset()
+
 	arrowsErr := isArrowStream(track)
 	if arrowsErr == nil {
 		return arrowio.NewReader(sctx, track.Reader())
@@ -139,6 +164,7 @@ func NewReaderWithOpts(sctx *super.Context, r io.Reader, opts ReaderOpts) (zio.R
 		jsonErr,
 		lineErr,
 		parquetErr,
+		pcapErr,
 		supErr,
 		tsvErr,
 		zeekErr,
@@ -28,6 +28,7 @@ type WriterOpts struct {
 	JSON   jsonio.WriterOpts
 	Lake   lakeio.WriterOpts
 	SUP    supio.WriterOpts
+	Table  tableio.WriterOpts
 }
 
 func NewWriter(w io.WriteCloser, opts WriterOpts) (zio.WriteCloser, error) {
@@ -56,7 +57,7 @@ func NewWriter(w io.WriteCloser, opts WriterOpts) (zio.WriteCloser, error) {
 	case "sup", "":
 		return supio.NewWriter(w, opts.SUP), nil
 	case "table":
-		return tableio.NewWriter(w), nil
+		return tableio.NewWriter(w, opts.Table), nil
 	case "text":
 		return textio.NewWriter(w), nil
 	case "tsv":
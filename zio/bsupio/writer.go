@@ -6,6 +6,7 @@ import (
 	"slices"
 
 	"github.com/brimdata/super"
+	"github.com/brimdata/super/vector"
 	"github.com/brimdata/super/zcode"
 	"github.com/pierrec/lz4/v4"
 )
@@ -16,13 +17,15 @@ const DefaultFrameThresh = 512 * 1024
 type Writer struct {
 	writer     io.WriteCloser
 	position   int64
+	rawSize    int64
 	flushed    int64
 	compressor *compressor
 	opts       WriterOpts
 
-	types  *Encoder
-	values []byte
-	header []byte
+	types   *Encoder
+	values  []byte
+	header  []byte
+	builder *zcode.Builder
 }
 
 type WriterOpts struct {
@@ -83,6 +86,11 @@ func (w *Writer) Position() int64 {
 	return w.position
 }
 
+// RawSize returns the number of frame bytes written before compression.
+func (w *Writer) RawSize() int64 {
+	return w.rawSize
+}
+
 func (w *Writer) EndStream() error {
 	// Flush any compression state and write the EOS afterward the
 	// compressed block since the buffer-filter may skip entire
@@ -101,17 +109,63 @@ func (w *Writer) EndStream() error {
 }
 
 func (w *Writer) Write(val super.Value) error {
-	typ := w.types.Lookup(val.Type())
-	if typ == nil {
+	id, err := w.typeID(val.Type())
+	if err != nil {
+		return err
+	}
+	w.values = binary.AppendUvarint(w.values, uint64(id))
+	w.values = zcode.Append(w.values, val.Bytes())
+	return w.flushIfFull()
+}
+
+// WriteVector serializes each value in vec directly into the output stream
+// without materializing the values as super.Values.
+func (w *Writer) WriteVector(vec vector.Any) error {
+	if w.builder == nil {
+		w.builder = zcode.NewBuilder()
+	}
+	d, _ := vec.(*vector.Dynamic)
+	var id int
+	if d == nil {
 		var err error
-		typ, err = w.types.Encode(val.Type())
-		if err != nil {
+		if id, err = w.typeID(vec.Type()); err != nil {
 			return err
 		}
 	}
-	id := super.TypeID(typ)
-	w.values = binary.AppendUvarint(w.values, uint64(id))
-	w.values = zcode.Append(w.values, val.Bytes())
+	n := vec.Len()
+	for slot := uint32(0); slot < n; slot++ {
+		if d != nil {
+			var err error
+			if id, err = w.typeID(d.TypeOf(slot)); err != nil {
+				return err
+			}
+		}
+		w.builder.Truncate()
+		vec.Serialize(w.builder, slot)
+		w.values = binary.AppendUvarint(w.values, uint64(id))
+		w.values = append(w.values, w.builder.Bytes()...)
+		if err := w.flushIfFull(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// typeID returns the ID of typ in the output stream's type context, encoding
+// a definition of typ into the types frame if it has not been seen before.
+func (w *Writer) typeID(typ super.Type) (int, error) {
+	outType := w.types.Lookup(typ)
+	if outType == nil {
+		var err error
+		outType, err = w.types.Encode(typ)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return super.TypeID(outType), nil
+}
+
+func (w *Writer) flushIfFull() error {
 	if thresh := w.opts.FrameThresh; len(w.values) >= thresh || len(w.types.bytes) >= thresh {
 		return w.flush()
 	}
@@ -147,6 +201,7 @@ func (w *Writer) writeBlock(blockType int, b []byte) error {
 	if len(b) == 0 {
 		return nil
 	}
+	w.rawSize += int64(len(b))
 	if w.compressor != nil {
 		zbuf, err := w.compressor.compress(b)
 		if err != nil {
@@ -8,6 +8,8 @@ import (
 	"testing"
 
 	"github.com/brimdata/super"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/zbuf"
 	"github.com/brimdata/super/zio"
 	"github.com/brimdata/super/zio/supio"
 	"github.com/stretchr/testify/assert"
@@ -82,3 +84,36 @@ ff
 	require.NoError(t, zw.Close())
 	assert.Equal(t, expected, buf.Bytes())
 }
+
+func TestWriteVector(t *testing.T) {
+	t.Parallel()
+	const input = `
+{a:1,b:"foo"}
+{a:2,b:null(string)}
+"bar"
+{a:3,b:"baz"}
+[1,2,3]
+`
+	var vals []super.Value
+	builder := vector.NewDynamicBuilder()
+	zr := supio.NewReader(super.NewContext(), strings.NewReader(input))
+	for {
+		val, err := zr.Read()
+		require.NoError(t, err)
+		if val == nil {
+			break
+		}
+		vals = append(vals, val.Copy())
+		builder.Write(*val)
+	}
+	var expected bytes.Buffer
+	zw := NewWriter(zio.NopCloser(&expected))
+	require.NoError(t, zio.Copy(zw, zbuf.NewArray(vals)))
+	require.NoError(t, zw.Close())
+
+	var actual bytes.Buffer
+	zw = NewWriter(zio.NopCloser(&actual))
+	require.NoError(t, zw.WriteVector(builder.Build()))
+	require.NoError(t, zw.Close())
+	assert.Equal(t, expected.Bytes(), actual.Bytes())
+}
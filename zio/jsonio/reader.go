@@ -29,6 +29,11 @@ func NewReader(sctx *super.Context, r io.Reader) *Reader {
 	}
 }
 
+// Reset discards any buffered input and switches the reader to read from rd.
+func (r *Reader) Reset(rd io.Reader) {
+	r.lexer.Reset(rd)
+}
+
 func (r *Reader) Read() (*super.Value, error) {
 	t := r.lexer.Token()
 	if t == jsonlexer.TokenErr {
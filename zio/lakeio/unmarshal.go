@@ -23,6 +23,7 @@ func init() {
 		pools.Config{},
 		lake.BranchMeta{},
 		lake.BranchTip{},
+		lake.ExternalMeta{},
 		data.Object{},
 	)
 }
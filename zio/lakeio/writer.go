@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"maps"
+	"slices"
 	"strings"
 
 	"github.com/brimdata/super"
@@ -84,6 +86,8 @@ func (w *Writer) formatValue(t table, b *bytes.Buffer, v any, width int, colors
 		formatPoolConfig(b, v)
 	case *lake.BranchMeta:
 		formatBranchMeta(b, v, w.headID, w.headName, colors)
+	case *lake.ExternalMeta:
+		formatExternalMeta(b, v)
 	case data.Object:
 		formatDataObject(b, &v, "", 0)
 	case *data.Object:
@@ -112,9 +116,26 @@ func formatPoolConfig(b *bytes.Buffer, p *pools.Config) {
 	b.WriteString(p.SortKeys.Primary().Key.String())
 	b.WriteString(" order ")
 	b.WriteString(p.SortKeys.Primary().Order.String())
+	if !p.Partition.IsZero() {
+		b.WriteString(" partition ")
+		b.WriteString(p.Partition.String())
+	}
 	b.WriteByte('\n')
 }
 
+func formatExternalMeta(b *bytes.Buffer, e *lake.ExternalMeta) {
+	b.WriteString(e.External.Name)
+	b.WriteByte(' ')
+	b.WriteString(e.External.ID.String())
+	b.WriteString(" pattern ")
+	b.WriteString(e.External.Pattern)
+	if e.External.Format != "" {
+		b.WriteString(" format ")
+		b.WriteString(e.External.Format)
+	}
+	fmt.Fprintf(b, " files %d size %s\n", e.Files, units.Bytes(e.Bytes).Abbrev())
+}
+
 func formatBranchMeta(b *bytes.Buffer, p *lake.BranchMeta, headID ksuid.KSUID, headName string, colors *color.Stack) {
 	b.WriteString(p.Pool.Name)
 	b.WriteByte('@')
@@ -155,6 +176,10 @@ func formatDataObject(b *bytes.Buffer, object *data.Object, prefix string, inden
 	b.WriteString(sup.String(object.Min))
 	b.WriteString(" max ")
 	b.WriteString(sup.String(object.Max))
+	if !object.Partition.IsNull() {
+		b.WriteString(" partition ")
+		b.WriteString(sup.String(object.Partition))
+	}
 	b.WriteByte('\n')
 }
 
@@ -209,6 +234,26 @@ func (t table) formatCommit(b *bytes.Buffer, commit *commits.Commit, branches []
 	b.WriteString(commit.Author)
 	b.WriteString("\nDate:   ")
 	b.WriteString(commit.Date.String())
+	if prov := commit.Provenance; prov != nil && len(prov.Labels) > 0 {
+		b.WriteString("\nLabels: ")
+		keys := slices.Sorted(maps.Keys(prov.Labels))
+		for k, key := range keys {
+			if k != 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString(key)
+			b.WriteByte('=')
+			b.WriteString(prov.Labels[key])
+		}
+	}
+	if prov := commit.Provenance; prov != nil && prov.Key != "" {
+		b.WriteString("\nKey:    ")
+		b.WriteString(prov.Key)
+	}
+	if prov := commit.Provenance; prov != nil && prov.Hash != "" {
+		b.WriteString("\nHash:   ")
+		b.WriteString(prov.Hash)
+	}
 	b.WriteString("\n\n")
 	if commit.Message != "" {
 		s := charm.FormatParagraph(commit.Message, "    ", width)
@@ -0,0 +1,221 @@
+package otlpio
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// The types below model the subset of the OTLP messages that the reader
+// maps to values.  Their JSON tags follow the OTLP/HTTP JSON encoding, which
+// uses lowerCamelCase field names, hex-encoded trace and span IDs, and
+// strings or numbers for 64-bit integers.
+
+type logsRequest struct {
+	ResourceLogs []resourceLogs `json:"resourceLogs"`
+}
+
+type resourceLogs struct {
+	Resource  resource    `json:"resource"`
+	ScopeLogs []scopeLogs `json:"scopeLogs"`
+}
+
+type scopeLogs struct {
+	Scope      scope       `json:"scope"`
+	LogRecords []logRecord `json:"logRecords"`
+}
+
+type logRecord struct {
+	TimeUnixNano         jsonUint64 `json:"timeUnixNano"`
+	ObservedTimeUnixNano jsonUint64 `json:"observedTimeUnixNano"`
+	SeverityNumber       int32      `json:"severityNumber"`
+	SeverityText         string     `json:"severityText"`
+	Body                 *anyValue  `json:"body"`
+	Attributes           []keyValue `json:"attributes"`
+	Flags                uint32     `json:"flags"`
+	TraceID              hexID      `json:"traceId"`
+	SpanID               hexID      `json:"spanId"`
+}
+
+type tracesRequest struct {
+	ResourceSpans []resourceSpans `json:"resourceSpans"`
+}
+
+type resourceSpans struct {
+	Resource   resource     `json:"resource"`
+	ScopeSpans []scopeSpans `json:"scopeSpans"`
+}
+
+type scopeSpans struct {
+	Scope scope  `json:"scope"`
+	Spans []span `json:"spans"`
+}
+
+type span struct {
+	TraceID           hexID      `json:"traceId"`
+	SpanID            hexID      `json:"spanId"`
+	TraceState        string     `json:"traceState"`
+	ParentSpanID      hexID      `json:"parentSpanId"`
+	Name              string     `json:"name"`
+	Kind              int32      `json:"kind"`
+	StartTimeUnixNano jsonUint64 `json:"startTimeUnixNano"`
+	EndTimeUnixNano   jsonUint64 `json:"endTimeUnixNano"`
+	Attributes        []keyValue `json:"attributes"`
+	Events            []event    `json:"events"`
+	Links             []link     `json:"links"`
+	Status            status     `json:"status"`
+}
+
+type event struct {
+	TimeUnixNano jsonUint64 `json:"timeUnixNano"`
+	Name         string     `json:"name"`
+	Attributes   []keyValue `json:"attributes"`
+}
+
+type link struct {
+	TraceID    hexID      `json:"traceId"`
+	SpanID     hexID      `json:"spanId"`
+	TraceState string     `json:"traceState"`
+	Attributes []keyValue `json:"attributes"`
+}
+
+type status struct {
+	Message string `json:"message"`
+	Code    int32  `json:"code"`
+}
+
+type resource struct {
+	Attributes []keyValue `json:"attributes"`
+}
+
+type scope struct {
+	Name       string     `json:"name"`
+	Version    string     `json:"version"`
+	Attributes []keyValue `json:"attributes"`
+}
+
+type keyValue struct {
+	Key   string   `json:"key"`
+	Value anyValue `json:"value"`
+}
+
+type anyKind int
+
+const (
+	kindEmpty anyKind = iota
+	kindString
+	kindBool
+	kindInt
+	kindDouble
+	kindArray
+	kindKVList
+	kindBytes
+)
+
+// anyValue is an OTLP AnyValue, i.e., an attribute value or log body.
+type anyValue struct {
+	kind   anyKind
+	str    string
+	bool   bool
+	int    int64
+	double float64
+	array  []anyValue
+	kvlist []keyValue
+	bytes  []byte
+}
+
+func (a *anyValue) UnmarshalJSON(b []byte) error {
+	var v struct {
+		StringValue *string                      `json:"stringValue"`
+		BoolValue   *bool                        `json:"boolValue"`
+		IntValue    *jsonInt64                   `json:"intValue"`
+		DoubleValue *float64                     `json:"doubleValue"`
+		ArrayValue  *struct{ Values []anyValue } `json:"arrayValue"`
+		KVListValue *struct{ Values []keyValue } `json:"kvlistValue"`
+		BytesValue  []byte                       `json:"bytesValue"`
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	switch {
+	case v.StringValue != nil:
+		*a = anyValue{kind: kindString, str: *v.StringValue}
+	case v.BoolValue != nil:
+		*a = anyValue{kind: kindBool, bool: *v.BoolValue}
+	case v.IntValue != nil:
+		*a = anyValue{kind: kindInt, int: int64(*v.IntValue)}
+	case v.DoubleValue != nil:
+		*a = anyValue{kind: kindDouble, double: *v.DoubleValue}
+	case v.ArrayValue != nil:
+		*a = anyValue{kind: kindArray, array: v.ArrayValue.Values}
+	case v.KVListValue != nil:
+		*a = anyValue{kind: kindKVList, kvlist: v.KVListValue.Values}
+	case v.BytesValue != nil:
+		*a = anyValue{kind: kindBytes, bytes: v.BytesValue}
+	default:
+		*a = anyValue{}
+	}
+	return nil
+}
+
+// jsonUint64 is a uint64 encoded as a JSON number or string.
+type jsonUint64 uint64
+
+func (j *jsonUint64) UnmarshalJSON(b []byte) error {
+	s, err := unquoteNumber(b)
+	if err != nil || s == "" {
+		return err
+	}
+	u, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("bad unsigned integer %s", b)
+	}
+	*j = jsonUint64(u)
+	return nil
+}
+
+// jsonInt64 is an int64 encoded as a JSON number or string.
+type jsonInt64 int64
+
+func (j *jsonInt64) UnmarshalJSON(b []byte) error {
+	s, err := unquoteNumber(b)
+	if err != nil || s == "" {
+		return err
+	}
+	i, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("bad integer %s", b)
+	}
+	*j = jsonInt64(i)
+	return nil
+}
+
+func unquoteNumber(b []byte) (string, error) {
+	if len(b) > 0 && b[0] == '"' {
+		var s string
+		err := json.Unmarshal(b, &s)
+		return s, err
+	}
+	if string(b) == "null" {
+		return "", nil
+	}
+	return string(b), nil
+}
+
+// hexID is a trace or span ID, which the JSON encoding represents in hex.
+type hexID []byte
+
+func (h *hexID) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	id, err := hex.DecodeString(s)
+	if err != nil {
+		return errors.New("trace and span IDs must be hex strings")
+	}
+	*h = id
+	return nil
+}
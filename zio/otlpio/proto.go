@@ -0,0 +1,317 @@
+package otlpio
+
+import (
+	"math"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// The functions below decode the OTLP protobuf messages by field number
+// (see https://github.com/open-telemetry/opentelemetry-proto) and skip
+// fields they do not know.
+
+type protoField struct {
+	num   protowire.Number
+	typ   protowire.Type
+	u64   uint64
+	bytes []byte
+}
+
+func eachField(b []byte, fn func(protoField) error) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		f := protoField{num: num, typ: typ}
+		switch typ {
+		case protowire.VarintType:
+			f.u64, n = protowire.ConsumeVarint(b)
+		case protowire.Fixed32Type:
+			var v uint32
+			v, n = protowire.ConsumeFixed32(b)
+			f.u64 = uint64(v)
+		case protowire.Fixed64Type:
+			f.u64, n = protowire.ConsumeFixed64(b)
+		case protowire.BytesType:
+			f.bytes, n = protowire.ConsumeBytes(b)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		if err := fn(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func decodeLogsRequest(b []byte) (*logsRequest, error) {
+	var req logsRequest
+	err := eachField(b, func(f protoField) error {
+		if f.num == 1 {
+			var rl resourceLogs
+			if err := decodeResourceLogs(f.bytes, &rl); err != nil {
+				return err
+			}
+			req.ResourceLogs = append(req.ResourceLogs, rl)
+		}
+		return nil
+	})
+	return &req, err
+}
+
+func decodeResourceLogs(b []byte, rl *resourceLogs) error {
+	return eachField(b, func(f protoField) error {
+		switch f.num {
+		case 1:
+			return decodeResource(f.bytes, &rl.Resource)
+		case 2:
+			var sl scopeLogs
+			if err := decodeScopeLogs(f.bytes, &sl); err != nil {
+				return err
+			}
+			rl.ScopeLogs = append(rl.ScopeLogs, sl)
+		}
+		return nil
+	})
+}
+
+func decodeScopeLogs(b []byte, sl *scopeLogs) error {
+	return eachField(b, func(f protoField) error {
+		switch f.num {
+		case 1:
+			return decodeScope(f.bytes, &sl.Scope)
+		case 2:
+			var lr logRecord
+			if err := decodeLogRecord(f.bytes, &lr); err != nil {
+				return err
+			}
+			sl.LogRecords = append(sl.LogRecords, lr)
+		}
+		return nil
+	})
+}
+
+func decodeLogRecord(b []byte, lr *logRecord) error {
+	return eachField(b, func(f protoField) error {
+		switch f.num {
+		case 1:
+			lr.TimeUnixNano = jsonUint64(f.u64)
+		case 2:
+			lr.SeverityNumber = int32(f.u64)
+		case 3:
+			lr.SeverityText = string(f.bytes)
+		case 5:
+			lr.Body = &anyValue{}
+			return decodeAnyValue(f.bytes, lr.Body)
+		case 6:
+			return appendKeyValue(f.bytes, &lr.Attributes)
+		case 8:
+			lr.Flags = uint32(f.u64)
+		case 9:
+			lr.TraceID = f.bytes
+		case 10:
+			lr.SpanID = f.bytes
+		case 11:
+			lr.ObservedTimeUnixNano = jsonUint64(f.u64)
+		}
+		return nil
+	})
+}
+
+func decodeTracesRequest(b []byte) (*tracesRequest, error) {
+	var req tracesRequest
+	err := eachField(b, func(f protoField) error {
+		if f.num == 1 {
+			var rs resourceSpans
+			if err := decodeResourceSpans(f.bytes, &rs); err != nil {
+				return err
+			}
+			req.ResourceSpans = append(req.ResourceSpans, rs)
+		}
+		return nil
+	})
+	return &req, err
+}
+
+func decodeResourceSpans(b []byte, rs *resourceSpans) error {
+	return eachField(b, func(f protoField) error {
+		switch f.num {
+		case 1:
+			return decodeResource(f.bytes, &rs.Resource)
+		case 2:
+			var ss scopeSpans
+			if err := decodeScopeSpans(f.bytes, &ss); err != nil {
+				return err
+			}
+			rs.ScopeSpans = append(rs.ScopeSpans, ss)
+		}
+		return nil
+	})
+}
+
+func decodeScopeSpans(b []byte, ss *scopeSpans) error {
+	return eachField(b, func(f protoField) error {
+		switch f.num {
+		case 1:
+			return decodeScope(f.bytes, &ss.Scope)
+		case 2:
+			var s span
+			if err := decodeSpan(f.bytes, &s); err != nil {
+				return err
+			}
+			ss.Spans = append(ss.Spans, s)
+		}
+		return nil
+	})
+}
+
+func decodeSpan(b []byte, s *span) error {
+	return eachField(b, func(f protoField) error {
+		switch f.num {
+		case 1:
+			s.TraceID = f.bytes
+		case 2:
+			s.SpanID = f.bytes
+		case 3:
+			s.TraceState = string(f.bytes)
+		case 4:
+			s.ParentSpanID = f.bytes
+		case 5:
+			s.Name = string(f.bytes)
+		case 6:
+			s.Kind = int32(f.u64)
+		case 7:
+			s.StartTimeUnixNano = jsonUint64(f.u64)
+		case 8:
+			s.EndTimeUnixNano = jsonUint64(f.u64)
+		case 9:
+			return appendKeyValue(f.bytes, &s.Attributes)
+		case 11:
+			var e event
+			err := eachField(f.bytes, func(f protoField) error {
+				switch f.num {
+				case 1:
+					e.TimeUnixNano = jsonUint64(f.u64)
+				case 2:
+					e.Name = string(f.bytes)
+				case 3:
+					return appendKeyValue(f.bytes, &e.Attributes)
+				}
+				return nil
+			})
+			s.Events = append(s.Events, e)
+			return err
+		case 13:
+			var l link
+			err := eachField(f.bytes, func(f protoField) error {
+				switch f.num {
+				case 1:
+					l.TraceID = f.bytes
+				case 2:
+					l.SpanID = f.bytes
+				case 3:
+					l.TraceState = string(f.bytes)
+				case 4:
+					return appendKeyValue(f.bytes, &l.Attributes)
+				}
+				return nil
+			})
+			s.Links = append(s.Links, l)
+			return err
+		case 15:
+			return eachField(f.bytes, func(f protoField) error {
+				switch f.num {
+				case 2:
+					s.Status.Message = string(f.bytes)
+				case 3:
+					s.Status.Code = int32(f.u64)
+				}
+				return nil
+			})
+		}
+		return nil
+	})
+}
+
+func decodeResource(b []byte, r *resource) error {
+	return eachField(b, func(f protoField) error {
+		if f.num == 1 {
+			return appendKeyValue(f.bytes, &r.Attributes)
+		}
+		return nil
+	})
+}
+
+func decodeScope(b []byte, s *scope) error {
+	return eachField(b, func(f protoField) error {
+		switch f.num {
+		case 1:
+			s.Name = string(f.bytes)
+		case 2:
+			s.Version = string(f.bytes)
+		case 3:
+			return appendKeyValue(f.bytes, &s.Attributes)
+		}
+		return nil
+	})
+}
+
+func appendKeyValue(b []byte, kvs *[]keyValue) error {
+	var kv keyValue
+	err := eachField(b, func(f protoField) error {
+		switch f.num {
+		case 1:
+			kv.Key = string(f.bytes)
+		case 2:
+			return decodeAnyValue(f.bytes, &kv.Value)
+		}
+		return nil
+	})
+	*kvs = append(*kvs, kv)
+	return err
+}
+
+func decodeAnyValue(b []byte, a *anyValue) error {
+	return eachField(b, func(f protoField) error {
+		switch f.num {
+		case 1:
+			*a = anyValue{kind: kindString, str: string(f.bytes)}
+		case 2:
+			*a = anyValue{kind: kindBool, bool: f.u64 != 0}
+		case 3:
+			*a = anyValue{kind: kindInt, int: int64(f.u64)}
+		case 4:
+			*a = anyValue{kind: kindDouble, double: math.Float64frombits(f.u64)}
+		case 5:
+			*a = anyValue{kind: kindArray}
+			return eachField(f.bytes, func(f protoField) error {
+				if f.num != 1 {
+					return nil
+				}
+				var elem anyValue
+				if err := decodeAnyValue(f.bytes, &elem); err != nil {
+					return err
+				}
+				a.array = append(a.array, elem)
+				return nil
+			})
+		case 6:
+			*a = anyValue{kind: kindKVList}
+			return eachField(f.bytes, func(f protoField) error {
+				if f.num != 1 {
+					return nil
+				}
+				return appendKeyValue(f.bytes, &a.kvlist)
+			})
+		case 7:
+			*a = anyValue{kind: kindBytes, bytes: append([]byte{}, f.bytes...)}
+		}
+		return nil
+	})
+}
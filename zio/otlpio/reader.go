@@ -0,0 +1,301 @@
+// Package otlpio decodes OpenTelemetry Protocol (OTLP) export requests for
+// logs and traces, in either the protobuf or JSON encoding of OTLP/HTTP, into
+// values with one value per log record or span.
+package otlpio
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/zcode"
+)
+
+type Signal string
+
+const (
+	Logs   Signal = "logs"
+	Traces Signal = "traces"
+)
+
+type Encoding string
+
+const (
+	JSON     Encoding = "json"
+	Protobuf Encoding = "protobuf"
+)
+
+var spanKinds = []string{"unspecified", "internal", "server", "client", "producer", "consumer"}
+
+var statusCodes = []string{"unset", "ok", "error"}
+
+// Reader reads the values of an export request.
+type Reader struct {
+	sctx *super.Context
+	vals []super.Value
+}
+
+// NewReader decodes the export request for signal read from r.
+func NewReader(sctx *super.Context, r io.Reader, signal Signal, encoding Encoding) (*Reader, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	reader := &Reader{sctx: sctx}
+	switch signal {
+	case Logs:
+		req, err := decode(b, encoding, decodeLogsRequest)
+		if err != nil {
+			return nil, err
+		}
+		reader.addLogs(req)
+	case Traces:
+		req, err := decode(b, encoding, decodeTracesRequest)
+		if err != nil {
+			return nil, err
+		}
+		reader.addTraces(req)
+	default:
+		return nil, fmt.Errorf("unknown OTLP signal %q", signal)
+	}
+	return reader, nil
+}
+
+func decode[T any](b []byte, encoding Encoding, decodeProto func([]byte) (*T, error)) (*T, error) {
+	switch encoding {
+	case JSON:
+		var req T
+		if err := json.Unmarshal(b, &req); err != nil {
+			return nil, fmt.Errorf("OTLP JSON: %w", err)
+		}
+		return &req, nil
+	case Protobuf:
+		req, err := decodeProto(b)
+		if err != nil {
+			return nil, fmt.Errorf("OTLP protobuf: %w", err)
+		}
+		return req, nil
+	}
+	return nil, fmt.Errorf("unknown OTLP encoding %q", encoding)
+}
+
+func (r *Reader) Read() (*super.Value, error) {
+	if len(r.vals) == 0 {
+		return nil, nil
+	}
+	val := r.vals[0]
+	r.vals = r.vals[1:]
+	return &val, nil
+}
+
+func (r *Reader) addLogs(req *logsRequest) {
+	for _, rl := range req.ResourceLogs {
+		res := r.attributes(rl.Resource.Attributes)
+		for _, sl := range rl.ScopeLogs {
+			scope := r.scope(sl.Scope)
+			for _, lr := range sl.LogRecords {
+				ts := lr.TimeUnixNano
+				if ts == 0 {
+					ts = lr.ObservedTimeUnixNano
+				}
+				var rec record
+				rec.add("time", timeValue(ts))
+				rec.add("observed_time", timeValue(lr.ObservedTimeUnixNano))
+				rec.add("severity_number", super.NewInt32(lr.SeverityNumber))
+				rec.add("severity_text", super.NewString(lr.SeverityText))
+				body := super.Null
+				if lr.Body != nil {
+					body = r.anyValue(*lr.Body)
+				}
+				rec.add("body", body)
+				rec.add("attributes", r.attributes(lr.Attributes))
+				rec.add("trace_id", idValue(lr.TraceID))
+				rec.add("span_id", idValue(lr.SpanID))
+				rec.add("flags", super.NewUint32(lr.Flags))
+				rec.add("resource", res)
+				rec.add("scope", scope)
+				r.vals = append(r.vals, rec.value(r.sctx))
+			}
+		}
+	}
+}
+
+func (r *Reader) addTraces(req *tracesRequest) {
+	for _, rs := range req.ResourceSpans {
+		res := r.attributes(rs.Resource.Attributes)
+		for _, ss := range rs.ScopeSpans {
+			scope := r.scope(ss.Scope)
+			for _, s := range ss.Spans {
+				var rec record
+				rec.add("trace_id", idValue(s.TraceID))
+				rec.add("span_id", idValue(s.SpanID))
+				rec.add("parent_span_id", idValue(s.ParentSpanID))
+				rec.add("trace_state", super.NewString(s.TraceState))
+				rec.add("name", super.NewString(s.Name))
+				rec.add("kind", super.NewString(enumName(spanKinds, s.Kind)))
+				rec.add("start_time", timeValue(s.StartTimeUnixNano))
+				rec.add("end_time", timeValue(s.EndTimeUnixNano))
+				rec.add("duration", super.NewDuration(nano.Duration(s.EndTimeUnixNano-s.StartTimeUnixNano)))
+				rec.add("attributes", r.attributes(s.Attributes))
+				var events []super.Value
+				for _, e := range s.Events {
+					var rec record
+					rec.add("time", timeValue(e.TimeUnixNano))
+					rec.add("name", super.NewString(e.Name))
+					rec.add("attributes", r.attributes(e.Attributes))
+					events = append(events, rec.value(r.sctx))
+				}
+				rec.add("events", r.array(events))
+				var links []super.Value
+				for _, l := range s.Links {
+					var rec record
+					rec.add("trace_id", idValue(l.TraceID))
+					rec.add("span_id", idValue(l.SpanID))
+					rec.add("attributes", r.attributes(l.Attributes))
+					links = append(links, rec.value(r.sctx))
+				}
+				rec.add("links", r.array(links))
+				var status record
+				status.add("code", super.NewString(enumName(statusCodes, s.Status.Code)))
+				status.add("message", super.NewString(s.Status.Message))
+				rec.add("status", status.value(r.sctx))
+				rec.add("resource", res)
+				rec.add("scope", scope)
+				r.vals = append(r.vals, rec.value(r.sctx))
+			}
+		}
+	}
+}
+
+func (r *Reader) scope(s scope) super.Value {
+	var rec record
+	rec.add("name", super.NewString(s.Name))
+	rec.add("version", super.NewString(s.Version))
+	rec.add("attributes", r.attributes(s.Attributes))
+	return rec.value(r.sctx)
+}
+
+// attributes returns a record of the key-value pairs in kvs.  A key
+// appearing more than once takes its last value.
+func (r *Reader) attributes(kvs []keyValue) super.Value {
+	var rec record
+	index := make(map[string]int)
+	for _, kv := range kvs {
+		val := r.anyValue(kv.Value)
+		if i, ok := index[kv.Key]; ok {
+			rec.vals[i] = val
+			rec.fields[i].Type = val.Type()
+			continue
+		}
+		index[kv.Key] = len(rec.fields)
+		rec.add(kv.Key, val)
+	}
+	return rec.value(r.sctx)
+}
+
+func (r *Reader) anyValue(a anyValue) super.Value {
+	switch a.kind {
+	case kindString:
+		return super.NewString(a.str)
+	case kindBool:
+		return super.NewBool(a.bool)
+	case kindInt:
+		return super.NewInt64(a.int)
+	case kindDouble:
+		return super.NewFloat64(a.double)
+	case kindBytes:
+		return super.NewBytes(a.bytes)
+	case kindArray:
+		vals := make([]super.Value, 0, len(a.array))
+		for _, elem := range a.array {
+			vals = append(vals, r.anyValue(elem))
+		}
+		return r.array(vals)
+	case kindKVList:
+		return r.attributes(a.kvlist)
+	}
+	return super.Null
+}
+
+// array returns an array of vals, whose elements are a union if vals vary
+// in type.
+func (r *Reader) array(vals []super.Value) super.Value {
+	var types []super.Type
+	seen := make(map[super.Type]bool)
+	for _, val := range vals {
+		if !seen[val.Type()] {
+			seen[val.Type()] = true
+			types = append(types, val.Type())
+		}
+	}
+	var b zcode.Builder
+	switch len(types) {
+	case 0:
+		return super.NewValue(r.sctx.LookupTypeArray(super.TypeNull), zcode.Bytes{})
+	case 1:
+		for _, val := range vals {
+			b.Append(val.Bytes())
+		}
+		return super.NewValue(r.sctx.LookupTypeArray(types[0]), b.Bytes())
+	}
+	union := r.sctx.LookupTypeUnion(types)
+	for _, val := range vals {
+		super.BuildUnion(&b, union.TagOf(val.Type()), val.Bytes())
+	}
+	return super.NewValue(r.sctx.LookupTypeArray(union), b.Bytes())
+}
+
+type record struct {
+	fields []super.Field
+	vals   []super.Value
+}
+
+func (r *record) add(name string, val super.Value) {
+	r.fields = append(r.fields, super.NewField(name, val.Type()))
+	r.vals = append(r.vals, val)
+}
+
+func (r *record) value(sctx *super.Context) super.Value {
+	var b zcode.Builder
+	for _, val := range r.vals {
+		b.Append(val.Bytes())
+	}
+	typ, err := sctx.LookupTypeRecord(r.fields)
+	if err != nil {
+		// The field names of r are unique, so this cannot happen.
+		panic(err)
+	}
+	bytes := b.Bytes()
+	if bytes == nil {
+		bytes = zcode.Bytes{}
+	}
+	return super.NewValue(typ, bytes)
+}
+
+// timeValue returns the time at nanoseconds ns since the epoch, where zero
+// means that the time is unknown.
+func timeValue(ns jsonUint64) super.Value {
+	if ns == 0 {
+		return super.NewValue(super.TypeTime, nil)
+	}
+	return super.NewTime(nano.Ts(ns))
+}
+
+// idValue returns a trace or span ID in hex, as OTLP JSON represents it,
+// where an empty ID is null.
+func idValue(id []byte) super.Value {
+	if len(id) == 0 {
+		return super.NewValue(super.TypeString, nil)
+	}
+	return super.NewString(hex.EncodeToString(id))
+}
+
+func enumName(names []string, n int32) string {
+	if n >= 0 && int(n) < len(names) {
+		return names[n]
+	}
+	return fmt.Sprint(n)
+}
@@ -0,0 +1,141 @@
+package otlpio
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/sup"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// message appends the fields of a protobuf message, each of which is
+// appended by a function.
+func message(fields ...func([]byte) []byte) []byte {
+	var b []byte
+	for _, f := range fields {
+		b = f(b)
+	}
+	return b
+}
+
+func bytesField(num protowire.Number, v []byte) func([]byte) []byte {
+	return func(b []byte) []byte {
+		b = protowire.AppendTag(b, num, protowire.BytesType)
+		return protowire.AppendBytes(b, v)
+	}
+}
+
+func stringField(num protowire.Number, s string) func([]byte) []byte {
+	return bytesField(num, []byte(s))
+}
+
+func varintField(num protowire.Number, v uint64) func([]byte) []byte {
+	return func(b []byte) []byte {
+		b = protowire.AppendTag(b, num, protowire.VarintType)
+		return protowire.AppendVarint(b, v)
+	}
+}
+
+func fixed64Field(num protowire.Number, v uint64) func([]byte) []byte {
+	return func(b []byte) []byte {
+		b = protowire.AppendTag(b, num, protowire.Fixed64Type)
+		return protowire.AppendFixed64(b, v)
+	}
+}
+
+// attribute appends a KeyValue as field num.
+func attribute(num protowire.Number, key string, value []byte) func([]byte) []byte {
+	return bytesField(num, message(stringField(1, key), bytesField(2, value)))
+}
+
+func TestLogs(t *testing.T) {
+	const expected = `{time:2024-01-01T00:00:00Z,observed_time:2024-01-01T00:00:01Z,severity_number:9(int32),severity_text:"INFO",body:{msg:"hello",vals:[1,2.5,0x01ff]},attributes:{port:-1,ok:true},trace_id:"0102",span_id:null(string),flags:1(uint32),resource:{"service.name":"api"},scope:{name:"app",version:"",attributes:{}}}`
+	body := message(bytesField(6, message(
+		attribute(1, "msg", message(stringField(1, "hello"))),
+		attribute(1, "vals", message(bytesField(5, message(
+			bytesField(1, message(varintField(3, 1))),
+			bytesField(1, message(fixed64Field(4, math.Float64bits(2.5)))),
+			bytesField(1, message(bytesField(7, []byte{1, 0xff}))),
+		)))),
+	)))
+	logRecord := message(
+		fixed64Field(1, 1704067200000000000),
+		fixed64Field(11, 1704067201000000000),
+		varintField(2, 9),
+		stringField(3, "INFO"),
+		bytesField(5, body),
+		attribute(6, "port", message(varintField(3, math.MaxUint64))),
+		attribute(6, "ok", message(varintField(2, 1))),
+		func(b []byte) []byte {
+			b = protowire.AppendTag(b, 8, protowire.Fixed32Type)
+			return protowire.AppendFixed32(b, 1)
+		},
+		bytesField(9, []byte{1, 2}),
+		// An unknown field is skipped.
+		stringField(100, "unknown"),
+	)
+	req := message(bytesField(1, message(
+		bytesField(1, message(attribute(1, "service.name", message(stringField(1, "api"))))),
+		bytesField(2, message(
+			bytesField(1, message(stringField(1, "app"))),
+			bytesField(2, logRecord),
+		)),
+	)))
+	require.Equal(t, []string{expected}, readAll(t, req, Logs, Protobuf))
+
+	json := `{"resourceLogs":[{"resource":{"attributes":[{"key":"service.name","value":{"stringValue":"api"}}]},"scopeLogs":[{"scope":{"name":"app"},"logRecords":[{
+		"timeUnixNano":"1704067200000000000","observedTimeUnixNano":1704067201000000000,"severityNumber":9,"severityText":"INFO",
+		"body":{"kvlistValue":{"values":[{"key":"msg","value":{"stringValue":"hello"}},{"key":"vals","value":{"arrayValue":{"values":[{"intValue":"1"},{"doubleValue":2.5},{"bytesValue":"Af8="}]}}}]}},
+		"attributes":[{"key":"port","value":{"intValue":-1}},{"key":"ok","value":{"boolValue":true}}],
+		"flags":1,"traceId":"0102","spanId":""}]}]}]}`
+	require.Equal(t, []string{expected}, readAll(t, []byte(json), Logs, JSON))
+}
+
+func TestTraces(t *testing.T) {
+	const expected = `{trace_id:"01",span_id:"02",parent_span_id:"03",trace_state:"",name:"GET /",kind:"client",start_time:2024-01-01T00:00:00Z,end_time:2024-01-01T00:00:00.5Z,duration:500ms,attributes:{"http.status_code":200},events:[{time:2024-01-01T00:00:00.1Z,name:"retry",attributes:{}}],links:[]([null]),status:{code:"ok",message:""},resource:{},scope:{name:"",version:"",attributes:{}}}`
+	span := message(
+		bytesField(1, []byte{1}),
+		bytesField(2, []byte{2}),
+		bytesField(4, []byte{3}),
+		stringField(5, "GET /"),
+		varintField(6, 3),
+		fixed64Field(7, 1704067200000000000),
+		fixed64Field(8, 1704067200500000000),
+		attribute(9, "http.status_code", message(varintField(3, 200))),
+		bytesField(11, message(fixed64Field(1, 1704067200100000000), stringField(2, "retry"))),
+		bytesField(15, message(varintField(3, 1))),
+	)
+	req := message(bytesField(1, message(bytesField(2, message(bytesField(2, span))))))
+	require.Equal(t, []string{expected}, readAll(t, req, Traces, Protobuf))
+
+	json := `{"resourceSpans":[{"scopeSpans":[{"spans":[{"traceId":"01","spanId":"02","parentSpanId":"03","name":"GET /","kind":3,
+		"startTimeUnixNano":"1704067200000000000","endTimeUnixNano":"1704067200500000000",
+		"attributes":[{"key":"http.status_code","value":{"intValue":"200"}}],
+		"events":[{"timeUnixNano":"1704067200100000000","name":"retry"}],"status":{"code":1}}]}]}]}`
+	require.Equal(t, []string{expected}, readAll(t, []byte(json), Traces, JSON))
+}
+
+func TestErrors(t *testing.T) {
+	_, err := NewReader(super.NewContext(), strings.NewReader(`{"resourceLogs":[{"scopeLogs":[{"logRecords":[{"traceId":"xyz"}]}]}]}`), Logs, JSON)
+	require.ErrorContains(t, err, "OTLP JSON: trace and span IDs must be hex strings")
+	_, err = NewReader(super.NewContext(), bytes.NewReader([]byte{0x0a, 0x05, 0x01}), Logs, Protobuf)
+	require.ErrorContains(t, err, "OTLP protobuf: ")
+}
+
+func readAll(t *testing.T, b []byte, signal Signal, encoding Encoding) []string {
+	r, err := NewReader(super.NewContext(), bytes.NewReader(b), signal, encoding)
+	require.NoError(t, err)
+	var vals []string
+	for {
+		val, err := r.Read()
+		require.NoError(t, err)
+		if val == nil {
+			return vals
+		}
+		vals = append(vals, sup.FormatValue(*val))
+	}
+}
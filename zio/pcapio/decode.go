@@ -0,0 +1,383 @@
+package pcapio
+
+import (
+	"encoding/binary"
+	"net"
+	"net/netip"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/zcode"
+)
+
+// Link types from https://www.tcpdump.org/linktypes.html.
+const (
+	linkNull     = 0
+	linkEthernet = 1
+	linkRaw      = 101
+	linkLoop     = 108
+	linkSLL      = 113
+	linkIPv4     = 228
+	linkIPv6     = 229
+	linkSLL2     = 276
+	// Some systems use these DLT values for raw IP in pcap headers.
+	linkRawAlt1 = 12
+	linkRawAlt2 = 14
+)
+
+const (
+	etherTypeIPv4 = 0x0800
+	etherTypeARP  = 0x0806
+	etherTypeVLAN = 0x8100
+	etherTypeQinQ = 0x88a8
+	etherTypeIPv6 = 0x86dd
+)
+
+const (
+	protoICMP   = 1
+	protoTCP    = 6
+	protoUDP    = 17
+	protoICMPv6 = 58
+)
+
+// A packet holds the decoded layers of a packet.  A layer that is absent
+// or truncated has a false has field.
+type packet struct {
+	ts      nano.Ts
+	origLen uint32
+	capLen  uint32
+
+	hasEth  bool
+	ethSrc  net.HardwareAddr
+	ethDst  net.HardwareAddr
+	ethType uint16
+	hasVLAN bool
+	vlan    uint16
+
+	hasIP   bool
+	version uint8
+	src     netip.Addr
+	dst     netip.Addr
+	proto   uint8
+	ttl     uint8
+	ipLen   uint16
+
+	hasTCP   bool
+	hasUDP   bool
+	srcPort  uint16
+	dstPort  uint16
+	seq      uint32
+	ack      uint32
+	tcpFlags uint8
+	window   uint16
+	udpLen   uint16
+
+	hasICMP  bool
+	icmpType uint8
+	icmpCode uint8
+
+	payload []byte
+}
+
+func (p *packet) decode(ts nano.Ts, origLen uint32, data []byte, linkType uint32) {
+	*p = packet{ts: ts, origLen: origLen, capLen: uint32(len(data))}
+	switch linkType {
+	case linkEthernet:
+		p.decodeEthernet(data)
+	case linkNull, linkLoop:
+		if len(data) < 4 {
+			p.payload = data
+			return
+		}
+		// The address family is in the byte order of the capturing host.
+		family := binary.LittleEndian.Uint32(data)
+		if family > 0xffff {
+			family = binary.BigEndian.Uint32(data)
+		}
+		switch family {
+		case 2:
+			p.decodeIPv4(data[4:])
+		case 10, 24, 28, 30:
+			p.decodeIPv6(data[4:])
+		default:
+			p.payload = data[4:]
+		}
+	case linkRaw, linkRawAlt1, linkRawAlt2:
+		p.decodeIP(data)
+	case linkIPv4:
+		p.decodeIPv4(data)
+	case linkIPv6:
+		p.decodeIPv6(data)
+	case linkSLL:
+		if len(data) < 16 {
+			p.payload = data
+			return
+		}
+		p.decodeNetwork(binary.BigEndian.Uint16(data[14:]), data[16:])
+	case linkSLL2:
+		if len(data) < 20 {
+			p.payload = data
+			return
+		}
+		p.decodeNetwork(binary.BigEndian.Uint16(data), data[20:])
+	default:
+		p.payload = data
+	}
+}
+
+func (p *packet) decodeEthernet(data []byte) {
+	if len(data) < 14 {
+		p.payload = data
+		return
+	}
+	p.hasEth = true
+	p.ethDst, p.ethSrc = net.HardwareAddr(data[0:6]), net.HardwareAddr(data[6:12])
+	typ := binary.BigEndian.Uint16(data[12:])
+	data = data[14:]
+	for (typ == etherTypeVLAN || typ == etherTypeQinQ) && len(data) >= 4 {
+		// With stacked tags, report the outermost VLAN.
+		if !p.hasVLAN {
+			p.hasVLAN = true
+			p.vlan = binary.BigEndian.Uint16(data) & 0x0fff
+		}
+		typ = binary.BigEndian.Uint16(data[2:])
+		data = data[4:]
+	}
+	p.ethType = typ
+	p.decodeNetwork(typ, data)
+}
+
+func (p *packet) decodeNetwork(etherType uint16, data []byte) {
+	switch etherType {
+	case etherTypeIPv4:
+		p.decodeIPv4(data)
+	case etherTypeIPv6:
+		p.decodeIPv6(data)
+	default:
+		p.payload = data
+	}
+}
+
+func (p *packet) decodeIP(data []byte) {
+	if len(data) > 0 && data[0]>>4 == 6 {
+		p.decodeIPv6(data)
+	} else {
+		p.decodeIPv4(data)
+	}
+}
+
+func (p *packet) decodeIPv4(data []byte) {
+	if len(data) < 20 || data[0]>>4 != 4 {
+		p.payload = data
+		return
+	}
+	hlen := int(data[0]&0x0f) * 4
+	if hlen < 20 || hlen > len(data) {
+		p.payload = data
+		return
+	}
+	p.hasIP = true
+	p.version = 4
+	p.ipLen = binary.BigEndian.Uint16(data[2:])
+	p.ttl = data[8]
+	p.proto = data[9]
+	p.src = netip.AddrFrom4([4]byte(data[12:16]))
+	p.dst = netip.AddrFrom4([4]byte(data[16:20]))
+	if n := int(p.ipLen); n >= hlen && n < len(data) {
+		// Drop the Ethernet trailer.
+		data = data[:n]
+	}
+	fragOffset := binary.BigEndian.Uint16(data[6:]) & 0x1fff
+	data = data[hlen:]
+	if fragOffset != 0 {
+		// Only the first fragment holds the transport header.
+		p.payload = data
+		return
+	}
+	p.decodeTransport(data)
+}
+
+func (p *packet) decodeIPv6(data []byte) {
+	if len(data) < 40 || data[0]>>4 != 6 {
+		p.payload = data
+		return
+	}
+	p.hasIP = true
+	p.version = 6
+	p.ipLen = binary.BigEndian.Uint16(data[4:])
+	next := data[6]
+	p.ttl = data[7]
+	p.src = netip.AddrFrom16([16]byte(data[8:24]))
+	p.dst = netip.AddrFrom16([16]byte(data[24:40]))
+	if n := 40 + int(p.ipLen); n < len(data) {
+		data = data[:n]
+	}
+	data = data[40:]
+	// Skip extension headers to find the transport protocol.
+loop:
+	for {
+		switch next {
+		case 0, 43, 60: // hop-by-hop, routing, destination options
+			if len(data) < 8 {
+				break loop
+			}
+			n := (int(data[1]) + 1) * 8
+			if n > len(data) {
+				break loop
+			}
+			next, data = data[0], data[n:]
+		case 44: // fragment
+			if len(data) < 8 {
+				break loop
+			}
+			offset := binary.BigEndian.Uint16(data[2:]) >> 3
+			next, data = data[0], data[8:]
+			if offset != 0 {
+				p.proto = next
+				p.payload = data
+				return
+			}
+		default:
+			break loop
+		}
+	}
+	p.proto = next
+	p.decodeTransport(data)
+}
+
+func (p *packet) decodeTransport(data []byte) {
+	switch p.proto {
+	case protoTCP:
+		if len(data) < 20 {
+			break
+		}
+		off := int(data[12]>>4) * 4
+		if off < 20 || off > len(data) {
+			break
+		}
+		p.hasTCP = true
+		p.srcPort = binary.BigEndian.Uint16(data)
+		p.dstPort = binary.BigEndian.Uint16(data[2:])
+		p.seq = binary.BigEndian.Uint32(data[4:])
+		p.ack = binary.BigEndian.Uint32(data[8:])
+		p.tcpFlags = data[13]
+		p.window = binary.BigEndian.Uint16(data[14:])
+		data = data[off:]
+	case protoUDP:
+		if len(data) < 8 {
+			break
+		}
+		p.hasUDP = true
+		p.srcPort = binary.BigEndian.Uint16(data)
+		p.dstPort = binary.BigEndian.Uint16(data[2:])
+		p.udpLen = binary.BigEndian.Uint16(data[4:])
+		data = data[8:]
+	case protoICMP, protoICMPv6:
+		if len(data) < 4 {
+			break
+		}
+		p.hasICMP = true
+		p.icmpType, p.icmpCode = data[0], data[1]
+		// The rest of the header, e.g., the identifier and sequence
+		// number of an echo, is part of the payload.
+		data = data[4:]
+	}
+	p.payload = data
+}
+
+// tcpFlags formats TCP flags as tcpdump does, e.g., "S." for SYN-ACK.
+func tcpFlags(flags uint8) string {
+	var b []byte
+	for _, f := range []struct {
+		bit  uint8
+		char byte
+	}{
+		{0x02, 'S'},
+		{0x01, 'F'},
+		{0x08, 'P'},
+		{0x04, 'R'},
+		{0x20, 'U'},
+		{0x40, 'E'},
+		{0x80, 'W'},
+		{0x10, '.'},
+	} {
+		if flags&f.bit != 0 {
+			b = append(b, f.char)
+		}
+	}
+	if len(b) == 0 {
+		return "none"
+	}
+	return string(b)
+}
+
+func (p *packet) value(typ super.Type, b *zcode.Builder) super.Value {
+	b.Truncate()
+	b.BeginContainer()
+	b.Append(super.EncodeTime(p.ts))
+	b.Append(super.EncodeUint(uint64(p.origLen)))
+	b.Append(super.EncodeUint(uint64(p.capLen)))
+	if p.hasEth {
+		b.BeginContainer()
+		b.Append(super.EncodeString(p.ethSrc.String()))
+		b.Append(super.EncodeString(p.ethDst.String()))
+		b.Append(super.EncodeUint(uint64(p.ethType)))
+		if p.hasVLAN {
+			b.Append(super.EncodeUint(uint64(p.vlan)))
+		} else {
+			b.Append(nil)
+		}
+		b.EndContainer()
+	} else {
+		b.Append(nil)
+	}
+	if p.hasIP {
+		b.BeginContainer()
+		b.Append(super.EncodeUint(uint64(p.version)))
+		b.Append(super.EncodeIP(p.src))
+		b.Append(super.EncodeIP(p.dst))
+		b.Append(super.EncodeUint(uint64(p.proto)))
+		b.Append(super.EncodeUint(uint64(p.ttl)))
+		b.Append(super.EncodeUint(uint64(p.ipLen)))
+		b.EndContainer()
+	} else {
+		b.Append(nil)
+	}
+	if p.hasTCP {
+		b.BeginContainer()
+		b.Append(super.EncodeUint(uint64(p.srcPort)))
+		b.Append(super.EncodeUint(uint64(p.dstPort)))
+		b.Append(super.EncodeUint(uint64(p.seq)))
+		b.Append(super.EncodeUint(uint64(p.ack)))
+		b.Append(super.EncodeString(tcpFlags(p.tcpFlags)))
+		b.Append(super.EncodeUint(uint64(p.window)))
+		b.EndContainer()
+	} else {
+		b.Append(nil)
+	}
+	if p.hasUDP {
+		b.BeginContainer()
+		b.Append(super.EncodeUint(uint64(p.srcPort)))
+		b.Append(super.EncodeUint(uint64(p.dstPort)))
+		b.Append(super.EncodeUint(uint64(p.udpLen)))
+		b.EndContainer()
+	} else {
+		b.Append(nil)
+	}
+	if p.hasICMP {
+		b.BeginContainer()
+		b.Append(super.EncodeUint(uint64(p.icmpType)))
+		b.Append(super.EncodeUint(uint64(p.icmpCode)))
+		b.EndContainer()
+	} else {
+		b.Append(nil)
+	}
+	payload := p.payload
+	if payload == nil {
+		// An empty payload is not null.
+		payload = []byte{}
+	}
+	b.Append(super.EncodeBytes(payload))
+	b.EndContainer()
+	return super.NewValue(typ, b.Bytes().Body())
+}
@@ -0,0 +1,310 @@
+package pcapio
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+// A filter selects packets with a subset of the tcpdump filter language
+// (see pcap-filter(7)) comprising the primitives
+//
+//	[ip|ip6|tcp|udp] [src|dst] host ADDR
+//	[ip|ip6|tcp|udp] [src|dst] net CIDR
+//	[tcp|udp] [src|dst] port PORT
+//	[tcp|udp] [src|dst] portrange PORT-PORT
+//	ip | ip6 | tcp | udp | icmp | icmp6 | arp | vlan [ID]
+//	greater LEN | less LEN
+//
+// combined with "and" ("&&"), "or" ("||"), "not" ("!"), and parentheses.
+// Filtering happens as packets are decoded, before they are converted to
+// values.
+type filter interface {
+	match(*packet) bool
+}
+
+type (
+	and struct{ lhs, rhs filter }
+	or  struct{ lhs, rhs filter }
+	not struct{ filter }
+	// predicate is a primitive.
+	predicate func(*packet) bool
+)
+
+func (a and) match(p *packet) bool       { return a.lhs.match(p) && a.rhs.match(p) }
+func (o or) match(p *packet) bool        { return o.lhs.match(p) || o.rhs.match(p) }
+func (n not) match(p *packet) bool       { return !n.filter.match(p) }
+func (f predicate) match(p *packet) bool { return f(p) }
+
+// parseFilter parses s into a filter.  It returns nil for an empty s.
+func parseFilter(s string) (filter, error) {
+	tokens, err := tokenize(s)
+	if err != nil || len(tokens) == 0 {
+		return nil, err
+	}
+	fp := &filterParser{tokens: tokens}
+	f, err := fp.parseOr()
+	if err != nil {
+		return nil, fmt.Errorf("pcap filter: %w", err)
+	}
+	if tok := fp.peek(); tok != "" {
+		return nil, fmt.Errorf("pcap filter: unexpected %q", tok)
+	}
+	return f, nil
+}
+
+func tokenize(s string) ([]string, error) {
+	var tokens []string
+	for i := 0; i < len(s); {
+		switch c := s[i]; {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(' || c == ')':
+			tokens = append(tokens, s[i:i+1])
+			i++
+		case c == '!':
+			tokens = append(tokens, "not")
+			i++
+		case strings.HasPrefix(s[i:], "&&"):
+			tokens = append(tokens, "and")
+			i += 2
+		case strings.HasPrefix(s[i:], "||"):
+			tokens = append(tokens, "or")
+			i += 2
+		default:
+			j := i
+			for j < len(s) && !strings.ContainsRune(" \t\n\r()!&|", rune(s[j])) {
+				j++
+			}
+			if j == i {
+				return nil, fmt.Errorf("pcap filter: unexpected %q", s[i:i+1])
+			}
+			tokens = append(tokens, s[i:j])
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+type filterParser struct {
+	tokens []string
+}
+
+func (f *filterParser) peek() string {
+	if len(f.tokens) == 0 {
+		return ""
+	}
+	return f.tokens[0]
+}
+
+func (f *filterParser) next() string {
+	tok := f.peek()
+	if tok != "" {
+		f.tokens = f.tokens[1:]
+	}
+	return tok
+}
+
+func (f *filterParser) parseOr() (filter, error) {
+	lhs, err := f.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for f.peek() == "or" {
+		f.next()
+		rhs, err := f.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		lhs = or{lhs, rhs}
+	}
+	return lhs, nil
+}
+
+func (f *filterParser) parseAnd() (filter, error) {
+	lhs, err := f.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for f.peek() == "and" {
+		f.next()
+		rhs, err := f.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		lhs = and{lhs, rhs}
+	}
+	return lhs, nil
+}
+
+func (f *filterParser) parseNot() (filter, error) {
+	switch f.peek() {
+	case "not":
+		f.next()
+		e, err := f.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return not{e}, nil
+	case "(":
+		f.next()
+		e, err := f.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if f.next() != ")" {
+			return nil, errors.New("missing \")\"")
+		}
+		return e, nil
+	}
+	return f.parsePrimitive()
+}
+
+func (f *filterParser) parsePrimitive() (filter, error) {
+	tok := f.next()
+	if tok == "" {
+		return nil, errors.New("unexpected end of expression")
+	}
+	if proto, ok := protoPredicate(tok); ok {
+		if !isQualifier(f.peek()) {
+			if tok == "vlan" {
+				return f.parseVLAN()
+			}
+			return proto, nil
+		}
+		if tok != "ip" && tok != "ip6" && tok != "tcp" && tok != "udp" {
+			return nil, fmt.Errorf("%q cannot qualify %q", tok, f.peek())
+		}
+		e, err := f.parsePrimitive()
+		if err != nil {
+			return nil, err
+		}
+		return and{proto, e}, nil
+	}
+	switch tok {
+	case "greater", "less":
+		arg := f.next()
+		n, err := strconv.ParseUint(arg, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("bad length %q", arg)
+		}
+		if tok == "greater" {
+			return predicate(func(p *packet) bool { return uint64(p.origLen) >= n }), nil
+		}
+		return predicate(func(p *packet) bool { return uint64(p.origLen) <= n }), nil
+	}
+	src, dst := true, true
+	switch tok {
+	case "src":
+		dst = false
+		tok = f.next()
+	case "dst":
+		src = false
+		tok = f.next()
+	}
+	switch tok {
+	case "host":
+		return f.parseHost(src, dst)
+	case "net":
+		return f.parseNet(src, dst)
+	case "port", "portrange":
+		return f.parsePort(tok == "portrange", src, dst)
+	case "":
+		return nil, errors.New("unexpected end of expression")
+	}
+	if !src || !dst {
+		// "src ADDR" is shorthand for "src host ADDR".
+		f.tokens = append([]string{tok}, f.tokens...)
+		return f.parseHost(src, dst)
+	}
+	return nil, fmt.Errorf("unknown primitive %q", tok)
+}
+
+func isQualifier(tok string) bool {
+	switch tok {
+	case "src", "dst", "host", "net", "port", "portrange":
+		return true
+	}
+	return false
+}
+
+func protoPredicate(tok string) (predicate, bool) {
+	switch tok {
+	case "ip":
+		return func(p *packet) bool { return p.hasIP && p.version == 4 }, true
+	case "ip6":
+		return func(p *packet) bool { return p.hasIP && p.version == 6 }, true
+	case "tcp":
+		return func(p *packet) bool { return p.hasTCP }, true
+	case "udp":
+		return func(p *packet) bool { return p.hasUDP }, true
+	case "icmp":
+		return func(p *packet) bool { return p.hasIP && p.proto == protoICMP }, true
+	case "icmp6":
+		return func(p *packet) bool { return p.hasIP && p.proto == protoICMPv6 }, true
+	case "arp":
+		return func(p *packet) bool { return p.hasEth && p.ethType == etherTypeARP }, true
+	case "vlan":
+		return func(p *packet) bool { return p.hasVLAN }, true
+	}
+	return nil, false
+}
+
+func (f *filterParser) parseVLAN() (filter, error) {
+	id, err := strconv.ParseUint(f.peek(), 10, 12)
+	if err != nil {
+		return predicate(func(p *packet) bool { return p.hasVLAN }), nil
+	}
+	f.next()
+	return predicate(func(p *packet) bool { return p.hasVLAN && uint64(p.vlan) == id }), nil
+}
+
+func (f *filterParser) parseHost(src, dst bool) (filter, error) {
+	arg := f.next()
+	addr, err := netip.ParseAddr(arg)
+	if err != nil {
+		return nil, fmt.Errorf("bad host address %q", arg)
+	}
+	return addrPredicate(src, dst, func(a netip.Addr) bool { return a == addr }), nil
+}
+
+func (f *filterParser) parseNet(src, dst bool) (filter, error) {
+	arg := f.next()
+	prefix, err := netip.ParsePrefix(arg)
+	if err != nil {
+		return nil, fmt.Errorf("bad network %q", arg)
+	}
+	prefix = prefix.Masked()
+	return addrPredicate(src, dst, prefix.Contains), nil
+}
+
+func addrPredicate(src, dst bool, fn func(netip.Addr) bool) predicate {
+	return func(p *packet) bool {
+		return p.hasIP && (src && fn(p.src) || dst && fn(p.dst))
+	}
+}
+
+func (f *filterParser) parsePort(isRange, src, dst bool) (filter, error) {
+	arg := f.next()
+	lo, hi := arg, arg
+	if isRange {
+		var ok bool
+		if lo, hi, ok = strings.Cut(arg, "-"); !ok {
+			return nil, fmt.Errorf("bad port range %q", arg)
+		}
+	}
+	low, err1 := strconv.ParseUint(lo, 10, 16)
+	high, err2 := strconv.ParseUint(hi, 10, 16)
+	if err1 != nil || err2 != nil || low > high {
+		if isRange {
+			return nil, fmt.Errorf("bad port range %q", arg)
+		}
+		return nil, fmt.Errorf("bad port %q", arg)
+	}
+	in := func(port uint16) bool { return uint64(port) >= low && uint64(port) <= high }
+	return predicate(func(p *packet) bool {
+		return (p.hasTCP || p.hasUDP) && (src && in(p.srcPort) || dst && in(p.dstPort))
+	}), nil
+}
@@ -0,0 +1,324 @@
+// Package pcapio implements a reader for packet captures in the pcap and
+// pcapng formats that decodes each packet into a record of its link,
+// network, and transport layers and its payload.
+package pcapio
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/bits"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/sup"
+	"github.com/brimdata/super/zcode"
+)
+
+// MaxPacketSize is the largest captured packet the reader accepts.  Larger
+// lengths indicate a corrupt capture.
+const MaxPacketSize = 16 * 1024 * 1024
+
+const (
+	pcapMagicMicros = 0xa1b2c3d4
+	pcapMagicNanos  = 0xa1b23c4d
+	pcapngSHB       = 0x0a0d0d0a
+	pcapngBOM       = 0x1a2b3c4d
+
+	pcapngIDB = 1
+	pcapngSPB = 3
+	pcapngEPB = 6
+)
+
+// ErrNotPCAP is returned by NewReader for input that is neither a pcap nor
+// a pcapng capture.
+var ErrNotPCAP = errors.New("not a pcap or pcapng capture")
+
+const packetType = `{ts:time,len:uint32,caplen:uint32,eth:{src:string,dst:string,type:uint16,vlan:uint16},ip:{version:uint8,src:ip,dst:ip,proto:uint8,ttl:uint8,len:uint16},tcp:{src_port:port=uint16,dst_port:port,seq:uint32,ack:uint32,flags:string,window:uint16},udp:{src_port:port,dst_port:port,len:uint16},icmp:{type:uint8,code:uint8},payload:bytes}`
+
+type ReaderOpts struct {
+	// Filter is a BPF-style expression, e.g., "tcp port 80", selecting the
+	// packets read.  It is empty to read all packets.
+	Filter string
+}
+
+type Reader struct {
+	reader  *bufio.Reader
+	order   binary.ByteOrder
+	filter  filter
+	typ     super.Type
+	builder zcode.Builder
+	packet  packet
+
+	// pcapng is true for a pcapng capture and false for a pcap capture.
+	pcapng bool
+	// linkType and nanos describe a pcap capture.
+	linkType uint32
+	nanos    bool
+	// ifaces describes the interfaces of the current pcapng section.
+	ifaces []iface
+	buf    []byte
+}
+
+type iface struct {
+	linkType uint16
+	snapLen  uint32
+	// tsPow10 is true if timestamps are in units of 10^-tsExp seconds
+	// and false if in units of 2^-tsExp seconds.
+	tsPow10 bool
+	tsExp   uint8
+}
+
+func NewReader(sctx *super.Context, r io.Reader, opts ReaderOpts) (*Reader, error) {
+	f, err := parseFilter(opts.Filter)
+	if err != nil {
+		return nil, err
+	}
+	typ, err := sup.ParseType(sctx, packetType)
+	if err != nil {
+		return nil, err
+	}
+	reader := &Reader{
+		reader: bufio.NewReader(r),
+		filter: f,
+		typ:    typ,
+	}
+	if err := reader.readHeader(); err != nil {
+		return nil, err
+	}
+	return reader, nil
+}
+
+func (r *Reader) readHeader() error {
+	magic, err := r.reader.Peek(4)
+	if err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return ErrNotPCAP
+		}
+		return err
+	}
+	switch {
+	case binary.LittleEndian.Uint32(magic) == pcapngSHB:
+		r.pcapng = true
+		return r.readSectionHeader()
+	case binary.LittleEndian.Uint32(magic) == pcapMagicMicros, binary.LittleEndian.Uint32(magic) == pcapMagicNanos:
+		r.order = binary.LittleEndian
+	case binary.BigEndian.Uint32(magic) == pcapMagicMicros, binary.BigEndian.Uint32(magic) == pcapMagicNanos:
+		r.order = binary.BigEndian
+	default:
+		return ErrNotPCAP
+	}
+	hdr, err := r.read(24)
+	if err != nil {
+		return err
+	}
+	r.nanos = r.order.Uint32(hdr) == pcapMagicNanos
+	r.linkType = r.order.Uint32(hdr[20:]) & 0xffff
+	return nil
+}
+
+// readSectionHeader reads a pcapng section header block, which determines
+// the byte order of the blocks of its section.
+func (r *Reader) readSectionHeader() error {
+	hdr, err := r.read(12)
+	if err != nil {
+		return err
+	}
+	switch {
+	case binary.LittleEndian.Uint32(hdr[8:]) == pcapngBOM:
+		r.order = binary.LittleEndian
+	case binary.BigEndian.Uint32(hdr[8:]) == pcapngBOM:
+		r.order = binary.BigEndian
+	default:
+		return ErrNotPCAP
+	}
+	n := r.order.Uint32(hdr[4:])
+	if n < 28 || n%4 != 0 || n > MaxPacketSize {
+		return fmt.Errorf("pcapng: bad section header block length %d", n)
+	}
+	if _, err := r.read(int(n) - 12); err != nil {
+		return err
+	}
+	r.ifaces = r.ifaces[:0]
+	return nil
+}
+
+func (r *Reader) Read() (*super.Value, error) {
+	for {
+		ok, err := r.next()
+		if !ok || err != nil {
+			return nil, err
+		}
+		if r.filter == nil || r.filter.match(&r.packet) {
+			val := r.packet.value(r.typ, &r.builder)
+			return &val, nil
+		}
+	}
+}
+
+// next reads and decodes the next packet into r.packet.  It returns false
+// at EOF.
+func (r *Reader) next() (bool, error) {
+	if r.pcapng {
+		return r.nextBlock()
+	}
+	hdr, err := r.read(16)
+	if hdr == nil || err != nil {
+		return false, err
+	}
+	capLen, origLen := r.order.Uint32(hdr[8:]), r.order.Uint32(hdr[12:])
+	if capLen > MaxPacketSize {
+		return false, fmt.Errorf("pcap: bad packet length %d", capLen)
+	}
+	frac := int64(r.order.Uint32(hdr[4:]))
+	if !r.nanos {
+		frac *= 1000
+	}
+	ts := nano.Unix(int64(r.order.Uint32(hdr)), frac)
+	data, err := r.read(int(capLen))
+	if err != nil {
+		return false, err
+	}
+	r.packet.decode(ts, origLen, data, r.linkType)
+	return true, nil
+}
+
+func (r *Reader) nextBlock() (bool, error) {
+	for {
+		hdr, err := r.peek(8)
+		if hdr == nil || err != nil {
+			return false, err
+		}
+		typ := r.order.Uint32(hdr)
+		if typ == pcapngSHB {
+			if err := r.readSectionHeader(); err != nil {
+				return false, err
+			}
+			continue
+		}
+		n := r.order.Uint32(hdr[4:])
+		if n < 12 || n%4 != 0 || n > MaxPacketSize {
+			return false, fmt.Errorf("pcapng: bad block length %d", n)
+		}
+		block, err := r.read(int(n))
+		if err != nil {
+			return false, err
+		}
+		body := block[8 : n-4]
+		switch typ {
+		case pcapngIDB:
+			if err := r.addInterface(body); err != nil {
+				return false, err
+			}
+		case pcapngEPB:
+			if len(body) < 20 {
+				return false, errors.New("pcapng: short enhanced packet block")
+			}
+			id := r.order.Uint32(body)
+			if int(id) >= len(r.ifaces) {
+				return false, fmt.Errorf("pcapng: no such interface %d", id)
+			}
+			capLen, origLen := r.order.Uint32(body[12:]), r.order.Uint32(body[16:])
+			if int(capLen) > len(body)-20 {
+				return false, fmt.Errorf("pcapng: bad packet length %d", capLen)
+			}
+			ifc := r.ifaces[id]
+			units := uint64(r.order.Uint32(body[4:]))<<32 | uint64(r.order.Uint32(body[8:]))
+			r.packet.decode(ifc.timestamp(units), origLen, body[20:20+capLen], uint32(ifc.linkType))
+			return true, nil
+		case pcapngSPB:
+			if len(body) < 4 || len(r.ifaces) == 0 {
+				return false, errors.New("pcapng: bad simple packet block")
+			}
+			origLen := r.order.Uint32(body)
+			data := body[4:]
+			if uint32(len(data)) > origLen {
+				data = data[:origLen]
+			}
+			if snapLen := r.ifaces[0].snapLen; snapLen != 0 && uint32(len(data)) > snapLen {
+				data = data[:snapLen]
+			}
+			// Simple packet blocks have no timestamp.
+			r.packet.decode(0, origLen, data, uint32(r.ifaces[0].linkType))
+			return true, nil
+		}
+		// Skip other blocks, e.g., name resolution and statistics.
+	}
+}
+
+func (r *Reader) addInterface(body []byte) error {
+	if len(body) < 8 {
+		return errors.New("pcapng: short interface description block")
+	}
+	ifc := iface{
+		linkType: r.order.Uint16(body),
+		snapLen:  r.order.Uint32(body[4:]),
+		tsPow10:  true,
+		tsExp:    6,
+	}
+	for opts := body[8:]; len(opts) >= 4; {
+		code, n := r.order.Uint16(opts), int(r.order.Uint16(opts[2:]))
+		if code == 0 || 4+n > len(opts) {
+			break
+		}
+		if code == 9 && n >= 1 {
+			// if_tsresol
+			ifc.tsPow10 = opts[4]&0x80 == 0
+			ifc.tsExp = opts[4] & 0x7f
+		}
+		opts = opts[4+(n+3)&^3:]
+	}
+	r.ifaces = append(r.ifaces, ifc)
+	return nil
+}
+
+// timestamp converts a timestamp in the units of i to a nano.Ts.
+func (i iface) timestamp(units uint64) nano.Ts {
+	if i.tsPow10 {
+		exp := int(i.tsExp)
+		for ; exp < 9; exp++ {
+			units *= 10
+		}
+		for ; exp > 9; exp-- {
+			units /= 10
+		}
+		return nano.Ts(units)
+	}
+	if i.tsExp >= 64 {
+		return 0
+	}
+	hi, lo := bits.Mul64(units, uint64(nano.Second))
+	if i.tsExp == 0 {
+		return nano.Ts(lo)
+	}
+	return nano.Ts(hi<<(64-i.tsExp) | lo>>i.tsExp)
+}
+
+// read returns the next n bytes of input, which are valid until the next
+// call.  It returns nil at EOF and io.ErrUnexpectedEOF if fewer than n
+// bytes remain.
+func (r *Reader) read(n int) ([]byte, error) {
+	if cap(r.buf) < n {
+		r.buf = make([]byte, n)
+	}
+	buf := r.buf[:n]
+	cc, err := io.ReadFull(r.reader, buf)
+	if err == io.EOF && cc == 0 {
+		return nil, nil
+	}
+	return buf, err
+}
+
+// peek is like read but does not advance the input.
+func (r *Reader) peek(n int) ([]byte, error) {
+	buf, err := r.reader.Peek(n)
+	if err == io.EOF {
+		if len(buf) == 0 {
+			return nil, nil
+		}
+		err = io.ErrUnexpectedEOF
+	}
+	return buf, err
+}
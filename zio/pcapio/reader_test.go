@@ -0,0 +1,275 @@
+package pcapio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"flag"
+	"io"
+	"math/bits"
+	"net/netip"
+	"os"
+	"testing"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/sup"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update the capture in the testdata directory")
+
+// fixturePath is a capture used by the ztests of the pcap reader.
+const fixturePath = "../../testdata/sample.pcap"
+
+type testPacket struct {
+	ts   nano.Ts
+	data []byte
+}
+
+var (
+	mac1 = []byte{0x02, 0, 0, 0, 0, 1}
+	mac2 = []byte{0x02, 0, 0, 0, 0, 2}
+	ts0  = nano.Unix(1704067200, 0)
+)
+
+// fixture is a TCP handshake and request, a DNS query on VLAN 100, an
+// ICMPv6 echo request, and an ARP request.
+var fixture = []testPacket{
+	{ts0, ether(mac1, mac2, 0, etherTypeIPv4, ipv4("10.0.0.1", "10.0.0.2", protoTCP, tcp(51000, 80, 1000, 0, 0x02, nil)))},
+	{ts0 + 1000, ether(mac2, mac1, 0, etherTypeIPv4, ipv4("10.0.0.2", "10.0.0.1", protoTCP, tcp(80, 51000, 5000, 1001, 0x12, nil)))},
+	{ts0 + 2000, ether(mac1, mac2, 0, etherTypeIPv4, ipv4("10.0.0.1", "10.0.0.2", protoTCP, tcp(51000, 80, 1001, 5001, 0x18, []byte("GET / HTTP/1.1\r\n\r\n"))))},
+	{ts0 + 3000, ether(mac1, mac2, 100, etherTypeIPv4, ipv4("10.0.0.1", "10.0.0.53", protoUDP, udp(5353, 53, []byte("query"))))},
+	{ts0 + 4000, ether(mac1, mac2, 0, etherTypeIPv6, ipv6("2001:db8::1", "2001:db8::2", protoICMPv6, []byte{128, 0, 0, 0, 0, 1, 0, 1}))},
+	{ts0 + 5000, ether(mac1, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, 0, etherTypeARP, make([]byte, 28))},
+}
+
+func TestFixture(t *testing.T) {
+	buf := writePCAP(fixture, binary.LittleEndian, false)
+	if *update {
+		require.NoError(t, os.WriteFile(fixturePath, buf, 0644))
+	}
+	b, err := os.ReadFile(fixturePath)
+	require.NoError(t, err)
+	require.Equal(t, buf, b, "run go test -update to update %s", fixturePath)
+}
+
+func TestFormats(t *testing.T) {
+	expected := readAll(t, writePCAP(fixture, binary.LittleEndian, false), "")
+	require.Len(t, expected, len(fixture))
+	for name, buf := range map[string][]byte{
+		"pcap big endian":      writePCAP(fixture, binary.BigEndian, false),
+		"pcap nanoseconds":     writePCAP(fixture, binary.LittleEndian, true),
+		"pcapng little endian": writePCAPNG(fixture, binary.LittleEndian),
+		"pcapng big endian":    writePCAPNG(fixture, binary.BigEndian),
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, expected, readAll(t, buf, ""))
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	vals := readAll(t, writePCAP(fixture, binary.LittleEndian, false), "")
+	expected := []string{
+		`{ts:2024-01-01T00:00:00Z,len:54(uint32),caplen:54(uint32),eth:{src:"02:00:00:00:00:01",dst:"02:00:00:00:00:02",type:2048(uint16),vlan:null(uint16)},ip:{version:4(uint8),src:10.0.0.1,dst:10.0.0.2,proto:6(uint8),ttl:64(uint8),len:40(uint16)},tcp:{src_port:51000(port=uint16),dst_port:80(port),seq:1000(uint32),ack:0(uint32),flags:"S",window:65535(uint16)},udp:null({src_port:port,dst_port:port,len:uint16}),icmp:null({type:uint8,code:uint8}),payload:0x}`,
+		`{ts:2024-01-01T00:00:00.000001Z,len:54(uint32),caplen:54(uint32),eth:{src:"02:00:00:00:00:02",dst:"02:00:00:00:00:01",type:2048(uint16),vlan:null(uint16)},ip:{version:4(uint8),src:10.0.0.2,dst:10.0.0.1,proto:6(uint8),ttl:64(uint8),len:40(uint16)},tcp:{src_port:80(port=uint16),dst_port:51000(port),seq:5000(uint32),ack:1001(uint32),flags:"S.",window:65535(uint16)},udp:null({src_port:port,dst_port:port,len:uint16}),icmp:null({type:uint8,code:uint8}),payload:0x}`,
+		`{ts:2024-01-01T00:00:00.000002Z,len:72(uint32),caplen:72(uint32),eth:{src:"02:00:00:00:00:01",dst:"02:00:00:00:00:02",type:2048(uint16),vlan:null(uint16)},ip:{version:4(uint8),src:10.0.0.1,dst:10.0.0.2,proto:6(uint8),ttl:64(uint8),len:58(uint16)},tcp:{src_port:51000(port=uint16),dst_port:80(port),seq:1001(uint32),ack:5001(uint32),flags:"P.",window:65535(uint16)},udp:null({src_port:port,dst_port:port,len:uint16}),icmp:null({type:uint8,code:uint8}),payload:0x474554202f20485454502f312e310d0a0d0a}`,
+		`{ts:2024-01-01T00:00:00.000003Z,len:51(uint32),caplen:51(uint32),eth:{src:"02:00:00:00:00:01",dst:"02:00:00:00:00:02",type:2048(uint16),vlan:100(uint16)},ip:{version:4(uint8),src:10.0.0.1,dst:10.0.0.53,proto:17(uint8),ttl:64(uint8),len:33(uint16)},tcp:null({src_port:port=uint16,dst_port:port,seq:uint32,ack:uint32,flags:string,window:uint16}),udp:{src_port:5353(port),dst_port:53(port),len:13(uint16)},icmp:null({type:uint8,code:uint8}),payload:0x7175657279}`,
+		`{ts:2024-01-01T00:00:00.000004Z,len:62(uint32),caplen:62(uint32),eth:{src:"02:00:00:00:00:01",dst:"02:00:00:00:00:02",type:34525(uint16),vlan:null(uint16)},ip:{version:6(uint8),src:2001:db8::1,dst:2001:db8::2,proto:58(uint8),ttl:64(uint8),len:8(uint16)},tcp:null({src_port:port=uint16,dst_port:port,seq:uint32,ack:uint32,flags:string,window:uint16}),udp:null({src_port:port,dst_port:port,len:uint16}),icmp:{type:128(uint8),code:0(uint8)},payload:0x00010001}`,
+		`{ts:2024-01-01T00:00:00.000005Z,len:42(uint32),caplen:42(uint32),eth:{src:"02:00:00:00:00:01",dst:"ff:ff:ff:ff:ff:ff",type:2054(uint16),vlan:null(uint16)},ip:null({version:uint8,src:ip,dst:ip,proto:uint8,ttl:uint8,len:uint16}),tcp:null({src_port:port=uint16,dst_port:port,seq:uint32,ack:uint32,flags:string,window:uint16}),udp:null({src_port:port,dst_port:port,len:uint16}),icmp:null({type:uint8,code:uint8}),payload:0x00000000000000000000000000000000000000000000000000000000}`,
+	}
+	require.Equal(t, expected, vals)
+}
+
+func TestFilter(t *testing.T) {
+	buf := writePCAP(fixture, binary.LittleEndian, false)
+	cases := []struct {
+		filter string
+		n      int
+	}{
+		{"tcp", 3},
+		{"udp or icmp6", 2},
+		{"tcp port 80", 3},
+		{"tcp dst port 80", 2},
+		{"src port 80", 1},
+		{"portrange 50-60", 1},
+		{"host 10.0.0.53", 1},
+		{"src host 10.0.0.2", 1},
+		{"dst 10.0.0.2", 2},
+		{"net 10.0.0.0/24 and not tcp", 1},
+		{"ip6 net 2001:db8::/32", 1},
+		{"vlan", 1},
+		{"vlan 100", 1},
+		{"vlan 200", 0},
+		{"arp", 1},
+		{"!(tcp || udp) && ip", 0},
+		{"greater 60", 2},
+		{"less 50", 1},
+	}
+	for _, c := range cases {
+		t.Run(c.filter, func(t *testing.T) {
+			require.Len(t, readAll(t, buf, c.filter), c.n)
+		})
+	}
+	for _, filter := range []string{"tcp port", "host 10.0.0", "(tcp", "tcp or", "bogus", "tcp udp", "icmp port 80", "portrange 80"} {
+		t.Run(filter, func(t *testing.T) {
+			_, err := NewReader(super.NewContext(), bytes.NewReader(buf), ReaderOpts{Filter: filter})
+			require.ErrorContains(t, err, "pcap filter: ")
+		})
+	}
+}
+
+func TestNotPCAP(t *testing.T) {
+	for _, s := range []string{"", "abc", "{a:1}\n"} {
+		_, err := NewReader(super.NewContext(), bytes.NewReader([]byte(s)), ReaderOpts{})
+		require.ErrorIs(t, err, ErrNotPCAP)
+	}
+	buf := writePCAP(fixture, binary.LittleEndian, false)
+	r, err := NewReader(super.NewContext(), bytes.NewReader(buf[:len(buf)-1]), ReaderOpts{})
+	require.NoError(t, err)
+	for {
+		val, err := r.Read()
+		if err != nil {
+			require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+			break
+		}
+		require.NotNil(t, val, "truncated capture read without error")
+	}
+}
+
+func readAll(t *testing.T, buf []byte, filter string) []string {
+	r, err := NewReader(super.NewContext(), bytes.NewReader(buf), ReaderOpts{Filter: filter})
+	require.NoError(t, err)
+	var vals []string
+	for {
+		val, err := r.Read()
+		require.NoError(t, err)
+		if val == nil {
+			return vals
+		}
+		vals = append(vals, sup.FormatValue(*val))
+	}
+}
+
+func writePCAP(packets []testPacket, order binary.AppendByteOrder, nanos bool) []byte {
+	var b []byte
+	magic := uint32(pcapMagicMicros)
+	if nanos {
+		magic = pcapMagicNanos
+	}
+	b = order.AppendUint32(b, magic)
+	b = order.AppendUint16(b, 2)
+	b = order.AppendUint16(b, 4)
+	b = order.AppendUint32(b, 0)
+	b = order.AppendUint32(b, 0)
+	b = order.AppendUint32(b, 65535)
+	b = order.AppendUint32(b, linkEthernet)
+	for _, p := range packets {
+		frac := uint32(p.ts % nano.Ts(nano.Second))
+		if !nanos {
+			frac /= 1000
+		}
+		b = order.AppendUint32(b, uint32(p.ts/nano.Ts(nano.Second)))
+		b = order.AppendUint32(b, frac)
+		b = order.AppendUint32(b, uint32(len(p.data)))
+		b = order.AppendUint32(b, uint32(len(p.data)))
+		b = append(b, p.data...)
+	}
+	return b
+}
+
+// writePCAPNG writes packets with an interface whose timestamps are in
+// units of 2^-30 seconds followed by a statistics block, which the reader
+// skips.
+func writePCAPNG(packets []testPacket, order binary.AppendByteOrder) []byte {
+	var b []byte
+	block := func(typ uint32, body []byte) {
+		for len(body)%4 != 0 {
+			body = append(body, 0)
+		}
+		b = order.AppendUint32(b, typ)
+		b = order.AppendUint32(b, uint32(12+len(body)))
+		b = append(b, body...)
+		b = order.AppendUint32(b, uint32(12+len(body)))
+	}
+	var shb []byte
+	shb = order.AppendUint32(shb, pcapngBOM)
+	shb = order.AppendUint16(shb, 1)
+	shb = order.AppendUint16(shb, 0)
+	shb = order.AppendUint64(shb, ^uint64(0))
+	block(pcapngSHB, shb)
+	var idb []byte
+	idb = order.AppendUint16(idb, linkEthernet)
+	idb = order.AppendUint16(idb, 0)
+	idb = order.AppendUint32(idb, 0)
+	idb = order.AppendUint16(idb, 9)
+	idb = order.AppendUint16(idb, 1)
+	idb = append(idb, 0x80|30, 0, 0, 0)
+	idb = order.AppendUint32(idb, 0)
+	block(pcapngIDB, idb)
+	for _, p := range packets {
+		// Microsecond timestamps are exact in units of 2^-30 seconds
+		// after rounding up.
+		hi, lo := bits.Mul64(uint64(p.ts), 1<<30)
+		units, rem := bits.Div64(hi, lo, uint64(nano.Second))
+		if rem != 0 {
+			units++
+		}
+		var epb []byte
+		epb = order.AppendUint32(epb, 0)
+		epb = order.AppendUint32(epb, uint32(units>>32))
+		epb = order.AppendUint32(epb, uint32(units))
+		epb = order.AppendUint32(epb, uint32(len(p.data)))
+		epb = order.AppendUint32(epb, uint32(len(p.data)))
+		block(pcapngEPB, append(epb, p.data...))
+	}
+	block(5, make([]byte, 12))
+	return b
+}
+
+func ether(src, dst []byte, vlan uint16, typ uint16, payload []byte) []byte {
+	b := append(append([]byte{}, dst...), src...)
+	if vlan != 0 {
+		b = binary.BigEndian.AppendUint16(b, etherTypeVLAN)
+		b = binary.BigEndian.AppendUint16(b, vlan)
+	}
+	b = binary.BigEndian.AppendUint16(b, typ)
+	return append(b, payload...)
+}
+
+func ipv4(src, dst string, proto uint8, payload []byte) []byte {
+	b := []byte{0x45, 0}
+	b = binary.BigEndian.AppendUint16(b, uint16(20+len(payload)))
+	b = append(b, 0, 0, 0x40, 0, 64, proto, 0, 0)
+	b = append(b, netip.MustParseAddr(src).AsSlice()...)
+	b = append(b, netip.MustParseAddr(dst).AsSlice()...)
+	return append(b, payload...)
+}
+
+func ipv6(src, dst string, next uint8, payload []byte) []byte {
+	b := []byte{0x60, 0, 0, 0}
+	b = binary.BigEndian.AppendUint16(b, uint16(len(payload)))
+	b = append(b, next, 64)
+	b = append(b, netip.MustParseAddr(src).AsSlice()...)
+	b = append(b, netip.MustParseAddr(dst).AsSlice()...)
+	return append(b, payload...)
+}
+
+func tcp(src, dst uint16, seq, ack uint32, flags uint8, payload []byte) []byte {
+	var b []byte
+	b = binary.BigEndian.AppendUint16(b, src)
+	b = binary.BigEndian.AppendUint16(b, dst)
+	b = binary.BigEndian.AppendUint32(b, seq)
+	b = binary.BigEndian.AppendUint32(b, ack)
+	b = append(b, 5<<4, flags, 0xff, 0xff, 0, 0, 0, 0)
+	return append(b, payload...)
+}
+
+func udp(src, dst uint16, payload []byte) []byte {
+	var b []byte
+	b = binary.BigEndian.AppendUint16(b, src)
+	b = binary.BigEndian.AppendUint16(b, dst)
+	b = binary.BigEndian.AppendUint16(b, uint16(8+len(payload)))
+	b = append(b, 0, 0)
+	return append(b, payload...)
+}
@@ -4,30 +4,38 @@ import (
 	"fmt"
 	"io"
 	"strings"
-	"text/tabwriter"
 	"time"
+	"unicode/utf8"
 
 	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/terminal/color"
 	"github.com/brimdata/super/runtime/sam/expr"
 	"github.com/brimdata/super/sup"
 	"github.com/brimdata/super/zio/zeekio"
 )
 
+type WriterOpts struct {
+	// Align right-aligns columns of numbers and durations.
+	Align bool
+	// MaxWidth, if positive, is the maximum width of a column.  Wider
+	// values are truncated and end with an ellipsis.
+	MaxWidth int
+}
+
 type Writer struct {
 	writer    io.WriteCloser
 	flattener *expr.Flattener
-	table     *tabwriter.Writer
+	opts      WriterOpts
 	typ       *super.TypeRecord
+	rows      [][]string
 	limit     int
-	nline     int
 }
 
-func NewWriter(w io.WriteCloser) *Writer {
-	table := tabwriter.NewWriter(w, 0, 8, 1, ' ', 0)
+func NewWriter(w io.WriteCloser, opts WriterOpts) *Writer {
 	return &Writer{
 		writer:    w,
 		flattener: expr.NewFlattener(super.NewContext()),
-		table:     table,
+		opts:      opts,
 		limit:     1000,
 	}
 }
@@ -40,20 +48,13 @@ func (w *Writer) Write(r super.Value) error {
 	if err != nil {
 		return err
 	}
-	if r.Type() != w.typ {
-		if w.typ != nil {
-			w.flush()
-			w.nline = 0
+	if r.Type() != w.typ || len(w.rows) >= w.limit {
+		// First time, new descriptor, or full table, so print what we
+		// have and start a new table with a header.
+		if err := w.flush(); err != nil {
+			return err
 		}
-		// First time, or new descriptor, print header
-		typ := super.TypeRecordOf(r.Type())
-		w.writeHeader(typ)
-		w.typ = typ
-	}
-	if w.nline >= w.limit {
-		w.flush()
-		w.writeHeader(w.typ)
-		w.nline = 0
+		w.typ = super.TypeRecordOf(r.Type())
 	}
 	var out []string
 	for k, f := range r.Fields() {
@@ -66,25 +67,69 @@ func (w *Writer) Write(r super.Value) error {
 		} else {
 			v = zeekio.FormatValue(value)
 		}
-		out = append(out, v)
+		out = append(out, w.truncate(v))
 	}
-	w.nline++
-	_, err = fmt.Fprintf(w.table, "%s\n", strings.Join(out, "\t"))
-	return err
+	w.rows = append(w.rows, out)
+	return nil
 }
 
-func (w *Writer) flush() error {
-	return w.table.Flush()
+func (w *Writer) truncate(s string) string {
+	if w.opts.MaxWidth <= 0 || utf8.RuneCountInString(s) <= w.opts.MaxWidth {
+		return s
+	}
+	runes := []rune(s)
+	return string(runes[:w.opts.MaxWidth-1]) + "…"
 }
 
-func (w *Writer) writeHeader(typ *super.TypeRecord) {
-	for i, f := range typ.Fields {
-		if i > 0 {
-			w.table.Write([]byte{'\t'})
+// flush writes the header and buffered rows with each column padded to the
+// width of its widest value.
+func (w *Writer) flush() error {
+	if w.typ == nil {
+		return nil
+	}
+	header := make([]string, 0, len(w.typ.Fields))
+	for _, f := range w.typ.Fields {
+		header = append(header, w.truncate(f.Name))
+	}
+	widths := make([]int, len(header))
+	for _, row := range append(w.rows, header) {
+		for k, cell := range row {
+			widths[k] = max(widths[k], utf8.RuneCountInString(cell))
 		}
-		w.table.Write([]byte(f.Name))
 	}
-	w.table.Write([]byte{'\n'})
+	right := make([]bool, len(header))
+	if w.opts.Align {
+		for k, f := range w.typ.Fields {
+			id := super.TypeUnder(f.Type).ID()
+			right[k] = super.IsNumber(id) && id != super.IDTime
+		}
+	}
+	var b strings.Builder
+	for k, row := range append([][]string{header}, w.rows...) {
+		for i, cell := range row {
+			last := i == len(row)-1
+			pad := widths[i] - utf8.RuneCountInString(cell)
+			if k == 0 {
+				cell = color.Embolden(cell)
+			}
+			if right[i] {
+				b.WriteString(strings.Repeat(" ", pad))
+				b.WriteString(cell)
+			} else {
+				b.WriteString(cell)
+				if !last {
+					b.WriteString(strings.Repeat(" ", pad))
+				}
+			}
+			if !last {
+				b.WriteByte(' ')
+			}
+		}
+		b.WriteByte('\n')
+	}
+	w.rows = w.rows[:0]
+	_, err := io.WriteString(w.writer, b.String())
+	return err
 }
 
 func (w *Writer) Close() error {